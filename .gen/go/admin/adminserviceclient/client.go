@@ -26,6 +26,7 @@ package adminserviceclient
 import (
 	"context"
 	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"go.uber.org/thriftrw/wire"
 	"go.uber.org/yarpc"
@@ -36,23 +37,95 @@ import (
 
 // Interface is a client for the AdminService service.
 type Interface interface {
+	AddCluster(
+		ctx context.Context,
+		AddRequest *admin.AddClusterRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	AdvanceTime(
+		ctx context.Context,
+		AdvanceRequest *admin.AdvanceTimeRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.AdvanceTimeResponse, error)
+
+	ApplyReplicationTask(
+		ctx context.Context,
+		ApplyRequest *admin.ApplyReplicationTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeHistoryHostResponse, error)
 
+	DescribeReplicationStatus(
+		ctx context.Context,
+		Request *replicator.DescribeReplicationStatusRequest,
+		opts ...yarpc.CallOption,
+	) (*replicator.DescribeReplicationStatusResponse, error)
+
+	DescribeTaskListDispatchRate(
+		ctx context.Context,
+		DescribeRequest *shared.DescribeTaskListDispatchRateRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeTaskListDispatchRateResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		Request *admin.DescribeWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) (*admin.DescribeWorkflowExecutionResponse, error)
 
+	GetReplicationMessages(
+		ctx context.Context,
+		Request *replicator.GetReplicationMessagesRequest,
+		opts ...yarpc.CallOption,
+	) (*replicator.GetReplicationMessagesResponse, error)
+
 	GetWorkflowExecutionRawHistory(
 		ctx context.Context,
 		GetRequest *admin.GetWorkflowExecutionRawHistoryRequest,
 		opts ...yarpc.CallOption,
 	) (*admin.GetWorkflowExecutionRawHistoryResponse, error)
+
+	ListClusters(
+		ctx context.Context,
+		ListRequest *admin.ListClustersRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ListClustersResponse, error)
+
+	ListConflictResolutions(
+		ctx context.Context,
+		ListRequest *admin.ListConflictResolutionsRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ListConflictResolutionsResponse, error)
+
+	PauseTaskList(
+		ctx context.Context,
+		PauseRequest *shared.PauseTaskListRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	ResumeTaskList(
+		ctx context.Context,
+		ResumeRequest *shared.ResumeTaskListRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UpdateCluster(
+		ctx context.Context,
+		UpdateRequest *admin.UpdateClusterRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UpdateTaskListDispatchRate(
+		ctx context.Context,
+		UpdateRequest *shared.UpdateTaskListDispatchRateRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the AdminService service.
@@ -79,6 +152,75 @@ type client struct {
 	c thrift.Client
 }
 
+func (c client) AddCluster(
+	ctx context.Context,
+	_AddRequest *admin.AddClusterRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_AddCluster_Helper.Args(_AddRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_AddCluster_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_AddCluster_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) AdvanceTime(
+	ctx context.Context,
+	_AdvanceRequest *admin.AdvanceTimeRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.AdvanceTimeResponse, err error) {
+
+	args := admin.AdminService_AdvanceTime_Helper.Args(_AdvanceRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_AdvanceTime_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_AdvanceTime_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ApplyReplicationTask(
+	ctx context.Context,
+	_ApplyRequest *admin.ApplyReplicationTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_ApplyReplicationTask_Helper.Args(_ApplyRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ApplyReplicationTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_ApplyReplicationTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeHistoryHost(
 	ctx context.Context,
 	_Request *shared.DescribeHistoryHostRequest,
@@ -102,6 +244,52 @@ func (c client) DescribeHistoryHost(
 	return
 }
 
+func (c client) DescribeReplicationStatus(
+	ctx context.Context,
+	_Request *replicator.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (success *replicator.DescribeReplicationStatusResponse, err error) {
+
+	args := admin.AdminService_DescribeReplicationStatus_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DescribeReplicationStatus_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DescribeReplicationStatus_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) DescribeTaskListDispatchRate(
+	ctx context.Context,
+	_DescribeRequest *shared.DescribeTaskListDispatchRateRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeTaskListDispatchRateResponse, err error) {
+
+	args := admin.AdminService_DescribeTaskListDispatchRate_Helper.Args(_DescribeRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DescribeTaskListDispatchRate_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DescribeTaskListDispatchRate_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeWorkflowExecution(
 	ctx context.Context,
 	_Request *admin.DescribeWorkflowExecutionRequest,
@@ -125,6 +313,29 @@ func (c client) DescribeWorkflowExecution(
 	return
 }
 
+func (c client) GetReplicationMessages(
+	ctx context.Context,
+	_Request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption,
+) (success *replicator.GetReplicationMessagesResponse, err error) {
+
+	args := admin.AdminService_GetReplicationMessages_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_GetReplicationMessages_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_GetReplicationMessages_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetWorkflowExecutionRawHistory(
 	ctx context.Context,
 	_GetRequest *admin.GetWorkflowExecutionRawHistoryRequest,
@@ -147,3 +358,141 @@ func (c client) GetWorkflowExecutionRawHistory(
 	success, err = admin.AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) ListClusters(
+	ctx context.Context,
+	_ListRequest *admin.ListClustersRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListClustersResponse, err error) {
+
+	args := admin.AdminService_ListClusters_Helper.Args(_ListRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ListClusters_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ListClusters_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ListConflictResolutions(
+	ctx context.Context,
+	_ListRequest *admin.ListConflictResolutionsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListConflictResolutionsResponse, err error) {
+
+	args := admin.AdminService_ListConflictResolutions_Helper.Args(_ListRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ListConflictResolutions_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ListConflictResolutions_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PauseTaskList(
+	ctx context.Context,
+	_PauseRequest *shared.PauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_PauseTaskList_Helper.Args(_PauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_PauseTaskList_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_PauseTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ResumeTaskList(
+	ctx context.Context,
+	_ResumeRequest *shared.ResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_ResumeTaskList_Helper.Args(_ResumeRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ResumeTaskList_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_ResumeTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateCluster(
+	ctx context.Context,
+	_UpdateRequest *admin.UpdateClusterRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_UpdateCluster_Helper.Args(_UpdateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_UpdateCluster_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_UpdateCluster_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateTaskListDispatchRate(
+	ctx context.Context,
+	_UpdateRequest *shared.UpdateTaskListDispatchRateRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_UpdateTaskListDispatchRate_Helper.Args(_UpdateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_UpdateTaskListDispatchRate_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_UpdateTaskListDispatchRate_Helper.UnwrapResponse(&result)
+	return
+}
@@ -26,6 +26,7 @@ package adminserviceserver
 import (
 	"context"
 	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"go.uber.org/thriftrw/wire"
 	"go.uber.org/yarpc/api/transport"
@@ -34,20 +35,80 @@ import (
 
 // Interface is the server-side interface for the AdminService service.
 type Interface interface {
+	AddCluster(
+		ctx context.Context,
+		AddRequest *admin.AddClusterRequest,
+	) error
+
+	AdvanceTime(
+		ctx context.Context,
+		AdvanceRequest *admin.AdvanceTimeRequest,
+	) (*admin.AdvanceTimeResponse, error)
+
+	ApplyReplicationTask(
+		ctx context.Context,
+		ApplyRequest *admin.ApplyReplicationTaskRequest,
+	) error
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
 	) (*shared.DescribeHistoryHostResponse, error)
 
+	DescribeReplicationStatus(
+		ctx context.Context,
+		Request *replicator.DescribeReplicationStatusRequest,
+	) (*replicator.DescribeReplicationStatusResponse, error)
+
+	DescribeTaskListDispatchRate(
+		ctx context.Context,
+		DescribeRequest *shared.DescribeTaskListDispatchRateRequest,
+	) (*shared.DescribeTaskListDispatchRateResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		Request *admin.DescribeWorkflowExecutionRequest,
 	) (*admin.DescribeWorkflowExecutionResponse, error)
 
+	GetReplicationMessages(
+		ctx context.Context,
+		Request *replicator.GetReplicationMessagesRequest,
+	) (*replicator.GetReplicationMessagesResponse, error)
+
 	GetWorkflowExecutionRawHistory(
 		ctx context.Context,
 		GetRequest *admin.GetWorkflowExecutionRawHistoryRequest,
 	) (*admin.GetWorkflowExecutionRawHistoryResponse, error)
+
+	ListClusters(
+		ctx context.Context,
+		ListRequest *admin.ListClustersRequest,
+	) (*admin.ListClustersResponse, error)
+
+	ListConflictResolutions(
+		ctx context.Context,
+		ListRequest *admin.ListConflictResolutionsRequest,
+	) (*admin.ListConflictResolutionsResponse, error)
+
+	PauseTaskList(
+		ctx context.Context,
+		PauseRequest *shared.PauseTaskListRequest,
+	) error
+
+	ResumeTaskList(
+		ctx context.Context,
+		ResumeRequest *shared.ResumeTaskListRequest,
+	) error
+
+	UpdateCluster(
+		ctx context.Context,
+		UpdateRequest *admin.UpdateClusterRequest,
+	) error
+
+	UpdateTaskListDispatchRate(
+		ctx context.Context,
+		UpdateRequest *shared.UpdateTaskListDispatchRateRequest,
+	) error
 }
 
 // New prepares an implementation of the AdminService service for
@@ -61,6 +122,39 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		Name: "AdminService",
 		Methods: []thrift.Method{
 
+			thrift.Method{
+				Name: "AddCluster",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.AddCluster),
+				},
+				Signature:    "AddCluster(AddRequest *admin.AddClusterRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "AdvanceTime",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.AdvanceTime),
+				},
+				Signature:    "AdvanceTime(AdvanceRequest *admin.AdvanceTimeRequest) (*admin.AdvanceTimeResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ApplyReplicationTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ApplyReplicationTask),
+				},
+				Signature:    "ApplyReplicationTask(ApplyRequest *admin.ApplyReplicationTaskRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeHistoryHost",
 				HandlerSpec: thrift.HandlerSpec{
@@ -72,6 +166,28 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeReplicationStatus",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeReplicationStatus),
+				},
+				Signature:    "DescribeReplicationStatus(Request *replicator.DescribeReplicationStatusRequest) (*replicator.DescribeReplicationStatusResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "DescribeTaskListDispatchRate",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeTaskListDispatchRate),
+				},
+				Signature:    "DescribeTaskListDispatchRate(DescribeRequest *shared.DescribeTaskListDispatchRateRequest) (*shared.DescribeTaskListDispatchRateResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeWorkflowExecution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -83,6 +199,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetReplicationMessages",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.GetReplicationMessages),
+				},
+				Signature:    "GetReplicationMessages(Request *replicator.GetReplicationMessagesRequest) (*replicator.GetReplicationMessagesResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetWorkflowExecutionRawHistory",
 				HandlerSpec: thrift.HandlerSpec{
@@ -93,16 +220,139 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "GetWorkflowExecutionRawHistory(GetRequest *admin.GetWorkflowExecutionRawHistoryRequest) (*admin.GetWorkflowExecutionRawHistoryResponse)",
 				ThriftModule: admin.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "ListClusters",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListClusters),
+				},
+				Signature:    "ListClusters(ListRequest *admin.ListClustersRequest) (*admin.ListClustersResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ListConflictResolutions",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListConflictResolutions),
+				},
+				Signature:    "ListConflictResolutions(ListRequest *admin.ListConflictResolutionsRequest) (*admin.ListConflictResolutionsResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PauseTaskList",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PauseTaskList),
+				},
+				Signature:    "PauseTaskList(PauseRequest *shared.PauseTaskListRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ResumeTaskList",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ResumeTaskList),
+				},
+				Signature:    "ResumeTaskList(ResumeRequest *shared.ResumeTaskListRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpdateCluster",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateCluster),
+				},
+				Signature:    "UpdateCluster(UpdateRequest *admin.UpdateClusterRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpdateTaskListDispatchRate",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateTaskListDispatchRate),
+				},
+				Signature:    "UpdateTaskListDispatchRate(UpdateRequest *shared.UpdateTaskListDispatchRateRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 3)
+	procedures := make([]transport.Procedure, 0, 15)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
 
 type handler struct{ impl Interface }
 
+func (h handler) AddCluster(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_AddCluster_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.AddCluster(ctx, args.AddRequest)
+
+	hadError := err != nil
+	result, err := admin.AdminService_AddCluster_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) AdvanceTime(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_AdvanceTime_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.AdvanceTime(ctx, args.AdvanceRequest)
+
+	hadError := err != nil
+	result, err := admin.AdminService_AdvanceTime_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ApplyReplicationTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ApplyReplicationTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.ApplyReplicationTask(ctx, args.ApplyRequest)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ApplyReplicationTask_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DescribeHistoryHost_Args
 	if err := args.FromWire(body); err != nil {
@@ -122,6 +372,44 @@ func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
+func (h handler) DescribeReplicationStatus(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeReplicationStatus_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DescribeReplicationStatus(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_DescribeReplicationStatus_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) DescribeTaskListDispatchRate(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeTaskListDispatchRate_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DescribeTaskListDispatchRate(ctx, args.DescribeRequest)
+
+	hadError := err != nil
+	result, err := admin.AdminService_DescribeTaskListDispatchRate_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DescribeWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
@@ -141,6 +429,25 @@ func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) GetReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetReplicationMessages_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.GetReplicationMessages(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_GetReplicationMessages_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) GetWorkflowExecutionRawHistory(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_GetWorkflowExecutionRawHistory_Args
 	if err := args.FromWire(body); err != nil {
@@ -159,3 +466,117 @@ func (h handler) GetWorkflowExecutionRawHistory(ctx context.Context, body wire.V
 	}
 	return response, err
 }
+
+func (h handler) ListClusters(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListClusters_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ListClusters(ctx, args.ListRequest)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ListClusters_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ListConflictResolutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListConflictResolutions_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ListConflictResolutions(ctx, args.ListRequest)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ListConflictResolutions_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) PauseTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PauseTaskList_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.PauseTaskList(ctx, args.PauseRequest)
+
+	hadError := err != nil
+	result, err := admin.AdminService_PauseTaskList_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ResumeTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResumeTaskList_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.ResumeTaskList(ctx, args.ResumeRequest)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ResumeTaskList_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) UpdateCluster(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UpdateCluster_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.UpdateCluster(ctx, args.UpdateRequest)
+
+	hadError := err != nil
+	result, err := admin.AdminService_UpdateCluster_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) UpdateTaskListDispatchRate(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UpdateTaskListDispatchRate_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.UpdateTaskListDispatchRate(ctx, args.UpdateRequest)
+
+	hadError := err != nil
+	result, err := admin.AdminService_UpdateTaskListDispatchRate_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/uber/cadence/.gen/go/admin"
 	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"go.uber.org/yarpc"
 )
@@ -63,6 +64,101 @@ func (m *MockClient) EXPECT() *_MockClientRecorder {
 	return m.recorder
 }
 
+// AddCluster responds to a AddCluster call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().AddCluster(gomock.Any(), ...).Return(...)
+// 	... := client.AddCluster(...)
+func (m *MockClient) AddCluster(
+	ctx context.Context,
+	_AddRequest *admin.AddClusterRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _AddRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "AddCluster", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) AddCluster(
+	ctx interface{},
+	_AddRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _AddRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "AddCluster", args...)
+}
+
+// AdvanceTime responds to a AdvanceTime call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().AdvanceTime(gomock.Any(), ...).Return(...)
+// 	... := client.AdvanceTime(...)
+func (m *MockClient) AdvanceTime(
+	ctx context.Context,
+	_AdvanceRequest *admin.AdvanceTimeRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.AdvanceTimeResponse, err error) {
+
+	args := []interface{}{ctx, _AdvanceRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "AdvanceTime", args...)
+	success, _ = ret[i].(*admin.AdvanceTimeResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) AdvanceTime(
+	ctx interface{},
+	_AdvanceRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _AdvanceRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "AdvanceTime", args...)
+}
+
+// ApplyReplicationTask responds to a ApplyReplicationTask call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ApplyReplicationTask(gomock.Any(), ...).Return(...)
+// 	... := client.ApplyReplicationTask(...)
+func (m *MockClient) ApplyReplicationTask(
+	ctx context.Context,
+	_ApplyRequest *admin.ApplyReplicationTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _ApplyRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ApplyReplicationTask", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ApplyReplicationTask(
+	ctx interface{},
+	_ApplyRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _ApplyRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ApplyReplicationTask", args...)
+}
+
 // DescribeHistoryHost responds to a DescribeHistoryHost call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -96,6 +192,72 @@ func (mr *_MockClientRecorder) DescribeHistoryHost(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeHistoryHost", args...)
 }
 
+// DescribeReplicationStatus responds to a DescribeReplicationStatus call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().DescribeReplicationStatus(gomock.Any(), ...).Return(...)
+// 	... := client.DescribeReplicationStatus(...)
+func (m *MockClient) DescribeReplicationStatus(
+	ctx context.Context,
+	_Request *replicator.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (success *replicator.DescribeReplicationStatusResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeReplicationStatus", args...)
+	success, _ = ret[i].(*replicator.DescribeReplicationStatusResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeReplicationStatus(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeReplicationStatus", args...)
+}
+
+// DescribeTaskListDispatchRate responds to a DescribeTaskListDispatchRate call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().DescribeTaskListDispatchRate(gomock.Any(), ...).Return(...)
+// 	... := client.DescribeTaskListDispatchRate(...)
+func (m *MockClient) DescribeTaskListDispatchRate(
+	ctx context.Context,
+	_DescribeRequest *shared.DescribeTaskListDispatchRateRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeTaskListDispatchRateResponse, err error) {
+
+	args := []interface{}{ctx, _DescribeRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeTaskListDispatchRate", args...)
+	success, _ = ret[i].(*shared.DescribeTaskListDispatchRateResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeTaskListDispatchRate(
+	ctx interface{},
+	_DescribeRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _DescribeRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeTaskListDispatchRate", args...)
+}
+
 // DescribeWorkflowExecution responds to a DescribeWorkflowExecution call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -129,6 +291,39 @@ func (mr *_MockClientRecorder) DescribeWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeWorkflowExecution", args...)
 }
 
+// GetReplicationMessages responds to a GetReplicationMessages call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().GetReplicationMessages(gomock.Any(), ...).Return(...)
+// 	... := client.GetReplicationMessages(...)
+func (m *MockClient) GetReplicationMessages(
+	ctx context.Context,
+	_Request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption,
+) (success *replicator.GetReplicationMessagesResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetReplicationMessages", args...)
+	success, _ = ret[i].(*replicator.GetReplicationMessagesResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetReplicationMessages(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetReplicationMessages", args...)
+}
+
 // GetWorkflowExecutionRawHistory responds to a GetWorkflowExecutionRawHistory call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -161,3 +356,193 @@ func (mr *_MockClientRecorder) GetWorkflowExecutionRawHistory(
 	args := append([]interface{}{ctx, _GetRequest}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetWorkflowExecutionRawHistory", args...)
 }
+
+// ListClusters responds to a ListClusters call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ListClusters(gomock.Any(), ...).Return(...)
+// 	... := client.ListClusters(...)
+func (m *MockClient) ListClusters(
+	ctx context.Context,
+	_ListRequest *admin.ListClustersRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListClustersResponse, err error) {
+
+	args := []interface{}{ctx, _ListRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListClusters", args...)
+	success, _ = ret[i].(*admin.ListClustersResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListClusters(
+	ctx interface{},
+	_ListRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _ListRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListClusters", args...)
+}
+
+// ListConflictResolutions responds to a ListConflictResolutions call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ListConflictResolutions(gomock.Any(), ...).Return(...)
+// 	... := client.ListConflictResolutions(...)
+func (m *MockClient) ListConflictResolutions(
+	ctx context.Context,
+	_ListRequest *admin.ListConflictResolutionsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListConflictResolutionsResponse, err error) {
+
+	args := []interface{}{ctx, _ListRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListConflictResolutions", args...)
+	success, _ = ret[i].(*admin.ListConflictResolutionsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListConflictResolutions(
+	ctx interface{},
+	_ListRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _ListRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListConflictResolutions", args...)
+}
+
+// PauseTaskList responds to a PauseTaskList call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().PauseTaskList(gomock.Any(), ...).Return(...)
+// 	... := client.PauseTaskList(...)
+func (m *MockClient) PauseTaskList(
+	ctx context.Context,
+	_PauseRequest *shared.PauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _PauseRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PauseTaskList", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PauseTaskList(
+	ctx interface{},
+	_PauseRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _PauseRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PauseTaskList", args...)
+}
+
+// ResumeTaskList responds to a ResumeTaskList call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ResumeTaskList(gomock.Any(), ...).Return(...)
+// 	... := client.ResumeTaskList(...)
+func (m *MockClient) ResumeTaskList(
+	ctx context.Context,
+	_ResumeRequest *shared.ResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _ResumeRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResumeTaskList", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResumeTaskList(
+	ctx interface{},
+	_ResumeRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _ResumeRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResumeTaskList", args...)
+}
+
+// UpdateCluster responds to a UpdateCluster call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().UpdateCluster(gomock.Any(), ...).Return(...)
+// 	... := client.UpdateCluster(...)
+func (m *MockClient) UpdateCluster(
+	ctx context.Context,
+	_UpdateRequest *admin.UpdateClusterRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _UpdateRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateCluster", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateCluster(
+	ctx interface{},
+	_UpdateRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _UpdateRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateCluster", args...)
+}
+
+// UpdateTaskListDispatchRate responds to a UpdateTaskListDispatchRate call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().UpdateTaskListDispatchRate(gomock.Any(), ...).Return(...)
+// 	... := client.UpdateTaskListDispatchRate(...)
+func (m *MockClient) UpdateTaskListDispatchRate(
+	ctx context.Context,
+	_UpdateRequest *shared.UpdateTaskListDispatchRateRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _UpdateRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateTaskListDispatchRate", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateTaskListDispatchRate(
+	ctx interface{},
+	_UpdateRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _UpdateRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateTaskListDispatchRate", args...)
+}
@@ -24,6 +24,7 @@
 package admin
 
 import (
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"go.uber.org/thriftrw/thriftreflect"
 )
@@ -33,11 +34,12 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "admin",
 	Package:  "github.com/uber/cadence/.gen/go/admin",
 	FilePath: "admin.thrift",
-	SHA1:     "5136f687aeefc01dd9b57994864926bb8f277b9f",
+	SHA1:     "4f067c626c8e9baab4c15ee807d58490054218b7",
 	Includes: []*thriftreflect.ThriftModule{
+		replicator.ThriftModule,
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.admin\n\ninclude \"shared.thrift\"\n\n/**\n* AdminService provides advanced APIs for debugging and analysis with admin privillege\n**/\nservice AdminService {\n  /**\n  * DescribeWorkflowExecution returns information about the internal states of workflow execution.\n  **/\n  DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: DescribeWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.AccessDeniedError       accessDeniedError,\n    )\n\n  /**\n  * DescribeHistoryHost returns information about the internal states of a history host\n  **/\n  shared.DescribeHistoryHostResponse DescribeHistoryHost(1: shared.DescribeHistoryHostRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  /**\n  * Returns the raw history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow\n  * execution in unknown to the service.\n  **/\n  GetWorkflowExecutionRawHistoryResponse GetWorkflowExecutionRawHistory(1: GetWorkflowExecutionRawHistoryRequest getRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct DescribeWorkflowExecutionResponse{\n  10: optional string shardId\n  20: optional string historyAddr\n  40: optional string mutableStateInCache\n  50: optional string mutableStateInDatabase\n}\n\nstruct GetWorkflowExecutionRawHistoryRequest {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n  30: optional i64 (js.type = \"Long\") firstEventId\n  40: optional i64 (js.type = \"Long\") nextEventId\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct GetWorkflowExecutionRawHistoryResponse {\n  10: optional binary nextPageToken\n  20: optional list<shared.DataBlob> historyBatches\n  30: optional map<string, shared.ReplicationInfo> replicationInfo\n  40: optional i32 eventStoreVersion\n}"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.admin\n\ninclude \"shared.thrift\"\ninclude \"replicator.thrift\"\n\n/**\n* AdminService provides advanced APIs for debugging and analysis with admin privillege\n**/\nservice AdminService {\n  /**\n  * DescribeWorkflowExecution returns information about the internal states of workflow execution.\n  **/\n  DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: DescribeWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.AccessDeniedError       accessDeniedError,\n    )\n\n  /**\n  * DescribeHistoryHost returns information about the internal states of a history host\n  **/\n  shared.DescribeHistoryHostResponse DescribeHistoryHost(1: shared.DescribeHistoryHostRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  /**\n  * Returns the raw history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow\n  * execution in unknown to the service.\n  **/\n  GetWorkflowExecutionRawHistoryResponse GetWorkflowExecutionRawHistory(1: GetWorkflowExecutionRawHistoryRequest getRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * GetReplicationMessages returns the replication tasks of the requested shards, starting after the last\n  * message each shard has processed. It is used by remote clusters to pull replication tasks without Kafka.\n  **/\n  replicator.GetReplicationMessagesResponse GetReplicationMessages(1: replicator.GetReplicationMessagesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * DescribeReplicationStatus returns the replication ack levels of the requested shards, compared with\n  * the max replication task ID of each shard, and the number of replication tasks still pending per domain.\n  **/\n  replicator.DescribeReplicationStatusResponse DescribeReplicationStatus(1: replicator.DescribeReplicationStatusRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * AddCluster persists a new remote cluster in the metadata store. The cluster is picked up at runtime by\n  * every host of the current cluster, without changing the static config.\n  **/\n  void AddCluster(1: AddClusterRequest addRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * UpdateCluster updates the address of a cluster, or enables / disables a remote cluster. The change is\n  * persisted in the metadata store and picked up at runtime by every host of the current cluster.\n  **/\n  void UpdateCluster(1: UpdateClusterRequest updateRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * ListClusters returns all clusters known to the current cluster, from both the static config and the\n  * metadata store.\n  **/\n  ListClustersResponse ListClusters(1: ListClustersRequest listRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * ApplyReplicationTask applies a history replication task, e.g. one read back from the replication DLQ,\n  * to the current cluster through the history ReplicateEvents API.\n  **/\n  void ApplyReplicationTask(1: ApplyReplicationTaskRequest applyRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.RetryTaskError retryTaskError,\n    )\n\n  /**\n  * ListConflictResolutions returns the audit trail of the conflict resolutions which reset the mutable state\n  * of a workflow, because its history diverged between clusters. Latest resolutions are returned first.\n  **/\n  ListConflictResolutionsResponse ListConflictResolutions(1: ListConflictResolutionsRequest listRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * UpdateTaskListDispatchRate sets the dispatch rate of a task list, which is persisted and takes precedence\n  * over the rate pollers ask for, e.g. to protect a downstream dependency during an incident. Leaving the\n  * rate unset clears it.\n  **/\n  void UpdateTaskListDispatchRate(1: shared.UpdateTaskListDispatchRateRequest updateRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * DescribeTaskListDispatchRate returns the dispatch rate set on a task list with UpdateTaskListDispatchRate.\n  **/\n  shared.DescribeTaskListDispatchRateResponse DescribeTaskListDispatchRate(1: shared.DescribeTaskListDispatchRateRequest describeRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * PauseTaskList stops dispatching the tasks of a task list, e.g. during an outage of a downstream dependency.\n  * The task list keeps accepting and persisting tasks, and its polls return empty until it is resumed. The\n  * pause is persisted, so it survives the task list moving to another host.\n  **/\n  void PauseTaskList(1: shared.PauseTaskListRequest pauseRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * ResumeTaskList resumes dispatching the tasks of a task list paused with PauseTaskList.\n  **/\n  void ResumeTaskList(1: shared.ResumeTaskListRequest resumeRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * AdvanceTime moves the clock of the cluster forward, firing the timers which became due. It is only\n  * available on test clusters which run all services in process with a controlled clock, e.g. the test server.\n  **/\n  AdvanceTimeResponse AdvanceTime(1: AdvanceTimeRequest advanceRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n    )\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct DescribeWorkflowExecutionResponse{\n  10: optional string shardId\n  20: optional string historyAddr\n  40: optional string mutableStateInCache\n  50: optional string mutableStateInDatabase\n}\n\nstruct GetWorkflowExecutionRawHistoryRequest {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n  30: optional i64 (js.type = \"Long\") firstEventId\n  40: optional i64 (js.type = \"Long\") nextEventId\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct GetWorkflowExecutionRawHistoryResponse {\n  10: optional binary nextPageToken\n  20: optional list<shared.DataBlob> historyBatches\n  30: optional map<string, shared.ReplicationInfo> replicationInfo\n  40: optional i32 eventStoreVersion\n}\n\nstruct ClusterMetadata {\n  10: optional string clusterName\n  20: optional i64 initialFailoverVersion\n  30: optional string rpcName\n  40: optional string rpcAddress\n  50: optional bool enabled\n}\n\nstruct AddClusterRequest {\n  10: optional string clusterName\n  20: optional i64 initialFailoverVersion\n  30: optional string rpcName\n  40: optional string rpcAddress\n}\n\nstruct UpdateClusterRequest {\n  10: optional string clusterName\n  20: optional string rpcName\n  30: optional string rpcAddress\n  40: optional bool enabled\n}\n\nstruct ListClustersRequest {\n}\n\nstruct ListClustersResponse {\n  10: optional list<ClusterMetadata> clusters\n}\n\nstruct ApplyReplicationTaskRequest {\n  10: optional string sourceCluster\n  20: optional replicator.ReplicationTask replicationTask\n  30: optional bool forceBufferEvents\n}\n\nstruct ConflictResolutionAudit {\n  10: optional string auditId\n  20: optional shared.WorkflowExecution execution\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") resolvedTimestamp\n  50: optional string reason\n  60: optional i64 (js.type = \"Long\") resetEventId\n  70: optional i64 (js.type = \"Long\") discardedNextEventId\n  80: optional i64 (js.type = \"Long\") discardedStartVersion\n  90: optional i64 (js.type = \"Long\") discardedEndVersion\n  100: optional i64 (js.type = \"Long\") adoptedFirstEventId\n  110: optional i64 (js.type = \"Long\") adoptedNextEventId\n  120: optional i64 (js.type = \"Long\") adoptedStartVersion\n  130: optional i64 (js.type = \"Long\") adoptedEndVersion\n}\n\nstruct ListConflictResolutionsRequest {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n  30: optional i32 maximumPageSize\n  40: optional binary nextPageToken\n}\n\nstruct ListConflictResolutionsResponse {\n  10: optional list<ConflictResolutionAudit> conflictResolutions\n  20: optional binary nextPageToken\n}\n\nstruct AdvanceTimeRequest {\n  10: optional i64 (js.type = \"Long\") durationNanos\n}\n\nstruct AdvanceTimeResponse {\n  10: optional i64 (js.type = \"Long\") timestamp\n}\n"
@@ -27,6 +27,7 @@ import (
 	"bytes"
 	"encoding/base64"
 	"fmt"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"go.uber.org/multierr"
 	"go.uber.org/thriftrw/wire"
@@ -34,12 +35,14 @@ import (
 	"strings"
 )
 
-type DescribeWorkflowExecutionRequest struct {
-	Domain    *string                   `json:"domain,omitempty"`
-	Execution *shared.WorkflowExecution `json:"execution,omitempty"`
+type AddClusterRequest struct {
+	ClusterName            *string `json:"clusterName,omitempty"`
+	InitialFailoverVersion *int64  `json:"initialFailoverVersion,omitempty"`
+	RpcName                *string `json:"rpcName,omitempty"`
+	RpcAddress             *string `json:"rpcAddress,omitempty"`
 }
 
-// ToWire translates a DescribeWorkflowExecutionRequest struct into a Thrift-level intermediate
+// ToWire translates a AddClusterRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -54,45 +57,55 @@ type DescribeWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *AddClusterRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.ClusterName != nil {
+		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
+	if v.InitialFailoverVersion != nil {
+		w, err = wire.NewValueI64(*(v.InitialFailoverVersion)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.RpcName != nil {
+		w, err = wire.NewValueString(*(v.RpcName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.RpcAddress != nil {
+		w, err = wire.NewValueString(*(v.RpcAddress)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _WorkflowExecution_Read(w wire.Value) (*shared.WorkflowExecution, error) {
-	var v shared.WorkflowExecution
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a DescribeWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a AddClusterRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a AddClusterRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -100,12 +113,12 @@ func _WorkflowExecution_Read(w wire.Value) (*shared.WorkflowExecution, error) {
 //     return nil, err
 //   }
 //
-//   var v DescribeWorkflowExecutionRequest
+//   var v AddClusterRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *AddClusterRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -114,15 +127,37 @@ func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+				v.ClusterName = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Execution, err = _WorkflowExecution_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InitialFailoverVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RpcName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RpcAddress = &x
 				if err != nil {
 					return err
 				}
@@ -134,51 +169,55 @@ func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DescribeWorkflowExecutionRequest
+// String returns a readable string representation of a AddClusterRequest
 // struct.
-func (v *DescribeWorkflowExecutionRequest) String() string {
+func (v *AddClusterRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [4]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+	if v.ClusterName != nil {
+		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
 		i++
 	}
-	if v.Execution != nil {
-		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+	if v.InitialFailoverVersion != nil {
+		fields[i] = fmt.Sprintf("InitialFailoverVersion: %v", *(v.InitialFailoverVersion))
 		i++
 	}
-
-	return fmt.Sprintf("DescribeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _String_EqualsPtr(lhs, rhs *string) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
+	if v.RpcName != nil {
+		fields[i] = fmt.Sprintf("RpcName: %v", *(v.RpcName))
+		i++
 	}
-	return lhs == nil && rhs == nil
+	if v.RpcAddress != nil {
+		fields[i] = fmt.Sprintf("RpcAddress: %v", *(v.RpcAddress))
+		i++
+	}
+
+	return fmt.Sprintf("AddClusterRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DescribeWorkflowExecutionRequest match the
-// provided DescribeWorkflowExecutionRequest.
+// Equals returns true if all the fields of this AddClusterRequest match the
+// provided AddClusterRequest.
 //
 // This function performs a deep comparison.
-func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecutionRequest) bool {
+func (v *AddClusterRequest) Equals(rhs *AddClusterRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
 		return false
 	}
-	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+	if !_I64_EqualsPtr(v.InitialFailoverVersion, rhs.InitialFailoverVersion) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RpcName, rhs.RpcName) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RpcAddress, rhs.RpcAddress) {
 		return false
 	}
 
@@ -186,48 +225,71 @@ func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecution
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DescribeWorkflowExecutionRequest.
-func (v *DescribeWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AddClusterRequest.
+func (v *AddClusterRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Domain != nil {
-		enc.AddString("domain", *v.Domain)
+	if v.ClusterName != nil {
+		enc.AddString("clusterName", *v.ClusterName)
 	}
-	if v.Execution != nil {
-		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	if v.InitialFailoverVersion != nil {
+		enc.AddInt64("initialFailoverVersion", *v.InitialFailoverVersion)
+	}
+	if v.RpcName != nil {
+		enc.AddString("rpcName", *v.RpcName)
+	}
+	if v.RpcAddress != nil {
+		enc.AddString("rpcAddress", *v.RpcAddress)
 	}
 	return err
 }
 
-// GetDomain returns the value of Domain if it is set or its
+// GetClusterName returns the value of ClusterName if it is set or its
 // zero value if it is unset.
-func (v *DescribeWorkflowExecutionRequest) GetDomain() (o string) {
-	if v.Domain != nil {
-		return *v.Domain
+func (v *AddClusterRequest) GetClusterName() (o string) {
+	if v.ClusterName != nil {
+		return *v.ClusterName
 	}
 
 	return
 }
 
-// GetExecution returns the value of Execution if it is set or its
+// GetInitialFailoverVersion returns the value of InitialFailoverVersion if it is set or its
 // zero value if it is unset.
-func (v *DescribeWorkflowExecutionRequest) GetExecution() (o *shared.WorkflowExecution) {
-	if v.Execution != nil {
-		return v.Execution
+func (v *AddClusterRequest) GetInitialFailoverVersion() (o int64) {
+	if v.InitialFailoverVersion != nil {
+		return *v.InitialFailoverVersion
 	}
 
 	return
 }
 
-type DescribeWorkflowExecutionResponse struct {
-	ShardId                *string `json:"shardId,omitempty"`
-	HistoryAddr            *string `json:"historyAddr,omitempty"`
-	MutableStateInCache    *string `json:"mutableStateInCache,omitempty"`
-	MutableStateInDatabase *string `json:"mutableStateInDatabase,omitempty"`
+// GetRpcName returns the value of RpcName if it is set or its
+// zero value if it is unset.
+func (v *AddClusterRequest) GetRpcName() (o string) {
+	if v.RpcName != nil {
+		return *v.RpcName
+	}
+
+	return
 }
 
-// ToWire translates a DescribeWorkflowExecutionResponse struct into a Thrift-level intermediate
+// GetRpcAddress returns the value of RpcAddress if it is set or its
+// zero value if it is unset.
+func (v *AddClusterRequest) GetRpcAddress() (o string) {
+	if v.RpcAddress != nil {
+		return *v.RpcAddress
+	}
+
+	return
+}
+
+type AdvanceTimeRequest struct {
+	DurationNanos *int64 `json:"durationNanos,omitempty"`
+}
+
+// ToWire translates a AdvanceTimeRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -242,55 +304,31 @@ type DescribeWorkflowExecutionResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeWorkflowExecutionResponse) ToWire() (wire.Value, error) {
+func (v *AdvanceTimeRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ShardId != nil {
-		w, err = wire.NewValueString(*(v.ShardId)), error(nil)
+	if v.DurationNanos != nil {
+		w, err = wire.NewValueI64(*(v.DurationNanos)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.HistoryAddr != nil {
-		w, err = wire.NewValueString(*(v.HistoryAddr)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.MutableStateInCache != nil {
-		w, err = wire.NewValueString(*(v.MutableStateInCache)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.MutableStateInDatabase != nil {
-		w, err = wire.NewValueString(*(v.MutableStateInDatabase)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a DescribeWorkflowExecutionResponse struct from its Thrift-level
+// FromWire deserializes a AdvanceTimeRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeWorkflowExecutionResponse struct
+// An error is returned if we were unable to build a AdvanceTimeRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -298,51 +336,21 @@ func (v *DescribeWorkflowExecutionResponse) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v DescribeWorkflowExecutionResponse
+//   var v AdvanceTimeRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeWorkflowExecutionResponse) FromWire(w wire.Value) error {
+func (v *AdvanceTimeRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ShardId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.HistoryAddr = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.MutableStateInCache = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.MutableStateInDatabase = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DurationNanos = &x
 				if err != nil {
 					return err
 				}
@@ -354,55 +362,34 @@ func (v *DescribeWorkflowExecutionResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DescribeWorkflowExecutionResponse
+// String returns a readable string representation of a AdvanceTimeRequest
 // struct.
-func (v *DescribeWorkflowExecutionResponse) String() string {
+func (v *AdvanceTimeRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [1]string
 	i := 0
-	if v.ShardId != nil {
-		fields[i] = fmt.Sprintf("ShardId: %v", *(v.ShardId))
-		i++
-	}
-	if v.HistoryAddr != nil {
-		fields[i] = fmt.Sprintf("HistoryAddr: %v", *(v.HistoryAddr))
-		i++
-	}
-	if v.MutableStateInCache != nil {
-		fields[i] = fmt.Sprintf("MutableStateInCache: %v", *(v.MutableStateInCache))
-		i++
-	}
-	if v.MutableStateInDatabase != nil {
-		fields[i] = fmt.Sprintf("MutableStateInDatabase: %v", *(v.MutableStateInDatabase))
+	if v.DurationNanos != nil {
+		fields[i] = fmt.Sprintf("DurationNanos: %v", *(v.DurationNanos))
 		i++
 	}
 
-	return fmt.Sprintf("DescribeWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdvanceTimeRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DescribeWorkflowExecutionResponse match the
-// provided DescribeWorkflowExecutionResponse.
+// Equals returns true if all the fields of this AdvanceTimeRequest match the
+// provided AdvanceTimeRequest.
 //
 // This function performs a deep comparison.
-func (v *DescribeWorkflowExecutionResponse) Equals(rhs *DescribeWorkflowExecutionResponse) bool {
+func (v *AdvanceTimeRequest) Equals(rhs *AdvanceTimeRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.ShardId, rhs.ShardId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.HistoryAddr, rhs.HistoryAddr) {
-		return false
-	}
-	if !_String_EqualsPtr(v.MutableStateInCache, rhs.MutableStateInCache) {
-		return false
-	}
-	if !_String_EqualsPtr(v.MutableStateInDatabase, rhs.MutableStateInDatabase) {
+	if !_I64_EqualsPtr(v.DurationNanos, rhs.DurationNanos) {
 		return false
 	}
 
@@ -410,76 +397,2788 @@ func (v *DescribeWorkflowExecutionResponse) Equals(rhs *DescribeWorkflowExecutio
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DescribeWorkflowExecutionResponse.
-func (v *DescribeWorkflowExecutionResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdvanceTimeRequest.
+func (v *AdvanceTimeRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.ShardId != nil {
-		enc.AddString("shardId", *v.ShardId)
-	}
-	if v.HistoryAddr != nil {
-		enc.AddString("historyAddr", *v.HistoryAddr)
-	}
-	if v.MutableStateInCache != nil {
-		enc.AddString("mutableStateInCache", *v.MutableStateInCache)
-	}
-	if v.MutableStateInDatabase != nil {
-		enc.AddString("mutableStateInDatabase", *v.MutableStateInDatabase)
+	if v.DurationNanos != nil {
+		enc.AddInt64("durationNanos", *v.DurationNanos)
 	}
 	return err
 }
 
-// GetShardId returns the value of ShardId if it is set or its
+// GetDurationNanos returns the value of DurationNanos if it is set or its
 // zero value if it is unset.
-func (v *DescribeWorkflowExecutionResponse) GetShardId() (o string) {
-	if v.ShardId != nil {
-		return *v.ShardId
+func (v *AdvanceTimeRequest) GetDurationNanos() (o int64) {
+	if v.DurationNanos != nil {
+		return *v.DurationNanos
 	}
 
 	return
 }
 
-// GetHistoryAddr returns the value of HistoryAddr if it is set or its
-// zero value if it is unset.
-func (v *DescribeWorkflowExecutionResponse) GetHistoryAddr() (o string) {
-	if v.HistoryAddr != nil {
-		return *v.HistoryAddr
-	}
-
-	return
+type AdvanceTimeResponse struct {
+	Timestamp *int64 `json:"timestamp,omitempty"`
 }
 
-// GetMutableStateInCache returns the value of MutableStateInCache if it is set or its
-// zero value if it is unset.
-func (v *DescribeWorkflowExecutionResponse) GetMutableStateInCache() (o string) {
-	if v.MutableStateInCache != nil {
-		return *v.MutableStateInCache
-	}
-
-	return
+// ToWire translates a AdvanceTimeResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdvanceTimeResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Timestamp != nil {
+		w, err = wire.NewValueI64(*(v.Timestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a AdvanceTimeResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdvanceTimeResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdvanceTimeResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdvanceTimeResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Timestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdvanceTimeResponse
+// struct.
+func (v *AdvanceTimeResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Timestamp != nil {
+		fields[i] = fmt.Sprintf("Timestamp: %v", *(v.Timestamp))
+		i++
+	}
+
+	return fmt.Sprintf("AdvanceTimeResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdvanceTimeResponse match the
+// provided AdvanceTimeResponse.
+//
+// This function performs a deep comparison.
+func (v *AdvanceTimeResponse) Equals(rhs *AdvanceTimeResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Timestamp, rhs.Timestamp) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdvanceTimeResponse.
+func (v *AdvanceTimeResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Timestamp != nil {
+		enc.AddInt64("timestamp", *v.Timestamp)
+	}
+	return err
+}
+
+// GetTimestamp returns the value of Timestamp if it is set or its
+// zero value if it is unset.
+func (v *AdvanceTimeResponse) GetTimestamp() (o int64) {
+	if v.Timestamp != nil {
+		return *v.Timestamp
+	}
+
+	return
+}
+
+type ApplyReplicationTaskRequest struct {
+	SourceCluster     *string                     `json:"sourceCluster,omitempty"`
+	ReplicationTask   *replicator.ReplicationTask `json:"replicationTask,omitempty"`
+	ForceBufferEvents *bool                       `json:"forceBufferEvents,omitempty"`
+}
+
+// ToWire translates a ApplyReplicationTaskRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ApplyReplicationTaskRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ReplicationTask != nil {
+		w, err = v.ReplicationTask.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ForceBufferEvents != nil {
+		w, err = wire.NewValueBool(*(v.ForceBufferEvents)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationTask_Read(w wire.Value) (*replicator.ReplicationTask, error) {
+	var v replicator.ReplicationTask
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a ApplyReplicationTaskRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ApplyReplicationTaskRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ApplyReplicationTaskRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ApplyReplicationTaskRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.ReplicationTask, err = _ReplicationTask_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ForceBufferEvents = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ApplyReplicationTaskRequest
+// struct.
+func (v *ApplyReplicationTaskRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
+		i++
+	}
+	if v.ReplicationTask != nil {
+		fields[i] = fmt.Sprintf("ReplicationTask: %v", v.ReplicationTask)
+		i++
+	}
+	if v.ForceBufferEvents != nil {
+		fields[i] = fmt.Sprintf("ForceBufferEvents: %v", *(v.ForceBufferEvents))
+		i++
+	}
+
+	return fmt.Sprintf("ApplyReplicationTaskRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ApplyReplicationTaskRequest match the
+// provided ApplyReplicationTaskRequest.
+//
+// This function performs a deep comparison.
+func (v *ApplyReplicationTaskRequest) Equals(rhs *ApplyReplicationTaskRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
+		return false
+	}
+	if !((v.ReplicationTask == nil && rhs.ReplicationTask == nil) || (v.ReplicationTask != nil && rhs.ReplicationTask != nil && v.ReplicationTask.Equals(rhs.ReplicationTask))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ForceBufferEvents, rhs.ForceBufferEvents) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ApplyReplicationTaskRequest.
+func (v *ApplyReplicationTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
+	}
+	if v.ReplicationTask != nil {
+		err = multierr.Append(err, enc.AddObject("replicationTask", v.ReplicationTask))
+	}
+	if v.ForceBufferEvents != nil {
+		enc.AddBool("forceBufferEvents", *v.ForceBufferEvents)
+	}
+	return err
+}
+
+// GetSourceCluster returns the value of SourceCluster if it is set or its
+// zero value if it is unset.
+func (v *ApplyReplicationTaskRequest) GetSourceCluster() (o string) {
+	if v.SourceCluster != nil {
+		return *v.SourceCluster
+	}
+
+	return
+}
+
+// GetReplicationTask returns the value of ReplicationTask if it is set or its
+// zero value if it is unset.
+func (v *ApplyReplicationTaskRequest) GetReplicationTask() (o *replicator.ReplicationTask) {
+	if v.ReplicationTask != nil {
+		return v.ReplicationTask
+	}
+
+	return
+}
+
+// GetForceBufferEvents returns the value of ForceBufferEvents if it is set or its
+// zero value if it is unset.
+func (v *ApplyReplicationTaskRequest) GetForceBufferEvents() (o bool) {
+	if v.ForceBufferEvents != nil {
+		return *v.ForceBufferEvents
+	}
+
+	return
+}
+
+type ClusterMetadata struct {
+	ClusterName            *string `json:"clusterName,omitempty"`
+	InitialFailoverVersion *int64  `json:"initialFailoverVersion,omitempty"`
+	RpcName                *string `json:"rpcName,omitempty"`
+	RpcAddress             *string `json:"rpcAddress,omitempty"`
+	Enabled                *bool   `json:"enabled,omitempty"`
+}
+
+// ToWire translates a ClusterMetadata struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ClusterMetadata) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ClusterName != nil {
+		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.InitialFailoverVersion != nil {
+		w, err = wire.NewValueI64(*(v.InitialFailoverVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.RpcName != nil {
+		w, err = wire.NewValueString(*(v.RpcName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.RpcAddress != nil {
+		w, err = wire.NewValueString(*(v.RpcAddress)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Enabled != nil {
+		w, err = wire.NewValueBool(*(v.Enabled)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ClusterMetadata struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ClusterMetadata struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ClusterMetadata
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ClusterMetadata) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ClusterName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InitialFailoverVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RpcName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RpcAddress = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Enabled = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ClusterMetadata
+// struct.
+func (v *ClusterMetadata) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.ClusterName != nil {
+		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
+		i++
+	}
+	if v.InitialFailoverVersion != nil {
+		fields[i] = fmt.Sprintf("InitialFailoverVersion: %v", *(v.InitialFailoverVersion))
+		i++
+	}
+	if v.RpcName != nil {
+		fields[i] = fmt.Sprintf("RpcName: %v", *(v.RpcName))
+		i++
+	}
+	if v.RpcAddress != nil {
+		fields[i] = fmt.Sprintf("RpcAddress: %v", *(v.RpcAddress))
+		i++
+	}
+	if v.Enabled != nil {
+		fields[i] = fmt.Sprintf("Enabled: %v", *(v.Enabled))
+		i++
+	}
+
+	return fmt.Sprintf("ClusterMetadata{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this ClusterMetadata match the
+// provided ClusterMetadata.
+//
+// This function performs a deep comparison.
+func (v *ClusterMetadata) Equals(rhs *ClusterMetadata) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.InitialFailoverVersion, rhs.InitialFailoverVersion) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RpcName, rhs.RpcName) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RpcAddress, rhs.RpcAddress) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Enabled, rhs.Enabled) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ClusterMetadata.
+func (v *ClusterMetadata) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ClusterName != nil {
+		enc.AddString("clusterName", *v.ClusterName)
+	}
+	if v.InitialFailoverVersion != nil {
+		enc.AddInt64("initialFailoverVersion", *v.InitialFailoverVersion)
+	}
+	if v.RpcName != nil {
+		enc.AddString("rpcName", *v.RpcName)
+	}
+	if v.RpcAddress != nil {
+		enc.AddString("rpcAddress", *v.RpcAddress)
+	}
+	if v.Enabled != nil {
+		enc.AddBool("enabled", *v.Enabled)
+	}
+	return err
+}
+
+// GetClusterName returns the value of ClusterName if it is set or its
+// zero value if it is unset.
+func (v *ClusterMetadata) GetClusterName() (o string) {
+	if v.ClusterName != nil {
+		return *v.ClusterName
+	}
+
+	return
+}
+
+// GetInitialFailoverVersion returns the value of InitialFailoverVersion if it is set or its
+// zero value if it is unset.
+func (v *ClusterMetadata) GetInitialFailoverVersion() (o int64) {
+	if v.InitialFailoverVersion != nil {
+		return *v.InitialFailoverVersion
+	}
+
+	return
+}
+
+// GetRpcName returns the value of RpcName if it is set or its
+// zero value if it is unset.
+func (v *ClusterMetadata) GetRpcName() (o string) {
+	if v.RpcName != nil {
+		return *v.RpcName
+	}
+
+	return
+}
+
+// GetRpcAddress returns the value of RpcAddress if it is set or its
+// zero value if it is unset.
+func (v *ClusterMetadata) GetRpcAddress() (o string) {
+	if v.RpcAddress != nil {
+		return *v.RpcAddress
+	}
+
+	return
+}
+
+// GetEnabled returns the value of Enabled if it is set or its
+// zero value if it is unset.
+func (v *ClusterMetadata) GetEnabled() (o bool) {
+	if v.Enabled != nil {
+		return *v.Enabled
+	}
+
+	return
+}
+
+type ConflictResolutionAudit struct {
+	AuditId               *string                   `json:"auditId,omitempty"`
+	Execution             *shared.WorkflowExecution `json:"execution,omitempty"`
+	SourceCluster         *string                   `json:"sourceCluster,omitempty"`
+	ResolvedTimestamp     *int64                    `json:"resolvedTimestamp,omitempty"`
+	Reason                *string                   `json:"reason,omitempty"`
+	ResetEventId          *int64                    `json:"resetEventId,omitempty"`
+	DiscardedNextEventId  *int64                    `json:"discardedNextEventId,omitempty"`
+	DiscardedStartVersion *int64                    `json:"discardedStartVersion,omitempty"`
+	DiscardedEndVersion   *int64                    `json:"discardedEndVersion,omitempty"`
+	AdoptedFirstEventId   *int64                    `json:"adoptedFirstEventId,omitempty"`
+	AdoptedNextEventId    *int64                    `json:"adoptedNextEventId,omitempty"`
+	AdoptedStartVersion   *int64                    `json:"adoptedStartVersion,omitempty"`
+	AdoptedEndVersion     *int64                    `json:"adoptedEndVersion,omitempty"`
+}
+
+// ToWire translates a ConflictResolutionAudit struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ConflictResolutionAudit) ToWire() (wire.Value, error) {
+	var (
+		fields [13]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.AuditId != nil {
+		w, err = wire.NewValueString(*(v.AuditId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ResolvedTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ResolvedTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.ResetEventId != nil {
+		w, err = wire.NewValueI64(*(v.ResetEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.DiscardedNextEventId != nil {
+		w, err = wire.NewValueI64(*(v.DiscardedNextEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.DiscardedStartVersion != nil {
+		w, err = wire.NewValueI64(*(v.DiscardedStartVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.DiscardedEndVersion != nil {
+		w, err = wire.NewValueI64(*(v.DiscardedEndVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.AdoptedFirstEventId != nil {
+		w, err = wire.NewValueI64(*(v.AdoptedFirstEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.AdoptedNextEventId != nil {
+		w, err = wire.NewValueI64(*(v.AdoptedNextEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.AdoptedStartVersion != nil {
+		w, err = wire.NewValueI64(*(v.AdoptedStartVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.AdoptedEndVersion != nil {
+		w, err = wire.NewValueI64(*(v.AdoptedEndVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ConflictResolutionAudit struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ConflictResolutionAudit struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ConflictResolutionAudit
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ConflictResolutionAudit) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.AuditId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ResolvedTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ResetEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DiscardedNextEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DiscardedStartVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DiscardedEndVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.AdoptedFirstEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.AdoptedNextEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.AdoptedStartVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.AdoptedEndVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ConflictResolutionAudit
+// struct.
+func (v *ConflictResolutionAudit) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [13]string
+	i := 0
+	if v.AuditId != nil {
+		fields[i] = fmt.Sprintf("AuditId: %v", *(v.AuditId))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
+		i++
+	}
+	if v.ResolvedTimestamp != nil {
+		fields[i] = fmt.Sprintf("ResolvedTimestamp: %v", *(v.ResolvedTimestamp))
+		i++
+	}
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.ResetEventId != nil {
+		fields[i] = fmt.Sprintf("ResetEventId: %v", *(v.ResetEventId))
+		i++
+	}
+	if v.DiscardedNextEventId != nil {
+		fields[i] = fmt.Sprintf("DiscardedNextEventId: %v", *(v.DiscardedNextEventId))
+		i++
+	}
+	if v.DiscardedStartVersion != nil {
+		fields[i] = fmt.Sprintf("DiscardedStartVersion: %v", *(v.DiscardedStartVersion))
+		i++
+	}
+	if v.DiscardedEndVersion != nil {
+		fields[i] = fmt.Sprintf("DiscardedEndVersion: %v", *(v.DiscardedEndVersion))
+		i++
+	}
+	if v.AdoptedFirstEventId != nil {
+		fields[i] = fmt.Sprintf("AdoptedFirstEventId: %v", *(v.AdoptedFirstEventId))
+		i++
+	}
+	if v.AdoptedNextEventId != nil {
+		fields[i] = fmt.Sprintf("AdoptedNextEventId: %v", *(v.AdoptedNextEventId))
+		i++
+	}
+	if v.AdoptedStartVersion != nil {
+		fields[i] = fmt.Sprintf("AdoptedStartVersion: %v", *(v.AdoptedStartVersion))
+		i++
+	}
+	if v.AdoptedEndVersion != nil {
+		fields[i] = fmt.Sprintf("AdoptedEndVersion: %v", *(v.AdoptedEndVersion))
+		i++
+	}
+
+	return fmt.Sprintf("ConflictResolutionAudit{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ConflictResolutionAudit match the
+// provided ConflictResolutionAudit.
+//
+// This function performs a deep comparison.
+func (v *ConflictResolutionAudit) Equals(rhs *ConflictResolutionAudit) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.AuditId, rhs.AuditId) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ResolvedTimestamp, rhs.ResolvedTimestamp) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ResetEventId, rhs.ResetEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DiscardedNextEventId, rhs.DiscardedNextEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DiscardedStartVersion, rhs.DiscardedStartVersion) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DiscardedEndVersion, rhs.DiscardedEndVersion) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.AdoptedFirstEventId, rhs.AdoptedFirstEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.AdoptedNextEventId, rhs.AdoptedNextEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.AdoptedStartVersion, rhs.AdoptedStartVersion) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.AdoptedEndVersion, rhs.AdoptedEndVersion) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ConflictResolutionAudit.
+func (v *ConflictResolutionAudit) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.AuditId != nil {
+		enc.AddString("auditId", *v.AuditId)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
+	}
+	if v.ResolvedTimestamp != nil {
+		enc.AddInt64("resolvedTimestamp", *v.ResolvedTimestamp)
+	}
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	if v.ResetEventId != nil {
+		enc.AddInt64("resetEventId", *v.ResetEventId)
+	}
+	if v.DiscardedNextEventId != nil {
+		enc.AddInt64("discardedNextEventId", *v.DiscardedNextEventId)
+	}
+	if v.DiscardedStartVersion != nil {
+		enc.AddInt64("discardedStartVersion", *v.DiscardedStartVersion)
+	}
+	if v.DiscardedEndVersion != nil {
+		enc.AddInt64("discardedEndVersion", *v.DiscardedEndVersion)
+	}
+	if v.AdoptedFirstEventId != nil {
+		enc.AddInt64("adoptedFirstEventId", *v.AdoptedFirstEventId)
+	}
+	if v.AdoptedNextEventId != nil {
+		enc.AddInt64("adoptedNextEventId", *v.AdoptedNextEventId)
+	}
+	if v.AdoptedStartVersion != nil {
+		enc.AddInt64("adoptedStartVersion", *v.AdoptedStartVersion)
+	}
+	if v.AdoptedEndVersion != nil {
+		enc.AddInt64("adoptedEndVersion", *v.AdoptedEndVersion)
+	}
+	return err
+}
+
+// GetAuditId returns the value of AuditId if it is set or its
+// zero value if it is unset.
+func (v *ConflictResolutionAudit) GetAuditId() (o string) {
+	if v.AuditId != nil {
+		return *v.AuditId
+	}
+
+	return
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *ConflictResolutionAudit) GetExecution() (o *shared.WorkflowExecution) {
+	if v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// GetSourceCluster returns the value of SourceCluster if it is set or its
+// zero value if it is unset.
+func (v *ConflictResolutionAudit) GetSourceCluster() (o string) {
+	if v.SourceCluster != nil {
+		return *v.SourceCluster
+	}
+
+	return
+}
+
+// GetResolvedTimestamp returns the value of ResolvedTimestamp if it is set or its
+// zero value if it is unset.
+func (v *ConflictResolutionAudit) GetResolvedTimestamp() (o int64) {
+	if v.ResolvedTimestamp != nil {
+		return *v.ResolvedTimestamp
+	}
+
+	return
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *ConflictResolutionAudit) GetReason() (o string) {
+	if v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// GetResetEventId returns the value of ResetEventId if it is set or its
+// zero value if it is unset.
+func (v *ConflictResolutionAudit) GetResetEventId() (o int64) {
+	if v.ResetEventId != nil {
+		return *v.ResetEventId
+	}
+
+	return
+}
+
+// GetDiscardedNextEventId returns the value of DiscardedNextEventId if it is set or its
+// zero value if it is unset.
+func (v *ConflictResolutionAudit) GetDiscardedNextEventId() (o int64) {
+	if v.DiscardedNextEventId != nil {
+		return *v.DiscardedNextEventId
+	}
+
+	return
+}
+
+// GetDiscardedStartVersion returns the value of DiscardedStartVersion if it is set or its
+// zero value if it is unset.
+func (v *ConflictResolutionAudit) GetDiscardedStartVersion() (o int64) {
+	if v.DiscardedStartVersion != nil {
+		return *v.DiscardedStartVersion
+	}
+
+	return
+}
+
+// GetDiscardedEndVersion returns the value of DiscardedEndVersion if it is set or its
+// zero value if it is unset.
+func (v *ConflictResolutionAudit) GetDiscardedEndVersion() (o int64) {
+	if v.DiscardedEndVersion != nil {
+		return *v.DiscardedEndVersion
+	}
+
+	return
+}
+
+// GetAdoptedFirstEventId returns the value of AdoptedFirstEventId if it is set or its
+// zero value if it is unset.
+func (v *ConflictResolutionAudit) GetAdoptedFirstEventId() (o int64) {
+	if v.AdoptedFirstEventId != nil {
+		return *v.AdoptedFirstEventId
+	}
+
+	return
+}
+
+// GetAdoptedNextEventId returns the value of AdoptedNextEventId if it is set or its
+// zero value if it is unset.
+func (v *ConflictResolutionAudit) GetAdoptedNextEventId() (o int64) {
+	if v.AdoptedNextEventId != nil {
+		return *v.AdoptedNextEventId
+	}
+
+	return
+}
+
+// GetAdoptedStartVersion returns the value of AdoptedStartVersion if it is set or its
+// zero value if it is unset.
+func (v *ConflictResolutionAudit) GetAdoptedStartVersion() (o int64) {
+	if v.AdoptedStartVersion != nil {
+		return *v.AdoptedStartVersion
+	}
+
+	return
+}
+
+// GetAdoptedEndVersion returns the value of AdoptedEndVersion if it is set or its
+// zero value if it is unset.
+func (v *ConflictResolutionAudit) GetAdoptedEndVersion() (o int64) {
+	if v.AdoptedEndVersion != nil {
+		return *v.AdoptedEndVersion
+	}
+
+	return
+}
+
+type DescribeWorkflowExecutionRequest struct {
+	Domain    *string                   `json:"domain,omitempty"`
+	Execution *shared.WorkflowExecution `json:"execution,omitempty"`
+}
+
+// ToWire translates a DescribeWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DescribeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _WorkflowExecution_Read(w wire.Value) (*shared.WorkflowExecution, error) {
+	var v shared.WorkflowExecution
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a DescribeWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DescribeWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DescribeWorkflowExecutionRequest
+// struct.
+func (v *DescribeWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+
+	return fmt.Sprintf("DescribeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this DescribeWorkflowExecutionRequest match the
+// provided DescribeWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecutionRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DescribeWorkflowExecutionRequest.
+func (v *DescribeWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionRequest) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionRequest) GetExecution() (o *shared.WorkflowExecution) {
+	if v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+type DescribeWorkflowExecutionResponse struct {
+	ShardId                *string `json:"shardId,omitempty"`
+	HistoryAddr            *string `json:"historyAddr,omitempty"`
+	MutableStateInCache    *string `json:"mutableStateInCache,omitempty"`
+	MutableStateInDatabase *string `json:"mutableStateInDatabase,omitempty"`
+}
+
+// ToWire translates a DescribeWorkflowExecutionResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DescribeWorkflowExecutionResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardId != nil {
+		w, err = wire.NewValueString(*(v.ShardId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.HistoryAddr != nil {
+		w, err = wire.NewValueString(*(v.HistoryAddr)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.MutableStateInCache != nil {
+		w, err = wire.NewValueString(*(v.MutableStateInCache)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.MutableStateInDatabase != nil {
+		w, err = wire.NewValueString(*(v.MutableStateInDatabase)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DescribeWorkflowExecutionResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeWorkflowExecutionResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DescribeWorkflowExecutionResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DescribeWorkflowExecutionResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ShardId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.HistoryAddr = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.MutableStateInCache = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.MutableStateInDatabase = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DescribeWorkflowExecutionResponse
+// struct.
+func (v *DescribeWorkflowExecutionResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.ShardId != nil {
+		fields[i] = fmt.Sprintf("ShardId: %v", *(v.ShardId))
+		i++
+	}
+	if v.HistoryAddr != nil {
+		fields[i] = fmt.Sprintf("HistoryAddr: %v", *(v.HistoryAddr))
+		i++
+	}
+	if v.MutableStateInCache != nil {
+		fields[i] = fmt.Sprintf("MutableStateInCache: %v", *(v.MutableStateInCache))
+		i++
+	}
+	if v.MutableStateInDatabase != nil {
+		fields[i] = fmt.Sprintf("MutableStateInDatabase: %v", *(v.MutableStateInDatabase))
+		i++
+	}
+
+	return fmt.Sprintf("DescribeWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DescribeWorkflowExecutionResponse match the
+// provided DescribeWorkflowExecutionResponse.
+//
+// This function performs a deep comparison.
+func (v *DescribeWorkflowExecutionResponse) Equals(rhs *DescribeWorkflowExecutionResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.ShardId, rhs.ShardId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.HistoryAddr, rhs.HistoryAddr) {
+		return false
+	}
+	if !_String_EqualsPtr(v.MutableStateInCache, rhs.MutableStateInCache) {
+		return false
+	}
+	if !_String_EqualsPtr(v.MutableStateInDatabase, rhs.MutableStateInDatabase) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DescribeWorkflowExecutionResponse.
+func (v *DescribeWorkflowExecutionResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ShardId != nil {
+		enc.AddString("shardId", *v.ShardId)
+	}
+	if v.HistoryAddr != nil {
+		enc.AddString("historyAddr", *v.HistoryAddr)
+	}
+	if v.MutableStateInCache != nil {
+		enc.AddString("mutableStateInCache", *v.MutableStateInCache)
+	}
+	if v.MutableStateInDatabase != nil {
+		enc.AddString("mutableStateInDatabase", *v.MutableStateInDatabase)
+	}
+	return err
+}
+
+// GetShardId returns the value of ShardId if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionResponse) GetShardId() (o string) {
+	if v.ShardId != nil {
+		return *v.ShardId
+	}
+
+	return
+}
+
+// GetHistoryAddr returns the value of HistoryAddr if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionResponse) GetHistoryAddr() (o string) {
+	if v.HistoryAddr != nil {
+		return *v.HistoryAddr
+	}
+
+	return
+}
+
+// GetMutableStateInCache returns the value of MutableStateInCache if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionResponse) GetMutableStateInCache() (o string) {
+	if v.MutableStateInCache != nil {
+		return *v.MutableStateInCache
+	}
+
+	return
+}
+
+// GetMutableStateInDatabase returns the value of MutableStateInDatabase if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionResponse) GetMutableStateInDatabase() (o string) {
+	if v.MutableStateInDatabase != nil {
+		return *v.MutableStateInDatabase
+	}
+
+	return
+}
+
+type GetWorkflowExecutionRawHistoryRequest struct {
+	Domain          *string                   `json:"domain,omitempty"`
+	Execution       *shared.WorkflowExecution `json:"execution,omitempty"`
+	FirstEventId    *int64                    `json:"firstEventId,omitempty"`
+	NextEventId     *int64                    `json:"nextEventId,omitempty"`
+	MaximumPageSize *int32                    `json:"maximumPageSize,omitempty"`
+	NextPageToken   []byte                    `json:"nextPageToken,omitempty"`
+}
+
+// ToWire translates a GetWorkflowExecutionRawHistoryRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetWorkflowExecutionRawHistoryRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.FirstEventId != nil {
+		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.NextEventId != nil {
+		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a GetWorkflowExecutionRawHistoryRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetWorkflowExecutionRawHistoryRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetWorkflowExecutionRawHistoryRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetWorkflowExecutionRawHistoryRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FirstEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumPageSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetWorkflowExecutionRawHistoryRequest
+// struct.
+func (v *GetWorkflowExecutionRawHistoryRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+	if v.FirstEventId != nil {
+		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
+		i++
+	}
+	if v.NextEventId != nil {
+		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("GetWorkflowExecutionRawHistoryRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this GetWorkflowExecutionRawHistoryRequest match the
+// provided GetWorkflowExecutionRawHistoryRequest.
+//
+// This function performs a deep comparison.
+func (v *GetWorkflowExecutionRawHistoryRequest) Equals(rhs *GetWorkflowExecutionRawHistoryRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetWorkflowExecutionRawHistoryRequest.
+func (v *GetWorkflowExecutionRawHistoryRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	if v.FirstEventId != nil {
+		enc.AddInt64("firstEventId", *v.FirstEventId)
+	}
+	if v.NextEventId != nil {
+		enc.AddInt64("nextEventId", *v.NextEventId)
+	}
+	if v.MaximumPageSize != nil {
+		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryRequest) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryRequest) GetExecution() (o *shared.WorkflowExecution) {
+	if v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// GetFirstEventId returns the value of FirstEventId if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryRequest) GetFirstEventId() (o int64) {
+	if v.FirstEventId != nil {
+		return *v.FirstEventId
+	}
+
+	return
+}
+
+// GetNextEventId returns the value of NextEventId if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryRequest) GetNextEventId() (o int64) {
+	if v.NextEventId != nil {
+		return *v.NextEventId
+	}
+
+	return
+}
+
+// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryRequest) GetMaximumPageSize() (o int32) {
+	if v.MaximumPageSize != nil {
+		return *v.MaximumPageSize
+	}
+
+	return
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryRequest) GetNextPageToken() (o []byte) {
+	if v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+type GetWorkflowExecutionRawHistoryResponse struct {
+	NextPageToken     []byte                             `json:"nextPageToken,omitempty"`
+	HistoryBatches    []*shared.DataBlob                 `json:"historyBatches,omitempty"`
+	ReplicationInfo   map[string]*shared.ReplicationInfo `json:"replicationInfo,omitempty"`
+	EventStoreVersion *int32                             `json:"eventStoreVersion,omitempty"`
+}
+
+type _List_DataBlob_ValueList []*shared.DataBlob
+
+func (v _List_DataBlob_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_DataBlob_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_DataBlob_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_DataBlob_ValueList) Close() {}
+
+type _Map_String_ReplicationInfo_MapItemList map[string]*shared.ReplicationInfo
+
+func (m _Map_String_ReplicationInfo_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_ReplicationInfo_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_ReplicationInfo_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_ReplicationInfo_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_ReplicationInfo_MapItemList) Close() {}
+
+// ToWire translates a GetWorkflowExecutionRawHistoryResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetWorkflowExecutionRawHistoryResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.HistoryBatches != nil {
+		w, err = wire.NewValueList(_List_DataBlob_ValueList(v.HistoryBatches)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ReplicationInfo != nil {
+		w, err = wire.NewValueMap(_Map_String_ReplicationInfo_MapItemList(v.ReplicationInfo)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DataBlob_Read(w wire.Value) (*shared.DataBlob, error) {
+	var v shared.DataBlob
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_DataBlob_Read(l wire.ValueList) ([]*shared.DataBlob, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*shared.DataBlob, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _DataBlob_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _ReplicationInfo_Read(w wire.Value) (*shared.ReplicationInfo, error) {
+	var v shared.ReplicationInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_ReplicationInfo_Read(m wire.MapItemList) (map[string]*shared.ReplicationInfo, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*shared.ReplicationInfo, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _ReplicationInfo_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a GetWorkflowExecutionRawHistoryResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetWorkflowExecutionRawHistoryResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetWorkflowExecutionRawHistoryResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetWorkflowExecutionRawHistoryResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.HistoryBatches, err = _List_DataBlob_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TMap {
+				v.ReplicationInfo, err = _Map_String_ReplicationInfo_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.EventStoreVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetWorkflowExecutionRawHistoryResponse
+// struct.
+func (v *GetWorkflowExecutionRawHistoryResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+	if v.HistoryBatches != nil {
+		fields[i] = fmt.Sprintf("HistoryBatches: %v", v.HistoryBatches)
+		i++
+	}
+	if v.ReplicationInfo != nil {
+		fields[i] = fmt.Sprintf("ReplicationInfo: %v", v.ReplicationInfo)
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
+		i++
+	}
+
+	return fmt.Sprintf("GetWorkflowExecutionRawHistoryResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_DataBlob_Equals(lhs, rhs []*shared.DataBlob) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_ReplicationInfo_Equals(lhs, rhs map[string]*shared.ReplicationInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this GetWorkflowExecutionRawHistoryResponse match the
+// provided GetWorkflowExecutionRawHistoryResponse.
+//
+// This function performs a deep comparison.
+func (v *GetWorkflowExecutionRawHistoryResponse) Equals(rhs *GetWorkflowExecutionRawHistoryResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+	if !((v.HistoryBatches == nil && rhs.HistoryBatches == nil) || (v.HistoryBatches != nil && rhs.HistoryBatches != nil && _List_DataBlob_Equals(v.HistoryBatches, rhs.HistoryBatches))) {
+		return false
+	}
+	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
+		return false
+	}
+
+	return true
+}
+
+type _List_DataBlob_Zapper []*shared.DataBlob
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_DataBlob_Zapper.
+func (l _List_DataBlob_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _Map_String_ReplicationInfo_Zapper map[string]*shared.ReplicationInfo
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_ReplicationInfo_Zapper.
+func (m _Map_String_ReplicationInfo_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetWorkflowExecutionRawHistoryResponse.
+func (v *GetWorkflowExecutionRawHistoryResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	if v.HistoryBatches != nil {
+		err = multierr.Append(err, enc.AddArray("historyBatches", (_List_DataBlob_Zapper)(v.HistoryBatches)))
+	}
+	if v.ReplicationInfo != nil {
+		err = multierr.Append(err, enc.AddObject("replicationInfo", (_Map_String_ReplicationInfo_Zapper)(v.ReplicationInfo)))
+	}
+	if v.EventStoreVersion != nil {
+		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+	}
+	return err
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryResponse) GetNextPageToken() (o []byte) {
+	if v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// GetHistoryBatches returns the value of HistoryBatches if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryResponse) GetHistoryBatches() (o []*shared.DataBlob) {
+	if v.HistoryBatches != nil {
+		return v.HistoryBatches
+	}
+
+	return
+}
+
+// GetReplicationInfo returns the value of ReplicationInfo if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryResponse) GetReplicationInfo() (o map[string]*shared.ReplicationInfo) {
+	if v.ReplicationInfo != nil {
+		return v.ReplicationInfo
+	}
+
+	return
+}
+
+// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryResponse) GetEventStoreVersion() (o int32) {
+	if v.EventStoreVersion != nil {
+		return *v.EventStoreVersion
+	}
+
+	return
 }
 
-// GetMutableStateInDatabase returns the value of MutableStateInDatabase if it is set or its
+type ListClustersRequest struct {
+}
+
+// ToWire translates a ListClustersRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ListClustersRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ListClustersRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ListClustersRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ListClustersRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ListClustersRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ListClustersRequest
+// struct.
+func (v *ListClustersRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("ListClustersRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ListClustersRequest match the
+// provided ListClustersRequest.
+//
+// This function performs a deep comparison.
+func (v *ListClustersRequest) Equals(rhs *ListClustersRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ListClustersRequest.
+func (v *ListClustersRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+type ListClustersResponse struct {
+	Clusters []*ClusterMetadata `json:"clusters,omitempty"`
+}
+
+type _List_ClusterMetadata_ValueList []*ClusterMetadata
+
+func (v _List_ClusterMetadata_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ClusterMetadata_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ClusterMetadata_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ClusterMetadata_ValueList) Close() {}
+
+// ToWire translates a ListClustersResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ListClustersResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Clusters != nil {
+		w, err = wire.NewValueList(_List_ClusterMetadata_ValueList(v.Clusters)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ClusterMetadata_Read(w wire.Value) (*ClusterMetadata, error) {
+	var v ClusterMetadata
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ClusterMetadata_Read(l wire.ValueList) ([]*ClusterMetadata, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ClusterMetadata, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ClusterMetadata_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ListClustersResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ListClustersResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ListClustersResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ListClustersResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Clusters, err = _List_ClusterMetadata_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ListClustersResponse
+// struct.
+func (v *ListClustersResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Clusters != nil {
+		fields[i] = fmt.Sprintf("Clusters: %v", v.Clusters)
+		i++
+	}
+
+	return fmt.Sprintf("ListClustersResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_ClusterMetadata_Equals(lhs, rhs []*ClusterMetadata) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ListClustersResponse match the
+// provided ListClustersResponse.
+//
+// This function performs a deep comparison.
+func (v *ListClustersResponse) Equals(rhs *ListClustersResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Clusters == nil && rhs.Clusters == nil) || (v.Clusters != nil && rhs.Clusters != nil && _List_ClusterMetadata_Equals(v.Clusters, rhs.Clusters))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_ClusterMetadata_Zapper []*ClusterMetadata
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ClusterMetadata_Zapper.
+func (l _List_ClusterMetadata_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ListClustersResponse.
+func (v *ListClustersResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Clusters != nil {
+		err = multierr.Append(err, enc.AddArray("clusters", (_List_ClusterMetadata_Zapper)(v.Clusters)))
+	}
+	return err
+}
+
+// GetClusters returns the value of Clusters if it is set or its
 // zero value if it is unset.
-func (v *DescribeWorkflowExecutionResponse) GetMutableStateInDatabase() (o string) {
-	if v.MutableStateInDatabase != nil {
-		return *v.MutableStateInDatabase
+func (v *ListClustersResponse) GetClusters() (o []*ClusterMetadata) {
+	if v.Clusters != nil {
+		return v.Clusters
 	}
 
 	return
 }
 
-type GetWorkflowExecutionRawHistoryRequest struct {
+type ListConflictResolutionsRequest struct {
 	Domain          *string                   `json:"domain,omitempty"`
 	Execution       *shared.WorkflowExecution `json:"execution,omitempty"`
-	FirstEventId    *int64                    `json:"firstEventId,omitempty"`
-	NextEventId     *int64                    `json:"nextEventId,omitempty"`
 	MaximumPageSize *int32                    `json:"maximumPageSize,omitempty"`
 	NextPageToken   []byte                    `json:"nextPageToken,omitempty"`
 }
 
-// ToWire translates a GetWorkflowExecutionRawHistoryRequest struct into a Thrift-level intermediate
+// ToWire translates a ListConflictResolutionsRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -494,9 +3193,9 @@ type GetWorkflowExecutionRawHistoryRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GetWorkflowExecutionRawHistoryRequest) ToWire() (wire.Value, error) {
+func (v *ListConflictResolutionsRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -518,28 +3217,12 @@ func (v *GetWorkflowExecutionRawHistoryRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.FirstEventId != nil {
-		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.NextEventId != nil {
-		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
 	if v.MaximumPageSize != nil {
 		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 50, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
 	if v.NextPageToken != nil {
@@ -547,18 +3230,18 @@ func (v *GetWorkflowExecutionRawHistoryRequest) ToWire() (wire.Value, error) {
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 60, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a GetWorkflowExecutionRawHistoryRequest struct from its Thrift-level
+// FromWire deserializes a ListConflictResolutionsRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GetWorkflowExecutionRawHistoryRequest struct
+// An error is returned if we were unable to build a ListConflictResolutionsRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -566,12 +3249,12 @@ func (v *GetWorkflowExecutionRawHistoryRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v GetWorkflowExecutionRawHistoryRequest
+//   var v ListConflictResolutionsRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *GetWorkflowExecutionRawHistoryRequest) FromWire(w wire.Value) error {
+func (v *ListConflictResolutionsRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -595,26 +3278,6 @@ func (v *GetWorkflowExecutionRawHistoryRequest) FromWire(w wire.Value) error {
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.FirstEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.NextEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
 			if field.Value.Type() == wire.TI32 {
 				var x int32
 				x, err = field.Value.GetI32(), error(nil)
@@ -624,7 +3287,7 @@ func (v *GetWorkflowExecutionRawHistoryRequest) FromWire(w wire.Value) error {
 				}
 
 			}
-		case 60:
+		case 40:
 			if field.Value.Type() == wire.TBinary {
 				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
@@ -638,14 +3301,14 @@ func (v *GetWorkflowExecutionRawHistoryRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a GetWorkflowExecutionRawHistoryRequest
+// String returns a readable string representation of a ListConflictResolutionsRequest
 // struct.
-func (v *GetWorkflowExecutionRawHistoryRequest) String() string {
+func (v *ListConflictResolutionsRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [4]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -655,14 +3318,6 @@ func (v *GetWorkflowExecutionRawHistoryRequest) String() string {
 		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
 		i++
 	}
-	if v.FirstEventId != nil {
-		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
-		i++
-	}
-	if v.NextEventId != nil {
-		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
-		i++
-	}
 	if v.MaximumPageSize != nil {
 		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
 		i++
@@ -672,34 +3327,14 @@ func (v *GetWorkflowExecutionRawHistoryRequest) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("GetWorkflowExecutionRawHistoryRequest{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _I64_EqualsPtr(lhs, rhs *int64) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
-func _I32_EqualsPtr(lhs, rhs *int32) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("ListConflictResolutionsRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this GetWorkflowExecutionRawHistoryRequest match the
-// provided GetWorkflowExecutionRawHistoryRequest.
+// Equals returns true if all the fields of this ListConflictResolutionsRequest match the
+// provided ListConflictResolutionsRequest.
 //
 // This function performs a deep comparison.
-func (v *GetWorkflowExecutionRawHistoryRequest) Equals(rhs *GetWorkflowExecutionRawHistoryRequest) bool {
+func (v *ListConflictResolutionsRequest) Equals(rhs *ListConflictResolutionsRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -711,12 +3346,6 @@ func (v *GetWorkflowExecutionRawHistoryRequest) Equals(rhs *GetWorkflowExecution
 	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
-		return false
-	}
 	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
 		return false
 	}
@@ -728,8 +3357,8 @@ func (v *GetWorkflowExecutionRawHistoryRequest) Equals(rhs *GetWorkflowExecution
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of GetWorkflowExecutionRawHistoryRequest.
-func (v *GetWorkflowExecutionRawHistoryRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ListConflictResolutionsRequest.
+func (v *ListConflictResolutionsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -739,12 +3368,6 @@ func (v *GetWorkflowExecutionRawHistoryRequest) MarshalLogObject(enc zapcore.Obj
 	if v.Execution != nil {
 		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
 	}
-	if v.FirstEventId != nil {
-		enc.AddInt64("firstEventId", *v.FirstEventId)
-	}
-	if v.NextEventId != nil {
-		enc.AddInt64("nextEventId", *v.NextEventId)
-	}
 	if v.MaximumPageSize != nil {
 		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
 	}
@@ -756,7 +3379,7 @@ func (v *GetWorkflowExecutionRawHistoryRequest) MarshalLogObject(enc zapcore.Obj
 
 // GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryRequest) GetDomain() (o string) {
+func (v *ListConflictResolutionsRequest) GetDomain() (o string) {
 	if v.Domain != nil {
 		return *v.Domain
 	}
@@ -766,7 +3389,7 @@ func (v *GetWorkflowExecutionRawHistoryRequest) GetDomain() (o string) {
 
 // GetExecution returns the value of Execution if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryRequest) GetExecution() (o *shared.WorkflowExecution) {
+func (v *ListConflictResolutionsRequest) GetExecution() (o *shared.WorkflowExecution) {
 	if v.Execution != nil {
 		return v.Execution
 	}
@@ -774,29 +3397,9 @@ func (v *GetWorkflowExecutionRawHistoryRequest) GetExecution() (o *shared.Workfl
 	return
 }
 
-// GetFirstEventId returns the value of FirstEventId if it is set or its
-// zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryRequest) GetFirstEventId() (o int64) {
-	if v.FirstEventId != nil {
-		return *v.FirstEventId
-	}
-
-	return
-}
-
-// GetNextEventId returns the value of NextEventId if it is set or its
-// zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryRequest) GetNextEventId() (o int64) {
-	if v.NextEventId != nil {
-		return *v.NextEventId
-	}
-
-	return
-}
-
 // GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryRequest) GetMaximumPageSize() (o int32) {
+func (v *ListConflictResolutionsRequest) GetMaximumPageSize() (o int32) {
 	if v.MaximumPageSize != nil {
 		return *v.MaximumPageSize
 	}
@@ -806,7 +3409,7 @@ func (v *GetWorkflowExecutionRawHistoryRequest) GetMaximumPageSize() (o int32) {
 
 // GetNextPageToken returns the value of NextPageToken if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryRequest) GetNextPageToken() (o []byte) {
+func (v *ListConflictResolutionsRequest) GetNextPageToken() (o []byte) {
 	if v.NextPageToken != nil {
 		return v.NextPageToken
 	}
@@ -814,16 +3417,14 @@ func (v *GetWorkflowExecutionRawHistoryRequest) GetNextPageToken() (o []byte) {
 	return
 }
 
-type GetWorkflowExecutionRawHistoryResponse struct {
-	NextPageToken     []byte                             `json:"nextPageToken,omitempty"`
-	HistoryBatches    []*shared.DataBlob                 `json:"historyBatches,omitempty"`
-	ReplicationInfo   map[string]*shared.ReplicationInfo `json:"replicationInfo,omitempty"`
-	EventStoreVersion *int32                             `json:"eventStoreVersion,omitempty"`
+type ListConflictResolutionsResponse struct {
+	ConflictResolutions []*ConflictResolutionAudit `json:"conflictResolutions,omitempty"`
+	NextPageToken       []byte                     `json:"nextPageToken,omitempty"`
 }
 
-type _List_DataBlob_ValueList []*shared.DataBlob
+type _List_ConflictResolutionAudit_ValueList []*ConflictResolutionAudit
 
-func (v _List_DataBlob_ValueList) ForEach(f func(wire.Value) error) error {
+func (v _List_ConflictResolutionAudit_ValueList) ForEach(f func(wire.Value) error) error {
 	for i, x := range v {
 		if x == nil {
 			return fmt.Errorf("invalid [%v]: value is nil", i)
@@ -840,55 +3441,237 @@ func (v _List_DataBlob_ValueList) ForEach(f func(wire.Value) error) error {
 	return nil
 }
 
-func (v _List_DataBlob_ValueList) Size() int {
+func (v _List_ConflictResolutionAudit_ValueList) Size() int {
 	return len(v)
 }
 
-func (_List_DataBlob_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+func (_List_ConflictResolutionAudit_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ConflictResolutionAudit_ValueList) Close() {}
+
+// ToWire translates a ListConflictResolutionsResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ListConflictResolutionsResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ConflictResolutions != nil {
+		w, err = wire.NewValueList(_List_ConflictResolutionAudit_ValueList(v.ConflictResolutions)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ConflictResolutionAudit_Read(w wire.Value) (*ConflictResolutionAudit, error) {
+	var v ConflictResolutionAudit
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ConflictResolutionAudit_Read(l wire.ValueList) ([]*ConflictResolutionAudit, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ConflictResolutionAudit, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ConflictResolutionAudit_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ListConflictResolutionsResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ListConflictResolutionsResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ListConflictResolutionsResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ListConflictResolutionsResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.ConflictResolutions, err = _List_ConflictResolutionAudit_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ListConflictResolutionsResponse
+// struct.
+func (v *ListConflictResolutionsResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.ConflictResolutions != nil {
+		fields[i] = fmt.Sprintf("ConflictResolutions: %v", v.ConflictResolutions)
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("ListConflictResolutionsResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_ConflictResolutionAudit_Equals(lhs, rhs []*ConflictResolutionAudit) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
 }
 
-func (_List_DataBlob_ValueList) Close() {}
+// Equals returns true if all the fields of this ListConflictResolutionsResponse match the
+// provided ListConflictResolutionsResponse.
+//
+// This function performs a deep comparison.
+func (v *ListConflictResolutionsResponse) Equals(rhs *ListConflictResolutionsResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.ConflictResolutions == nil && rhs.ConflictResolutions == nil) || (v.ConflictResolutions != nil && rhs.ConflictResolutions != nil && _List_ConflictResolutionAudit_Equals(v.ConflictResolutions, rhs.ConflictResolutions))) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
 
-type _Map_String_ReplicationInfo_MapItemList map[string]*shared.ReplicationInfo
+	return true
+}
 
-func (m _Map_String_ReplicationInfo_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		if v == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", k)
-		}
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
+type _List_ConflictResolutionAudit_Zapper []*ConflictResolutionAudit
 
-		vw, err := v.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
-			return err
-		}
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ConflictResolutionAudit_Zapper.
+func (l _List_ConflictResolutionAudit_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
 	}
-	return nil
+	return err
 }
 
-func (m _Map_String_ReplicationInfo_MapItemList) Size() int {
-	return len(m)
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ListConflictResolutionsResponse.
+func (v *ListConflictResolutionsResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ConflictResolutions != nil {
+		err = multierr.Append(err, enc.AddArray("conflictResolutions", (_List_ConflictResolutionAudit_Zapper)(v.ConflictResolutions)))
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	return err
 }
 
-func (_Map_String_ReplicationInfo_MapItemList) KeyType() wire.Type {
-	return wire.TBinary
+// GetConflictResolutions returns the value of ConflictResolutions if it is set or its
+// zero value if it is unset.
+func (v *ListConflictResolutionsResponse) GetConflictResolutions() (o []*ConflictResolutionAudit) {
+	if v.ConflictResolutions != nil {
+		return v.ConflictResolutions
+	}
+
+	return
 }
 
-func (_Map_String_ReplicationInfo_MapItemList) ValueType() wire.Type {
-	return wire.TStruct
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *ListConflictResolutionsResponse) GetNextPageToken() (o []byte) {
+	if v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
 }
 
-func (_Map_String_ReplicationInfo_MapItemList) Close() {}
+type UpdateClusterRequest struct {
+	ClusterName *string `json:"clusterName,omitempty"`
+	RpcName     *string `json:"rpcName,omitempty"`
+	RpcAddress  *string `json:"rpcAddress,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
 
-// ToWire translates a GetWorkflowExecutionRawHistoryResponse struct into a Thrift-level intermediate
+// ToWire translates a UpdateClusterRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -903,7 +3686,7 @@ func (_Map_String_ReplicationInfo_MapItemList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GetWorkflowExecutionRawHistoryResponse) ToWire() (wire.Value, error) {
+func (v *UpdateClusterRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [4]wire.Field
 		i      int = 0
@@ -911,32 +3694,32 @@ func (v *GetWorkflowExecutionRawHistoryResponse) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+	if v.ClusterName != nil {
+		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.HistoryBatches != nil {
-		w, err = wire.NewValueList(_List_DataBlob_ValueList(v.HistoryBatches)), error(nil)
+	if v.RpcName != nil {
+		w, err = wire.NewValueString(*(v.RpcName)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ReplicationInfo != nil {
-		w, err = wire.NewValueMap(_Map_String_ReplicationInfo_MapItemList(v.ReplicationInfo)), error(nil)
+	if v.RpcAddress != nil {
+		w, err = wire.NewValueString(*(v.RpcAddress)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.EventStoreVersion != nil {
-		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
+	if v.Enabled != nil {
+		w, err = wire.NewValueBool(*(v.Enabled)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -947,69 +3730,11 @@ func (v *GetWorkflowExecutionRawHistoryResponse) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DataBlob_Read(w wire.Value) (*shared.DataBlob, error) {
-	var v shared.DataBlob
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _List_DataBlob_Read(l wire.ValueList) ([]*shared.DataBlob, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make([]*shared.DataBlob, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _DataBlob_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-func _ReplicationInfo_Read(w wire.Value) (*shared.ReplicationInfo, error) {
-	var v shared.ReplicationInfo
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _Map_String_ReplicationInfo_Read(m wire.MapItemList) (map[string]*shared.ReplicationInfo, error) {
-	if m.KeyType() != wire.TBinary {
-		return nil, nil
-	}
-
-	if m.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make(map[string]*shared.ReplicationInfo, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
-
-		v, err := _ReplicationInfo_Read(x.Value)
-		if err != nil {
-			return err
-		}
-
-		o[k] = v
-		return nil
-	})
-	m.Close()
-	return o, err
-}
-
-// FromWire deserializes a GetWorkflowExecutionRawHistoryResponse struct from its Thrift-level
+// FromWire deserializes a UpdateClusterRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GetWorkflowExecutionRawHistoryResponse struct
+// An error is returned if we were unable to build a UpdateClusterRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1017,45 +3742,51 @@ func _Map_String_ReplicationInfo_Read(m wire.MapItemList) (map[string]*shared.Re
 //     return nil, err
 //   }
 //
-//   var v GetWorkflowExecutionRawHistoryResponse
+//   var v UpdateClusterRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *GetWorkflowExecutionRawHistoryResponse) FromWire(w wire.Value) error {
+func (v *UpdateClusterRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ClusterName = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TList {
-				v.HistoryBatches, err = _List_DataBlob_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RpcName = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TMap {
-				v.ReplicationInfo, err = _Map_String_ReplicationInfo_Read(field.Value.GetMap())
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RpcAddress = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.EventStoreVersion = &x
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Enabled = &x
 				if err != nil {
 					return err
 				}
@@ -1067,171 +3798,117 @@ func (v *GetWorkflowExecutionRawHistoryResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a GetWorkflowExecutionRawHistoryResponse
+// String returns a readable string representation of a UpdateClusterRequest
 // struct.
-func (v *GetWorkflowExecutionRawHistoryResponse) String() string {
+func (v *UpdateClusterRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [4]string
 	i := 0
-	if v.NextPageToken != nil {
-		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+	if v.ClusterName != nil {
+		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
 		i++
 	}
-	if v.HistoryBatches != nil {
-		fields[i] = fmt.Sprintf("HistoryBatches: %v", v.HistoryBatches)
+	if v.RpcName != nil {
+		fields[i] = fmt.Sprintf("RpcName: %v", *(v.RpcName))
 		i++
 	}
-	if v.ReplicationInfo != nil {
-		fields[i] = fmt.Sprintf("ReplicationInfo: %v", v.ReplicationInfo)
+	if v.RpcAddress != nil {
+		fields[i] = fmt.Sprintf("RpcAddress: %v", *(v.RpcAddress))
 		i++
 	}
-	if v.EventStoreVersion != nil {
-		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
+	if v.Enabled != nil {
+		fields[i] = fmt.Sprintf("Enabled: %v", *(v.Enabled))
 		i++
 	}
 
-	return fmt.Sprintf("GetWorkflowExecutionRawHistoryResponse{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _List_DataBlob_Equals(lhs, rhs []*shared.DataBlob) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-
-	return true
-}
-
-func _Map_String_ReplicationInfo_Equals(lhs, rhs map[string]*shared.ReplicationInfo) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-	return true
+	return fmt.Sprintf("UpdateClusterRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this GetWorkflowExecutionRawHistoryResponse match the
-// provided GetWorkflowExecutionRawHistoryResponse.
+// Equals returns true if all the fields of this UpdateClusterRequest match the
+// provided UpdateClusterRequest.
 //
 // This function performs a deep comparison.
-func (v *GetWorkflowExecutionRawHistoryResponse) Equals(rhs *GetWorkflowExecutionRawHistoryResponse) bool {
+func (v *UpdateClusterRequest) Equals(rhs *UpdateClusterRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
 		return false
 	}
-	if !((v.HistoryBatches == nil && rhs.HistoryBatches == nil) || (v.HistoryBatches != nil && rhs.HistoryBatches != nil && _List_DataBlob_Equals(v.HistoryBatches, rhs.HistoryBatches))) {
+	if !_String_EqualsPtr(v.RpcName, rhs.RpcName) {
 		return false
 	}
-	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
+	if !_String_EqualsPtr(v.RpcAddress, rhs.RpcAddress) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
+	if !_Bool_EqualsPtr(v.Enabled, rhs.Enabled) {
 		return false
 	}
 
 	return true
 }
 
-type _List_DataBlob_Zapper []*shared.DataBlob
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_DataBlob_Zapper.
-func (l _List_DataBlob_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
-	}
-	return err
-}
-
-type _Map_String_ReplicationInfo_Zapper map[string]*shared.ReplicationInfo
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of _Map_String_ReplicationInfo_Zapper.
-func (m _Map_String_ReplicationInfo_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	for k, v := range m {
-		err = multierr.Append(err, enc.AddObject((string)(k), v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of GetWorkflowExecutionRawHistoryResponse.
-func (v *GetWorkflowExecutionRawHistoryResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of UpdateClusterRequest.
+func (v *UpdateClusterRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.NextPageToken != nil {
-		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	if v.ClusterName != nil {
+		enc.AddString("clusterName", *v.ClusterName)
 	}
-	if v.HistoryBatches != nil {
-		err = multierr.Append(err, enc.AddArray("historyBatches", (_List_DataBlob_Zapper)(v.HistoryBatches)))
+	if v.RpcName != nil {
+		enc.AddString("rpcName", *v.RpcName)
 	}
-	if v.ReplicationInfo != nil {
-		err = multierr.Append(err, enc.AddObject("replicationInfo", (_Map_String_ReplicationInfo_Zapper)(v.ReplicationInfo)))
+	if v.RpcAddress != nil {
+		enc.AddString("rpcAddress", *v.RpcAddress)
 	}
-	if v.EventStoreVersion != nil {
-		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+	if v.Enabled != nil {
+		enc.AddBool("enabled", *v.Enabled)
 	}
 	return err
 }
 
-// GetNextPageToken returns the value of NextPageToken if it is set or its
+// GetClusterName returns the value of ClusterName if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryResponse) GetNextPageToken() (o []byte) {
-	if v.NextPageToken != nil {
-		return v.NextPageToken
+func (v *UpdateClusterRequest) GetClusterName() (o string) {
+	if v.ClusterName != nil {
+		return *v.ClusterName
 	}
 
 	return
 }
 
-// GetHistoryBatches returns the value of HistoryBatches if it is set or its
+// GetRpcName returns the value of RpcName if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryResponse) GetHistoryBatches() (o []*shared.DataBlob) {
-	if v.HistoryBatches != nil {
-		return v.HistoryBatches
+func (v *UpdateClusterRequest) GetRpcName() (o string) {
+	if v.RpcName != nil {
+		return *v.RpcName
 	}
 
 	return
 }
 
-// GetReplicationInfo returns the value of ReplicationInfo if it is set or its
+// GetRpcAddress returns the value of RpcAddress if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryResponse) GetReplicationInfo() (o map[string]*shared.ReplicationInfo) {
-	if v.ReplicationInfo != nil {
-		return v.ReplicationInfo
+func (v *UpdateClusterRequest) GetRpcAddress() (o string) {
+	if v.RpcAddress != nil {
+		return *v.RpcAddress
 	}
 
 	return
 }
 
-// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
+// GetEnabled returns the value of Enabled if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryResponse) GetEventStoreVersion() (o int32) {
-	if v.EventStoreVersion != nil {
-		return *v.EventStoreVersion
+func (v *UpdateClusterRequest) GetEnabled() (o bool) {
+	if v.Enabled != nil {
+		return *v.Enabled
 	}
 
 	return
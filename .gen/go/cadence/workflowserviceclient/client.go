@@ -84,6 +84,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.ListOpenWorkflowExecutionsResponse, error)
 
+	ListTaskLists(
+		ctx context.Context,
+		Request *shared.ListTaskListsRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListTaskListsResponse, error)
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *shared.PollForActivityTaskRequest,
@@ -431,6 +437,29 @@ func (c client) ListOpenWorkflowExecutions(
 	return
 }
 
+func (c client) ListTaskLists(
+	ctx context.Context,
+	_Request *shared.ListTaskListsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListTaskListsResponse, err error) {
+
+	args := cadence.WorkflowService_ListTaskLists_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_ListTaskLists_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_ListTaskLists_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollForActivityTask(
 	ctx context.Context,
 	_PollRequest *shared.PollForActivityTaskRequest,
@@ -26,6 +26,7 @@ package historyserviceclient
 import (
 	"context"
 	"github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"go.uber.org/thriftrw/wire"
 	"go.uber.org/yarpc"
@@ -48,6 +49,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*history.DescribeMutableStateResponse, error)
 
+	DescribeReplicationStatus(
+		ctx context.Context,
+		Request *replicator.DescribeReplicationStatusRequest,
+		opts ...yarpc.CallOption,
+	) (*replicator.DescribeReplicationStatusResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		DescribeRequest *history.DescribeWorkflowExecutionRequest,
@@ -60,6 +67,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*history.GetMutableStateResponse, error)
 
+	GetReplicationMessages(
+		ctx context.Context,
+		Request *replicator.GetReplicationMessagesRequest,
+		opts ...yarpc.CallOption,
+	) (*replicator.GetReplicationMessagesResponse, error)
+
 	RecordActivityTaskHeartbeat(
 		ctx context.Context,
 		HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -263,6 +276,29 @@ func (c client) DescribeMutableState(
 	return
 }
 
+func (c client) DescribeReplicationStatus(
+	ctx context.Context,
+	_Request *replicator.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (success *replicator.DescribeReplicationStatusResponse, err error) {
+
+	args := history.HistoryService_DescribeReplicationStatus_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_DescribeReplicationStatus_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_DescribeReplicationStatus_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeWorkflowExecution(
 	ctx context.Context,
 	_DescribeRequest *history.DescribeWorkflowExecutionRequest,
@@ -309,6 +345,29 @@ func (c client) GetMutableState(
 	return
 }
 
+func (c client) GetReplicationMessages(
+	ctx context.Context,
+	_Request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption,
+) (success *replicator.GetReplicationMessagesResponse, err error) {
+
+	args := history.HistoryService_GetReplicationMessages_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_GetReplicationMessages_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_GetReplicationMessages_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	_HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -60,6 +60,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeTaskListResponse, error)
 
+	DescribeTaskListDispatchRate(
+		ctx context.Context,
+		Request *matching.DescribeTaskListDispatchRateRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeTaskListDispatchRateResponse, error)
+
+	ListTaskLists(
+		ctx context.Context,
+		Request *matching.ListTaskListsRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListTaskListsResponse, error)
+
+	PauseTaskList(
+		ctx context.Context,
+		Request *matching.PauseTaskListRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *matching.PollForActivityTaskRequest,
@@ -83,6 +101,18 @@ type Interface interface {
 		Request *matching.RespondQueryTaskCompletedRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	ResumeTaskList(
+		ctx context.Context,
+		Request *matching.ResumeTaskListRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UpdateTaskListDispatchRate(
+		ctx context.Context,
+		Request *matching.UpdateTaskListDispatchRateRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the MatchingService service.
@@ -201,6 +231,75 @@ func (c client) DescribeTaskList(
 	return
 }
 
+func (c client) DescribeTaskListDispatchRate(
+	ctx context.Context,
+	_Request *matching.DescribeTaskListDispatchRateRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeTaskListDispatchRateResponse, err error) {
+
+	args := matching.MatchingService_DescribeTaskListDispatchRate_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result matching.MatchingService_DescribeTaskListDispatchRate_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = matching.MatchingService_DescribeTaskListDispatchRate_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ListTaskLists(
+	ctx context.Context,
+	_Request *matching.ListTaskListsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListTaskListsResponse, err error) {
+
+	args := matching.MatchingService_ListTaskLists_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result matching.MatchingService_ListTaskLists_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = matching.MatchingService_ListTaskLists_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PauseTaskList(
+	ctx context.Context,
+	_Request *matching.PauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := matching.MatchingService_PauseTaskList_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result matching.MatchingService_PauseTaskList_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = matching.MatchingService_PauseTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollForActivityTask(
 	ctx context.Context,
 	_PollRequest *matching.PollForActivityTaskRequest,
@@ -292,3 +391,49 @@ func (c client) RespondQueryTaskCompleted(
 	err = matching.MatchingService_RespondQueryTaskCompleted_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) ResumeTaskList(
+	ctx context.Context,
+	_Request *matching.ResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := matching.MatchingService_ResumeTaskList_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result matching.MatchingService_ResumeTaskList_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = matching.MatchingService_ResumeTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateTaskListDispatchRate(
+	ctx context.Context,
+	_Request *matching.UpdateTaskListDispatchRateRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := matching.MatchingService_UpdateTaskListDispatchRate_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result matching.MatchingService_UpdateTaskListDispatchRate_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = matching.MatchingService_UpdateTaskListDispatchRate_Helper.UnwrapResponse(&result)
+	return
+}
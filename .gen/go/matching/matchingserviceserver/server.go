@@ -54,6 +54,21 @@ type Interface interface {
 		Request *matching.DescribeTaskListRequest,
 	) (*shared.DescribeTaskListResponse, error)
 
+	DescribeTaskListDispatchRate(
+		ctx context.Context,
+		Request *matching.DescribeTaskListDispatchRateRequest,
+	) (*shared.DescribeTaskListDispatchRateResponse, error)
+
+	ListTaskLists(
+		ctx context.Context,
+		Request *matching.ListTaskListsRequest,
+	) (*shared.ListTaskListsResponse, error)
+
+	PauseTaskList(
+		ctx context.Context,
+		Request *matching.PauseTaskListRequest,
+	) error
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *matching.PollForActivityTaskRequest,
@@ -73,6 +88,16 @@ type Interface interface {
 		ctx context.Context,
 		Request *matching.RespondQueryTaskCompletedRequest,
 	) error
+
+	ResumeTaskList(
+		ctx context.Context,
+		Request *matching.ResumeTaskListRequest,
+	) error
+
+	UpdateTaskListDispatchRate(
+		ctx context.Context,
+		Request *matching.UpdateTaskListDispatchRateRequest,
+	) error
 }
 
 // New prepares an implementation of the MatchingService service for
@@ -130,6 +155,39 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: matching.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeTaskListDispatchRate",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeTaskListDispatchRate),
+				},
+				Signature:    "DescribeTaskListDispatchRate(Request *matching.DescribeTaskListDispatchRateRequest) (*shared.DescribeTaskListDispatchRateResponse)",
+				ThriftModule: matching.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ListTaskLists",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListTaskLists),
+				},
+				Signature:    "ListTaskLists(Request *matching.ListTaskListsRequest) (*shared.ListTaskListsResponse)",
+				ThriftModule: matching.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PauseTaskList",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PauseTaskList),
+				},
+				Signature:    "PauseTaskList(Request *matching.PauseTaskListRequest)",
+				ThriftModule: matching.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PollForActivityTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -173,10 +231,32 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "RespondQueryTaskCompleted(Request *matching.RespondQueryTaskCompletedRequest)",
 				ThriftModule: matching.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "ResumeTaskList",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ResumeTaskList),
+				},
+				Signature:    "ResumeTaskList(Request *matching.ResumeTaskListRequest)",
+				ThriftModule: matching.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpdateTaskListDispatchRate",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateTaskListDispatchRate),
+				},
+				Signature:    "UpdateTaskListDispatchRate(Request *matching.UpdateTaskListDispatchRateRequest)",
+				ThriftModule: matching.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 8)
+	procedures := make([]transport.Procedure, 0, 13)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -259,6 +339,63 @@ func (h handler) DescribeTaskList(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
+func (h handler) DescribeTaskListDispatchRate(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_DescribeTaskListDispatchRate_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DescribeTaskListDispatchRate(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := matching.MatchingService_DescribeTaskListDispatchRate_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ListTaskLists(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_ListTaskLists_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ListTaskLists(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := matching.MatchingService_ListTaskLists_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) PauseTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_PauseTaskList_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.PauseTaskList(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := matching.MatchingService_PauseTaskList_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) PollForActivityTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args matching.MatchingService_PollForActivityTask_Args
 	if err := args.FromWire(body); err != nil {
@@ -334,3 +471,41 @@ func (h handler) RespondQueryTaskCompleted(ctx context.Context, body wire.Value)
 	}
 	return response, err
 }
+
+func (h handler) ResumeTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_ResumeTaskList_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.ResumeTaskList(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := matching.MatchingService_ResumeTaskList_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) UpdateTaskListDispatchRate(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_UpdateTaskListDispatchRate_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.UpdateTaskListDispatchRate(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := matching.MatchingService_UpdateTaskListDispatchRate_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
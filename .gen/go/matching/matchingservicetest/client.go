@@ -189,6 +189,103 @@ func (mr *_MockClientRecorder) DescribeTaskList(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeTaskList", args...)
 }
 
+// DescribeTaskListDispatchRate responds to a DescribeTaskListDispatchRate call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().DescribeTaskListDispatchRate(gomock.Any(), ...).Return(...)
+// 	... := client.DescribeTaskListDispatchRate(...)
+func (m *MockClient) DescribeTaskListDispatchRate(
+	ctx context.Context,
+	_Request *matching.DescribeTaskListDispatchRateRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeTaskListDispatchRateResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeTaskListDispatchRate", args...)
+	success, _ = ret[i].(*shared.DescribeTaskListDispatchRateResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeTaskListDispatchRate(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeTaskListDispatchRate", args...)
+}
+
+// ListTaskLists responds to a ListTaskLists call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ListTaskLists(gomock.Any(), ...).Return(...)
+// 	... := client.ListTaskLists(...)
+func (m *MockClient) ListTaskLists(
+	ctx context.Context,
+	_Request *matching.ListTaskListsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListTaskListsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListTaskLists", args...)
+	success, _ = ret[i].(*shared.ListTaskListsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListTaskLists(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListTaskLists", args...)
+}
+
+// PauseTaskList responds to a PauseTaskList call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().PauseTaskList(gomock.Any(), ...).Return(...)
+// 	... := client.PauseTaskList(...)
+func (m *MockClient) PauseTaskList(
+	ctx context.Context,
+	_Request *matching.PauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PauseTaskList", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PauseTaskList(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PauseTaskList", args...)
+}
+
 // PollForActivityTask responds to a PollForActivityTask call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -318,3 +415,65 @@ func (mr *_MockClientRecorder) RespondQueryTaskCompleted(
 	args := append([]interface{}{ctx, _Request}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "RespondQueryTaskCompleted", args...)
 }
+
+// ResumeTaskList responds to a ResumeTaskList call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ResumeTaskList(gomock.Any(), ...).Return(...)
+// 	... := client.ResumeTaskList(...)
+func (m *MockClient) ResumeTaskList(
+	ctx context.Context,
+	_Request *matching.ResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResumeTaskList", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResumeTaskList(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResumeTaskList", args...)
+}
+
+// UpdateTaskListDispatchRate responds to a UpdateTaskListDispatchRate call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().UpdateTaskListDispatchRate(gomock.Any(), ...).Return(...)
+// 	... := client.UpdateTaskListDispatchRate(...)
+func (m *MockClient) UpdateTaskListDispatchRate(
+	ctx context.Context,
+	_Request *matching.UpdateTaskListDispatchRateRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateTaskListDispatchRate", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateTaskListDispatchRate(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateTaskListDispatchRate", args...)
+}
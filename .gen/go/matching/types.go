@@ -41,6 +41,8 @@ type AddActivityTaskRequest struct {
 	TaskList                      *shared.TaskList          `json:"taskList,omitempty"`
 	ScheduleId                    *int64                    `json:"scheduleId,omitempty"`
 	ScheduleToStartTimeoutSeconds *int32                    `json:"scheduleToStartTimeoutSeconds,omitempty"`
+	ForwardedFrom                 *string                   `json:"forwardedFrom,omitempty"`
+	Priority                      *int32                    `json:"priority,omitempty"`
 }
 
 // ToWire translates a AddActivityTaskRequest struct into a Thrift-level intermediate
@@ -60,7 +62,7 @@ type AddActivityTaskRequest struct {
 //   }
 func (v *AddActivityTaskRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -114,6 +116,22 @@ func (v *AddActivityTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
+	if v.ForwardedFrom != nil {
+		w, err = wire.NewValueString(*(v.ForwardedFrom)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -207,6 +225,26 @@ func (v *AddActivityTaskRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 70:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ForwardedFrom = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -221,7 +259,7 @@ func (v *AddActivityTaskRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [8]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
@@ -247,6 +285,14 @@ func (v *AddActivityTaskRequest) String() string {
 		fields[i] = fmt.Sprintf("ScheduleToStartTimeoutSeconds: %v", *(v.ScheduleToStartTimeoutSeconds))
 		i++
 	}
+	if v.ForwardedFrom != nil {
+		fields[i] = fmt.Sprintf("ForwardedFrom: %v", *(v.ForwardedFrom))
+		i++
+	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
 
 	return fmt.Sprintf("AddActivityTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -309,6 +355,12 @@ func (v *AddActivityTaskRequest) Equals(rhs *AddActivityTaskRequest) bool {
 	if !_I32_EqualsPtr(v.ScheduleToStartTimeoutSeconds, rhs.ScheduleToStartTimeoutSeconds) {
 		return false
 	}
+	if !_String_EqualsPtr(v.ForwardedFrom, rhs.ForwardedFrom) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
 
 	return true
 }
@@ -337,6 +389,12 @@ func (v *AddActivityTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (er
 	if v.ScheduleToStartTimeoutSeconds != nil {
 		enc.AddInt32("scheduleToStartTimeoutSeconds", *v.ScheduleToStartTimeoutSeconds)
 	}
+	if v.ForwardedFrom != nil {
+		enc.AddString("forwardedFrom", *v.ForwardedFrom)
+	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
 	return err
 }
 
@@ -400,12 +458,34 @@ func (v *AddActivityTaskRequest) GetScheduleToStartTimeoutSeconds() (o int32) {
 	return
 }
 
+// GetForwardedFrom returns the value of ForwardedFrom if it is set or its
+// zero value if it is unset.
+func (v *AddActivityTaskRequest) GetForwardedFrom() (o string) {
+	if v.ForwardedFrom != nil {
+		return *v.ForwardedFrom
+	}
+
+	return
+}
+
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *AddActivityTaskRequest) GetPriority() (o int32) {
+	if v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
 type AddDecisionTaskRequest struct {
 	DomainUUID                    *string                   `json:"domainUUID,omitempty"`
 	Execution                     *shared.WorkflowExecution `json:"execution,omitempty"`
 	TaskList                      *shared.TaskList          `json:"taskList,omitempty"`
 	ScheduleId                    *int64                    `json:"scheduleId,omitempty"`
 	ScheduleToStartTimeoutSeconds *int32                    `json:"scheduleToStartTimeoutSeconds,omitempty"`
+	ForwardedFrom                 *string                   `json:"forwardedFrom,omitempty"`
+	Priority                      *int32                    `json:"priority,omitempty"`
 }
 
 // ToWire translates a AddDecisionTaskRequest struct into a Thrift-level intermediate
@@ -425,7 +505,7 @@ type AddDecisionTaskRequest struct {
 //   }
 func (v *AddDecisionTaskRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -471,6 +551,22 @@ func (v *AddDecisionTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.ForwardedFrom != nil {
+		w, err = wire.NewValueString(*(v.ForwardedFrom)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -542,6 +638,26 @@ func (v *AddDecisionTaskRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ForwardedFrom = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -556,7 +672,7 @@ func (v *AddDecisionTaskRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [7]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
@@ -578,6 +694,14 @@ func (v *AddDecisionTaskRequest) String() string {
 		fields[i] = fmt.Sprintf("ScheduleToStartTimeoutSeconds: %v", *(v.ScheduleToStartTimeoutSeconds))
 		i++
 	}
+	if v.ForwardedFrom != nil {
+		fields[i] = fmt.Sprintf("ForwardedFrom: %v", *(v.ForwardedFrom))
+		i++
+	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
 
 	return fmt.Sprintf("AddDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -607,6 +731,12 @@ func (v *AddDecisionTaskRequest) Equals(rhs *AddDecisionTaskRequest) bool {
 	if !_I32_EqualsPtr(v.ScheduleToStartTimeoutSeconds, rhs.ScheduleToStartTimeoutSeconds) {
 		return false
 	}
+	if !_String_EqualsPtr(v.ForwardedFrom, rhs.ForwardedFrom) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
 
 	return true
 }
@@ -632,6 +762,12 @@ func (v *AddDecisionTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (er
 	if v.ScheduleToStartTimeoutSeconds != nil {
 		enc.AddInt32("scheduleToStartTimeoutSeconds", *v.ScheduleToStartTimeoutSeconds)
 	}
+	if v.ForwardedFrom != nil {
+		enc.AddString("forwardedFrom", *v.ForwardedFrom)
+	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
 	return err
 }
 
@@ -685,6 +821,26 @@ func (v *AddDecisionTaskRequest) GetScheduleToStartTimeoutSeconds() (o int32) {
 	return
 }
 
+// GetForwardedFrom returns the value of ForwardedFrom if it is set or its
+// zero value if it is unset.
+func (v *AddDecisionTaskRequest) GetForwardedFrom() (o string) {
+	if v.ForwardedFrom != nil {
+		return *v.ForwardedFrom
+	}
+
+	return
+}
+
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *AddDecisionTaskRequest) GetPriority() (o int32) {
+	if v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
 type CancelOutstandingPollRequest struct {
 	DomainUUID   *string          `json:"domainUUID,omitempty"`
 	TaskListType *int32           `json:"taskListType,omitempty"`
@@ -933,12 +1089,12 @@ func (v *CancelOutstandingPollRequest) GetPollerID() (o string) {
 	return
 }
 
-type DescribeTaskListRequest struct {
-	DomainUUID  *string                         `json:"domainUUID,omitempty"`
-	DescRequest *shared.DescribeTaskListRequest `json:"descRequest,omitempty"`
+type DescribeTaskListDispatchRateRequest struct {
+	DomainUUID  *string                                     `json:"domainUUID,omitempty"`
+	DescRequest *shared.DescribeTaskListDispatchRateRequest `json:"descRequest,omitempty"`
 }
 
-// ToWire translates a DescribeTaskListRequest struct into a Thrift-level intermediate
+// ToWire translates a DescribeTaskListDispatchRateRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -953,7 +1109,7 @@ type DescribeTaskListRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeTaskListRequest) ToWire() (wire.Value, error) {
+func (v *DescribeTaskListDispatchRateRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -981,17 +1137,17 @@ func (v *DescribeTaskListRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeTaskListRequest_Read(w wire.Value) (*shared.DescribeTaskListRequest, error) {
-	var v shared.DescribeTaskListRequest
+func _DescribeTaskListDispatchRateRequest_Read(w wire.Value) (*shared.DescribeTaskListDispatchRateRequest, error) {
+	var v shared.DescribeTaskListDispatchRateRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a DescribeTaskListRequest struct from its Thrift-level
+// FromWire deserializes a DescribeTaskListDispatchRateRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeTaskListRequest struct
+// An error is returned if we were unable to build a DescribeTaskListDispatchRateRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -999,12 +1155,12 @@ func _DescribeTaskListRequest_Read(w wire.Value) (*shared.DescribeTaskListReques
 //     return nil, err
 //   }
 //
-//   var v DescribeTaskListRequest
+//   var v DescribeTaskListDispatchRateRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeTaskListRequest) FromWire(w wire.Value) error {
+func (v *DescribeTaskListDispatchRateRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -1021,7 +1177,7 @@ func (v *DescribeTaskListRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.DescRequest, err = _DescribeTaskListRequest_Read(field.Value)
+				v.DescRequest, err = _DescribeTaskListDispatchRateRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -1033,9 +1189,9 @@ func (v *DescribeTaskListRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DescribeTaskListRequest
+// String returns a readable string representation of a DescribeTaskListDispatchRateRequest
 // struct.
-func (v *DescribeTaskListRequest) String() string {
+func (v *DescribeTaskListDispatchRateRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -1051,14 +1207,14 @@ func (v *DescribeTaskListRequest) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("DescribeTaskListRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DescribeTaskListDispatchRateRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DescribeTaskListRequest match the
-// provided DescribeTaskListRequest.
+// Equals returns true if all the fields of this DescribeTaskListDispatchRateRequest match the
+// provided DescribeTaskListDispatchRateRequest.
 //
 // This function performs a deep comparison.
-func (v *DescribeTaskListRequest) Equals(rhs *DescribeTaskListRequest) bool {
+func (v *DescribeTaskListDispatchRateRequest) Equals(rhs *DescribeTaskListDispatchRateRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -1075,8 +1231,8 @@ func (v *DescribeTaskListRequest) Equals(rhs *DescribeTaskListRequest) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DescribeTaskListRequest.
-func (v *DescribeTaskListRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DescribeTaskListDispatchRateRequest.
+func (v *DescribeTaskListDispatchRateRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -1091,7 +1247,7 @@ func (v *DescribeTaskListRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (e
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *DescribeTaskListRequest) GetDomainUUID() (o string) {
+func (v *DescribeTaskListDispatchRateRequest) GetDomainUUID() (o string) {
 	if v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -1101,7 +1257,7 @@ func (v *DescribeTaskListRequest) GetDomainUUID() (o string) {
 
 // GetDescRequest returns the value of DescRequest if it is set or its
 // zero value if it is unset.
-func (v *DescribeTaskListRequest) GetDescRequest() (o *shared.DescribeTaskListRequest) {
+func (v *DescribeTaskListDispatchRateRequest) GetDescRequest() (o *shared.DescribeTaskListDispatchRateRequest) {
 	if v.DescRequest != nil {
 		return v.DescRequest
 	}
@@ -1109,13 +1265,12 @@ func (v *DescribeTaskListRequest) GetDescRequest() (o *shared.DescribeTaskListRe
 	return
 }
 
-type PollForActivityTaskRequest struct {
-	DomainUUID  *string                            `json:"domainUUID,omitempty"`
-	PollerID    *string                            `json:"pollerID,omitempty"`
-	PollRequest *shared.PollForActivityTaskRequest `json:"pollRequest,omitempty"`
+type DescribeTaskListRequest struct {
+	DomainUUID  *string                         `json:"domainUUID,omitempty"`
+	DescRequest *shared.DescribeTaskListRequest `json:"descRequest,omitempty"`
 }
 
-// ToWire translates a PollForActivityTaskRequest struct into a Thrift-level intermediate
+// ToWire translates a DescribeTaskListRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1130,9 +1285,9 @@ type PollForActivityTaskRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
+func (v *DescribeTaskListRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -1146,16 +1301,8 @@ func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.PollerID != nil {
-		w, err = wire.NewValueString(*(v.PollerID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 15, Value: w}
-		i++
-	}
-	if v.PollRequest != nil {
-		w, err = v.PollRequest.ToWire()
+	if v.DescRequest != nil {
+		w, err = v.DescRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -1166,17 +1313,17 @@ func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForActivityTaskRequest_Read(w wire.Value) (*shared.PollForActivityTaskRequest, error) {
-	var v shared.PollForActivityTaskRequest
+func _DescribeTaskListRequest_Read(w wire.Value) (*shared.DescribeTaskListRequest, error) {
+	var v shared.DescribeTaskListRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a PollForActivityTaskRequest struct from its Thrift-level
+// FromWire deserializes a DescribeTaskListRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PollForActivityTaskRequest struct
+// An error is returned if we were unable to build a DescribeTaskListRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1184,12 +1331,12 @@ func _PollForActivityTaskRequest_Read(w wire.Value) (*shared.PollForActivityTask
 //     return nil, err
 //   }
 //
-//   var v PollForActivityTaskRequest
+//   var v DescribeTaskListRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
+func (v *DescribeTaskListRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -1203,20 +1350,10 @@ func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
-			}
-		case 15:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.PollerID = &x
-				if err != nil {
-					return err
-				}
-
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.PollRequest, err = _PollForActivityTaskRequest_Read(field.Value)
+				v.DescRequest, err = _DescribeTaskListRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -1228,36 +1365,32 @@ func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a PollForActivityTaskRequest
+// String returns a readable string representation of a DescribeTaskListRequest
 // struct.
-func (v *PollForActivityTaskRequest) String() string {
+func (v *DescribeTaskListRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [2]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.PollerID != nil {
-		fields[i] = fmt.Sprintf("PollerID: %v", *(v.PollerID))
-		i++
-	}
-	if v.PollRequest != nil {
-		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
+	if v.DescRequest != nil {
+		fields[i] = fmt.Sprintf("DescRequest: %v", v.DescRequest)
 		i++
 	}
 
-	return fmt.Sprintf("PollForActivityTaskRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DescribeTaskListRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PollForActivityTaskRequest match the
-// provided PollForActivityTaskRequest.
+// Equals returns true if all the fields of this DescribeTaskListRequest match the
+// provided DescribeTaskListRequest.
 //
 // This function performs a deep comparison.
-func (v *PollForActivityTaskRequest) Equals(rhs *PollForActivityTaskRequest) bool {
+func (v *DescribeTaskListRequest) Equals(rhs *DescribeTaskListRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -1266,10 +1399,7 @@ func (v *PollForActivityTaskRequest) Equals(rhs *PollForActivityTaskRequest) boo
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !_String_EqualsPtr(v.PollerID, rhs.PollerID) {
-		return false
-	}
-	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
+	if !((v.DescRequest == nil && rhs.DescRequest == nil) || (v.DescRequest != nil && rhs.DescRequest != nil && v.DescRequest.Equals(rhs.DescRequest))) {
 		return false
 	}
 
@@ -1277,26 +1407,23 @@ func (v *PollForActivityTaskRequest) Equals(rhs *PollForActivityTaskRequest) boo
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PollForActivityTaskRequest.
-func (v *PollForActivityTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DescribeTaskListRequest.
+func (v *DescribeTaskListRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.DomainUUID != nil {
 		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.PollerID != nil {
-		enc.AddString("pollerID", *v.PollerID)
-	}
-	if v.PollRequest != nil {
-		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
+	if v.DescRequest != nil {
+		err = multierr.Append(err, enc.AddObject("descRequest", v.DescRequest))
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *PollForActivityTaskRequest) GetDomainUUID() (o string) {
+func (v *DescribeTaskListRequest) GetDomainUUID() (o string) {
 	if v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -1304,33 +1431,22 @@ func (v *PollForActivityTaskRequest) GetDomainUUID() (o string) {
 	return
 }
 
-// GetPollerID returns the value of PollerID if it is set or its
-// zero value if it is unset.
-func (v *PollForActivityTaskRequest) GetPollerID() (o string) {
-	if v.PollerID != nil {
-		return *v.PollerID
-	}
-
-	return
-}
-
-// GetPollRequest returns the value of PollRequest if it is set or its
+// GetDescRequest returns the value of DescRequest if it is set or its
 // zero value if it is unset.
-func (v *PollForActivityTaskRequest) GetPollRequest() (o *shared.PollForActivityTaskRequest) {
-	if v.PollRequest != nil {
-		return v.PollRequest
+func (v *DescribeTaskListRequest) GetDescRequest() (o *shared.DescribeTaskListRequest) {
+	if v.DescRequest != nil {
+		return v.DescRequest
 	}
 
 	return
 }
 
-type PollForDecisionTaskRequest struct {
-	DomainUUID  *string                            `json:"domainUUID,omitempty"`
-	PollerID    *string                            `json:"pollerID,omitempty"`
-	PollRequest *shared.PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
+type ListTaskListsRequest struct {
+	DomainUUID  *string                      `json:"domainUUID,omitempty"`
+	ListRequest *shared.ListTaskListsRequest `json:"listRequest,omitempty"`
 }
 
-// ToWire translates a PollForDecisionTaskRequest struct into a Thrift-level intermediate
+// ToWire translates a ListTaskListsRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1345,9 +1461,9 @@ type PollForDecisionTaskRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
+func (v *ListTaskListsRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -1361,16 +1477,8 @@ func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.PollerID != nil {
-		w, err = wire.NewValueString(*(v.PollerID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 15, Value: w}
-		i++
-	}
-	if v.PollRequest != nil {
-		w, err = v.PollRequest.ToWire()
+	if v.ListRequest != nil {
+		w, err = v.ListRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -1381,17 +1489,17 @@ func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForDecisionTaskRequest_Read(w wire.Value) (*shared.PollForDecisionTaskRequest, error) {
-	var v shared.PollForDecisionTaskRequest
+func _ListTaskListsRequest_Read(w wire.Value) (*shared.ListTaskListsRequest, error) {
+	var v shared.ListTaskListsRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a PollForDecisionTaskRequest struct from its Thrift-level
+// FromWire deserializes a ListTaskListsRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PollForDecisionTaskRequest struct
+// An error is returned if we were unable to build a ListTaskListsRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1399,12 +1507,12 @@ func _PollForDecisionTaskRequest_Read(w wire.Value) (*shared.PollForDecisionTask
 //     return nil, err
 //   }
 //
-//   var v PollForDecisionTaskRequest
+//   var v ListTaskListsRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
+func (v *ListTaskListsRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -1418,20 +1526,10 @@ func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
-			}
-		case 15:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.PollerID = &x
-				if err != nil {
-					return err
-				}
-
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.PollRequest, err = _PollForDecisionTaskRequest_Read(field.Value)
+				v.ListRequest, err = _ListTaskListsRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -1443,36 +1541,32 @@ func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a PollForDecisionTaskRequest
+// String returns a readable string representation of a ListTaskListsRequest
 // struct.
-func (v *PollForDecisionTaskRequest) String() string {
+func (v *ListTaskListsRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [2]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.PollerID != nil {
-		fields[i] = fmt.Sprintf("PollerID: %v", *(v.PollerID))
-		i++
-	}
-	if v.PollRequest != nil {
-		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
+	if v.ListRequest != nil {
+		fields[i] = fmt.Sprintf("ListRequest: %v", v.ListRequest)
 		i++
 	}
 
-	return fmt.Sprintf("PollForDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ListTaskListsRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PollForDecisionTaskRequest match the
-// provided PollForDecisionTaskRequest.
+// Equals returns true if all the fields of this ListTaskListsRequest match the
+// provided ListTaskListsRequest.
 //
 // This function performs a deep comparison.
-func (v *PollForDecisionTaskRequest) Equals(rhs *PollForDecisionTaskRequest) bool {
+func (v *ListTaskListsRequest) Equals(rhs *ListTaskListsRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -1481,10 +1575,7 @@ func (v *PollForDecisionTaskRequest) Equals(rhs *PollForDecisionTaskRequest) boo
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !_String_EqualsPtr(v.PollerID, rhs.PollerID) {
-		return false
-	}
-	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
+	if !((v.ListRequest == nil && rhs.ListRequest == nil) || (v.ListRequest != nil && rhs.ListRequest != nil && v.ListRequest.Equals(rhs.ListRequest))) {
 		return false
 	}
 
@@ -1492,26 +1583,23 @@ func (v *PollForDecisionTaskRequest) Equals(rhs *PollForDecisionTaskRequest) boo
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PollForDecisionTaskRequest.
-func (v *PollForDecisionTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ListTaskListsRequest.
+func (v *ListTaskListsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.DomainUUID != nil {
 		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.PollerID != nil {
-		enc.AddString("pollerID", *v.PollerID)
-	}
-	if v.PollRequest != nil {
-		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
+	if v.ListRequest != nil {
+		err = multierr.Append(err, enc.AddObject("listRequest", v.ListRequest))
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskRequest) GetDomainUUID() (o string) {
+func (v *ListTaskListsRequest) GetDomainUUID() (o string) {
 	if v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -1519,44 +1607,22 @@ func (v *PollForDecisionTaskRequest) GetDomainUUID() (o string) {
 	return
 }
 
-// GetPollerID returns the value of PollerID if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskRequest) GetPollerID() (o string) {
-	if v.PollerID != nil {
-		return *v.PollerID
-	}
-
-	return
-}
-
-// GetPollRequest returns the value of PollRequest if it is set or its
+// GetListRequest returns the value of ListRequest if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskRequest) GetPollRequest() (o *shared.PollForDecisionTaskRequest) {
-	if v.PollRequest != nil {
-		return v.PollRequest
+func (v *ListTaskListsRequest) GetListRequest() (o *shared.ListTaskListsRequest) {
+	if v.ListRequest != nil {
+		return v.ListRequest
 	}
 
 	return
 }
 
-type PollForDecisionTaskResponse struct {
-	TaskToken                 []byte                        `json:"taskToken,omitempty"`
-	WorkflowExecution         *shared.WorkflowExecution     `json:"workflowExecution,omitempty"`
-	WorkflowType              *shared.WorkflowType          `json:"workflowType,omitempty"`
-	PreviousStartedEventId    *int64                        `json:"previousStartedEventId,omitempty"`
-	StartedEventId            *int64                        `json:"startedEventId,omitempty"`
-	Attempt                   *int64                        `json:"attempt,omitempty"`
-	NextEventId               *int64                        `json:"nextEventId,omitempty"`
-	BacklogCountHint          *int64                        `json:"backlogCountHint,omitempty"`
-	StickyExecutionEnabled    *bool                         `json:"stickyExecutionEnabled,omitempty"`
-	Query                     *shared.WorkflowQuery         `json:"query,omitempty"`
-	DecisionInfo              *shared.TransientDecisionInfo `json:"decisionInfo,omitempty"`
-	WorkflowExecutionTaskList *shared.TaskList              `json:"WorkflowExecutionTaskList,omitempty"`
-	EventStoreVersion         *int32                        `json:"eventStoreVersion,omitempty"`
-	BranchToken               []byte                        `json:"branchToken,omitempty"`
+type PauseTaskListRequest struct {
+	DomainUUID   *string                      `json:"domainUUID,omitempty"`
+	PauseRequest *shared.PauseTaskListRequest `json:"pauseRequest,omitempty"`
 }
 
-// ToWire translates a PollForDecisionTaskResponse struct into a Thrift-level intermediate
+// ToWire translates a PauseTaskListRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1571,153 +1637,45 @@ type PollForDecisionTaskResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *PollForDecisionTaskResponse) ToWire() (wire.Value, error) {
+func (v *PauseTaskListRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [14]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TaskToken != nil {
-		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.PauseRequest != nil {
+		w, err = v.PauseRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.WorkflowType != nil {
-		w, err = v.WorkflowType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.PreviousStartedEventId != nil {
-		w, err = wire.NewValueI64(*(v.PreviousStartedEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.StartedEventId != nil {
-		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.Attempt != nil {
-		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 51, Value: w}
-		i++
-	}
-	if v.NextEventId != nil {
-		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
-	if v.BacklogCountHint != nil {
-		w, err = wire.NewValueI64(*(v.BacklogCountHint)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 65, Value: w}
-		i++
-	}
-	if v.StickyExecutionEnabled != nil {
-		w, err = wire.NewValueBool(*(v.StickyExecutionEnabled)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.Query != nil {
-		w, err = v.Query.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
-	if v.DecisionInfo != nil {
-		w, err = v.DecisionInfo.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
-	}
-	if v.WorkflowExecutionTaskList != nil {
-		w, err = v.WorkflowExecutionTaskList.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 100, Value: w}
-		i++
-	}
-	if v.EventStoreVersion != nil {
-		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 110, Value: w}
-		i++
-	}
-	if v.BranchToken != nil {
-		w, err = wire.NewValueBinary(v.BranchToken), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 120, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _WorkflowType_Read(w wire.Value) (*shared.WorkflowType, error) {
-	var v shared.WorkflowType
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _WorkflowQuery_Read(w wire.Value) (*shared.WorkflowQuery, error) {
-	var v shared.WorkflowQuery
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _TransientDecisionInfo_Read(w wire.Value) (*shared.TransientDecisionInfo, error) {
-	var v shared.TransientDecisionInfo
+func _PauseTaskListRequest_Read(w wire.Value) (*shared.PauseTaskListRequest, error) {
+	var v shared.PauseTaskListRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a PollForDecisionTaskResponse struct from its Thrift-level
+// FromWire deserializes a PauseTaskListRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PollForDecisionTaskResponse struct
+// An error is returned if we were unable to build a PauseTaskListRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1725,19 +1683,21 @@ func _TransientDecisionInfo_Read(w wire.Value) (*shared.TransientDecisionInfo, e
 //     return nil, err
 //   }
 //
-//   var v PollForDecisionTaskResponse
+//   var v PauseTaskListRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
+func (v *PauseTaskListRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				v.TaskToken, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
 				if err != nil {
 					return err
 				}
@@ -1745,117 +1705,221 @@ func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				v.PauseRequest, err = _PauseTaskListRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowType, err = _WorkflowType_Read(field.Value)
-				if err != nil {
-					return err
-				}
+		}
+	}
 
-			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.PreviousStartedEventId = &x
-				if err != nil {
-					return err
-				}
+	return nil
+}
 
-			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedEventId = &x
-				if err != nil {
-					return err
-				}
+// String returns a readable string representation of a PauseTaskListRequest
+// struct.
+func (v *PauseTaskListRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
 
-			}
-		case 51:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.Attempt = &x
-				if err != nil {
-					return err
-				}
+	var fields [2]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.PauseRequest != nil {
+		fields[i] = fmt.Sprintf("PauseRequest: %v", v.PauseRequest)
+		i++
+	}
 
-			}
-		case 60:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.NextEventId = &x
-				if err != nil {
-					return err
-				}
+	return fmt.Sprintf("PauseTaskListRequest{%v}", strings.Join(fields[:i], ", "))
+}
 
-			}
-		case 65:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.BacklogCountHint = &x
-				if err != nil {
-					return err
-				}
+// Equals returns true if all the fields of this PauseTaskListRequest match the
+// provided PauseTaskListRequest.
+//
+// This function performs a deep comparison.
+func (v *PauseTaskListRequest) Equals(rhs *PauseTaskListRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.PauseRequest == nil && rhs.PauseRequest == nil) || (v.PauseRequest != nil && rhs.PauseRequest != nil && v.PauseRequest.Equals(rhs.PauseRequest))) {
+		return false
+	}
 
-			}
-		case 70:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.StickyExecutionEnabled = &x
-				if err != nil {
-					return err
-				}
+	return true
+}
 
-			}
-		case 80:
-			if field.Value.Type() == wire.TStruct {
-				v.Query, err = _WorkflowQuery_Read(field.Value)
-				if err != nil {
-					return err
-				}
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PauseTaskListRequest.
+func (v *PauseTaskListRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.PauseRequest != nil {
+		err = multierr.Append(err, enc.AddObject("pauseRequest", v.PauseRequest))
+	}
+	return err
+}
 
-			}
-		case 90:
-			if field.Value.Type() == wire.TStruct {
-				v.DecisionInfo, err = _TransientDecisionInfo_Read(field.Value)
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *PauseTaskListRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// GetPauseRequest returns the value of PauseRequest if it is set or its
+// zero value if it is unset.
+func (v *PauseTaskListRequest) GetPauseRequest() (o *shared.PauseTaskListRequest) {
+	if v.PauseRequest != nil {
+		return v.PauseRequest
+	}
+
+	return
+}
+
+type PollForActivityTaskRequest struct {
+	DomainUUID    *string                            `json:"domainUUID,omitempty"`
+	PollerID      *string                            `json:"pollerID,omitempty"`
+	PollRequest   *shared.PollForActivityTaskRequest `json:"pollRequest,omitempty"`
+	ForwardedFrom *string                            `json:"forwardedFrom,omitempty"`
+}
+
+// ToWire translates a PollForActivityTaskRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.PollerID != nil {
+		w, err = wire.NewValueString(*(v.PollerID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 15, Value: w}
+		i++
+	}
+	if v.PollRequest != nil {
+		w, err = v.PollRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ForwardedFrom != nil {
+		w, err = wire.NewValueString(*(v.ForwardedFrom)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _PollForActivityTaskRequest_Read(w wire.Value) (*shared.PollForActivityTaskRequest, error) {
+	var v shared.PollForActivityTaskRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a PollForActivityTaskRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PollForActivityTaskRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PollForActivityTaskRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 100:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecutionTaskList, err = _TaskList_Read(field.Value)
+		case 15:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.PollerID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 110:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.EventStoreVersion = &x
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.PollRequest, err = _PollForActivityTaskRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 120:
+		case 30:
 			if field.Value.Type() == wire.TBinary {
-				v.BranchToken, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ForwardedFrom = &x
 				if err != nil {
 					return err
 				}
@@ -1867,135 +1931,55 @@ func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a PollForDecisionTaskResponse
+// String returns a readable string representation of a PollForActivityTaskRequest
 // struct.
-func (v *PollForDecisionTaskResponse) String() string {
+func (v *PollForActivityTaskRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [14]string
+	var fields [4]string
 	i := 0
-	if v.TaskToken != nil {
-		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
-		i++
-	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
-		i++
-	}
-	if v.WorkflowType != nil {
-		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
-		i++
-	}
-	if v.PreviousStartedEventId != nil {
-		fields[i] = fmt.Sprintf("PreviousStartedEventId: %v", *(v.PreviousStartedEventId))
-		i++
-	}
-	if v.StartedEventId != nil {
-		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
-		i++
-	}
-	if v.Attempt != nil {
-		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
-		i++
-	}
-	if v.NextEventId != nil {
-		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
-		i++
-	}
-	if v.BacklogCountHint != nil {
-		fields[i] = fmt.Sprintf("BacklogCountHint: %v", *(v.BacklogCountHint))
-		i++
-	}
-	if v.StickyExecutionEnabled != nil {
-		fields[i] = fmt.Sprintf("StickyExecutionEnabled: %v", *(v.StickyExecutionEnabled))
-		i++
-	}
-	if v.Query != nil {
-		fields[i] = fmt.Sprintf("Query: %v", v.Query)
-		i++
-	}
-	if v.DecisionInfo != nil {
-		fields[i] = fmt.Sprintf("DecisionInfo: %v", v.DecisionInfo)
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.WorkflowExecutionTaskList != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionTaskList: %v", v.WorkflowExecutionTaskList)
+	if v.PollerID != nil {
+		fields[i] = fmt.Sprintf("PollerID: %v", *(v.PollerID))
 		i++
 	}
-	if v.EventStoreVersion != nil {
-		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
+	if v.PollRequest != nil {
+		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
 		i++
 	}
-	if v.BranchToken != nil {
-		fields[i] = fmt.Sprintf("BranchToken: %v", v.BranchToken)
+	if v.ForwardedFrom != nil {
+		fields[i] = fmt.Sprintf("ForwardedFrom: %v", *(v.ForwardedFrom))
 		i++
 	}
 
-	return fmt.Sprintf("PollForDecisionTaskResponse{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _Bool_EqualsPtr(lhs, rhs *bool) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("PollForActivityTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PollForDecisionTaskResponse match the
-// provided PollForDecisionTaskResponse.
+// Equals returns true if all the fields of this PollForActivityTaskRequest match the
+// provided PollForActivityTaskRequest.
 //
 // This function performs a deep comparison.
-func (v *PollForDecisionTaskResponse) Equals(rhs *PollForDecisionTaskResponse) bool {
+func (v *PollForActivityTaskRequest) Equals(rhs *PollForActivityTaskRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
-		return false
-	}
-	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.PreviousStartedEventId, rhs.PreviousStartedEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.BacklogCountHint, rhs.BacklogCountHint) {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.StickyExecutionEnabled, rhs.StickyExecutionEnabled) {
-		return false
-	}
-	if !((v.Query == nil && rhs.Query == nil) || (v.Query != nil && rhs.Query != nil && v.Query.Equals(rhs.Query))) {
-		return false
-	}
-	if !((v.DecisionInfo == nil && rhs.DecisionInfo == nil) || (v.DecisionInfo != nil && rhs.DecisionInfo != nil && v.DecisionInfo.Equals(rhs.DecisionInfo))) {
-		return false
-	}
-	if !((v.WorkflowExecutionTaskList == nil && rhs.WorkflowExecutionTaskList == nil) || (v.WorkflowExecutionTaskList != nil && rhs.WorkflowExecutionTaskList != nil && v.WorkflowExecutionTaskList.Equals(rhs.WorkflowExecutionTaskList))) {
+	if !_String_EqualsPtr(v.PollerID, rhs.PollerID) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
+	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
 		return false
 	}
-	if !((v.BranchToken == nil && rhs.BranchToken == nil) || (v.BranchToken != nil && rhs.BranchToken != nil && bytes.Equal(v.BranchToken, rhs.BranchToken))) {
+	if !_String_EqualsPtr(v.ForwardedFrom, rhs.ForwardedFrom) {
 		return false
 	}
 
@@ -2003,203 +1987,1445 @@ func (v *PollForDecisionTaskResponse) Equals(rhs *PollForDecisionTaskResponse) b
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PollForDecisionTaskResponse.
-func (v *PollForDecisionTaskResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of PollForActivityTaskRequest.
+func (v *PollForActivityTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TaskToken != nil {
-		enc.AddString("taskToken", base64.StdEncoding.EncodeToString(v.TaskToken))
-	}
-	if v.WorkflowExecution != nil {
-		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
-	}
-	if v.WorkflowType != nil {
-		err = multierr.Append(err, enc.AddObject("workflowType", v.WorkflowType))
-	}
-	if v.PreviousStartedEventId != nil {
-		enc.AddInt64("previousStartedEventId", *v.PreviousStartedEventId)
-	}
-	if v.StartedEventId != nil {
-		enc.AddInt64("startedEventId", *v.StartedEventId)
-	}
-	if v.Attempt != nil {
-		enc.AddInt64("attempt", *v.Attempt)
-	}
-	if v.NextEventId != nil {
-		enc.AddInt64("nextEventId", *v.NextEventId)
-	}
-	if v.BacklogCountHint != nil {
-		enc.AddInt64("backlogCountHint", *v.BacklogCountHint)
-	}
-	if v.StickyExecutionEnabled != nil {
-		enc.AddBool("stickyExecutionEnabled", *v.StickyExecutionEnabled)
-	}
-	if v.Query != nil {
-		err = multierr.Append(err, enc.AddObject("query", v.Query))
-	}
-	if v.DecisionInfo != nil {
-		err = multierr.Append(err, enc.AddObject("decisionInfo", v.DecisionInfo))
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.WorkflowExecutionTaskList != nil {
-		err = multierr.Append(err, enc.AddObject("WorkflowExecutionTaskList", v.WorkflowExecutionTaskList))
+	if v.PollerID != nil {
+		enc.AddString("pollerID", *v.PollerID)
 	}
-	if v.EventStoreVersion != nil {
-		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+	if v.PollRequest != nil {
+		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
 	}
-	if v.BranchToken != nil {
-		enc.AddString("branchToken", base64.StdEncoding.EncodeToString(v.BranchToken))
+	if v.ForwardedFrom != nil {
+		enc.AddString("forwardedFrom", *v.ForwardedFrom)
 	}
 	return err
 }
 
-// GetTaskToken returns the value of TaskToken if it is set or its
+// GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetTaskToken() (o []byte) {
-	if v.TaskToken != nil {
-		return v.TaskToken
+func (v *PollForActivityTaskRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
 	}
 
 	return
 }
 
-// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// GetPollerID returns the value of PollerID if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetWorkflowExecution() (o *shared.WorkflowExecution) {
-	if v.WorkflowExecution != nil {
-		return v.WorkflowExecution
+func (v *PollForActivityTaskRequest) GetPollerID() (o string) {
+	if v.PollerID != nil {
+		return *v.PollerID
 	}
 
 	return
 }
 
-// GetWorkflowType returns the value of WorkflowType if it is set or its
+// GetPollRequest returns the value of PollRequest if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetWorkflowType() (o *shared.WorkflowType) {
-	if v.WorkflowType != nil {
-		return v.WorkflowType
+func (v *PollForActivityTaskRequest) GetPollRequest() (o *shared.PollForActivityTaskRequest) {
+	if v.PollRequest != nil {
+		return v.PollRequest
 	}
 
 	return
 }
 
-// GetPreviousStartedEventId returns the value of PreviousStartedEventId if it is set or its
+// GetForwardedFrom returns the value of ForwardedFrom if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetPreviousStartedEventId() (o int64) {
-	if v.PreviousStartedEventId != nil {
-		return *v.PreviousStartedEventId
+func (v *PollForActivityTaskRequest) GetForwardedFrom() (o string) {
+	if v.ForwardedFrom != nil {
+		return *v.ForwardedFrom
 	}
 
 	return
 }
 
-// GetStartedEventId returns the value of StartedEventId if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetStartedEventId() (o int64) {
-	if v.StartedEventId != nil {
-		return *v.StartedEventId
-	}
-
-	return
+type PollForDecisionTaskRequest struct {
+	DomainUUID    *string                            `json:"domainUUID,omitempty"`
+	PollerID      *string                            `json:"pollerID,omitempty"`
+	PollRequest   *shared.PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
+	ForwardedFrom *string                            `json:"forwardedFrom,omitempty"`
 }
 
-// GetAttempt returns the value of Attempt if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetAttempt() (o int64) {
-	if v.Attempt != nil {
-		return *v.Attempt
+// ToWire translates a PollForDecisionTaskRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.PollerID != nil {
+		w, err = wire.NewValueString(*(v.PollerID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 15, Value: w}
+		i++
+	}
+	if v.PollRequest != nil {
+		w, err = v.PollRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ForwardedFrom != nil {
+		w, err = wire.NewValueString(*(v.ForwardedFrom)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
 	}
 
-	return
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// GetNextEventId returns the value of NextEventId if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetNextEventId() (o int64) {
-	if v.NextEventId != nil {
-		return *v.NextEventId
+func _PollForDecisionTaskRequest_Read(w wire.Value) (*shared.PollForDecisionTaskRequest, error) {
+	var v shared.PollForDecisionTaskRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a PollForDecisionTaskRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PollForDecisionTaskRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PollForDecisionTaskRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 15:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.PollerID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.PollRequest, err = _PollForDecisionTaskRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ForwardedFrom = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
 	}
 
-	return
+	return nil
 }
 
-// GetBacklogCountHint returns the value of BacklogCountHint if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetBacklogCountHint() (o int64) {
-	if v.BacklogCountHint != nil {
+// String returns a readable string representation of a PollForDecisionTaskRequest
+// struct.
+func (v *PollForDecisionTaskRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.PollerID != nil {
+		fields[i] = fmt.Sprintf("PollerID: %v", *(v.PollerID))
+		i++
+	}
+	if v.PollRequest != nil {
+		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
+		i++
+	}
+	if v.ForwardedFrom != nil {
+		fields[i] = fmt.Sprintf("ForwardedFrom: %v", *(v.ForwardedFrom))
+		i++
+	}
+
+	return fmt.Sprintf("PollForDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PollForDecisionTaskRequest match the
+// provided PollForDecisionTaskRequest.
+//
+// This function performs a deep comparison.
+func (v *PollForDecisionTaskRequest) Equals(rhs *PollForDecisionTaskRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.PollerID, rhs.PollerID) {
+		return false
+	}
+	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ForwardedFrom, rhs.ForwardedFrom) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PollForDecisionTaskRequest.
+func (v *PollForDecisionTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.PollerID != nil {
+		enc.AddString("pollerID", *v.PollerID)
+	}
+	if v.PollRequest != nil {
+		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
+	}
+	if v.ForwardedFrom != nil {
+		enc.AddString("forwardedFrom", *v.ForwardedFrom)
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// GetPollerID returns the value of PollerID if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskRequest) GetPollerID() (o string) {
+	if v.PollerID != nil {
+		return *v.PollerID
+	}
+
+	return
+}
+
+// GetPollRequest returns the value of PollRequest if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskRequest) GetPollRequest() (o *shared.PollForDecisionTaskRequest) {
+	if v.PollRequest != nil {
+		return v.PollRequest
+	}
+
+	return
+}
+
+// GetForwardedFrom returns the value of ForwardedFrom if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskRequest) GetForwardedFrom() (o string) {
+	if v.ForwardedFrom != nil {
+		return *v.ForwardedFrom
+	}
+
+	return
+}
+
+type PollForDecisionTaskResponse struct {
+	TaskToken                 []byte                        `json:"taskToken,omitempty"`
+	WorkflowExecution         *shared.WorkflowExecution     `json:"workflowExecution,omitempty"`
+	WorkflowType              *shared.WorkflowType          `json:"workflowType,omitempty"`
+	PreviousStartedEventId    *int64                        `json:"previousStartedEventId,omitempty"`
+	StartedEventId            *int64                        `json:"startedEventId,omitempty"`
+	Attempt                   *int64                        `json:"attempt,omitempty"`
+	NextEventId               *int64                        `json:"nextEventId,omitempty"`
+	BacklogCountHint          *int64                        `json:"backlogCountHint,omitempty"`
+	StickyExecutionEnabled    *bool                         `json:"stickyExecutionEnabled,omitempty"`
+	Query                     *shared.WorkflowQuery         `json:"query,omitempty"`
+	DecisionInfo              *shared.TransientDecisionInfo `json:"decisionInfo,omitempty"`
+	WorkflowExecutionTaskList *shared.TaskList              `json:"WorkflowExecutionTaskList,omitempty"`
+	EventStoreVersion         *int32                        `json:"eventStoreVersion,omitempty"`
+	BranchToken               []byte                        `json:"branchToken,omitempty"`
+}
+
+// ToWire translates a PollForDecisionTaskResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PollForDecisionTaskResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [14]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TaskToken != nil {
+		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.WorkflowType != nil {
+		w, err = v.WorkflowType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.PreviousStartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.PreviousStartedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.StartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 51, Value: w}
+		i++
+	}
+	if v.NextEventId != nil {
+		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.BacklogCountHint != nil {
+		w, err = wire.NewValueI64(*(v.BacklogCountHint)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 65, Value: w}
+		i++
+	}
+	if v.StickyExecutionEnabled != nil {
+		w, err = wire.NewValueBool(*(v.StickyExecutionEnabled)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.Query != nil {
+		w, err = v.Query.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.DecisionInfo != nil {
+		w, err = v.DecisionInfo.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionTaskList != nil {
+		w, err = v.WorkflowExecutionTaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.BranchToken != nil {
+		w, err = wire.NewValueBinary(v.BranchToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _WorkflowType_Read(w wire.Value) (*shared.WorkflowType, error) {
+	var v shared.WorkflowType
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowQuery_Read(w wire.Value) (*shared.WorkflowQuery, error) {
+	var v shared.WorkflowQuery
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _TransientDecisionInfo_Read(w wire.Value) (*shared.TransientDecisionInfo, error) {
+	var v shared.TransientDecisionInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a PollForDecisionTaskResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PollForDecisionTaskResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PollForDecisionTaskResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.TaskToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowType, err = _WorkflowType_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.PreviousStartedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 51:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 65:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.BacklogCountHint = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.StickyExecutionEnabled = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TStruct {
+				v.Query, err = _WorkflowQuery_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TStruct {
+				v.DecisionInfo, err = _TransientDecisionInfo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionTaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.EventStoreVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TBinary {
+				v.BranchToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PollForDecisionTaskResponse
+// struct.
+func (v *PollForDecisionTaskResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [14]string
+	i := 0
+	if v.TaskToken != nil {
+		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.WorkflowType != nil {
+		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
+		i++
+	}
+	if v.PreviousStartedEventId != nil {
+		fields[i] = fmt.Sprintf("PreviousStartedEventId: %v", *(v.PreviousStartedEventId))
+		i++
+	}
+	if v.StartedEventId != nil {
+		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.NextEventId != nil {
+		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
+		i++
+	}
+	if v.BacklogCountHint != nil {
+		fields[i] = fmt.Sprintf("BacklogCountHint: %v", *(v.BacklogCountHint))
+		i++
+	}
+	if v.StickyExecutionEnabled != nil {
+		fields[i] = fmt.Sprintf("StickyExecutionEnabled: %v", *(v.StickyExecutionEnabled))
+		i++
+	}
+	if v.Query != nil {
+		fields[i] = fmt.Sprintf("Query: %v", v.Query)
+		i++
+	}
+	if v.DecisionInfo != nil {
+		fields[i] = fmt.Sprintf("DecisionInfo: %v", v.DecisionInfo)
+		i++
+	}
+	if v.WorkflowExecutionTaskList != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionTaskList: %v", v.WorkflowExecutionTaskList)
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
+		i++
+	}
+	if v.BranchToken != nil {
+		fields[i] = fmt.Sprintf("BranchToken: %v", v.BranchToken)
+		i++
+	}
+
+	return fmt.Sprintf("PollForDecisionTaskResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this PollForDecisionTaskResponse match the
+// provided PollForDecisionTaskResponse.
+//
+// This function performs a deep comparison.
+func (v *PollForDecisionTaskResponse) Equals(rhs *PollForDecisionTaskResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.PreviousStartedEventId, rhs.PreviousStartedEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.BacklogCountHint, rhs.BacklogCountHint) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.StickyExecutionEnabled, rhs.StickyExecutionEnabled) {
+		return false
+	}
+	if !((v.Query == nil && rhs.Query == nil) || (v.Query != nil && rhs.Query != nil && v.Query.Equals(rhs.Query))) {
+		return false
+	}
+	if !((v.DecisionInfo == nil && rhs.DecisionInfo == nil) || (v.DecisionInfo != nil && rhs.DecisionInfo != nil && v.DecisionInfo.Equals(rhs.DecisionInfo))) {
+		return false
+	}
+	if !((v.WorkflowExecutionTaskList == nil && rhs.WorkflowExecutionTaskList == nil) || (v.WorkflowExecutionTaskList != nil && rhs.WorkflowExecutionTaskList != nil && v.WorkflowExecutionTaskList.Equals(rhs.WorkflowExecutionTaskList))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
+		return false
+	}
+	if !((v.BranchToken == nil && rhs.BranchToken == nil) || (v.BranchToken != nil && rhs.BranchToken != nil && bytes.Equal(v.BranchToken, rhs.BranchToken))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PollForDecisionTaskResponse.
+func (v *PollForDecisionTaskResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TaskToken != nil {
+		enc.AddString("taskToken", base64.StdEncoding.EncodeToString(v.TaskToken))
+	}
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.WorkflowType != nil {
+		err = multierr.Append(err, enc.AddObject("workflowType", v.WorkflowType))
+	}
+	if v.PreviousStartedEventId != nil {
+		enc.AddInt64("previousStartedEventId", *v.PreviousStartedEventId)
+	}
+	if v.StartedEventId != nil {
+		enc.AddInt64("startedEventId", *v.StartedEventId)
+	}
+	if v.Attempt != nil {
+		enc.AddInt64("attempt", *v.Attempt)
+	}
+	if v.NextEventId != nil {
+		enc.AddInt64("nextEventId", *v.NextEventId)
+	}
+	if v.BacklogCountHint != nil {
+		enc.AddInt64("backlogCountHint", *v.BacklogCountHint)
+	}
+	if v.StickyExecutionEnabled != nil {
+		enc.AddBool("stickyExecutionEnabled", *v.StickyExecutionEnabled)
+	}
+	if v.Query != nil {
+		err = multierr.Append(err, enc.AddObject("query", v.Query))
+	}
+	if v.DecisionInfo != nil {
+		err = multierr.Append(err, enc.AddObject("decisionInfo", v.DecisionInfo))
+	}
+	if v.WorkflowExecutionTaskList != nil {
+		err = multierr.Append(err, enc.AddObject("WorkflowExecutionTaskList", v.WorkflowExecutionTaskList))
+	}
+	if v.EventStoreVersion != nil {
+		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+	}
+	if v.BranchToken != nil {
+		enc.AddString("branchToken", base64.StdEncoding.EncodeToString(v.BranchToken))
+	}
+	return err
+}
+
+// GetTaskToken returns the value of TaskToken if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetTaskToken() (o []byte) {
+	if v.TaskToken != nil {
+		return v.TaskToken
+	}
+
+	return
+}
+
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetWorkflowExecution() (o *shared.WorkflowExecution) {
+	if v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+
+	return
+}
+
+// GetWorkflowType returns the value of WorkflowType if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetWorkflowType() (o *shared.WorkflowType) {
+	if v.WorkflowType != nil {
+		return v.WorkflowType
+	}
+
+	return
+}
+
+// GetPreviousStartedEventId returns the value of PreviousStartedEventId if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetPreviousStartedEventId() (o int64) {
+	if v.PreviousStartedEventId != nil {
+		return *v.PreviousStartedEventId
+	}
+
+	return
+}
+
+// GetStartedEventId returns the value of StartedEventId if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetStartedEventId() (o int64) {
+	if v.StartedEventId != nil {
+		return *v.StartedEventId
+	}
+
+	return
+}
+
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetAttempt() (o int64) {
+	if v.Attempt != nil {
+		return *v.Attempt
+	}
+
+	return
+}
+
+// GetNextEventId returns the value of NextEventId if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetNextEventId() (o int64) {
+	if v.NextEventId != nil {
+		return *v.NextEventId
+	}
+
+	return
+}
+
+// GetBacklogCountHint returns the value of BacklogCountHint if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetBacklogCountHint() (o int64) {
+	if v.BacklogCountHint != nil {
 		return *v.BacklogCountHint
 	}
-
-	return
-}
-
-// GetStickyExecutionEnabled returns the value of StickyExecutionEnabled if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetStickyExecutionEnabled() (o bool) {
-	if v.StickyExecutionEnabled != nil {
-		return *v.StickyExecutionEnabled
+
+	return
+}
+
+// GetStickyExecutionEnabled returns the value of StickyExecutionEnabled if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetStickyExecutionEnabled() (o bool) {
+	if v.StickyExecutionEnabled != nil {
+		return *v.StickyExecutionEnabled
+	}
+
+	return
+}
+
+// GetQuery returns the value of Query if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetQuery() (o *shared.WorkflowQuery) {
+	if v.Query != nil {
+		return v.Query
+	}
+
+	return
+}
+
+// GetDecisionInfo returns the value of DecisionInfo if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetDecisionInfo() (o *shared.TransientDecisionInfo) {
+	if v.DecisionInfo != nil {
+		return v.DecisionInfo
+	}
+
+	return
+}
+
+// GetWorkflowExecutionTaskList returns the value of WorkflowExecutionTaskList if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetWorkflowExecutionTaskList() (o *shared.TaskList) {
+	if v.WorkflowExecutionTaskList != nil {
+		return v.WorkflowExecutionTaskList
+	}
+
+	return
+}
+
+// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetEventStoreVersion() (o int32) {
+	if v.EventStoreVersion != nil {
+		return *v.EventStoreVersion
+	}
+
+	return
+}
+
+// GetBranchToken returns the value of BranchToken if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetBranchToken() (o []byte) {
+	if v.BranchToken != nil {
+		return v.BranchToken
+	}
+
+	return
+}
+
+type QueryWorkflowRequest struct {
+	DomainUUID   *string                      `json:"domainUUID,omitempty"`
+	TaskList     *shared.TaskList             `json:"taskList,omitempty"`
+	QueryRequest *shared.QueryWorkflowRequest `json:"queryRequest,omitempty"`
+}
+
+// ToWire translates a QueryWorkflowRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *QueryWorkflowRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.QueryRequest != nil {
+		w, err = v.QueryRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _QueryWorkflowRequest_Read(w wire.Value) (*shared.QueryWorkflowRequest, error) {
+	var v shared.QueryWorkflowRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a QueryWorkflowRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a QueryWorkflowRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v QueryWorkflowRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *QueryWorkflowRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.QueryRequest, err = _QueryWorkflowRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a QueryWorkflowRequest
+// struct.
+func (v *QueryWorkflowRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.QueryRequest != nil {
+		fields[i] = fmt.Sprintf("QueryRequest: %v", v.QueryRequest)
+		i++
+	}
+
+	return fmt.Sprintf("QueryWorkflowRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this QueryWorkflowRequest match the
+// provided QueryWorkflowRequest.
+//
+// This function performs a deep comparison.
+func (v *QueryWorkflowRequest) Equals(rhs *QueryWorkflowRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !((v.QueryRequest == nil && rhs.QueryRequest == nil) || (v.QueryRequest != nil && rhs.QueryRequest != nil && v.QueryRequest.Equals(rhs.QueryRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of QueryWorkflowRequest.
+func (v *QueryWorkflowRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.QueryRequest != nil {
+		err = multierr.Append(err, enc.AddObject("queryRequest", v.QueryRequest))
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *QueryWorkflowRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *QueryWorkflowRequest) GetTaskList() (o *shared.TaskList) {
+	if v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// GetQueryRequest returns the value of QueryRequest if it is set or its
+// zero value if it is unset.
+func (v *QueryWorkflowRequest) GetQueryRequest() (o *shared.QueryWorkflowRequest) {
+	if v.QueryRequest != nil {
+		return v.QueryRequest
+	}
+
+	return
+}
+
+type RespondQueryTaskCompletedRequest struct {
+	DomainUUID       *string                                  `json:"domainUUID,omitempty"`
+	TaskList         *shared.TaskList                         `json:"taskList,omitempty"`
+	TaskID           *string                                  `json:"taskID,omitempty"`
+	CompletedRequest *shared.RespondQueryTaskCompletedRequest `json:"completedRequest,omitempty"`
+}
+
+// ToWire translates a RespondQueryTaskCompletedRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RespondQueryTaskCompletedRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.TaskID != nil {
+		w, err = wire.NewValueString(*(v.TaskID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.CompletedRequest != nil {
+		w, err = v.CompletedRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _RespondQueryTaskCompletedRequest_Read(w wire.Value) (*shared.RespondQueryTaskCompletedRequest, error) {
+	var v shared.RespondQueryTaskCompletedRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a RespondQueryTaskCompletedRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RespondQueryTaskCompletedRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RespondQueryTaskCompletedRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TaskID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.CompletedRequest, err = _RespondQueryTaskCompletedRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RespondQueryTaskCompletedRequest
+// struct.
+func (v *RespondQueryTaskCompletedRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.TaskID != nil {
+		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
+		i++
+	}
+	if v.CompletedRequest != nil {
+		fields[i] = fmt.Sprintf("CompletedRequest: %v", v.CompletedRequest)
+		i++
+	}
+
+	return fmt.Sprintf("RespondQueryTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RespondQueryTaskCompletedRequest match the
+// provided RespondQueryTaskCompletedRequest.
+//
+// This function performs a deep comparison.
+func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompletedRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.TaskID, rhs.TaskID) {
+		return false
+	}
+	if !((v.CompletedRequest == nil && rhs.CompletedRequest == nil) || (v.CompletedRequest != nil && rhs.CompletedRequest != nil && v.CompletedRequest.Equals(rhs.CompletedRequest))) {
+		return false
 	}
 
-	return
+	return true
 }
 
-// GetQuery returns the value of Query if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetQuery() (o *shared.WorkflowQuery) {
-	if v.Query != nil {
-		return v.Query
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RespondQueryTaskCompletedRequest.
+func (v *RespondQueryTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
 	}
-
-	return
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.TaskID != nil {
+		enc.AddString("taskID", *v.TaskID)
+	}
+	if v.CompletedRequest != nil {
+		err = multierr.Append(err, enc.AddObject("completedRequest", v.CompletedRequest))
+	}
+	return err
 }
 
-// GetDecisionInfo returns the value of DecisionInfo if it is set or its
+// GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetDecisionInfo() (o *shared.TransientDecisionInfo) {
-	if v.DecisionInfo != nil {
-		return v.DecisionInfo
+func (v *RespondQueryTaskCompletedRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
 	}
 
 	return
 }
 
-// GetWorkflowExecutionTaskList returns the value of WorkflowExecutionTaskList if it is set or its
+// GetTaskList returns the value of TaskList if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetWorkflowExecutionTaskList() (o *shared.TaskList) {
-	if v.WorkflowExecutionTaskList != nil {
-		return v.WorkflowExecutionTaskList
+func (v *RespondQueryTaskCompletedRequest) GetTaskList() (o *shared.TaskList) {
+	if v.TaskList != nil {
+		return v.TaskList
 	}
 
 	return
 }
 
-// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
+// GetTaskID returns the value of TaskID if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetEventStoreVersion() (o int32) {
-	if v.EventStoreVersion != nil {
-		return *v.EventStoreVersion
+func (v *RespondQueryTaskCompletedRequest) GetTaskID() (o string) {
+	if v.TaskID != nil {
+		return *v.TaskID
 	}
 
 	return
 }
 
-// GetBranchToken returns the value of BranchToken if it is set or its
+// GetCompletedRequest returns the value of CompletedRequest if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetBranchToken() (o []byte) {
-	if v.BranchToken != nil {
-		return v.BranchToken
+func (v *RespondQueryTaskCompletedRequest) GetCompletedRequest() (o *shared.RespondQueryTaskCompletedRequest) {
+	if v.CompletedRequest != nil {
+		return v.CompletedRequest
 	}
 
 	return
 }
 
-type QueryWorkflowRequest struct {
-	DomainUUID   *string                      `json:"domainUUID,omitempty"`
-	TaskList     *shared.TaskList             `json:"taskList,omitempty"`
-	QueryRequest *shared.QueryWorkflowRequest `json:"queryRequest,omitempty"`
+type ResumeTaskListRequest struct {
+	DomainUUID    *string                       `json:"domainUUID,omitempty"`
+	ResumeRequest *shared.ResumeTaskListRequest `json:"resumeRequest,omitempty"`
 }
 
-// ToWire translates a QueryWorkflowRequest struct into a Thrift-level intermediate
+// ToWire translates a ResumeTaskListRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2214,9 +3440,9 @@ type QueryWorkflowRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *QueryWorkflowRequest) ToWire() (wire.Value, error) {
+func (v *ResumeTaskListRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -2230,37 +3456,29 @@ func (v *QueryWorkflowRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
+	if v.ResumeRequest != nil {
+		w, err = v.ResumeRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.QueryRequest != nil {
-		w, err = v.QueryRequest.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _QueryWorkflowRequest_Read(w wire.Value) (*shared.QueryWorkflowRequest, error) {
-	var v shared.QueryWorkflowRequest
+func _ResumeTaskListRequest_Read(w wire.Value) (*shared.ResumeTaskListRequest, error) {
+	var v shared.ResumeTaskListRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a QueryWorkflowRequest struct from its Thrift-level
+// FromWire deserializes a ResumeTaskListRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a QueryWorkflowRequest struct
+// An error is returned if we were unable to build a ResumeTaskListRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2268,12 +3486,12 @@ func _QueryWorkflowRequest_Read(w wire.Value) (*shared.QueryWorkflowRequest, err
 //     return nil, err
 //   }
 //
-//   var v QueryWorkflowRequest
+//   var v ResumeTaskListRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *QueryWorkflowRequest) FromWire(w wire.Value) error {
+func (v *ResumeTaskListRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -2290,15 +3508,7 @@ func (v *QueryWorkflowRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.TaskList, err = _TaskList_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.QueryRequest, err = _QueryWorkflowRequest_Read(field.Value)
+				v.ResumeRequest, err = _ResumeTaskListRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -2310,36 +3520,32 @@ func (v *QueryWorkflowRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a QueryWorkflowRequest
+// String returns a readable string representation of a ResumeTaskListRequest
 // struct.
-func (v *QueryWorkflowRequest) String() string {
+func (v *ResumeTaskListRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [2]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.TaskList != nil {
-		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
-		i++
-	}
-	if v.QueryRequest != nil {
-		fields[i] = fmt.Sprintf("QueryRequest: %v", v.QueryRequest)
+	if v.ResumeRequest != nil {
+		fields[i] = fmt.Sprintf("ResumeRequest: %v", v.ResumeRequest)
 		i++
 	}
 
-	return fmt.Sprintf("QueryWorkflowRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ResumeTaskListRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this QueryWorkflowRequest match the
-// provided QueryWorkflowRequest.
+// Equals returns true if all the fields of this ResumeTaskListRequest match the
+// provided ResumeTaskListRequest.
 //
 // This function performs a deep comparison.
-func (v *QueryWorkflowRequest) Equals(rhs *QueryWorkflowRequest) bool {
+func (v *ResumeTaskListRequest) Equals(rhs *ResumeTaskListRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -2348,10 +3554,7 @@ func (v *QueryWorkflowRequest) Equals(rhs *QueryWorkflowRequest) bool {
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
-		return false
-	}
-	if !((v.QueryRequest == nil && rhs.QueryRequest == nil) || (v.QueryRequest != nil && rhs.QueryRequest != nil && v.QueryRequest.Equals(rhs.QueryRequest))) {
+	if !((v.ResumeRequest == nil && rhs.ResumeRequest == nil) || (v.ResumeRequest != nil && rhs.ResumeRequest != nil && v.ResumeRequest.Equals(rhs.ResumeRequest))) {
 		return false
 	}
 
@@ -2359,26 +3562,23 @@ func (v *QueryWorkflowRequest) Equals(rhs *QueryWorkflowRequest) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of QueryWorkflowRequest.
-func (v *QueryWorkflowRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ResumeTaskListRequest.
+func (v *ResumeTaskListRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.DomainUUID != nil {
 		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.TaskList != nil {
-		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
-	}
-	if v.QueryRequest != nil {
-		err = multierr.Append(err, enc.AddObject("queryRequest", v.QueryRequest))
+	if v.ResumeRequest != nil {
+		err = multierr.Append(err, enc.AddObject("resumeRequest", v.ResumeRequest))
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *QueryWorkflowRequest) GetDomainUUID() (o string) {
+func (v *ResumeTaskListRequest) GetDomainUUID() (o string) {
 	if v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -2386,34 +3586,22 @@ func (v *QueryWorkflowRequest) GetDomainUUID() (o string) {
 	return
 }
 
-// GetTaskList returns the value of TaskList if it is set or its
-// zero value if it is unset.
-func (v *QueryWorkflowRequest) GetTaskList() (o *shared.TaskList) {
-	if v.TaskList != nil {
-		return v.TaskList
-	}
-
-	return
-}
-
-// GetQueryRequest returns the value of QueryRequest if it is set or its
+// GetResumeRequest returns the value of ResumeRequest if it is set or its
 // zero value if it is unset.
-func (v *QueryWorkflowRequest) GetQueryRequest() (o *shared.QueryWorkflowRequest) {
-	if v.QueryRequest != nil {
-		return v.QueryRequest
+func (v *ResumeTaskListRequest) GetResumeRequest() (o *shared.ResumeTaskListRequest) {
+	if v.ResumeRequest != nil {
+		return v.ResumeRequest
 	}
 
 	return
 }
 
-type RespondQueryTaskCompletedRequest struct {
-	DomainUUID       *string                                  `json:"domainUUID,omitempty"`
-	TaskList         *shared.TaskList                         `json:"taskList,omitempty"`
-	TaskID           *string                                  `json:"taskID,omitempty"`
-	CompletedRequest *shared.RespondQueryTaskCompletedRequest `json:"completedRequest,omitempty"`
+type UpdateTaskListDispatchRateRequest struct {
+	DomainUUID    *string                                   `json:"domainUUID,omitempty"`
+	UpdateRequest *shared.UpdateTaskListDispatchRateRequest `json:"updateRequest,omitempty"`
 }
 
-// ToWire translates a RespondQueryTaskCompletedRequest struct into a Thrift-level intermediate
+// ToWire translates a UpdateTaskListDispatchRateRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2428,9 +3616,9 @@ type RespondQueryTaskCompletedRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondQueryTaskCompletedRequest) ToWire() (wire.Value, error) {
+func (v *UpdateTaskListDispatchRateRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -2444,45 +3632,29 @@ func (v *RespondQueryTaskCompletedRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
+	if v.UpdateRequest != nil {
+		w, err = v.UpdateRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.TaskID != nil {
-		w, err = wire.NewValueString(*(v.TaskID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.CompletedRequest != nil {
-		w, err = v.CompletedRequest.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RespondQueryTaskCompletedRequest_Read(w wire.Value) (*shared.RespondQueryTaskCompletedRequest, error) {
-	var v shared.RespondQueryTaskCompletedRequest
+func _UpdateTaskListDispatchRateRequest_Read(w wire.Value) (*shared.UpdateTaskListDispatchRateRequest, error) {
+	var v shared.UpdateTaskListDispatchRateRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a RespondQueryTaskCompletedRequest struct from its Thrift-level
+// FromWire deserializes a UpdateTaskListDispatchRateRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondQueryTaskCompletedRequest struct
+// An error is returned if we were unable to build a UpdateTaskListDispatchRateRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2490,12 +3662,12 @@ func _RespondQueryTaskCompletedRequest_Read(w wire.Value) (*shared.RespondQueryT
 //     return nil, err
 //   }
 //
-//   var v RespondQueryTaskCompletedRequest
+//   var v UpdateTaskListDispatchRateRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
+func (v *UpdateTaskListDispatchRateRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -2512,25 +3684,7 @@ func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.TaskList, err = _TaskList_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.TaskID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.CompletedRequest, err = _RespondQueryTaskCompletedRequest_Read(field.Value)
+				v.UpdateRequest, err = _UpdateTaskListDispatchRateRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -2542,40 +3696,32 @@ func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondQueryTaskCompletedRequest
+// String returns a readable string representation of a UpdateTaskListDispatchRateRequest
 // struct.
-func (v *RespondQueryTaskCompletedRequest) String() string {
+func (v *UpdateTaskListDispatchRateRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [2]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.TaskList != nil {
-		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
-		i++
-	}
-	if v.TaskID != nil {
-		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
-		i++
-	}
-	if v.CompletedRequest != nil {
-		fields[i] = fmt.Sprintf("CompletedRequest: %v", v.CompletedRequest)
+	if v.UpdateRequest != nil {
+		fields[i] = fmt.Sprintf("UpdateRequest: %v", v.UpdateRequest)
 		i++
 	}
 
-	return fmt.Sprintf("RespondQueryTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("UpdateTaskListDispatchRateRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondQueryTaskCompletedRequest match the
-// provided RespondQueryTaskCompletedRequest.
+// Equals returns true if all the fields of this UpdateTaskListDispatchRateRequest match the
+// provided UpdateTaskListDispatchRateRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompletedRequest) bool {
+func (v *UpdateTaskListDispatchRateRequest) Equals(rhs *UpdateTaskListDispatchRateRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -2584,13 +3730,7 @@ func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompleted
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.TaskID, rhs.TaskID) {
-		return false
-	}
-	if !((v.CompletedRequest == nil && rhs.CompletedRequest == nil) || (v.CompletedRequest != nil && rhs.CompletedRequest != nil && v.CompletedRequest.Equals(rhs.CompletedRequest))) {
+	if !((v.UpdateRequest == nil && rhs.UpdateRequest == nil) || (v.UpdateRequest != nil && rhs.UpdateRequest != nil && v.UpdateRequest.Equals(rhs.UpdateRequest))) {
 		return false
 	}
 
@@ -2598,29 +3738,23 @@ func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompleted
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondQueryTaskCompletedRequest.
-func (v *RespondQueryTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of UpdateTaskListDispatchRateRequest.
+func (v *UpdateTaskListDispatchRateRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.DomainUUID != nil {
 		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.TaskList != nil {
-		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
-	}
-	if v.TaskID != nil {
-		enc.AddString("taskID", *v.TaskID)
-	}
-	if v.CompletedRequest != nil {
-		err = multierr.Append(err, enc.AddObject("completedRequest", v.CompletedRequest))
+	if v.UpdateRequest != nil {
+		err = multierr.Append(err, enc.AddObject("updateRequest", v.UpdateRequest))
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetDomainUUID() (o string) {
+func (v *UpdateTaskListDispatchRateRequest) GetDomainUUID() (o string) {
 	if v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -2628,31 +3762,11 @@ func (v *RespondQueryTaskCompletedRequest) GetDomainUUID() (o string) {
 	return
 }
 
-// GetTaskList returns the value of TaskList if it is set or its
-// zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetTaskList() (o *shared.TaskList) {
-	if v.TaskList != nil {
-		return v.TaskList
-	}
-
-	return
-}
-
-// GetTaskID returns the value of TaskID if it is set or its
-// zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetTaskID() (o string) {
-	if v.TaskID != nil {
-		return *v.TaskID
-	}
-
-	return
-}
-
-// GetCompletedRequest returns the value of CompletedRequest if it is set or its
+// GetUpdateRequest returns the value of UpdateRequest if it is set or its
 // zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetCompletedRequest() (o *shared.RespondQueryTaskCompletedRequest) {
-	if v.CompletedRequest != nil {
-		return v.CompletedRequest
+func (v *UpdateTaskListDispatchRateRequest) GetUpdateRequest() (o *shared.UpdateTaskListDispatchRateRequest) {
+	if v.UpdateRequest != nil {
+		return v.UpdateRequest
 	}
 
 	return
@@ -37,188 +37,38 @@ import (
 	"strings"
 )
 
-type DomainOperation int32
-
-const (
-	DomainOperationCreate DomainOperation = 0
-	DomainOperationUpdate DomainOperation = 1
-)
-
-// DomainOperation_Values returns all recognized values of DomainOperation.
-func DomainOperation_Values() []DomainOperation {
-	return []DomainOperation{
-		DomainOperationCreate,
-		DomainOperationUpdate,
-	}
+type DescribeReplicationStatusRequest struct {
+	ShardIDs []int32 `json:"shardIDs,omitempty"`
+	Domain   *string `json:"domain,omitempty"`
 }
 
-// UnmarshalText tries to decode DomainOperation from a byte slice
-// containing its name.
-//
-//   var v DomainOperation
-//   err := v.UnmarshalText([]byte("Create"))
-func (v *DomainOperation) UnmarshalText(value []byte) error {
-	switch s := string(value); s {
-	case "Create":
-		*v = DomainOperationCreate
-		return nil
-	case "Update":
-		*v = DomainOperationUpdate
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
+type _List_I32_ValueList []int32
+
+func (v _List_I32_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueI32(x), error(nil)
 		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "DomainOperation", err)
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
 		}
-		*v = DomainOperation(val)
-		return nil
-	}
-}
-
-// MarshalText encodes DomainOperation to text.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
-//
-// This implements the TextMarshaler interface.
-func (v DomainOperation) MarshalText() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return []byte("Create"), nil
-	case 1:
-		return []byte("Update"), nil
-	}
-	return []byte(strconv.FormatInt(int64(v), 10)), nil
-}
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DomainOperation.
-// Enums are logged as objects, where the value is logged with key "value", and
-// if this value's name is known, the name is logged with key "name".
-func (v DomainOperation) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddInt32("value", int32(v))
-	switch int32(v) {
-	case 0:
-		enc.AddString("name", "Create")
-	case 1:
-		enc.AddString("name", "Update")
 	}
 	return nil
 }
 
-// Ptr returns a pointer to this enum value.
-func (v DomainOperation) Ptr() *DomainOperation {
-	return &v
-}
-
-// ToWire translates DomainOperation into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// Enums are represented as 32-bit integers over the wire.
-func (v DomainOperation) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
-}
-
-// FromWire deserializes DomainOperation from its Thrift-level
-// representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
-//   if err != nil {
-//     return DomainOperation(0), err
-//   }
-//
-//   var v DomainOperation
-//   if err := v.FromWire(x); err != nil {
-//     return DomainOperation(0), err
-//   }
-//   return v, nil
-func (v *DomainOperation) FromWire(w wire.Value) error {
-	*v = (DomainOperation)(w.GetI32())
-	return nil
-}
-
-// String returns a readable string representation of DomainOperation.
-func (v DomainOperation) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "Create"
-	case 1:
-		return "Update"
-	}
-	return fmt.Sprintf("DomainOperation(%d)", w)
-}
-
-// Equals returns true if this DomainOperation value matches the provided
-// value.
-func (v DomainOperation) Equals(rhs DomainOperation) bool {
-	return v == rhs
-}
-
-// MarshalJSON serializes DomainOperation into JSON.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
-//
-// This implements json.Marshaler.
-func (v DomainOperation) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"Create\""), nil
-	case 1:
-		return ([]byte)("\"Update\""), nil
-	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+func (v _List_I32_ValueList) Size() int {
+	return len(v)
 }
 
-// UnmarshalJSON attempts to decode DomainOperation from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *DomainOperation) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
-	}
-
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
-		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "DomainOperation")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "DomainOperation")
-		}
-		*v = (DomainOperation)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "DomainOperation")
-	}
+func (_List_I32_ValueList) ValueType() wire.Type {
+	return wire.TI32
 }
 
-type DomainTaskAttributes struct {
-	DomainOperation   *DomainOperation                       `json:"domainOperation,omitempty"`
-	ID                *string                                `json:"id,omitempty"`
-	Info              *shared.DomainInfo                     `json:"info,omitempty"`
-	Config            *shared.DomainConfiguration            `json:"config,omitempty"`
-	ReplicationConfig *shared.DomainReplicationConfiguration `json:"replicationConfig,omitempty"`
-	ConfigVersion     *int64                                 `json:"configVersion,omitempty"`
-	FailoverVersion   *int64                                 `json:"failoverVersion,omitempty"`
-}
+func (_List_I32_ValueList) Close() {}
 
-// ToWire translates a DomainTaskAttributes struct into a Thrift-level intermediate
+// ToWire translates a DescribeReplicationStatusRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -233,103 +83,57 @@ type DomainTaskAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DomainTaskAttributes) ToWire() (wire.Value, error) {
+func (v *DescribeReplicationStatusRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainOperation != nil {
-		w, err = v.DomainOperation.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
-		i++
-	}
-	if v.ID != nil {
-		w, err = wire.NewValueString(*(v.ID)), error(nil)
+	if v.ShardIDs != nil {
+		w, err = wire.NewValueList(_List_I32_ValueList(v.ShardIDs)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Info != nil {
-		w, err = v.Info.ToWire()
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Config != nil {
-		w, err = v.Config.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.ReplicationConfig != nil {
-		w, err = v.ReplicationConfig.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.ConfigVersion != nil {
-		w, err = wire.NewValueI64(*(v.ConfigVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.FailoverVersion != nil {
-		w, err = wire.NewValueI64(*(v.FailoverVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DomainOperation_Read(w wire.Value) (DomainOperation, error) {
-	var v DomainOperation
-	err := v.FromWire(w)
-	return v, err
-}
-
-func _DomainInfo_Read(w wire.Value) (*shared.DomainInfo, error) {
-	var v shared.DomainInfo
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _DomainConfiguration_Read(w wire.Value) (*shared.DomainConfiguration, error) {
-	var v shared.DomainConfiguration
-	err := v.FromWire(w)
-	return &v, err
-}
+func _List_I32_Read(l wire.ValueList) ([]int32, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
 
-func _DomainReplicationConfiguration_Read(w wire.Value) (*shared.DomainReplicationConfiguration, error) {
-	var v shared.DomainReplicationConfiguration
-	err := v.FromWire(w)
-	return &v, err
+	o := make([]int32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
 }
 
-// FromWire deserializes a DomainTaskAttributes struct from its Thrift-level
+// FromWire deserializes a DescribeReplicationStatusRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DomainTaskAttributes struct
+// An error is returned if we were unable to build a DescribeReplicationStatusRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -337,75 +141,29 @@ func _DomainReplicationConfiguration_Read(w wire.Value) (*shared.DomainReplicati
 //     return nil, err
 //   }
 //
-//   var v DomainTaskAttributes
+//   var v DescribeReplicationStatusRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DomainTaskAttributes) FromWire(w wire.Value) error {
+func (v *DescribeReplicationStatusRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 5:
-			if field.Value.Type() == wire.TI32 {
-				var x DomainOperation
-				x, err = _DomainOperation_Read(field.Value)
-				v.DomainOperation = &x
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.ShardIDs, err = _List_I32_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
 
 			}
-		case 10:
+		case 20:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.ID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Info, err = _DomainInfo_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.Config, err = _DomainConfiguration_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.ReplicationConfig, err = _DomainReplicationConfiguration_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ConfigVersion = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.FailoverVersion = &x
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
@@ -417,233 +175,150 @@ func (v *DomainTaskAttributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DomainTaskAttributes
+// String returns a readable string representation of a DescribeReplicationStatusRequest
 // struct.
-func (v *DomainTaskAttributes) String() string {
+func (v *DescribeReplicationStatusRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [2]string
 	i := 0
-	if v.DomainOperation != nil {
-		fields[i] = fmt.Sprintf("DomainOperation: %v", *(v.DomainOperation))
-		i++
-	}
-	if v.ID != nil {
-		fields[i] = fmt.Sprintf("ID: %v", *(v.ID))
-		i++
-	}
-	if v.Info != nil {
-		fields[i] = fmt.Sprintf("Info: %v", v.Info)
-		i++
-	}
-	if v.Config != nil {
-		fields[i] = fmt.Sprintf("Config: %v", v.Config)
-		i++
-	}
-	if v.ReplicationConfig != nil {
-		fields[i] = fmt.Sprintf("ReplicationConfig: %v", v.ReplicationConfig)
-		i++
-	}
-	if v.ConfigVersion != nil {
-		fields[i] = fmt.Sprintf("ConfigVersion: %v", *(v.ConfigVersion))
+	if v.ShardIDs != nil {
+		fields[i] = fmt.Sprintf("ShardIDs: %v", v.ShardIDs)
 		i++
 	}
-	if v.FailoverVersion != nil {
-		fields[i] = fmt.Sprintf("FailoverVersion: %v", *(v.FailoverVersion))
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
 
-	return fmt.Sprintf("DomainTaskAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DescribeReplicationStatusRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _DomainOperation_EqualsPtr(lhs, rhs *DomainOperation) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
+func _List_I32_Equals(lhs, rhs []int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
 	}
-	return lhs == nil && rhs == nil
-}
-
-func _String_EqualsPtr(lhs, rhs *string) bool {
-	if lhs != nil && rhs != nil {
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
 	}
-	return lhs == nil && rhs == nil
-}
 
-func _I64_EqualsPtr(lhs, rhs *int64) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
+	return true
 }
 
-// Equals returns true if all the fields of this DomainTaskAttributes match the
-// provided DomainTaskAttributes.
+// Equals returns true if all the fields of this DescribeReplicationStatusRequest match the
+// provided DescribeReplicationStatusRequest.
 //
 // This function performs a deep comparison.
-func (v *DomainTaskAttributes) Equals(rhs *DomainTaskAttributes) bool {
+func (v *DescribeReplicationStatusRequest) Equals(rhs *DescribeReplicationStatusRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_DomainOperation_EqualsPtr(v.DomainOperation, rhs.DomainOperation) {
-		return false
-	}
-	if !_String_EqualsPtr(v.ID, rhs.ID) {
-		return false
-	}
-	if !((v.Info == nil && rhs.Info == nil) || (v.Info != nil && rhs.Info != nil && v.Info.Equals(rhs.Info))) {
-		return false
-	}
-	if !((v.Config == nil && rhs.Config == nil) || (v.Config != nil && rhs.Config != nil && v.Config.Equals(rhs.Config))) {
-		return false
-	}
-	if !((v.ReplicationConfig == nil && rhs.ReplicationConfig == nil) || (v.ReplicationConfig != nil && rhs.ReplicationConfig != nil && v.ReplicationConfig.Equals(rhs.ReplicationConfig))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ConfigVersion, rhs.ConfigVersion) {
+	if !((v.ShardIDs == nil && rhs.ShardIDs == nil) || (v.ShardIDs != nil && rhs.ShardIDs != nil && _List_I32_Equals(v.ShardIDs, rhs.ShardIDs))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.FailoverVersion, rhs.FailoverVersion) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
 
 	return true
 }
 
+type _List_I32_Zapper []int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_I32_Zapper.
+func (l _List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendInt32(v)
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DomainTaskAttributes.
-func (v *DomainTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DescribeReplicationStatusRequest.
+func (v *DescribeReplicationStatusRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.DomainOperation != nil {
-		err = multierr.Append(err, enc.AddObject("domainOperation", *v.DomainOperation))
-	}
-	if v.ID != nil {
-		enc.AddString("id", *v.ID)
-	}
-	if v.Info != nil {
-		err = multierr.Append(err, enc.AddObject("info", v.Info))
-	}
-	if v.Config != nil {
-		err = multierr.Append(err, enc.AddObject("config", v.Config))
-	}
-	if v.ReplicationConfig != nil {
-		err = multierr.Append(err, enc.AddObject("replicationConfig", v.ReplicationConfig))
-	}
-	if v.ConfigVersion != nil {
-		enc.AddInt64("configVersion", *v.ConfigVersion)
+	if v.ShardIDs != nil {
+		err = multierr.Append(err, enc.AddArray("shardIDs", (_List_I32_Zapper)(v.ShardIDs)))
 	}
-	if v.FailoverVersion != nil {
-		enc.AddInt64("failoverVersion", *v.FailoverVersion)
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
 	}
 	return err
 }
 
-// GetDomainOperation returns the value of DomainOperation if it is set or its
+// GetShardIDs returns the value of ShardIDs if it is set or its
 // zero value if it is unset.
-func (v *DomainTaskAttributes) GetDomainOperation() (o DomainOperation) {
-	if v.DomainOperation != nil {
-		return *v.DomainOperation
+func (v *DescribeReplicationStatusRequest) GetShardIDs() (o []int32) {
+	if v.ShardIDs != nil {
+		return v.ShardIDs
 	}
 
 	return
 }
 
-// GetID returns the value of ID if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *DomainTaskAttributes) GetID() (o string) {
-	if v.ID != nil {
-		return *v.ID
+func (v *DescribeReplicationStatusRequest) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// GetInfo returns the value of Info if it is set or its
-// zero value if it is unset.
-func (v *DomainTaskAttributes) GetInfo() (o *shared.DomainInfo) {
-	if v.Info != nil {
-		return v.Info
-	}
-
-	return
+type DescribeReplicationStatusResponse struct {
+	Shards  []*ShardReplicationStatus  `json:"shards,omitempty"`
+	Domains []*DomainReplicationStatus `json:"domains,omitempty"`
 }
 
-// GetConfig returns the value of Config if it is set or its
-// zero value if it is unset.
-func (v *DomainTaskAttributes) GetConfig() (o *shared.DomainConfiguration) {
-	if v.Config != nil {
-		return v.Config
-	}
-
-	return
-}
+type _List_ShardReplicationStatus_ValueList []*ShardReplicationStatus
 
-// GetReplicationConfig returns the value of ReplicationConfig if it is set or its
-// zero value if it is unset.
-func (v *DomainTaskAttributes) GetReplicationConfig() (o *shared.DomainReplicationConfiguration) {
-	if v.ReplicationConfig != nil {
-		return v.ReplicationConfig
+func (v _List_ShardReplicationStatus_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
 	}
-
-	return
+	return nil
 }
 
-// GetConfigVersion returns the value of ConfigVersion if it is set or its
-// zero value if it is unset.
-func (v *DomainTaskAttributes) GetConfigVersion() (o int64) {
-	if v.ConfigVersion != nil {
-		return *v.ConfigVersion
-	}
-
-	return
+func (v _List_ShardReplicationStatus_ValueList) Size() int {
+	return len(v)
 }
 
-// GetFailoverVersion returns the value of FailoverVersion if it is set or its
-// zero value if it is unset.
-func (v *DomainTaskAttributes) GetFailoverVersion() (o int64) {
-	if v.FailoverVersion != nil {
-		return *v.FailoverVersion
-	}
-
-	return
+func (_List_ShardReplicationStatus_ValueList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
-type HistoryTaskAttributes struct {
-	TargetClusters          []string                           `json:"targetClusters,omitempty"`
-	DomainId                *string                            `json:"domainId,omitempty"`
-	WorkflowId              *string                            `json:"workflowId,omitempty"`
-	RunId                   *string                            `json:"runId,omitempty"`
-	FirstEventId            *int64                             `json:"firstEventId,omitempty"`
-	NextEventId             *int64                             `json:"nextEventId,omitempty"`
-	Version                 *int64                             `json:"version,omitempty"`
-	ReplicationInfo         map[string]*shared.ReplicationInfo `json:"replicationInfo,omitempty"`
-	History                 *shared.History                    `json:"history,omitempty"`
-	NewRunHistory           *shared.History                    `json:"newRunHistory,omitempty"`
-	EventStoreVersion       *int32                             `json:"eventStoreVersion,omitempty"`
-	NewRunEventStoreVersion *int32                             `json:"newRunEventStoreVersion,omitempty"`
-	ResetWorkflow           *bool                              `json:"resetWorkflow,omitempty"`
-}
+func (_List_ShardReplicationStatus_ValueList) Close() {}
 
-type _List_String_ValueList []string
+type _List_DomainReplicationStatus_ValueList []*DomainReplicationStatus
 
-func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
-	for _, x := range v {
-		w, err := wire.NewValueString(x), error(nil)
+func (v _List_DomainReplicationStatus_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
 		if err != nil {
 			return err
 		}
@@ -655,55 +330,17 @@ func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
 	return nil
 }
 
-func (v _List_String_ValueList) Size() int {
+func (v _List_DomainReplicationStatus_ValueList) Size() int {
 	return len(v)
 }
 
-func (_List_String_ValueList) ValueType() wire.Type {
-	return wire.TBinary
+func (_List_DomainReplicationStatus_ValueList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
-func (_List_String_ValueList) Close() {}
-
-type _Map_String_ReplicationInfo_MapItemList map[string]*shared.ReplicationInfo
+func (_List_DomainReplicationStatus_ValueList) Close() {}
 
-func (m _Map_String_ReplicationInfo_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		if v == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", k)
-		}
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
-
-		vw, err := v.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (m _Map_String_ReplicationInfo_MapItemList) Size() int {
-	return len(m)
-}
-
-func (_Map_String_ReplicationInfo_MapItemList) KeyType() wire.Type {
-	return wire.TBinary
-}
-
-func (_Map_String_ReplicationInfo_MapItemList) ValueType() wire.Type {
-	return wire.TStruct
-}
-
-func (_Map_String_ReplicationInfo_MapItemList) Close() {}
-
-// ToWire translates a HistoryTaskAttributes struct into a Thrift-level intermediate
+// ToWire translates a DescribeReplicationStatusResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -718,130 +355,48 @@ func (_Map_String_ReplicationInfo_MapItemList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *HistoryTaskAttributes) ToWire() (wire.Value, error) {
+func (v *DescribeReplicationStatusResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [13]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TargetClusters != nil {
-		w, err = wire.NewValueList(_List_String_ValueList(v.TargetClusters)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
-		i++
-	}
-	if v.DomainId != nil {
-		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
+	if v.Shards != nil {
+		w, err = wire.NewValueList(_List_ShardReplicationStatus_ValueList(v.Shards)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowId != nil {
-		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+	if v.Domains != nil {
+		w, err = wire.NewValueList(_List_DomainReplicationStatus_ValueList(v.Domains)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.RunId != nil {
-		w, err = wire.NewValueString(*(v.RunId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.FirstEventId != nil {
-		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.NextEventId != nil {
-		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.Version != nil {
-		w, err = wire.NewValueI64(*(v.Version)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
-	if v.ReplicationInfo != nil {
-		w, err = wire.NewValueMap(_Map_String_ReplicationInfo_MapItemList(v.ReplicationInfo)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.History != nil {
-		w, err = v.History.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
-	if v.NewRunHistory != nil {
-		w, err = v.NewRunHistory.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
-	}
-	if v.EventStoreVersion != nil {
-		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 100, Value: w}
-		i++
-	}
-	if v.NewRunEventStoreVersion != nil {
-		w, err = wire.NewValueI32(*(v.NewRunEventStoreVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 110, Value: w}
-		i++
-	}
-	if v.ResetWorkflow != nil {
-		w, err = wire.NewValueBool(*(v.ResetWorkflow)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 120, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _List_String_Read(l wire.ValueList) ([]string, error) {
-	if l.ValueType() != wire.TBinary {
+func _ShardReplicationStatus_Read(w wire.Value) (*ShardReplicationStatus, error) {
+	var v ShardReplicationStatus
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ShardReplicationStatus_Read(l wire.ValueList) ([]*ShardReplicationStatus, error) {
+	if l.ValueType() != wire.TStruct {
 		return nil, nil
 	}
 
-	o := make([]string, 0, l.Size())
+	o := make([]*ShardReplicationStatus, 0, l.Size())
 	err := l.ForEach(func(x wire.Value) error {
-		i, err := x.GetString(), error(nil)
+		i, err := _ShardReplicationStatus_Read(x)
 		if err != nil {
 			return err
 		}
@@ -852,51 +407,35 @@ func _List_String_Read(l wire.ValueList) ([]string, error) {
 	return o, err
 }
 
-func _ReplicationInfo_Read(w wire.Value) (*shared.ReplicationInfo, error) {
-	var v shared.ReplicationInfo
+func _DomainReplicationStatus_Read(w wire.Value) (*DomainReplicationStatus, error) {
+	var v DomainReplicationStatus
 	err := v.FromWire(w)
 	return &v, err
 }
 
-func _Map_String_ReplicationInfo_Read(m wire.MapItemList) (map[string]*shared.ReplicationInfo, error) {
-	if m.KeyType() != wire.TBinary {
-		return nil, nil
-	}
-
-	if m.ValueType() != wire.TStruct {
+func _List_DomainReplicationStatus_Read(l wire.ValueList) ([]*DomainReplicationStatus, error) {
+	if l.ValueType() != wire.TStruct {
 		return nil, nil
 	}
 
-	o := make(map[string]*shared.ReplicationInfo, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
-
-		v, err := _ReplicationInfo_Read(x.Value)
+	o := make([]*DomainReplicationStatus, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _DomainReplicationStatus_Read(x)
 		if err != nil {
 			return err
 		}
-
-		o[k] = v
+		o = append(o, i)
 		return nil
 	})
-	m.Close()
+	l.Close()
 	return o, err
 }
 
-func _History_Read(w wire.Value) (*shared.History, error) {
-	var v shared.History
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a HistoryTaskAttributes struct from its Thrift-level
+// FromWire deserializes a DescribeReplicationStatusResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a HistoryTaskAttributes struct
+// An error is returned if we were unable to build a DescribeReplicationStatusResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -904,217 +443,67 @@ func _History_Read(w wire.Value) (*shared.History, error) {
 //     return nil, err
 //   }
 //
-//   var v HistoryTaskAttributes
+//   var v DescribeReplicationStatusResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *HistoryTaskAttributes) FromWire(w wire.Value) error {
+func (v *DescribeReplicationStatusResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 5:
-			if field.Value.Type() == wire.TList {
-				v.TargetClusters, err = _List_String_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-
-			}
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainId = &x
+			if field.Value.Type() == wire.TList {
+				v.Shards, err = _List_ShardReplicationStatus_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowId = &x
+			if field.Value.Type() == wire.TList {
+				v.Domains, err = _List_DomainReplicationStatus_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RunId = &x
-				if err != nil {
-					return err
-				}
+		}
+	}
 
-			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.FirstEventId = &x
-				if err != nil {
-					return err
-				}
+	return nil
+}
 
-			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.NextEventId = &x
-				if err != nil {
-					return err
-				}
+// String returns a readable string representation of a DescribeReplicationStatusResponse
+// struct.
+func (v *DescribeReplicationStatusResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
 
-			}
-		case 60:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.Version = &x
-				if err != nil {
-					return err
-				}
+	var fields [2]string
+	i := 0
+	if v.Shards != nil {
+		fields[i] = fmt.Sprintf("Shards: %v", v.Shards)
+		i++
+	}
+	if v.Domains != nil {
+		fields[i] = fmt.Sprintf("Domains: %v", v.Domains)
+		i++
+	}
 
-			}
-		case 70:
-			if field.Value.Type() == wire.TMap {
-				v.ReplicationInfo, err = _Map_String_ReplicationInfo_Read(field.Value.GetMap())
-				if err != nil {
-					return err
-				}
+	return fmt.Sprintf("DescribeReplicationStatusResponse{%v}", strings.Join(fields[:i], ", "))
+}
 
-			}
-		case 80:
-			if field.Value.Type() == wire.TStruct {
-				v.History, err = _History_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 90:
-			if field.Value.Type() == wire.TStruct {
-				v.NewRunHistory, err = _History_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 100:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.EventStoreVersion = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 110:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.NewRunEventStoreVersion = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 120:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.ResetWorkflow = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		}
-	}
-
-	return nil
-}
-
-// String returns a readable string representation of a HistoryTaskAttributes
-// struct.
-func (v *HistoryTaskAttributes) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-
-	var fields [13]string
-	i := 0
-	if v.TargetClusters != nil {
-		fields[i] = fmt.Sprintf("TargetClusters: %v", v.TargetClusters)
-		i++
-	}
-	if v.DomainId != nil {
-		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
-		i++
-	}
-	if v.WorkflowId != nil {
-		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
-		i++
-	}
-	if v.RunId != nil {
-		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
-		i++
-	}
-	if v.FirstEventId != nil {
-		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
-		i++
-	}
-	if v.NextEventId != nil {
-		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
-		i++
-	}
-	if v.Version != nil {
-		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
-		i++
-	}
-	if v.ReplicationInfo != nil {
-		fields[i] = fmt.Sprintf("ReplicationInfo: %v", v.ReplicationInfo)
-		i++
-	}
-	if v.History != nil {
-		fields[i] = fmt.Sprintf("History: %v", v.History)
-		i++
-	}
-	if v.NewRunHistory != nil {
-		fields[i] = fmt.Sprintf("NewRunHistory: %v", v.NewRunHistory)
-		i++
-	}
-	if v.EventStoreVersion != nil {
-		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
-		i++
-	}
-	if v.NewRunEventStoreVersion != nil {
-		fields[i] = fmt.Sprintf("NewRunEventStoreVersion: %v", *(v.NewRunEventStoreVersion))
-		i++
-	}
-	if v.ResetWorkflow != nil {
-		fields[i] = fmt.Sprintf("ResetWorkflow: %v", *(v.ResetWorkflow))
-		i++
-	}
-
-	return fmt.Sprintf("HistoryTaskAttributes{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _List_String_Equals(lhs, rhs []string) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
+func _List_ShardReplicationStatus_Equals(lhs, rhs []*ShardReplicationStatus) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
 
 	for i, lv := range lhs {
 		rv := rhs[i]
-		if !(lv == rv) {
+		if !lv.Equals(rv) {
 			return false
 		}
 	}
@@ -1122,305 +511,3671 @@ func _List_String_Equals(lhs, rhs []string) bool {
 	return true
 }
 
-func _Map_String_ReplicationInfo_Equals(lhs, rhs map[string]*shared.ReplicationInfo) bool {
+func _List_DomainReplicationStatus_Equals(lhs, rhs []*DomainReplicationStatus) bool {
 	if len(lhs) != len(rhs) {
 		return false
 	}
 
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
+	for i, lv := range lhs {
+		rv := rhs[i]
 		if !lv.Equals(rv) {
 			return false
 		}
 	}
-	return true
-}
-
-func _I32_EqualsPtr(lhs, rhs *int32) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
-func _Bool_EqualsPtr(lhs, rhs *bool) bool {
-	if lhs != nil && rhs != nil {
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
+	return true
 }
 
-// Equals returns true if all the fields of this HistoryTaskAttributes match the
-// provided HistoryTaskAttributes.
+// Equals returns true if all the fields of this DescribeReplicationStatusResponse match the
+// provided DescribeReplicationStatusResponse.
 //
 // This function performs a deep comparison.
-func (v *HistoryTaskAttributes) Equals(rhs *HistoryTaskAttributes) bool {
+func (v *DescribeReplicationStatusResponse) Equals(rhs *DescribeReplicationStatusResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.TargetClusters == nil && rhs.TargetClusters == nil) || (v.TargetClusters != nil && rhs.TargetClusters != nil && _List_String_Equals(v.TargetClusters, rhs.TargetClusters))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.Version, rhs.Version) {
-		return false
-	}
-	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
-		return false
-	}
-	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
-		return false
-	}
-	if !((v.NewRunHistory == nil && rhs.NewRunHistory == nil) || (v.NewRunHistory != nil && rhs.NewRunHistory != nil && v.NewRunHistory.Equals(rhs.NewRunHistory))) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.NewRunEventStoreVersion, rhs.NewRunEventStoreVersion) {
+	if !((v.Shards == nil && rhs.Shards == nil) || (v.Shards != nil && rhs.Shards != nil && _List_ShardReplicationStatus_Equals(v.Shards, rhs.Shards))) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.ResetWorkflow, rhs.ResetWorkflow) {
+	if !((v.Domains == nil && rhs.Domains == nil) || (v.Domains != nil && rhs.Domains != nil && _List_DomainReplicationStatus_Equals(v.Domains, rhs.Domains))) {
 		return false
 	}
 
 	return true
 }
 
-type _List_String_Zapper []string
+type _List_ShardReplicationStatus_Zapper []*ShardReplicationStatus
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_String_Zapper.
-func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+// fast logging of _List_ShardReplicationStatus_Zapper.
+func (l _List_ShardReplicationStatus_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
 	for _, v := range l {
-		enc.AppendString(v)
+		err = multierr.Append(err, enc.AppendObject(v))
 	}
 	return err
 }
 
-type _Map_String_ReplicationInfo_Zapper map[string]*shared.ReplicationInfo
+type _List_DomainReplicationStatus_Zapper []*DomainReplicationStatus
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of _Map_String_ReplicationInfo_Zapper.
-func (m _Map_String_ReplicationInfo_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	for k, v := range m {
-		err = multierr.Append(err, enc.AddObject((string)(k), v))
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_DomainReplicationStatus_Zapper.
+func (l _List_DomainReplicationStatus_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
 	}
 	return err
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of HistoryTaskAttributes.
-func (v *HistoryTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DescribeReplicationStatusResponse.
+func (v *DescribeReplicationStatusResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TargetClusters != nil {
-		err = multierr.Append(err, enc.AddArray("targetClusters", (_List_String_Zapper)(v.TargetClusters)))
-	}
-	if v.DomainId != nil {
-		enc.AddString("domainId", *v.DomainId)
-	}
-	if v.WorkflowId != nil {
-		enc.AddString("workflowId", *v.WorkflowId)
-	}
-	if v.RunId != nil {
-		enc.AddString("runId", *v.RunId)
-	}
-	if v.FirstEventId != nil {
-		enc.AddInt64("firstEventId", *v.FirstEventId)
-	}
-	if v.NextEventId != nil {
-		enc.AddInt64("nextEventId", *v.NextEventId)
-	}
-	if v.Version != nil {
-		enc.AddInt64("version", *v.Version)
-	}
-	if v.ReplicationInfo != nil {
-		err = multierr.Append(err, enc.AddObject("replicationInfo", (_Map_String_ReplicationInfo_Zapper)(v.ReplicationInfo)))
-	}
-	if v.History != nil {
-		err = multierr.Append(err, enc.AddObject("history", v.History))
-	}
-	if v.NewRunHistory != nil {
-		err = multierr.Append(err, enc.AddObject("newRunHistory", v.NewRunHistory))
-	}
-	if v.EventStoreVersion != nil {
-		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+	if v.Shards != nil {
+		err = multierr.Append(err, enc.AddArray("shards", (_List_ShardReplicationStatus_Zapper)(v.Shards)))
 	}
-	if v.NewRunEventStoreVersion != nil {
-		enc.AddInt32("newRunEventStoreVersion", *v.NewRunEventStoreVersion)
-	}
-	if v.ResetWorkflow != nil {
-		enc.AddBool("resetWorkflow", *v.ResetWorkflow)
+	if v.Domains != nil {
+		err = multierr.Append(err, enc.AddArray("domains", (_List_DomainReplicationStatus_Zapper)(v.Domains)))
 	}
 	return err
 }
 
-// GetTargetClusters returns the value of TargetClusters if it is set or its
+// GetShards returns the value of Shards if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskAttributes) GetTargetClusters() (o []string) {
-	if v.TargetClusters != nil {
-		return v.TargetClusters
+func (v *DescribeReplicationStatusResponse) GetShards() (o []*ShardReplicationStatus) {
+	if v.Shards != nil {
+		return v.Shards
 	}
 
 	return
 }
 
-// GetDomainId returns the value of DomainId if it is set or its
+// GetDomains returns the value of Domains if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskAttributes) GetDomainId() (o string) {
-	if v.DomainId != nil {
-		return *v.DomainId
+func (v *DescribeReplicationStatusResponse) GetDomains() (o []*DomainReplicationStatus) {
+	if v.Domains != nil {
+		return v.Domains
 	}
 
 	return
 }
 
-// GetWorkflowId returns the value of WorkflowId if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetWorkflowId() (o string) {
-	if v.WorkflowId != nil {
-		return *v.WorkflowId
-	}
+type DomainOperation int32
 
-	return
+const (
+	DomainOperationCreate DomainOperation = 0
+	DomainOperationUpdate DomainOperation = 1
+)
+
+// DomainOperation_Values returns all recognized values of DomainOperation.
+func DomainOperation_Values() []DomainOperation {
+	return []DomainOperation{
+		DomainOperationCreate,
+		DomainOperationUpdate,
+	}
+}
+
+// UnmarshalText tries to decode DomainOperation from a byte slice
+// containing its name.
+//
+//   var v DomainOperation
+//   err := v.UnmarshalText([]byte("Create"))
+func (v *DomainOperation) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "Create":
+		*v = DomainOperationCreate
+		return nil
+	case "Update":
+		*v = DomainOperationUpdate
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "DomainOperation", err)
+		}
+		*v = DomainOperation(val)
+		return nil
+	}
+}
+
+// MarshalText encodes DomainOperation to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v DomainOperation) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("Create"), nil
+	case 1:
+		return []byte("Update"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DomainOperation.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v DomainOperation) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "Create")
+	case 1:
+		enc.AddString("name", "Update")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v DomainOperation) Ptr() *DomainOperation {
+	return &v
+}
+
+// ToWire translates DomainOperation into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v DomainOperation) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes DomainOperation from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return DomainOperation(0), err
+//   }
+//
+//   var v DomainOperation
+//   if err := v.FromWire(x); err != nil {
+//     return DomainOperation(0), err
+//   }
+//   return v, nil
+func (v *DomainOperation) FromWire(w wire.Value) error {
+	*v = (DomainOperation)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of DomainOperation.
+func (v DomainOperation) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "Create"
+	case 1:
+		return "Update"
+	}
+	return fmt.Sprintf("DomainOperation(%d)", w)
+}
+
+// Equals returns true if this DomainOperation value matches the provided
+// value.
+func (v DomainOperation) Equals(rhs DomainOperation) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes DomainOperation into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v DomainOperation) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"Create\""), nil
+	case 1:
+		return ([]byte)("\"Update\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode DomainOperation from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *DomainOperation) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "DomainOperation")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "DomainOperation")
+		}
+		*v = (DomainOperation)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "DomainOperation")
+	}
+}
+
+type DomainReplicationStatus struct {
+	Domain                  *string                     `json:"domain,omitempty"`
+	PendingReplicationTasks *int64                      `json:"pendingReplicationTasks,omitempty"`
+	HeldReplicationTasks    *int64                      `json:"heldReplicationTasks,omitempty"`
+	Controls                []*ReplicationControlStatus `json:"controls,omitempty"`
+}
+
+type _List_ReplicationControlStatus_ValueList []*ReplicationControlStatus
+
+func (v _List_ReplicationControlStatus_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ReplicationControlStatus_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ReplicationControlStatus_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ReplicationControlStatus_ValueList) Close() {}
+
+// ToWire translates a DomainReplicationStatus struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DomainReplicationStatus) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.PendingReplicationTasks != nil {
+		w, err = wire.NewValueI64(*(v.PendingReplicationTasks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.HeldReplicationTasks != nil {
+		w, err = wire.NewValueI64(*(v.HeldReplicationTasks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Controls != nil {
+		w, err = wire.NewValueList(_List_ReplicationControlStatus_ValueList(v.Controls)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationControlStatus_Read(w wire.Value) (*ReplicationControlStatus, error) {
+	var v ReplicationControlStatus
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ReplicationControlStatus_Read(l wire.ValueList) ([]*ReplicationControlStatus, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ReplicationControlStatus, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ReplicationControlStatus_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a DomainReplicationStatus struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DomainReplicationStatus struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DomainReplicationStatus
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DomainReplicationStatus) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.PendingReplicationTasks = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.HeldReplicationTasks = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TList {
+				v.Controls, err = _List_ReplicationControlStatus_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DomainReplicationStatus
+// struct.
+func (v *DomainReplicationStatus) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.PendingReplicationTasks != nil {
+		fields[i] = fmt.Sprintf("PendingReplicationTasks: %v", *(v.PendingReplicationTasks))
+		i++
+	}
+	if v.HeldReplicationTasks != nil {
+		fields[i] = fmt.Sprintf("HeldReplicationTasks: %v", *(v.HeldReplicationTasks))
+		i++
+	}
+	if v.Controls != nil {
+		fields[i] = fmt.Sprintf("Controls: %v", v.Controls)
+		i++
+	}
+
+	return fmt.Sprintf("DomainReplicationStatus{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_ReplicationControlStatus_Equals(lhs, rhs []*ReplicationControlStatus) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this DomainReplicationStatus match the
+// provided DomainReplicationStatus.
+//
+// This function performs a deep comparison.
+func (v *DomainReplicationStatus) Equals(rhs *DomainReplicationStatus) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.PendingReplicationTasks, rhs.PendingReplicationTasks) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.HeldReplicationTasks, rhs.HeldReplicationTasks) {
+		return false
+	}
+	if !((v.Controls == nil && rhs.Controls == nil) || (v.Controls != nil && rhs.Controls != nil && _List_ReplicationControlStatus_Equals(v.Controls, rhs.Controls))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_ReplicationControlStatus_Zapper []*ReplicationControlStatus
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ReplicationControlStatus_Zapper.
+func (l _List_ReplicationControlStatus_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DomainReplicationStatus.
+func (v *DomainReplicationStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.PendingReplicationTasks != nil {
+		enc.AddInt64("pendingReplicationTasks", *v.PendingReplicationTasks)
+	}
+	if v.HeldReplicationTasks != nil {
+		enc.AddInt64("heldReplicationTasks", *v.HeldReplicationTasks)
+	}
+	if v.Controls != nil {
+		err = multierr.Append(err, enc.AddArray("controls", (_List_ReplicationControlStatus_Zapper)(v.Controls)))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *DomainReplicationStatus) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// GetPendingReplicationTasks returns the value of PendingReplicationTasks if it is set or its
+// zero value if it is unset.
+func (v *DomainReplicationStatus) GetPendingReplicationTasks() (o int64) {
+	if v.PendingReplicationTasks != nil {
+		return *v.PendingReplicationTasks
+	}
+
+	return
+}
+
+// GetHeldReplicationTasks returns the value of HeldReplicationTasks if it is set or its
+// zero value if it is unset.
+func (v *DomainReplicationStatus) GetHeldReplicationTasks() (o int64) {
+	if v.HeldReplicationTasks != nil {
+		return *v.HeldReplicationTasks
+	}
+
+	return
+}
+
+// GetControls returns the value of Controls if it is set or its
+// zero value if it is unset.
+func (v *DomainReplicationStatus) GetControls() (o []*ReplicationControlStatus) {
+	if v.Controls != nil {
+		return v.Controls
+	}
+
+	return
+}
+
+type DomainTaskAttributes struct {
+	DomainOperation   *DomainOperation                       `json:"domainOperation,omitempty"`
+	ID                *string                                `json:"id,omitempty"`
+	Info              *shared.DomainInfo                     `json:"info,omitempty"`
+	Config            *shared.DomainConfiguration            `json:"config,omitempty"`
+	ReplicationConfig *shared.DomainReplicationConfiguration `json:"replicationConfig,omitempty"`
+	ConfigVersion     *int64                                 `json:"configVersion,omitempty"`
+	FailoverVersion   *int64                                 `json:"failoverVersion,omitempty"`
+}
+
+// ToWire translates a DomainTaskAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DomainTaskAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainOperation != nil {
+		w, err = v.DomainOperation.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.ID != nil {
+		w, err = wire.NewValueString(*(v.ID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Info != nil {
+		w, err = v.Info.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Config != nil {
+		w, err = v.Config.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ReplicationConfig != nil {
+		w, err = v.ReplicationConfig.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ConfigVersion != nil {
+		w, err = wire.NewValueI64(*(v.ConfigVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.FailoverVersion != nil {
+		w, err = wire.NewValueI64(*(v.FailoverVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DomainOperation_Read(w wire.Value) (DomainOperation, error) {
+	var v DomainOperation
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _DomainInfo_Read(w wire.Value) (*shared.DomainInfo, error) {
+	var v shared.DomainInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _DomainConfiguration_Read(w wire.Value) (*shared.DomainConfiguration, error) {
+	var v shared.DomainConfiguration
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _DomainReplicationConfiguration_Read(w wire.Value) (*shared.DomainReplicationConfiguration, error) {
+	var v shared.DomainReplicationConfiguration
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a DomainTaskAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DomainTaskAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DomainTaskAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DomainTaskAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 5:
+			if field.Value.Type() == wire.TI32 {
+				var x DomainOperation
+				x, err = _DomainOperation_Read(field.Value)
+				v.DomainOperation = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Info, err = _DomainInfo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.Config, err = _DomainConfiguration_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.ReplicationConfig, err = _DomainReplicationConfiguration_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ConfigVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FailoverVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DomainTaskAttributes
+// struct.
+func (v *DomainTaskAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	if v.DomainOperation != nil {
+		fields[i] = fmt.Sprintf("DomainOperation: %v", *(v.DomainOperation))
+		i++
+	}
+	if v.ID != nil {
+		fields[i] = fmt.Sprintf("ID: %v", *(v.ID))
+		i++
+	}
+	if v.Info != nil {
+		fields[i] = fmt.Sprintf("Info: %v", v.Info)
+		i++
+	}
+	if v.Config != nil {
+		fields[i] = fmt.Sprintf("Config: %v", v.Config)
+		i++
+	}
+	if v.ReplicationConfig != nil {
+		fields[i] = fmt.Sprintf("ReplicationConfig: %v", v.ReplicationConfig)
+		i++
+	}
+	if v.ConfigVersion != nil {
+		fields[i] = fmt.Sprintf("ConfigVersion: %v", *(v.ConfigVersion))
+		i++
+	}
+	if v.FailoverVersion != nil {
+		fields[i] = fmt.Sprintf("FailoverVersion: %v", *(v.FailoverVersion))
+		i++
+	}
+
+	return fmt.Sprintf("DomainTaskAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _DomainOperation_EqualsPtr(lhs, rhs *DomainOperation) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this DomainTaskAttributes match the
+// provided DomainTaskAttributes.
+//
+// This function performs a deep comparison.
+func (v *DomainTaskAttributes) Equals(rhs *DomainTaskAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_DomainOperation_EqualsPtr(v.DomainOperation, rhs.DomainOperation) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ID, rhs.ID) {
+		return false
+	}
+	if !((v.Info == nil && rhs.Info == nil) || (v.Info != nil && rhs.Info != nil && v.Info.Equals(rhs.Info))) {
+		return false
+	}
+	if !((v.Config == nil && rhs.Config == nil) || (v.Config != nil && rhs.Config != nil && v.Config.Equals(rhs.Config))) {
+		return false
+	}
+	if !((v.ReplicationConfig == nil && rhs.ReplicationConfig == nil) || (v.ReplicationConfig != nil && rhs.ReplicationConfig != nil && v.ReplicationConfig.Equals(rhs.ReplicationConfig))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ConfigVersion, rhs.ConfigVersion) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.FailoverVersion, rhs.FailoverVersion) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DomainTaskAttributes.
+func (v *DomainTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainOperation != nil {
+		err = multierr.Append(err, enc.AddObject("domainOperation", *v.DomainOperation))
+	}
+	if v.ID != nil {
+		enc.AddString("id", *v.ID)
+	}
+	if v.Info != nil {
+		err = multierr.Append(err, enc.AddObject("info", v.Info))
+	}
+	if v.Config != nil {
+		err = multierr.Append(err, enc.AddObject("config", v.Config))
+	}
+	if v.ReplicationConfig != nil {
+		err = multierr.Append(err, enc.AddObject("replicationConfig", v.ReplicationConfig))
+	}
+	if v.ConfigVersion != nil {
+		enc.AddInt64("configVersion", *v.ConfigVersion)
+	}
+	if v.FailoverVersion != nil {
+		enc.AddInt64("failoverVersion", *v.FailoverVersion)
+	}
+	return err
+}
+
+// GetDomainOperation returns the value of DomainOperation if it is set or its
+// zero value if it is unset.
+func (v *DomainTaskAttributes) GetDomainOperation() (o DomainOperation) {
+	if v.DomainOperation != nil {
+		return *v.DomainOperation
+	}
+
+	return
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *DomainTaskAttributes) GetID() (o string) {
+	if v.ID != nil {
+		return *v.ID
+	}
+
+	return
+}
+
+// GetInfo returns the value of Info if it is set or its
+// zero value if it is unset.
+func (v *DomainTaskAttributes) GetInfo() (o *shared.DomainInfo) {
+	if v.Info != nil {
+		return v.Info
+	}
+
+	return
+}
+
+// GetConfig returns the value of Config if it is set or its
+// zero value if it is unset.
+func (v *DomainTaskAttributes) GetConfig() (o *shared.DomainConfiguration) {
+	if v.Config != nil {
+		return v.Config
+	}
+
+	return
+}
+
+// GetReplicationConfig returns the value of ReplicationConfig if it is set or its
+// zero value if it is unset.
+func (v *DomainTaskAttributes) GetReplicationConfig() (o *shared.DomainReplicationConfiguration) {
+	if v.ReplicationConfig != nil {
+		return v.ReplicationConfig
+	}
+
+	return
+}
+
+// GetConfigVersion returns the value of ConfigVersion if it is set or its
+// zero value if it is unset.
+func (v *DomainTaskAttributes) GetConfigVersion() (o int64) {
+	if v.ConfigVersion != nil {
+		return *v.ConfigVersion
+	}
+
+	return
+}
+
+// GetFailoverVersion returns the value of FailoverVersion if it is set or its
+// zero value if it is unset.
+func (v *DomainTaskAttributes) GetFailoverVersion() (o int64) {
+	if v.FailoverVersion != nil {
+		return *v.FailoverVersion
+	}
+
+	return
+}
+
+type GetReplicationMessagesRequest struct {
+	Tokens      []*ReplicationToken `json:"tokens,omitempty"`
+	ClusterName *string             `json:"clusterName,omitempty"`
+}
+
+type _List_ReplicationToken_ValueList []*ReplicationToken
+
+func (v _List_ReplicationToken_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ReplicationToken_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ReplicationToken_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ReplicationToken_ValueList) Close() {}
+
+// ToWire translates a GetReplicationMessagesRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetReplicationMessagesRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Tokens != nil {
+		w, err = wire.NewValueList(_List_ReplicationToken_ValueList(v.Tokens)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ClusterName != nil {
+		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationToken_Read(w wire.Value) (*ReplicationToken, error) {
+	var v ReplicationToken
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ReplicationToken_Read(l wire.ValueList) ([]*ReplicationToken, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ReplicationToken, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ReplicationToken_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a GetReplicationMessagesRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetReplicationMessagesRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetReplicationMessagesRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetReplicationMessagesRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Tokens, err = _List_ReplicationToken_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ClusterName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetReplicationMessagesRequest
+// struct.
+func (v *GetReplicationMessagesRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Tokens != nil {
+		fields[i] = fmt.Sprintf("Tokens: %v", v.Tokens)
+		i++
+	}
+	if v.ClusterName != nil {
+		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
+		i++
+	}
+
+	return fmt.Sprintf("GetReplicationMessagesRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_ReplicationToken_Equals(lhs, rhs []*ReplicationToken) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this GetReplicationMessagesRequest match the
+// provided GetReplicationMessagesRequest.
+//
+// This function performs a deep comparison.
+func (v *GetReplicationMessagesRequest) Equals(rhs *GetReplicationMessagesRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Tokens == nil && rhs.Tokens == nil) || (v.Tokens != nil && rhs.Tokens != nil && _List_ReplicationToken_Equals(v.Tokens, rhs.Tokens))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
+		return false
+	}
+
+	return true
+}
+
+type _List_ReplicationToken_Zapper []*ReplicationToken
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ReplicationToken_Zapper.
+func (l _List_ReplicationToken_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetReplicationMessagesRequest.
+func (v *GetReplicationMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Tokens != nil {
+		err = multierr.Append(err, enc.AddArray("tokens", (_List_ReplicationToken_Zapper)(v.Tokens)))
+	}
+	if v.ClusterName != nil {
+		enc.AddString("clusterName", *v.ClusterName)
+	}
+	return err
+}
+
+// GetTokens returns the value of Tokens if it is set or its
+// zero value if it is unset.
+func (v *GetReplicationMessagesRequest) GetTokens() (o []*ReplicationToken) {
+	if v.Tokens != nil {
+		return v.Tokens
+	}
+
+	return
+}
+
+// GetClusterName returns the value of ClusterName if it is set or its
+// zero value if it is unset.
+func (v *GetReplicationMessagesRequest) GetClusterName() (o string) {
+	if v.ClusterName != nil {
+		return *v.ClusterName
+	}
+
+	return
+}
+
+type GetReplicationMessagesResponse struct {
+	MessagesByShard map[int32]*ReplicationMessages `json:"messagesByShard,omitempty"`
+}
+
+type _Map_I32_ReplicationMessages_MapItemList map[int32]*ReplicationMessages
+
+func (m _Map_I32_ReplicationMessages_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueI32(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_I32_ReplicationMessages_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_I32_ReplicationMessages_MapItemList) KeyType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_I32_ReplicationMessages_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_I32_ReplicationMessages_MapItemList) Close() {}
+
+// ToWire translates a GetReplicationMessagesResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetReplicationMessagesResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.MessagesByShard != nil {
+		w, err = wire.NewValueMap(_Map_I32_ReplicationMessages_MapItemList(v.MessagesByShard)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationMessages_Read(w wire.Value) (*ReplicationMessages, error) {
+	var v ReplicationMessages
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_I32_ReplicationMessages_Read(m wire.MapItemList) (map[int32]*ReplicationMessages, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[int32]*ReplicationMessages, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _ReplicationMessages_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a GetReplicationMessagesResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetReplicationMessagesResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetReplicationMessagesResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetReplicationMessagesResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TMap {
+				v.MessagesByShard, err = _Map_I32_ReplicationMessages_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetReplicationMessagesResponse
+// struct.
+func (v *GetReplicationMessagesResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.MessagesByShard != nil {
+		fields[i] = fmt.Sprintf("MessagesByShard: %v", v.MessagesByShard)
+		i++
+	}
+
+	return fmt.Sprintf("GetReplicationMessagesResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Map_I32_ReplicationMessages_Equals(lhs, rhs map[int32]*ReplicationMessages) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this GetReplicationMessagesResponse match the
+// provided GetReplicationMessagesResponse.
+//
+// This function performs a deep comparison.
+func (v *GetReplicationMessagesResponse) Equals(rhs *GetReplicationMessagesResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.MessagesByShard == nil && rhs.MessagesByShard == nil) || (v.MessagesByShard != nil && rhs.MessagesByShard != nil && _Map_I32_ReplicationMessages_Equals(v.MessagesByShard, rhs.MessagesByShard))) {
+		return false
+	}
+
+	return true
+}
+
+type _Map_I32_ReplicationMessages_Zapper map[int32]*ReplicationMessages
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_I32_ReplicationMessages_Zapper.
+func (m _Map_I32_ReplicationMessages_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetReplicationMessagesResponse.
+func (v *GetReplicationMessagesResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.MessagesByShard != nil {
+		err = multierr.Append(err, enc.AddObject("messagesByShard", (_Map_I32_ReplicationMessages_Zapper)(v.MessagesByShard)))
+	}
+	return err
+}
+
+// GetMessagesByShard returns the value of MessagesByShard if it is set or its
+// zero value if it is unset.
+func (v *GetReplicationMessagesResponse) GetMessagesByShard() (o map[int32]*ReplicationMessages) {
+	if v.MessagesByShard != nil {
+		return v.MessagesByShard
+	}
+
+	return
+}
+
+type HistoryTaskAttributes struct {
+	TargetClusters          []string                           `json:"targetClusters,omitempty"`
+	DomainId                *string                            `json:"domainId,omitempty"`
+	WorkflowId              *string                            `json:"workflowId,omitempty"`
+	RunId                   *string                            `json:"runId,omitempty"`
+	FirstEventId            *int64                             `json:"firstEventId,omitempty"`
+	NextEventId             *int64                             `json:"nextEventId,omitempty"`
+	Version                 *int64                             `json:"version,omitempty"`
+	ReplicationInfo         map[string]*shared.ReplicationInfo `json:"replicationInfo,omitempty"`
+	History                 *shared.History                    `json:"history,omitempty"`
+	NewRunHistory           *shared.History                    `json:"newRunHistory,omitempty"`
+	EventStoreVersion       *int32                             `json:"eventStoreVersion,omitempty"`
+	NewRunEventStoreVersion *int32                             `json:"newRunEventStoreVersion,omitempty"`
+	ResetWorkflow           *bool                              `json:"resetWorkflow,omitempty"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+type _Map_String_ReplicationInfo_MapItemList map[string]*shared.ReplicationInfo
+
+func (m _Map_String_ReplicationInfo_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_ReplicationInfo_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_ReplicationInfo_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_ReplicationInfo_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_ReplicationInfo_MapItemList) Close() {}
+
+// ToWire translates a HistoryTaskAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *HistoryTaskAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [13]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TargetClusters != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.TargetClusters)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.DomainId != nil {
+		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.FirstEventId != nil {
+		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.NextEventId != nil {
+		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.ReplicationInfo != nil {
+		w, err = wire.NewValueMap(_Map_String_ReplicationInfo_MapItemList(v.ReplicationInfo)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.History != nil {
+		w, err = v.History.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.NewRunHistory != nil {
+		w, err = v.NewRunHistory.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.NewRunEventStoreVersion != nil {
+		w, err = wire.NewValueI32(*(v.NewRunEventStoreVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.ResetWorkflow != nil {
+		w, err = wire.NewValueBool(*(v.ResetWorkflow)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _ReplicationInfo_Read(w wire.Value) (*shared.ReplicationInfo, error) {
+	var v shared.ReplicationInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_ReplicationInfo_Read(m wire.MapItemList) (map[string]*shared.ReplicationInfo, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*shared.ReplicationInfo, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _ReplicationInfo_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _History_Read(w wire.Value) (*shared.History, error) {
+	var v shared.History
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a HistoryTaskAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a HistoryTaskAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v HistoryTaskAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *HistoryTaskAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 5:
+			if field.Value.Type() == wire.TList {
+				v.TargetClusters, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FirstEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TMap {
+				v.ReplicationInfo, err = _Map_String_ReplicationInfo_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TStruct {
+				v.History, err = _History_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TStruct {
+				v.NewRunHistory, err = _History_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.EventStoreVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NewRunEventStoreVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ResetWorkflow = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a HistoryTaskAttributes
+// struct.
+func (v *HistoryTaskAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [13]string
+	i := 0
+	if v.TargetClusters != nil {
+		fields[i] = fmt.Sprintf("TargetClusters: %v", v.TargetClusters)
+		i++
+	}
+	if v.DomainId != nil {
+		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
+		i++
+	}
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
+		i++
+	}
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+	if v.FirstEventId != nil {
+		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
+		i++
+	}
+	if v.NextEventId != nil {
+		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
+		i++
+	}
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
+	if v.ReplicationInfo != nil {
+		fields[i] = fmt.Sprintf("ReplicationInfo: %v", v.ReplicationInfo)
+		i++
+	}
+	if v.History != nil {
+		fields[i] = fmt.Sprintf("History: %v", v.History)
+		i++
+	}
+	if v.NewRunHistory != nil {
+		fields[i] = fmt.Sprintf("NewRunHistory: %v", v.NewRunHistory)
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
+		i++
+	}
+	if v.NewRunEventStoreVersion != nil {
+		fields[i] = fmt.Sprintf("NewRunEventStoreVersion: %v", *(v.NewRunEventStoreVersion))
+		i++
+	}
+	if v.ResetWorkflow != nil {
+		fields[i] = fmt.Sprintf("ResetWorkflow: %v", *(v.ResetWorkflow))
+		i++
+	}
+
+	return fmt.Sprintf("HistoryTaskAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_ReplicationInfo_Equals(lhs, rhs map[string]*shared.ReplicationInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this HistoryTaskAttributes match the
+// provided HistoryTaskAttributes.
+//
+// This function performs a deep comparison.
+func (v *HistoryTaskAttributes) Equals(rhs *HistoryTaskAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.TargetClusters == nil && rhs.TargetClusters == nil) || (v.TargetClusters != nil && rhs.TargetClusters != nil && _List_String_Equals(v.TargetClusters, rhs.TargetClusters))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
+		return false
+	}
+	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
+		return false
+	}
+	if !((v.NewRunHistory == nil && rhs.NewRunHistory == nil) || (v.NewRunHistory != nil && rhs.NewRunHistory != nil && v.NewRunHistory.Equals(rhs.NewRunHistory))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.NewRunEventStoreVersion, rhs.NewRunEventStoreVersion) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ResetWorkflow, rhs.ResetWorkflow) {
+		return false
+	}
+
+	return true
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+type _Map_String_ReplicationInfo_Zapper map[string]*shared.ReplicationInfo
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_ReplicationInfo_Zapper.
+func (m _Map_String_ReplicationInfo_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of HistoryTaskAttributes.
+func (v *HistoryTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TargetClusters != nil {
+		err = multierr.Append(err, enc.AddArray("targetClusters", (_List_String_Zapper)(v.TargetClusters)))
+	}
+	if v.DomainId != nil {
+		enc.AddString("domainId", *v.DomainId)
+	}
+	if v.WorkflowId != nil {
+		enc.AddString("workflowId", *v.WorkflowId)
+	}
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
+	}
+	if v.FirstEventId != nil {
+		enc.AddInt64("firstEventId", *v.FirstEventId)
+	}
+	if v.NextEventId != nil {
+		enc.AddInt64("nextEventId", *v.NextEventId)
+	}
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
+	}
+	if v.ReplicationInfo != nil {
+		err = multierr.Append(err, enc.AddObject("replicationInfo", (_Map_String_ReplicationInfo_Zapper)(v.ReplicationInfo)))
+	}
+	if v.History != nil {
+		err = multierr.Append(err, enc.AddObject("history", v.History))
+	}
+	if v.NewRunHistory != nil {
+		err = multierr.Append(err, enc.AddObject("newRunHistory", v.NewRunHistory))
+	}
+	if v.EventStoreVersion != nil {
+		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+	}
+	if v.NewRunEventStoreVersion != nil {
+		enc.AddInt32("newRunEventStoreVersion", *v.NewRunEventStoreVersion)
+	}
+	if v.ResetWorkflow != nil {
+		enc.AddBool("resetWorkflow", *v.ResetWorkflow)
+	}
+	return err
+}
+
+// GetTargetClusters returns the value of TargetClusters if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetTargetClusters() (o []string) {
+	if v.TargetClusters != nil {
+		return v.TargetClusters
+	}
+
+	return
+}
+
+// GetDomainId returns the value of DomainId if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetDomainId() (o string) {
+	if v.DomainId != nil {
+		return *v.DomainId
+	}
+
+	return
+}
+
+// GetWorkflowId returns the value of WorkflowId if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetWorkflowId() (o string) {
+	if v.WorkflowId != nil {
+		return *v.WorkflowId
+	}
+
+	return
 }
 
 // GetRunId returns the value of RunId if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskAttributes) GetRunId() (o string) {
-	if v.RunId != nil {
-		return *v.RunId
+func (v *HistoryTaskAttributes) GetRunId() (o string) {
+	if v.RunId != nil {
+		return *v.RunId
+	}
+
+	return
+}
+
+// GetFirstEventId returns the value of FirstEventId if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetFirstEventId() (o int64) {
+	if v.FirstEventId != nil {
+		return *v.FirstEventId
+	}
+
+	return
+}
+
+// GetNextEventId returns the value of NextEventId if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetNextEventId() (o int64) {
+	if v.NextEventId != nil {
+		return *v.NextEventId
+	}
+
+	return
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetVersion() (o int64) {
+	if v.Version != nil {
+		return *v.Version
+	}
+
+	return
+}
+
+// GetReplicationInfo returns the value of ReplicationInfo if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetReplicationInfo() (o map[string]*shared.ReplicationInfo) {
+	if v.ReplicationInfo != nil {
+		return v.ReplicationInfo
+	}
+
+	return
+}
+
+// GetHistory returns the value of History if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetHistory() (o *shared.History) {
+	if v.History != nil {
+		return v.History
+	}
+
+	return
+}
+
+// GetNewRunHistory returns the value of NewRunHistory if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetNewRunHistory() (o *shared.History) {
+	if v.NewRunHistory != nil {
+		return v.NewRunHistory
+	}
+
+	return
+}
+
+// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetEventStoreVersion() (o int32) {
+	if v.EventStoreVersion != nil {
+		return *v.EventStoreVersion
+	}
+
+	return
+}
+
+// GetNewRunEventStoreVersion returns the value of NewRunEventStoreVersion if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetNewRunEventStoreVersion() (o int32) {
+	if v.NewRunEventStoreVersion != nil {
+		return *v.NewRunEventStoreVersion
+	}
+
+	return
+}
+
+// GetResetWorkflow returns the value of ResetWorkflow if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetResetWorkflow() (o bool) {
+	if v.ResetWorkflow != nil {
+		return *v.ResetWorkflow
+	}
+
+	return
+}
+
+type ReplicationControlStatus struct {
+	Cluster        *string `json:"cluster,omitempty"`
+	OutboundPaused *bool   `json:"outboundPaused,omitempty"`
+	OutboundMaxRPS *int32  `json:"outboundMaxRPS,omitempty"`
+	InboundPaused  *bool   `json:"inboundPaused,omitempty"`
+	InboundMaxRPS  *int32  `json:"inboundMaxRPS,omitempty"`
+}
+
+// ToWire translates a ReplicationControlStatus struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicationControlStatus) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Cluster != nil {
+		w, err = wire.NewValueString(*(v.Cluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.OutboundPaused != nil {
+		w, err = wire.NewValueBool(*(v.OutboundPaused)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.OutboundMaxRPS != nil {
+		w, err = wire.NewValueI32(*(v.OutboundMaxRPS)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.InboundPaused != nil {
+		w, err = wire.NewValueBool(*(v.InboundPaused)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.InboundMaxRPS != nil {
+		w, err = wire.NewValueI32(*(v.InboundMaxRPS)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ReplicationControlStatus struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationControlStatus struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReplicationControlStatus
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReplicationControlStatus) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Cluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.OutboundPaused = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.OutboundMaxRPS = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.InboundPaused = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.InboundMaxRPS = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationControlStatus
+// struct.
+func (v *ReplicationControlStatus) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.Cluster != nil {
+		fields[i] = fmt.Sprintf("Cluster: %v", *(v.Cluster))
+		i++
+	}
+	if v.OutboundPaused != nil {
+		fields[i] = fmt.Sprintf("OutboundPaused: %v", *(v.OutboundPaused))
+		i++
+	}
+	if v.OutboundMaxRPS != nil {
+		fields[i] = fmt.Sprintf("OutboundMaxRPS: %v", *(v.OutboundMaxRPS))
+		i++
+	}
+	if v.InboundPaused != nil {
+		fields[i] = fmt.Sprintf("InboundPaused: %v", *(v.InboundPaused))
+		i++
+	}
+	if v.InboundMaxRPS != nil {
+		fields[i] = fmt.Sprintf("InboundMaxRPS: %v", *(v.InboundMaxRPS))
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationControlStatus{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ReplicationControlStatus match the
+// provided ReplicationControlStatus.
+//
+// This function performs a deep comparison.
+func (v *ReplicationControlStatus) Equals(rhs *ReplicationControlStatus) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Cluster, rhs.Cluster) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.OutboundPaused, rhs.OutboundPaused) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.OutboundMaxRPS, rhs.OutboundMaxRPS) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.InboundPaused, rhs.InboundPaused) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.InboundMaxRPS, rhs.InboundMaxRPS) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationControlStatus.
+func (v *ReplicationControlStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Cluster != nil {
+		enc.AddString("cluster", *v.Cluster)
+	}
+	if v.OutboundPaused != nil {
+		enc.AddBool("outboundPaused", *v.OutboundPaused)
+	}
+	if v.OutboundMaxRPS != nil {
+		enc.AddInt32("outboundMaxRPS", *v.OutboundMaxRPS)
+	}
+	if v.InboundPaused != nil {
+		enc.AddBool("inboundPaused", *v.InboundPaused)
+	}
+	if v.InboundMaxRPS != nil {
+		enc.AddInt32("inboundMaxRPS", *v.InboundMaxRPS)
+	}
+	return err
+}
+
+// GetCluster returns the value of Cluster if it is set or its
+// zero value if it is unset.
+func (v *ReplicationControlStatus) GetCluster() (o string) {
+	if v.Cluster != nil {
+		return *v.Cluster
+	}
+
+	return
+}
+
+// GetOutboundPaused returns the value of OutboundPaused if it is set or its
+// zero value if it is unset.
+func (v *ReplicationControlStatus) GetOutboundPaused() (o bool) {
+	if v.OutboundPaused != nil {
+		return *v.OutboundPaused
+	}
+
+	return
+}
+
+// GetOutboundMaxRPS returns the value of OutboundMaxRPS if it is set or its
+// zero value if it is unset.
+func (v *ReplicationControlStatus) GetOutboundMaxRPS() (o int32) {
+	if v.OutboundMaxRPS != nil {
+		return *v.OutboundMaxRPS
+	}
+
+	return
+}
+
+// GetInboundPaused returns the value of InboundPaused if it is set or its
+// zero value if it is unset.
+func (v *ReplicationControlStatus) GetInboundPaused() (o bool) {
+	if v.InboundPaused != nil {
+		return *v.InboundPaused
+	}
+
+	return
+}
+
+// GetInboundMaxRPS returns the value of InboundMaxRPS if it is set or its
+// zero value if it is unset.
+func (v *ReplicationControlStatus) GetInboundMaxRPS() (o int32) {
+	if v.InboundMaxRPS != nil {
+		return *v.InboundMaxRPS
+	}
+
+	return
+}
+
+type ReplicationMessages struct {
+	ReplicationTasks       []*ReplicationTask             `json:"replicationTasks,omitempty"`
+	LastRetrievedMessageId *int64                         `json:"lastRetrievedMessageId,omitempty"`
+	HasMore                *bool                          `json:"hasMore,omitempty"`
+	SyncShardStatus        *SyncShardStatusTaskAttributes `json:"syncShardStatus,omitempty"`
+}
+
+type _List_ReplicationTask_ValueList []*ReplicationTask
+
+func (v _List_ReplicationTask_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ReplicationTask_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ReplicationTask_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ReplicationTask_ValueList) Close() {}
+
+// ToWire translates a ReplicationMessages struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicationMessages) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ReplicationTasks != nil {
+		w, err = wire.NewValueList(_List_ReplicationTask_ValueList(v.ReplicationTasks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.LastRetrievedMessageId != nil {
+		w, err = wire.NewValueI64(*(v.LastRetrievedMessageId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.HasMore != nil {
+		w, err = wire.NewValueBool(*(v.HasMore)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.SyncShardStatus != nil {
+		w, err = v.SyncShardStatus.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationTask_Read(w wire.Value) (*ReplicationTask, error) {
+	var v ReplicationTask
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ReplicationTask_Read(l wire.ValueList) ([]*ReplicationTask, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ReplicationTask, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ReplicationTask_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ReplicationMessages struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationMessages struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReplicationMessages
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReplicationMessages) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.ReplicationTasks, err = _List_ReplicationTask_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastRetrievedMessageId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.HasMore = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.SyncShardStatus, err = _SyncShardStatusTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationMessages
+// struct.
+func (v *ReplicationMessages) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.ReplicationTasks != nil {
+		fields[i] = fmt.Sprintf("ReplicationTasks: %v", v.ReplicationTasks)
+		i++
+	}
+	if v.LastRetrievedMessageId != nil {
+		fields[i] = fmt.Sprintf("LastRetrievedMessageId: %v", *(v.LastRetrievedMessageId))
+		i++
+	}
+	if v.HasMore != nil {
+		fields[i] = fmt.Sprintf("HasMore: %v", *(v.HasMore))
+		i++
+	}
+	if v.SyncShardStatus != nil {
+		fields[i] = fmt.Sprintf("SyncShardStatus: %v", v.SyncShardStatus)
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationMessages{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_ReplicationTask_Equals(lhs, rhs []*ReplicationTask) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ReplicationMessages match the
+// provided ReplicationMessages.
+//
+// This function performs a deep comparison.
+func (v *ReplicationMessages) Equals(rhs *ReplicationMessages) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.ReplicationTasks == nil && rhs.ReplicationTasks == nil) || (v.ReplicationTasks != nil && rhs.ReplicationTasks != nil && _List_ReplicationTask_Equals(v.ReplicationTasks, rhs.ReplicationTasks))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastRetrievedMessageId, rhs.LastRetrievedMessageId) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.HasMore, rhs.HasMore) {
+		return false
+	}
+	if !((v.SyncShardStatus == nil && rhs.SyncShardStatus == nil) || (v.SyncShardStatus != nil && rhs.SyncShardStatus != nil && v.SyncShardStatus.Equals(rhs.SyncShardStatus))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_ReplicationTask_Zapper []*ReplicationTask
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ReplicationTask_Zapper.
+func (l _List_ReplicationTask_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationMessages.
+func (v *ReplicationMessages) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ReplicationTasks != nil {
+		err = multierr.Append(err, enc.AddArray("replicationTasks", (_List_ReplicationTask_Zapper)(v.ReplicationTasks)))
+	}
+	if v.LastRetrievedMessageId != nil {
+		enc.AddInt64("lastRetrievedMessageId", *v.LastRetrievedMessageId)
+	}
+	if v.HasMore != nil {
+		enc.AddBool("hasMore", *v.HasMore)
+	}
+	if v.SyncShardStatus != nil {
+		err = multierr.Append(err, enc.AddObject("syncShardStatus", v.SyncShardStatus))
+	}
+	return err
+}
+
+// GetReplicationTasks returns the value of ReplicationTasks if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessages) GetReplicationTasks() (o []*ReplicationTask) {
+	if v.ReplicationTasks != nil {
+		return v.ReplicationTasks
+	}
+
+	return
+}
+
+// GetLastRetrievedMessageId returns the value of LastRetrievedMessageId if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessages) GetLastRetrievedMessageId() (o int64) {
+	if v.LastRetrievedMessageId != nil {
+		return *v.LastRetrievedMessageId
+	}
+
+	return
+}
+
+// GetHasMore returns the value of HasMore if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessages) GetHasMore() (o bool) {
+	if v.HasMore != nil {
+		return *v.HasMore
+	}
+
+	return
+}
+
+// GetSyncShardStatus returns the value of SyncShardStatus if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessages) GetSyncShardStatus() (o *SyncShardStatusTaskAttributes) {
+	if v.SyncShardStatus != nil {
+		return v.SyncShardStatus
+	}
+
+	return
+}
+
+type ReplicationTask struct {
+	TaskType                      *ReplicationTaskType           `json:"taskType,omitempty"`
+	DomainTaskAttributes          *DomainTaskAttributes          `json:"domainTaskAttributes,omitempty"`
+	HistoryTaskAttributes         *HistoryTaskAttributes         `json:"historyTaskAttributes,omitempty"`
+	SyncShardStatusTaskAttributes *SyncShardStatusTaskAttributes `json:"syncShardStatusTaskAttributes,omitempty"`
+	SyncActicvityTaskAttributes   *SyncActicvityTaskAttributes   `json:"syncActicvityTaskAttributes,omitempty"`
+	SourceTaskId                  *int64                         `json:"sourceTaskId,omitempty"`
+}
+
+// ToWire translates a ReplicationTask struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicationTask) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TaskType != nil {
+		w, err = v.TaskType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.DomainTaskAttributes != nil {
+		w, err = v.DomainTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.HistoryTaskAttributes != nil {
+		w, err = v.HistoryTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.SyncShardStatusTaskAttributes != nil {
+		w, err = v.SyncShardStatusTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.SyncActicvityTaskAttributes != nil {
+		w, err = v.SyncActicvityTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.SourceTaskId != nil {
+		w, err = wire.NewValueI64(*(v.SourceTaskId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationTaskType_Read(w wire.Value) (ReplicationTaskType, error) {
+	var v ReplicationTaskType
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _DomainTaskAttributes_Read(w wire.Value) (*DomainTaskAttributes, error) {
+	var v DomainTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _HistoryTaskAttributes_Read(w wire.Value) (*HistoryTaskAttributes, error) {
+	var v HistoryTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _SyncShardStatusTaskAttributes_Read(w wire.Value) (*SyncShardStatusTaskAttributes, error) {
+	var v SyncShardStatusTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _SyncActicvityTaskAttributes_Read(w wire.Value) (*SyncActicvityTaskAttributes, error) {
+	var v SyncActicvityTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a ReplicationTask struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationTask struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReplicationTask
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReplicationTask) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x ReplicationTaskType
+				x, err = _ReplicationTaskType_Read(field.Value)
+				v.TaskType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.DomainTaskAttributes, err = _DomainTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.HistoryTaskAttributes, err = _HistoryTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.SyncShardStatusTaskAttributes, err = _SyncShardStatusTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.SyncActicvityTaskAttributes, err = _SyncActicvityTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.SourceTaskId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationTask
+// struct.
+func (v *ReplicationTask) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.TaskType != nil {
+		fields[i] = fmt.Sprintf("TaskType: %v", *(v.TaskType))
+		i++
+	}
+	if v.DomainTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("DomainTaskAttributes: %v", v.DomainTaskAttributes)
+		i++
+	}
+	if v.HistoryTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("HistoryTaskAttributes: %v", v.HistoryTaskAttributes)
+		i++
+	}
+	if v.SyncShardStatusTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("SyncShardStatusTaskAttributes: %v", v.SyncShardStatusTaskAttributes)
+		i++
+	}
+	if v.SyncActicvityTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("SyncActicvityTaskAttributes: %v", v.SyncActicvityTaskAttributes)
+		i++
+	}
+	if v.SourceTaskId != nil {
+		fields[i] = fmt.Sprintf("SourceTaskId: %v", *(v.SourceTaskId))
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationTask{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _ReplicationTaskType_EqualsPtr(lhs, rhs *ReplicationTaskType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this ReplicationTask match the
+// provided ReplicationTask.
+//
+// This function performs a deep comparison.
+func (v *ReplicationTask) Equals(rhs *ReplicationTask) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_ReplicationTaskType_EqualsPtr(v.TaskType, rhs.TaskType) {
+		return false
+	}
+	if !((v.DomainTaskAttributes == nil && rhs.DomainTaskAttributes == nil) || (v.DomainTaskAttributes != nil && rhs.DomainTaskAttributes != nil && v.DomainTaskAttributes.Equals(rhs.DomainTaskAttributes))) {
+		return false
+	}
+	if !((v.HistoryTaskAttributes == nil && rhs.HistoryTaskAttributes == nil) || (v.HistoryTaskAttributes != nil && rhs.HistoryTaskAttributes != nil && v.HistoryTaskAttributes.Equals(rhs.HistoryTaskAttributes))) {
+		return false
+	}
+	if !((v.SyncShardStatusTaskAttributes == nil && rhs.SyncShardStatusTaskAttributes == nil) || (v.SyncShardStatusTaskAttributes != nil && rhs.SyncShardStatusTaskAttributes != nil && v.SyncShardStatusTaskAttributes.Equals(rhs.SyncShardStatusTaskAttributes))) {
+		return false
+	}
+	if !((v.SyncActicvityTaskAttributes == nil && rhs.SyncActicvityTaskAttributes == nil) || (v.SyncActicvityTaskAttributes != nil && rhs.SyncActicvityTaskAttributes != nil && v.SyncActicvityTaskAttributes.Equals(rhs.SyncActicvityTaskAttributes))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.SourceTaskId, rhs.SourceTaskId) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationTask.
+func (v *ReplicationTask) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TaskType != nil {
+		err = multierr.Append(err, enc.AddObject("taskType", *v.TaskType))
+	}
+	if v.DomainTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("domainTaskAttributes", v.DomainTaskAttributes))
+	}
+	if v.HistoryTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("historyTaskAttributes", v.HistoryTaskAttributes))
+	}
+	if v.SyncShardStatusTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("syncShardStatusTaskAttributes", v.SyncShardStatusTaskAttributes))
+	}
+	if v.SyncActicvityTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("syncActicvityTaskAttributes", v.SyncActicvityTaskAttributes))
+	}
+	if v.SourceTaskId != nil {
+		enc.AddInt64("sourceTaskId", *v.SourceTaskId)
+	}
+	return err
+}
+
+// GetTaskType returns the value of TaskType if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetTaskType() (o ReplicationTaskType) {
+	if v.TaskType != nil {
+		return *v.TaskType
+	}
+
+	return
+}
+
+// GetDomainTaskAttributes returns the value of DomainTaskAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetDomainTaskAttributes() (o *DomainTaskAttributes) {
+	if v.DomainTaskAttributes != nil {
+		return v.DomainTaskAttributes
+	}
+
+	return
+}
+
+// GetHistoryTaskAttributes returns the value of HistoryTaskAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetHistoryTaskAttributes() (o *HistoryTaskAttributes) {
+	if v.HistoryTaskAttributes != nil {
+		return v.HistoryTaskAttributes
+	}
+
+	return
+}
+
+// GetSyncShardStatusTaskAttributes returns the value of SyncShardStatusTaskAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetSyncShardStatusTaskAttributes() (o *SyncShardStatusTaskAttributes) {
+	if v.SyncShardStatusTaskAttributes != nil {
+		return v.SyncShardStatusTaskAttributes
+	}
+
+	return
+}
+
+// GetSyncActicvityTaskAttributes returns the value of SyncActicvityTaskAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetSyncActicvityTaskAttributes() (o *SyncActicvityTaskAttributes) {
+	if v.SyncActicvityTaskAttributes != nil {
+		return v.SyncActicvityTaskAttributes
+	}
+
+	return
+}
+
+// GetSourceTaskId returns the value of SourceTaskId if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetSourceTaskId() (o int64) {
+	if v.SourceTaskId != nil {
+		return *v.SourceTaskId
+	}
+
+	return
+}
+
+type ReplicationTaskType int32
+
+const (
+	ReplicationTaskTypeDomain          ReplicationTaskType = 0
+	ReplicationTaskTypeHistory         ReplicationTaskType = 1
+	ReplicationTaskTypeSyncShardStatus ReplicationTaskType = 2
+	ReplicationTaskTypeSyncActivity    ReplicationTaskType = 3
+)
+
+// ReplicationTaskType_Values returns all recognized values of ReplicationTaskType.
+func ReplicationTaskType_Values() []ReplicationTaskType {
+	return []ReplicationTaskType{
+		ReplicationTaskTypeDomain,
+		ReplicationTaskTypeHistory,
+		ReplicationTaskTypeSyncShardStatus,
+		ReplicationTaskTypeSyncActivity,
+	}
+}
+
+// UnmarshalText tries to decode ReplicationTaskType from a byte slice
+// containing its name.
+//
+//   var v ReplicationTaskType
+//   err := v.UnmarshalText([]byte("Domain"))
+func (v *ReplicationTaskType) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "Domain":
+		*v = ReplicationTaskTypeDomain
+		return nil
+	case "History":
+		*v = ReplicationTaskTypeHistory
+		return nil
+	case "SyncShardStatus":
+		*v = ReplicationTaskTypeSyncShardStatus
+		return nil
+	case "SyncActivity":
+		*v = ReplicationTaskTypeSyncActivity
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ReplicationTaskType", err)
+		}
+		*v = ReplicationTaskType(val)
+		return nil
 	}
+}
 
-	return
+// MarshalText encodes ReplicationTaskType to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v ReplicationTaskType) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("Domain"), nil
+	case 1:
+		return []byte("History"), nil
+	case 2:
+		return []byte("SyncShardStatus"), nil
+	case 3:
+		return []byte("SyncActivity"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
 
-// GetFirstEventId returns the value of FirstEventId if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetFirstEventId() (o int64) {
-	if v.FirstEventId != nil {
-		return *v.FirstEventId
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationTaskType.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v ReplicationTaskType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "Domain")
+	case 1:
+		enc.AddString("name", "History")
+	case 2:
+		enc.AddString("name", "SyncShardStatus")
+	case 3:
+		enc.AddString("name", "SyncActivity")
 	}
+	return nil
+}
 
-	return
+// Ptr returns a pointer to this enum value.
+func (v ReplicationTaskType) Ptr() *ReplicationTaskType {
+	return &v
 }
 
-// GetNextEventId returns the value of NextEventId if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetNextEventId() (o int64) {
-	if v.NextEventId != nil {
-		return *v.NextEventId
+// ToWire translates ReplicationTaskType into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v ReplicationTaskType) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes ReplicationTaskType from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return ReplicationTaskType(0), err
+//   }
+//
+//   var v ReplicationTaskType
+//   if err := v.FromWire(x); err != nil {
+//     return ReplicationTaskType(0), err
+//   }
+//   return v, nil
+func (v *ReplicationTaskType) FromWire(w wire.Value) error {
+	*v = (ReplicationTaskType)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of ReplicationTaskType.
+func (v ReplicationTaskType) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "Domain"
+	case 1:
+		return "History"
+	case 2:
+		return "SyncShardStatus"
+	case 3:
+		return "SyncActivity"
 	}
+	return fmt.Sprintf("ReplicationTaskType(%d)", w)
+}
 
-	return
+// Equals returns true if this ReplicationTaskType value matches the provided
+// value.
+func (v ReplicationTaskType) Equals(rhs ReplicationTaskType) bool {
+	return v == rhs
 }
 
-// GetVersion returns the value of Version if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetVersion() (o int64) {
-	if v.Version != nil {
-		return *v.Version
+// MarshalJSON serializes ReplicationTaskType into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v ReplicationTaskType) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"Domain\""), nil
+	case 1:
+		return ([]byte)("\"History\""), nil
+	case 2:
+		return ([]byte)("\"SyncShardStatus\""), nil
+	case 3:
+		return ([]byte)("\"SyncActivity\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode ReplicationTaskType from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *ReplicationTaskType) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "ReplicationTaskType")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "ReplicationTaskType")
+		}
+		*v = (ReplicationTaskType)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "ReplicationTaskType")
+	}
+}
+
+type ReplicationToken struct {
+	ShardID                *int32 `json:"shardID,omitempty"`
+	LastProcessedMessageId *int64 `json:"lastProcessedMessageId,omitempty"`
+}
+
+// ToWire translates a ReplicationToken struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicationToken) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.LastProcessedMessageId != nil {
+		w, err = wire.NewValueI64(*(v.LastProcessedMessageId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ReplicationToken struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationToken struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReplicationToken
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReplicationToken) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastProcessedMessageId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
 	}
 
-	return
+	return nil
 }
 
-// GetReplicationInfo returns the value of ReplicationInfo if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetReplicationInfo() (o map[string]*shared.ReplicationInfo) {
-	if v.ReplicationInfo != nil {
-		return v.ReplicationInfo
+// String returns a readable string representation of a ReplicationToken
+// struct.
+func (v *ReplicationToken) String() string {
+	if v == nil {
+		return "<nil>"
 	}
 
-	return
+	var fields [2]string
+	i := 0
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+	if v.LastProcessedMessageId != nil {
+		fields[i] = fmt.Sprintf("LastProcessedMessageId: %v", *(v.LastProcessedMessageId))
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationToken{%v}", strings.Join(fields[:i], ", "))
 }
 
-// GetHistory returns the value of History if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetHistory() (o *shared.History) {
-	if v.History != nil {
-		return v.History
+// Equals returns true if all the fields of this ReplicationToken match the
+// provided ReplicationToken.
+//
+// This function performs a deep comparison.
+func (v *ReplicationToken) Equals(rhs *ReplicationToken) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastProcessedMessageId, rhs.LastProcessedMessageId) {
+		return false
 	}
 
-	return
+	return true
 }
 
-// GetNewRunHistory returns the value of NewRunHistory if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetNewRunHistory() (o *shared.History) {
-	if v.NewRunHistory != nil {
-		return v.NewRunHistory
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationToken.
+func (v *ReplicationToken) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
 	}
-
-	return
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	if v.LastProcessedMessageId != nil {
+		enc.AddInt64("lastProcessedMessageId", *v.LastProcessedMessageId)
+	}
+	return err
 }
 
-// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
+// GetShardID returns the value of ShardID if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskAttributes) GetEventStoreVersion() (o int32) {
-	if v.EventStoreVersion != nil {
-		return *v.EventStoreVersion
+func (v *ReplicationToken) GetShardID() (o int32) {
+	if v.ShardID != nil {
+		return *v.ShardID
 	}
 
 	return
 }
 
-// GetNewRunEventStoreVersion returns the value of NewRunEventStoreVersion if it is set or its
+// GetLastProcessedMessageId returns the value of LastProcessedMessageId if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskAttributes) GetNewRunEventStoreVersion() (o int32) {
-	if v.NewRunEventStoreVersion != nil {
-		return *v.NewRunEventStoreVersion
+func (v *ReplicationToken) GetLastProcessedMessageId() (o int64) {
+	if v.LastProcessedMessageId != nil {
+		return *v.LastProcessedMessageId
 	}
 
 	return
 }
 
-// GetResetWorkflow returns the value of ResetWorkflow if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetResetWorkflow() (o bool) {
-	if v.ResetWorkflow != nil {
-		return *v.ResetWorkflow
+type ShardReplicationStatus struct {
+	ShardID                        *int32           `json:"shardID,omitempty"`
+	MaxReplicationTaskId           *int64           `json:"maxReplicationTaskId,omitempty"`
+	ReplicatorAckLevel             *int64           `json:"replicatorAckLevel,omitempty"`
+	RemoteClusterAckLevels         map[string]int64 `json:"remoteClusterAckLevels,omitempty"`
+	SourceClusterReplicationLevels map[string]int64 `json:"sourceClusterReplicationLevels,omitempty"`
+	RemoteClusterShardTimes        map[string]int64 `json:"remoteClusterShardTimes,omitempty"`
+	PendingTasksByDomainId         map[string]int64 `json:"pendingTasksByDomainId,omitempty"`
+	PendingTasksTruncated          *bool            `json:"pendingTasksTruncated,omitempty"`
+	HeldTasksByDomainId            map[string]int64 `json:"heldTasksByDomainId,omitempty"`
+}
+
+type _Map_String_I64_MapItemList map[string]int64
+
+func (m _Map_String_I64_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI64(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	return
+func (m _Map_String_I64_MapItemList) Size() int {
+	return len(m)
 }
 
-type ReplicationTask struct {
-	TaskType                      *ReplicationTaskType           `json:"taskType,omitempty"`
-	DomainTaskAttributes          *DomainTaskAttributes          `json:"domainTaskAttributes,omitempty"`
-	HistoryTaskAttributes         *HistoryTaskAttributes         `json:"historyTaskAttributes,omitempty"`
-	SyncShardStatusTaskAttributes *SyncShardStatusTaskAttributes `json:"syncShardStatusTaskAttributes,omitempty"`
-	SyncActicvityTaskAttributes   *SyncActicvityTaskAttributes   `json:"syncActicvityTaskAttributes,omitempty"`
+func (_Map_String_I64_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
 }
 
-// ToWire translates a ReplicationTask struct into a Thrift-level intermediate
+func (_Map_String_I64_MapItemList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_Map_String_I64_MapItemList) Close() {}
+
+// ToWire translates a ShardReplicationStatus struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1435,93 +4190,123 @@ type ReplicationTask struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ReplicationTask) ToWire() (wire.Value, error) {
+func (v *ShardReplicationStatus) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [9]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TaskType != nil {
-		w, err = v.TaskType.ToWire()
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.DomainTaskAttributes != nil {
-		w, err = v.DomainTaskAttributes.ToWire()
+	if v.MaxReplicationTaskId != nil {
+		w, err = wire.NewValueI64(*(v.MaxReplicationTaskId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.HistoryTaskAttributes != nil {
-		w, err = v.HistoryTaskAttributes.ToWire()
+	if v.ReplicatorAckLevel != nil {
+		w, err = wire.NewValueI64(*(v.ReplicatorAckLevel)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.SyncShardStatusTaskAttributes != nil {
-		w, err = v.SyncShardStatusTaskAttributes.ToWire()
+	if v.RemoteClusterAckLevels != nil {
+		w, err = wire.NewValueMap(_Map_String_I64_MapItemList(v.RemoteClusterAckLevels)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.SyncActicvityTaskAttributes != nil {
-		w, err = v.SyncActicvityTaskAttributes.ToWire()
+	if v.SourceClusterReplicationLevels != nil {
+		w, err = wire.NewValueMap(_Map_String_I64_MapItemList(v.SourceClusterReplicationLevels)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.RemoteClusterShardTimes != nil {
+		w, err = wire.NewValueMap(_Map_String_I64_MapItemList(v.RemoteClusterShardTimes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.PendingTasksByDomainId != nil {
+		w, err = wire.NewValueMap(_Map_String_I64_MapItemList(v.PendingTasksByDomainId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.PendingTasksTruncated != nil {
+		w, err = wire.NewValueBool(*(v.PendingTasksTruncated)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.HeldTasksByDomainId != nil {
+		w, err = wire.NewValueMap(_Map_String_I64_MapItemList(v.HeldTasksByDomainId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ReplicationTaskType_Read(w wire.Value) (ReplicationTaskType, error) {
-	var v ReplicationTaskType
-	err := v.FromWire(w)
-	return v, err
-}
+func _Map_String_I64_Read(m wire.MapItemList) (map[string]int64, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
 
-func _DomainTaskAttributes_Read(w wire.Value) (*DomainTaskAttributes, error) {
-	var v DomainTaskAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
+	if m.ValueType() != wire.TI64 {
+		return nil, nil
+	}
 
-func _HistoryTaskAttributes_Read(w wire.Value) (*HistoryTaskAttributes, error) {
-	var v HistoryTaskAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
+	o := make(map[string]int64, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
 
-func _SyncShardStatusTaskAttributes_Read(w wire.Value) (*SyncShardStatusTaskAttributes, error) {
-	var v SyncShardStatusTaskAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
+		v, err := x.Value.GetI64(), error(nil)
+		if err != nil {
+			return err
+		}
 
-func _SyncActicvityTaskAttributes_Read(w wire.Value) (*SyncActicvityTaskAttributes, error) {
-	var v SyncActicvityTaskAttributes
-	err := v.FromWire(w)
-	return &v, err
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
 }
 
-// FromWire deserializes a ReplicationTask struct from its Thrift-level
+// FromWire deserializes a ShardReplicationStatus struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ReplicationTask struct
+// An error is returned if we were unable to build a ShardReplicationStatus struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1529,53 +4314,91 @@ func _SyncActicvityTaskAttributes_Read(w wire.Value) (*SyncActicvityTaskAttribut
 //     return nil, err
 //   }
 //
-//   var v ReplicationTask
+//   var v ShardReplicationStatus
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ReplicationTask) FromWire(w wire.Value) error {
+func (v *ShardReplicationStatus) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TI32 {
-				var x ReplicationTaskType
-				x, err = _ReplicationTaskType_Read(field.Value)
-				v.TaskType = &x
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.DomainTaskAttributes, err = _DomainTaskAttributes_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.MaxReplicationTaskId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.HistoryTaskAttributes, err = _HistoryTaskAttributes_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ReplicatorAckLevel = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.SyncShardStatusTaskAttributes, err = _SyncShardStatusTaskAttributes_Read(field.Value)
+			if field.Value.Type() == wire.TMap {
+				v.RemoteClusterAckLevels, err = _Map_String_I64_Read(field.Value.GetMap())
 				if err != nil {
 					return err
 				}
 
 			}
 		case 50:
-			if field.Value.Type() == wire.TStruct {
-				v.SyncActicvityTaskAttributes, err = _SyncActicvityTaskAttributes_Read(field.Value)
+			if field.Value.Type() == wire.TMap {
+				v.SourceClusterReplicationLevels, err = _Map_String_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TMap {
+				v.RemoteClusterShardTimes, err = _Map_String_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TMap {
+				v.PendingTasksByDomainId, err = _Map_String_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.PendingTasksTruncated = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TMap {
+				v.HeldTasksByDomainId, err = _Map_String_I64_Read(field.Value.GetMap())
 				if err != nil {
 					return err
 				}
@@ -1587,347 +4410,248 @@ func (v *ReplicationTask) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ReplicationTask
+// String returns a readable string representation of a ShardReplicationStatus
 // struct.
-func (v *ReplicationTask) String() string {
+func (v *ShardReplicationStatus) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [9]string
 	i := 0
-	if v.TaskType != nil {
-		fields[i] = fmt.Sprintf("TaskType: %v", *(v.TaskType))
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
 		i++
 	}
-	if v.DomainTaskAttributes != nil {
-		fields[i] = fmt.Sprintf("DomainTaskAttributes: %v", v.DomainTaskAttributes)
+	if v.MaxReplicationTaskId != nil {
+		fields[i] = fmt.Sprintf("MaxReplicationTaskId: %v", *(v.MaxReplicationTaskId))
 		i++
 	}
-	if v.HistoryTaskAttributes != nil {
-		fields[i] = fmt.Sprintf("HistoryTaskAttributes: %v", v.HistoryTaskAttributes)
+	if v.ReplicatorAckLevel != nil {
+		fields[i] = fmt.Sprintf("ReplicatorAckLevel: %v", *(v.ReplicatorAckLevel))
 		i++
 	}
-	if v.SyncShardStatusTaskAttributes != nil {
-		fields[i] = fmt.Sprintf("SyncShardStatusTaskAttributes: %v", v.SyncShardStatusTaskAttributes)
+	if v.RemoteClusterAckLevels != nil {
+		fields[i] = fmt.Sprintf("RemoteClusterAckLevels: %v", v.RemoteClusterAckLevels)
 		i++
 	}
-	if v.SyncActicvityTaskAttributes != nil {
-		fields[i] = fmt.Sprintf("SyncActicvityTaskAttributes: %v", v.SyncActicvityTaskAttributes)
+	if v.SourceClusterReplicationLevels != nil {
+		fields[i] = fmt.Sprintf("SourceClusterReplicationLevels: %v", v.SourceClusterReplicationLevels)
+		i++
+	}
+	if v.RemoteClusterShardTimes != nil {
+		fields[i] = fmt.Sprintf("RemoteClusterShardTimes: %v", v.RemoteClusterShardTimes)
+		i++
+	}
+	if v.PendingTasksByDomainId != nil {
+		fields[i] = fmt.Sprintf("PendingTasksByDomainId: %v", v.PendingTasksByDomainId)
+		i++
+	}
+	if v.PendingTasksTruncated != nil {
+		fields[i] = fmt.Sprintf("PendingTasksTruncated: %v", *(v.PendingTasksTruncated))
+		i++
+	}
+	if v.HeldTasksByDomainId != nil {
+		fields[i] = fmt.Sprintf("HeldTasksByDomainId: %v", v.HeldTasksByDomainId)
 		i++
 	}
 
-	return fmt.Sprintf("ReplicationTask{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ShardReplicationStatus{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _ReplicationTaskType_EqualsPtr(lhs, rhs *ReplicationTaskType) bool {
-	if lhs != nil && rhs != nil {
+func _Map_String_I64_Equals(lhs, rhs map[string]int64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
 
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
 	}
-	return lhs == nil && rhs == nil
+	return true
 }
 
-// Equals returns true if all the fields of this ReplicationTask match the
-// provided ReplicationTask.
+// Equals returns true if all the fields of this ShardReplicationStatus match the
+// provided ShardReplicationStatus.
 //
 // This function performs a deep comparison.
-func (v *ReplicationTask) Equals(rhs *ReplicationTask) bool {
+func (v *ShardReplicationStatus) Equals(rhs *ShardReplicationStatus) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_ReplicationTaskType_EqualsPtr(v.TaskType, rhs.TaskType) {
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
 		return false
 	}
-	if !((v.DomainTaskAttributes == nil && rhs.DomainTaskAttributes == nil) || (v.DomainTaskAttributes != nil && rhs.DomainTaskAttributes != nil && v.DomainTaskAttributes.Equals(rhs.DomainTaskAttributes))) {
+	if !_I64_EqualsPtr(v.MaxReplicationTaskId, rhs.MaxReplicationTaskId) {
 		return false
 	}
-	if !((v.HistoryTaskAttributes == nil && rhs.HistoryTaskAttributes == nil) || (v.HistoryTaskAttributes != nil && rhs.HistoryTaskAttributes != nil && v.HistoryTaskAttributes.Equals(rhs.HistoryTaskAttributes))) {
+	if !_I64_EqualsPtr(v.ReplicatorAckLevel, rhs.ReplicatorAckLevel) {
 		return false
 	}
-	if !((v.SyncShardStatusTaskAttributes == nil && rhs.SyncShardStatusTaskAttributes == nil) || (v.SyncShardStatusTaskAttributes != nil && rhs.SyncShardStatusTaskAttributes != nil && v.SyncShardStatusTaskAttributes.Equals(rhs.SyncShardStatusTaskAttributes))) {
+	if !((v.RemoteClusterAckLevels == nil && rhs.RemoteClusterAckLevels == nil) || (v.RemoteClusterAckLevels != nil && rhs.RemoteClusterAckLevels != nil && _Map_String_I64_Equals(v.RemoteClusterAckLevels, rhs.RemoteClusterAckLevels))) {
 		return false
 	}
-	if !((v.SyncActicvityTaskAttributes == nil && rhs.SyncActicvityTaskAttributes == nil) || (v.SyncActicvityTaskAttributes != nil && rhs.SyncActicvityTaskAttributes != nil && v.SyncActicvityTaskAttributes.Equals(rhs.SyncActicvityTaskAttributes))) {
+	if !((v.SourceClusterReplicationLevels == nil && rhs.SourceClusterReplicationLevels == nil) || (v.SourceClusterReplicationLevels != nil && rhs.SourceClusterReplicationLevels != nil && _Map_String_I64_Equals(v.SourceClusterReplicationLevels, rhs.SourceClusterReplicationLevels))) {
+		return false
+	}
+	if !((v.RemoteClusterShardTimes == nil && rhs.RemoteClusterShardTimes == nil) || (v.RemoteClusterShardTimes != nil && rhs.RemoteClusterShardTimes != nil && _Map_String_I64_Equals(v.RemoteClusterShardTimes, rhs.RemoteClusterShardTimes))) {
+		return false
+	}
+	if !((v.PendingTasksByDomainId == nil && rhs.PendingTasksByDomainId == nil) || (v.PendingTasksByDomainId != nil && rhs.PendingTasksByDomainId != nil && _Map_String_I64_Equals(v.PendingTasksByDomainId, rhs.PendingTasksByDomainId))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.PendingTasksTruncated, rhs.PendingTasksTruncated) {
+		return false
+	}
+	if !((v.HeldTasksByDomainId == nil && rhs.HeldTasksByDomainId == nil) || (v.HeldTasksByDomainId != nil && rhs.HeldTasksByDomainId != nil && _Map_String_I64_Equals(v.HeldTasksByDomainId, rhs.HeldTasksByDomainId))) {
 		return false
 	}
 
 	return true
 }
 
+type _Map_String_I64_Zapper map[string]int64
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ReplicationTask.
-func (v *ReplicationTask) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of _Map_String_I64_Zapper.
+func (m _Map_String_I64_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddInt64((string)(k), v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ShardReplicationStatus.
+func (v *ShardReplicationStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TaskType != nil {
-		err = multierr.Append(err, enc.AddObject("taskType", *v.TaskType))
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
 	}
-	if v.DomainTaskAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("domainTaskAttributes", v.DomainTaskAttributes))
+	if v.MaxReplicationTaskId != nil {
+		enc.AddInt64("maxReplicationTaskId", *v.MaxReplicationTaskId)
 	}
-	if v.HistoryTaskAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("historyTaskAttributes", v.HistoryTaskAttributes))
+	if v.ReplicatorAckLevel != nil {
+		enc.AddInt64("replicatorAckLevel", *v.ReplicatorAckLevel)
 	}
-	if v.SyncShardStatusTaskAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("syncShardStatusTaskAttributes", v.SyncShardStatusTaskAttributes))
+	if v.RemoteClusterAckLevels != nil {
+		err = multierr.Append(err, enc.AddObject("remoteClusterAckLevels", (_Map_String_I64_Zapper)(v.RemoteClusterAckLevels)))
 	}
-	if v.SyncActicvityTaskAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("syncActicvityTaskAttributes", v.SyncActicvityTaskAttributes))
+	if v.SourceClusterReplicationLevels != nil {
+		err = multierr.Append(err, enc.AddObject("sourceClusterReplicationLevels", (_Map_String_I64_Zapper)(v.SourceClusterReplicationLevels)))
+	}
+	if v.RemoteClusterShardTimes != nil {
+		err = multierr.Append(err, enc.AddObject("remoteClusterShardTimes", (_Map_String_I64_Zapper)(v.RemoteClusterShardTimes)))
+	}
+	if v.PendingTasksByDomainId != nil {
+		err = multierr.Append(err, enc.AddObject("pendingTasksByDomainId", (_Map_String_I64_Zapper)(v.PendingTasksByDomainId)))
+	}
+	if v.PendingTasksTruncated != nil {
+		enc.AddBool("pendingTasksTruncated", *v.PendingTasksTruncated)
+	}
+	if v.HeldTasksByDomainId != nil {
+		err = multierr.Append(err, enc.AddObject("heldTasksByDomainId", (_Map_String_I64_Zapper)(v.HeldTasksByDomainId)))
 	}
 	return err
 }
 
-// GetTaskType returns the value of TaskType if it is set or its
+// GetShardID returns the value of ShardID if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTask) GetTaskType() (o ReplicationTaskType) {
-	if v.TaskType != nil {
-		return *v.TaskType
+func (v *ShardReplicationStatus) GetShardID() (o int32) {
+	if v.ShardID != nil {
+		return *v.ShardID
 	}
 
 	return
 }
 
-// GetDomainTaskAttributes returns the value of DomainTaskAttributes if it is set or its
+// GetMaxReplicationTaskId returns the value of MaxReplicationTaskId if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTask) GetDomainTaskAttributes() (o *DomainTaskAttributes) {
-	if v.DomainTaskAttributes != nil {
-		return v.DomainTaskAttributes
+func (v *ShardReplicationStatus) GetMaxReplicationTaskId() (o int64) {
+	if v.MaxReplicationTaskId != nil {
+		return *v.MaxReplicationTaskId
 	}
 
 	return
 }
 
-// GetHistoryTaskAttributes returns the value of HistoryTaskAttributes if it is set or its
+// GetReplicatorAckLevel returns the value of ReplicatorAckLevel if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTask) GetHistoryTaskAttributes() (o *HistoryTaskAttributes) {
-	if v.HistoryTaskAttributes != nil {
-		return v.HistoryTaskAttributes
+func (v *ShardReplicationStatus) GetReplicatorAckLevel() (o int64) {
+	if v.ReplicatorAckLevel != nil {
+		return *v.ReplicatorAckLevel
 	}
 
 	return
 }
 
-// GetSyncShardStatusTaskAttributes returns the value of SyncShardStatusTaskAttributes if it is set or its
+// GetRemoteClusterAckLevels returns the value of RemoteClusterAckLevels if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTask) GetSyncShardStatusTaskAttributes() (o *SyncShardStatusTaskAttributes) {
-	if v.SyncShardStatusTaskAttributes != nil {
-		return v.SyncShardStatusTaskAttributes
+func (v *ShardReplicationStatus) GetRemoteClusterAckLevels() (o map[string]int64) {
+	if v.RemoteClusterAckLevels != nil {
+		return v.RemoteClusterAckLevels
 	}
 
 	return
 }
 
-// GetSyncActicvityTaskAttributes returns the value of SyncActicvityTaskAttributes if it is set or its
+// GetSourceClusterReplicationLevels returns the value of SourceClusterReplicationLevels if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTask) GetSyncActicvityTaskAttributes() (o *SyncActicvityTaskAttributes) {
-	if v.SyncActicvityTaskAttributes != nil {
-		return v.SyncActicvityTaskAttributes
+func (v *ShardReplicationStatus) GetSourceClusterReplicationLevels() (o map[string]int64) {
+	if v.SourceClusterReplicationLevels != nil {
+		return v.SourceClusterReplicationLevels
 	}
 
 	return
 }
 
-type ReplicationTaskType int32
-
-const (
-	ReplicationTaskTypeDomain          ReplicationTaskType = 0
-	ReplicationTaskTypeHistory         ReplicationTaskType = 1
-	ReplicationTaskTypeSyncShardStatus ReplicationTaskType = 2
-	ReplicationTaskTypeSyncActivity    ReplicationTaskType = 3
-)
-
-// ReplicationTaskType_Values returns all recognized values of ReplicationTaskType.
-func ReplicationTaskType_Values() []ReplicationTaskType {
-	return []ReplicationTaskType{
-		ReplicationTaskTypeDomain,
-		ReplicationTaskTypeHistory,
-		ReplicationTaskTypeSyncShardStatus,
-		ReplicationTaskTypeSyncActivity,
-	}
-}
-
-// UnmarshalText tries to decode ReplicationTaskType from a byte slice
-// containing its name.
-//
-//   var v ReplicationTaskType
-//   err := v.UnmarshalText([]byte("Domain"))
-func (v *ReplicationTaskType) UnmarshalText(value []byte) error {
-	switch s := string(value); s {
-	case "Domain":
-		*v = ReplicationTaskTypeDomain
-		return nil
-	case "History":
-		*v = ReplicationTaskTypeHistory
-		return nil
-	case "SyncShardStatus":
-		*v = ReplicationTaskTypeSyncShardStatus
-		return nil
-	case "SyncActivity":
-		*v = ReplicationTaskTypeSyncActivity
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ReplicationTaskType", err)
-		}
-		*v = ReplicationTaskType(val)
-		return nil
+// GetRemoteClusterShardTimes returns the value of RemoteClusterShardTimes if it is set or its
+// zero value if it is unset.
+func (v *ShardReplicationStatus) GetRemoteClusterShardTimes() (o map[string]int64) {
+	if v.RemoteClusterShardTimes != nil {
+		return v.RemoteClusterShardTimes
 	}
-}
 
-// MarshalText encodes ReplicationTaskType to text.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
-//
-// This implements the TextMarshaler interface.
-func (v ReplicationTaskType) MarshalText() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return []byte("Domain"), nil
-	case 1:
-		return []byte("History"), nil
-	case 2:
-		return []byte("SyncShardStatus"), nil
-	case 3:
-		return []byte("SyncActivity"), nil
-	}
-	return []byte(strconv.FormatInt(int64(v), 10)), nil
+	return
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ReplicationTaskType.
-// Enums are logged as objects, where the value is logged with key "value", and
-// if this value's name is known, the name is logged with key "name".
-func (v ReplicationTaskType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddInt32("value", int32(v))
-	switch int32(v) {
-	case 0:
-		enc.AddString("name", "Domain")
-	case 1:
-		enc.AddString("name", "History")
-	case 2:
-		enc.AddString("name", "SyncShardStatus")
-	case 3:
-		enc.AddString("name", "SyncActivity")
+// GetPendingTasksByDomainId returns the value of PendingTasksByDomainId if it is set or its
+// zero value if it is unset.
+func (v *ShardReplicationStatus) GetPendingTasksByDomainId() (o map[string]int64) {
+	if v.PendingTasksByDomainId != nil {
+		return v.PendingTasksByDomainId
 	}
-	return nil
-}
-
-// Ptr returns a pointer to this enum value.
-func (v ReplicationTaskType) Ptr() *ReplicationTaskType {
-	return &v
-}
-
-// ToWire translates ReplicationTaskType into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// Enums are represented as 32-bit integers over the wire.
-func (v ReplicationTaskType) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
-}
 
-// FromWire deserializes ReplicationTaskType from its Thrift-level
-// representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
-//   if err != nil {
-//     return ReplicationTaskType(0), err
-//   }
-//
-//   var v ReplicationTaskType
-//   if err := v.FromWire(x); err != nil {
-//     return ReplicationTaskType(0), err
-//   }
-//   return v, nil
-func (v *ReplicationTaskType) FromWire(w wire.Value) error {
-	*v = (ReplicationTaskType)(w.GetI32())
-	return nil
+	return
 }
 
-// String returns a readable string representation of ReplicationTaskType.
-func (v ReplicationTaskType) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "Domain"
-	case 1:
-		return "History"
-	case 2:
-		return "SyncShardStatus"
-	case 3:
-		return "SyncActivity"
+// GetPendingTasksTruncated returns the value of PendingTasksTruncated if it is set or its
+// zero value if it is unset.
+func (v *ShardReplicationStatus) GetPendingTasksTruncated() (o bool) {
+	if v.PendingTasksTruncated != nil {
+		return *v.PendingTasksTruncated
 	}
-	return fmt.Sprintf("ReplicationTaskType(%d)", w)
-}
-
-// Equals returns true if this ReplicationTaskType value matches the provided
-// value.
-func (v ReplicationTaskType) Equals(rhs ReplicationTaskType) bool {
-	return v == rhs
-}
 
-// MarshalJSON serializes ReplicationTaskType into JSON.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
-//
-// This implements json.Marshaler.
-func (v ReplicationTaskType) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"Domain\""), nil
-	case 1:
-		return ([]byte)("\"History\""), nil
-	case 2:
-		return ([]byte)("\"SyncShardStatus\""), nil
-	case 3:
-		return ([]byte)("\"SyncActivity\""), nil
-	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+	return
 }
 
-// UnmarshalJSON attempts to decode ReplicationTaskType from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *ReplicationTaskType) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
+// GetHeldTasksByDomainId returns the value of HeldTasksByDomainId if it is set or its
+// zero value if it is unset.
+func (v *ShardReplicationStatus) GetHeldTasksByDomainId() (o map[string]int64) {
+	if v.HeldTasksByDomainId != nil {
+		return v.HeldTasksByDomainId
 	}
 
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
-		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "ReplicationTaskType")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "ReplicationTaskType")
-		}
-		*v = (ReplicationTaskType)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "ReplicationTaskType")
-	}
+	return
 }
 
 type SyncActicvityTaskAttributes struct {
@@ -1192,6 +1192,7 @@ type ActivityTaskScheduledEventAttributes struct {
 	HeartbeatTimeoutSeconds       *int32        `json:"heartbeatTimeoutSeconds,omitempty"`
 	DecisionTaskCompletedEventId  *int64        `json:"decisionTaskCompletedEventId,omitempty"`
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
+	Priority                      *int32        `json:"priority,omitempty"`
 }
 
 // ToWire translates a ActivityTaskScheduledEventAttributes struct into a Thrift-level intermediate
@@ -1211,7 +1212,7 @@ type ActivityTaskScheduledEventAttributes struct {
 //   }
 func (v *ActivityTaskScheduledEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [11]wire.Field
+		fields [12]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -1305,6 +1306,14 @@ func (v *ActivityTaskScheduledEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 110, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -1450,6 +1459,16 @@ func (v *ActivityTaskScheduledEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 120:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -1464,7 +1483,7 @@ func (v *ActivityTaskScheduledEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [11]string
+	var fields [12]string
 	i := 0
 	if v.ActivityId != nil {
 		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
@@ -1510,6 +1529,10 @@ func (v *ActivityTaskScheduledEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("RetryPolicy: %v", v.RetryPolicy)
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
 
 	return fmt.Sprintf("ActivityTaskScheduledEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -1567,6 +1590,9 @@ func (v *ActivityTaskScheduledEventAttributes) Equals(rhs *ActivityTaskScheduled
 	if !((v.RetryPolicy == nil && rhs.RetryPolicy == nil) || (v.RetryPolicy != nil && rhs.RetryPolicy != nil && v.RetryPolicy.Equals(rhs.RetryPolicy))) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
 
 	return true
 }
@@ -1610,6 +1636,9 @@ func (v *ActivityTaskScheduledEventAttributes) MarshalLogObject(enc zapcore.Obje
 	if v.RetryPolicy != nil {
 		err = multierr.Append(err, enc.AddObject("retryPolicy", v.RetryPolicy))
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
 	return err
 }
 
@@ -1723,6 +1752,16 @@ func (v *ActivityTaskScheduledEventAttributes) GetRetryPolicy() (o *RetryPolicy)
 	return
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *ActivityTaskScheduledEventAttributes) GetPriority() (o int32) {
+	if v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
 type ActivityTaskStartedEventAttributes struct {
 	ScheduledEventId *int64  `json:"scheduledEventId,omitempty"`
 	Identity         *string `json:"identity,omitempty"`
@@ -9918,6 +9957,7 @@ type DescribeDomainResponse struct {
 	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
 	FailoverVersion          *int64                          `json:"failoverVersion,omitempty"`
 	IsGlobalDomain           *bool                           `json:"isGlobalDomain,omitempty"`
+	FailoverInfo             *DomainFailoverInfo             `json:"failoverInfo,omitempty"`
 }
 
 // ToWire translates a DescribeDomainResponse struct into a Thrift-level intermediate
@@ -9937,7 +9977,7 @@ type DescribeDomainResponse struct {
 //   }
 func (v *DescribeDomainResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -9983,6 +10023,14 @@ func (v *DescribeDomainResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.FailoverInfo != nil {
+		w, err = v.FailoverInfo.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -10005,6 +10053,12 @@ func _DomainReplicationConfiguration_Read(w wire.Value) (*DomainReplicationConfi
 	return &v, err
 }
 
+func _DomainFailoverInfo_Read(w wire.Value) (*DomainFailoverInfo, error) {
+	var v DomainFailoverInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a DescribeDomainResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -10070,6 +10124,14 @@ func (v *DescribeDomainResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.FailoverInfo, err = _DomainFailoverInfo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -10084,7 +10146,7 @@ func (v *DescribeDomainResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.DomainInfo != nil {
 		fields[i] = fmt.Sprintf("DomainInfo: %v", v.DomainInfo)
@@ -10106,6 +10168,10 @@ func (v *DescribeDomainResponse) String() string {
 		fields[i] = fmt.Sprintf("IsGlobalDomain: %v", *(v.IsGlobalDomain))
 		i++
 	}
+	if v.FailoverInfo != nil {
+		fields[i] = fmt.Sprintf("FailoverInfo: %v", v.FailoverInfo)
+		i++
+	}
 
 	return fmt.Sprintf("DescribeDomainResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -10145,6 +10211,9 @@ func (v *DescribeDomainResponse) Equals(rhs *DescribeDomainResponse) bool {
 	if !_Bool_EqualsPtr(v.IsGlobalDomain, rhs.IsGlobalDomain) {
 		return false
 	}
+	if !((v.FailoverInfo == nil && rhs.FailoverInfo == nil) || (v.FailoverInfo != nil && rhs.FailoverInfo != nil && v.FailoverInfo.Equals(rhs.FailoverInfo))) {
+		return false
+	}
 
 	return true
 }
@@ -10170,6 +10239,9 @@ func (v *DescribeDomainResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (er
 	if v.IsGlobalDomain != nil {
 		enc.AddBool("isGlobalDomain", *v.IsGlobalDomain)
 	}
+	if v.FailoverInfo != nil {
+		err = multierr.Append(err, enc.AddObject("failoverInfo", v.FailoverInfo))
+	}
 	return err
 }
 
@@ -10223,6 +10295,16 @@ func (v *DescribeDomainResponse) GetIsGlobalDomain() (o bool) {
 	return
 }
 
+// GetFailoverInfo returns the value of FailoverInfo if it is set or its
+// zero value if it is unset.
+func (v *DescribeDomainResponse) GetFailoverInfo() (o *DomainFailoverInfo) {
+	if v.FailoverInfo != nil {
+		return v.FailoverInfo
+	}
+
+	return
+}
+
 type DescribeHistoryHostRequest struct {
 	HostAddress      *string            `json:"hostAddress,omitempty"`
 	ShardIdForHost   *int32             `json:"shardIdForHost,omitempty"`
@@ -10793,6 +10875,348 @@ func (v *DescribeHistoryHostResponse) GetAddress() (o string) {
 	return
 }
 
+type DescribeTaskListDispatchRateRequest struct {
+	Domain       *string       `json:"domain,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+}
+
+// ToWire translates a DescribeTaskListDispatchRateRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DescribeTaskListDispatchRateRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.TaskListType != nil {
+		w, err = v.TaskListType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DescribeTaskListDispatchRateRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeTaskListDispatchRateRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DescribeTaskListDispatchRateRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DescribeTaskListDispatchRateRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x TaskListType
+				x, err = _TaskListType_Read(field.Value)
+				v.TaskListType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DescribeTaskListDispatchRateRequest
+// struct.
+func (v *DescribeTaskListDispatchRateRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.TaskListType != nil {
+		fields[i] = fmt.Sprintf("TaskListType: %v", *(v.TaskListType))
+		i++
+	}
+
+	return fmt.Sprintf("DescribeTaskListDispatchRateRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DescribeTaskListDispatchRateRequest match the
+// provided DescribeTaskListDispatchRateRequest.
+//
+// This function performs a deep comparison.
+func (v *DescribeTaskListDispatchRateRequest) Equals(rhs *DescribeTaskListDispatchRateRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_TaskListType_EqualsPtr(v.TaskListType, rhs.TaskListType) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DescribeTaskListDispatchRateRequest.
+func (v *DescribeTaskListDispatchRateRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.TaskListType != nil {
+		err = multierr.Append(err, enc.AddObject("taskListType", *v.TaskListType))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListDispatchRateRequest) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListDispatchRateRequest) GetTaskList() (o *TaskList) {
+	if v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// GetTaskListType returns the value of TaskListType if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListDispatchRateRequest) GetTaskListType() (o TaskListType) {
+	if v.TaskListType != nil {
+		return *v.TaskListType
+	}
+
+	return
+}
+
+type DescribeTaskListDispatchRateResponse struct {
+	DispatchRatePerSecond *float64 `json:"dispatchRatePerSecond,omitempty"`
+}
+
+// ToWire translates a DescribeTaskListDispatchRateResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DescribeTaskListDispatchRateResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DispatchRatePerSecond != nil {
+		w, err = wire.NewValueDouble(*(v.DispatchRatePerSecond)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DescribeTaskListDispatchRateResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeTaskListDispatchRateResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DescribeTaskListDispatchRateResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DescribeTaskListDispatchRateResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.DispatchRatePerSecond = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DescribeTaskListDispatchRateResponse
+// struct.
+func (v *DescribeTaskListDispatchRateResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.DispatchRatePerSecond != nil {
+		fields[i] = fmt.Sprintf("DispatchRatePerSecond: %v", *(v.DispatchRatePerSecond))
+		i++
+	}
+
+	return fmt.Sprintf("DescribeTaskListDispatchRateResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DescribeTaskListDispatchRateResponse match the
+// provided DescribeTaskListDispatchRateResponse.
+//
+// This function performs a deep comparison.
+func (v *DescribeTaskListDispatchRateResponse) Equals(rhs *DescribeTaskListDispatchRateResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_Double_EqualsPtr(v.DispatchRatePerSecond, rhs.DispatchRatePerSecond) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DescribeTaskListDispatchRateResponse.
+func (v *DescribeTaskListDispatchRateResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DispatchRatePerSecond != nil {
+		enc.AddFloat64("dispatchRatePerSecond", *v.DispatchRatePerSecond)
+	}
+	return err
+}
+
+// GetDispatchRatePerSecond returns the value of DispatchRatePerSecond if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListDispatchRateResponse) GetDispatchRatePerSecond() (o float64) {
+	if v.DispatchRatePerSecond != nil {
+		return *v.DispatchRatePerSecond
+	}
+
+	return
+}
+
 type DescribeTaskListRequest struct {
 	Domain       *string       `json:"domain,omitempty"`
 	TaskList     *TaskList     `json:"taskList,omitempty"`
@@ -11020,6 +11444,7 @@ func (v *DescribeTaskListRequest) GetTaskListType() (o TaskListType) {
 
 type DescribeTaskListResponse struct {
 	Pollers []*PollerInfo `json:"pollers,omitempty"`
+	Paused  *bool         `json:"paused,omitempty"`
 }
 
 type _List_PollerInfo_ValueList []*PollerInfo
@@ -11068,7 +11493,7 @@ func (_List_PollerInfo_ValueList) Close() {}
 //   }
 func (v *DescribeTaskListResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -11082,6 +11507,14 @@ func (v *DescribeTaskListResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.Paused != nil {
+		w, err = wire.NewValueBool(*(v.Paused)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -11139,6 +11572,16 @@ func (v *DescribeTaskListResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 20:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Paused = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -11153,12 +11596,16 @@ func (v *DescribeTaskListResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
 	if v.Pollers != nil {
 		fields[i] = fmt.Sprintf("Pollers: %v", v.Pollers)
 		i++
 	}
+	if v.Paused != nil {
+		fields[i] = fmt.Sprintf("Paused: %v", *(v.Paused))
+		i++
+	}
 
 	return fmt.Sprintf("DescribeTaskListResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -11191,6 +11638,9 @@ func (v *DescribeTaskListResponse) Equals(rhs *DescribeTaskListResponse) bool {
 	if !((v.Pollers == nil && rhs.Pollers == nil) || (v.Pollers != nil && rhs.Pollers != nil && _List_PollerInfo_Equals(v.Pollers, rhs.Pollers))) {
 		return false
 	}
+	if !_Bool_EqualsPtr(v.Paused, rhs.Paused) {
+		return false
+	}
 
 	return true
 }
@@ -11215,6 +11665,9 @@ func (v *DescribeTaskListResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (
 	if v.Pollers != nil {
 		err = multierr.Append(err, enc.AddArray("pollers", (_List_PollerInfo_Zapper)(v.Pollers)))
 	}
+	if v.Paused != nil {
+		enc.AddBool("paused", *v.Paused)
+	}
 	return err
 }
 
@@ -11228,6 +11681,16 @@ func (v *DescribeTaskListResponse) GetPollers() (o []*PollerInfo) {
 	return
 }
 
+// GetPaused returns the value of Paused if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListResponse) GetPaused() (o bool) {
+	if v.Paused != nil {
+		return *v.Paused
+	}
+
+	return
+}
+
 type DescribeWorkflowExecutionRequest struct {
 	Domain    *string            `json:"domain,omitempty"`
 	Execution *WorkflowExecution `json:"execution,omitempty"`
@@ -12339,6 +12802,256 @@ func (v *DomainConfiguration) GetArchivalBucketOwner() (o string) {
 	return
 }
 
+type DomainFailoverInfo struct {
+	PendingActiveClusterName *string `json:"pendingActiveClusterName,omitempty"`
+	StartTimestamp           *int64  `json:"startTimestamp,omitempty"`
+	ExpireTimestamp          *int64  `json:"expireTimestamp,omitempty"`
+	PendingReplicationTasks  *int64  `json:"pendingReplicationTasks,omitempty"`
+}
+
+// ToWire translates a DomainFailoverInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DomainFailoverInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.PendingActiveClusterName != nil {
+		w, err = wire.NewValueString(*(v.PendingActiveClusterName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.StartTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.StartTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ExpireTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ExpireTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.PendingReplicationTasks != nil {
+		w, err = wire.NewValueI64(*(v.PendingReplicationTasks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DomainFailoverInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DomainFailoverInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DomainFailoverInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DomainFailoverInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.PendingActiveClusterName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ExpireTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.PendingReplicationTasks = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DomainFailoverInfo
+// struct.
+func (v *DomainFailoverInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.PendingActiveClusterName != nil {
+		fields[i] = fmt.Sprintf("PendingActiveClusterName: %v", *(v.PendingActiveClusterName))
+		i++
+	}
+	if v.StartTimestamp != nil {
+		fields[i] = fmt.Sprintf("StartTimestamp: %v", *(v.StartTimestamp))
+		i++
+	}
+	if v.ExpireTimestamp != nil {
+		fields[i] = fmt.Sprintf("ExpireTimestamp: %v", *(v.ExpireTimestamp))
+		i++
+	}
+	if v.PendingReplicationTasks != nil {
+		fields[i] = fmt.Sprintf("PendingReplicationTasks: %v", *(v.PendingReplicationTasks))
+		i++
+	}
+
+	return fmt.Sprintf("DomainFailoverInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DomainFailoverInfo match the
+// provided DomainFailoverInfo.
+//
+// This function performs a deep comparison.
+func (v *DomainFailoverInfo) Equals(rhs *DomainFailoverInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.PendingActiveClusterName, rhs.PendingActiveClusterName) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartTimestamp, rhs.StartTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ExpireTimestamp, rhs.ExpireTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.PendingReplicationTasks, rhs.PendingReplicationTasks) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DomainFailoverInfo.
+func (v *DomainFailoverInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.PendingActiveClusterName != nil {
+		enc.AddString("pendingActiveClusterName", *v.PendingActiveClusterName)
+	}
+	if v.StartTimestamp != nil {
+		enc.AddInt64("startTimestamp", *v.StartTimestamp)
+	}
+	if v.ExpireTimestamp != nil {
+		enc.AddInt64("expireTimestamp", *v.ExpireTimestamp)
+	}
+	if v.PendingReplicationTasks != nil {
+		enc.AddInt64("pendingReplicationTasks", *v.PendingReplicationTasks)
+	}
+	return err
+}
+
+// GetPendingActiveClusterName returns the value of PendingActiveClusterName if it is set or its
+// zero value if it is unset.
+func (v *DomainFailoverInfo) GetPendingActiveClusterName() (o string) {
+	if v.PendingActiveClusterName != nil {
+		return *v.PendingActiveClusterName
+	}
+
+	return
+}
+
+// GetStartTimestamp returns the value of StartTimestamp if it is set or its
+// zero value if it is unset.
+func (v *DomainFailoverInfo) GetStartTimestamp() (o int64) {
+	if v.StartTimestamp != nil {
+		return *v.StartTimestamp
+	}
+
+	return
+}
+
+// GetExpireTimestamp returns the value of ExpireTimestamp if it is set or its
+// zero value if it is unset.
+func (v *DomainFailoverInfo) GetExpireTimestamp() (o int64) {
+	if v.ExpireTimestamp != nil {
+		return *v.ExpireTimestamp
+	}
+
+	return
+}
+
+// GetPendingReplicationTasks returns the value of PendingReplicationTasks if it is set or its
+// zero value if it is unset.
+func (v *DomainFailoverInfo) GetPendingReplicationTasks() (o int64) {
+	if v.PendingReplicationTasks != nil {
+		return *v.PendingReplicationTasks
+	}
+
+	return
+}
+
 type DomainInfo struct {
 	Name        *string           `json:"name,omitempty"`
 	Status      *DomainStatus     `json:"status,omitempty"`
@@ -20423,6 +21136,462 @@ func (v *ListOpenWorkflowExecutionsResponse) GetNextPageToken() (o []byte) {
 	return
 }
 
+type ListTaskListsRequest struct {
+	Domain        *string `json:"domain,omitempty"`
+	PageSize      *int32  `json:"pageSize,omitempty"`
+	NextPageToken []byte  `json:"nextPageToken,omitempty"`
+}
+
+// ToWire translates a ListTaskListsRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ListTaskListsRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.PageSize != nil {
+		w, err = wire.NewValueI32(*(v.PageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ListTaskListsRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ListTaskListsRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ListTaskListsRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ListTaskListsRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.PageSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ListTaskListsRequest
+// struct.
+func (v *ListTaskListsRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.PageSize != nil {
+		fields[i] = fmt.Sprintf("PageSize: %v", *(v.PageSize))
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("ListTaskListsRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ListTaskListsRequest match the
+// provided ListTaskListsRequest.
+//
+// This function performs a deep comparison.
+func (v *ListTaskListsRequest) Equals(rhs *ListTaskListsRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.PageSize, rhs.PageSize) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ListTaskListsRequest.
+func (v *ListTaskListsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.PageSize != nil {
+		enc.AddInt32("pageSize", *v.PageSize)
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *ListTaskListsRequest) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// GetPageSize returns the value of PageSize if it is set or its
+// zero value if it is unset.
+func (v *ListTaskListsRequest) GetPageSize() (o int32) {
+	if v.PageSize != nil {
+		return *v.PageSize
+	}
+
+	return
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *ListTaskListsRequest) GetNextPageToken() (o []byte) {
+	if v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+type ListTaskListsResponse struct {
+	TaskLists     []*TaskListInfo `json:"taskLists,omitempty"`
+	NextPageToken []byte          `json:"nextPageToken,omitempty"`
+}
+
+type _List_TaskListInfo_ValueList []*TaskListInfo
+
+func (v _List_TaskListInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_TaskListInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_TaskListInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_TaskListInfo_ValueList) Close() {}
+
+// ToWire translates a ListTaskListsResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ListTaskListsResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TaskLists != nil {
+		w, err = wire.NewValueList(_List_TaskListInfo_ValueList(v.TaskLists)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _TaskListInfo_Read(w wire.Value) (*TaskListInfo, error) {
+	var v TaskListInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_TaskListInfo_Read(l wire.ValueList) ([]*TaskListInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*TaskListInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _TaskListInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ListTaskListsResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ListTaskListsResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ListTaskListsResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ListTaskListsResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.TaskLists, err = _List_TaskListInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ListTaskListsResponse
+// struct.
+func (v *ListTaskListsResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.TaskLists != nil {
+		fields[i] = fmt.Sprintf("TaskLists: %v", v.TaskLists)
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("ListTaskListsResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_TaskListInfo_Equals(lhs, rhs []*TaskListInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ListTaskListsResponse match the
+// provided ListTaskListsResponse.
+//
+// This function performs a deep comparison.
+func (v *ListTaskListsResponse) Equals(rhs *ListTaskListsResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.TaskLists == nil && rhs.TaskLists == nil) || (v.TaskLists != nil && rhs.TaskLists != nil && _List_TaskListInfo_Equals(v.TaskLists, rhs.TaskLists))) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_TaskListInfo_Zapper []*TaskListInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_TaskListInfo_Zapper.
+func (l _List_TaskListInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ListTaskListsResponse.
+func (v *ListTaskListsResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TaskLists != nil {
+		err = multierr.Append(err, enc.AddArray("taskLists", (_List_TaskListInfo_Zapper)(v.TaskLists)))
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	return err
+}
+
+// GetTaskLists returns the value of TaskLists if it is set or its
+// zero value if it is unset.
+func (v *ListTaskListsResponse) GetTaskLists() (o []*TaskListInfo) {
+	if v.TaskLists != nil {
+		return v.TaskLists
+	}
+
+	return
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *ListTaskListsResponse) GetNextPageToken() (o []byte) {
+	if v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
 type MarkerRecordedEventAttributes struct {
 	MarkerName                   *string `json:"markerName,omitempty"`
 	Details                      []byte  `json:"details,omitempty"`
@@ -20675,6 +21844,215 @@ func (v *MarkerRecordedEventAttributes) GetHeader() (o *Header) {
 	return
 }
 
+type PauseTaskListRequest struct {
+	Domain       *string       `json:"domain,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+}
+
+// ToWire translates a PauseTaskListRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PauseTaskListRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.TaskListType != nil {
+		w, err = v.TaskListType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a PauseTaskListRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PauseTaskListRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PauseTaskListRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PauseTaskListRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x TaskListType
+				x, err = _TaskListType_Read(field.Value)
+				v.TaskListType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PauseTaskListRequest
+// struct.
+func (v *PauseTaskListRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.TaskListType != nil {
+		fields[i] = fmt.Sprintf("TaskListType: %v", *(v.TaskListType))
+		i++
+	}
+
+	return fmt.Sprintf("PauseTaskListRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PauseTaskListRequest match the
+// provided PauseTaskListRequest.
+//
+// This function performs a deep comparison.
+func (v *PauseTaskListRequest) Equals(rhs *PauseTaskListRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_TaskListType_EqualsPtr(v.TaskListType, rhs.TaskListType) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PauseTaskListRequest.
+func (v *PauseTaskListRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.TaskListType != nil {
+		err = multierr.Append(err, enc.AddObject("taskListType", *v.TaskListType))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *PauseTaskListRequest) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *PauseTaskListRequest) GetTaskList() (o *TaskList) {
+	if v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// GetTaskListType returns the value of TaskListType if it is set or its
+// zero value if it is unset.
+func (v *PauseTaskListRequest) GetTaskListType() (o TaskListType) {
+	if v.TaskListType != nil {
+		return *v.TaskListType
+	}
+
+	return
+}
+
 type PendingActivityInfo struct {
 	ActivityID             *string               `json:"activityID,omitempty"`
 	ActivityType           *ActivityType         `json:"activityType,omitempty"`
@@ -20683,6 +22061,7 @@ type PendingActivityInfo struct {
 	LastHeartbeatTimestamp *int64                `json:"lastHeartbeatTimestamp,omitempty"`
 	LastStartedTimestamp   *int64                `json:"lastStartedTimestamp,omitempty"`
 	Attempt                *int32                `json:"attempt,omitempty"`
+	ScheduleID             *int64                `json:"scheduleID,omitempty"`
 }
 
 // ToWire translates a PendingActivityInfo struct into a Thrift-level intermediate
@@ -20702,7 +22081,7 @@ type PendingActivityInfo struct {
 //   }
 func (v *PendingActivityInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -20764,6 +22143,14 @@ func (v *PendingActivityInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
+	if v.ScheduleID != nil {
+		w, err = wire.NewValueI64(*(v.ScheduleID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -20861,6 +22248,16 @@ func (v *PendingActivityInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 80:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduleID = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -20875,7 +22272,7 @@ func (v *PendingActivityInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [8]string
 	i := 0
 	if v.ActivityID != nil {
 		fields[i] = fmt.Sprintf("ActivityID: %v", *(v.ActivityID))
@@ -20905,6 +22302,10 @@ func (v *PendingActivityInfo) String() string {
 		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
 		i++
 	}
+	if v.ScheduleID != nil {
+		fields[i] = fmt.Sprintf("ScheduleID: %v", *(v.ScheduleID))
+		i++
+	}
 
 	return fmt.Sprintf("PendingActivityInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -20950,6 +22351,9 @@ func (v *PendingActivityInfo) Equals(rhs *PendingActivityInfo) bool {
 	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
 		return false
 	}
+	if !_I64_EqualsPtr(v.ScheduleID, rhs.ScheduleID) {
+		return false
+	}
 
 	return true
 }
@@ -20981,6 +22385,9 @@ func (v *PendingActivityInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err e
 	if v.Attempt != nil {
 		enc.AddInt32("attempt", *v.Attempt)
 	}
+	if v.ScheduleID != nil {
+		enc.AddInt64("scheduleID", *v.ScheduleID)
+	}
 	return err
 }
 
@@ -21054,6 +22461,16 @@ func (v *PendingActivityInfo) GetAttempt() (o int32) {
 	return
 }
 
+// GetScheduleID returns the value of ScheduleID if it is set or its
+// zero value if it is unset.
+func (v *PendingActivityInfo) GetScheduleID() (o int64) {
+	if v.ScheduleID != nil {
+		return *v.ScheduleID
+	}
+
+	return
+}
+
 type PendingActivityState int32
 
 const (
@@ -21243,6 +22660,7 @@ type PollForActivityTaskRequest struct {
 	TaskList         *TaskList         `json:"taskList,omitempty"`
 	Identity         *string           `json:"identity,omitempty"`
 	TaskListMetadata *TaskListMetadata `json:"taskListMetadata,omitempty"`
+	PollerMetadata   *PollerMetadata   `json:"pollerMetadata,omitempty"`
 }
 
 // ToWire translates a PollForActivityTaskRequest struct into a Thrift-level intermediate
@@ -21262,7 +22680,7 @@ type PollForActivityTaskRequest struct {
 //   }
 func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -21300,6 +22718,14 @@ func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.PollerMetadata != nil {
+		w, err = v.PollerMetadata.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -21367,6 +22793,14 @@ func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.PollerMetadata, err = _PollerMetadata_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -21381,7 +22815,7 @@ func (v *PollForActivityTaskRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -21399,6 +22833,10 @@ func (v *PollForActivityTaskRequest) String() string {
 		fields[i] = fmt.Sprintf("TaskListMetadata: %v", v.TaskListMetadata)
 		i++
 	}
+	if v.PollerMetadata != nil {
+		fields[i] = fmt.Sprintf("PollerMetadata: %v", v.PollerMetadata)
+		i++
+	}
 
 	return fmt.Sprintf("PollForActivityTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -21425,6 +22863,9 @@ func (v *PollForActivityTaskRequest) Equals(rhs *PollForActivityTaskRequest) boo
 	if !((v.TaskListMetadata == nil && rhs.TaskListMetadata == nil) || (v.TaskListMetadata != nil && rhs.TaskListMetadata != nil && v.TaskListMetadata.Equals(rhs.TaskListMetadata))) {
 		return false
 	}
+	if !((v.PollerMetadata == nil && rhs.PollerMetadata == nil) || (v.PollerMetadata != nil && rhs.PollerMetadata != nil && v.PollerMetadata.Equals(rhs.PollerMetadata))) {
+		return false
+	}
 
 	return true
 }
@@ -21447,6 +22888,9 @@ func (v *PollForActivityTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder)
 	if v.TaskListMetadata != nil {
 		err = multierr.Append(err, enc.AddObject("taskListMetadata", v.TaskListMetadata))
 	}
+	if v.PollerMetadata != nil {
+		err = multierr.Append(err, enc.AddObject("pollerMetadata", v.PollerMetadata))
+	}
 	return err
 }
 
@@ -21490,6 +22934,16 @@ func (v *PollForActivityTaskRequest) GetTaskListMetadata() (o *TaskListMetadata)
 	return
 }
 
+// GetPollerMetadata returns the value of PollerMetadata if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskRequest) GetPollerMetadata() (o *PollerMetadata) {
+	if v.PollerMetadata != nil {
+		return v.PollerMetadata
+	}
+
+	return
+}
+
 type PollForActivityTaskResponse struct {
 	TaskToken                       []byte             `json:"taskToken,omitempty"`
 	WorkflowExecution               *WorkflowExecution `json:"workflowExecution,omitempty"`
@@ -22158,9 +23612,10 @@ func (v *PollForActivityTaskResponse) GetWorkflowDomain() (o string) {
 }
 
 type PollForDecisionTaskRequest struct {
-	Domain   *string   `json:"domain,omitempty"`
-	TaskList *TaskList `json:"taskList,omitempty"`
-	Identity *string   `json:"identity,omitempty"`
+	Domain         *string         `json:"domain,omitempty"`
+	TaskList       *TaskList       `json:"taskList,omitempty"`
+	Identity       *string         `json:"identity,omitempty"`
+	PollerMetadata *PollerMetadata `json:"pollerMetadata,omitempty"`
 }
 
 // ToWire translates a PollForDecisionTaskRequest struct into a Thrift-level intermediate
@@ -22180,7 +23635,7 @@ type PollForDecisionTaskRequest struct {
 //   }
 func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -22210,10 +23665,24 @@ func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.PollerMetadata != nil {
+		w, err = v.PollerMetadata.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
+func _PollerMetadata_Read(w wire.Value) (*PollerMetadata, error) {
+	var v PollerMetadata
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a PollForDecisionTaskRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -22263,6 +23732,14 @@ func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.PollerMetadata, err = _PollerMetadata_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -22277,7 +23754,7 @@ func (v *PollForDecisionTaskRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [4]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -22291,6 +23768,10 @@ func (v *PollForDecisionTaskRequest) String() string {
 		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
+	if v.PollerMetadata != nil {
+		fields[i] = fmt.Sprintf("PollerMetadata: %v", v.PollerMetadata)
+		i++
+	}
 
 	return fmt.Sprintf("PollForDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -22314,6 +23795,9 @@ func (v *PollForDecisionTaskRequest) Equals(rhs *PollForDecisionTaskRequest) boo
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
+	if !((v.PollerMetadata == nil && rhs.PollerMetadata == nil) || (v.PollerMetadata != nil && rhs.PollerMetadata != nil && v.PollerMetadata.Equals(rhs.PollerMetadata))) {
+		return false
+	}
 
 	return true
 }
@@ -22333,6 +23817,9 @@ func (v *PollForDecisionTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder)
 	if v.Identity != nil {
 		enc.AddString("identity", *v.Identity)
 	}
+	if v.PollerMetadata != nil {
+		err = multierr.Append(err, enc.AddObject("pollerMetadata", v.PollerMetadata))
+	}
 	return err
 }
 
@@ -22366,6 +23853,16 @@ func (v *PollForDecisionTaskRequest) GetIdentity() (o string) {
 	return
 }
 
+// GetPollerMetadata returns the value of PollerMetadata if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskRequest) GetPollerMetadata() (o *PollerMetadata) {
+	if v.PollerMetadata != nil {
+		return v.PollerMetadata
+	}
+
+	return
+}
+
 type PollForDecisionTaskResponse struct {
 	TaskToken                 []byte             `json:"taskToken,omitempty"`
 	WorkflowExecution         *WorkflowExecution `json:"workflowExecution,omitempty"`
@@ -22882,8 +24379,12 @@ func (v *PollForDecisionTaskResponse) GetWorkflowExecutionTaskList() (o *TaskLis
 }
 
 type PollerInfo struct {
-	LastAccessTime *int64  `json:"lastAccessTime,omitempty"`
-	Identity       *string `json:"identity,omitempty"`
+	LastAccessTime *int64   `json:"lastAccessTime,omitempty"`
+	Identity       *string  `json:"identity,omitempty"`
+	RatePerSecond  *float64 `json:"ratePerSecond,omitempty"`
+	BinaryChecksum *string  `json:"binaryChecksum,omitempty"`
+	SdkVersion     *string  `json:"sdkVersion,omitempty"`
+	Hostname       *string  `json:"hostname,omitempty"`
 }
 
 // ToWire translates a PollerInfo struct into a Thrift-level intermediate
@@ -22903,7 +24404,7 @@ type PollerInfo struct {
 //   }
 func (v *PollerInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -22925,6 +24426,38 @@ func (v *PollerInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.RatePerSecond != nil {
+		w, err = wire.NewValueDouble(*(v.RatePerSecond)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.BinaryChecksum != nil {
+		w, err = wire.NewValueString(*(v.BinaryChecksum)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.SdkVersion != nil {
+		w, err = wire.NewValueString(*(v.SdkVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Hostname != nil {
+		w, err = wire.NewValueString(*(v.Hostname)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -22970,6 +24503,46 @@ func (v *PollerInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 30:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.RatePerSecond = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.BinaryChecksum = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SdkVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Hostname = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -22984,7 +24557,7 @@ func (v *PollerInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [6]string
 	i := 0
 	if v.LastAccessTime != nil {
 		fields[i] = fmt.Sprintf("LastAccessTime: %v", *(v.LastAccessTime))
@@ -22994,6 +24567,22 @@ func (v *PollerInfo) String() string {
 		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
+	if v.RatePerSecond != nil {
+		fields[i] = fmt.Sprintf("RatePerSecond: %v", *(v.RatePerSecond))
+		i++
+	}
+	if v.BinaryChecksum != nil {
+		fields[i] = fmt.Sprintf("BinaryChecksum: %v", *(v.BinaryChecksum))
+		i++
+	}
+	if v.SdkVersion != nil {
+		fields[i] = fmt.Sprintf("SdkVersion: %v", *(v.SdkVersion))
+		i++
+	}
+	if v.Hostname != nil {
+		fields[i] = fmt.Sprintf("Hostname: %v", *(v.Hostname))
+		i++
+	}
 
 	return fmt.Sprintf("PollerInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -23014,6 +24603,18 @@ func (v *PollerInfo) Equals(rhs *PollerInfo) bool {
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
+	if !_Double_EqualsPtr(v.RatePerSecond, rhs.RatePerSecond) {
+		return false
+	}
+	if !_String_EqualsPtr(v.BinaryChecksum, rhs.BinaryChecksum) {
+		return false
+	}
+	if !_String_EqualsPtr(v.SdkVersion, rhs.SdkVersion) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Hostname, rhs.Hostname) {
+		return false
+	}
 
 	return true
 }
@@ -23030,6 +24631,18 @@ func (v *PollerInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.Identity != nil {
 		enc.AddString("identity", *v.Identity)
 	}
+	if v.RatePerSecond != nil {
+		enc.AddFloat64("ratePerSecond", *v.RatePerSecond)
+	}
+	if v.BinaryChecksum != nil {
+		enc.AddString("binaryChecksum", *v.BinaryChecksum)
+	}
+	if v.SdkVersion != nil {
+		enc.AddString("sdkVersion", *v.SdkVersion)
+	}
+	if v.Hostname != nil {
+		enc.AddString("hostname", *v.Hostname)
+	}
 	return err
 }
 
@@ -23053,6 +24666,257 @@ func (v *PollerInfo) GetIdentity() (o string) {
 	return
 }
 
+// GetRatePerSecond returns the value of RatePerSecond if it is set or its
+// zero value if it is unset.
+func (v *PollerInfo) GetRatePerSecond() (o float64) {
+	if v.RatePerSecond != nil {
+		return *v.RatePerSecond
+	}
+
+	return
+}
+
+// GetBinaryChecksum returns the value of BinaryChecksum if it is set or its
+// zero value if it is unset.
+func (v *PollerInfo) GetBinaryChecksum() (o string) {
+	if v.BinaryChecksum != nil {
+		return *v.BinaryChecksum
+	}
+
+	return
+}
+
+// GetSdkVersion returns the value of SdkVersion if it is set or its
+// zero value if it is unset.
+func (v *PollerInfo) GetSdkVersion() (o string) {
+	if v.SdkVersion != nil {
+		return *v.SdkVersion
+	}
+
+	return
+}
+
+// GetHostname returns the value of Hostname if it is set or its
+// zero value if it is unset.
+func (v *PollerInfo) GetHostname() (o string) {
+	if v.Hostname != nil {
+		return *v.Hostname
+	}
+
+	return
+}
+
+type PollerMetadata struct {
+	BinaryChecksum *string `json:"binaryChecksum,omitempty"`
+	SdkVersion     *string `json:"sdkVersion,omitempty"`
+	Hostname       *string `json:"hostname,omitempty"`
+}
+
+// ToWire translates a PollerMetadata struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PollerMetadata) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BinaryChecksum != nil {
+		w, err = wire.NewValueString(*(v.BinaryChecksum)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.SdkVersion != nil {
+		w, err = wire.NewValueString(*(v.SdkVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Hostname != nil {
+		w, err = wire.NewValueString(*(v.Hostname)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a PollerMetadata struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PollerMetadata struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PollerMetadata
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PollerMetadata) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.BinaryChecksum = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SdkVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Hostname = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PollerMetadata
+// struct.
+func (v *PollerMetadata) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.BinaryChecksum != nil {
+		fields[i] = fmt.Sprintf("BinaryChecksum: %v", *(v.BinaryChecksum))
+		i++
+	}
+	if v.SdkVersion != nil {
+		fields[i] = fmt.Sprintf("SdkVersion: %v", *(v.SdkVersion))
+		i++
+	}
+	if v.Hostname != nil {
+		fields[i] = fmt.Sprintf("Hostname: %v", *(v.Hostname))
+		i++
+	}
+
+	return fmt.Sprintf("PollerMetadata{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PollerMetadata match the
+// provided PollerMetadata.
+//
+// This function performs a deep comparison.
+func (v *PollerMetadata) Equals(rhs *PollerMetadata) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.BinaryChecksum, rhs.BinaryChecksum) {
+		return false
+	}
+	if !_String_EqualsPtr(v.SdkVersion, rhs.SdkVersion) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Hostname, rhs.Hostname) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PollerMetadata.
+func (v *PollerMetadata) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BinaryChecksum != nil {
+		enc.AddString("binaryChecksum", *v.BinaryChecksum)
+	}
+	if v.SdkVersion != nil {
+		enc.AddString("sdkVersion", *v.SdkVersion)
+	}
+	if v.Hostname != nil {
+		enc.AddString("hostname", *v.Hostname)
+	}
+	return err
+}
+
+// GetBinaryChecksum returns the value of BinaryChecksum if it is set or its
+// zero value if it is unset.
+func (v *PollerMetadata) GetBinaryChecksum() (o string) {
+	if v.BinaryChecksum != nil {
+		return *v.BinaryChecksum
+	}
+
+	return
+}
+
+// GetSdkVersion returns the value of SdkVersion if it is set or its
+// zero value if it is unset.
+func (v *PollerMetadata) GetSdkVersion() (o string) {
+	if v.SdkVersion != nil {
+		return *v.SdkVersion
+	}
+
+	return
+}
+
+// GetHostname returns the value of Hostname if it is set or its
+// zero value if it is unset.
+func (v *PollerMetadata) GetHostname() (o string) {
+	if v.Hostname != nil {
+		return *v.Hostname
+	}
+
+	return
+}
+
 type QueryFailedError struct {
 	Message string `json:"message,required"`
 }
@@ -30244,6 +32108,215 @@ func (v *RespondQueryTaskCompletedRequest) GetErrorMessage() (o string) {
 	return
 }
 
+type ResumeTaskListRequest struct {
+	Domain       *string       `json:"domain,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+}
+
+// ToWire translates a ResumeTaskListRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ResumeTaskListRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.TaskListType != nil {
+		w, err = v.TaskListType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ResumeTaskListRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ResumeTaskListRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ResumeTaskListRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ResumeTaskListRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x TaskListType
+				x, err = _TaskListType_Read(field.Value)
+				v.TaskListType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ResumeTaskListRequest
+// struct.
+func (v *ResumeTaskListRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.TaskListType != nil {
+		fields[i] = fmt.Sprintf("TaskListType: %v", *(v.TaskListType))
+		i++
+	}
+
+	return fmt.Sprintf("ResumeTaskListRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ResumeTaskListRequest match the
+// provided ResumeTaskListRequest.
+//
+// This function performs a deep comparison.
+func (v *ResumeTaskListRequest) Equals(rhs *ResumeTaskListRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_TaskListType_EqualsPtr(v.TaskListType, rhs.TaskListType) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ResumeTaskListRequest.
+func (v *ResumeTaskListRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.TaskListType != nil {
+		err = multierr.Append(err, enc.AddObject("taskListType", *v.TaskListType))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *ResumeTaskListRequest) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *ResumeTaskListRequest) GetTaskList() (o *TaskList) {
+	if v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// GetTaskListType returns the value of TaskListType if it is set or its
+// zero value if it is unset.
+func (v *ResumeTaskListRequest) GetTaskListType() (o TaskListType) {
+	if v.TaskListType != nil {
+		return *v.TaskListType
+	}
+
+	return
+}
+
 type RetryPolicy struct {
 	InitialIntervalInSeconds    *int32   `json:"initialIntervalInSeconds,omitempty"`
 	BackoffCoefficient          *float64 `json:"backoffCoefficient,omitempty"`
@@ -30946,6 +33019,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	StartToCloseTimeoutSeconds    *int32        `json:"startToCloseTimeoutSeconds,omitempty"`
 	HeartbeatTimeoutSeconds       *int32        `json:"heartbeatTimeoutSeconds,omitempty"`
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
+	Priority                      *int32        `json:"priority,omitempty"`
 }
 
 // ToWire translates a ScheduleActivityTaskDecisionAttributes struct into a Thrift-level intermediate
@@ -30965,7 +33039,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 //   }
 func (v *ScheduleActivityTaskDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [10]wire.Field
+		fields [11]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -31051,6 +33125,14 @@ func (v *ScheduleActivityTaskDecisionAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -31168,6 +33250,16 @@ func (v *ScheduleActivityTaskDecisionAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 80:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -31182,7 +33274,7 @@ func (v *ScheduleActivityTaskDecisionAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [10]string
+	var fields [11]string
 	i := 0
 	if v.ActivityId != nil {
 		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
@@ -31224,6 +33316,10 @@ func (v *ScheduleActivityTaskDecisionAttributes) String() string {
 		fields[i] = fmt.Sprintf("RetryPolicy: %v", v.RetryPolicy)
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
 
 	return fmt.Sprintf("ScheduleActivityTaskDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -31268,6 +33364,9 @@ func (v *ScheduleActivityTaskDecisionAttributes) Equals(rhs *ScheduleActivityTas
 	if !((v.RetryPolicy == nil && rhs.RetryPolicy == nil) || (v.RetryPolicy != nil && rhs.RetryPolicy != nil && v.RetryPolicy.Equals(rhs.RetryPolicy))) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
 
 	return true
 }
@@ -31308,6 +33407,9 @@ func (v *ScheduleActivityTaskDecisionAttributes) MarshalLogObject(enc zapcore.Ob
 	if v.RetryPolicy != nil {
 		err = multierr.Append(err, enc.AddObject("retryPolicy", v.RetryPolicy))
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
 	return err
 }
 
@@ -31411,6 +33513,16 @@ func (v *ScheduleActivityTaskDecisionAttributes) GetRetryPolicy() (o *RetryPolic
 	return
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *ScheduleActivityTaskDecisionAttributes) GetPriority() (o int32) {
+	if v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
 type ServiceBusyError struct {
 	Message string `json:"message,required"`
 }
@@ -32737,6 +34849,7 @@ type SignalWithStartWorkflowExecutionRequest struct {
 	Control                             []byte                 `json:"control,omitempty"`
 	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
 	CronSchedule                        *string                `json:"cronSchedule,omitempty"`
+	Priority                            *int32                 `json:"priority,omitempty"`
 }
 
 // ToWire translates a SignalWithStartWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -32756,7 +34869,7 @@ type SignalWithStartWorkflowExecutionRequest struct {
 //   }
 func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [15]wire.Field
+		fields [16]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -32882,6 +34995,14 @@ func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 150, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 160, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -33051,6 +35172,16 @@ func (v *SignalWithStartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 160:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -33065,7 +35196,7 @@ func (v *SignalWithStartWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [15]string
+	var fields [16]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -33127,6 +35258,10 @@ func (v *SignalWithStartWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("CronSchedule: %v", *(v.CronSchedule))
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
 
 	return fmt.Sprintf("SignalWithStartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -33196,6 +35331,9 @@ func (v *SignalWithStartWorkflowExecutionRequest) Equals(rhs *SignalWithStartWor
 	if !_String_EqualsPtr(v.CronSchedule, rhs.CronSchedule) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
 
 	return true
 }
@@ -33251,6 +35389,9 @@ func (v *SignalWithStartWorkflowExecutionRequest) MarshalLogObject(enc zapcore.O
 	if v.CronSchedule != nil {
 		enc.AddString("cronSchedule", *v.CronSchedule)
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
 	return err
 }
 
@@ -33404,6 +35545,16 @@ func (v *SignalWithStartWorkflowExecutionRequest) GetCronSchedule() (o string) {
 	return
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *SignalWithStartWorkflowExecutionRequest) GetPriority() (o int32) {
+	if v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
 type SignalWorkflowExecutionRequest struct {
 	Domain            *string            `json:"domain,omitempty"`
 	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
@@ -35661,6 +37812,7 @@ type StartWorkflowExecutionRequest struct {
 	ChildPolicy                         *ChildPolicy           `json:"childPolicy,omitempty"`
 	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
 	CronSchedule                        *string                `json:"cronSchedule,omitempty"`
+	Priority                            *int32                 `json:"priority,omitempty"`
 }
 
 // ToWire translates a StartWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -35680,7 +37832,7 @@ type StartWorkflowExecutionRequest struct {
 //   }
 func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [13]wire.Field
+		fields [14]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -35790,6 +37942,14 @@ func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 130, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -35937,6 +38097,16 @@ func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 140:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -35951,7 +38121,7 @@ func (v *StartWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [13]string
+	var fields [14]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -36005,6 +38175,10 @@ func (v *StartWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("CronSchedule: %v", *(v.CronSchedule))
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
 
 	return fmt.Sprintf("StartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -36058,6 +38232,9 @@ func (v *StartWorkflowExecutionRequest) Equals(rhs *StartWorkflowExecutionReques
 	if !_String_EqualsPtr(v.CronSchedule, rhs.CronSchedule) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
 
 	return true
 }
@@ -36107,6 +38284,9 @@ func (v *StartWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncod
 	if v.CronSchedule != nil {
 		enc.AddString("cronSchedule", *v.CronSchedule)
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
 	return err
 }
 
@@ -36240,6 +38420,16 @@ func (v *StartWorkflowExecutionRequest) GetCronSchedule() (o string) {
 	return
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetPriority() (o int32) {
+	if v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
 type StartWorkflowExecutionResponse struct {
 	RunId *string `json:"runId,omitempty"`
 }
@@ -36731,6 +38921,293 @@ func (v *TaskList) GetKind() (o TaskListKind) {
 	return
 }
 
+type TaskListInfo struct {
+	TaskList        *TaskList     `json:"taskList,omitempty"`
+	TaskListType    *TaskListType `json:"taskListType,omitempty"`
+	Kind            *TaskListKind `json:"kind,omitempty"`
+	AckLevel        *int64        `json:"ackLevel,omitempty"`
+	LastUpdatedTime *int64        `json:"lastUpdatedTime,omitempty"`
+}
+
+// ToWire translates a TaskListInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *TaskListInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskListType != nil {
+		w, err = v.TaskListType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Kind != nil {
+		w, err = v.Kind.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.AckLevel != nil {
+		w, err = wire.NewValueI64(*(v.AckLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.LastUpdatedTime != nil {
+		w, err = wire.NewValueI64(*(v.LastUpdatedTime)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a TaskListInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TaskListInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v TaskListInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *TaskListInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x TaskListType
+				x, err = _TaskListType_Read(field.Value)
+				v.TaskListType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x TaskListKind
+				x, err = _TaskListKind_Read(field.Value)
+				v.Kind = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.AckLevel = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastUpdatedTime = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a TaskListInfo
+// struct.
+func (v *TaskListInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.TaskListType != nil {
+		fields[i] = fmt.Sprintf("TaskListType: %v", *(v.TaskListType))
+		i++
+	}
+	if v.Kind != nil {
+		fields[i] = fmt.Sprintf("Kind: %v", *(v.Kind))
+		i++
+	}
+	if v.AckLevel != nil {
+		fields[i] = fmt.Sprintf("AckLevel: %v", *(v.AckLevel))
+		i++
+	}
+	if v.LastUpdatedTime != nil {
+		fields[i] = fmt.Sprintf("LastUpdatedTime: %v", *(v.LastUpdatedTime))
+		i++
+	}
+
+	return fmt.Sprintf("TaskListInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this TaskListInfo match the
+// provided TaskListInfo.
+//
+// This function performs a deep comparison.
+func (v *TaskListInfo) Equals(rhs *TaskListInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_TaskListType_EqualsPtr(v.TaskListType, rhs.TaskListType) {
+		return false
+	}
+	if !_TaskListKind_EqualsPtr(v.Kind, rhs.Kind) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.AckLevel, rhs.AckLevel) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastUpdatedTime, rhs.LastUpdatedTime) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TaskListInfo.
+func (v *TaskListInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.TaskListType != nil {
+		err = multierr.Append(err, enc.AddObject("taskListType", *v.TaskListType))
+	}
+	if v.Kind != nil {
+		err = multierr.Append(err, enc.AddObject("kind", *v.Kind))
+	}
+	if v.AckLevel != nil {
+		enc.AddInt64("ackLevel", *v.AckLevel)
+	}
+	if v.LastUpdatedTime != nil {
+		enc.AddInt64("lastUpdatedTime", *v.LastUpdatedTime)
+	}
+	return err
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetTaskList() (o *TaskList) {
+	if v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// GetTaskListType returns the value of TaskListType if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetTaskListType() (o TaskListType) {
+	if v.TaskListType != nil {
+		return *v.TaskListType
+	}
+
+	return
+}
+
+// GetKind returns the value of Kind if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetKind() (o TaskListKind) {
+	if v.Kind != nil {
+		return *v.Kind
+	}
+
+	return
+}
+
+// GetAckLevel returns the value of AckLevel if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetAckLevel() (o int64) {
+	if v.AckLevel != nil {
+		return *v.AckLevel
+	}
+
+	return
+}
+
+// GetLastUpdatedTime returns the value of LastUpdatedTime if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetLastUpdatedTime() (o int64) {
+	if v.LastUpdatedTime != nil {
+		return *v.LastUpdatedTime
+	}
+
+	return
+}
+
 type TaskListKind int32
 
 const (
@@ -38704,6 +41181,7 @@ type UpdateDomainRequest struct {
 	Configuration            *DomainConfiguration            `json:"configuration,omitempty"`
 	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
 	SecurityToken            *string                         `json:"securityToken,omitempty"`
+	FailoverTimeoutInSeconds *int32                          `json:"failoverTimeoutInSeconds,omitempty"`
 }
 
 // ToWire translates a UpdateDomainRequest struct into a Thrift-level intermediate
@@ -38723,7 +41201,7 @@ type UpdateDomainRequest struct {
 //   }
 func (v *UpdateDomainRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -38769,6 +41247,14 @@ func (v *UpdateDomainRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.FailoverTimeoutInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.FailoverTimeoutInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -38844,6 +41330,16 @@ func (v *UpdateDomainRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.FailoverTimeoutInSeconds = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -38858,7 +41354,7 @@ func (v *UpdateDomainRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.Name != nil {
 		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
@@ -38880,6 +41376,10 @@ func (v *UpdateDomainRequest) String() string {
 		fields[i] = fmt.Sprintf("SecurityToken: %v", *(v.SecurityToken))
 		i++
 	}
+	if v.FailoverTimeoutInSeconds != nil {
+		fields[i] = fmt.Sprintf("FailoverTimeoutInSeconds: %v", *(v.FailoverTimeoutInSeconds))
+		i++
+	}
 
 	return fmt.Sprintf("UpdateDomainRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -38909,6 +41409,9 @@ func (v *UpdateDomainRequest) Equals(rhs *UpdateDomainRequest) bool {
 	if !_String_EqualsPtr(v.SecurityToken, rhs.SecurityToken) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.FailoverTimeoutInSeconds, rhs.FailoverTimeoutInSeconds) {
+		return false
+	}
 
 	return true
 }
@@ -38934,6 +41437,9 @@ func (v *UpdateDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err e
 	if v.SecurityToken != nil {
 		enc.AddString("securityToken", *v.SecurityToken)
 	}
+	if v.FailoverTimeoutInSeconds != nil {
+		enc.AddInt32("failoverTimeoutInSeconds", *v.FailoverTimeoutInSeconds)
+	}
 	return err
 }
 
@@ -38987,12 +41493,23 @@ func (v *UpdateDomainRequest) GetSecurityToken() (o string) {
 	return
 }
 
+// GetFailoverTimeoutInSeconds returns the value of FailoverTimeoutInSeconds if it is set or its
+// zero value if it is unset.
+func (v *UpdateDomainRequest) GetFailoverTimeoutInSeconds() (o int32) {
+	if v.FailoverTimeoutInSeconds != nil {
+		return *v.FailoverTimeoutInSeconds
+	}
+
+	return
+}
+
 type UpdateDomainResponse struct {
 	DomainInfo               *DomainInfo                     `json:"domainInfo,omitempty"`
 	Configuration            *DomainConfiguration            `json:"configuration,omitempty"`
 	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
 	FailoverVersion          *int64                          `json:"failoverVersion,omitempty"`
 	IsGlobalDomain           *bool                           `json:"isGlobalDomain,omitempty"`
+	FailoverInfo             *DomainFailoverInfo             `json:"failoverInfo,omitempty"`
 }
 
 // ToWire translates a UpdateDomainResponse struct into a Thrift-level intermediate
@@ -39012,7 +41529,7 @@ type UpdateDomainResponse struct {
 //   }
 func (v *UpdateDomainResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -39058,6 +41575,14 @@ func (v *UpdateDomainResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.FailoverInfo != nil {
+		w, err = v.FailoverInfo.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -39127,6 +41652,14 @@ func (v *UpdateDomainResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.FailoverInfo, err = _DomainFailoverInfo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -39141,7 +41674,7 @@ func (v *UpdateDomainResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.DomainInfo != nil {
 		fields[i] = fmt.Sprintf("DomainInfo: %v", v.DomainInfo)
@@ -39163,6 +41696,10 @@ func (v *UpdateDomainResponse) String() string {
 		fields[i] = fmt.Sprintf("IsGlobalDomain: %v", *(v.IsGlobalDomain))
 		i++
 	}
+	if v.FailoverInfo != nil {
+		fields[i] = fmt.Sprintf("FailoverInfo: %v", v.FailoverInfo)
+		i++
+	}
 
 	return fmt.Sprintf("UpdateDomainResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -39192,6 +41729,9 @@ func (v *UpdateDomainResponse) Equals(rhs *UpdateDomainResponse) bool {
 	if !_Bool_EqualsPtr(v.IsGlobalDomain, rhs.IsGlobalDomain) {
 		return false
 	}
+	if !((v.FailoverInfo == nil && rhs.FailoverInfo == nil) || (v.FailoverInfo != nil && rhs.FailoverInfo != nil && v.FailoverInfo.Equals(rhs.FailoverInfo))) {
+		return false
+	}
 
 	return true
 }
@@ -39217,6 +41757,9 @@ func (v *UpdateDomainResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 	if v.IsGlobalDomain != nil {
 		enc.AddBool("isGlobalDomain", *v.IsGlobalDomain)
 	}
+	if v.FailoverInfo != nil {
+		err = multierr.Append(err, enc.AddObject("failoverInfo", v.FailoverInfo))
+	}
 	return err
 }
 
@@ -39270,6 +41813,264 @@ func (v *UpdateDomainResponse) GetIsGlobalDomain() (o bool) {
 	return
 }
 
+// GetFailoverInfo returns the value of FailoverInfo if it is set or its
+// zero value if it is unset.
+func (v *UpdateDomainResponse) GetFailoverInfo() (o *DomainFailoverInfo) {
+	if v.FailoverInfo != nil {
+		return v.FailoverInfo
+	}
+
+	return
+}
+
+type UpdateTaskListDispatchRateRequest struct {
+	Domain                *string       `json:"domain,omitempty"`
+	TaskList              *TaskList     `json:"taskList,omitempty"`
+	TaskListType          *TaskListType `json:"taskListType,omitempty"`
+	DispatchRatePerSecond *float64      `json:"dispatchRatePerSecond,omitempty"`
+}
+
+// ToWire translates a UpdateTaskListDispatchRateRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *UpdateTaskListDispatchRateRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.TaskListType != nil {
+		w, err = v.TaskListType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.DispatchRatePerSecond != nil {
+		w, err = wire.NewValueDouble(*(v.DispatchRatePerSecond)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a UpdateTaskListDispatchRateRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a UpdateTaskListDispatchRateRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v UpdateTaskListDispatchRateRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *UpdateTaskListDispatchRateRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x TaskListType
+				x, err = _TaskListType_Read(field.Value)
+				v.TaskListType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.DispatchRatePerSecond = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a UpdateTaskListDispatchRateRequest
+// struct.
+func (v *UpdateTaskListDispatchRateRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.TaskListType != nil {
+		fields[i] = fmt.Sprintf("TaskListType: %v", *(v.TaskListType))
+		i++
+	}
+	if v.DispatchRatePerSecond != nil {
+		fields[i] = fmt.Sprintf("DispatchRatePerSecond: %v", *(v.DispatchRatePerSecond))
+		i++
+	}
+
+	return fmt.Sprintf("UpdateTaskListDispatchRateRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this UpdateTaskListDispatchRateRequest match the
+// provided UpdateTaskListDispatchRateRequest.
+//
+// This function performs a deep comparison.
+func (v *UpdateTaskListDispatchRateRequest) Equals(rhs *UpdateTaskListDispatchRateRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_TaskListType_EqualsPtr(v.TaskListType, rhs.TaskListType) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.DispatchRatePerSecond, rhs.DispatchRatePerSecond) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UpdateTaskListDispatchRateRequest.
+func (v *UpdateTaskListDispatchRateRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.TaskListType != nil {
+		err = multierr.Append(err, enc.AddObject("taskListType", *v.TaskListType))
+	}
+	if v.DispatchRatePerSecond != nil {
+		enc.AddFloat64("dispatchRatePerSecond", *v.DispatchRatePerSecond)
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *UpdateTaskListDispatchRateRequest) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *UpdateTaskListDispatchRateRequest) GetTaskList() (o *TaskList) {
+	if v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// GetTaskListType returns the value of TaskListType if it is set or its
+// zero value if it is unset.
+func (v *UpdateTaskListDispatchRateRequest) GetTaskListType() (o TaskListType) {
+	if v.TaskListType != nil {
+		return *v.TaskListType
+	}
+
+	return
+}
+
+// GetDispatchRatePerSecond returns the value of DispatchRatePerSecond if it is set or its
+// zero value if it is unset.
+func (v *UpdateTaskListDispatchRateRequest) GetDispatchRatePerSecond() (o float64) {
+	if v.DispatchRatePerSecond != nil {
+		return *v.DispatchRatePerSecond
+	}
+
+	return
+}
+
 type WorkflowExecution struct {
 	WorkflowId *string `json:"workflowId,omitempty"`
 	RunId      *string `json:"runId,omitempty"`
@@ -42164,6 +44965,7 @@ type WorkflowExecutionStartedEventAttributes struct {
 	ExpirationTimestamp                 *int64                  `json:"expirationTimestamp,omitempty"`
 	CronSchedule                        *string                 `json:"cronSchedule,omitempty"`
 	FirstDecisionTaskBackoffSeconds     *int32                  `json:"firstDecisionTaskBackoffSeconds,omitempty"`
+	Priority                            *int32                  `json:"priority,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionStartedEventAttributes struct into a Thrift-level intermediate
@@ -42183,7 +44985,7 @@ type WorkflowExecutionStartedEventAttributes struct {
 //   }
 func (v *WorkflowExecutionStartedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [20]wire.Field
+		fields [21]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -42349,6 +45151,14 @@ func (v *WorkflowExecutionStartedEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 110, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -42560,6 +45370,16 @@ func (v *WorkflowExecutionStartedEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 120:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -42574,7 +45394,7 @@ func (v *WorkflowExecutionStartedEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [20]string
+	var fields [21]string
 	i := 0
 	if v.WorkflowType != nil {
 		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
@@ -42656,6 +45476,10 @@ func (v *WorkflowExecutionStartedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("FirstDecisionTaskBackoffSeconds: %v", *(v.FirstDecisionTaskBackoffSeconds))
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionStartedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -42730,6 +45554,9 @@ func (v *WorkflowExecutionStartedEventAttributes) Equals(rhs *WorkflowExecutionS
 	if !_I32_EqualsPtr(v.FirstDecisionTaskBackoffSeconds, rhs.FirstDecisionTaskBackoffSeconds) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
 
 	return true
 }
@@ -42800,6 +45627,9 @@ func (v *WorkflowExecutionStartedEventAttributes) MarshalLogObject(enc zapcore.O
 	if v.FirstDecisionTaskBackoffSeconds != nil {
 		enc.AddInt32("firstDecisionTaskBackoffSeconds", *v.FirstDecisionTaskBackoffSeconds)
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
 	return err
 }
 
@@ -43003,6 +45833,16 @@ func (v *WorkflowExecutionStartedEventAttributes) GetFirstDecisionTaskBackoffSec
 	return
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetPriority() (o int32) {
+	if v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
 type WorkflowExecutionTerminatedEventAttributes struct {
 	Reason   *string `json:"reason,omitempty"`
 	Details  []byte  `json:"details,omitempty"`
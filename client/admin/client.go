@@ -27,6 +27,7 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/uber/cadence/.gen/go/admin"
 	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"go.uber.org/yarpc"
@@ -103,6 +104,182 @@ func (c *clientImpl) GetWorkflowExecutionRawHistory(
 	return client.GetWorkflowExecutionRawHistory(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetReplicationMessages(
+	ctx context.Context,
+	request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.GetReplicationMessagesResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetReplicationMessages(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeReplicationStatus(
+	ctx context.Context,
+	request *replicator.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.DescribeReplicationStatusResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeReplicationStatus(ctx, request, opts...)
+}
+
+func (c *clientImpl) AddCluster(
+	ctx context.Context,
+	request *admin.AddClusterRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.AddCluster(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateCluster(
+	ctx context.Context,
+	request *admin.UpdateClusterRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateCluster(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListClusters(
+	ctx context.Context,
+	request *admin.ListClustersRequest,
+	opts ...yarpc.CallOption,
+) (*admin.ListClustersResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListClusters(ctx, request, opts...)
+}
+
+func (c *clientImpl) ApplyReplicationTask(
+	ctx context.Context,
+	request *admin.ApplyReplicationTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ApplyReplicationTask(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListConflictResolutions(
+	ctx context.Context,
+	request *admin.ListConflictResolutionsRequest,
+	opts ...yarpc.CallOption,
+) (*admin.ListConflictResolutionsResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListConflictResolutions(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateTaskListDispatchRate(
+	ctx context.Context,
+	request *shared.UpdateTaskListDispatchRateRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateTaskListDispatchRate(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeTaskListDispatchRate(
+	ctx context.Context,
+	request *shared.DescribeTaskListDispatchRateRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeTaskListDispatchRateResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeTaskListDispatchRate(ctx, request, opts...)
+}
+
+func (c *clientImpl) PauseTaskList(
+	ctx context.Context,
+	request *shared.PauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PauseTaskList(ctx, request, opts...)
+}
+
+func (c *clientImpl) ResumeTaskList(
+	ctx context.Context,
+	request *shared.ResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ResumeTaskList(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	if parent == nil {
 		return context.WithTimeout(context.Background(), c.timeout)
@@ -120,3 +297,19 @@ func (c *clientImpl) getRandomClient() (adminserviceclient.Interface, error) {
 
 	return client.(adminserviceclient.Interface), nil
 }
+
+func (c *clientImpl) AdvanceTime(
+	ctx context.Context,
+	request *admin.AdvanceTimeRequest,
+	opts ...yarpc.CallOption,
+) (*admin.AdvanceTimeResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.AdvanceTime(ctx, request, opts...)
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 
 	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/metrics"
 	"go.uber.org/yarpc"
@@ -97,3 +98,219 @@ func (c *metricClient) GetWorkflowExecutionRawHistory(
 	}
 	return resp, err
 }
+
+func (c *metricClient) GetReplicationMessages(
+	ctx context.Context,
+	request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.GetReplicationMessagesResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetReplicationMessagesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetReplicationMessagesScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetReplicationMessages(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetReplicationMessagesScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeReplicationStatus(
+	ctx context.Context,
+	request *replicator.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.DescribeReplicationStatusResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeReplicationStatusScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeReplicationStatusScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeReplicationStatus(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeReplicationStatusScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) AddCluster(
+	ctx context.Context,
+	request *admin.AddClusterRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientAddClusterScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientAddClusterScope, metrics.CadenceClientLatency)
+	err := c.client.AddCluster(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientAddClusterScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) UpdateCluster(
+	ctx context.Context,
+	request *admin.UpdateClusterRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateClusterScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateClusterScope, metrics.CadenceClientLatency)
+	err := c.client.UpdateCluster(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateClusterScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ListClusters(
+	ctx context.Context,
+	request *admin.ListClustersRequest,
+	opts ...yarpc.CallOption,
+) (*admin.ListClustersResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListClustersScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListClustersScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListClusters(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListClustersScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ApplyReplicationTask(
+	ctx context.Context,
+	request *admin.ApplyReplicationTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientApplyReplicationTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientApplyReplicationTaskScope, metrics.CadenceClientLatency)
+	err := c.client.ApplyReplicationTask(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientApplyReplicationTaskScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ListConflictResolutions(
+	ctx context.Context,
+	request *admin.ListConflictResolutionsRequest,
+	opts ...yarpc.CallOption,
+) (*admin.ListConflictResolutionsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListConflictResolutionsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListConflictResolutionsScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListConflictResolutions(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListConflictResolutionsScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateTaskListDispatchRate(
+	ctx context.Context,
+	request *shared.UpdateTaskListDispatchRateRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateTaskListDispatchRateScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateTaskListDispatchRateScope, metrics.CadenceClientLatency)
+	err := c.client.UpdateTaskListDispatchRate(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateTaskListDispatchRateScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) DescribeTaskListDispatchRate(
+	ctx context.Context,
+	request *shared.DescribeTaskListDispatchRateRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeTaskListDispatchRateResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeTaskListDispatchRateScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeTaskListDispatchRateScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeTaskListDispatchRate(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeTaskListDispatchRateScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PauseTaskList(
+	ctx context.Context,
+	request *shared.PauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPauseTaskListScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPauseTaskListScope, metrics.CadenceClientLatency)
+	err := c.client.PauseTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPauseTaskListScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ResumeTaskList(
+	ctx context.Context,
+	request *shared.ResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientResumeTaskListScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientResumeTaskListScope, metrics.CadenceClientLatency)
+	err := c.client.ResumeTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientResumeTaskListScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) AdvanceTime(
+	ctx context.Context,
+	request *admin.AdvanceTimeRequest,
+	opts ...yarpc.CallOption,
+) (*admin.AdvanceTimeResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientAdvanceTimeScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientAdvanceTimeScope, metrics.CadenceClientLatency)
+	resp, err := c.client.AdvanceTime(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientAdvanceTimeScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
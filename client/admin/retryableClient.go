@@ -24,6 +24,7 @@ import (
 	"context"
 
 	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/backoff"
 	"go.uber.org/yarpc"
@@ -93,3 +94,171 @@ func (c *retryableClient) GetWorkflowExecutionRawHistory(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) GetReplicationMessages(
+	ctx context.Context,
+	request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.GetReplicationMessagesResponse, error) {
+
+	var resp *replicator.GetReplicationMessagesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetReplicationMessages(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeReplicationStatus(
+	ctx context.Context,
+	request *replicator.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.DescribeReplicationStatusResponse, error) {
+
+	var resp *replicator.DescribeReplicationStatusResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeReplicationStatus(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) AddCluster(
+	ctx context.Context,
+	request *admin.AddClusterRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.AddCluster(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) UpdateCluster(
+	ctx context.Context,
+	request *admin.UpdateClusterRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UpdateCluster(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ListClusters(
+	ctx context.Context,
+	request *admin.ListClustersRequest,
+	opts ...yarpc.CallOption,
+) (*admin.ListClustersResponse, error) {
+
+	var resp *admin.ListClustersResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListClusters(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ApplyReplicationTask(
+	ctx context.Context,
+	request *admin.ApplyReplicationTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ApplyReplicationTask(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ListConflictResolutions(
+	ctx context.Context,
+	request *admin.ListConflictResolutionsRequest,
+	opts ...yarpc.CallOption,
+) (*admin.ListConflictResolutionsResponse, error) {
+
+	var resp *admin.ListConflictResolutionsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListConflictResolutions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateTaskListDispatchRate(
+	ctx context.Context,
+	request *shared.UpdateTaskListDispatchRateRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UpdateTaskListDispatchRate(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) DescribeTaskListDispatchRate(
+	ctx context.Context,
+	request *shared.DescribeTaskListDispatchRateRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeTaskListDispatchRateResponse, error) {
+
+	var resp *shared.DescribeTaskListDispatchRateResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeTaskListDispatchRate(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PauseTaskList(
+	ctx context.Context,
+	request *shared.PauseTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PauseTaskList(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ResumeTaskList(
+	ctx context.Context,
+	request *shared.ResumeTaskListRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ResumeTaskList(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) AdvanceTime(
+	ctx context.Context,
+	request *admin.AdvanceTimeRequest,
+	opts ...yarpc.CallOption,
+) (*admin.AdvanceTimeResponse, error) {
+
+	var resp *admin.AdvanceTimeResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.AdvanceTime(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
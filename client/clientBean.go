@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sync"
 
 	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/transport/tchannel"
@@ -33,6 +34,7 @@ import (
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/service/config"
 )
 
 const (
@@ -43,10 +45,10 @@ type (
 	// Bean in an collection of clients
 	Bean interface {
 		GetHistoryClient() history.Client
-		GetMatchingClient() matching.Client
+		GetMatchingClient(domainIDToName DomainIDToNameFunc) (matching.Client, error)
 		GetFrontendClient() frontend.Client
-		GetRemoteAdminClient(cluster string) admin.Client
-		GetRemoteFrontendClient(cluster string) frontend.Client
+		GetRemoteAdminClient(cluster string) (admin.Client, error)
+		GetRemoteFrontendClient(cluster string) (frontend.Client, error)
 	}
 
 	// DispatcherProvider provides a diapatcher to a given address
@@ -55,15 +57,25 @@ type (
 	}
 
 	clientBeanImpl struct {
+		sync.RWMutex
 		historyClient         history.Client
 		matchingClient        matching.Client
 		frontendClient        frontend.Client
+		factory               Factory
+		dispatcherProvider    DispatcherProvider
+		clusterMetadata       cluster.Metadata
+		remoteAddresses       map[string]config.Address
 		remoteAdminClients    map[string]admin.Client
 		remoteFrontendClients map[string]frontend.Client
 	}
 
 	ipDispatcherProvider struct {
 	}
+
+	// ClusterNotFoundError is returned when the clients of a cluster which is unknown or disabled are requested
+	ClusterNotFoundError struct {
+		Cluster string
+	}
 )
 
 // NewClientBean provides a collection of clients
@@ -74,90 +86,158 @@ func NewClientBean(factory Factory, dispatcherProvider DispatcherProvider, clust
 		return nil, err
 	}
 
-	matchingClient, err := factory.NewMatchingClient()
-	if err != nil {
-		return nil, err
-	}
-
 	frontendClient, err := factory.NewFrontendClient()
 	if err != nil {
 		return nil, err
 	}
 
-	remoteAdminClients := map[string]admin.Client{}
-	remoteFrontendClients := map[string]frontend.Client{}
+	bean := &clientBeanImpl{
+		historyClient:         historyClient,
+		frontendClient:        frontendClient,
+		factory:               factory,
+		dispatcherProvider:    dispatcherProvider,
+		clusterMetadata:       clusterMetadata,
+		remoteAddresses:       map[string]config.Address{},
+		remoteAdminClients:    map[string]admin.Client{},
+		remoteFrontendClients: map[string]frontend.Client{},
+	}
 	for cluster, address := range clusterMetadata.GetAllClientAddress() {
-		dispatcher, err := dispatcherProvider.Get(address.RPCName, address.RPCAddress)
-		if err != nil {
-			return nil, err
-		}
-
-		adminClient, err := factory.NewAdminClientWithTimeoutAndDispatcher(
-			address.RPCName,
-			admin.DefaultTimeout,
-			dispatcher,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		frontendclient, err := factory.NewFrontendClientWithTimeoutAndDispatcher(
-			address.RPCName,
-			frontend.DefaultTimeout,
-			frontend.DefaultLongPollTimeout,
-			dispatcher,
-		)
-		if err != nil {
+		if err := bean.createRemoteClients(cluster, address); err != nil {
 			return nil, err
 		}
-
-		remoteAdminClients[cluster] = adminClient
-		remoteFrontendClients[cluster] = frontendclient
 	}
-
-	return &clientBeanImpl{
-		historyClient:         historyClient,
-		matchingClient:        matchingClient,
-		frontendClient:        frontendClient,
-		remoteAdminClients:    remoteAdminClients,
-		remoteFrontendClients: remoteFrontendClients,
-	}, nil
+	return bean, nil
 }
 
 func (h *clientBeanImpl) GetHistoryClient() history.Client {
 	return h.historyClient
 }
 
-func (h *clientBeanImpl) GetMatchingClient() matching.Client {
-	return h.matchingClient
+// GetMatchingClient returns the matching client, creating it on first use since the
+// client needs the domain cache of the calling service to pick task list partitions
+func (h *clientBeanImpl) GetMatchingClient(domainIDToName DomainIDToNameFunc) (matching.Client, error) {
+	h.RLock()
+	client := h.matchingClient
+	h.RUnlock()
+	if client != nil {
+		return client, nil
+	}
+
+	h.Lock()
+	defer h.Unlock()
+	if h.matchingClient != nil {
+		return h.matchingClient, nil
+	}
+	client, err := h.factory.NewMatchingClient(domainIDToName)
+	if err != nil {
+		return nil, err
+	}
+	h.matchingClient = client
+	return client, nil
 }
 
 func (h *clientBeanImpl) GetFrontendClient() frontend.Client {
 	return h.frontendClient
 }
 
-func (h *clientBeanImpl) GetRemoteAdminClient(cluster string) admin.Client {
-	client, ok := h.remoteAdminClients[cluster]
-	if !ok {
-		panic(fmt.Sprintf(
-			"Unknown cluster name: %v with given cluster client map: %v.",
-			cluster,
-			h.remoteAdminClients,
-		))
+// GetRemoteAdminClient returns the admin client of the remote cluster, or an error if the cluster
+// is unknown or disabled
+func (h *clientBeanImpl) GetRemoteAdminClient(cluster string) (admin.Client, error) {
+	if err := h.refreshRemoteClients(cluster); err != nil {
+		return nil, err
+	}
+
+	h.RLock()
+	defer h.RUnlock()
+	return h.remoteAdminClients[cluster], nil
+}
+
+// GetRemoteFrontendClient returns the frontend client of the remote cluster, or an error if the cluster
+// is unknown or disabled
+func (h *clientBeanImpl) GetRemoteFrontendClient(cluster string) (frontend.Client, error) {
+	if err := h.refreshRemoteClients(cluster); err != nil {
+		return nil, err
 	}
-	return client
+
+	h.RLock()
+	defer h.RUnlock()
+	return h.remoteFrontendClients[cluster], nil
 }
 
-func (h *clientBeanImpl) GetRemoteFrontendClient(cluster string) frontend.Client {
-	client, ok := h.remoteFrontendClients[cluster]
+// refreshRemoteClients makes sure the clients of the remote cluster are created against the current
+// cluster address, since clusters can be added, updated or disabled at runtime
+func (h *clientBeanImpl) refreshRemoteClients(cluster string) error {
+	address, ok := h.clusterMetadata.GetAllClientAddress()[cluster]
 	if !ok {
-		panic(fmt.Sprintf(
-			"Unknown cluster name: %v with given cluster client map: %v.",
-			cluster,
-			h.remoteFrontendClients,
-		))
+		h.removeRemoteClients(cluster)
+		return &ClusterNotFoundError{Cluster: cluster}
+	}
+
+	h.RLock()
+	currentAddress, ok := h.remoteAddresses[cluster]
+	h.RUnlock()
+	if ok && currentAddress == address {
+		return nil
+	}
+
+	if err := h.createRemoteClients(cluster, address); err != nil {
+		return fmt.Errorf("unable to create clients for cluster %v with address %v: %v", cluster, address, err)
+	}
+	return nil
+}
+
+// removeRemoteClients drops the clients of a cluster which is no longer known, clients already handed
+// out are left to their callers
+func (h *clientBeanImpl) removeRemoteClients(cluster string) {
+	h.Lock()
+	defer h.Unlock()
+
+	delete(h.remoteAddresses, cluster)
+	delete(h.remoteAdminClients, cluster)
+	delete(h.remoteFrontendClients, cluster)
+}
+
+func (h *clientBeanImpl) createRemoteClients(cluster string, address config.Address) error {
+	h.Lock()
+	defer h.Unlock()
+
+	if currentAddress, ok := h.remoteAddresses[cluster]; ok && currentAddress == address {
+		return nil
 	}
-	return client
+
+	// clients created against a previous address are left to the callers still holding them
+	dispatcher, err := h.dispatcherProvider.Get(address.RPCName, address.RPCAddress)
+	if err != nil {
+		return err
+	}
+
+	adminClient, err := h.factory.NewAdminClientWithTimeoutAndDispatcher(
+		address.RPCName,
+		admin.DefaultTimeout,
+		dispatcher,
+	)
+	if err != nil {
+		return err
+	}
+
+	frontendClient, err := h.factory.NewFrontendClientWithTimeoutAndDispatcher(
+		address.RPCName,
+		frontend.DefaultTimeout,
+		frontend.DefaultLongPollTimeout,
+		dispatcher,
+	)
+	if err != nil {
+		return err
+	}
+
+	h.remoteAddresses[cluster] = address
+	h.remoteAdminClients[cluster] = adminClient
+	h.remoteFrontendClients[cluster] = frontendClient
+	return nil
+}
+
+func (e *ClusterNotFoundError) Error() string {
+	return fmt.Sprintf("cluster %v is unknown or disabled", e.Cluster)
 }
 
 // NewIPYarpcDispatcherProvider create a dispatcher provider which handles with IP address
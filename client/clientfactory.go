@@ -35,6 +35,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 )
 
@@ -52,31 +53,36 @@ const (
 // Factory can be used to create RPC clients for cadence services
 type Factory interface {
 	NewHistoryClient() (history.Client, error)
-	NewMatchingClient() (matching.Client, error)
+	NewMatchingClient(domainIDToName DomainIDToNameFunc) (matching.Client, error)
 	NewFrontendClient() (frontend.Client, error)
 
 	NewHistoryClientWithTimeout(timeout time.Duration) (history.Client, error)
-	NewMatchingClientWithTimeout(timeout time.Duration, longPollTimeout time.Duration) (matching.Client, error)
+	NewMatchingClientWithTimeout(domainIDToName DomainIDToNameFunc, timeout time.Duration, longPollTimeout time.Duration) (matching.Client, error)
 	NewFrontendClientWithTimeout(timeout time.Duration, longPollTimeout time.Duration) (frontend.Client, error)
 
 	NewAdminClientWithTimeoutAndDispatcher(rpcName string, timeout time.Duration, dispatcher *yarpc.Dispatcher) (admin.Client, error)
 	NewFrontendClientWithTimeoutAndDispatcher(rpcName string, timeout time.Duration, longPollTimeout time.Duration, dispatcher *yarpc.Dispatcher) (frontend.Client, error)
 }
 
+// DomainIDToNameFunc maps a domainID to domain name. Returns error when mapping is not possible.
+type DomainIDToNameFunc = matching.DomainIDToNameFunc
+
 type rpcClientFactory struct {
 	rpcFactory            common.RPCFactory
 	monitor               membership.Monitor
 	metricsClient         metrics.Client
+	dynConfig             *dynamicconfig.Collection
 	numberOfHistoryShards int
 }
 
 // NewRPCClientFactory creates an instance of client factory that knows how to dispatch RPC calls.
 func NewRPCClientFactory(rpcFactory common.RPCFactory, monitor membership.Monitor,
-	metricsClient metrics.Client, numberOfHistoryShards int) Factory {
+	metricsClient metrics.Client, dc *dynamicconfig.Collection, numberOfHistoryShards int) Factory {
 	return &rpcClientFactory{
 		rpcFactory:            rpcFactory,
 		monitor:               monitor,
 		metricsClient:         metricsClient,
+		dynConfig:             dc,
 		numberOfHistoryShards: numberOfHistoryShards,
 	}
 }
@@ -85,8 +91,8 @@ func (cf *rpcClientFactory) NewHistoryClient() (history.Client, error) {
 	return cf.NewHistoryClientWithTimeout(history.DefaultTimeout)
 }
 
-func (cf *rpcClientFactory) NewMatchingClient() (matching.Client, error) {
-	return cf.NewMatchingClientWithTimeout(matching.DefaultTimeout, matching.DefaultLongPollTimeout)
+func (cf *rpcClientFactory) NewMatchingClient(domainIDToName DomainIDToNameFunc) (matching.Client, error) {
+	return cf.NewMatchingClientWithTimeout(domainIDToName, matching.DefaultTimeout, matching.DefaultLongPollTimeout)
 }
 
 func (cf *rpcClientFactory) NewFrontendClient() (frontend.Client, error) {
@@ -120,6 +126,7 @@ func (cf *rpcClientFactory) NewHistoryClientWithTimeout(timeout time.Duration) (
 }
 
 func (cf *rpcClientFactory) NewMatchingClientWithTimeout(
+	domainIDToName DomainIDToNameFunc,
 	timeout time.Duration,
 	longPollTimeout time.Duration,
 ) (matching.Client, error) {
@@ -141,7 +148,12 @@ func (cf *rpcClientFactory) NewMatchingClientWithTimeout(
 		return matchingserviceclient.New(dispatcher.ClientConfig(common.MatchingServiceName)), nil
 	}
 
-	client := matching.NewClient(timeout, longPollTimeout, common.NewClientCache(keyResolver, clientProvider))
+	client := matching.NewClient(
+		timeout,
+		longPollTimeout,
+		common.NewClientCache(keyResolver, clientProvider),
+		matching.NewLoadBalancer(domainIDToName, cf.dynConfig),
+	)
 	if cf.metricsClient != nil {
 		client = matching.NewMetricClient(client, cf.metricsClient)
 	}
@@ -22,10 +22,12 @@ package history
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	h "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/history/historyserviceclient"
+	"github.com/uber/cadence/.gen/go/replicator"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"go.uber.org/yarpc"
@@ -36,6 +38,9 @@ var _ Client = (*clientImpl)(nil)
 const (
 	// DefaultTimeout is the default timeout used to make calls
 	DefaultTimeout = time.Second * 30
+
+	// describeReplicationStatusConcurrency is the number of shards described at the same time
+	describeReplicationStatusConcurrency = 32
 )
 
 type clientImpl struct {
@@ -638,6 +643,103 @@ func (c *clientImpl) SyncActivity(
 	return err
 }
 
+func (c *clientImpl) GetReplicationMessages(
+	ctx context.Context,
+	request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption) (*replicator.GetReplicationMessagesResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	response := &replicator.GetReplicationMessagesResponse{
+		MessagesByShard: make(map[int32]*replicator.ReplicationMessages),
+	}
+	// tokens may belong to shards owned by different hosts, so each shard is fetched separately
+	for _, token := range request.Tokens {
+		client, err := c.getClientForShardID(int(token.GetShardID()))
+		if err != nil {
+			return nil, err
+		}
+		shardRequest := &replicator.GetReplicationMessagesRequest{
+			Tokens:      []*replicator.ReplicationToken{token},
+			ClusterName: request.ClusterName,
+		}
+		var shardResponse *replicator.GetReplicationMessagesResponse
+		op := func(ctx context.Context, client historyserviceclient.Interface) error {
+			var err error
+			ctx, cancel := c.createContext(ctx)
+			defer cancel()
+			shardResponse, err = client.GetReplicationMessages(ctx, shardRequest, opts...)
+			return err
+		}
+		if err := c.executeWithRedirect(ctx, client, op); err != nil {
+			return nil, err
+		}
+		for shardID, messages := range shardResponse.MessagesByShard {
+			response.MessagesByShard[shardID] = messages
+		}
+	}
+	return response, nil
+}
+
+func (c *clientImpl) DescribeReplicationStatus(
+	ctx context.Context,
+	request *replicator.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption) (*replicator.DescribeReplicationStatusResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	// every shard is described by its owner, up to describeReplicationStatusConcurrency shards at a time
+	shardResponses := make([]*replicator.DescribeReplicationStatusResponse, len(request.ShardIDs))
+	errs := make([]error, len(request.ShardIDs))
+	sem := make(chan struct{}, describeReplicationStatusConcurrency)
+	var wg sync.WaitGroup
+	for i, shardID := range request.ShardIDs {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, shardID int32) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			shardResponses[i], errs[i] = c.describeShardReplicationStatus(ctx, shardID, opts...)
+		}(i, shardID)
+	}
+	wg.Wait()
+
+	response := &replicator.DescribeReplicationStatusResponse{}
+	for i, shardResponse := range shardResponses {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		response.Shards = append(response.Shards, shardResponse.Shards...)
+	}
+	return response, nil
+}
+
+func (c *clientImpl) describeShardReplicationStatus(
+	ctx context.Context,
+	shardID int32,
+	opts ...yarpc.CallOption) (*replicator.DescribeReplicationStatusResponse, error) {
+
+	client, err := c.getClientForShardID(int(shardID))
+	if err != nil {
+		return nil, err
+	}
+	shardRequest := &replicator.DescribeReplicationStatusRequest{
+		ShardIDs: []int32{shardID},
+	}
+	var response *replicator.DescribeReplicationStatusResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DescribeReplicationStatus(ctx, shardRequest, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, client, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	if parent == nil {
 		return context.WithTimeout(context.Background(), c.timeout)
@@ -0,0 +1,108 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/history/historyservicetest"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	clientSuite struct {
+		suite.Suite
+		controller    *gomock.Controller
+		historyClient *historyservicetest.MockClient
+		client        Client
+	}
+
+	// testClientCache returns the same history client for every shard
+	testClientCache struct {
+		client *historyservicetest.MockClient
+	}
+)
+
+func TestClientSuite(t *testing.T) {
+	s := new(clientSuite)
+	suite.Run(t, s)
+}
+
+func (s *clientSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.historyClient = historyservicetest.NewMockClient(s.controller)
+	s.client = NewClient(64, DefaultTimeout, &testClientCache{client: s.historyClient})
+}
+
+func (s *clientSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *clientSuite) TestDescribeReplicationStatus_AllShards() {
+	var shardIDs []int32
+	for shardID := int32(0); shardID < 64; shardID++ {
+		shardIDs = append(shardIDs, shardID)
+		s.historyClient.EXPECT().DescribeReplicationStatus(gomock.Any(), &replicator.DescribeReplicationStatusRequest{
+			ShardIDs: []int32{shardID},
+		}).Return(&replicator.DescribeReplicationStatusResponse{
+			Shards: []*replicator.ShardReplicationStatus{{ShardID: common.Int32Ptr(shardID)}},
+		}, nil)
+	}
+
+	response, err := s.client.DescribeReplicationStatus(context.Background(), &replicator.DescribeReplicationStatusRequest{
+		ShardIDs: shardIDs,
+	})
+	s.NoError(err)
+	s.Equal(64, len(response.Shards))
+	// the shards are reported in the order they were requested
+	for i, shard := range response.Shards {
+		s.Equal(int32(i), shard.GetShardID())
+	}
+}
+
+func (s *clientSuite) TestDescribeReplicationStatus_ShardFailed() {
+	s.historyClient.EXPECT().DescribeReplicationStatus(gomock.Any(), &replicator.DescribeReplicationStatusRequest{
+		ShardIDs: []int32{1},
+	}).Return(&replicator.DescribeReplicationStatusResponse{
+		Shards: []*replicator.ShardReplicationStatus{{ShardID: common.Int32Ptr(1)}},
+	}, nil)
+	s.historyClient.EXPECT().DescribeReplicationStatus(gomock.Any(), &replicator.DescribeReplicationStatusRequest{
+		ShardIDs: []int32{2},
+	}).Return(nil, errors.New("shard is not available"))
+
+	_, err := s.client.DescribeReplicationStatus(context.Background(), &replicator.DescribeReplicationStatusRequest{
+		ShardIDs: []int32{1, 2},
+	})
+	s.EqualError(err, "shard is not available")
+}
+
+func (c *testClientCache) GetClientForKey(key string) (interface{}, error) {
+	return c.client, nil
+}
+
+func (c *testClientCache) GetClientForClientKey(clientKey string) (interface{}, error) {
+	return c.client, nil
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 
 	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/metrics"
 	"go.uber.org/yarpc"
@@ -469,3 +470,37 @@ func (c *metricClient) SyncActivity(
 
 	return err
 }
+
+func (c *metricClient) GetReplicationMessages(
+	context context.Context,
+	request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption) (*replicator.GetReplicationMessagesResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationMessagesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetReplicationMessagesScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetReplicationMessages(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationMessagesScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) DescribeReplicationStatus(
+	context context.Context,
+	request *replicator.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption) (*replicator.DescribeReplicationStatusResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientDescribeReplicationStatusScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDescribeReplicationStatusScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeReplicationStatus(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDescribeReplicationStatusScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
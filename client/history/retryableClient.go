@@ -24,6 +24,7 @@ import (
 	"context"
 
 	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/backoff"
 	"go.uber.org/yarpc"
@@ -405,3 +406,35 @@ func (c *retryableClient) SyncActivity(
 
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
+
+func (c *retryableClient) GetReplicationMessages(
+	ctx context.Context,
+	request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption) (*replicator.GetReplicationMessagesResponse, error) {
+
+	var resp *replicator.GetReplicationMessagesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetReplicationMessages(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeReplicationStatus(
+	ctx context.Context,
+	request *replicator.DescribeReplicationStatusRequest,
+	opts ...yarpc.CallOption) (*replicator.DescribeReplicationStatusResponse, error) {
+
+	var resp *replicator.DescribeReplicationStatusResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeReplicationStatus(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
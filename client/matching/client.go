@@ -28,6 +28,7 @@ import (
 	"github.com/uber/cadence/.gen/go/matching/matchingserviceclient"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
 	"go.uber.org/yarpc"
 )
 
@@ -44,6 +45,7 @@ type clientImpl struct {
 	timeout         time.Duration
 	longPollTimeout time.Duration
 	clients         common.ClientCache
+	loadBalancer    LoadBalancer
 }
 
 // NewClient creates a new history service TChannel client
@@ -51,11 +53,13 @@ func NewClient(
 	timeout time.Duration,
 	longPollTimeout time.Duration,
 	clients common.ClientCache,
+	lb LoadBalancer,
 ) Client {
 	return &clientImpl{
 		timeout:         timeout,
 		longPollTimeout: longPollTimeout,
 		clients:         clients,
+		loadBalancer:    lb,
 	}
 }
 
@@ -64,13 +68,20 @@ func (c *clientImpl) AddActivityTask(
 	addRequest *m.AddActivityTaskRequest,
 	opts ...yarpc.CallOption) error {
 	opts = common.AggregateYarpcOptions(ctx, opts...)
-	client, err := c.getClientForTasklist(addRequest.TaskList.GetName())
+	request := *addRequest
+	request.TaskList = c.partitionTaskList(addRequest.TaskList, c.loadBalancer.PickWritePartition(
+		addRequest.GetDomainUUID(),
+		*addRequest.TaskList,
+		persistence.TaskListTypeActivity,
+		addRequest.GetForwardedFrom(),
+	))
+	client, err := c.getClientForTasklist(request.TaskList.GetName())
 	if err != nil {
 		return err
 	}
 	ctx, cancel := c.createContext(ctx)
 	defer cancel()
-	return client.AddActivityTask(ctx, addRequest, opts...)
+	return client.AddActivityTask(ctx, &request, opts...)
 }
 
 func (c *clientImpl) AddDecisionTask(
@@ -78,13 +89,20 @@ func (c *clientImpl) AddDecisionTask(
 	addRequest *m.AddDecisionTaskRequest,
 	opts ...yarpc.CallOption) error {
 	opts = common.AggregateYarpcOptions(ctx, opts...)
-	client, err := c.getClientForTasklist(addRequest.TaskList.GetName())
+	request := *addRequest
+	request.TaskList = c.partitionTaskList(addRequest.TaskList, c.loadBalancer.PickWritePartition(
+		addRequest.GetDomainUUID(),
+		*addRequest.TaskList,
+		persistence.TaskListTypeDecision,
+		addRequest.GetForwardedFrom(),
+	))
+	client, err := c.getClientForTasklist(request.TaskList.GetName())
 	if err != nil {
 		return err
 	}
 	ctx, cancel := c.createContext(ctx)
 	defer cancel()
-	return client.AddDecisionTask(ctx, addRequest, opts...)
+	return client.AddDecisionTask(ctx, &request, opts...)
 }
 
 func (c *clientImpl) PollForActivityTask(
@@ -92,13 +110,22 @@ func (c *clientImpl) PollForActivityTask(
 	pollRequest *m.PollForActivityTaskRequest,
 	opts ...yarpc.CallOption) (*workflow.PollForActivityTaskResponse, error) {
 	opts = common.AggregateYarpcOptions(ctx, opts...)
-	client, err := c.getClientForTasklist(pollRequest.PollRequest.TaskList.GetName())
+	request := *pollRequest
+	innerRequest := *pollRequest.PollRequest
+	innerRequest.TaskList = c.partitionTaskList(pollRequest.PollRequest.TaskList, c.loadBalancer.PickReadPartition(
+		pollRequest.GetDomainUUID(),
+		*pollRequest.PollRequest.TaskList,
+		persistence.TaskListTypeActivity,
+		pollRequest.GetForwardedFrom(),
+	))
+	request.PollRequest = &innerRequest
+	client, err := c.getClientForTasklist(innerRequest.TaskList.GetName())
 	if err != nil {
 		return nil, err
 	}
 	ctx, cancel := c.createLongPollContext(ctx)
 	defer cancel()
-	return client.PollForActivityTask(ctx, pollRequest, opts...)
+	return client.PollForActivityTask(ctx, &request, opts...)
 }
 
 func (c *clientImpl) PollForDecisionTask(
@@ -106,17 +133,27 @@ func (c *clientImpl) PollForDecisionTask(
 	pollRequest *m.PollForDecisionTaskRequest,
 	opts ...yarpc.CallOption) (*m.PollForDecisionTaskResponse, error) {
 	opts = common.AggregateYarpcOptions(ctx, opts...)
-	client, err := c.getClientForTasklist(pollRequest.PollRequest.TaskList.GetName())
+	request := *pollRequest
+	innerRequest := *pollRequest.PollRequest
+	innerRequest.TaskList = c.partitionTaskList(pollRequest.PollRequest.TaskList, c.loadBalancer.PickReadPartition(
+		pollRequest.GetDomainUUID(),
+		*pollRequest.PollRequest.TaskList,
+		persistence.TaskListTypeDecision,
+		pollRequest.GetForwardedFrom(),
+	))
+	request.PollRequest = &innerRequest
+	client, err := c.getClientForTasklist(innerRequest.TaskList.GetName())
 	if err != nil {
 		return nil, err
 	}
 	ctx, cancel := c.createLongPollContext(ctx)
 	defer cancel()
-	return client.PollForDecisionTask(ctx, pollRequest, opts...)
+	return client.PollForDecisionTask(ctx, &request, opts...)
 }
 
 func (c *clientImpl) QueryWorkflow(ctx context.Context, queryRequest *m.QueryWorkflowRequest, opts ...yarpc.CallOption) (*workflow.QueryWorkflowResponse, error) {
 	opts = common.AggregateYarpcOptions(ctx, opts...)
+	// the query goes to the root partition, which the other partitions forward their polls to
 	client, err := c.getClientForTasklist(queryRequest.TaskList.GetName())
 	if err != nil {
 		return nil, err
@@ -139,16 +176,79 @@ func (c *clientImpl) RespondQueryTaskCompleted(ctx context.Context, request *m.R
 
 func (c *clientImpl) CancelOutstandingPoll(ctx context.Context, request *m.CancelOutstandingPollRequest, opts ...yarpc.CallOption) error {
 	opts = common.AggregateYarpcOptions(ctx, opts...)
-	client, err := c.getClientForTasklist(request.TaskList.GetName())
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	// the poller could have been routed to any partition of the task list
+	var lastErr error
+	partitions := c.loadBalancer.AllPartitions(request.GetDomainUUID(), *request.TaskList, int(request.GetTaskListType()))
+	for _, partition := range partitions {
+		client, err := c.getClientForTasklist(partition)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		partitionRequest := *request
+		partitionRequest.TaskList = c.partitionTaskList(request.TaskList, partition)
+		if err := client.CancelOutstandingPoll(ctx, &partitionRequest, opts...); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+func (c *clientImpl) DescribeTaskList(ctx context.Context, request *m.DescribeTaskListRequest, opts ...yarpc.CallOption) (*workflow.DescribeTaskListResponse, error) {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	// pollers are spread over the partitions of the task list, and each partition is paused on its own
+	descRequest := request.DescRequest
+	result := &workflow.DescribeTaskListResponse{Pollers: []*workflow.PollerInfo{}, Paused: common.BoolPtr(false)}
+	pollerIdx := make(map[string]int)
+	partitions := c.loadBalancer.AllPartitions(request.GetDomainUUID(), *descRequest.TaskList, int(descRequest.GetTaskListType()))
+	for _, partition := range partitions {
+		client, err := c.getClientForTasklist(partition)
+		if err != nil {
+			return nil, err
+		}
+		partitionRequest := *descRequest
+		partitionRequest.TaskList = c.partitionTaskList(descRequest.TaskList, partition)
+		resp, err := client.DescribeTaskList(ctx, &m.DescribeTaskListRequest{
+			DomainUUID:  request.DomainUUID,
+			DescRequest: &partitionRequest,
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if resp.GetPaused() {
+			result.Paused = common.BoolPtr(true)
+		}
+		// a poller polling several partitions is reported once, with its latest access
+		for _, poller := range resp.Pollers {
+			idx, ok := pollerIdx[poller.GetIdentity()]
+			if !ok {
+				pollerIdx[poller.GetIdentity()] = len(result.Pollers)
+				result.Pollers = append(result.Pollers, poller)
+			} else if result.Pollers[idx].GetLastAccessTime() < poller.GetLastAccessTime() {
+				result.Pollers[idx] = poller
+			}
+		}
+	}
+	return result, nil
+}
+
+func (c *clientImpl) UpdateTaskListDispatchRate(ctx context.Context, request *m.UpdateTaskListDispatchRateRequest, opts ...yarpc.CallOption) error {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	// the total rate of the task list is set on the root partition, the other partitions read it from there
+	client, err := c.getClientForTasklist(request.UpdateRequest.TaskList.GetName())
 	if err != nil {
 		return err
 	}
 	ctx, cancel := c.createContext(ctx)
 	defer cancel()
-	return client.CancelOutstandingPoll(ctx, request, opts...)
+	return client.UpdateTaskListDispatchRate(ctx, request, opts...)
 }
 
-func (c *clientImpl) DescribeTaskList(ctx context.Context, request *m.DescribeTaskListRequest, opts ...yarpc.CallOption) (*workflow.DescribeTaskListResponse, error) {
+func (c *clientImpl) DescribeTaskListDispatchRate(ctx context.Context, request *m.DescribeTaskListDispatchRateRequest, opts ...yarpc.CallOption) (*workflow.DescribeTaskListDispatchRateResponse, error) {
 	opts = common.AggregateYarpcOptions(ctx, opts...)
 	client, err := c.getClientForTasklist(request.DescRequest.TaskList.GetName())
 	if err != nil {
@@ -156,7 +256,67 @@ func (c *clientImpl) DescribeTaskList(ctx context.Context, request *m.DescribeTa
 	}
 	ctx, cancel := c.createContext(ctx)
 	defer cancel()
-	return client.DescribeTaskList(ctx, request, opts...)
+	return client.DescribeTaskListDispatchRate(ctx, request, opts...)
+}
+
+func (c *clientImpl) PauseTaskList(ctx context.Context, request *m.PauseTaskListRequest, opts ...yarpc.CallOption) error {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	pauseRequest := request.PauseRequest
+	partitions := c.loadBalancer.AllPartitions(request.GetDomainUUID(), *pauseRequest.TaskList, int(pauseRequest.GetTaskListType()))
+	for _, partition := range partitions {
+		client, err := c.getClientForTasklist(partition)
+		if err != nil {
+			return err
+		}
+		partitionRequest := *pauseRequest
+		partitionRequest.TaskList = c.partitionTaskList(pauseRequest.TaskList, partition)
+		err = client.PauseTaskList(ctx, &m.PauseTaskListRequest{
+			DomainUUID:   request.DomainUUID,
+			PauseRequest: &partitionRequest,
+		}, opts...)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *clientImpl) ResumeTaskList(ctx context.Context, request *m.ResumeTaskListRequest, opts ...yarpc.CallOption) error {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	resumeRequest := request.ResumeRequest
+	partitions := c.loadBalancer.AllPartitions(request.GetDomainUUID(), *resumeRequest.TaskList, int(resumeRequest.GetTaskListType()))
+	for _, partition := range partitions {
+		client, err := c.getClientForTasklist(partition)
+		if err != nil {
+			return err
+		}
+		partitionRequest := *resumeRequest
+		partitionRequest.TaskList = c.partitionTaskList(resumeRequest.TaskList, partition)
+		err = client.ResumeTaskList(ctx, &m.ResumeTaskListRequest{
+			DomainUUID:    request.DomainUUID,
+			ResumeRequest: &partitionRequest,
+		}, opts...)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *clientImpl) ListTaskLists(ctx context.Context, request *m.ListTaskListsRequest, opts ...yarpc.CallOption) (*workflow.ListTaskListsResponse, error) {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	// task lists of a domain are read from persistence, so any host can serve the request
+	client, err := c.getClientForTasklist(request.GetDomainUUID())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListTaskLists(ctx, request, opts...)
 }
 
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
@@ -173,6 +333,13 @@ func (c *clientImpl) createLongPollContext(parent context.Context) (context.Cont
 	return context.WithTimeout(parent, c.longPollTimeout)
 }
 
+func (c *clientImpl) partitionTaskList(taskList *workflow.TaskList, partition string) *workflow.TaskList {
+	return &workflow.TaskList{
+		Name: common.StringPtr(partition),
+		Kind: taskList.Kind,
+	}
+}
+
 func (c *clientImpl) getClientForTasklist(key string) (matchingserviceclient.Interface, error) {
 	client, err := c.clients.GetClientForKey(key)
 	if err != nil {
@@ -0,0 +1,163 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	m "github.com/uber/cadence/.gen/go/matching"
+	"github.com/uber/cadence/.gen/go/matching/matchingservicetest"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"go.uber.org/yarpc"
+)
+
+type (
+	clientSuite struct {
+		suite.Suite
+		controller     *gomock.Controller
+		matchingClient *matchingservicetest.MockClient
+		numPartitions  int
+		client         Client
+	}
+
+	// testClientCache returns the same matching client for every task list
+	testClientCache struct {
+		client *matchingservicetest.MockClient
+	}
+
+	// queryTaskListMatcher matches the query requests sent to a task list partition
+	queryTaskListMatcher struct {
+		taskList string
+	}
+)
+
+func TestClientSuite(t *testing.T) {
+	s := new(clientSuite)
+	suite.Run(t, s)
+}
+
+func (s *clientSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.matchingClient = matchingservicetest.NewMockClient(s.controller)
+	s.numPartitions = 3
+	lb := &defaultLoadBalancer{
+		numPartitions: func(domain string, taskList string, taskType int) int {
+			return s.numPartitions
+		},
+		domainIDToName: func(domainID string) (string, error) {
+			return "test-domain", nil
+		},
+	}
+	s.client = NewClient(DefaultTimeout, DefaultLongPollTimeout, &testClientCache{client: s.matchingClient}, lb)
+}
+
+func (s *clientSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *clientSuite) TestQueryWorkflow_RootPartition() {
+	// the other partitions forward their polls to the root partition, so only the root gets the query
+	s.matchingClient.EXPECT().QueryWorkflow(gomock.Any(), &queryTaskListMatcher{taskList: "tl"}).
+		Return(&shared.QueryWorkflowResponse{QueryResult: []byte("result")}, nil)
+
+	resp, err := s.client.QueryWorkflow(context.Background(), s.queryRequest("tl"))
+	s.NoError(err)
+	s.Equal([]byte("result"), resp.QueryResult)
+}
+
+func (s *clientSuite) TestQueryWorkflow_Failed() {
+	s.matchingClient.EXPECT().QueryWorkflow(gomock.Any(), &queryTaskListMatcher{taskList: "tl"}).
+		Return(nil, &shared.QueryFailedError{Message: "query failed"})
+
+	_, err := s.client.QueryWorkflow(context.Background(), s.queryRequest("tl"))
+	s.Equal(&shared.QueryFailedError{Message: "query failed"}, err)
+}
+
+func (s *clientSuite) TestUpdateTaskListDispatchRate_RootPartition() {
+	// the total rate is set on the root partition, the other partitions derive their share from it
+	s.matchingClient.EXPECT().UpdateTaskListDispatchRate(gomock.Any(), gomock.Any()).
+		Do(func(ctx context.Context, request *m.UpdateTaskListDispatchRateRequest, opts ...yarpc.CallOption) {
+			s.Equal("tl", request.UpdateRequest.TaskList.GetName())
+			s.Equal(30.0, request.UpdateRequest.GetDispatchRatePerSecond())
+		}).
+		Return(nil)
+
+	err := s.client.UpdateTaskListDispatchRate(context.Background(), &m.UpdateTaskListDispatchRateRequest{
+		DomainUUID: common.StringPtr("domain-id"),
+		UpdateRequest: &shared.UpdateTaskListDispatchRateRequest{
+			Domain:                common.StringPtr("test-domain"),
+			TaskList:              &shared.TaskList{Name: common.StringPtr("tl")},
+			DispatchRatePerSecond: common.Float64Ptr(30),
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *clientSuite) TestDescribeTaskListDispatchRate_RootPartition() {
+	s.matchingClient.EXPECT().DescribeTaskListDispatchRate(gomock.Any(), gomock.Any()).
+		Do(func(ctx context.Context, request *m.DescribeTaskListDispatchRateRequest, opts ...yarpc.CallOption) {
+			s.Equal("tl", request.DescRequest.TaskList.GetName())
+		}).
+		Return(&shared.DescribeTaskListDispatchRateResponse{DispatchRatePerSecond: common.Float64Ptr(30)}, nil)
+
+	resp, err := s.client.DescribeTaskListDispatchRate(context.Background(), &m.DescribeTaskListDispatchRateRequest{
+		DomainUUID: common.StringPtr("domain-id"),
+		DescRequest: &shared.DescribeTaskListDispatchRateRequest{
+			Domain:   common.StringPtr("test-domain"),
+			TaskList: &shared.TaskList{Name: common.StringPtr("tl")},
+		},
+	})
+	s.NoError(err)
+	s.Equal(30.0, resp.GetDispatchRatePerSecond())
+}
+
+func (s *clientSuite) queryRequest(taskList string) *m.QueryWorkflowRequest {
+	return &m.QueryWorkflowRequest{
+		DomainUUID: common.StringPtr("domain-id"),
+		TaskList:   &shared.TaskList{Name: common.StringPtr(taskList)},
+		QueryRequest: &shared.QueryWorkflowRequest{
+			Domain: common.StringPtr("test-domain"),
+			Query:  &shared.WorkflowQuery{QueryType: common.StringPtr("state")},
+		},
+	}
+}
+
+func (c *testClientCache) GetClientForKey(key string) (interface{}, error) {
+	return c.client, nil
+}
+
+func (c *testClientCache) GetClientForClientKey(clientKey string) (interface{}, error) {
+	return c.client, nil
+}
+
+func (q *queryTaskListMatcher) Matches(x interface{}) bool {
+	request, ok := x.(*m.QueryWorkflowRequest)
+	return ok && request.TaskList.GetName() == q.taskList
+}
+
+func (q *queryTaskListMatcher) String() string {
+	return fmt.Sprintf("is a query request to task list %v", q.taskList)
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// LoadBalancer is the interface for implementers of
+	// component that distributes add/poll api calls across
+	// task list partitions when possible
+	LoadBalancer interface {
+		// PickWritePartition returns the task list partition for adding
+		// an activity or decision task. The input is the name of the
+		// original task list (with no partition info). When forwardedFrom
+		// is non-empty, this call is forwarded from a child partition
+		// to a parent partition in which case, no load balancing should be
+		// performed
+		PickWritePartition(
+			domainID string,
+			taskList shared.TaskList,
+			taskListType int,
+			forwardedFrom string,
+		) string
+
+		// PickReadPartition returns the task list partition to send a poller to.
+		// Input is name of the original task list as specified by caller. When
+		// forwardedFrom is non-empty, no load balancing should be done.
+		PickReadPartition(
+			domainID string,
+			taskList shared.TaskList,
+			taskListType int,
+			forwardedFrom string,
+		) string
+
+		// AllPartitions returns the names of all partitions of the task list,
+		// starting with the root partition
+		AllPartitions(
+			domainID string,
+			taskList shared.TaskList,
+			taskListType int,
+		) []string
+	}
+
+	// DomainIDToNameFunc maps a domainID to domain name. Returns error when mapping is not possible.
+	DomainIDToNameFunc func(string) (string, error)
+
+	defaultLoadBalancer struct {
+		numPartitions  dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		domainIDToName DomainIDToNameFunc
+	}
+)
+
+// NewLoadBalancer returns an instance of matching load balancer that
+// can help distribute api calls across task list partitions
+func NewLoadBalancer(
+	domainIDToName DomainIDToNameFunc,
+	dc *dynamicconfig.Collection,
+) LoadBalancer {
+	return &defaultLoadBalancer{
+		domainIDToName: domainIDToName,
+		numPartitions:  dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistPartitions, 1),
+	}
+}
+
+func (lb *defaultLoadBalancer) PickWritePartition(
+	domainID string,
+	taskList shared.TaskList,
+	taskListType int,
+	forwardedFrom string,
+) string {
+	return lb.pickPartition(domainID, taskList, taskListType, forwardedFrom)
+}
+
+func (lb *defaultLoadBalancer) PickReadPartition(
+	domainID string,
+	taskList shared.TaskList,
+	taskListType int,
+	forwardedFrom string,
+) string {
+	return lb.pickPartition(domainID, taskList, taskListType, forwardedFrom)
+}
+
+func (lb *defaultLoadBalancer) AllPartitions(
+	domainID string,
+	taskList shared.TaskList,
+	taskListType int,
+) []string {
+	n := lb.getNumPartitions(domainID, taskList, taskListType)
+	partitions := make([]string, 0, n)
+	for p := 0; p < n; p++ {
+		partitions = append(partitions, TaskListPartitionName(taskList.GetName(), p))
+	}
+	return partitions
+}
+
+func (lb *defaultLoadBalancer) pickPartition(
+	domainID string,
+	taskList shared.TaskList,
+	taskListType int,
+	forwardedFrom string,
+) string {
+	if forwardedFrom != "" || IsTaskListPartition(taskList.GetName()) {
+		return taskList.GetName()
+	}
+	n := lb.getNumPartitions(domainID, taskList, taskListType)
+	return TaskListPartitionName(taskList.GetName(), rand.Intn(n))
+}
+
+func (lb *defaultLoadBalancer) getNumPartitions(
+	domainID string,
+	taskList shared.TaskList,
+	taskListType int,
+) int {
+	if taskList.GetKind() == shared.TaskListKindSticky {
+		return 1
+	}
+	domainName, err := lb.domainIDToName(domainID)
+	if err != nil {
+		return 1
+	}
+	n := lb.numPartitions(domainName, taskList.GetName(), taskListType)
+	if n <= 0 {
+		return 1
+	}
+	return n
+}
+
+// TaskListPartitionName returns the name of the given partition of a task list.
+// Partition 0 is the root partition and keeps the name of the task list.
+func TaskListPartitionName(root string, partition int) string {
+	if partition <= 0 {
+		return root
+	}
+	return fmt.Sprintf("%v%v/%v", common.ReservedTaskListPrefix, root, partition)
+}
+
+// IsTaskListPartition returns true if the name is the name of a non-root task list partition
+func IsTaskListPartition(name string) bool {
+	return strings.HasPrefix(name, common.ReservedTaskListPrefix)
+}
+
+// RootTaskListName returns the name of the root partition of the task list the given partition belongs to
+func RootTaskListName(name string) string {
+	if !IsTaskListPartition(name) {
+		return name
+	}
+	suffix := name[len(common.ReservedTaskListPrefix):]
+	idx := strings.LastIndex(suffix, "/")
+	if idx <= 0 {
+		return name
+	}
+	if _, err := strconv.Atoi(suffix[idx+1:]); err != nil {
+		return name
+	}
+	return suffix[:idx]
+}
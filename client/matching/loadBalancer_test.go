@@ -0,0 +1,145 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	loadBalancerSuite struct {
+		suite.Suite
+		numPartitions int
+		lb            *defaultLoadBalancer
+	}
+)
+
+func TestLoadBalancerSuite(t *testing.T) {
+	s := new(loadBalancerSuite)
+	suite.Run(t, s)
+}
+
+func (s *loadBalancerSuite) SetupTest() {
+	s.numPartitions = 4
+	s.lb = &defaultLoadBalancer{
+		numPartitions: func(domain string, taskList string, taskType int) int {
+			return s.numPartitions
+		},
+		domainIDToName: func(domainID string) (string, error) {
+			if domainID == "unknown-domain-id" {
+				return "", errors.New("domain not found")
+			}
+			return "test-domain", nil
+		},
+	}
+}
+
+func (s *loadBalancerSuite) TestAllPartitions() {
+	partitions := s.lb.AllPartitions("domain-id", s.taskList("tl", shared.TaskListKindNormal), persistence.TaskListTypeDecision)
+	s.Equal([]string{
+		"tl",
+		"/__cadence_sys/tl/1",
+		"/__cadence_sys/tl/2",
+		"/__cadence_sys/tl/3",
+	}, partitions)
+}
+
+func (s *loadBalancerSuite) TestAllPartitions_SinglePartition() {
+	// sticky task lists are never partitioned
+	partitions := s.lb.AllPartitions("domain-id", s.taskList("tl", shared.TaskListKindSticky), persistence.TaskListTypeDecision)
+	s.Equal([]string{"tl"}, partitions)
+
+	// the root partition serves the task list when the domain cannot be resolved
+	partitions = s.lb.AllPartitions("unknown-domain-id", s.taskList("tl", shared.TaskListKindNormal), persistence.TaskListTypeDecision)
+	s.Equal([]string{"tl"}, partitions)
+
+	// and when the number of partitions is misconfigured
+	s.numPartitions = 0
+	partitions = s.lb.AllPartitions("domain-id", s.taskList("tl", shared.TaskListKindNormal), persistence.TaskListTypeDecision)
+	s.Equal([]string{"tl"}, partitions)
+	s.numPartitions = -1
+	partitions = s.lb.AllPartitions("domain-id", s.taskList("tl", shared.TaskListKindNormal), persistence.TaskListTypeDecision)
+	s.Equal([]string{"tl"}, partitions)
+}
+
+func (s *loadBalancerSuite) TestPickPartition() {
+	partitions := s.lb.AllPartitions("domain-id", s.taskList("tl", shared.TaskListKindNormal), persistence.TaskListTypeActivity)
+	picked := make(map[string]struct{})
+	for i := 0; i < 1000; i++ {
+		write := s.lb.PickWritePartition("domain-id", s.taskList("tl", shared.TaskListKindNormal), persistence.TaskListTypeActivity, "")
+		s.Contains(partitions, write)
+		picked[write] = struct{}{}
+		read := s.lb.PickReadPartition("domain-id", s.taskList("tl", shared.TaskListKindNormal), persistence.TaskListTypeActivity, "")
+		s.Contains(partitions, read)
+		picked[read] = struct{}{}
+	}
+	s.Equal(len(partitions), len(picked))
+}
+
+func (s *loadBalancerSuite) TestPickPartition_NoLoadBalancing() {
+	// forwarded calls are not load balanced again
+	s.Equal("tl", s.lb.PickWritePartition("domain-id", s.taskList("tl", shared.TaskListKindNormal), persistence.TaskListTypeActivity, "/__cadence_sys/tl/2"))
+	s.Equal("tl", s.lb.PickReadPartition("domain-id", s.taskList("tl", shared.TaskListKindNormal), persistence.TaskListTypeActivity, "/__cadence_sys/tl/2"))
+
+	// neither are calls already addressed to a partition
+	s.Equal("/__cadence_sys/tl/3", s.lb.PickWritePartition("domain-id", s.taskList("/__cadence_sys/tl/3", shared.TaskListKindNormal), persistence.TaskListTypeActivity, ""))
+	s.Equal("/__cadence_sys/tl/3", s.lb.PickReadPartition("domain-id", s.taskList("/__cadence_sys/tl/3", shared.TaskListKindNormal), persistence.TaskListTypeActivity, ""))
+
+	s.Equal("tl", s.lb.PickWritePartition("domain-id", s.taskList("tl", shared.TaskListKindSticky), persistence.TaskListTypeDecision, ""))
+	s.Equal("tl", s.lb.PickReadPartition("domain-id", s.taskList("tl", shared.TaskListKindSticky), persistence.TaskListTypeDecision, ""))
+}
+
+func (s *loadBalancerSuite) TestTaskListPartitionName() {
+	s.Equal("tl", TaskListPartitionName("tl", 0))
+	s.Equal("tl", TaskListPartitionName("tl", -1))
+	s.Equal("/__cadence_sys/tl/1", TaskListPartitionName("tl", 1))
+	s.Equal("/__cadence_sys/a/b/12", TaskListPartitionName("a/b", 12))
+}
+
+func (s *loadBalancerSuite) TestIsTaskListPartition() {
+	s.False(IsTaskListPartition("tl"))
+	s.False(IsTaskListPartition("a/b/1"))
+	s.True(IsTaskListPartition("/__cadence_sys/tl/1"))
+}
+
+func (s *loadBalancerSuite) TestRootTaskListName() {
+	s.Equal("tl", RootTaskListName("tl"))
+	s.Equal("tl", RootTaskListName("/__cadence_sys/tl/1"))
+	s.Equal("a/b", RootTaskListName("/__cadence_sys/a/b/12"))
+	s.Equal("a/b", RootTaskListName(TaskListPartitionName("a/b", 3)))
+	// names that are not well formed partition names are left unchanged
+	s.Equal("/__cadence_sys/tl", RootTaskListName("/__cadence_sys/tl"))
+	s.Equal("/__cadence_sys/tl/x", RootTaskListName("/__cadence_sys/tl/x"))
+	s.Equal("/__cadence_sys//1", RootTaskListName("/__cadence_sys//1"))
+}
+
+func (s *loadBalancerSuite) taskList(name string, kind shared.TaskListKind) shared.TaskList {
+	return shared.TaskList{
+		Name: common.StringPtr(name),
+		Kind: common.TaskListKindPtr(kind),
+	}
+}
@@ -179,3 +179,88 @@ func (c *metricClient) DescribeTaskList(
 
 	return resp, err
 }
+
+func (c *metricClient) UpdateTaskListDispatchRate(
+	ctx context.Context,
+	request *m.UpdateTaskListDispatchRateRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.MatchingClientUpdateTaskListDispatchRateScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientUpdateTaskListDispatchRateScope, metrics.CadenceClientLatency)
+	err := c.client.UpdateTaskListDispatchRate(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientUpdateTaskListDispatchRateScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) DescribeTaskListDispatchRate(
+	ctx context.Context,
+	request *m.DescribeTaskListDispatchRateRequest,
+	opts ...yarpc.CallOption) (*workflow.DescribeTaskListDispatchRateResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientDescribeTaskListDispatchRateScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientDescribeTaskListDispatchRateScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeTaskListDispatchRate(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientDescribeTaskListDispatchRateScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) PauseTaskList(
+	ctx context.Context,
+	request *m.PauseTaskListRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.MatchingClientPauseTaskListScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientPauseTaskListScope, metrics.CadenceClientLatency)
+	err := c.client.PauseTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientPauseTaskListScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) ResumeTaskList(
+	ctx context.Context,
+	request *m.ResumeTaskListRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.MatchingClientResumeTaskListScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientResumeTaskListScope, metrics.CadenceClientLatency)
+	err := c.client.ResumeTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientResumeTaskListScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) ListTaskLists(
+	ctx context.Context,
+	request *m.ListTaskListsRequest,
+	opts ...yarpc.CallOption) (*workflow.ListTaskListsResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientListTaskListsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientListTaskListsScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListTaskLists(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientListTaskListsScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
@@ -156,3 +156,71 @@ func (c *retryableClient) DescribeTaskList(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) UpdateTaskListDispatchRate(
+	ctx context.Context,
+	request *m.UpdateTaskListDispatchRateRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.UpdateTaskListDispatchRate(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) DescribeTaskListDispatchRate(
+	ctx context.Context,
+	request *m.DescribeTaskListDispatchRateRequest,
+	opts ...yarpc.CallOption) (*workflow.DescribeTaskListDispatchRateResponse, error) {
+
+	var resp *workflow.DescribeTaskListDispatchRateResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeTaskListDispatchRate(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PauseTaskList(
+	ctx context.Context,
+	request *m.PauseTaskListRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.PauseTaskList(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ResumeTaskList(
+	ctx context.Context,
+	request *m.ResumeTaskListRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.ResumeTaskList(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ListTaskLists(
+	ctx context.Context,
+	request *m.ListTaskListsRequest,
+	opts ...yarpc.CallOption) (*workflow.ListTaskListsResponse, error) {
+
+	var resp *workflow.ListTaskListsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListTaskLists(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
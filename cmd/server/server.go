@@ -137,9 +137,15 @@ func (s *server) startService() common.Daemon {
 	params.ESConfig = &s.cfg.ElasticSearch
 	enableVisibilityToKafka := dc.GetBoolProperty(dynamicconfig.EnableVisibilityToKafka, params.ESConfig.Enable)()
 	if params.ClusterMetadata.IsGlobalDomainEnabled() {
-		params.MessagingClient = messaging.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, zap.NewNop(), params.Logger, params.MetricScope, true, enableVisibilityToKafka)
+		params.MessagingClient, err = messaging.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, zap.NewNop(), params.Logger, params.MetricScope, true, enableVisibilityToKafka)
+		if err != nil {
+			log.Fatalf("error creating kafka client: %v", err)
+		}
 	} else if enableVisibilityToKafka {
-		params.MessagingClient = messaging.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, zap.NewNop(), params.Logger, params.MetricScope, false, enableVisibilityToKafka)
+		params.MessagingClient, err = messaging.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, zap.NewNop(), params.Logger, params.MetricScope, false, enableVisibilityToKafka)
+		if err != nil {
+			log.Fatalf("error creating kafka client: %v", err)
+		}
 	} else {
 		params.MessagingClient = nil
 	}
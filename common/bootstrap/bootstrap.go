@@ -0,0 +1,77 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bootstrap
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// Domain is the domain bootstrap workflows run in, the cadence system domain
+	Domain = "cadence-system"
+	// TaskList is the task list bootstrap workflows and activities are dispatched on
+	TaskList = "cadsys-bootstrap-tl"
+	// WorkflowIDPrefix is the prefix of all bootstrap workflow ids
+	WorkflowIDPrefix = "cadsys-bootstrap"
+	// WorkflowFnName name of bootstrap workflow function
+	WorkflowFnName = "BootstrapWorkflow"
+	// ProgressQueryType is the query type returning the checkpointed state of a bootstrap workflow
+	ProgressQueryType = "bootstrap-progress"
+	// WorkflowStartToCloseTimeout is the time for a single run of the workflow to finish
+	WorkflowStartToCloseTimeout = time.Hour * 24 * 30
+	// DecisionTaskStartToCloseTimeout is the time for decision to finish
+	DecisionTaskStartToCloseTimeout = time.Minute
+	// DefaultPageSize is the default number of open executions listed per page
+	DefaultPageSize = 100
+	// DefaultRPS is the default number of executions replicated per second
+	DefaultRPS = 10
+)
+
+type (
+	// Params is the input of the bootstrap workflow, it also carries the checkpoint of the
+	// bootstrap across continue as new and is returned by the progress query
+	Params struct {
+		DomainName    string
+		SourceCluster string
+		RPS           int
+		PageSize      int
+		// LatestStartTime bounds the listing of open executions, executions started later
+		// are replicated by the regular replication stream
+		LatestStartTime int64
+		NextPageToken   []byte
+		Progress        Progress
+	}
+
+	// Progress is the progress of a bootstrap
+	Progress struct {
+		PagesProcessed       int
+		ExecutionsReplicated int
+		ExecutionsSkipped    int
+		ExecutionsFailed     int
+		Completed            bool
+	}
+)
+
+// WorkflowID returns the id of the bootstrap workflow of a domain
+func WorkflowID(domainName string) string {
+	return fmt.Sprintf("%v-%v", WorkflowIDPrefix, domainName)
+}
@@ -76,6 +76,7 @@ type (
 		GetDomain(name string) (*DomainCacheEntry, error)
 		GetDomainByID(id string) (*DomainCacheEntry, error)
 		GetDomainID(name string) (string, error)
+		GetDomainName(id string) (string, error)
 		GetAllDomain() map[string]*DomainCacheEntry
 		GetCacheSize() (sizeOfCacheByName int64, sizeOfCacheByID int64)
 	}
@@ -277,6 +278,15 @@ func (c *domainCache) GetDomainID(name string) (string, error) {
 	return entry.info.ID, nil
 }
 
+// GetDomainName retrieves domain name by using GetDomainByID
+func (c *domainCache) GetDomainName(id string) (string, error) {
+	entry, err := c.GetDomainByID(id)
+	if err != nil {
+		return "", err
+	}
+	return entry.info.Name, nil
+}
+
 func (c *domainCache) refreshLoop() {
 	timer := time.NewTimer(DomainCacheRefreshInterval)
 	defer timer.Stop()
@@ -557,11 +567,20 @@ func (entry *DomainCacheEntry) duplicate() *DomainCacheEntry {
 		ArchivalStatus: entry.config.ArchivalStatus,
 	}
 	result.replicationConfig = &persistence.DomainReplicationConfig{
-		ActiveClusterName: entry.replicationConfig.ActiveClusterName,
+		ActiveClusterName:        entry.replicationConfig.ActiveClusterName,
+		PendingActiveClusterName: entry.replicationConfig.PendingActiveClusterName,
+		FailoverStartTime:        entry.replicationConfig.FailoverStartTime,
+		FailoverExpireTime:       entry.replicationConfig.FailoverExpireTime,
 	}
 	for _, cluster := range entry.replicationConfig.Clusters {
 		result.replicationConfig.Clusters = append(result.replicationConfig.Clusters, &*cluster)
 	}
+	if entry.replicationConfig.FailoverMarkers != nil {
+		result.replicationConfig.FailoverMarkers = make(map[int32]int64, len(entry.replicationConfig.FailoverMarkers))
+		for shardID, taskID := range entry.replicationConfig.FailoverMarkers {
+			result.replicationConfig.FailoverMarkers[shardID] = taskID
+		}
+	}
 	result.configVersion = entry.configVersion
 	result.failoverVersion = entry.failoverVersion
 	result.isGlobalDomain = entry.isGlobalDomain
@@ -624,6 +643,12 @@ func (entry *DomainCacheEntry) IsDomainActive() bool {
 	return entry.clusterMetadata.GetCurrentClusterName() == entry.replicationConfig.ActiveClusterName
 }
 
+// IsDomainFailoverInProgress return whether a graceful failover of the domain is in progress, during which the
+// active cluster stops accepting new workflows and decisions for the domain
+func (entry *DomainCacheEntry) IsDomainFailoverInProgress() bool {
+	return entry.isGlobalDomain && len(entry.replicationConfig.PendingActiveClusterName) != 0
+}
+
 // CanReplicateEvent return whether the workflows within this domain should be replicated
 func (entry *DomainCacheEntry) CanReplicateEvent() bool {
 	// frontend guarantee that the clusters always contains the active domain, so if the # of clusters is 1
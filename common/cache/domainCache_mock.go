@@ -131,6 +131,27 @@ func (_m *DomainCacheMock) GetDomainID(name string) (string, error) {
 	return r0, r1
 }
 
+// GetDomainName provides a mock function with given fields: id
+func (_m *DomainCacheMock) GetDomainName(id string) (string, error) {
+	ret := _m.Called(id)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RegisterDomainChangeCallback provides a mock function with given fields: shard, initialNotificationVersion, prepareCallbackFn, callback
 func (_m *DomainCacheMock) RegisterDomainChangeCallback(shard int, initialNotificationVersion int64,
 	prepareCallbackFn PrepareCallbackFn, callback CallbackFn) {
@@ -22,7 +22,10 @@ package cluster
 
 import (
 	"fmt"
+	"reflect"
+	"sync"
 
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 )
@@ -49,6 +52,16 @@ type (
 		ClusterNameForFailoverVersion(failoverVersion int64) string
 		// GetAllClientAddress return the frontend address for each cluster name
 		GetAllClientAddress() map[string]config.Address
+		// ValidateClusters checks whether the clusters persisted in the metadata store can be
+		// applied on top of the static cluster config
+		ValidateClusters(clusters []*persistence.ClusterMetadataInfo) error
+		// UpdateClusters applies the clusters persisted in the metadata store on top of the static
+		// cluster config, so remote clusters can be added, updated or disabled at runtime
+		UpdateClusters(clusters []*persistence.ClusterMetadataInfo) error
+		// RegisterClusterChangeCallback registers a callback which is invoked after the clusters change
+		RegisterClusterChangeCallback(callerID string, callback func())
+		// UnregisterClusterChangeCallback removes the callback registered by the caller
+		UnregisterClusterChangeCallback(callerID string)
 
 		// IsArchivalEnabled whether archival is enabled
 		IsArchivalEnabled() bool
@@ -57,6 +70,7 @@ type (
 	}
 
 	metadataImpl struct {
+		sync.RWMutex
 		// EnableGlobalDomain whether the global domain is enabled,
 		// this attr should be discarded when cross DC is made public
 		enableGlobalDomain dynamicconfig.BoolPropertyFn
@@ -73,6 +87,12 @@ type (
 		initialFailoverVersionClusters map[int64]string
 		// clusterToAddress contains the cluster name to corresponding frontend client
 		clusterToAddress map[string]config.Address
+		// staticClusterInitialFailoverVersions contains the clusters from the static config
+		staticClusterInitialFailoverVersions map[string]int64
+		// staticClusterToAddress contains the cluster addresses from the static config
+		staticClusterToAddress map[string]config.Address
+		// changeCallbacks contains the callbacks invoked after the clusters change
+		changeCallbacks map[string]func()
 
 		// enableArchival whether archival is enabled
 		enableArchival dynamicconfig.BoolPropertyFn
@@ -136,15 +156,18 @@ func NewMetadata(
 	}
 
 	return &metadataImpl{
-		enableGlobalDomain:             enableGlobalDomain,
-		failoverVersionIncrement:       failoverVersionIncrement,
-		masterClusterName:              masterClusterName,
-		currentClusterName:             currentClusterName,
-		clusterInitialFailoverVersions: clusterInitialFailoverVersions,
-		initialFailoverVersionClusters: initialFailoverVersionClusters,
-		clusterToAddress:               clusterToAddress,
-		enableArchival:                 enableArchival,
-		defaultArchivalBucket:          defaultArchivalBucket,
+		enableGlobalDomain:                   enableGlobalDomain,
+		failoverVersionIncrement:             failoverVersionIncrement,
+		masterClusterName:                    masterClusterName,
+		currentClusterName:                   currentClusterName,
+		clusterInitialFailoverVersions:       clusterInitialFailoverVersions,
+		initialFailoverVersionClusters:       initialFailoverVersionClusters,
+		clusterToAddress:                     clusterToAddress,
+		staticClusterInitialFailoverVersions: clusterInitialFailoverVersions,
+		staticClusterToAddress:               clusterToAddress,
+		changeCallbacks:                      make(map[string]func()),
+		enableArchival:                       enableArchival,
+		defaultArchivalBucket:                defaultArchivalBucket,
 	}
 }
 
@@ -156,6 +179,9 @@ func (metadata *metadataImpl) IsGlobalDomainEnabled() bool {
 
 // GetNextFailoverVersion return the next failover version based on input
 func (metadata *metadataImpl) GetNextFailoverVersion(cluster string, currentFailoverVersion int64) int64 {
+	metadata.RLock()
+	defer metadata.RUnlock()
+
 	initialFailoverVersion, ok := metadata.clusterInitialFailoverVersions[cluster]
 	if !ok {
 		panic(fmt.Sprintf(
@@ -192,11 +218,17 @@ func (metadata *metadataImpl) GetCurrentClusterName() string {
 
 // GetAllClusterFailoverVersions return the all cluster name -> corresponding initial failover version
 func (metadata *metadataImpl) GetAllClusterFailoverVersions() map[string]int64 {
+	metadata.RLock()
+	defer metadata.RUnlock()
+
 	return metadata.clusterInitialFailoverVersions
 }
 
 // ClusterNameForFailoverVersion return the corresponding cluster name for a given failover version
 func (metadata *metadataImpl) ClusterNameForFailoverVersion(failoverVersion int64) string {
+	metadata.RLock()
+	defer metadata.RUnlock()
+
 	initialFailoverVersion := failoverVersion % metadata.failoverVersionIncrement
 	clusterName, ok := metadata.initialFailoverVersionClusters[initialFailoverVersion]
 	if !ok {
@@ -212,9 +244,132 @@ func (metadata *metadataImpl) ClusterNameForFailoverVersion(failoverVersion int6
 
 // GetAllClientAddress return the frontend address for each cluster name
 func (metadata *metadataImpl) GetAllClientAddress() map[string]config.Address {
+	metadata.RLock()
+	defer metadata.RUnlock()
+
 	return metadata.clusterToAddress
 }
 
+// ValidateClusters checks whether the clusters persisted in the metadata store can be
+// applied on top of the static cluster config
+func (metadata *metadataImpl) ValidateClusters(clusters []*persistence.ClusterMetadataInfo) error {
+	metadata.RLock()
+	defer metadata.RUnlock()
+
+	_, _, _, err := metadata.buildClusters(clusters)
+	return err
+}
+
+// UpdateClusters applies the clusters persisted in the metadata store on top of the static
+// cluster config, so remote clusters can be added, updated or disabled at runtime
+func (metadata *metadataImpl) UpdateClusters(clusters []*persistence.ClusterMetadataInfo) error {
+	metadata.Lock()
+	clusterInitialFailoverVersions, initialFailoverVersionClusters, clusterToAddress, err := metadata.buildClusters(clusters)
+	if err != nil {
+		metadata.Unlock()
+		return err
+	}
+	changed := !reflect.DeepEqual(clusterInitialFailoverVersions, metadata.clusterInitialFailoverVersions) ||
+		!reflect.DeepEqual(clusterToAddress, metadata.clusterToAddress)
+	// the maps are replaced rather than modified, since they are handed out to callers without copying
+	metadata.clusterInitialFailoverVersions = clusterInitialFailoverVersions
+	metadata.initialFailoverVersionClusters = initialFailoverVersionClusters
+	metadata.clusterToAddress = clusterToAddress
+	callbacks := make([]func(), 0, len(metadata.changeCallbacks))
+	for _, callback := range metadata.changeCallbacks {
+		callbacks = append(callbacks, callback)
+	}
+	metadata.Unlock()
+
+	if changed {
+		for _, callback := range callbacks {
+			callback()
+		}
+	}
+	return nil
+}
+
+// RegisterClusterChangeCallback registers a callback which is invoked after the clusters change
+func (metadata *metadataImpl) RegisterClusterChangeCallback(callerID string, callback func()) {
+	metadata.Lock()
+	defer metadata.Unlock()
+
+	metadata.changeCallbacks[callerID] = callback
+}
+
+// UnregisterClusterChangeCallback removes the callback registered by the caller
+func (metadata *metadataImpl) UnregisterClusterChangeCallback(callerID string) {
+	metadata.Lock()
+	defer metadata.Unlock()
+
+	delete(metadata.changeCallbacks, callerID)
+}
+
+// buildClusters merges the persisted clusters into the static cluster config, caller must hold the lock
+func (metadata *metadataImpl) buildClusters(clusters []*persistence.ClusterMetadataInfo) (
+	map[string]int64, map[int64]string, map[string]config.Address, error) {
+
+	clusterInitialFailoverVersions := make(map[string]int64)
+	clusterToAddress := make(map[string]config.Address)
+	for clusterName, initialFailoverVersion := range metadata.staticClusterInitialFailoverVersions {
+		clusterInitialFailoverVersions[clusterName] = initialFailoverVersion
+		clusterToAddress[clusterName] = metadata.staticClusterToAddress[clusterName]
+	}
+	// disabled clusters are kept here, so failover versions they have used can still be resolved
+	initialFailoverVersionClusters := make(map[int64]string)
+	for initialFailoverVersion, clusterName := range metadata.initialFailoverVersionClusters {
+		initialFailoverVersionClusters[initialFailoverVersion] = clusterName
+	}
+
+	for _, info := range clusters {
+		if len(info.ClusterName) == 0 {
+			return nil, nil, nil, fmt.Errorf("cluster name is empty")
+		}
+		if info.InitialFailoverVersion < 0 || info.InitialFailoverVersion >= metadata.failoverVersionIncrement {
+			return nil, nil, nil, fmt.Errorf(
+				"initial failover version %v of cluster %v is not within [0, %v)",
+				info.InitialFailoverVersion,
+				info.ClusterName,
+				metadata.failoverVersionIncrement,
+			)
+		}
+		if clusterName, ok := initialFailoverVersionClusters[info.InitialFailoverVersion]; ok && clusterName != info.ClusterName {
+			return nil, nil, nil, fmt.Errorf(
+				"initial failover version %v of cluster %v is already used by cluster %v",
+				info.InitialFailoverVersion,
+				info.ClusterName,
+				clusterName,
+			)
+		}
+		for initialFailoverVersion, clusterName := range initialFailoverVersionClusters {
+			if clusterName == info.ClusterName && initialFailoverVersion != info.InitialFailoverVersion {
+				return nil, nil, nil, fmt.Errorf(
+					"initial failover version of cluster %v cannot be changed from %v to %v",
+					info.ClusterName,
+					initialFailoverVersion,
+					info.InitialFailoverVersion,
+				)
+			}
+		}
+		initialFailoverVersionClusters[info.InitialFailoverVersion] = info.ClusterName
+
+		if !info.Enabled {
+			if info.ClusterName == metadata.currentClusterName || info.ClusterName == metadata.masterClusterName {
+				return nil, nil, nil, fmt.Errorf("cluster %v is the current or master cluster and cannot be disabled", info.ClusterName)
+			}
+			delete(clusterInitialFailoverVersions, info.ClusterName)
+			delete(clusterToAddress, info.ClusterName)
+			continue
+		}
+		if len(info.RPCName) == 0 || len(info.RPCAddress) == 0 {
+			return nil, nil, nil, fmt.Errorf("rpc name or rpc address of cluster %v is empty", info.ClusterName)
+		}
+		clusterInitialFailoverVersions[info.ClusterName] = info.InitialFailoverVersion
+		clusterToAddress[info.ClusterName] = config.Address{RPCName: info.RPCName, RPCAddress: info.RPCAddress}
+	}
+	return clusterInitialFailoverVersions, initialFailoverVersionClusters, clusterToAddress, nil
+}
+
 // IsArchivalEnabled whether archival is enabled
 func (metadata *metadataImpl) IsArchivalEnabled() bool {
 	return metadata.enableArchival()
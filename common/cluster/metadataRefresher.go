@@ -0,0 +1,114 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cluster
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// metadataRefresher periodically loads the clusters persisted in the metadata store
+	// and applies them to the cluster metadata
+	metadataRefresher struct {
+		status          int32
+		metadata        Metadata
+		metadataMgr     persistence.MetadataManager
+		refreshInterval dynamicconfig.DurationPropertyFn
+		logger          bark.Logger
+		shutdownCh      chan struct{}
+		shutdownWG      sync.WaitGroup
+	}
+)
+
+// NewMetadataRefresher creates a daemon which keeps the cluster metadata in sync with the metadata store
+func NewMetadataRefresher(metadata Metadata, metadataMgr persistence.MetadataManager,
+	refreshInterval dynamicconfig.DurationPropertyFn, logger bark.Logger) common.Daemon {
+	return &metadataRefresher{
+		status:          common.DaemonStatusInitialized,
+		metadata:        metadata,
+		metadataMgr:     metadataMgr,
+		refreshInterval: refreshInterval,
+		logger: logger.WithFields(bark.Fields{
+			logging.TagWorkflowComponent: logging.TagValueClusterMetadataRefresherComponent,
+		}),
+		shutdownCh: make(chan struct{}),
+	}
+}
+
+func (r *metadataRefresher) Start() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	// load the persisted clusters before the dependent components are started
+	if err := r.refresh(); err != nil {
+		r.logger.WithField(logging.TagErr, err).Warn("Failed to refresh cluster metadata.")
+	}
+	r.shutdownWG.Add(1)
+	go r.refreshLoop()
+	r.logger.Info("Cluster metadata refresher started.")
+}
+
+func (r *metadataRefresher) Stop() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(r.shutdownCh)
+	if success := common.AwaitWaitGroup(&r.shutdownWG, time.Minute); !success {
+		r.logger.Warn("Cluster metadata refresher timed out on shutdown.")
+	}
+	r.logger.Info("Cluster metadata refresher stopped.")
+}
+
+func (r *metadataRefresher) refreshLoop() {
+	defer r.shutdownWG.Done()
+
+	timer := time.NewTimer(r.refreshInterval())
+	defer timer.Stop()
+	for {
+		select {
+		case <-r.shutdownCh:
+			return
+		case <-timer.C:
+			if err := r.refresh(); err != nil {
+				r.logger.WithField(logging.TagErr, err).Warn("Failed to refresh cluster metadata.")
+			}
+			timer.Reset(r.refreshInterval())
+		}
+	}
+}
+
+func (r *metadataRefresher) refresh() error {
+	resp, err := r.metadataMgr.ListClusterMetadata()
+	if err != nil {
+		return err
+	}
+	return r.metadata.UpdateClusters(resp.Clusters)
+}
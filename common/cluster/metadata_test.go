@@ -0,0 +1,112 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	metadataSuite struct {
+		suite.Suite
+
+		metadata Metadata
+	}
+)
+
+func TestMetadataSuite(t *testing.T) {
+	s := new(metadataSuite)
+	suite.Run(t, s)
+}
+
+func (s *metadataSuite) SetupTest() {
+	s.metadata = GetTestClusterMetadata(true, true)
+}
+
+func (s *metadataSuite) TestUpdateClusters_AddCluster() {
+	callbackCount := 0
+	s.metadata.RegisterClusterChangeCallback("test", func() { callbackCount++ })
+
+	clusters := []*persistence.ClusterMetadataInfo{
+		{
+			ClusterName:            "third",
+			InitialFailoverVersion: 2,
+			RPCName:                common.FrontendServiceName,
+			RPCAddress:             "127.0.0.1:9104",
+			Enabled:                true,
+		},
+	}
+	s.NoError(s.metadata.UpdateClusters(clusters))
+	s.Equal(1, callbackCount)
+	s.Equal(int64(2), s.metadata.GetAllClusterFailoverVersions()["third"])
+	s.Equal(config.Address{RPCName: common.FrontendServiceName, RPCAddress: "127.0.0.1:9104"},
+		s.metadata.GetAllClientAddress()["third"])
+	s.Equal("third", s.metadata.ClusterNameForFailoverVersion(12))
+	s.Len(s.metadata.GetAllClusterFailoverVersions(), 3)
+
+	// applying the same clusters again is not a change
+	s.NoError(s.metadata.UpdateClusters(clusters))
+	s.Equal(1, callbackCount)
+}
+
+func (s *metadataSuite) TestUpdateClusters_DisableCluster() {
+	s.NoError(s.metadata.UpdateClusters([]*persistence.ClusterMetadataInfo{
+		{
+			ClusterName:            TestAlternativeClusterName,
+			InitialFailoverVersion: TestAlternativeClusterInitialFailoverVersion,
+			Enabled:                false,
+		},
+	}))
+	_, ok := s.metadata.GetAllClusterFailoverVersions()[TestAlternativeClusterName]
+	s.False(ok)
+	_, ok = s.metadata.GetAllClientAddress()[TestAlternativeClusterName]
+	s.False(ok)
+	// versions used by a disabled cluster can still be resolved
+	s.Equal(TestAlternativeClusterName, s.metadata.ClusterNameForFailoverVersion(TestAlternativeClusterInitialFailoverVersion))
+
+	// removing the persisted entry restores the static config
+	s.NoError(s.metadata.UpdateClusters(nil))
+	s.Equal(TestAllClusterFailoverVersions, s.metadata.GetAllClusterFailoverVersions())
+}
+
+func (s *metadataSuite) TestValidateClusters() {
+	testCases := []*persistence.ClusterMetadataInfo{
+		// duplicate initial failover version
+		{ClusterName: "third", InitialFailoverVersion: TestAlternativeClusterInitialFailoverVersion, RPCName: "name", RPCAddress: "address", Enabled: true},
+		// initial failover version out of range
+		{ClusterName: "third", InitialFailoverVersion: TestFailoverVersionIncrement, RPCName: "name", RPCAddress: "address", Enabled: true},
+		// initial failover version changed
+		{ClusterName: TestAlternativeClusterName, InitialFailoverVersion: 5, RPCName: "name", RPCAddress: "address", Enabled: true},
+		// current cluster disabled
+		{ClusterName: TestCurrentClusterName, InitialFailoverVersion: TestCurrentClusterInitialFailoverVersion, Enabled: false},
+		// address missing
+		{ClusterName: "third", InitialFailoverVersion: 2, Enabled: true},
+	}
+	for _, info := range testCases {
+		s.Error(s.metadata.ValidateClusters([]*persistence.ClusterMetadataInfo{info}))
+	}
+	s.Equal(TestAllClusterFailoverVersions, s.metadata.GetAllClusterFailoverVersions())
+}
@@ -67,6 +67,13 @@ type (
 // MaxTaskTimeout is maximum task timeout allowed. 366 days in seconds
 const MaxTaskTimeout = 31622400
 
+const (
+	// MinTaskPriority is the lowest priority of a task, and the default one
+	MinTaskPriority = 0
+	// MaxTaskPriority is the highest priority of a task
+	MaxTaskPriority = 4
+)
+
 const (
 	// GetHistoryWarnSizeLimit is the threshold for emitting warn log
 	GetHistoryWarnSizeLimit = 500 * 1024 // Warn when size goes over 500KB
@@ -78,3 +85,9 @@ const (
 	// VisibilityAppName is used to find kafka topics and ES indexName for visibility
 	VisibilityAppName = "visibility"
 )
+
+const (
+	// ReservedTaskListPrefix is the prefix of task list names reserved for cadence internal use,
+	// e.g. the names of task list partitions
+	ReservedTaskListPrefix = "/__cadence_sys/"
+)
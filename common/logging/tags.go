@@ -36,6 +36,7 @@ const (
 	TagHistoryBuilderAction       = "history-builder-action"
 	TagStoreOperation             = "store-operation"
 	TagDomainID                   = "domain-id"
+	TagDomainName                 = "domain-name"
 	TagWorkflowExecutionID        = "execution-id"
 	TagWorkflowRunID              = "run-id"
 	TagHistoryShardID             = "shard-id"
@@ -49,6 +50,7 @@ const (
 	TagTaskType                   = "task-type"
 	TagSourceCluster              = "source-cluster"
 	TagPrevActiveCluster          = "prev-active-cluster"
+	TagPollingCluster             = "polling-cluster"
 	TagTopicName                  = "topic-name"
 	TagConsumerName               = "consumer-name"
 	TagPartition                  = "partition"
@@ -96,6 +98,11 @@ const (
 	TagValueReplicatorComponent               = "replicator"
 	TagValueReplicationTaskProcessorComponent = "replication-task-processor"
 	TagValueHistoryReplicatorComponent        = "history-replicator"
+	TagValueReplicationTaskPullerComponent    = "replication-task-puller"
+	TagValueBootstrapComponent                = "bootstrap"
+	TagValueTaskListScavengerComponent        = "tasklist-scavenger"
+	TagValueClusterMetadataRefresherComponent = "cluster-metadata-refresher"
+	TagValueDomainFailoverDrainerComponent    = "domain-failover-drainer"
 	TagValueIndexerComponent                  = "indexer"
 	TagValueIndexerProcessorComponent         = "indexer-processor"
 	TagValueIndexerESProcessorComponent       = "indexer-es-processor"
@@ -149,12 +156,16 @@ const (
 	TagValueStoreOperationDeleteWorkflowExecution = "delete-wf-execution"
 	TagValueStoreOperationUpdateShard             = "update-shard"
 	TagValueStoreOperationCreateTask              = "create-task"
+	TagValueStoreOperationGetTaskList             = "get-task-list"
 	TagValueStoreOperationUpdateTaskList          = "update-task-list"
 	TagValueStoreOperationStopTaskList            = "stop-task-list"
 
 	// task list tags
 	TagTaskListType = "task-list-type"
 	TagTaskListName = "task-list-name"
+	TagBacklogAge   = "backlog-age"
+	TagBacklogSize  = "backlog-size"
+	TagLastPollTime = "last-poll-time"
 
 	// persistence tags
 	TagTreeID   = "tree-id"
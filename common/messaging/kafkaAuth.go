@@ -0,0 +1,147 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/Shopify/sarama"
+	"github.com/xdg/scram"
+)
+
+// SASL mechanisms supported to authenticate against a Kafka cluster
+const (
+	SASLMechanismPlain       = "PLAIN"
+	SASLMechanismSCRAMSHA256 = "SCRAM-SHA-256"
+	SASLMechanismSCRAMSHA512 = "SCRAM-SHA-512"
+)
+
+type (
+	// scramClient implements sarama.SCRAMClient on top of xdg/scram
+	scramClient struct {
+		hashGenerator scram.HashGeneratorFcn
+		conversation  *scram.ClientConversation
+	}
+)
+
+// NewTLSConfig creates the TLS config used to connect to the Kafka cluster, or nil if TLS is disabled
+func (c *ClusterConfig) NewTLSConfig() (*tls.Config, error) {
+	if !c.TLS.Enabled {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.TLS.InsecureSkipVerify,
+	}
+	if c.TLS.CaFile != "" {
+		caPEM, err := ioutil.ReadFile(c.TLS.CaFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read kafka ca file %v: %v", c.TLS.CaFile, err)
+		}
+		caPool := x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificate found in kafka ca file %v", c.TLS.CaFile)
+		}
+		tlsConfig.RootCAs = caPool
+	}
+	if c.TLS.CertFile != "" || c.TLS.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.TLS.CertFile, c.TLS.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load kafka client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// ApplyToSaramaConfig sets the TLS and SASL settings of the Kafka cluster on a sarama config
+func (c *ClusterConfig) ApplyToSaramaConfig(config *sarama.Config) error {
+	tlsConfig, err := c.NewTLSConfig()
+	if err != nil {
+		return err
+	}
+	if tlsConfig != nil {
+		config.Net.TLS.Enable = true
+		config.Net.TLS.Config = tlsConfig
+	}
+
+	if !c.SASL.Enabled {
+		return nil
+	}
+	config.Net.SASL.Enable = true
+	config.Net.SASL.Handshake = true
+	config.Net.SASL.User = c.SASL.User
+	config.Net.SASL.Password = c.SASL.Password
+	switch c.SASL.Mechanism {
+	case "", SASLMechanismPlain:
+		config.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+	case SASLMechanismSCRAMSHA256:
+		config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+		config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+			return &scramClient{hashGenerator: scram.SHA256}
+		}
+	case SASLMechanismSCRAMSHA512:
+		config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+		config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+			return &scramClient{hashGenerator: scram.SHA512}
+		}
+	default:
+		return fmt.Errorf("unknown sasl mechanism %v", c.SASL.Mechanism)
+	}
+	// SCRAM handshake requires at least kafka 0.10.2
+	if !config.Version.IsAtLeast(sarama.V0_10_2_0) {
+		config.Version = sarama.V0_10_2_0
+	}
+	return nil
+}
+
+// NewSaramaConfig creates a sarama config with the TLS and SASL settings of the Kafka cluster
+func (c *ClusterConfig) NewSaramaConfig() (*sarama.Config, error) {
+	config := sarama.NewConfig()
+	if err := c.ApplyToSaramaConfig(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func (s *scramClient) Begin(userName, password, authzID string) error {
+	client, err := s.hashGenerator.NewClient(userName, password, authzID)
+	if err != nil {
+		return err
+	}
+	s.conversation = client.NewConversation()
+	return nil
+}
+
+func (s *scramClient) Step(challenge string) (string, error) {
+	if s.conversation == nil {
+		return "", errors.New("scram conversation is not started")
+	}
+	return s.conversation.Step(challenge)
+}
+
+func (s *scramClient) Done() bool {
+	return s.conversation != nil && s.conversation.Done()
+}
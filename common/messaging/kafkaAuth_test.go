@@ -0,0 +1,97 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	kafkaAuthSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestKafkaAuthSuite(t *testing.T) {
+	suite.Run(t, new(kafkaAuthSuite))
+}
+
+func (s *kafkaAuthSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *kafkaAuthSuite) TestValidate() {
+	s.NoError((&ClusterConfig{}).validate())
+	s.NoError((&ClusterConfig{TLS: TLSConfig{Enabled: true, CertFile: "cert.pem", KeyFile: "key.pem"}}).validate())
+	s.Error((&ClusterConfig{TLS: TLSConfig{Enabled: true, CertFile: "cert.pem"}}).validate())
+	s.NoError((&ClusterConfig{SASL: SASLConfig{Enabled: true, User: "cadence"}}).validate())
+	s.Error((&ClusterConfig{SASL: SASLConfig{Enabled: true}}).validate())
+	s.Error((&ClusterConfig{SASL: SASLConfig{Enabled: true, Mechanism: "GSSAPI", User: "cadence"}}).validate())
+}
+
+func (s *kafkaAuthSuite) TestNewSaramaConfig_Plaintext() {
+	config, err := (&ClusterConfig{}).NewSaramaConfig()
+	s.NoError(err)
+	s.False(config.Net.TLS.Enable)
+	s.False(config.Net.SASL.Enable)
+}
+
+func (s *kafkaAuthSuite) TestNewSaramaConfig_TLS() {
+	config, err := (&ClusterConfig{TLS: TLSConfig{Enabled: true, InsecureSkipVerify: true}}).NewSaramaConfig()
+	s.NoError(err)
+	s.True(config.Net.TLS.Enable)
+	s.True(config.Net.TLS.Config.InsecureSkipVerify)
+
+	_, err = (&ClusterConfig{TLS: TLSConfig{Enabled: true, CaFile: "/non/existing/ca.pem"}}).NewSaramaConfig()
+	s.Error(err)
+}
+
+func (s *kafkaAuthSuite) TestNewSaramaConfig_SASL() {
+	config, err := (&ClusterConfig{SASL: SASLConfig{Enabled: true, User: "cadence", Password: "secret"}}).NewSaramaConfig()
+	s.NoError(err)
+	s.True(config.Net.SASL.Enable)
+	s.Equal(sarama.SASLMechanism(sarama.SASLTypePlaintext), config.Net.SASL.Mechanism)
+	s.Equal("cadence", config.Net.SASL.User)
+	s.Equal("secret", config.Net.SASL.Password)
+
+	config, err = (&ClusterConfig{SASL: SASLConfig{
+		Enabled:   true,
+		Mechanism: SASLMechanismSCRAMSHA512,
+		User:      "cadence",
+		Password:  "secret",
+	}}).NewSaramaConfig()
+	s.NoError(err)
+	s.Equal(sarama.SASLMechanism(sarama.SASLTypeSCRAMSHA512), config.Net.SASL.Mechanism)
+	s.True(config.Version.IsAtLeast(sarama.V0_10_2_0))
+	s.NoError(config.Validate())
+
+	client := config.Net.SASL.SCRAMClientGeneratorFunc()
+	s.False(client.Done())
+	s.NoError(client.Begin("cadence", "secret", ""))
+	firstMessage, err := client.Step("")
+	s.NoError(err)
+	s.Contains(firstMessage, "n=cadence")
+}
@@ -21,9 +21,13 @@
 package messaging
 
 import (
+	"crypto/tls"
+	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/Shopify/sarama"
+	"github.com/bsm/sarama-cluster"
 	"github.com/uber-common/bark"
 	uberKafkaClient "github.com/uber-go/kafka-client"
 	uberKafka "github.com/uber-go/kafka-client/kafka"
@@ -36,6 +40,7 @@ type (
 	// This is a default implementation of Client interface which makes use of uber-go/kafka-client as consumer
 	kafkaClient struct {
 		config        *KafkaConfig
+		tlsConfigs    map[string]*tls.Config
 		client        uberKafkaClient.Client
 		metricsClient metrics.Client
 		logger        bark.Logger
@@ -46,12 +51,19 @@ var _ Client = (*kafkaClient)(nil)
 
 // NewKafkaClient is used to create an instance of KafkaClient
 func NewKafkaClient(kc *KafkaConfig, metricsClient metrics.Client, zLogger *zap.Logger, logger bark.Logger, metricScope tally.Scope,
-	checkCluster, checkApp bool) Client {
+	checkCluster, checkApp bool) (Client, error) {
 	kc.Validate(checkCluster, checkApp)
 
 	// mapping from cluster name to list of broker ip addresses
 	brokers := map[string][]string{}
+	// mapping from cluster name to the TLS config used to connect to it, nil if TLS is disabled
+	tlsConfigs := map[string]*tls.Config{}
 	for cluster, cfg := range kc.Clusters {
+		tlsConfig, err := cfg.NewTLSConfig()
+		if err != nil {
+			return nil, fmt.Errorf("invalid kafka tls config for cluster %v: %v", cluster, err)
+		}
+		tlsConfigs[cluster] = tlsConfig
 		brokers[cluster] = cfg.Brokers
 		for i := range brokers[cluster] {
 			if !strings.Contains(cfg.Brokers[i], ":") {
@@ -70,10 +82,11 @@ func NewKafkaClient(kc *KafkaConfig, metricsClient metrics.Client, zLogger *zap.
 
 	return &kafkaClient{
 		config:        kc,
+		tlsConfigs:    tlsConfigs,
 		client:        client,
 		metricsClient: metricsClient,
 		logger:        logger,
-	}
+	}, nil
 }
 
 // NewConsumer is used to create a Kafka consumer
@@ -94,15 +107,7 @@ func (c *kafkaClient) NewConsumer(app, consumerName string, concurrency int) (Co
 		},
 	}
 
-	consumerConfig := uberKafka.NewConsumerConfig(consumerName, topicList)
-	consumerConfig.Concurrency = concurrency
-	consumerConfig.Offsets.Initial.Offset = uberKafka.OffsetOldest
-
-	uConsumer, err := c.client.NewConsumer(consumerConfig)
-	if err != nil {
-		return nil, err
-	}
-	return newKafkaConsumer(uConsumer, c.logger), nil
+	return c.newConsumer(consumerName, topicList, concurrency)
 }
 
 // NewConsumerWithClusterName is used to create a Kafka consumer for consuming replication tasks
@@ -126,9 +131,33 @@ func (c *kafkaClient) NewConsumerWithClusterName(currentCluster, sourceCluster,
 		},
 	}
 
+	return c.newConsumer(consumerName, topicList, concurrency)
+}
+
+func (c *kafkaClient) newConsumer(consumerName string, topicList uberKafka.ConsumerTopicList, concurrency int) (Consumer, error) {
+	for _, topic := range topicList {
+		for _, kafkaCluster := range []string{topic.Topic.Cluster, topic.DLQ.Cluster} {
+			if c.config.Clusters[kafkaCluster].SASL.Enabled {
+				return c.newSaramaConsumer(consumerName, topicList)
+			}
+		}
+	}
+
+	// the consumer library applies a single TLS config to all the kafka clusters it connects to
+	firstCluster := topicList[0].Topic.Cluster
+	for _, topic := range topicList {
+		for _, kafkaCluster := range []string{topic.Topic.Cluster, topic.DLQ.Cluster} {
+			if !reflect.DeepEqual(c.config.Clusters[kafkaCluster].TLS, c.config.Clusters[firstCluster].TLS) {
+				return nil, fmt.Errorf("kafka clusters %v and %v consumed by %v have different tls configs",
+					firstCluster, kafkaCluster, consumerName)
+			}
+		}
+	}
+
 	consumerConfig := uberKafka.NewConsumerConfig(consumerName, topicList)
 	consumerConfig.Concurrency = concurrency
 	consumerConfig.Offsets.Initial.Offset = uberKafka.OffsetOldest
+	consumerConfig.TLSConfig = c.tlsConfigs[firstCluster]
 
 	uConsumer, err := c.client.NewConsumer(consumerConfig)
 	if err != nil {
@@ -137,13 +166,51 @@ func (c *kafkaClient) NewConsumerWithClusterName(currentCluster, sourceCluster,
 	return newKafkaConsumer(uConsumer, c.logger), nil
 }
 
+// newSaramaConsumer creates a consumer which authenticates with the TLS and SASL settings of each kafka cluster,
+// the nacked messages are published to the DLQ topic directly
+func (c *kafkaClient) newSaramaConsumer(consumerName string, topicList uberKafka.ConsumerTopicList) (Consumer, error) {
+	if len(topicList) != 1 {
+		return nil, fmt.Errorf("sasl kafka consumer %v supports a single topic, got %v", consumerName, len(topicList))
+	}
+	topic := topicList[0]
+
+	config, err := c.newSaramaClusterConfig(topic.Topic.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	dlqProducer, err := c.newSyncProducer(topic.DLQ.Name)
+	if err != nil {
+		return nil, err
+	}
+	consumer, err := cluster.NewConsumer(
+		c.config.getBrokersForKafkaCluster(topic.Topic.Cluster),
+		consumerName,
+		[]string{topic.Topic.Name},
+		config,
+	)
+	if err != nil {
+		dlqProducer.Close()
+		return nil, err
+	}
+	return newSaramaConsumer(topic.Topic.Name, topic.DLQ.Name, consumer, dlqProducer, c.logger), nil
+}
+
+func (c *kafkaClient) newSaramaClusterConfig(kafkaCluster string) (*cluster.Config, error) {
+	clusterConfig := c.config.Clusters[kafkaCluster]
+
+	config := cluster.NewConfig()
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	config.Consumer.Return.Errors = true
+	if err := clusterConfig.ApplyToSaramaConfig(&config.Config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
 // NewProducer is used to create a Kafka producer
 func (c *kafkaClient) NewProducer(app string) (Producer, error) {
 	topics := c.config.getTopicsForApplication(app)
-	kafkaClusterName := c.config.getKafkaClusterForTopic(topics.Topic)
-	brokers := c.config.getBrokersForKafkaCluster(kafkaClusterName)
-
-	producer, err := sarama.NewSyncProducer(brokers, nil)
+	producer, err := c.newSyncProducer(topics.Topic)
 	if err != nil {
 		return nil, err
 	}
@@ -157,13 +224,24 @@ func (c *kafkaClient) NewProducer(app string) (Producer, error) {
 // NewProducerWithClusterName is used to create a Kafka producer for shipping replication tasks
 func (c *kafkaClient) NewProducerWithClusterName(sourceCluster string) (Producer, error) {
 	topics := c.config.getTopicsForCadenceCluster(sourceCluster)
-	kafkaClusterName := c.config.getKafkaClusterForTopic(topics.Topic)
-	brokers := c.config.getBrokersForKafkaCluster(kafkaClusterName)
-
-	producer, err := sarama.NewSyncProducer(brokers, nil)
+	producer, err := c.newSyncProducer(topics.Topic)
 	if err != nil {
 		return nil, err
 	}
 
 	return NewKafkaProducer(topics.Topic, producer, c.logger), nil
 }
+
+func (c *kafkaClient) newSyncProducer(topic string) (sarama.SyncProducer, error) {
+	kafkaClusterName := c.config.getKafkaClusterForTopic(topic)
+	brokers := c.config.getBrokersForKafkaCluster(kafkaClusterName)
+	clusterConfig := c.config.Clusters[kafkaClusterName]
+
+	config := sarama.NewConfig()
+	// required by sync producer
+	config.Producer.Return.Successes = true
+	if err := clusterConfig.ApplyToSaramaConfig(config); err != nil {
+		return nil, err
+	}
+	return sarama.NewSyncProducer(brokers, config)
+}
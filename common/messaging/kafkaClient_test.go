@@ -0,0 +1,155 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+var (
+	// the sasl broker tests run against a broker which requires SASL, and TLS if a CA file is set, e.g.
+	// go test ./common/messaging -saslBroker=127.0.0.1:9093 -saslUser=cadence -saslPassword=cadence -saslCaFile=ca.pem
+	saslBroker    = flag.String("saslBroker", "", "kafka broker requiring sasl, the sasl broker tests are skipped if empty")
+	saslMechanism = flag.String("saslMechanism", SASLMechanismSCRAMSHA512, "sasl mechanism of the kafka broker")
+	saslUser      = flag.String("saslUser", "", "sasl user of the kafka broker")
+	saslPassword  = flag.String("saslPassword", "", "sasl password of the kafka broker")
+	saslCaFile    = flag.String("saslCaFile", "", "ca file of the kafka broker, tls is disabled if empty")
+)
+
+type (
+	kafkaClientSuite struct {
+		suite.Suite
+		*require.Assertions
+		logger bark.Logger
+	}
+)
+
+func TestKafkaClientSuite(t *testing.T) {
+	suite.Run(t, new(kafkaClientSuite))
+}
+
+func (s *kafkaClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.logger = bark.NewLoggerFromLogrus(logrus.New())
+}
+
+func (s *kafkaClientSuite) newKafkaConfig(clusterConfig ClusterConfig, topic string) *KafkaConfig {
+	return &KafkaConfig{
+		Clusters: map[string]ClusterConfig{"test": clusterConfig},
+		Topics: map[string]TopicConfig{
+			topic:          {Cluster: "test"},
+			topic + "-dlq": {Cluster: "test"},
+		},
+		Applications: map[string]TopicList{
+			"app": {Topic: topic, DLQTopic: topic + "-dlq"},
+		},
+	}
+}
+
+func (s *kafkaClientSuite) TestNewKafkaClient_InvalidTLSConfig() {
+	kafkaConfig := s.newKafkaConfig(ClusterConfig{
+		Brokers: []string{"127.0.0.1"},
+		TLS:     TLSConfig{Enabled: true, CaFile: "/non/existing/ca.pem"},
+	}, "topic")
+
+	client, err := NewKafkaClient(kafkaConfig, nil, zap.NewNop(), s.logger, tally.NoopScope, false, true)
+	s.Error(err)
+	s.Nil(client)
+}
+
+func (s *kafkaClientSuite) TestNewKafkaClient_DefaultPort() {
+	kafkaConfig := s.newKafkaConfig(ClusterConfig{Brokers: []string{"127.0.0.1"}}, "topic")
+
+	_, err := NewKafkaClient(kafkaConfig, nil, zap.NewNop(), s.logger, tally.NoopScope, false, true)
+	s.NoError(err)
+	s.Equal([]string{"127.0.0.1:9092"}, kafkaConfig.Clusters["test"].Brokers)
+}
+
+func (s *kafkaClientSuite) TestNewSaramaClusterConfig() {
+	kafkaConfig := s.newKafkaConfig(ClusterConfig{
+		Brokers: []string{"127.0.0.1:9093"},
+		TLS:     TLSConfig{Enabled: true, InsecureSkipVerify: true},
+		SASL:    SASLConfig{Enabled: true, Mechanism: SASLMechanismSCRAMSHA256, User: "cadence", Password: "secret"},
+	}, "topic")
+	client, err := NewKafkaClient(kafkaConfig, nil, zap.NewNop(), s.logger, tally.NoopScope, false, true)
+	s.NoError(err)
+
+	config, err := client.(*kafkaClient).newSaramaClusterConfig("test")
+	s.NoError(err)
+	s.True(config.Net.TLS.Enable)
+	s.True(config.Net.SASL.Enable)
+	s.Equal(sarama.SASLMechanism(sarama.SASLTypeSCRAMSHA256), config.Net.SASL.Mechanism)
+	s.Equal(sarama.OffsetOldest, config.Consumer.Offsets.Initial)
+	s.NoError(config.Validate())
+}
+
+func (s *kafkaClientSuite) TestSASLBroker_ProduceAndConsume() {
+	if *saslBroker == "" {
+		s.T().Skip("no sasl kafka broker configured")
+	}
+
+	topic := fmt.Sprintf("cadence-sasl-test-%v", time.Now().UnixNano())
+	kafkaConfig := s.newKafkaConfig(ClusterConfig{
+		Brokers: []string{*saslBroker},
+		TLS:     TLSConfig{Enabled: *saslCaFile != "", CaFile: *saslCaFile},
+		SASL:    SASLConfig{Enabled: true, Mechanism: *saslMechanism, User: *saslUser, Password: *saslPassword},
+	}, topic)
+	client, err := NewKafkaClient(kafkaConfig, nil, zap.NewNop(), s.logger, tally.NoopScope, false, true)
+	s.NoError(err)
+
+	producer, err := client.(*kafkaClient).newSyncProducer(topic)
+	s.NoError(err)
+	defer producer.Close()
+	for _, value := range []string{"acked", "nacked"} {
+		_, _, err = producer.SendMessage(&sarama.ProducerMessage{Topic: topic, Value: sarama.StringEncoder(value)})
+		s.NoError(err)
+	}
+
+	consumer, err := client.NewConsumer("app", topic+"-consumer", 1)
+	s.NoError(err)
+	s.NoError(consumer.Start())
+	defer consumer.Stop()
+
+	for _, value := range []string{"acked", "nacked"} {
+		select {
+		case msg := <-consumer.Messages():
+			s.Equal(value, string(msg.Value()))
+			if value == "acked" {
+				s.NoError(msg.Ack())
+			} else {
+				s.NoError(msg.Nack())
+			}
+		case <-time.After(time.Minute):
+			s.Fail("timed out waiting for message " + value)
+		}
+	}
+}
@@ -21,6 +21,7 @@
 package messaging
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -35,7 +36,29 @@ type (
 
 	// ClusterConfig describes the configuration for a single Kafka cluster
 	ClusterConfig struct {
-		Brokers []string `yaml:"brokers"`
+		Brokers []string   `yaml:"brokers"`
+		TLS     TLSConfig  `yaml:"tls"`
+		SASL    SASLConfig `yaml:"sasl"`
+	}
+
+	// TLSConfig describes the TLS configuration used to connect to a Kafka cluster
+	TLSConfig struct {
+		Enabled bool `yaml:"enabled"`
+		// CaFile is the PEM file of the CA used to verify the brokers, the system CAs are used if empty
+		CaFile string `yaml:"ca-file"`
+		// CertFile and KeyFile are the PEM files of the client certificate, both are optional
+		CertFile           string `yaml:"cert-file"`
+		KeyFile            string `yaml:"key-file"`
+		InsecureSkipVerify bool   `yaml:"insecure-skip-verify"`
+	}
+
+	// SASLConfig describes the SASL authentication used to connect to a Kafka cluster
+	SASLConfig struct {
+		Enabled bool `yaml:"enabled"`
+		// Mechanism is one of PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512, PLAIN is used if empty
+		Mechanism string `yaml:"mechanism"`
+		User      string `yaml:"user"`
+		Password  string `yaml:"password"`
 	}
 
 	// TopicConfig describes the mapping from topic to Kafka cluster
@@ -59,6 +82,11 @@ func (k *KafkaConfig) Validate(checkCluster bool, checkApp bool) {
 	if len(k.Topics) == 0 {
 		panic("Empty Topics Config")
 	}
+	for name, cluster := range k.Clusters {
+		if err := cluster.validate(); err != nil {
+			panic(fmt.Sprintf("Invalid Kafka Cluster Config for Cluster %v: %v", name, err))
+		}
+	}
 
 	validateTopicsFn := func(topic string) {
 		if topic == "" {
@@ -93,6 +121,23 @@ func (k *KafkaConfig) Validate(checkCluster bool, checkApp bool) {
 	}
 }
 
+func (c *ClusterConfig) validate() error {
+	if c.TLS.Enabled && (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		return errors.New("tls cert-file and key-file must be set together")
+	}
+	if c.SASL.Enabled {
+		switch c.SASL.Mechanism {
+		case "", SASLMechanismPlain, SASLMechanismSCRAMSHA256, SASLMechanismSCRAMSHA512:
+		default:
+			return fmt.Errorf("unknown sasl mechanism %v", c.SASL.Mechanism)
+		}
+		if c.SASL.User == "" {
+			return errors.New("sasl user is not set")
+		}
+	}
+	return nil
+}
+
 func (k *KafkaConfig) getTopicsForCadenceCluster(cadenceCluster string) TopicList {
 	return k.ClusterToTopic[cadenceCluster]
 }
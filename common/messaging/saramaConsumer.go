@@ -0,0 +1,221 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"sync"
+
+	"github.com/Shopify/sarama"
+	"github.com/bsm/sarama-cluster"
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/logging"
+)
+
+type (
+	// saramaConsumer consumes a topic with sarama-cluster directly, it is used for kafka clusters
+	// which require SASL since uber-go/kafka-client cannot authenticate with SASL
+	saramaConsumer struct {
+		topic       string
+		dlqTopic    string
+		consumer    *cluster.Consumer
+		dlqProducer sarama.SyncProducer
+		logger      bark.Logger
+		msgC        chan Message
+		doneC       chan struct{}
+
+		sync.Mutex
+		partitions map[int32]*partitionAckLevel
+	}
+
+	saramaMessage struct {
+		msg       *sarama.ConsumerMessage
+		consumer  *saramaConsumer
+		ackLevel  *partitionAckLevel
+		ackedOnce sync.Once
+	}
+
+	// partitionAckLevel tracks the outstanding messages of a partition, so that the committed offset
+	// never moves past a message which is not acked yet
+	partitionAckLevel struct {
+		sync.Mutex
+		outstanding []int64
+		acked       map[int64]struct{}
+	}
+)
+
+var _ Consumer = (*saramaConsumer)(nil)
+var _ Message = (*saramaMessage)(nil)
+
+func newSaramaConsumer(
+	topic string,
+	dlqTopic string,
+	consumer *cluster.Consumer,
+	dlqProducer sarama.SyncProducer,
+	logger bark.Logger,
+) Consumer {
+	return &saramaConsumer{
+		topic:       topic,
+		dlqTopic:    dlqTopic,
+		consumer:    consumer,
+		dlqProducer: dlqProducer,
+		logger: logger.WithFields(bark.Fields{
+			logging.TagTopicName: topic,
+		}),
+		msgC:       make(chan Message, rcvBufferSize),
+		doneC:      make(chan struct{}),
+		partitions: make(map[int32]*partitionAckLevel),
+	}
+}
+
+func (c *saramaConsumer) Start() error {
+	go func() {
+		for {
+			select {
+			case <-c.doneC:
+				close(c.msgC)
+				c.logger.Info("Stop consuming messages from channel")
+				return
+			case msg, ok := <-c.consumer.Messages():
+				if !ok {
+					continue
+				}
+				c.msgC <- &saramaMessage{
+					msg:      msg,
+					consumer: c,
+					ackLevel: c.getPartitionAckLevel(msg.Partition, msg.Offset),
+				}
+			case err, ok := <-c.consumer.Errors():
+				if ok {
+					c.logger.WithField(logging.TagErr, err).Warn("Kafka consumer error")
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops the consumer
+func (c *saramaConsumer) Stop() {
+	c.logger.Info("Stopping consumer")
+	close(c.doneC)
+	if err := c.consumer.Close(); err != nil {
+		c.logger.WithField(logging.TagErr, err).Warn("Failed to close kafka consumer")
+	}
+	if err := c.dlqProducer.Close(); err != nil {
+		c.logger.WithField(logging.TagErr, err).Warn("Failed to close kafka dlq producer")
+	}
+}
+
+// Messages return the message channel for this consumer
+func (c *saramaConsumer) Messages() <-chan Message {
+	return c.msgC
+}
+
+func (c *saramaConsumer) getPartitionAckLevel(partition int32, offset int64) *partitionAckLevel {
+	c.Lock()
+	defer c.Unlock()
+
+	ackLevel, ok := c.partitions[partition]
+	if !ok || !ackLevel.add(offset) {
+		// the partition is consumed from an earlier offset after a rebalance, messages delivered
+		// before are no longer relevant to the committed offset
+		ackLevel = newPartitionAckLevel()
+		ackLevel.add(offset)
+		c.partitions[partition] = ackLevel
+	}
+	return ackLevel
+}
+
+func (m *saramaMessage) Value() []byte {
+	return m.msg.Value
+}
+
+func (m *saramaMessage) Partition() int32 {
+	return m.msg.Partition
+}
+
+func (m *saramaMessage) Offset() int64 {
+	return m.msg.Offset
+}
+
+func (m *saramaMessage) Ack() error {
+	m.ackedOnce.Do(func() {
+		if offset, ok := m.ackLevel.ack(m.msg.Offset); ok {
+			m.consumer.consumer.MarkPartitionOffset(m.msg.Topic, m.msg.Partition, offset, "")
+		}
+	})
+	return nil
+}
+
+// Nack sends the message to the DLQ topic before acking it, as there is no retry topic for SASL consumers
+func (m *saramaMessage) Nack() error {
+	_, _, err := m.consumer.dlqProducer.SendMessage(&sarama.ProducerMessage{
+		Topic: m.consumer.dlqTopic,
+		Key:   sarama.ByteEncoder(m.msg.Key),
+		Value: sarama.ByteEncoder(m.msg.Value),
+	})
+	if err != nil {
+		m.consumer.logger.WithFields(bark.Fields{
+			logging.TagPartition: m.msg.Partition,
+			logging.TagOffset:    m.msg.Offset,
+			logging.TagErr:       err,
+		}).Warn("Failed to publish message to kafka dlq")
+		return err
+	}
+	return m.Ack()
+}
+
+func newPartitionAckLevel() *partitionAckLevel {
+	return &partitionAckLevel{
+		acked: make(map[int64]struct{}),
+	}
+}
+
+// add records a newly delivered message, it returns false if the offset is not after the
+// offsets delivered so far
+func (p *partitionAckLevel) add(offset int64) bool {
+	p.Lock()
+	defer p.Unlock()
+
+	if len(p.outstanding) > 0 && offset <= p.outstanding[len(p.outstanding)-1] {
+		return false
+	}
+	p.outstanding = append(p.outstanding, offset)
+	return true
+}
+
+// ack records the message as acked, it returns the highest offset which can be committed, if any
+func (p *partitionAckLevel) ack(offset int64) (int64, bool) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.acked[offset] = struct{}{}
+	committed := int64(-1)
+	for len(p.outstanding) > 0 {
+		if _, ok := p.acked[p.outstanding[0]]; !ok {
+			break
+		}
+		committed = p.outstanding[0]
+		delete(p.acked, committed)
+		p.outstanding = p.outstanding[1:]
+	}
+	return committed, committed >= 0
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	saramaConsumerSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestSaramaConsumerSuite(t *testing.T) {
+	suite.Run(t, new(saramaConsumerSuite))
+}
+
+func (s *saramaConsumerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *saramaConsumerSuite) TestPartitionAckLevel_InOrder() {
+	ackLevel := newPartitionAckLevel()
+	s.True(ackLevel.add(10))
+	s.True(ackLevel.add(11))
+
+	offset, ok := ackLevel.ack(10)
+	s.True(ok)
+	s.Equal(int64(10), offset)
+	offset, ok = ackLevel.ack(11)
+	s.True(ok)
+	s.Equal(int64(11), offset)
+}
+
+func (s *saramaConsumerSuite) TestPartitionAckLevel_OutOfOrder() {
+	ackLevel := newPartitionAckLevel()
+	s.True(ackLevel.add(10))
+	s.True(ackLevel.add(11))
+	s.True(ackLevel.add(13))
+
+	_, ok := ackLevel.ack(13)
+	s.False(ok)
+	_, ok = ackLevel.ack(11)
+	s.False(ok)
+	offset, ok := ackLevel.ack(10)
+	s.True(ok)
+	s.Equal(int64(13), offset)
+}
+
+func (s *saramaConsumerSuite) TestPartitionAckLevel_OffsetGoesBack() {
+	ackLevel := newPartitionAckLevel()
+	s.True(ackLevel.add(10))
+	s.False(ackLevel.add(10))
+	s.False(ackLevel.add(5))
+}
+
+func (s *saramaConsumerSuite) TestGetPartitionAckLevel_ResetAfterRebalance() {
+	consumer := &saramaConsumer{partitions: make(map[int32]*partitionAckLevel)}
+	first := consumer.getPartitionAckLevel(0, 10)
+	s.Equal(first, consumer.getPartitionAckLevel(0, 11))
+	s.NotEqual(first, consumer.getPartitionAckLevel(1, 11))
+
+	// the partition is assigned back and consumed from the committed offset
+	reset := consumer.getPartitionAckLevel(0, 10)
+	s.NotEqual(first, reset)
+	offset, ok := reset.ack(10)
+	s.True(ok)
+	s.Equal(int64(10), offset)
+}
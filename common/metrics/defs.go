@@ -66,9 +66,12 @@ const (
 	HostnameTagName  = "hostname"
 	OperationTagName = "operation"
 	// ShardTagName is temporary until we can get all metric data removed for the service
-	ShardTagName       = "shard"
-	CadenceRoleTagName = "cadence-role"
-	StatsTypeTagName   = "stats-type"
+	ShardTagName        = "shard"
+	CadenceRoleTagName  = "cadence-role"
+	StatsTypeTagName    = "stats-type"
+	DomainTagName       = "domain"
+	TaskListTagName     = "tasklist"
+	TaskListTypeTagName = "tasklist-type"
 )
 
 // This package should hold all the metrics and tags for cadence
@@ -168,6 +171,12 @@ const (
 	PersistenceLeaseTaskListScope
 	// PersistenceUpdateTaskListScope tracks PersistenceUpdateTaskListScope calls made by service to persistence layer
 	PersistenceUpdateTaskListScope
+	// PersistenceGetTaskListScope tracks GetTaskList calls made by service to persistence layer
+	PersistenceGetTaskListScope
+	// PersistenceListTaskListsScope tracks ListTaskLists calls made by service to persistence layer
+	PersistenceListTaskListsScope
+	// PersistenceDeleteTaskListScope tracks DeleteTaskList calls made by service to persistence layer
+	PersistenceDeleteTaskListScope
 	// PersistenceAppendHistoryEventsScope tracks AppendHistoryEvents calls made by service to persistence layer
 	PersistenceAppendHistoryEventsScope
 	// PersistenceGetWorkflowExecutionHistoryScope tracks GetWorkflowExecutionHistory calls made by service to persistence layer
@@ -188,6 +197,16 @@ const (
 	PersistenceListDomainScope
 	// PersistenceGetMetadataScope tracks DeleteDomainByName calls made by service to persistence layer
 	PersistenceGetMetadataScope
+	// PersistenceCreateClusterMetadataScope tracks CreateClusterMetadata calls made by service to persistence layer
+	PersistenceCreateClusterMetadataScope
+	// PersistenceUpdateClusterMetadataScope tracks UpdateClusterMetadata calls made by service to persistence layer
+	PersistenceUpdateClusterMetadataScope
+	// PersistenceListClusterMetadataScope tracks ListClusterMetadata calls made by service to persistence layer
+	PersistenceListClusterMetadataScope
+	// PersistenceCreateConflictResolutionAuditScope tracks CreateConflictResolutionAudit calls made by service to persistence layer
+	PersistenceCreateConflictResolutionAuditScope
+	// PersistenceListConflictResolutionAuditsScope tracks ListConflictResolutionAudits calls made by service to persistence layer
+	PersistenceListConflictResolutionAuditsScope
 	// PersistenceRecordWorkflowExecutionStartedScope tracks RecordWorkflowExecutionStarted calls made by service to persistence layer
 	PersistenceRecordWorkflowExecutionStartedScope
 	// PersistenceRecordWorkflowExecutionClosedScope tracks RecordWorkflowExecutionClosed calls made by service to persistence layer
@@ -256,6 +275,10 @@ const (
 	HistoryClientSyncShardStatusScope
 	// HistoryClientSyncActivityScope tracks RPC calls to history service
 	HistoryClientSyncActivityScope
+	// HistoryClientGetReplicationMessagesScope tracks RPC calls to history service
+	HistoryClientGetReplicationMessagesScope
+	// HistoryClientDescribeReplicationStatusScope tracks RPC calls to history service
+	HistoryClientDescribeReplicationStatusScope
 	// MatchingClientPollForDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientPollForDecisionTaskScope
 	// MatchingClientPollForActivityTaskScope tracks RPC calls to matching service
@@ -272,6 +295,16 @@ const (
 	MatchingClientCancelOutstandingPollScope
 	// MatchingClientDescribeTaskListScope tracks RPC calls to matching service
 	MatchingClientDescribeTaskListScope
+	// MatchingClientUpdateTaskListDispatchRateScope tracks RPC calls to matching service
+	MatchingClientUpdateTaskListDispatchRateScope
+	// MatchingClientDescribeTaskListDispatchRateScope tracks RPC calls to matching service
+	MatchingClientDescribeTaskListDispatchRateScope
+	// MatchingClientPauseTaskListScope tracks RPC calls to matching service
+	MatchingClientPauseTaskListScope
+	// MatchingClientResumeTaskListScope tracks RPC calls to matching service
+	MatchingClientResumeTaskListScope
+	// MatchingClientListTaskListsScope tracks RPC calls to matching service
+	MatchingClientListTaskListsScope
 	// FrontendClientDeprecateDomainScope tracks RPC calls to frontend service
 	FrontendClientDeprecateDomainScope
 	// FrontendClientDescribeDomainScope tracks RPC calls to frontend service
@@ -338,6 +371,30 @@ const (
 	AdminClientDescribeWorkflowExecutionScope
 	// AdminClientGetWorkflowExecutionRawHistoryScope tracks RPC calls to admin service
 	AdminClientGetWorkflowExecutionRawHistoryScope
+	// AdminClientGetReplicationMessagesScope tracks RPC calls to admin service
+	AdminClientGetReplicationMessagesScope
+	// AdminClientDescribeReplicationStatusScope tracks RPC calls to admin service
+	AdminClientDescribeReplicationStatusScope
+	// AdminClientAddClusterScope tracks RPC calls to admin service
+	AdminClientAddClusterScope
+	// AdminClientUpdateClusterScope tracks RPC calls to admin service
+	AdminClientUpdateClusterScope
+	// AdminClientListClustersScope tracks RPC calls to admin service
+	AdminClientListClustersScope
+	// AdminClientApplyReplicationTaskScope tracks RPC calls to admin service
+	AdminClientApplyReplicationTaskScope
+	// AdminClientListConflictResolutionsScope tracks RPC calls to admin service
+	AdminClientListConflictResolutionsScope
+	// AdminClientUpdateTaskListDispatchRateScope tracks RPC calls to admin service
+	AdminClientUpdateTaskListDispatchRateScope
+	// AdminClientDescribeTaskListDispatchRateScope tracks RPC calls to admin service
+	AdminClientDescribeTaskListDispatchRateScope
+	// AdminClientPauseTaskListScope tracks RPC calls to admin service
+	AdminClientPauseTaskListScope
+	// AdminClientResumeTaskListScope tracks RPC calls to admin service
+	AdminClientResumeTaskListScope
+	// AdminClientAdvanceTimeScope tracks RPC calls to admin service
+	AdminClientAdvanceTimeScope
 
 	// MessagingPublishScope tracks Publish calls made by service to messaging layer
 	MessagingClientPublishScope
@@ -379,6 +436,30 @@ const (
 	AdminDescribeWorkflowExecutionScope
 	// AdminGetWorkflowExecutionRawHistoryScope is the metric scope for admin.GetWorkflowExecutionRawHistoryScope
 	AdminGetWorkflowExecutionRawHistoryScope
+	// AdminGetReplicationMessagesScope is the metric scope for admin.GetReplicationMessages
+	AdminGetReplicationMessagesScope
+	// AdminDescribeReplicationStatusScope is the metric scope for admin.DescribeReplicationStatus
+	AdminDescribeReplicationStatusScope
+	// AdminAddClusterScope is the metric scope for admin.AddCluster
+	AdminAddClusterScope
+	// AdminUpdateClusterScope is the metric scope for admin.UpdateCluster
+	AdminUpdateClusterScope
+	// AdminListClustersScope is the metric scope for admin.ListClusters
+	AdminListClustersScope
+	// AdminApplyReplicationTaskScope is the metric scope for admin.ApplyReplicationTask
+	AdminApplyReplicationTaskScope
+	// AdminListConflictResolutionsScope is the metric scope for admin.ListConflictResolutions
+	AdminListConflictResolutionsScope
+	// AdminUpdateTaskListDispatchRateScope is the metric scope for admin.UpdateTaskListDispatchRate
+	AdminUpdateTaskListDispatchRateScope
+	// AdminDescribeTaskListDispatchRateScope is the metric scope for admin.DescribeTaskListDispatchRate
+	AdminDescribeTaskListDispatchRateScope
+	// AdminPauseTaskListScope is the metric scope for admin.PauseTaskList
+	AdminPauseTaskListScope
+	// AdminResumeTaskListScope is the metric scope for admin.ResumeTaskList
+	AdminResumeTaskListScope
+	// AdminAdvanceTimeScope is the metric scope for admin.AdvanceTime
+	AdminAdvanceTimeScope
 
 	NumAdminScopes
 )
@@ -447,6 +528,10 @@ const (
 	FrontendListDomainsScope
 	// FrontendResetWorkflowExecutionScope is the metric scope for frontend.ResetWorkflowExecution
 	FrontendResetWorkflowExecutionScope
+	// FrontendDomainFailoverDrainerScope is the metric scope for the graceful domain failover drainer
+	FrontendDomainFailoverDrainerScope
+	// FrontendListTaskListsScope is the metric scope for frontend.ListTaskLists
+	FrontendListTaskListsScope
 
 	NumFrontendScopes
 )
@@ -499,6 +584,10 @@ const (
 	HistorySyncShardStatusScope
 	// HistorySyncActivityScope tracks HistoryActivity API calls received by service
 	HistorySyncActivityScope
+	// HistoryGetReplicationMessagesScope tracks GetReplicationMessages API calls received by service
+	HistoryGetReplicationMessagesScope
+	// HistoryDescribeReplicationStatusScope tracks DescribeReplicationStatus API calls received by service
+	HistoryDescribeReplicationStatusScope
 	// HistoryDescribeMutableStateScope tracks HistoryActivity API calls received by service
 	HistoryDescribeMutableStateScope
 	// HistoryShardControllerScope is the scope used by shard controller
@@ -575,6 +664,8 @@ const (
 	ReplicatorTaskHistoryScope
 	// ReplicatorTaskSyncActivityScope is the scope used for sync activity by replicator queue processor
 	ReplicatorTaskSyncActivityScope
+	// ReplicationTaskPullerScope is the scope used by the replication task puller
+	ReplicationTaskPullerScope
 	// ReplicateHistoryEventsScope is the scope used by historyReplicator API for applying events
 	ReplicateHistoryEventsScope
 	// ShardInfoScope is the scope used when updating shard info
@@ -621,6 +712,16 @@ const (
 	MatchingCancelOutstandingPollScope
 	// MatchingDescribeTaskListScope tracks DescribeTaskList API calls received by service
 	MatchingDescribeTaskListScope
+	// MatchingUpdateTaskListDispatchRateScope tracks UpdateTaskListDispatchRate API calls received by service
+	MatchingUpdateTaskListDispatchRateScope
+	// MatchingDescribeTaskListDispatchRateScope tracks DescribeTaskListDispatchRate API calls received by service
+	MatchingDescribeTaskListDispatchRateScope
+	// MatchingPauseTaskListScope tracks PauseTaskList API calls received by service
+	MatchingPauseTaskListScope
+	// MatchingResumeTaskListScope tracks ResumeTaskList API calls received by service
+	MatchingResumeTaskListScope
+	// MatchingListTaskListsScope tracks ListTaskLists API calls received by service
+	MatchingListTaskListsScope
 
 	NumMatchingScopes
 )
@@ -672,6 +773,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceCompleteTaskScope:                             {operation: "CompleteTask", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceLeaseTaskListScope:                            {operation: "LeaseTaskList", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceUpdateTaskListScope:                           {operation: "UpdateTaskList", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceGetTaskListScope:                              {operation: "GetTaskList", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceListTaskListsScope:                            {operation: "ListTaskLists", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceDeleteTaskListScope:                           {operation: "DeleteTaskList", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceAppendHistoryEventsScope:                      {operation: "AppendHistoryEvents", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceGetWorkflowExecutionHistoryScope:              {operation: "GetWorkflowExecutionHistory", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceDeleteWorkflowExecutionHistoryScope:           {operation: "DeleteWorkflowExecutionHistory", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
@@ -682,6 +786,11 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceDeleteDomainByNameScope:                       {operation: "DeleteDomainByName", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceListDomainScope:                               {operation: "ListDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceGetMetadataScope:                              {operation: "GetMetadata", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceCreateClusterMetadataScope:                    {operation: "CreateClusterMetadata", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceUpdateClusterMetadataScope:                    {operation: "UpdateClusterMetadata", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceListClusterMetadataScope:                      {operation: "ListClusterMetadata", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceCreateConflictResolutionAuditScope:            {operation: "CreateConflictResolutionAudit", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceListConflictResolutionAuditsScope:             {operation: "ListConflictResolutionAudits", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceRecordWorkflowExecutionStartedScope:           {operation: "RecordWorkflowExecutionStarted"},
 		PersistenceRecordWorkflowExecutionClosedScope:            {operation: "RecordWorkflowExecutionClosed"},
 		PersistenceListOpenWorkflowExecutionsScope:               {operation: "ListOpenWorkflowExecutions"},
@@ -723,6 +832,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientReplicateRawEventsScope:                {operation: "HistoryClientReplicateRawEvents", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientSyncShardStatusScope:                   {operation: "HistoryClientSyncShardStatusScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientSyncActivityScope:                      {operation: "HistoryClientSyncActivityScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientGetReplicationMessagesScope:            {operation: "HistoryClientGetReplicationMessages", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDescribeReplicationStatusScope:         {operation: "HistoryClientDescribeReplicationStatus", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		MatchingClientPollForDecisionTaskScope:              {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientPollForActivityTaskScope:              {operation: "MatchingClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientAddActivityTaskScope:                  {operation: "MatchingClientAddActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
@@ -731,6 +842,11 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingClientRespondQueryTaskCompletedScope:        {operation: "MatchingClientRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientCancelOutstandingPollScope:            {operation: "MatchingClientCancelOutstandingPoll", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientDescribeTaskListScope:                 {operation: "MatchingClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientUpdateTaskListDispatchRateScope:       {operation: "MatchingClientUpdateTaskListDispatchRate", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientDescribeTaskListDispatchRateScope:     {operation: "MatchingClientDescribeTaskListDispatchRate", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientPauseTaskListScope:                    {operation: "MatchingClientPauseTaskList", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientResumeTaskListScope:                   {operation: "MatchingClientResumeTaskList", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientListTaskListsScope:                    {operation: "MatchingClientListTaskLists", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		FrontendClientDeprecateDomainScope:                  {operation: "FrontendClientDeprecateDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientDescribeDomainScope:                   {operation: "FrontendClientDescribeDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientDescribeTaskListScope:                 {operation: "FrontendClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
@@ -764,6 +880,18 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientDescribeHistoryHostScope:                 {operation: "AdminClientDescribeHistoryHost", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeWorkflowExecutionScope:           {operation: "AdminClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetWorkflowExecutionRawHistoryScope:      {operation: "AdminClientGetWorkflowExecutionRawHistory", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetReplicationMessagesScope:              {operation: "AdminClientGetReplicationMessages", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeReplicationStatusScope:           {operation: "AdminClientDescribeReplicationStatus", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientAddClusterScope:                          {operation: "AdminClientAddCluster", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateClusterScope:                       {operation: "AdminClientUpdateCluster", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientListClustersScope:                        {operation: "AdminClientListClusters", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientApplyReplicationTaskScope:                {operation: "AdminClientApplyReplicationTask", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientListConflictResolutionsScope:             {operation: "AdminClientListConflictResolutions", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateTaskListDispatchRateScope:          {operation: "AdminClientUpdateTaskListDispatchRate", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeTaskListDispatchRateScope:        {operation: "AdminClientDescribeTaskListDispatchRate", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientPauseTaskListScope:                       {operation: "AdminClientPauseTaskList", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientResumeTaskListScope:                      {operation: "AdminClientResumeTaskList", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientAdvanceTimeScope:                         {operation: "AdminClientAdvanceTime", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 
 		MessagingClientPublishScope:      {operation: "MessagingClientPublish"},
 		MessagingClientPublishBatchScope: {operation: "MessagingClientPublishBatch"},
@@ -780,6 +908,18 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminDescribeHistoryHostScope:            {operation: "DescribeHistoryHost"},
 		AdminDescribeWorkflowExecutionScope:      {operation: "DescribeWorkflowExecution"},
 		AdminGetWorkflowExecutionRawHistoryScope: {operation: "GetWorkflowExecutionRawHistory"},
+		AdminGetReplicationMessagesScope:         {operation: "GetReplicationMessages"},
+		AdminDescribeReplicationStatusScope:      {operation: "DescribeReplicationStatus"},
+		AdminAddClusterScope:                     {operation: "AddCluster"},
+		AdminUpdateClusterScope:                  {operation: "UpdateCluster"},
+		AdminListClustersScope:                   {operation: "ListClusters"},
+		AdminApplyReplicationTaskScope:           {operation: "ApplyReplicationTask"},
+		AdminListConflictResolutionsScope:        {operation: "ListConflictResolutions"},
+		AdminUpdateTaskListDispatchRateScope:     {operation: "UpdateTaskListDispatchRate"},
+		AdminDescribeTaskListDispatchRateScope:   {operation: "DescribeTaskListDispatchRate"},
+		AdminPauseTaskListScope:                  {operation: "PauseTaskList"},
+		AdminResumeTaskListScope:                 {operation: "ResumeTaskList"},
+		AdminAdvanceTimeScope:                    {operation: "AdvanceTime"},
 
 		FrontendStartWorkflowExecutionScope:           {operation: "StartWorkflowExecution"},
 		FrontendPollForDecisionTaskScope:              {operation: "PollForDecisionTask"},
@@ -812,6 +952,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendDescribeWorkflowExecutionScope:        {operation: "DescribeWorkflowExecution"},
 		FrontendDescribeTaskListScope:                 {operation: "DescribeTaskList"},
 		FrontendResetStickyTaskListScope:              {operation: "ResetStickyTaskList"},
+		FrontendDomainFailoverDrainerScope:            {operation: "DomainFailoverDrainer"},
+		FrontendListTaskListsScope:                    {operation: "ListTaskLists"},
 	},
 	// History Scope Names
 	History: {
@@ -839,6 +981,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryReplicateRawEventsScope:               {operation: "ReplicateRawEvents"},
 		HistorySyncShardStatusScope:                  {operation: "SyncShardStatus"},
 		HistorySyncActivityScope:                     {operation: "SyncActivity"},
+		HistoryGetReplicationMessagesScope:           {operation: "GetReplicationMessages"},
+		HistoryDescribeReplicationStatusScope:        {operation: "DescribeReplicationStatus"},
 		HistoryDescribeMutableStateScope:             {operation: "DescribeMutableState"},
 		HistoryShardControllerScope:                  {operation: "ShardController"},
 		TransferQueueProcessorScope:                  {operation: "TransferQueueProcessor"},
@@ -877,6 +1021,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ReplicatorQueueProcessorScope:                {operation: "ReplicatorQueueProcessor"},
 		ReplicatorTaskHistoryScope:                   {operation: "ReplicatorTaskHistory"},
 		ReplicatorTaskSyncActivityScope:              {operation: "ReplicatorTaskSyncActivity"},
+		ReplicationTaskPullerScope:                   {operation: "ReplicationTaskPuller"},
 		ReplicateHistoryEventsScope:                  {operation: "ReplicateHistoryEvents"},
 		ShardInfoScope:                               {operation: "ShardInfo"},
 		WorkflowContextScope:                         {operation: "WorkflowContext"},
@@ -890,15 +1035,20 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 	},
 	// Matching Scope Names
 	Matching: {
-		MatchingPollForDecisionTaskScope:       {operation: "PollForDecisionTask"},
-		MatchingPollForActivityTaskScope:       {operation: "PollForActivityTask"},
-		MatchingAddActivityTaskScope:           {operation: "AddActivityTask"},
-		MatchingAddDecisionTaskScope:           {operation: "AddDecisionTask"},
-		MatchingTaskListMgrScope:               {operation: "TaskListMgr"},
-		MatchingQueryWorkflowScope:             {operation: "QueryWorkflow"},
-		MatchingRespondQueryTaskCompletedScope: {operation: "RespondQueryTaskCompleted"},
-		MatchingCancelOutstandingPollScope:     {operation: "CancelOutstandingPoll"},
-		MatchingDescribeTaskListScope:          {operation: "DescribeTaskList"},
+		MatchingPollForDecisionTaskScope:          {operation: "PollForDecisionTask"},
+		MatchingPollForActivityTaskScope:          {operation: "PollForActivityTask"},
+		MatchingAddActivityTaskScope:              {operation: "AddActivityTask"},
+		MatchingAddDecisionTaskScope:              {operation: "AddDecisionTask"},
+		MatchingTaskListMgrScope:                  {operation: "TaskListMgr"},
+		MatchingQueryWorkflowScope:                {operation: "QueryWorkflow"},
+		MatchingRespondQueryTaskCompletedScope:    {operation: "RespondQueryTaskCompleted"},
+		MatchingCancelOutstandingPollScope:        {operation: "CancelOutstandingPoll"},
+		MatchingDescribeTaskListScope:             {operation: "DescribeTaskList"},
+		MatchingUpdateTaskListDispatchRateScope:   {operation: "UpdateTaskListDispatchRate"},
+		MatchingDescribeTaskListDispatchRateScope: {operation: "DescribeTaskListDispatchRate"},
+		MatchingPauseTaskListScope:                {operation: "PauseTaskList"},
+		MatchingResumeTaskListScope:               {operation: "ResumeTaskList"},
+		MatchingListTaskListsScope:                {operation: "ListTaskLists"},
 	},
 	// Worker Scope Names
 	Worker: {
@@ -966,6 +1116,7 @@ const (
 	TaskDiscarded
 	TaskAttemptTimer
 	TaskStandbyRetryCounter
+	TaskHeldCounter
 	TaskNotActiveCounter
 	TaskLimitExceededCounter
 	TaskBatchCompleteCounter
@@ -1064,10 +1215,25 @@ const (
 	DeleteRequestCancelInfoCount
 	WorkflowRetryBackoffTimerCount
 	WorkflowCronBackoffTimerCount
+	ReplicationTasksPulled
+	ReplicationTasksApplied
+	ReplicationTasksFailed
+	ReplicationTaskPullLatency
+	ReplicationTasksLag
+	ReplicationShardTimeLag
+	ReplicationPendingTasks
 
 	NumHistoryMetrics
 )
 
+// Frontend metrics enum
+const (
+	DomainFailoverDrainCompletedCounter = iota + NumCommonMetrics
+	DomainFailoverDrainTimeoutCounter
+
+	NumFrontendMetrics
+)
+
 // Matching metrics enum
 const (
 	PollSuccessCounter = iota + NumCommonMetrics
@@ -1079,7 +1245,15 @@ const (
 	RespondQueryTaskFailedCounter
 	SyncThrottleCounter
 	BufferThrottleCounter
+	ExpiredTasksCounter
+	DomainTaskListLimitCounter
+	DomainBufferThrottleCounter
+	DomainPersistenceThrottleCounter
 	SyncMatchLatency
+	TaskListBacklogSizeGauge
+	TaskListBacklogAgeGauge
+	TaskListPollerCountGauge
+	StarvedTaskListGauge
 
 	NumMatchingMetrics
 )
@@ -1089,6 +1263,7 @@ const (
 	ReplicatorMessages = iota + NumCommonMetrics
 	ReplicatorFailures
 	ReplicatorLatency
+	ReplicatorHeld
 	ESProcessorFailures
 	ESProcessorCorruptedData
 	IndexProcessorCorruptedData
@@ -1137,7 +1312,10 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		HistoryCount:                                        {metricName: "history-count", metricType: Timer},
 		EventBlobSize:                                       {metricName: "event-blob-size", metricType: Timer},
 	},
-	Frontend: {},
+	Frontend: {
+		DomainFailoverDrainCompletedCounter: {metricName: "domain-failover-drain.completed", metricType: Counter},
+		DomainFailoverDrainTimeoutCounter:   {metricName: "domain-failover-drain.timeout", metricType: Counter},
+	},
 	History: {
 		TaskRequests:                                 {metricName: "task.requests", metricType: Counter},
 		TaskLatency:                                  {metricName: "task.latency", metricType: Timer},
@@ -1145,6 +1323,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskFailures:                                 {metricName: "task.errors", metricType: Counter},
 		TaskDiscarded:                                {metricName: "task.errors.discarded", metricType: Counter},
 		TaskStandbyRetryCounter:                      {metricName: "task.errors.standby-retry-counter", metricType: Counter},
+		TaskHeldCounter:                              {metricName: "task.held-counter", metricType: Counter},
 		TaskNotActiveCounter:                         {metricName: "task.errors.not-active-counter", metricType: Counter},
 		TaskLimitExceededCounter:                     {metricName: "task.errors.limit-exceeded-counter", metricType: Counter},
 		TaskProcessingLatency:                        {metricName: "task.latency.processing", metricType: Timer},
@@ -1242,23 +1421,39 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DeleteRequestCancelInfoCount:                 {metricName: "delete-request-cancel-info", metricType: Timer},
 		WorkflowRetryBackoffTimerCount:               {metricName: "workflow-retry-backoff-timer", metricType: Counter},
 		WorkflowCronBackoffTimerCount:                {metricName: "workflow-cron-backoff-timer", metricType: Counter},
+		ReplicationTasksPulled:                       {metricName: "replication-tasks-pulled", metricType: Counter},
+		ReplicationTasksApplied:                      {metricName: "replication-tasks-applied", metricType: Counter},
+		ReplicationTasksFailed:                       {metricName: "replication-tasks-failed", metricType: Counter},
+		ReplicationTaskPullLatency:                   {metricName: "replication-task-pull-latency", metricType: Timer},
+		ReplicationTasksLag:                          {metricName: "replication-tasks-lag", metricType: Gauge},
+		ReplicationShardTimeLag:                      {metricName: "replication-shard-time-lag", metricType: Timer},
+		ReplicationPendingTasks:                      {metricName: "replication-pending-tasks", metricType: Gauge},
 	},
 	Matching: {
-		PollSuccessCounter:            {metricName: "poll.success"},
-		PollTimeoutCounter:            {metricName: "poll.timeouts"},
-		PollSuccessWithSyncCounter:    {metricName: "poll.success.sync"},
-		LeaseRequestCounter:           {metricName: "lease.requests"},
-		LeaseFailureCounter:           {metricName: "lease.failures"},
-		ConditionFailedErrorCounter:   {metricName: "condition-failed-errors"},
-		RespondQueryTaskFailedCounter: {metricName: "respond-query-failed"},
-		SyncThrottleCounter:           {metricName: "sync.throttle.count"},
-		BufferThrottleCounter:         {metricName: "buffer.throttle.count"},
-		SyncMatchLatency:              {metricName: "syncmatch.latency", metricType: Timer},
+		PollSuccessCounter:               {metricName: "poll.success"},
+		PollTimeoutCounter:               {metricName: "poll.timeouts"},
+		PollSuccessWithSyncCounter:       {metricName: "poll.success.sync"},
+		LeaseRequestCounter:              {metricName: "lease.requests"},
+		LeaseFailureCounter:              {metricName: "lease.failures"},
+		ConditionFailedErrorCounter:      {metricName: "condition-failed-errors"},
+		RespondQueryTaskFailedCounter:    {metricName: "respond-query-failed"},
+		SyncThrottleCounter:              {metricName: "sync.throttle.count"},
+		BufferThrottleCounter:            {metricName: "buffer.throttle.count"},
+		ExpiredTasksCounter:              {metricName: "expired.tasks"},
+		DomainTaskListLimitCounter:       {metricName: "domain.tasklist-limit.count"},
+		DomainBufferThrottleCounter:      {metricName: "domain.buffer.throttle.count"},
+		DomainPersistenceThrottleCounter: {metricName: "domain.persistence.throttle.count"},
+		SyncMatchLatency:                 {metricName: "syncmatch.latency", metricType: Timer},
+		TaskListBacklogSizeGauge:         {metricName: "tasklist.backlog.size", metricType: Gauge},
+		TaskListBacklogAgeGauge:          {metricName: "tasklist.backlog.age-seconds", metricType: Gauge},
+		TaskListPollerCountGauge:         {metricName: "tasklist.pollers", metricType: Gauge},
+		StarvedTaskListGauge:             {metricName: "tasklist.starved", metricType: Gauge},
 	},
 	Worker: {
 		ReplicatorMessages:          {metricName: "replicator.messages"},
 		ReplicatorFailures:          {metricName: "replicator.errors"},
 		ReplicatorLatency:           {metricName: "replicator.latency"},
+		ReplicatorHeld:              {metricName: "replicator.held"},
 		ESProcessorFailures:         {metricName: "es-processor.errors"},
 		ESProcessorCorruptedData:    {metricName: "es-processor.corrupted-data"},
 		IndexProcessorCorruptedData: {metricName: "index-processor.corrupted-data"},
@@ -64,6 +64,11 @@ func TestMetricDefsMapped(t *testing.T) {
 		require.True(t, ok)
 		require.NotEmpty(t, key)
 	}
+	for i := DomainFailoverDrainCompletedCounter; i < NumFrontendMetrics; i++ {
+		key, ok := MetricDefs[Frontend][i]
+		require.True(t, ok)
+		require.NotEmpty(t, key)
+	}
 	for i := PollSuccessCounter; i < NumMatchingMetrics; i++ {
 		key, ok := MetricDefs[Matching][i]
 		require.True(t, ok)
@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/uber/cadence/.gen/go/admin"
 	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"go.uber.org/yarpc"
 )
@@ -105,3 +106,225 @@ func (_m *AdminClient) GetWorkflowExecutionRawHistory(ctx context.Context, reque
 
 	return r0, r1
 }
+
+// GetReplicationMessages provides a mock function with given fields: ctx, request
+func (_m *AdminClient) GetReplicationMessages(ctx context.Context, request *replicator.GetReplicationMessagesRequest, opts ...yarpc.CallOption) (*replicator.GetReplicationMessagesResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *replicator.GetReplicationMessagesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.GetReplicationMessagesRequest) *replicator.GetReplicationMessagesResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.GetReplicationMessagesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *replicator.GetReplicationMessagesRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeReplicationStatus provides a mock function with given fields: ctx, request
+func (_m *AdminClient) DescribeReplicationStatus(ctx context.Context, request *replicator.DescribeReplicationStatusRequest, opts ...yarpc.CallOption) (*replicator.DescribeReplicationStatusResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *replicator.DescribeReplicationStatusResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.DescribeReplicationStatusRequest) *replicator.DescribeReplicationStatusResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.DescribeReplicationStatusResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *replicator.DescribeReplicationStatusRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddCluster provides a mock function with given fields: ctx, request
+func (_m *AdminClient) AddCluster(ctx context.Context, request *admin.AddClusterRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.AddClusterRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCluster provides a mock function with given fields: ctx, request
+func (_m *AdminClient) UpdateCluster(ctx context.Context, request *admin.UpdateClusterRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.UpdateClusterRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListClusters provides a mock function with given fields: ctx, request
+func (_m *AdminClient) ListClusters(ctx context.Context, request *admin.ListClustersRequest, opts ...yarpc.CallOption) (*admin.ListClustersResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *admin.ListClustersResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ListClustersRequest) *admin.ListClustersResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.ListClustersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.ListClustersRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApplyReplicationTask provides a mock function with given fields: ctx, request
+func (_m *AdminClient) ApplyReplicationTask(ctx context.Context, request *admin.ApplyReplicationTaskRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ApplyReplicationTaskRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListConflictResolutions provides a mock function with given fields: ctx, request
+func (_m *AdminClient) ListConflictResolutions(ctx context.Context, request *admin.ListConflictResolutionsRequest, opts ...yarpc.CallOption) (*admin.ListConflictResolutionsResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *admin.ListConflictResolutionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ListConflictResolutionsRequest) *admin.ListConflictResolutionsResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.ListConflictResolutionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.ListConflictResolutionsRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateTaskListDispatchRate provides a mock function with given fields: ctx, request
+func (_m *AdminClient) UpdateTaskListDispatchRate(ctx context.Context, request *shared.UpdateTaskListDispatchRateRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.UpdateTaskListDispatchRateRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeTaskListDispatchRate provides a mock function with given fields: ctx, request
+func (_m *AdminClient) DescribeTaskListDispatchRate(ctx context.Context, request *shared.DescribeTaskListDispatchRateRequest, opts ...yarpc.CallOption) (*shared.DescribeTaskListDispatchRateResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.DescribeTaskListDispatchRateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.DescribeTaskListDispatchRateRequest) *shared.DescribeTaskListDispatchRateResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeTaskListDispatchRateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.DescribeTaskListDispatchRateRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PauseTaskList provides a mock function with given fields: ctx, request
+func (_m *AdminClient) PauseTaskList(ctx context.Context, request *shared.PauseTaskListRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.PauseTaskListRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeTaskList provides a mock function with given fields: ctx, request
+func (_m *AdminClient) ResumeTaskList(ctx context.Context, request *shared.ResumeTaskListRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ResumeTaskListRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AdvanceTime provides a mock function with given fields: ctx, request
+func (_m *AdminClient) AdvanceTime(ctx context.Context, request *admin.AdvanceTimeRequest, opts ...yarpc.CallOption) (*admin.AdvanceTimeResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *admin.AdvanceTimeResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.AdvanceTimeRequest) *admin.AdvanceTimeResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.AdvanceTimeResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.AdvanceTimeRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
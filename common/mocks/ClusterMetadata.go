@@ -22,6 +22,7 @@ package mocks
 
 import (
 	mock "github.com/stretchr/testify/mock"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service/config"
 )
 
@@ -172,6 +173,44 @@ func (_m *ClusterMetadata) GetAllClientAddress() map[string]config.Address {
 	return r0
 }
 
+// ValidateClusters provides a mock function with given fields: clusters
+func (_m *ClusterMetadata) ValidateClusters(clusters []*persistence.ClusterMetadataInfo) error {
+	ret := _m.Called(clusters)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*persistence.ClusterMetadataInfo) error); ok {
+		r0 = rf(clusters)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateClusters provides a mock function with given fields: clusters
+func (_m *ClusterMetadata) UpdateClusters(clusters []*persistence.ClusterMetadataInfo) error {
+	ret := _m.Called(clusters)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*persistence.ClusterMetadataInfo) error); ok {
+		r0 = rf(clusters)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RegisterClusterChangeCallback provides a mock function with given fields: callerID, callback
+func (_m *ClusterMetadata) RegisterClusterChangeCallback(callerID string, callback func()) {
+	_m.Called(callerID, callback)
+}
+
+// UnregisterClusterChangeCallback provides a mock function with given fields: callerID
+func (_m *ClusterMetadata) UnregisterClusterChangeCallback(callerID string) {
+	_m.Called(callerID)
+}
+
 // IsArchivalEnabled provides a mock function with given fields:
 func (_m *ClusterMetadata) IsArchivalEnabled() bool {
 	ret := _m.Called()
@@ -199,4 +238,3 @@ func (_m *ClusterMetadata) GetDefaultArchivalBucket() string {
 
 	return r0
 }
-
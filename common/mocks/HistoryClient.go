@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/history/historyserviceclient"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 )
 
@@ -511,3 +512,49 @@ func (_m *HistoryClient) SyncActivity(ctx context.Context, request *history.Sync
 
 	return r0
 }
+
+// GetReplicationMessages provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) GetReplicationMessages(ctx context.Context, request *replicator.GetReplicationMessagesRequest, opts ...yarpc.CallOption) (*replicator.GetReplicationMessagesResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *replicator.GetReplicationMessagesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.GetReplicationMessagesRequest) *replicator.GetReplicationMessagesResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.GetReplicationMessagesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *replicator.GetReplicationMessagesRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeReplicationStatus provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) DescribeReplicationStatus(ctx context.Context, request *replicator.DescribeReplicationStatusRequest, opts ...yarpc.CallOption) (*replicator.DescribeReplicationStatusResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *replicator.DescribeReplicationStatusResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *replicator.DescribeReplicationStatusRequest) *replicator.DescribeReplicationStatusResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.DescribeReplicationStatusResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *replicator.DescribeReplicationStatusRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
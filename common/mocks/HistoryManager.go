@@ -125,6 +125,43 @@ func (_m *HistoryManager) DeleteWorkflowExecutionHistory(request *persistence.De
 	return r0
 }
 
+// CreateConflictResolutionAudit provides a mock function with given fields: request
+func (_m *HistoryManager) CreateConflictResolutionAudit(request *persistence.CreateConflictResolutionAuditRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.CreateConflictResolutionAuditRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListConflictResolutionAudits provides a mock function with given fields: request
+func (_m *HistoryManager) ListConflictResolutionAudits(request *persistence.ListConflictResolutionAuditsRequest) (*persistence.ListConflictResolutionAuditsResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.ListConflictResolutionAuditsResponse
+	if rf, ok := ret.Get(0).(func(*persistence.ListConflictResolutionAuditsRequest) *persistence.ListConflictResolutionAuditsResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.ListConflictResolutionAuditsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.ListConflictResolutionAuditsRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields:
 func (_m *HistoryManager) Close() {
 	_m.Called()
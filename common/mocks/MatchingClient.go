@@ -188,3 +188,91 @@ func (_m *MatchingClient) DescribeTaskList(ctx context.Context,
 
 	return r0, r1
 }
+
+// UpdateTaskListDispatchRate provides a mock function with given fields: ctx, request
+func (_m *MatchingClient) UpdateTaskListDispatchRate(ctx context.Context, request *matching.UpdateTaskListDispatchRateRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *matching.UpdateTaskListDispatchRateRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeTaskListDispatchRate provides a mock function with given fields: ctx, request
+func (_m *MatchingClient) DescribeTaskListDispatchRate(ctx context.Context, request *matching.DescribeTaskListDispatchRateRequest, opts ...yarpc.CallOption) (*shared.DescribeTaskListDispatchRateResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.DescribeTaskListDispatchRateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *matching.DescribeTaskListDispatchRateRequest) *shared.DescribeTaskListDispatchRateResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeTaskListDispatchRateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *matching.DescribeTaskListDispatchRateRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PauseTaskList provides a mock function with given fields: ctx, request
+func (_m *MatchingClient) PauseTaskList(ctx context.Context, request *matching.PauseTaskListRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *matching.PauseTaskListRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeTaskList provides a mock function with given fields: ctx, request
+func (_m *MatchingClient) ResumeTaskList(ctx context.Context, request *matching.ResumeTaskListRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *matching.ResumeTaskListRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListTaskLists provides a mock function with given fields: ctx, request
+func (_m *MatchingClient) ListTaskLists(ctx context.Context, request *matching.ListTaskListsRequest, opts ...yarpc.CallOption) (*shared.ListTaskListsResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.ListTaskListsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *matching.ListTaskListsRequest) *shared.ListTaskListsResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ListTaskListsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *matching.ListTaskListsRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -180,3 +180,54 @@ func (_m *MetadataManager) GetMetadata() (*persistence.GetMetadataResponse, erro
 
 	return r0, r1
 }
+
+// CreateClusterMetadata provides a mock function with given fields: request
+func (_m *MetadataManager) CreateClusterMetadata(request *persistence.CreateClusterMetadataRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.CreateClusterMetadataRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateClusterMetadata provides a mock function with given fields: request
+func (_m *MetadataManager) UpdateClusterMetadata(request *persistence.UpdateClusterMetadataRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.UpdateClusterMetadataRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListClusterMetadata provides a mock function with given fields:
+func (_m *MetadataManager) ListClusterMetadata() (*persistence.ListClusterMetadataResponse, error) {
+	ret := _m.Called()
+
+	var r0 *persistence.ListClusterMetadataResponse
+	if rf, ok := ret.Get(0).(func() *persistence.ListClusterMetadataResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.ListClusterMetadataResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
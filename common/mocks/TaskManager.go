@@ -91,6 +91,66 @@ func (_m *TaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequest
 	return r0, r1
 }
 
+// GetTaskList provides a mock function with given fields: request
+func (_m *TaskManager) GetTaskList(request *persistence.GetTaskListRequest) (*persistence.GetTaskListResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.GetTaskListResponse
+	if rf, ok := ret.Get(0).(func(*persistence.GetTaskListRequest) *persistence.GetTaskListResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetTaskListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.GetTaskListRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTaskLists provides a mock function with given fields: request
+func (_m *TaskManager) ListTaskLists(request *persistence.ListTaskListsRequest) (*persistence.ListTaskListsResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.ListTaskListsResponse
+	if rf, ok := ret.Get(0).(func(*persistence.ListTaskListsRequest) *persistence.ListTaskListsResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.ListTaskListsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.ListTaskListsRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteTaskList provides a mock function with given fields: request
+func (_m *TaskManager) DeleteTaskList(request *persistence.DeleteTaskListRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.DeleteTaskListRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CompleteTask provides a mock function with given fields: request
 func (_m *TaskManager) CompleteTask(request *persistence.CompleteTaskRequest) error {
 	ret := _m.Called(request)
@@ -52,6 +52,19 @@ const (
 		`WHERE domain_id = ? ` +
 		`AND workflow_id = ? ` +
 		`AND run_id = ? `
+
+	templateConflictResolutionAuditColumns = `domain_id, workflow_id, resolved_time, audit_id, run_id, source_cluster, reason, ` +
+		`reset_event_id, discarded_next_event_id, discarded_start_version, discarded_end_version, ` +
+		`adopted_first_event_id, adopted_next_event_id, adopted_start_version, adopted_end_version`
+
+	templateCreateConflictResolutionAuditQuery = `INSERT INTO conflict_resolution_audits (` +
+		templateConflictResolutionAuditColumns + `) ` +
+		`VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateListConflictResolutionAuditsQuery = `SELECT ` + templateConflictResolutionAuditColumns + ` ` +
+		`FROM conflict_resolution_audits ` +
+		`WHERE domain_id = ? ` +
+		`and workflow_id = ?`
 )
 
 type (
@@ -238,3 +251,73 @@ func (h *cassandraHistoryPersistence) DeleteWorkflowExecutionHistory(
 
 	return nil
 }
+
+func (h *cassandraHistoryPersistence) CreateConflictResolutionAudit(request *p.CreateConflictResolutionAuditRequest) error {
+	info := request.Info
+	query := h.session.Query(templateCreateConflictResolutionAuditQuery,
+		info.DomainID,
+		info.WorkflowID,
+		info.ResolvedTime,
+		info.AuditID,
+		info.RunID,
+		info.SourceCluster,
+		info.Reason,
+		info.ResetEventID,
+		info.DiscardedNextEventID,
+		info.DiscardedStartVersion,
+		info.DiscardedEndVersion,
+		info.AdoptedFirstEventID,
+		info.AdoptedNextEventID,
+		info.AdoptedStartVersion,
+		info.AdoptedEndVersion,
+	)
+	if err := query.Exec(); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CreateConflictResolutionAudit operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (h *cassandraHistoryPersistence) ListConflictResolutionAudits(
+	request *p.ListConflictResolutionAuditsRequest) (*p.ListConflictResolutionAuditsResponse, error) {
+	query := h.session.Query(templateListConflictResolutionAuditsQuery, request.DomainID, request.WorkflowID)
+	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "ListConflictResolutionAudits operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &p.ListConflictResolutionAuditsResponse{}
+	info := &p.ConflictResolutionAuditInfo{}
+	for iter.Scan(
+		&info.DomainID,
+		&info.WorkflowID,
+		&info.ResolvedTime,
+		&info.AuditID,
+		&info.RunID,
+		&info.SourceCluster,
+		&info.Reason,
+		&info.ResetEventID,
+		&info.DiscardedNextEventID,
+		&info.DiscardedStartVersion,
+		&info.DiscardedEndVersion,
+		&info.AdoptedFirstEventID,
+		&info.AdoptedNextEventID,
+		&info.AdoptedStartVersion,
+		&info.AdoptedEndVersion,
+	) {
+		response.Audits = append(response.Audits, info)
+		info = &p.ConflictResolutionAuditInfo{}
+	}
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+	if err := iter.Close(); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListConflictResolutionAudits operation failed. Error: %v", err),
+		}
+	}
+	return response, nil
+}
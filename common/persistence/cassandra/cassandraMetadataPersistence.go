@@ -49,7 +49,11 @@ const (
 
 	templateDomainReplicationConfigType = `{` +
 		`active_cluster_name: ?, ` +
-		`clusters: ? ` +
+		`clusters: ?, ` +
+		`pending_active_cluster_name: ?, ` +
+		`failover_start_time: ?, ` +
+		`failover_expire_time: ?, ` +
+		`failover_markers: ? ` +
 		`}`
 
 	templateCreateDomainQuery = `INSERT INTO domains (` +
@@ -68,6 +72,8 @@ const (
 		`domain.owner_email, domain.data, config.retention, config.emit_metric, ` +
 		`config.archival_bucket, config.archival_status, ` +
 		`replication_config.active_cluster_name, replication_config.clusters, ` +
+		`replication_config.pending_active_cluster_name, replication_config.failover_start_time, ` +
+		`replication_config.failover_expire_time, replication_config.failover_markers, ` +
 		`is_global_domain, ` +
 		`config_version, ` +
 		`failover_version, ` +
@@ -159,6 +165,10 @@ func (m *cassandraMetadataPersistence) CreateDomain(request *p.CreateDomainReque
 		request.Config.ArchivalStatus,
 		request.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(request.ReplicationConfig.Clusters),
+		request.ReplicationConfig.PendingActiveClusterName,
+		request.ReplicationConfig.FailoverStartTime,
+		request.ReplicationConfig.FailoverExpireTime,
+		request.ReplicationConfig.FailoverMarkers,
 		request.IsGlobalDomain,
 		request.ConfigVersion,
 		request.FailoverVersion,
@@ -254,6 +264,10 @@ func (m *cassandraMetadataPersistence) GetDomain(request *p.GetDomainRequest) (*
 		&config.ArchivalStatus,
 		&replicationConfig.ActiveClusterName,
 		&replicationClusters,
+		&replicationConfig.PendingActiveClusterName,
+		&replicationConfig.FailoverStartTime,
+		&replicationConfig.FailoverExpireTime,
+		&replicationConfig.FailoverMarkers,
 		&isGlobalDomain,
 		&configVersion,
 		&failoverVersion,
@@ -300,6 +314,10 @@ func (m *cassandraMetadataPersistence) UpdateDomain(request *p.UpdateDomainReque
 		request.Config.ArchivalStatus,
 		request.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(request.ReplicationConfig.Clusters),
+		request.ReplicationConfig.PendingActiveClusterName,
+		request.ReplicationConfig.FailoverStartTime,
+		request.ReplicationConfig.FailoverExpireTime,
+		request.ReplicationConfig.FailoverMarkers,
 		request.ConfigVersion,
 		request.FailoverVersion,
 		nextVersion,
@@ -357,6 +375,18 @@ func (m *cassandraMetadataPersistence) GetMetadata() (*p.GetMetadataResponse, er
 	panic("cassandraMetadataPersistence do not support get metadata operation.")
 }
 
+func (m *cassandraMetadataPersistence) CreateClusterMetadata(request *p.CreateClusterMetadataRequest) error {
+	panic("cassandraMetadataPersistence do not support create cluster metadata operation.")
+}
+
+func (m *cassandraMetadataPersistence) UpdateClusterMetadata(request *p.UpdateClusterMetadataRequest) error {
+	panic("cassandraMetadataPersistence do not support update cluster metadata operation.")
+}
+
+func (m *cassandraMetadataPersistence) ListClusterMetadata() (*p.ListClusterMetadataResponse, error) {
+	panic("cassandraMetadataPersistence do not support list cluster metadata operation.")
+}
+
 func (m *cassandraMetadataPersistence) deleteDomain(name, ID string) error {
 	query := m.session.Query(templateDeleteDomainByNameQuery, name)
 	if err := query.Exec(); err != nil {
@@ -85,6 +85,18 @@ func (m *metadataManagerProxy) GetMetadata() (*p.GetMetadataResponse, error) {
 	return m.metadataMgrV2.GetMetadata()
 }
 
+func (m *metadataManagerProxy) CreateClusterMetadata(request *p.CreateClusterMetadataRequest) error {
+	return m.metadataMgrV2.CreateClusterMetadata(request)
+}
+
+func (m *metadataManagerProxy) UpdateClusterMetadata(request *p.UpdateClusterMetadataRequest) error {
+	return m.metadataMgrV2.UpdateClusterMetadata(request)
+}
+
+func (m *metadataManagerProxy) ListClusterMetadata() (*p.ListClusterMetadataResponse, error) {
+	return m.metadataMgrV2.ListClusterMetadata()
+}
+
 func (m *metadataManagerProxy) Close() {
 	m.metadataMgr.Close()
 	m.metadataMgrV2.Close()
@@ -42,6 +42,8 @@ const (
 		`domain.owner_email, domain.data, config.retention, config.emit_metric, ` +
 		`config.archival_bucket, config.archival_status, ` +
 		`replication_config.active_cluster_name, replication_config.clusters, ` +
+		`replication_config.pending_active_cluster_name, replication_config.failover_start_time, ` +
+		`replication_config.failover_expire_time, replication_config.failover_markers, ` +
 		`is_global_domain, ` +
 		`config_version, ` +
 		`failover_version, ` +
@@ -81,6 +83,8 @@ const (
 		`domain.owner_email, domain.data, config.retention, config.emit_metric, ` +
 		`config.archival_bucket, config.archival_status, ` +
 		`replication_config.active_cluster_name, replication_config.clusters, ` +
+		`replication_config.pending_active_cluster_name, replication_config.failover_start_time, ` +
+		`replication_config.failover_expire_time, replication_config.failover_markers, ` +
 		`is_global_domain, ` +
 		`config_version, ` +
 		`failover_version, ` +
@@ -88,6 +92,21 @@ const (
 		`notification_version ` +
 		`FROM domains_by_name_v2 ` +
 		`WHERE domains_partition = ? `
+
+	templateCreateClusterMetadataQuery = `INSERT INTO cluster_metadata (` +
+		`cluster_name, initial_failover_version, rpc_name, rpc_address, enabled) ` +
+		`VALUES(?, ?, ?, ?, ?) IF NOT EXISTS`
+
+	templateUpdateClusterMetadataQuery = `UPDATE cluster_metadata ` +
+		`SET initial_failover_version = ?, ` +
+		`rpc_name = ?, ` +
+		`rpc_address = ?, ` +
+		`enabled = ? ` +
+		`WHERE cluster_name = ? ` +
+		`IF EXISTS`
+
+	templateListClusterMetadataQuery = `SELECT cluster_name, initial_failover_version, rpc_name, rpc_address, enabled ` +
+		`FROM cluster_metadata`
 )
 
 type (
@@ -163,6 +182,10 @@ func (m *cassandraMetadataPersistenceV2) CreateDomain(request *p.CreateDomainReq
 		request.Config.ArchivalStatus,
 		request.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(request.ReplicationConfig.Clusters),
+		request.ReplicationConfig.PendingActiveClusterName,
+		request.ReplicationConfig.FailoverStartTime,
+		request.ReplicationConfig.FailoverExpireTime,
+		request.ReplicationConfig.FailoverMarkers,
 		request.IsGlobalDomain,
 		request.ConfigVersion,
 		request.FailoverVersion,
@@ -221,6 +244,10 @@ func (m *cassandraMetadataPersistenceV2) UpdateDomain(request *p.UpdateDomainReq
 		request.Config.ArchivalStatus,
 		request.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(request.ReplicationConfig.Clusters),
+		request.ReplicationConfig.PendingActiveClusterName,
+		request.ReplicationConfig.FailoverStartTime,
+		request.ReplicationConfig.FailoverExpireTime,
+		request.ReplicationConfig.FailoverMarkers,
 		request.ConfigVersion,
 		request.FailoverVersion,
 		request.FailoverNotificationVersion,
@@ -313,6 +340,10 @@ func (m *cassandraMetadataPersistenceV2) GetDomain(request *p.GetDomainRequest)
 		&config.ArchivalStatus,
 		&replicationConfig.ActiveClusterName,
 		&replicationClusters,
+		&replicationConfig.PendingActiveClusterName,
+		&replicationConfig.FailoverStartTime,
+		&replicationConfig.FailoverExpireTime,
+		&replicationConfig.FailoverMarkers,
 		&isGlobalDomain,
 		&configVersion,
 		&failoverVersion,
@@ -370,6 +401,9 @@ func (m *cassandraMetadataPersistenceV2) ListDomains(request *p.ListDomainsReque
 		&domain.Config.Retention, &domain.Config.EmitMetric,
 		&domain.Config.ArchivalBucket, &domain.Config.ArchivalStatus,
 		&domain.ReplicationConfig.ActiveClusterName, &replicationClusters,
+		&domain.ReplicationConfig.PendingActiveClusterName,
+		&domain.ReplicationConfig.FailoverStartTime, &domain.ReplicationConfig.FailoverExpireTime,
+		&domain.ReplicationConfig.FailoverMarkers,
 		&domain.IsGlobalDomain, &domain.ConfigVersion, &domain.FailoverVersion,
 		&domain.FailoverNotificationVersion, &domain.NotificationVersion,
 	) {
@@ -445,6 +479,74 @@ func (m *cassandraMetadataPersistenceV2) GetMetadata() (*p.GetMetadataResponse,
 	return &p.GetMetadataResponse{NotificationVersion: notificationVersion}, nil
 }
 
+func (m *cassandraMetadataPersistenceV2) CreateClusterMetadata(request *p.CreateClusterMetadataRequest) error {
+	query := m.session.Query(templateCreateClusterMetadataQuery,
+		request.Info.ClusterName,
+		request.Info.InitialFailoverVersion,
+		request.Info.RPCName,
+		request.Info.RPCAddress,
+		request.Info.Enabled,
+	)
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CreateClusterMetadata operation failed. Error: %v", err),
+		}
+	}
+	if !applied {
+		return &workflow.BadRequestError{
+			Message: fmt.Sprintf("Cluster %v already exists.", request.Info.ClusterName),
+		}
+	}
+	return nil
+}
+
+func (m *cassandraMetadataPersistenceV2) UpdateClusterMetadata(request *p.UpdateClusterMetadataRequest) error {
+	query := m.session.Query(templateUpdateClusterMetadataQuery,
+		request.Info.InitialFailoverVersion,
+		request.Info.RPCName,
+		request.Info.RPCAddress,
+		request.Info.Enabled,
+		request.Info.ClusterName,
+	)
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateClusterMetadata operation failed. Error: %v", err),
+		}
+	}
+	if !applied {
+		return &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Cluster %v does not exist.", request.Info.ClusterName),
+		}
+	}
+	return nil
+}
+
+func (m *cassandraMetadataPersistenceV2) ListClusterMetadata() (*p.ListClusterMetadataResponse, error) {
+	iter := m.session.Query(templateListClusterMetadataQuery).Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "ListClusterMetadata operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &p.ListClusterMetadataResponse{}
+	info := &p.ClusterMetadataInfo{}
+	for iter.Scan(&info.ClusterName, &info.InitialFailoverVersion, &info.RPCName, &info.RPCAddress, &info.Enabled) {
+		response.Clusters = append(response.Clusters, info)
+		info = &p.ClusterMetadataInfo{}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListClusterMetadata operation failed. Error: %v", err),
+		}
+	}
+	return response, nil
+}
+
 func (m *cassandraMetadataPersistenceV2) updateMetadataBatch(batch *gocql.Batch, notificationVersion int64) {
 	var nextVersion int64 = 1
 	var currentVersion *int64
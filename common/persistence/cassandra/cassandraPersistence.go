@@ -108,6 +108,7 @@ const (
 		`timer_ack_level: ?, ` +
 		`cluster_transfer_ack_level: ?, ` +
 		`cluster_timer_ack_level: ?, ` +
+		`cluster_replication_level: ?, ` +
 		`domain_notification_version: ? ` +
 		`}`
 
@@ -161,7 +162,8 @@ const (
 		`event_store_version: ?, ` +
 		`branch_token: ?, ` +
 		`cron_schedule: ?, ` +
-		`expiration_seconds: ? ` +
+		`expiration_seconds: ?, ` +
+		`priority: ? ` +
 		`}`
 
 	templateReplicationStateType = `{` +
@@ -316,14 +318,21 @@ const (
 		`name: ?, ` +
 		`type: ?, ` +
 		`ack_level: ?, ` +
-		`kind: ? ` +
+		`kind: ?, ` +
+		`dispatch_rate: ?, ` +
+		`paused: ?, ` +
+		`last_updated: ?, ` +
+		`pollers: ? ` +
 		`}`
 
 	templateTaskType = `{` +
 		`domain_id: ?, ` +
 		`workflow_id: ?, ` +
 		`run_id: ?, ` +
-		`schedule_id: ?` +
+		`schedule_id: ?, ` +
+		`created_time: ?, ` +
+		`expiry: ?, ` +
+		`priority: ?` +
 		`}`
 
 	templateCreateShardQuery = `INSERT INTO executions (` +
@@ -832,6 +841,20 @@ workflow_state = ? ` +
 		`and type = ? ` +
 		`and task_id = ?`
 
+	// the task list rows of a page of task lists are read at once, the partitions of every combination
+	// of the names and types of the page are read, so rows of task lists not in the page are ignored
+	templateGetTaskListsQuery = `SELECT ` +
+		`task_list_name, ` +
+		`task_list_type, ` +
+		`range_id, ` +
+		`task_list ` +
+		`FROM tasks ` +
+		`WHERE domain_id = ? ` +
+		`and task_list_name IN ? ` +
+		`and task_list_type IN ? ` +
+		`and type = ? ` +
+		`and task_id = ?`
+
 	templateInsertTaskListQuery = `INSERT INTO tasks (` +
 		`domain_id, ` +
 		`task_list_name, ` +
@@ -852,6 +875,17 @@ workflow_state = ? ` +
 		`and task_id = ? ` +
 		`IF range_id = ?`
 
+	// CreateTasks only fences on the range_id, the task list metadata including its pollers is
+	// written by UpdateTaskList
+	templateUpdateTaskListRangeIDQuery = `UPDATE tasks SET ` +
+		`range_id = ? ` +
+		`WHERE domain_id = ? ` +
+		`and task_list_name = ? ` +
+		`and task_list_type = ? ` +
+		`and type = ? ` +
+		`and task_id = ? ` +
+		`IF range_id = ?`
+
 	templateUpdateTaskListQueryWithTTL = `INSERT INTO tasks (` +
 		`domain_id, ` +
 		`task_list_name, ` +
@@ -861,6 +895,33 @@ workflow_state = ? ` +
 		`range_id, ` +
 		`task_list ` +
 		`) VALUES (?, ?, ?, ?, ?, ?, ` + templateTaskListType + `) USING TTL ?`
+
+	// the task list rows of a domain are spread over the partitions of its task lists, so listing them
+	// scans the tasks table
+	templateInsertTaskListByDomainQuery = `INSERT INTO task_lists_by_domain (` +
+		`domain_id, task_list_name, task_list_type) ` +
+		`VALUES (?, ?, ?)`
+
+	templateInsertTaskListByDomainWithTTLQuery = templateInsertTaskListByDomainQuery + ` USING TTL ?`
+
+	templateListTaskListsByDomainQuery = `SELECT ` +
+		`task_list_name, ` +
+		`task_list_type ` +
+		`FROM task_lists_by_domain ` +
+		`WHERE domain_id = ?`
+
+	templateDeleteTaskListByDomainQuery = `DELETE FROM task_lists_by_domain ` +
+		`WHERE domain_id = ? ` +
+		`and task_list_name = ? ` +
+		`and task_list_type = ?`
+
+	templateDeleteTaskListQuery = `DELETE FROM tasks ` +
+		`WHERE domain_id = ? ` +
+		`and task_list_name = ? ` +
+		`and task_list_type = ? ` +
+		`and type = ? ` +
+		`and task_id = ? ` +
+		`IF range_id = ?`
 )
 
 var (
@@ -880,9 +941,15 @@ type (
 		shardID            int
 		currentClusterName string
 	}
+
+	// taskListKey identifies a task list of a domain
+	taskListKey struct {
+		name     string
+		taskType int
+	}
 )
 
-//NewWorkflowExecutionPersistenceFromSession returns new ExecutionStore
+// NewWorkflowExecutionPersistenceFromSession returns new ExecutionStore
 func NewWorkflowExecutionPersistenceFromSession(session *gocql.Session, shardID int, logger bark.Logger) p.ExecutionStore {
 	return &cassandraPersistence{cassandraStore: cassandraStore{session: session, logger: logger}, shardID: shardID}
 }
@@ -965,6 +1032,7 @@ func (d *cassandraPersistence) CreateShard(request *p.CreateShardRequest) error
 		shardInfo.TimerAckLevel,
 		shardInfo.ClusterTransferAckLevel,
 		shardInfo.ClusterTimerAckLevel,
+		shardInfo.ClusterReplicationLevel,
 		shardInfo.DomainNotificationVersion,
 		shardInfo.RangeID)
 
@@ -1040,6 +1108,7 @@ func (d *cassandraPersistence) UpdateShard(request *p.UpdateShardRequest) error
 		shardInfo.TimerAckLevel,
 		shardInfo.ClusterTransferAckLevel,
 		shardInfo.ClusterTimerAckLevel,
+		shardInfo.ClusterReplicationLevel,
 		shardInfo.DomainNotificationVersion,
 		shardInfo.RangeID,
 		shardInfo.ShardID,
@@ -1373,6 +1442,7 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *p.Cre
 			request.BranchToken,
 			request.CronSchedule,
 			request.ExpirationSeconds,
+			request.Priority,
 			request.NextEventID,
 			defaultVisibilityTimestamp,
 			rowTypeExecutionTaskID)
@@ -1438,6 +1508,7 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *p.Cre
 			request.BranchToken,
 			request.CronSchedule,
 			request.ExpirationSeconds,
+			request.Priority,
 			request.ReplicationState.CurrentVersion,
 			request.ReplicationState.StartVersion,
 			request.ReplicationState.LastWriteVersion,
@@ -1623,6 +1694,7 @@ func (d *cassandraPersistence) updateMutableState(batch *gocql.Batch, executionI
 			executionInfo.BranchToken,
 			executionInfo.CronSchedule,
 			executionInfo.ExpirationSeconds,
+			executionInfo.Priority,
 			executionInfo.NextEventID,
 			d.shardID,
 			rowTypeExecution,
@@ -1688,6 +1760,7 @@ func (d *cassandraPersistence) updateMutableState(batch *gocql.Batch, executionI
 			executionInfo.BranchToken,
 			executionInfo.CronSchedule,
 			executionInfo.ExpirationSeconds,
+			executionInfo.Priority,
 			replicationState.CurrentVersion,
 			replicationState.StartVersion,
 			replicationState.LastWriteVersion,
@@ -2471,7 +2544,11 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 		rowTypeTaskList,
 		taskListTaskID,
 	)
+	now := time.Now()
 	var rangeID, ackLevel int64
+	var dispatchRate float64
+	var paused bool
+	var pollers []*p.TaskListPollerInfo
 	var tlDB map[string]interface{}
 	err := query.Scan(&rangeID, &tlDB)
 	if err != nil {
@@ -2488,6 +2565,10 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 				request.TaskType,
 				0,
 				request.TaskListKind,
+				0.0,
+				false,
+				now,
+				nil,
 			)
 		} else if isThrottlingError(err) {
 			return nil, &workflow.ServiceBusyError{
@@ -2503,6 +2584,11 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 	} else {
 		ackLevel = tlDB["ack_level"].(int64)
 		taskListKind := tlDB["kind"].(int)
+		// dispatch_rate and paused are null for task lists created before they were added
+		dispatchRate, _ = tlDB["dispatch_rate"].(float64)
+		paused, _ = tlDB["paused"].(bool)
+		// pollers seen by the previous owner are kept so the new owner can restore them
+		pollers = createTaskListPollers(tlDB["pollers"])
 		query = d.session.Query(templateUpdateTaskListQuery,
 			rangeID+1,
 			request.DomainID,
@@ -2510,6 +2596,10 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 			request.TaskType,
 			ackLevel,
 			taskListKind,
+			dispatchRate,
+			paused,
+			now,
+			createTaskListPollersList(pollers),
 			request.DomainID,
 			&request.TaskList,
 			request.TaskType,
@@ -2536,7 +2626,36 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 			Msg: fmt.Sprintf("LeaseTaskList failed to apply. db rangeID %v", previousRangeID),
 		}
 	}
-	tli := &p.TaskListInfo{DomainID: request.DomainID, Name: request.TaskList, TaskType: request.TaskType, RangeID: rangeID + 1, AckLevel: ackLevel, Kind: request.TaskListKind}
+	// the task list is indexed on every lease, so that the task lists created before the index are indexed too
+	if request.TaskListKind == p.TaskListKindSticky {
+		query = d.session.Query(templateInsertTaskListByDomainWithTTLQuery,
+			request.DomainID, request.TaskList, request.TaskType, stickyTaskListTTL)
+	} else {
+		query = d.session.Query(templateInsertTaskListByDomainQuery,
+			request.DomainID, request.TaskList, request.TaskType)
+	}
+	if err := query.Exec(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("LeaseTaskList operation failed to index task list. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("LeaseTaskList operation failed to index task list. Error: %v", err),
+		}
+	}
+	tli := &p.TaskListInfo{
+		DomainID:     request.DomainID,
+		Name:         request.TaskList,
+		TaskType:     request.TaskType,
+		RangeID:      rangeID + 1,
+		AckLevel:     ackLevel,
+		Kind:         request.TaskListKind,
+		DispatchRate: dispatchRate,
+		Paused:       paused,
+		LastUpdated:  now,
+		Pollers:      pollers,
+	}
 	return &p.LeaseTaskListResponse{TaskListInfo: tli}, nil
 }
 
@@ -2557,6 +2676,10 @@ func (d *cassandraPersistence) UpdateTaskList(request *p.UpdateTaskListRequest)
 			tli.TaskType,
 			tli.AckLevel,
 			tli.Kind,
+			tli.DispatchRate,
+			tli.Paused,
+			time.Now(),
+			createTaskListPollersList(tli.Pollers),
 			stickyTaskListTTL,
 		)
 		err := query.Exec()
@@ -2580,6 +2703,10 @@ func (d *cassandraPersistence) UpdateTaskList(request *p.UpdateTaskListRequest)
 		tli.TaskType,
 		tli.AckLevel,
 		tli.Kind,
+		tli.DispatchRate,
+		tli.Paused,
+		time.Now(),
+		createTaskListPollersList(tli.Pollers),
 		tli.DomainID,
 		&tli.Name,
 		tli.TaskType,
@@ -2616,14 +2743,211 @@ func (d *cassandraPersistence) UpdateTaskList(request *p.UpdateTaskListRequest)
 	return &p.UpdateTaskListResponse{}, nil
 }
 
+// From TaskManager interface
+// The task lists are indexed by domain when they are leased, so the task lists which were not leased
+// since the upgrade to schema v0.24 are only listed once they are loaded again.
+func (d *cassandraPersistence) ListTaskLists(request *p.ListTaskListsRequest) (*p.ListTaskListsResponse, error) {
+	query := d.session.Query(templateListTaskListsByDomainQuery, request.DomainID)
+	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "ListTaskLists operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	var keys []taskListKey
+	var name string
+	var taskType int
+	for iter.Scan(&name, &taskType) {
+		keys = append(keys, taskListKey{name: name, taskType: taskType})
+	}
+	nextPageToken := iter.PageState()
+	response := &p.ListTaskListsResponse{
+		NextPageToken: make([]byte, len(nextPageToken)),
+	}
+	copy(response.NextPageToken, nextPageToken)
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("ListTaskLists operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListTaskLists operation failed. Error: %v", err),
+		}
+	}
+	if len(keys) == 0 {
+		return response, nil
+	}
+
+	infos, err := d.getTaskLists(request.DomainID, keys)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		// the index is not updated atomically with the task list, it may point at a deleted or expired one
+		if info, ok := infos[key]; ok {
+			response.Items = append(response.Items, info)
+		}
+	}
+	return response, nil
+}
+
+// getTaskLists reads the given task lists of a domain without leasing them, the task lists which do
+// not exist are missing from the result
+func (d *cassandraPersistence) getTaskLists(domainID string, keys []taskListKey) (map[taskListKey]*p.TaskListInfo, error) {
+	var names []string
+	var taskTypes []int
+	seenNames := make(map[string]struct{})
+	seenTaskTypes := make(map[int]struct{})
+	for _, key := range keys {
+		if _, ok := seenNames[key.name]; !ok {
+			seenNames[key.name] = struct{}{}
+			names = append(names, key.name)
+		}
+		if _, ok := seenTaskTypes[key.taskType]; !ok {
+			seenTaskTypes[key.taskType] = struct{}{}
+			taskTypes = append(taskTypes, key.taskType)
+		}
+	}
+
+	query := d.session.Query(templateGetTaskListsQuery,
+		domainID,
+		names,
+		taskTypes,
+		rowTypeTaskList,
+		taskListTaskID,
+	)
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "ListTaskLists operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	infos := make(map[taskListKey]*p.TaskListInfo, len(keys))
+	for {
+		var name string
+		var taskType int
+		var rangeID int64
+		var tlDB map[string]interface{}
+		if !iter.Scan(&name, &taskType, &rangeID, &tlDB) {
+			break
+		}
+		infos[taskListKey{name: name, taskType: taskType}] = createTaskListInfo(domainID, name, taskType, rangeID, tlDB)
+	}
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("ListTaskLists operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListTaskLists operation failed. Error: %v", err),
+		}
+	}
+	return infos, nil
+}
+
+// From TaskManager interface
+func (d *cassandraPersistence) GetTaskList(request *p.GetTaskListRequest) (*p.GetTaskListResponse, error) {
+	info, err := d.getTaskList(request.DomainID, request.TaskList, request.TaskType)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Task list %v of type %v does not exist", request.TaskList, request.TaskType),
+		}
+	}
+	return &p.GetTaskListResponse{TaskListInfo: info}, nil
+}
+
+// getTaskList reads a task list without leasing it, it returns nil if the task list does not exist
+func (d *cassandraPersistence) getTaskList(domainID string, name string, taskType int) (*p.TaskListInfo, error) {
+	query := d.session.Query(templateGetTaskList,
+		domainID,
+		name,
+		taskType,
+		rowTypeTaskList,
+		taskListTaskID,
+	)
+	var rangeID int64
+	var tlDB map[string]interface{}
+	if err := query.Scan(&rangeID, &tlDB); err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, nil
+		}
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("Failed to read task list. TaskList: %v, TaskType: %v, Error: %v",
+					name, taskType, err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Failed to read task list. TaskList: %v, TaskType: %v, Error: %v",
+				name, taskType, err),
+		}
+	}
+
+	return createTaskListInfo(domainID, name, taskType, rangeID, tlDB), nil
+}
+
+// From TaskManager interface
+func (d *cassandraPersistence) DeleteTaskList(request *p.DeleteTaskListRequest) error {
+	query := d.session.Query(templateDeleteTaskListQuery,
+		request.DomainID,
+		request.TaskListName,
+		request.TaskListType,
+		rowTypeTaskList,
+		taskListTaskID,
+		request.RangeID,
+	)
+
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteTaskList operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteTaskList operation failed. Error: %v", err),
+		}
+	}
+
+	if !applied {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("Failed to delete task list. name: %v, type: %v, rangeID: %v, previous rangeID: %v",
+				request.TaskListName, request.TaskListType, request.RangeID, previous["range_id"]),
+		}
+	}
+
+	query = d.session.Query(templateDeleteTaskListByDomainQuery,
+		request.DomainID,
+		request.TaskListName,
+		request.TaskListType,
+	)
+	if err := query.Exec(); err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteTaskList operation failed to remove task list from index. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteTaskList operation failed to remove task list from index. Error: %v", err),
+		}
+	}
+	return nil
+}
+
 // From TaskManager interface
 func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.CreateTasksResponse, error) {
 	batch := d.session.NewBatch(gocql.LoggedBatch)
 	domainID := request.TaskListInfo.DomainID
 	taskList := request.TaskListInfo.Name
 	taskListType := request.TaskListInfo.TaskType
-	taskListKind := request.TaskListInfo.Kind
-	ackLevel := request.TaskListInfo.AckLevel
 
 	for _, task := range request.Tasks {
 		scheduleID := task.Data.ScheduleID
@@ -2637,7 +2961,10 @@ func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.Cr
 				domainID,
 				task.Execution.GetWorkflowId(),
 				task.Execution.GetRunId(),
-				scheduleID)
+				scheduleID,
+				task.Data.CreatedTime,
+				task.Data.Expiry,
+				task.Data.Priority)
 		} else {
 			batch.Query(templateCreateTaskWithTTLQuery,
 				domainID,
@@ -2649,21 +2976,19 @@ func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.Cr
 				task.Execution.GetWorkflowId(),
 				task.Execution.GetRunId(),
 				scheduleID,
+				task.Data.CreatedTime,
+				task.Data.Expiry,
+				task.Data.Priority,
 				task.Data.ScheduleToStartTimeout)
 		}
 	}
 
 	// The following query is used to ensure that range_id didn't change
-	batch.Query(templateUpdateTaskListQuery,
+	batch.Query(templateUpdateTaskListRangeIDQuery,
 		request.TaskListInfo.RangeID,
 		domainID,
 		taskList,
 		taskListType,
-		ackLevel,
-		taskListKind,
-		domainID,
-		taskList,
-		taskListType,
 		rowTypeTaskList,
 		taskListTaskID,
 		request.TaskListInfo.RangeID,
@@ -3511,6 +3836,8 @@ func createShardInfo(currentCluster string, result map[string]interface{}) *p.Sh
 			info.ClusterTransferAckLevel = v.(map[string]int64)
 		case "cluster_timer_ack_level":
 			info.ClusterTimerAckLevel = v.(map[string]time.Time)
+		case "cluster_replication_level":
+			info.ClusterReplicationLevel = v.(map[string]int64)
 		case "domain_notification_version":
 			info.DomainNotificationVersion = v.(int64)
 		}
@@ -3526,6 +3853,9 @@ func createShardInfo(currentCluster string, result map[string]interface{}) *p.Sh
 			currentCluster: info.TimerAckLevel,
 		}
 	}
+	if info.ClusterReplicationLevel == nil {
+		info.ClusterReplicationLevel = make(map[string]int64)
+	}
 
 	return info
 }
@@ -3636,6 +3966,8 @@ func createWorkflowExecutionInfo(result map[string]interface{}) *p.InternalWorkf
 			info.CronSchedule = v.(string)
 		case "expiration_seconds":
 			info.ExpirationSeconds = int32(v.(int))
+		case "priority":
+			info.Priority = int32(v.(int))
 		}
 	}
 	info.CompletionEvent = p.NewDataBlob(completionEventData, completionEventEncoding)
@@ -4086,6 +4418,12 @@ func createTaskInfo(result map[string]interface{}) *p.TaskInfo {
 			info.RunID = v.(gocql.UUID).String()
 		case "schedule_id":
 			info.ScheduleID = v.(int64)
+		case "created_time":
+			info.CreatedTime = v.(time.Time)
+		case "expiry":
+			info.Expiry = v.(time.Time)
+		case "priority":
+			info.Priority = int32(v.(int))
 		}
 	}
 
@@ -4144,6 +4482,68 @@ func createReplicationInfoMap(info *p.ReplicationInfo) map[string]interface{} {
 	return rInfoMap
 }
 
+func createTaskListInfo(domainID string, name string, taskType int, rangeID int64,
+	tlDB map[string]interface{}) *p.TaskListInfo {
+	info := &p.TaskListInfo{
+		DomainID: domainID,
+		Name:     name,
+		TaskType: taskType,
+		RangeID:  rangeID,
+		AckLevel: tlDB["ack_level"].(int64),
+		Kind:     tlDB["kind"].(int),
+	}
+	// the fields below are null for task lists not updated since they were added
+	info.DispatchRate, _ = tlDB["dispatch_rate"].(float64)
+	info.Paused, _ = tlDB["paused"].(bool)
+	info.LastUpdated, _ = tlDB["last_updated"].(time.Time)
+	info.Pollers = createTaskListPollers(tlDB["pollers"])
+	return info
+}
+
+func createTaskListPollers(result interface{}) []*p.TaskListPollerInfo {
+	// pollers is null for task lists not updated since it was added
+	pollers, _ := result.([]map[string]interface{})
+	var infos []*p.TaskListPollerInfo
+	for _, poller := range pollers {
+		info := &p.TaskListPollerInfo{}
+		for k, v := range poller {
+			switch k {
+			case "identity":
+				info.Identity = v.(string)
+			case "binary_checksum":
+				info.BinaryChecksum = v.(string)
+			case "sdk_version":
+				info.SDKVersion = v.(string)
+			case "hostname":
+				info.Hostname = v.(string)
+			case "rate_per_second":
+				info.RatePerSecond = v.(float64)
+			case "last_access_time":
+				info.LastAccessTime = v.(time.Time)
+			}
+		}
+		infos = append(infos, info)
+	}
+
+	return infos
+}
+
+func createTaskListPollersList(infos []*p.TaskListPollerInfo) []map[string]interface{} {
+	pollers := make([]map[string]interface{}, 0, len(infos))
+	for _, info := range infos {
+		poller := make(map[string]interface{})
+		poller["identity"] = info.Identity
+		poller["binary_checksum"] = info.BinaryChecksum
+		poller["sdk_version"] = info.SDKVersion
+		poller["hostname"] = info.Hostname
+		poller["rate_per_second"] = info.RatePerSecond
+		poller["last_access_time"] = info.LastAccessTime
+		pollers = append(pollers, poller)
+	}
+
+	return pollers
+}
+
 func isTimeoutError(err error) bool {
 	if err == gocql.ErrTimeoutNoResponse {
 		return true
@@ -192,6 +192,7 @@ type (
 		ClusterTimerAckLevel      map[string]time.Time
 		TransferFailoverLevels    map[string]TransferFailoverLevel // uuid -> TransferFailoverLevel
 		TimerFailoverLevels       map[string]TimerFailoverLevel    // uuid -> TimerFailoverLevel
+		ClusterReplicationLevel   map[string]int64                 // cluster -> last replication task ID pulled and applied
 		DomainNotificationVersion int64
 	}
 
@@ -266,6 +267,8 @@ type (
 		BranchToken       []byte
 		CronSchedule      string
 		ExpirationSeconds int32
+		// Priority of the decision tasks of the workflow
+		Priority int32
 	}
 
 	// ReplicationState represents mutable state information for global domains.
@@ -339,6 +342,23 @@ type (
 		RangeID  int64
 		AckLevel int64
 		Kind     int
+		// DispatchRate is set by an operator and takes precedence over the rate of pollers, 0 if not set
+		DispatchRate float64
+		// Paused task lists keep accepting tasks but do not dispatch them
+		Paused      bool
+		LastUpdated time.Time
+		// Pollers recently seen by the owner, kept so they survive a change of ownership
+		Pollers []*TaskListPollerInfo
+	}
+
+	// TaskListPollerInfo describes a poller recently seen on a task list
+	TaskListPollerInfo struct {
+		Identity       string
+		BinaryChecksum string
+		SDKVersion     string
+		Hostname       string
+		RatePerSecond  float64
+		LastAccessTime time.Time
 	}
 
 	// TaskInfo describes either activity or decision task
@@ -349,6 +369,12 @@ type (
 		TaskID                 int64
 		ScheduleID             int64
 		ScheduleToStartTimeout int32
+		// CreatedTime is when matching accepted the task
+		CreatedTime time.Time
+		// Expiry is when the task stops being dispatchable, zero if the task never expires
+		Expiry time.Time
+		// Priority of the task, tasks of higher priority are dispatched first
+		Priority int32
 	}
 
 	// Task is the generic interface for workflow tasks
@@ -676,6 +702,8 @@ type (
 		BranchToken       []byte
 		CronSchedule      string
 		ExpirationSeconds int32
+		// Priority of the decision tasks of the workflow
+		Priority int32
 	}
 
 	// CreateWorkflowExecutionResponse is the response to CreateWorkflowExecutionRequest
@@ -915,6 +943,40 @@ type (
 		TaskID   int64
 	}
 
+	// GetTaskListRequest is used to read a task list without leasing it
+	GetTaskListRequest struct {
+		DomainID string
+		TaskList string
+		TaskType int
+	}
+
+	// GetTaskListResponse is the response to GetTaskList
+	GetTaskListResponse struct {
+		TaskListInfo *TaskListInfo
+	}
+
+	// ListTaskListsRequest is used to page through the task lists of a domain
+	ListTaskListsRequest struct {
+		DomainID      string
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// ListTaskListsResponse is the response to ListTaskLists
+	ListTaskListsResponse struct {
+		Items         []*TaskListInfo
+		NextPageToken []byte
+	}
+
+	// DeleteTaskListRequest is used to delete a task list, the delete fails with ConditionFailedError
+	// if the task list was leased again since RangeID was read
+	DeleteTaskListRequest struct {
+		DomainID     string
+		TaskListName string
+		TaskListType int
+		RangeID      int64
+	}
+
 	// GetTimerIndexTasksRequest is the request for GetTimerIndexTasks
 	// TODO: replace this with an iterator that can configure min and max index.
 	GetTimerIndexTasksRequest struct {
@@ -1016,6 +1078,14 @@ type (
 	DomainReplicationConfig struct {
 		ActiveClusterName string
 		Clusters          []*ClusterReplicationConfig
+		// PendingActiveClusterName, FailoverStartTime and FailoverExpireTime (unix nano) are set
+		// on the active cluster while a graceful failover of the domain is in progress
+		PendingActiveClusterName string
+		FailoverStartTime        int64
+		FailoverExpireTime       int64
+		// FailoverMarkers contains shard ID -> replication task ID, which replication to the pending
+		// active cluster has to pass before the graceful failover completes
+		FailoverMarkers map[int32]int64
 	}
 
 	// ClusterReplicationConfig describes the cross DC cluster replication configuration
@@ -1096,6 +1166,70 @@ type (
 		NotificationVersion int64
 	}
 
+	// ClusterMetadataInfo describes a cluster taking part in cross DC replication
+	ClusterMetadataInfo struct {
+		ClusterName            string
+		InitialFailoverVersion int64
+		RPCName                string
+		RPCAddress             string
+		Enabled                bool
+	}
+
+	// CreateClusterMetadataRequest is used to add a cluster to the metadata store
+	CreateClusterMetadataRequest struct {
+		Info *ClusterMetadataInfo
+	}
+
+	// UpdateClusterMetadataRequest is used to update an existing cluster in the metadata store
+	UpdateClusterMetadataRequest struct {
+		Info *ClusterMetadataInfo
+	}
+
+	// ListClusterMetadataResponse is the response for ListClusterMetadata
+	ListClusterMetadataResponse struct {
+		Clusters []*ClusterMetadataInfo
+	}
+
+	// ConflictResolutionAuditInfo records a conflict resolution which reset the mutable state of a workflow because
+	// its history diverged between clusters. Events after the reset event are discarded in favor of the events
+	// replicated from the source cluster.
+	ConflictResolutionAuditInfo struct {
+		AuditID               string
+		DomainID              string
+		WorkflowID            string
+		RunID                 string
+		SourceCluster         string
+		ResolvedTime          time.Time
+		Reason                string
+		ResetEventID          int64
+		DiscardedNextEventID  int64
+		DiscardedStartVersion int64
+		DiscardedEndVersion   int64
+		AdoptedFirstEventID   int64
+		AdoptedNextEventID    int64
+		AdoptedStartVersion   int64
+		AdoptedEndVersion     int64
+	}
+
+	// CreateConflictResolutionAuditRequest is used to record a conflict resolution
+	CreateConflictResolutionAuditRequest struct {
+		Info *ConflictResolutionAuditInfo
+	}
+
+	// ListConflictResolutionAuditsRequest is used to list the conflict resolutions of a workflow, latest first
+	ListConflictResolutionAuditsRequest struct {
+		DomainID      string
+		WorkflowID    string
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// ListConflictResolutionAuditsResponse is the response for ListConflictResolutionAudits
+	ListConflictResolutionAuditsResponse struct {
+		Audits        []*ConflictResolutionAuditInfo
+		NextPageToken []byte
+	}
+
 	// MutableStateStats is the size stats for MutableState
 	MutableStateStats struct {
 		// Total size of mutable state
@@ -1336,6 +1470,9 @@ type (
 		CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error)
 		GetTasks(request *GetTasksRequest) (*GetTasksResponse, error)
 		CompleteTask(request *CompleteTaskRequest) error
+		GetTaskList(request *GetTaskListRequest) (*GetTaskListResponse, error)
+		ListTaskLists(request *ListTaskListsRequest) (*ListTaskListsResponse, error)
+		DeleteTaskList(request *DeleteTaskListRequest) error
 	}
 
 	// HistoryManager is used to manage Workflow Execution HistoryEventBatch
@@ -1353,6 +1490,10 @@ type (
 		GetWorkflowExecutionHistoryByBatch(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryByBatchResponse, error)
 		//Deprecated: use v2 API-DeleteHistoryBranch instead
 		DeleteWorkflowExecutionHistory(request *DeleteWorkflowExecutionHistoryRequest) error
+		// CreateConflictResolutionAudit records a reset of the history of a workflow which diverged between clusters
+		CreateConflictResolutionAudit(request *CreateConflictResolutionAuditRequest) error
+		// ListConflictResolutionAudits returns the conflict resolutions of a workflow, latest first
+		ListConflictResolutionAudits(request *ListConflictResolutionAuditsRequest) (*ListConflictResolutionAuditsResponse, error)
 	}
 
 	// HistoryV2Manager is used to manager workflow history events
@@ -1392,6 +1533,9 @@ type (
 		DeleteDomainByName(request *DeleteDomainByNameRequest) error
 		ListDomains(request *ListDomainsRequest) (*ListDomainsResponse, error)
 		GetMetadata() (*GetMetadataResponse, error)
+		CreateClusterMetadata(request *CreateClusterMetadataRequest) error
+		UpdateClusterMetadata(request *UpdateClusterMetadataRequest) error
+		ListClusterMetadata() (*ListClusterMetadataResponse, error)
 	}
 )
 
@@ -218,6 +218,15 @@ func (m *historyManagerImpl) DeleteWorkflowExecutionHistory(request *DeleteWorkf
 	return m.persistence.DeleteWorkflowExecutionHistory(request)
 }
 
+func (m *historyManagerImpl) CreateConflictResolutionAudit(request *CreateConflictResolutionAuditRequest) error {
+	return m.persistence.CreateConflictResolutionAudit(request)
+}
+
+func (m *historyManagerImpl) ListConflictResolutionAudits(
+	request *ListConflictResolutionAuditsRequest) (*ListConflictResolutionAuditsResponse, error) {
+	return m.persistence.ListConflictResolutionAudits(request)
+}
+
 func (m *historyManagerImpl) Close() {
 	m.persistence.Close()
 }
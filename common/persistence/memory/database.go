@@ -0,0 +1,162 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// database holds all the tables of a single in-memory database. A single
+	// lock guards all of them, which makes every store operation atomic and
+	// gives us the conditional update semantics of the real datastores for free
+	database struct {
+		sync.RWMutex
+		name string
+
+		shards     map[int]*p.ShardInfo
+		executions map[int]*shardExecutions
+
+		taskLists map[taskListKey]*p.TaskListInfo
+		tasks     map[taskListKey]map[int64]*p.TaskInfo
+
+		histories       map[executionKey]map[int64]*historyEventsRow
+		historyBranches map[string]map[string]*historyTreeRow
+		historyNodes    map[branchKey]map[int64]*historyNodeRow
+
+		domains                   map[string]*domainRow
+		domainIDsByName           map[string]string
+		domainNotificationVersion int64
+		clusters                  map[string]*p.ClusterMetadataInfo
+		conflictResolutionAudits  map[currentExecutionKey][]*p.ConflictResolutionAuditInfo
+		openVisibilityRecords     map[visibilityKey]*visibilityRecord
+		closedVisibilityRecords   map[visibilityKey]*visibilityRecord
+	}
+
+	// memoryStore is the base of all stores backed by the in-memory database
+	memoryStore struct {
+		db     *database
+		logger bark.Logger
+	}
+
+	executionKey struct {
+		domainID   string
+		workflowID string
+		runID      string
+	}
+
+	currentExecutionKey struct {
+		domainID   string
+		workflowID string
+	}
+
+	taskListKey struct {
+		domainID string
+		name     string
+		taskType int
+	}
+
+	branchKey struct {
+		treeID   string
+		branchID string
+	}
+
+	visibilityKey struct {
+		domainID string
+		runID    string
+	}
+)
+
+const (
+	storeName = "memory"
+)
+
+var (
+	databasesLock sync.Mutex
+	databases     = make(map[string]*database)
+)
+
+// DropDatabase removes all data held by the in-memory database with the given name
+func DropDatabase(name string) {
+	databasesLock.Lock()
+	defer databasesLock.Unlock()
+	delete(databases, name)
+}
+
+// getDatabase returns the in-memory database with the given name, creating it
+// if it does not exist yet
+func getDatabase(name string) *database {
+	databasesLock.Lock()
+	defer databasesLock.Unlock()
+	db, ok := databases[name]
+	if !ok {
+		db = newDatabase(name)
+		databases[name] = db
+	}
+	return db
+}
+
+func newDatabase(name string) *database {
+	return &database{
+		name:                     name,
+		shards:                   make(map[int]*p.ShardInfo),
+		executions:               make(map[int]*shardExecutions),
+		taskLists:                make(map[taskListKey]*p.TaskListInfo),
+		tasks:                    make(map[taskListKey]map[int64]*p.TaskInfo),
+		histories:                make(map[executionKey]map[int64]*historyEventsRow),
+		historyBranches:          make(map[string]map[string]*historyTreeRow),
+		historyNodes:             make(map[branchKey]map[int64]*historyNodeRow),
+		domains:                  make(map[string]*domainRow),
+		domainIDsByName:          make(map[string]string),
+		clusters:                 make(map[string]*p.ClusterMetadataInfo),
+		conflictResolutionAudits: make(map[currentExecutionKey][]*p.ConflictResolutionAuditInfo),
+		openVisibilityRecords:    make(map[visibilityKey]*visibilityRecord),
+		closedVisibilityRecords:  make(map[visibilityKey]*visibilityRecord),
+	}
+}
+
+func (m *memoryStore) GetName() string {
+	return storeName
+}
+
+func (m *memoryStore) Close() {
+}
+
+func serializePageToken(offset int64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(offset))
+	return b
+}
+
+func deserializePageToken(payload []byte) (int64, error) {
+	if len(payload) != 8 {
+		return 0, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Invalid token of %v length", len(payload)),
+		}
+	}
+	return int64(binary.LittleEndian.Uint64(payload)), nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"github.com/uber-common/bark"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	// Factory vends store objects backed by an in-memory database
+	Factory struct {
+		cfg         config.Memory
+		clusterName string
+		logger      bark.Logger
+		db          *database
+	}
+)
+
+// NewFactory returns an instance of a factory object which can be used to create
+// datastores backed by an in-memory database. All factories created with the same
+// database name within a process share the same data.
+func NewFactory(cfg config.Memory, clusterName string, logger bark.Logger) *Factory {
+	return &Factory{
+		cfg:         cfg,
+		clusterName: clusterName,
+		logger:      logger,
+		db:          getDatabase(cfg.DatabaseName),
+	}
+}
+
+// NewTaskStore returns a new task store
+func (f *Factory) NewTaskStore() (p.TaskStore, error) {
+	return newTaskPersistence(f.db, f.logger), nil
+}
+
+// NewShardStore returns a new shard store
+func (f *Factory) NewShardStore() (p.ShardStore, error) {
+	return newShardPersistence(f.db, f.clusterName, f.logger), nil
+}
+
+// NewHistoryStore returns a new history store
+func (f *Factory) NewHistoryStore() (p.HistoryStore, error) {
+	return newHistoryPersistence(f.db, f.logger), nil
+}
+
+// NewHistoryV2Store returns a new historyV2 store
+func (f *Factory) NewHistoryV2Store() (p.HistoryV2Store, error) {
+	return newHistoryV2Persistence(f.db, f.logger), nil
+}
+
+// NewMetadataStore returns a new metadata store
+func (f *Factory) NewMetadataStore() (p.MetadataStore, error) {
+	return newMetadataPersistenceV2(f.db, f.clusterName, f.logger), nil
+}
+
+// NewMetadataStoreV1 returns the default metadatastore
+func (f *Factory) NewMetadataStoreV1() (p.MetadataStore, error) {
+	return f.NewMetadataStore()
+}
+
+// NewMetadataStoreV2 returns the default metadatastore
+func (f *Factory) NewMetadataStoreV2() (p.MetadataStore, error) {
+	return f.NewMetadataStore()
+}
+
+// NewExecutionStore returns an ExecutionStore for a given shardID
+func (f *Factory) NewExecutionStore(shardID int) (p.ExecutionStore, error) {
+	return newExecutionPersistence(f.db, shardID, f.logger), nil
+}
+
+// NewVisibilityStore returns a visibility store
+func (f *Factory) NewVisibilityStore() (p.VisibilityStore, error) {
+	return newVisibilityPersistence(f.db, f.logger), nil
+}
+
+// Close closes the factory. The data held by the underlying database is retained
+// until the database is explicitly dropped.
+func (f *Factory) Close() {
+}
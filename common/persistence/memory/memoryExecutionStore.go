@@ -0,0 +1,898 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// Implements ExecutionStore
+	memoryExecutionStore struct {
+		memoryStore
+		shardID int
+	}
+
+	// shardExecutions holds all the execution related rows owned by a single shard
+	shardExecutions struct {
+		executions        map[executionKey]*p.InternalWorkflowMutableState
+		currentExecutions map[currentExecutionKey]*currentExecutionRow
+		transferTasks     map[int64]*p.TransferTaskInfo
+		replicationTasks  map[int64]*p.ReplicationTaskInfo
+		timerTasks        map[timerTaskKey]*p.TimerTaskInfo
+	}
+
+	currentExecutionRow struct {
+		runID            string
+		createRequestID  string
+		state            int
+		closeStatus      int
+		startVersion     int64
+		lastWriteVersion int64
+	}
+
+	timerTaskKey struct {
+		visibilityTimestamp int64
+		taskID              int64
+	}
+
+	timerTaskPageToken struct {
+		TaskID    int64
+		Timestamp time.Time
+	}
+)
+
+func newExecutionPersistence(db *database, shardID int, logger bark.Logger) p.ExecutionStore {
+	db.Lock()
+	defer db.Unlock()
+
+	if _, ok := db.executions[shardID]; !ok {
+		db.executions[shardID] = &shardExecutions{
+			executions:        make(map[executionKey]*p.InternalWorkflowMutableState),
+			currentExecutions: make(map[currentExecutionKey]*currentExecutionRow),
+			transferTasks:     make(map[int64]*p.TransferTaskInfo),
+			replicationTasks:  make(map[int64]*p.ReplicationTaskInfo),
+			timerTasks:        make(map[timerTaskKey]*p.TimerTaskInfo),
+		}
+	}
+
+	return &memoryExecutionStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+		shardID: shardID,
+	}
+}
+
+func (e *memoryExecutionStore) GetShardID() int {
+	return e.shardID
+}
+
+// shard returns the rows owned by this shard, the caller must hold the database lock
+func (e *memoryExecutionStore) shard() *shardExecutions {
+	return e.db.executions[e.shardID]
+}
+
+func (e *memoryExecutionStore) CreateWorkflowExecution(request *p.CreateWorkflowExecutionRequest) (*p.CreateWorkflowExecutionResponse, error) {
+	if request.CreateWorkflowMode == p.CreateWorkflowModeContinueAsNew {
+		return nil, &workflow.InternalServiceError{
+			Message: "CreateWorkflowExecution operation failed. Invalid CreateWorkflowModeContinueAsNew is used",
+		}
+	}
+
+	e.db.Lock()
+	defer e.db.Unlock()
+
+	if err := e.db.assertShardOwnership(e.shardID, request.RangeID, "CreateWorkflowExecution"); err != nil {
+		return nil, err
+	}
+	if err := e.validateCreateWorkflowExecution(request); err != nil {
+		return nil, err
+	}
+
+	e.createWorkflowExecution(request)
+	e.createReplicationTasks(request.ReplicationTasks, request.DomainID, request.Execution.GetWorkflowId(),
+		request.Execution.GetRunId())
+	return &p.CreateWorkflowExecutionResponse{}, nil
+}
+
+// validateCreateWorkflowExecution checks the current execution row against the creation mode,
+// the caller must hold the database lock
+func (e *memoryExecutionStore) validateCreateWorkflowExecution(request *p.CreateWorkflowExecutionRequest) error {
+	shard := e.shard()
+	workflowID := request.Execution.GetWorkflowId()
+	runID := request.Execution.GetRunId()
+	current, ok := shard.currentExecutions[currentExecutionKey{domainID: request.DomainID, workflowID: workflowID}]
+
+	switch request.CreateWorkflowMode {
+	case p.CreateWorkflowModeBrandNew:
+		if ok {
+			lastWriteVersion := common.EmptyVersion
+			if request.ReplicationState != nil {
+				lastWriteVersion = current.lastWriteVersion
+			}
+			return &p.WorkflowExecutionAlreadyStartedError{
+				Msg:              fmt.Sprintf("Workflow execution already running. WorkflowId: %v", workflowID),
+				StartRequestID:   current.createRequestID,
+				RunID:            current.runID,
+				State:            current.state,
+				CloseStatus:      current.closeStatus,
+				LastWriteVersion: lastWriteVersion,
+			}
+		}
+	case p.CreateWorkflowModeWorkflowIDReuse:
+		if !ok {
+			return &p.CurrentWorkflowConditionFailedError{
+				Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, current execution not found",
+					workflowID),
+			}
+		}
+		if request.PreviousLastWriteVersion != current.lastWriteVersion {
+			return &p.CurrentWorkflowConditionFailedError{
+				Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
+					"LastWriteVersion: %v, PreviousLastWriteVersion: %v",
+					workflowID, current.lastWriteVersion, request.PreviousLastWriteVersion),
+			}
+		}
+		if current.state != p.WorkflowStateCompleted {
+			return &p.CurrentWorkflowConditionFailedError{
+				Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
+					"State: %v, Expected: %v",
+					workflowID, current.state, p.WorkflowStateCompleted),
+			}
+		}
+		if current.runID != request.PreviousRunID {
+			return &p.CurrentWorkflowConditionFailedError{
+				Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
+					"RunID: %v, PreviousRunID: %v",
+					workflowID, current.runID, request.PreviousRunID),
+			}
+		}
+	case p.CreateWorkflowModeContinueAsNew:
+		if !ok || current.runID != request.PreviousRunID {
+			return &p.CurrentWorkflowConditionFailedError{
+				Msg: fmt.Sprintf("ContinueAsNew failed. WorkflowId: %v, expected current run ID %v",
+					workflowID, request.PreviousRunID),
+			}
+		}
+	default:
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Unknown workflow creation mode: %v", request.CreateWorkflowMode),
+		}
+	}
+
+	if _, ok := shard.executions[executionKey{domainID: request.DomainID, workflowID: workflowID, runID: runID}]; ok {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("Workflow execution already exists. WorkflowId: %v, RunId: %v", workflowID, runID),
+		}
+	}
+	return nil
+}
+
+// createWorkflowExecution inserts the execution, points the current execution to it and creates
+// its transfer and timer tasks, the caller must hold the database lock and validate the request
+func (e *memoryExecutionStore) createWorkflowExecution(request *p.CreateWorkflowExecutionRequest) {
+	shard := e.shard()
+	now := time.Now()
+	workflowID := request.Execution.GetWorkflowId()
+	runID := request.Execution.GetRunId()
+
+	parentDomainID := ""
+	parentWorkflowID := ""
+	parentRunID := ""
+	initiatedID := common.EmptyEventID
+	state := p.WorkflowStateRunning
+	if request.ParentExecution != nil {
+		parentDomainID = request.ParentDomainID
+		parentWorkflowID = request.ParentExecution.GetWorkflowId()
+		parentRunID = request.ParentExecution.GetRunId()
+		initiatedID = request.InitiatedID
+		state = p.WorkflowStateCreated
+	}
+
+	executionInfo := &p.InternalWorkflowExecutionInfo{
+		DomainID:             request.DomainID,
+		WorkflowID:           workflowID,
+		RunID:                runID,
+		ParentDomainID:       parentDomainID,
+		ParentWorkflowID:     parentWorkflowID,
+		ParentRunID:          parentRunID,
+		InitiatedID:          initiatedID,
+		TaskList:             request.TaskList,
+		WorkflowTypeName:     request.WorkflowTypeName,
+		WorkflowTimeout:      request.WorkflowTimeout,
+		DecisionTimeoutValue: request.DecisionTimeoutValue,
+		ExecutionContext:     copyBytes(request.ExecutionContext),
+		State:                p.WorkflowStateCreated,
+		CloseStatus:          p.WorkflowCloseStatusNone,
+		LastFirstEventID:     common.FirstEventID,
+		NextEventID:          request.NextEventID,
+		LastProcessedEvent:   request.LastProcessedEvent,
+		StartTimestamp:       now,
+		LastUpdatedTimestamp: now,
+		CreateRequestID:      request.RequestID,
+		SignalCount:          request.SignalCount,
+		HistorySize:          request.HistorySize,
+		DecisionVersion:      request.DecisionVersion,
+		DecisionScheduleID:   request.DecisionScheduleID,
+		DecisionStartedID:    request.DecisionStartedID,
+		DecisionTimeout:      request.DecisionStartToCloseTimeout,
+		Attempt:              request.Attempt,
+		HasRetryPolicy:       request.HasRetryPolicy,
+		InitialInterval:      request.InitialInterval,
+		BackoffCoefficient:   request.BackoffCoefficient,
+		MaximumInterval:      request.MaximumInterval,
+		ExpirationTime:       request.ExpirationTime,
+		MaximumAttempts:      request.MaximumAttempts,
+		NonRetriableErrors:   copyStrings(request.NonRetriableErrors),
+		EventStoreVersion:    request.EventStoreVersion,
+		BranchToken:          copyBytes(request.BranchToken),
+		CronSchedule:         request.CronSchedule,
+		ExpirationSeconds:    request.ExpirationSeconds,
+		Priority:             request.Priority,
+	}
+	shard.executions[executionKey{domainID: request.DomainID, workflowID: workflowID, runID: runID}] =
+		newMutableState(executionInfo, copyReplicationState(request.ReplicationState))
+
+	current := &currentExecutionRow{
+		runID:            runID,
+		createRequestID:  request.RequestID,
+		state:            state,
+		closeStatus:      p.WorkflowCloseStatusNone,
+		startVersion:     common.EmptyVersion,
+		lastWriteVersion: common.EmptyVersion,
+	}
+	if request.ReplicationState != nil {
+		current.startVersion = request.ReplicationState.StartVersion
+		current.lastWriteVersion = request.ReplicationState.LastWriteVersion
+	}
+	shard.currentExecutions[currentExecutionKey{domainID: request.DomainID, workflowID: workflowID}] = current
+
+	e.createTransferTasks(request.TransferTasks, request.DomainID, workflowID, runID)
+	e.createTimerTasks(request.TimerTasks, nil, request.DomainID, workflowID, runID)
+}
+
+func (e *memoryExecutionStore) GetWorkflowExecution(request *p.GetWorkflowExecutionRequest) (*p.InternalGetWorkflowExecutionResponse, error) {
+	e.db.RLock()
+	defer e.db.RUnlock()
+
+	key := executionKey{
+		domainID:   request.DomainID,
+		workflowID: request.Execution.GetWorkflowId(),
+		runID:      request.Execution.GetRunId(),
+	}
+	state, ok := e.shard().executions[key]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+				request.Execution.GetWorkflowId(), request.Execution.GetRunId()),
+		}
+	}
+	return &p.InternalGetWorkflowExecutionResponse{State: copyMutableState(state)}, nil
+}
+
+func (e *memoryExecutionStore) UpdateWorkflowExecution(request *p.InternalUpdateWorkflowExecutionRequest) error {
+	executionInfo := request.ExecutionInfo
+
+	e.db.Lock()
+	defer e.db.Unlock()
+
+	if err := e.db.assertShardOwnership(e.shardID, request.RangeID, "UpdateWorkflowExecution"); err != nil {
+		return err
+	}
+	if request.ContinueAsNew != nil {
+		if err := e.validateCreateWorkflowExecution(request.ContinueAsNew); err != nil {
+			return err
+		}
+	} else if err := e.assertCurrentRunID(executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID,
+		"UpdateWorkflowExecution"); err != nil {
+		return err
+	}
+	state, err := e.getExecutionWithCondition(executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID,
+		request.Condition, "UpdateWorkflowExecution")
+	if err != nil {
+		return err
+	}
+
+	state.ExecutionInfo = copyExecutionInfo(executionInfo)
+	state.ExecutionInfo.LastUpdatedTimestamp = time.Now()
+	if request.ReplicationState != nil {
+		state.ReplicationState = copyReplicationState(request.ReplicationState)
+	}
+
+	for _, ai := range request.UpsertActivityInfos {
+		state.ActivitInfos[ai.ScheduleID] = copyActivityInfo(ai)
+	}
+	for _, scheduleID := range request.DeleteActivityInfos {
+		delete(state.ActivitInfos, scheduleID)
+	}
+	for _, ti := range request.UpserTimerInfos {
+		state.TimerInfos[ti.TimerID] = copyTimerInfo(ti)
+	}
+	for _, timerID := range request.DeleteTimerInfos {
+		delete(state.TimerInfos, timerID)
+	}
+	for _, ci := range request.UpsertChildExecutionInfos {
+		state.ChildExecutionInfos[ci.InitiatedID] = copyChildExecutionInfo(ci)
+	}
+	if request.DeleteChildExecutionInfo != nil {
+		delete(state.ChildExecutionInfos, *request.DeleteChildExecutionInfo)
+	}
+	for _, rci := range request.UpsertRequestCancelInfos {
+		state.RequestCancelInfos[rci.InitiatedID] = copyRequestCancelInfo(rci)
+	}
+	if request.DeleteRequestCancelInfo != nil {
+		delete(state.RequestCancelInfos, *request.DeleteRequestCancelInfo)
+	}
+	for _, si := range request.UpsertSignalInfos {
+		state.SignalInfos[si.InitiatedID] = copySignalInfo(si)
+	}
+	if request.DeleteSignalInfo != nil {
+		delete(state.SignalInfos, *request.DeleteSignalInfo)
+	}
+	for _, signalRequestedID := range request.UpsertSignalRequestedIDs {
+		state.SignalRequestedIDs[signalRequestedID] = struct{}{}
+	}
+	if request.DeleteSignalRequestedID != "" {
+		delete(state.SignalRequestedIDs, request.DeleteSignalRequestedID)
+	}
+	if request.ClearBufferedEvents {
+		state.BufferedEvents = nil
+	} else if request.NewBufferedEvents != nil {
+		state.BufferedEvents = append(state.BufferedEvents, request.NewBufferedEvents)
+	}
+	if request.NewBufferedReplicationTask != nil {
+		task := copyBufferedReplicationTask(request.NewBufferedReplicationTask)
+		state.BufferedReplicationTasks[task.FirstEventID] = task
+	}
+	if request.DeleteBufferedReplicationTask != nil {
+		delete(state.BufferedReplicationTasks, *request.DeleteBufferedReplicationTask)
+	}
+
+	e.createTransferTasks(request.TransferTasks, executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID)
+	e.createReplicationTasks(request.ReplicationTasks, executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID)
+	e.createTimerTasks(request.TimerTasks, request.DeleteTimerTask, executionInfo.DomainID, executionInfo.WorkflowID,
+		executionInfo.RunID)
+
+	if request.ContinueAsNew != nil {
+		e.createWorkflowExecution(request.ContinueAsNew)
+	} else {
+		// NOTE: unlike cassandra, the current execution of a finished workflow is not expired by TTL
+		e.updateCurrentExecution(executionInfo, request.ReplicationState)
+	}
+	return nil
+}
+
+func (e *memoryExecutionStore) ResetMutableState(request *p.InternalResetMutableStateRequest) error {
+	executionInfo := request.ExecutionInfo
+
+	e.db.Lock()
+	defer e.db.Unlock()
+
+	if err := e.db.assertShardOwnership(e.shardID, request.RangeID, "ResetMutableState"); err != nil {
+		return err
+	}
+	if err := e.assertCurrentRunID(executionInfo.DomainID, executionInfo.WorkflowID, request.PrevRunID,
+		"ResetMutableState"); err != nil {
+		return err
+	}
+	state, err := e.getExecutionWithCondition(executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID,
+		request.Condition, "ResetMutableState")
+	if err != nil {
+		return err
+	}
+
+	replicationState := state.ReplicationState
+	if request.ReplicationState != nil {
+		replicationState = request.ReplicationState
+	}
+	newState := newMutableState(copyExecutionInfo(executionInfo), copyReplicationState(replicationState))
+	newState.ExecutionInfo.LastUpdatedTimestamp = time.Now()
+	insertMutableStateInfos(newState, request.InsertActivityInfos, request.InsertTimerInfos,
+		request.InsertChildExecutionInfos, request.InsertRequestCancelInfos, request.InsertSignalInfos,
+		request.InsertSignalRequestedIDs)
+	newState.BufferedReplicationTasks = state.BufferedReplicationTasks
+	e.shard().executions[executionKey{
+		domainID:   executionInfo.DomainID,
+		workflowID: executionInfo.WorkflowID,
+		runID:      executionInfo.RunID,
+	}] = newState
+
+	e.updateCurrentExecution(executionInfo, request.ReplicationState)
+	return nil
+}
+
+func (e *memoryExecutionStore) ResetWorkflowExecution(request *p.InternalResetWorkflowExecutionRequest) error {
+	currExecutionInfo := request.CurrExecutionInfo
+	insertExecutionInfo := request.InsertExecutionInfo
+
+	e.db.Lock()
+	defer e.db.Unlock()
+
+	if err := e.db.assertShardOwnership(e.shardID, request.RangeID, "ResetWorkflowExecution"); err != nil {
+		return err
+	}
+	if err := e.assertCurrentRunID(insertExecutionInfo.DomainID, insertExecutionInfo.WorkflowID, request.PrevRunID,
+		"ResetWorkflowExecution"); err != nil {
+		return err
+	}
+	currState, err := e.getExecutionWithCondition(currExecutionInfo.DomainID, currExecutionInfo.WorkflowID,
+		currExecutionInfo.RunID, request.Condition, "ResetWorkflowExecution")
+	if err != nil {
+		return err
+	}
+	insertKey := executionKey{
+		domainID:   insertExecutionInfo.DomainID,
+		workflowID: insertExecutionInfo.WorkflowID,
+		runID:      insertExecutionInfo.RunID,
+	}
+	if _, ok := e.shard().executions[insertKey]; ok {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("ResetWorkflowExecution operation failed. Workflow execution already exists. "+
+				"WorkflowId: %v, RunId: %v", insertExecutionInfo.WorkflowID, insertExecutionInfo.RunID),
+		}
+	}
+
+	if request.UpdateCurr {
+		currState.ExecutionInfo = copyExecutionInfo(currExecutionInfo)
+		currState.ExecutionInfo.LastUpdatedTimestamp = time.Now()
+		if request.CurrReplicationState != nil {
+			currState.ReplicationState = copyReplicationState(request.CurrReplicationState)
+		}
+		e.createTransferTasks(request.CurrTransferTasks, currExecutionInfo.DomainID, currExecutionInfo.WorkflowID,
+			currExecutionInfo.RunID)
+		e.createTimerTasks(request.CurrTimerTasks, nil, currExecutionInfo.DomainID, currExecutionInfo.WorkflowID,
+			currExecutionInfo.RunID)
+	}
+	e.createReplicationTasks(request.InsertReplicationTasks, currExecutionInfo.DomainID, currExecutionInfo.WorkflowID,
+		currExecutionInfo.RunID)
+
+	insertState := newMutableState(copyExecutionInfo(insertExecutionInfo), copyReplicationState(request.InsertReplicationState))
+	insertState.ExecutionInfo.LastUpdatedTimestamp = time.Now()
+	insertMutableStateInfos(insertState, request.InsertActivityInfos, request.InsertTimerInfos,
+		request.InsertChildExecutionInfos, request.InsertRequestCancelInfos, request.InsertSignalInfos,
+		request.InsertSignalRequestedIDs)
+	e.shard().executions[insertKey] = insertState
+	e.createTransferTasks(request.InsertTransferTasks, insertExecutionInfo.DomainID, insertExecutionInfo.WorkflowID,
+		insertExecutionInfo.RunID)
+	e.createTimerTasks(request.InsertTimerTasks, nil, insertExecutionInfo.DomainID, insertExecutionInfo.WorkflowID,
+		insertExecutionInfo.RunID)
+
+	e.updateCurrentExecution(insertExecutionInfo, request.InsertReplicationState)
+	return nil
+}
+
+func (e *memoryExecutionStore) DeleteWorkflowExecution(request *p.DeleteWorkflowExecutionRequest) error {
+	e.db.Lock()
+	defer e.db.Unlock()
+
+	delete(e.shard().executions, executionKey{
+		domainID:   request.DomainID,
+		workflowID: request.WorkflowID,
+		runID:      request.RunID,
+	})
+	return nil
+}
+
+func (e *memoryExecutionStore) GetCurrentExecution(request *p.GetCurrentExecutionRequest) (*p.GetCurrentExecutionResponse, error) {
+	e.db.RLock()
+	defer e.db.RUnlock()
+
+	current, ok := e.shard().currentExecutions[currentExecutionKey{domainID: request.DomainID, workflowID: request.WorkflowID}]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v", request.WorkflowID),
+		}
+	}
+	return &p.GetCurrentExecutionResponse{
+		StartRequestID: current.createRequestID,
+		RunID:          current.runID,
+		State:          current.state,
+		CloseStatus:    current.closeStatus,
+	}, nil
+}
+
+func (e *memoryExecutionStore) GetTransferTasks(request *p.GetTransferTasksRequest) (*p.GetTransferTasksResponse, error) {
+	readLevel := request.ReadLevel
+	if len(request.NextPageToken) > 0 {
+		var err error
+		if readLevel, err = deserializePageToken(request.NextPageToken); err != nil {
+			return nil, err
+		}
+	}
+
+	e.db.RLock()
+	defer e.db.RUnlock()
+
+	tasks := e.shard().transferTasks
+	taskIDs := make([]int64, 0, len(tasks))
+	for taskID := range tasks {
+		if taskID > readLevel && taskID <= request.MaxReadLevel {
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	sort.Slice(taskIDs, func(i, j int) bool { return taskIDs[i] < taskIDs[j] })
+
+	response := &p.GetTransferTasksResponse{}
+	for i, taskID := range taskIDs {
+		if request.BatchSize > 0 && i == request.BatchSize {
+			response.NextPageToken = serializePageToken(taskIDs[i-1])
+			break
+		}
+		task := *tasks[taskID]
+		response.Tasks = append(response.Tasks, &task)
+	}
+	return response, nil
+}
+
+func (e *memoryExecutionStore) CompleteTransferTask(request *p.CompleteTransferTaskRequest) error {
+	e.db.Lock()
+	defer e.db.Unlock()
+
+	delete(e.shard().transferTasks, request.TaskID)
+	return nil
+}
+
+func (e *memoryExecutionStore) RangeCompleteTransferTask(request *p.RangeCompleteTransferTaskRequest) error {
+	e.db.Lock()
+	defer e.db.Unlock()
+
+	tasks := e.shard().transferTasks
+	for taskID := range tasks {
+		if taskID > request.ExclusiveBeginTaskID && taskID <= request.InclusiveEndTaskID {
+			delete(tasks, taskID)
+		}
+	}
+	return nil
+}
+
+func (e *memoryExecutionStore) GetReplicationTasks(request *p.GetReplicationTasksRequest) (*p.GetReplicationTasksResponse, error) {
+	readLevel := request.ReadLevel
+	if len(request.NextPageToken) > 0 {
+		var err error
+		if readLevel, err = deserializePageToken(request.NextPageToken); err != nil {
+			return nil, err
+		}
+	}
+
+	e.db.RLock()
+	defer e.db.RUnlock()
+
+	tasks := e.shard().replicationTasks
+	taskIDs := make([]int64, 0, len(tasks))
+	for taskID := range tasks {
+		if taskID > readLevel && taskID <= request.MaxReadLevel {
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	sort.Slice(taskIDs, func(i, j int) bool { return taskIDs[i] < taskIDs[j] })
+
+	response := &p.GetReplicationTasksResponse{}
+	for i, taskID := range taskIDs {
+		if request.BatchSize > 0 && i == request.BatchSize {
+			response.NextPageToken = serializePageToken(taskIDs[i-1])
+			break
+		}
+		task := *tasks[taskID]
+		task.LastReplicationInfo = copyReplicationInfo(task.LastReplicationInfo)
+		response.Tasks = append(response.Tasks, &task)
+	}
+	return response, nil
+}
+
+func (e *memoryExecutionStore) CompleteReplicationTask(request *p.CompleteReplicationTaskRequest) error {
+	e.db.Lock()
+	defer e.db.Unlock()
+
+	delete(e.shard().replicationTasks, request.TaskID)
+	return nil
+}
+
+func (e *memoryExecutionStore) GetTimerIndexTasks(request *p.GetTimerIndexTasksRequest) (*p.GetTimerIndexTasksResponse, error) {
+	pageToken := &timerTaskPageToken{TaskID: math.MinInt64, Timestamp: request.MinTimestamp}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, pageToken); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("error deserializing timerTaskPageToken: %v", err),
+			}
+		}
+	}
+	begin := timerTaskKey{visibilityTimestamp: pageToken.Timestamp.UnixNano(), taskID: pageToken.TaskID}
+	maxTimestamp := request.MaxTimestamp.UnixNano()
+
+	e.db.RLock()
+	defer e.db.RUnlock()
+
+	tasks := e.shard().timerTasks
+	keys := make([]timerTaskKey, 0, len(tasks))
+	for key := range tasks {
+		if !key.less(begin) && key.visibilityTimestamp < maxTimestamp {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].less(keys[j]) })
+
+	response := &p.GetTimerIndexTasksResponse{}
+	for i, key := range keys {
+		task := tasks[key]
+		if request.BatchSize > 0 && i == request.BatchSize {
+			nextToken, err := json.Marshal(&timerTaskPageToken{
+				TaskID:    task.TaskID,
+				Timestamp: task.VisibilityTimestamp,
+			})
+			if err != nil {
+				return nil, &workflow.InternalServiceError{
+					Message: fmt.Sprintf("GetTimerTasks: error serializing page token: %v", err),
+				}
+			}
+			response.NextPageToken = nextToken
+			break
+		}
+		timer := *task
+		response.Timers = append(response.Timers, &timer)
+	}
+	return response, nil
+}
+
+func (e *memoryExecutionStore) CompleteTimerTask(request *p.CompleteTimerTaskRequest) error {
+	e.db.Lock()
+	defer e.db.Unlock()
+
+	delete(e.shard().timerTasks, timerTaskKey{
+		visibilityTimestamp: request.VisibilityTimestamp.UnixNano(),
+		taskID:              request.TaskID,
+	})
+	return nil
+}
+
+func (e *memoryExecutionStore) RangeCompleteTimerTask(request *p.RangeCompleteTimerTaskRequest) error {
+	begin := request.InclusiveBeginTimestamp.UnixNano()
+	end := request.ExclusiveEndTimestamp.UnixNano()
+
+	e.db.Lock()
+	defer e.db.Unlock()
+
+	tasks := e.shard().timerTasks
+	for key := range tasks {
+		if key.visibilityTimestamp >= begin && key.visibilityTimestamp < end {
+			delete(tasks, key)
+		}
+	}
+	return nil
+}
+
+// assertCurrentRunID checks the current execution points to the given run, the caller must hold the database lock
+func (e *memoryExecutionStore) assertCurrentRunID(domainID, workflowID, runID, operation string) error {
+	current, ok := e.shard().currentExecutions[currentExecutionKey{domainID: domainID, workflowID: workflowID}]
+	if !ok || current.runID != runID {
+		currentRunID := ""
+		if ok {
+			currentRunID = current.runID
+		}
+		return &p.CurrentWorkflowConditionFailedError{
+			Msg: fmt.Sprintf("%v operation failed. Current run ID was %v, expected %v", operation, currentRunID, runID),
+		}
+	}
+	return nil
+}
+
+// getExecutionWithCondition returns the stored mutable state if its next event ID matches the condition,
+// the caller must hold the database lock
+func (e *memoryExecutionStore) getExecutionWithCondition(domainID, workflowID, runID string, condition int64,
+	operation string) (*p.InternalWorkflowMutableState, error) {
+	state, ok := e.shard().executions[executionKey{domainID: domainID, workflowID: workflowID, runID: runID}]
+	if !ok {
+		return nil, &p.ConditionFailedError{
+			Msg: fmt.Sprintf("%v operation failed. Workflow execution not found. WorkflowId: %v, RunId: %v",
+				operation, workflowID, runID),
+		}
+	}
+	if state.ExecutionInfo.NextEventID != condition {
+		return nil, &p.ConditionFailedError{
+			Msg: fmt.Sprintf("%v operation failed. Current nextEventID: %v, Condition: %v",
+				operation, state.ExecutionInfo.NextEventID, condition),
+		}
+	}
+	return state, nil
+}
+
+// updateCurrentExecution points the current execution to the given run, the caller must hold the database lock
+func (e *memoryExecutionStore) updateCurrentExecution(executionInfo *p.InternalWorkflowExecutionInfo,
+	replicationState *p.ReplicationState) {
+	current := &currentExecutionRow{
+		runID:            executionInfo.RunID,
+		createRequestID:  executionInfo.CreateRequestID,
+		state:            executionInfo.State,
+		closeStatus:      executionInfo.CloseStatus,
+		startVersion:     common.EmptyVersion,
+		lastWriteVersion: common.EmptyVersion,
+	}
+	if replicationState != nil {
+		current.startVersion = replicationState.StartVersion
+		current.lastWriteVersion = replicationState.LastWriteVersion
+	}
+	e.shard().currentExecutions[currentExecutionKey{
+		domainID:   executionInfo.DomainID,
+		workflowID: executionInfo.WorkflowID,
+	}] = current
+}
+
+// createTransferTasks stores the transfer tasks of an execution, the caller must hold the database lock
+func (e *memoryExecutionStore) createTransferTasks(transferTasks []p.Task, domainID, workflowID, runID string) {
+	for _, task := range transferTasks {
+		info := &p.TransferTaskInfo{
+			DomainID:            domainID,
+			WorkflowID:          workflowID,
+			RunID:               runID,
+			VisibilityTimestamp: task.GetVisibilityTimestamp(),
+			TaskID:              task.GetTaskID(),
+			TargetDomainID:      domainID,
+			TargetWorkflowID:    p.TransferTaskTransferTargetWorkflowID,
+			TargetRunID:         p.TransferTaskTransferTargetRunID,
+			TaskType:            task.GetType(),
+			Version:             task.GetVersion(),
+		}
+
+		switch t := task.(type) {
+		case *p.ActivityTask:
+			info.TargetDomainID = t.DomainID
+			info.TaskList = t.TaskList
+			info.ScheduleID = t.ScheduleID
+
+		case *p.DecisionTask:
+			info.TargetDomainID = t.DomainID
+			info.TaskList = t.TaskList
+			info.ScheduleID = t.ScheduleID
+			info.RecordVisibility = t.RecordVisibility
+
+		case *p.CancelExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			if t.TargetRunID != "" {
+				info.TargetRunID = t.TargetRunID
+			}
+			info.TargetChildWorkflowOnly = t.TargetChildWorkflowOnly
+			info.ScheduleID = t.InitiatedID
+
+		case *p.SignalExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			if t.TargetRunID != "" {
+				info.TargetRunID = t.TargetRunID
+			}
+			info.TargetChildWorkflowOnly = t.TargetChildWorkflowOnly
+			info.ScheduleID = t.InitiatedID
+
+		case *p.StartChildExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			info.ScheduleID = t.InitiatedID
+		}
+
+		e.shard().transferTasks[info.TaskID] = info
+	}
+}
+
+// createReplicationTasks stores the replication tasks of an execution, the caller must hold the database lock
+func (e *memoryExecutionStore) createReplicationTasks(replicationTasks []p.Task, domainID, workflowID, runID string) {
+	for _, task := range replicationTasks {
+		info := &p.ReplicationTaskInfo{
+			DomainID:           domainID,
+			WorkflowID:         workflowID,
+			RunID:              runID,
+			TaskID:             task.GetTaskID(),
+			TaskType:           task.GetType(),
+			FirstEventID:       common.EmptyEventID,
+			NextEventID:        common.EmptyEventID,
+			Version:            common.EmptyVersion,
+			ScheduledID:        common.EmptyEventID,
+			NewRunFirstEventID: common.EmptyEventID,
+			NewRunNextEventID:  common.EmptyEventID,
+		}
+
+		switch t := task.(type) {
+		case *p.HistoryReplicationTask:
+			info.FirstEventID = t.FirstEventID
+			info.NextEventID = t.NextEventID
+			info.Version = t.Version
+			info.LastReplicationInfo = copyReplicationInfo(t.LastReplicationInfo)
+			info.EventStoreVersion = t.EventStoreVersion
+			info.BranchToken = copyBytes(t.BranchToken)
+			info.ResetWorkflow = t.ResetWorkflow
+			info.NewRunFirstEventID = t.NewRunFirstEventID
+			info.NewRunNextEventID = t.NewRunNextEventID
+			info.NewRunEventStoreVersion = t.NewRunEventStoreVersion
+			info.NewRunBranchToken = copyBytes(t.NewRunBranchToken)
+
+		case *p.SyncActivityTask:
+			info.Version = t.Version
+			info.ScheduledID = t.ScheduledID
+		}
+
+		e.shard().replicationTasks[info.TaskID] = info
+	}
+}
+
+// createTimerTasks stores the timer tasks of an execution and removes the given completed one,
+// the caller must hold the database lock
+func (e *memoryExecutionStore) createTimerTasks(timerTasks []p.Task, deleteTimerTask p.Task, domainID, workflowID,
+	runID string) {
+	shard := e.shard()
+	for _, task := range timerTasks {
+		info := &p.TimerTaskInfo{
+			DomainID:            domainID,
+			WorkflowID:          workflowID,
+			RunID:               runID,
+			VisibilityTimestamp: task.GetVisibilityTimestamp(),
+			TaskID:              task.GetTaskID(),
+			TaskType:            task.GetType(),
+			Version:             task.GetVersion(),
+		}
+
+		switch t := task.(type) {
+		case *p.DecisionTimeoutTask:
+			info.EventID = t.EventID
+			info.TimeoutType = t.TimeoutType
+			info.ScheduleAttempt = t.ScheduleAttempt
+		case *p.ActivityTimeoutTask:
+			info.EventID = t.EventID
+			info.TimeoutType = t.TimeoutType
+			info.ScheduleAttempt = t.Attempt
+		case *p.UserTimerTask:
+			info.EventID = t.EventID
+		case *p.ActivityRetryTimerTask:
+			info.EventID = t.EventID
+			info.ScheduleAttempt = int64(t.Attempt)
+		case *p.WorkflowBackoffTimerTask:
+			info.EventID = t.EventID
+			info.TimeoutType = t.TimeoutType
+		}
+
+		shard.timerTasks[timerTaskKey{
+			visibilityTimestamp: info.VisibilityTimestamp.UnixNano(),
+			taskID:              info.TaskID,
+		}] = info
+	}
+
+	if deleteTimerTask != nil {
+		delete(shard.timerTasks, timerTaskKey{
+			visibilityTimestamp: deleteTimerTask.GetVisibilityTimestamp().UnixNano(),
+			taskID:              deleteTimerTask.GetTaskID(),
+		})
+	}
+}
+
+func (k timerTaskKey) less(other timerTaskKey) bool {
+	if k.visibilityTimestamp == other.visibilityTimestamp {
+		return k.taskID < other.taskID
+	}
+	return k.visibilityTimestamp < other.visibilityTimestamp
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	memoryHistoryStore struct {
+		memoryStore
+	}
+
+	historyEventsRow struct {
+		eventBatchVersion int64
+		rangeID           int64
+		transactionID     int64
+		events            *p.DataBlob
+	}
+)
+
+func newHistoryPersistence(db *database, logger bark.Logger) p.HistoryStore {
+	return &memoryHistoryStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}
+}
+
+func (m *memoryHistoryStore) AppendHistoryEvents(request *p.InternalAppendHistoryEventsRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := executionKey{
+		domainID:   request.DomainID,
+		workflowID: request.Execution.GetWorkflowId(),
+		runID:      request.Execution.GetRunId(),
+	}
+	batches, ok := m.db.histories[key]
+	if !ok {
+		batches = make(map[int64]*historyEventsRow)
+		m.db.histories[key] = batches
+	}
+
+	row, exists := batches[request.FirstEventID]
+	if request.Overwrite {
+		if !exists || row.rangeID > request.RangeID || row.transactionID >= request.TransactionID {
+			return &p.ConditionFailedError{
+				Msg: "Failed to append history events.",
+			}
+		}
+	} else if exists {
+		return &p.ConditionFailedError{
+			Msg: "Failed to append history events.",
+		}
+	}
+
+	batches[request.FirstEventID] = &historyEventsRow{
+		eventBatchVersion: request.EventBatchVersion,
+		rangeID:           request.RangeID,
+		transactionID:     request.TransactionID,
+		events:            request.Events,
+	}
+	return nil
+}
+
+func (m *memoryHistoryStore) GetWorkflowExecutionHistory(request *p.InternalGetWorkflowExecutionHistoryRequest) (
+	*p.InternalGetWorkflowExecutionHistoryResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	execution := request.Execution
+	key := executionKey{
+		domainID:   request.DomainID,
+		workflowID: execution.GetWorkflowId(),
+		runID:      execution.GetRunId(),
+	}
+
+	firstEventID := request.FirstEventID
+	if len(request.NextPageToken) > 0 {
+		var err error
+		if firstEventID, err = deserializePageToken(request.NextPageToken); err != nil {
+			return nil, err
+		}
+	}
+
+	batches := m.db.histories[key]
+	var eventIDs []int64
+	for eventID := range batches {
+		if eventID >= firstEventID && eventID < request.NextEventID {
+			eventIDs = append(eventIDs, eventID)
+		}
+	}
+	sort.Slice(eventIDs, func(i, j int) bool { return eventIDs[i] < eventIDs[j] })
+
+	if len(eventIDs) == 0 && len(request.NextPageToken) == 0 {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution history not found.  WorkflowId: %v, RunId: %v",
+				execution.GetWorkflowId(), execution.GetRunId()),
+		}
+	}
+
+	var nextPageToken []byte
+	if request.PageSize > 0 && len(eventIDs) > request.PageSize {
+		nextPageToken = serializePageToken(eventIDs[request.PageSize])
+		eventIDs = eventIDs[:request.PageSize]
+	}
+
+	//NOTE: in this method, we need to make sure is NOT decreasing(otherwise we skip the events)
+	lastEventBatchVersion := request.LastEventBatchVersion
+	history := make([]*p.DataBlob, 0, len(eventIDs))
+	for _, eventID := range eventIDs {
+		row := batches[eventID]
+		if row.eventBatchVersion >= lastEventBatchVersion {
+			history = append(history, row.events)
+			lastEventBatchVersion = row.eventBatchVersion
+		}
+	}
+
+	return &p.InternalGetWorkflowExecutionHistoryResponse{
+		History:               history,
+		NextPageToken:         nextPageToken,
+		LastEventBatchVersion: lastEventBatchVersion,
+	}, nil
+}
+
+func (m *memoryHistoryStore) DeleteWorkflowExecutionHistory(request *p.DeleteWorkflowExecutionHistoryRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	execution := request.Execution
+	delete(m.db.histories, executionKey{
+		domainID:   request.DomainID,
+		workflowID: execution.GetWorkflowId(),
+		runID:      execution.GetRunId(),
+	})
+	return nil
+}
+
+// CreateConflictResolutionAudit records a conflict resolution of a workflow
+func (m *memoryHistoryStore) CreateConflictResolutionAudit(request *p.CreateConflictResolutionAuditRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	info := *request.Info
+	key := currentExecutionKey{domainID: info.DomainID, workflowID: info.WorkflowID}
+	audits := append(m.db.conflictResolutionAudits[key], &info)
+	sort.SliceStable(audits, func(i, j int) bool {
+		return audits[i].ResolvedTime.After(audits[j].ResolvedTime)
+	})
+	m.db.conflictResolutionAudits[key] = audits
+	return nil
+}
+
+// ListConflictResolutionAudits returns the conflict resolutions of a workflow, latest first
+func (m *memoryHistoryStore) ListConflictResolutionAudits(
+	request *p.ListConflictResolutionAuditsRequest) (*p.ListConflictResolutionAuditsResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	offset := int64(0)
+	if len(request.NextPageToken) > 0 {
+		var err error
+		if offset, err = deserializePageToken(request.NextPageToken); err != nil {
+			return nil, err
+		}
+	}
+
+	audits := m.db.conflictResolutionAudits[currentExecutionKey{domainID: request.DomainID, workflowID: request.WorkflowID}]
+	response := &p.ListConflictResolutionAuditsResponse{}
+	for i := int(offset); i < len(audits); i++ {
+		if len(response.Audits) == request.PageSize {
+			response.NextPageToken = serializePageToken(int64(i))
+			break
+		}
+		info := *audits[i]
+		response.Audits = append(response.Audits, &info)
+	}
+	return response, nil
+}
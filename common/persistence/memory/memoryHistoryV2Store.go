@@ -0,0 +1,345 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	memoryHistoryV2Store struct {
+		memoryStore
+	}
+
+	historyTreeRow struct {
+		ancestors  []*workflow.HistoryBranchRange
+		inProgress bool
+		forkTime   time.Time
+		info       string
+	}
+
+	historyNodeRow struct {
+		transactionID int64
+		events        *p.DataBlob
+	}
+)
+
+func newHistoryV2Persistence(db *database, logger bark.Logger) p.HistoryV2Store {
+	return &memoryHistoryV2Store{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}
+}
+
+// AppendHistoryNodes upsert a batch of events as a single node to a history branch
+// Note that it's not allowed to append above the branch's ancestors' nodes, which means nodeID >= ForkNodeID
+func (h *memoryHistoryV2Store) AppendHistoryNodes(request *p.InternalAppendHistoryNodesRequest) error {
+	branchInfo := request.BranchInfo
+	beginNodeID := getBeginNodeID(branchInfo)
+
+	if request.NodeID < beginNodeID {
+		return &p.InvalidPersistenceRequestError{
+			Msg: fmt.Sprintf("cannot append to ancestors' nodes"),
+		}
+	}
+
+	h.db.Lock()
+	defer h.db.Unlock()
+
+	if request.IsNewBranch {
+		h.insertBranch(branchInfo.GetTreeID(), branchInfo.GetBranchID(), &historyTreeRow{
+			ancestors:  copyBranchRanges(branchInfo.Ancestors),
+			inProgress: false,
+			forkTime:   time.Now(),
+			info:       request.Info,
+		})
+	}
+
+	key := branchKey{treeID: branchInfo.GetTreeID(), branchID: branchInfo.GetBranchID()}
+	nodes, ok := h.db.historyNodes[key]
+	if !ok {
+		nodes = make(map[int64]*historyNodeRow)
+		h.db.historyNodes[key] = nodes
+	}
+	// a node written by a larger transaction always wins over the smaller ones
+	if node, ok := nodes[request.NodeID]; !ok || node.transactionID <= request.TransactionID {
+		nodes[request.NodeID] = &historyNodeRow{
+			transactionID: request.TransactionID,
+			events:        request.Events,
+		}
+	}
+	return nil
+}
+
+// ReadHistoryBranch returns history node data for a branch
+// NOTE: all the nodes within the requested range are returned in a single page
+func (h *memoryHistoryV2Store) ReadHistoryBranch(request *p.InternalReadHistoryBranchRequest) (*p.InternalReadHistoryBranchResponse, error) {
+	h.db.RLock()
+	defer h.db.RUnlock()
+
+	nodes := h.db.historyNodes[branchKey{treeID: request.TreeID, branchID: request.BranchID}]
+	var nodeIDs []int64
+	for nodeID := range nodes {
+		if nodeID >= request.MinNodeID && nodeID < request.MaxNodeID {
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+	}
+	sort.Slice(nodeIDs, func(i, j int) bool { return nodeIDs[i] < nodeIDs[j] })
+
+	history := make([]*p.DataBlob, 0, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		history = append(history, nodes[nodeID].events)
+	}
+	return &p.InternalReadHistoryBranchResponse{
+		History: history,
+	}, nil
+}
+
+// ForkHistoryBranch forks a new branch from an existing old branch
+// See the cassandra implementation for a detailed description of how the ancestors are computed
+func (h *memoryHistoryV2Store) ForkHistoryBranch(request *p.InternalForkHistoryBranchRequest) (*p.InternalForkHistoryBranchResponse, error) {
+	forkB := request.ForkBranchInfo
+	treeID := forkB.GetTreeID()
+	newAncestors := make([]*workflow.HistoryBranchRange, 0, len(forkB.Ancestors)+1)
+
+	beginNodeID := getBeginNodeID(forkB)
+	if beginNodeID >= request.ForkNodeID {
+		// this is the case that new branch's ancestors doesn't include the forking branch
+		for _, br := range forkB.Ancestors {
+			if br.GetEndNodeID() >= request.ForkNodeID {
+				newAncestors = append(newAncestors, &workflow.HistoryBranchRange{
+					BranchID:    br.BranchID,
+					BeginNodeID: br.BeginNodeID,
+					EndNodeID:   common.Int64Ptr(request.ForkNodeID),
+				})
+				break
+			}
+			newAncestors = append(newAncestors, br)
+		}
+	} else {
+		// this is the case the new branch will inherit all ancestors from forking branch
+		newAncestors = append(newAncestors, forkB.Ancestors...)
+		newAncestors = append(newAncestors, &workflow.HistoryBranchRange{
+			BranchID:    forkB.BranchID,
+			BeginNodeID: common.Int64Ptr(beginNodeID),
+			EndNodeID:   common.Int64Ptr(request.ForkNodeID),
+		})
+	}
+
+	h.db.Lock()
+	defer h.db.Unlock()
+
+	// NOTE: To prevent leaking event data caused by forking, the branch is marked as in progress
+	h.insertBranch(treeID, request.NewBranchID, &historyTreeRow{
+		ancestors:  copyBranchRanges(newAncestors),
+		inProgress: true,
+		forkTime:   time.Now(),
+		info:       request.Info,
+	})
+
+	return &p.InternalForkHistoryBranchResponse{
+		NewBranchInfo: workflow.HistoryBranch{
+			TreeID:    common.StringPtr(treeID),
+			BranchID:  common.StringPtr(request.NewBranchID),
+			Ancestors: newAncestors,
+		},
+	}, nil
+}
+
+// CompleteForkBranch marks the forking as done, or removes the branch if the fork failed
+func (h *memoryHistoryV2Store) CompleteForkBranch(request *p.InternalCompleteForkBranchRequest) error {
+	treeID := request.BranchInfo.GetTreeID()
+	branchID := request.BranchInfo.GetBranchID()
+
+	h.db.Lock()
+	defer h.db.Unlock()
+
+	if request.Success {
+		if branch, ok := h.db.historyBranches[treeID][branchID]; ok {
+			branch.inProgress = false
+		}
+		return nil
+	}
+
+	h.deleteBranch(treeID, branchID)
+	h.deleteNodes(treeID, branchID, 1)
+	return nil
+}
+
+// DeleteHistoryBranch removes a branch along with all the nodes that are not referred by other branches
+func (h *memoryHistoryV2Store) DeleteHistoryBranch(request *p.InternalDeleteHistoryBranchRequest) error {
+	branch := request.BranchInfo
+	treeID := branch.GetTreeID()
+	brsToDelete := append([]*workflow.HistoryBranchRange{}, branch.Ancestors...)
+	brsToDelete = append(brsToDelete, &workflow.HistoryBranchRange{
+		BranchID:    branch.BranchID,
+		BeginNodeID: common.Int64Ptr(getBeginNodeID(branch)),
+	})
+
+	h.db.Lock()
+	defer h.db.Unlock()
+
+	// We won't delete the branch if there is any branch forking in progress.
+	for _, row := range h.db.historyBranches[treeID] {
+		if row.inProgress {
+			return &p.ConditionFailedError{
+				Msg: fmt.Sprintf("Some branch is in progress of forking"),
+			}
+		}
+	}
+
+	h.deleteBranch(treeID, branch.GetBranchID())
+
+	// validBRsMaxEndNode is the max nodeID of each branch that is still referred by the remaining branches
+	validBRsMaxEndNode := map[string]int64{}
+	for _, row := range h.db.historyBranches[treeID] {
+		for _, br := range row.ancestors {
+			curr, ok := validBRsMaxEndNode[br.GetBranchID()]
+			if !ok || curr < br.GetEndNodeID() {
+				validBRsMaxEndNode[br.GetBranchID()] = br.GetEndNodeID()
+			}
+		}
+	}
+
+	// for each branch range to delete, we iterate from bottom to up, and delete up to the point according to validBRsMaxEndNode
+	for i := len(brsToDelete) - 1; i >= 0; i-- {
+		br := brsToDelete[i]
+		if _, ok := h.db.historyBranches[treeID][br.GetBranchID()]; ok {
+			// the branch is still alive, so are all of its nodes and the ones of its ancestors
+			break
+		}
+		if maxReferredEndNodeID, ok := validBRsMaxEndNode[br.GetBranchID()]; ok {
+			// we can only delete from the maxEndNode and stop here
+			h.deleteNodes(treeID, br.GetBranchID(), maxReferredEndNodeID)
+			break
+		}
+		// No any branch is using this range, we can delete all of it
+		h.deleteNodes(treeID, br.GetBranchID(), br.GetBeginNodeID())
+	}
+	return nil
+}
+
+// GetHistoryTree returns all branch information of a tree
+func (h *memoryHistoryV2Store) GetHistoryTree(request *p.GetHistoryTreeRequest) (*p.GetHistoryTreeResponse, error) {
+	treeID := request.TreeID
+
+	h.db.RLock()
+	defer h.db.RUnlock()
+
+	branches := make([]*workflow.HistoryBranch, 0)
+	forkingBranches := make([]p.ForkingInProgressBranch, 0)
+	for branchID, row := range h.db.historyBranches[treeID] {
+		if row.inProgress {
+			forkingBranches = append(forkingBranches, p.ForkingInProgressBranch{
+				BranchID: branchID,
+				ForkTime: row.forkTime,
+				Info:     row.info,
+			})
+		}
+		branches = append(branches, &workflow.HistoryBranch{
+			TreeID:    common.StringPtr(treeID),
+			BranchID:  common.StringPtr(branchID),
+			Ancestors: copyBranchRanges(row.ancestors),
+		})
+	}
+
+	return &p.GetHistoryTreeResponse{
+		Branches:                  branches,
+		ForkingInProgressBranches: forkingBranches,
+	}, nil
+}
+
+// insertBranch adds a branch to the tree, the caller must hold the database lock
+func (h *memoryHistoryV2Store) insertBranch(treeID, branchID string, row *historyTreeRow) {
+	tree, ok := h.db.historyBranches[treeID]
+	if !ok {
+		tree = make(map[string]*historyTreeRow)
+		h.db.historyBranches[treeID] = tree
+	}
+	tree[branchID] = row
+}
+
+// deleteBranch removes a branch from the tree, the caller must hold the database lock
+func (h *memoryHistoryV2Store) deleteBranch(treeID, branchID string) {
+	tree, ok := h.db.historyBranches[treeID]
+	if !ok {
+		return
+	}
+	delete(tree, branchID)
+	if len(tree) == 0 {
+		delete(h.db.historyBranches, treeID)
+	}
+}
+
+// deleteNodes removes all nodes of a branch starting from beginNodeID, the caller must hold the database lock
+func (h *memoryHistoryV2Store) deleteNodes(treeID, branchID string, beginNodeID int64) {
+	key := branchKey{treeID: treeID, branchID: branchID}
+	nodes, ok := h.db.historyNodes[key]
+	if !ok {
+		return
+	}
+	for nodeID := range nodes {
+		if nodeID >= beginNodeID {
+			delete(nodes, nodeID)
+		}
+	}
+	if len(nodes) == 0 {
+		delete(h.db.historyNodes, key)
+	}
+}
+
+func getBeginNodeID(bi workflow.HistoryBranch) int64 {
+	if len(bi.Ancestors) == 0 {
+		// root branch
+		return 1
+	}
+	idx := len(bi.Ancestors) - 1
+	return bi.Ancestors[idx].GetEndNodeID()
+}
+
+// copyBranchRanges returns a copy of the given ancestors sorted by EndNodeID with BeginNodeID filled
+func copyBranchRanges(ancestors []*workflow.HistoryBranchRange) []*workflow.HistoryBranchRange {
+	result := make([]*workflow.HistoryBranchRange, 0, len(ancestors))
+	for _, an := range ancestors {
+		result = append(result, &workflow.HistoryBranchRange{
+			BranchID:  common.StringPtr(an.GetBranchID()),
+			EndNodeID: common.Int64Ptr(an.GetEndNodeID()),
+		})
+	}
+
+	if len(result) > 0 {
+		sort.Slice(result, func(i, j int) bool { return result[i].GetEndNodeID() < result[j].GetEndNodeID() })
+		result[0].BeginNodeID = common.Int64Ptr(int64(1))
+		for i := 1; i < len(result); i++ {
+			result[i].BeginNodeID = result[i-1].EndNodeID
+		}
+	}
+	return result
+}
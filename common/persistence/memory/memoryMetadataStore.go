@@ -0,0 +1,328 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	memoryMetadataStoreV2 struct {
+		memoryStore
+		currentClusterName string
+	}
+
+	domainRow struct {
+		info                        p.DomainInfo
+		config                      p.DomainConfig
+		replicationConfig           p.DomainReplicationConfig
+		isGlobalDomain              bool
+		configVersion               int64
+		failoverVersion             int64
+		failoverNotificationVersion int64
+		notificationVersion         int64
+	}
+)
+
+func newMetadataPersistenceV2(db *database, currentClusterName string, logger bark.Logger) p.MetadataStore {
+	return &memoryMetadataStoreV2{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+		currentClusterName: currentClusterName,
+	}
+}
+
+// CreateDomain adds a new domain and bumps the domain metadata notification version
+func (m *memoryMetadataStoreV2) CreateDomain(request *p.CreateDomainRequest) (*p.CreateDomainResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if _, ok := m.db.domainIDsByName[request.Info.Name]; ok {
+		return nil, &workflow.DomainAlreadyExistsError{
+			Message: fmt.Sprintf("name: %v", request.Info.Name),
+		}
+	}
+	if _, ok := m.db.domains[request.Info.ID]; ok {
+		return nil, &workflow.DomainAlreadyExistsError{
+			Message: fmt.Sprintf("id: %v", request.Info.ID),
+		}
+	}
+
+	row := &domainRow{
+		isGlobalDomain:              request.IsGlobalDomain,
+		configVersion:               request.ConfigVersion,
+		failoverVersion:             request.FailoverVersion,
+		failoverNotificationVersion: p.InitialFailoverNotificationVersion,
+		notificationVersion:         m.db.domainNotificationVersion,
+	}
+	row.setInfo(request.Info, request.Config, request.ReplicationConfig)
+
+	m.db.domains[request.Info.ID] = row
+	m.db.domainIDsByName[request.Info.Name] = request.Info.ID
+	m.db.domainNotificationVersion++
+	return &p.CreateDomainResponse{ID: request.Info.ID}, nil
+}
+
+// GetDomain returns the domain identified by either ID or name
+func (m *memoryMetadataStoreV2) GetDomain(request *p.GetDomainRequest) (*p.GetDomainResponse, error) {
+	if len(request.ID) > 0 && len(request.Name) > 0 {
+		return nil, &workflow.BadRequestError{
+			Message: "GetDomain operation failed.  Both ID and Name specified in request.",
+		}
+	} else if len(request.ID) == 0 && len(request.Name) == 0 {
+		return nil, &workflow.BadRequestError{
+			Message: "GetDomain operation failed.  Both ID and Name are empty.",
+		}
+	}
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	identity := request.ID
+	domainID := request.ID
+	if len(request.Name) > 0 {
+		identity = request.Name
+		domainID = m.db.domainIDsByName[request.Name]
+	}
+
+	row, ok := m.db.domains[domainID]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Domain %s does not exist.", identity),
+		}
+	}
+	return m.toGetDomainResponse(row), nil
+}
+
+// UpdateDomain updates the domain, conditioned on the domain metadata notification version
+func (m *memoryMetadataStoreV2) UpdateDomain(request *p.UpdateDomainRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if m.db.domainNotificationVersion != request.NotificationVersion {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateDomain operation failed because of conditional failure."),
+		}
+	}
+
+	row, ok := m.db.domains[request.Info.ID]
+	if !ok || row.info.Name != request.Info.Name {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateDomain operation failed. Domain %v does not exist.", request.Info.Name),
+		}
+	}
+
+	row.setInfo(request.Info, request.Config, request.ReplicationConfig)
+	row.configVersion = request.ConfigVersion
+	row.failoverVersion = request.FailoverVersion
+	row.failoverNotificationVersion = request.FailoverNotificationVersion
+	row.notificationVersion = request.NotificationVersion
+	m.db.domainNotificationVersion++
+	return nil
+}
+
+// DeleteDomain removes the domain by ID, deleting a non-existing domain is a no-op
+func (m *memoryMetadataStoreV2) DeleteDomain(request *p.DeleteDomainRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if row, ok := m.db.domains[request.ID]; ok {
+		delete(m.db.domainIDsByName, row.info.Name)
+		delete(m.db.domains, request.ID)
+	}
+	return nil
+}
+
+// DeleteDomainByName removes the domain by name, deleting a non-existing domain is a no-op
+func (m *memoryMetadataStoreV2) DeleteDomainByName(request *p.DeleteDomainByNameRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if domainID, ok := m.db.domainIDsByName[request.Name]; ok {
+		delete(m.db.domains, domainID)
+		delete(m.db.domainIDsByName, request.Name)
+	}
+	return nil
+}
+
+// ListDomains returns a page of domains ordered by name
+func (m *memoryMetadataStoreV2) ListDomains(request *p.ListDomainsRequest) (*p.ListDomainsResponse, error) {
+	var offset int64
+	if len(request.NextPageToken) > 0 {
+		var err error
+		if offset, err = deserializePageToken(request.NextPageToken); err != nil {
+			return nil, err
+		}
+	}
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	names := make([]string, 0, len(m.db.domainIDsByName))
+	for name := range m.db.domainIDsByName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	response := &p.ListDomainsResponse{}
+	for i := int(offset); i < len(names); i++ {
+		if request.PageSize > 0 && len(response.Domains) == request.PageSize {
+			response.NextPageToken = serializePageToken(int64(i))
+			break
+		}
+		response.Domains = append(response.Domains, m.toGetDomainResponse(m.db.domains[m.db.domainIDsByName[names[i]]]))
+	}
+	return response, nil
+}
+
+// GetMetadata returns the current domain metadata notification version
+func (m *memoryMetadataStoreV2) GetMetadata() (*p.GetMetadataResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	return &p.GetMetadataResponse{NotificationVersion: m.db.domainNotificationVersion}, nil
+}
+
+// CreateClusterMetadata adds a new cluster
+func (m *memoryMetadataStoreV2) CreateClusterMetadata(request *p.CreateClusterMetadataRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if _, ok := m.db.clusters[request.Info.ClusterName]; ok {
+		return &workflow.BadRequestError{
+			Message: fmt.Sprintf("Cluster %v already exists.", request.Info.ClusterName),
+		}
+	}
+	info := *request.Info
+	m.db.clusters[info.ClusterName] = &info
+	return nil
+}
+
+// UpdateClusterMetadata updates an existing cluster
+func (m *memoryMetadataStoreV2) UpdateClusterMetadata(request *p.UpdateClusterMetadataRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if _, ok := m.db.clusters[request.Info.ClusterName]; !ok {
+		return &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Cluster %v does not exist.", request.Info.ClusterName),
+		}
+	}
+	info := *request.Info
+	m.db.clusters[info.ClusterName] = &info
+	return nil
+}
+
+// ListClusterMetadata returns all clusters ordered by name
+func (m *memoryMetadataStoreV2) ListClusterMetadata() (*p.ListClusterMetadataResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	names := make([]string, 0, len(m.db.clusters))
+	for name := range m.db.clusters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	response := &p.ListClusterMetadataResponse{}
+	for _, name := range names {
+		info := *m.db.clusters[name]
+		response.Clusters = append(response.Clusters, &info)
+	}
+	return response, nil
+}
+
+func (m *memoryMetadataStoreV2) toGetDomainResponse(row *domainRow) *p.GetDomainResponse {
+	info := row.info
+	info.Data = copyStringMap(row.info.Data)
+	if info.Data == nil {
+		info.Data = map[string]string{}
+	}
+	config := row.config
+	var clusters []*p.ClusterReplicationConfig
+	for _, cluster := range row.replicationConfig.Clusters {
+		clusters = append(clusters, &p.ClusterReplicationConfig{ClusterName: cluster.ClusterName})
+	}
+
+	return &p.GetDomainResponse{
+		Info:   &info,
+		Config: &config,
+		ReplicationConfig: &p.DomainReplicationConfig{
+			ActiveClusterName:        p.GetOrUseDefaultActiveCluster(m.currentClusterName, row.replicationConfig.ActiveClusterName),
+			Clusters:                 p.GetOrUseDefaultClusters(m.currentClusterName, clusters),
+			PendingActiveClusterName: row.replicationConfig.PendingActiveClusterName,
+			FailoverStartTime:        row.replicationConfig.FailoverStartTime,
+			FailoverExpireTime:       row.replicationConfig.FailoverExpireTime,
+			FailoverMarkers:          copyFailoverMarkers(row.replicationConfig.FailoverMarkers),
+		},
+		IsGlobalDomain:              row.isGlobalDomain,
+		ConfigVersion:               row.configVersion,
+		FailoverVersion:             row.failoverVersion,
+		FailoverNotificationVersion: row.failoverNotificationVersion,
+		NotificationVersion:         row.notificationVersion,
+		TableVersion:                p.DomainTableVersionV2,
+	}
+}
+
+func (r *domainRow) setInfo(info *p.DomainInfo, config *p.DomainConfig, replicationConfig *p.DomainReplicationConfig) {
+	r.info = *info
+	r.info.Data = copyStringMap(info.Data)
+	r.config = *config
+	r.replicationConfig = p.DomainReplicationConfig{
+		ActiveClusterName:        replicationConfig.ActiveClusterName,
+		PendingActiveClusterName: replicationConfig.PendingActiveClusterName,
+		FailoverStartTime:        replicationConfig.FailoverStartTime,
+		FailoverExpireTime:       replicationConfig.FailoverExpireTime,
+		FailoverMarkers:          copyFailoverMarkers(replicationConfig.FailoverMarkers),
+	}
+	for _, cluster := range replicationConfig.Clusters {
+		r.replicationConfig.Clusters = append(r.replicationConfig.Clusters, &p.ClusterReplicationConfig{ClusterName: cluster.ClusterName})
+	}
+}
+
+func copyFailoverMarkers(source map[int32]int64) map[int32]int64 {
+	if source == nil {
+		return nil
+	}
+	result := make(map[int32]int64, len(source))
+	for k, v := range source {
+		result[k] = v
+	}
+	return result
+}
+
+func copyStringMap(source map[string]string) map[string]string {
+	if source == nil {
+		return nil
+	}
+	result := make(map[string]string, len(source))
+	for k, v := range source {
+		result[k] = v
+	}
+	return result
+}
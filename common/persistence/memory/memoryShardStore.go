@@ -0,0 +1,157 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	memoryShardStore struct {
+		memoryStore
+		currentClusterName string
+	}
+)
+
+func newShardPersistence(db *database, currentClusterName string, logger bark.Logger) p.ShardStore {
+	return &memoryShardStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+		currentClusterName: currentClusterName,
+	}
+}
+
+func (m *memoryShardStore) CreateShard(request *p.CreateShardRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	shardID := request.ShardInfo.ShardID
+	if _, ok := m.db.shards[shardID]; ok {
+		return &p.ShardAlreadyExistError{
+			Msg: fmt.Sprintf("CreateShard operation failed. Shard with ID %v already exists.", shardID),
+		}
+	}
+
+	shardInfo := copyShardInfo(request.ShardInfo)
+	shardInfo.UpdatedAt = time.Now()
+	m.db.shards[shardID] = shardInfo
+	return nil
+}
+
+func (m *memoryShardStore) GetShard(request *p.GetShardRequest) (*p.GetShardResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	shardInfo, ok := m.db.shards[request.ShardID]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Shard not found.  ShardId: %v", request.ShardID),
+		}
+	}
+
+	info := copyShardInfo(shardInfo)
+	if len(info.ClusterTransferAckLevel) == 0 {
+		info.ClusterTransferAckLevel = map[string]int64{
+			m.currentClusterName: info.TransferAckLevel,
+		}
+	}
+	if len(info.ClusterTimerAckLevel) == 0 {
+		info.ClusterTimerAckLevel = map[string]time.Time{
+			m.currentClusterName: info.TimerAckLevel,
+		}
+	}
+	return &p.GetShardResponse{ShardInfo: info}, nil
+}
+
+func (m *memoryShardStore) UpdateShard(request *p.UpdateShardRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	shardID := request.ShardInfo.ShardID
+	shardInfo, ok := m.db.shards[shardID]
+	if !ok || shardInfo.RangeID != request.PreviousRangeID {
+		var rangeID interface{}
+		if ok {
+			rangeID = shardInfo.RangeID
+		}
+		return &p.ShardOwnershipLostError{
+			ShardID: shardID,
+			Msg: fmt.Sprintf("Failed to update shard.  previous_range_id: %v, columns: (range_id=%v)",
+				request.PreviousRangeID, rangeID),
+		}
+	}
+
+	updated := copyShardInfo(request.ShardInfo)
+	updated.UpdatedAt = time.Now()
+	m.db.shards[shardID] = updated
+	return nil
+}
+
+// assertShardOwnership verifies the rangeID of the given shard matches the one
+// provided by the caller, the caller must hold the database lock
+func (db *database) assertShardOwnership(shardID int, rangeID int64, operation string) error {
+	shardInfo, ok := db.shards[shardID]
+	if !ok || shardInfo.RangeID != rangeID {
+		var actualRangeID interface{}
+		if ok {
+			actualRangeID = shardInfo.RangeID
+		}
+		return &p.ShardOwnershipLostError{
+			ShardID: shardID,
+			Msg: fmt.Sprintf("%v failed.  Request RangeID: %v, Actual RangeID: %v",
+				operation, rangeID, actualRangeID),
+		}
+	}
+	return nil
+}
+
+func copyShardInfo(info *p.ShardInfo) *p.ShardInfo {
+	result := *info
+	if info.ClusterTransferAckLevel != nil {
+		result.ClusterTransferAckLevel = make(map[string]int64, len(info.ClusterTransferAckLevel))
+		for k, v := range info.ClusterTransferAckLevel {
+			result.ClusterTransferAckLevel[k] = v
+		}
+	}
+	if info.ClusterTimerAckLevel != nil {
+		result.ClusterTimerAckLevel = make(map[string]time.Time, len(info.ClusterTimerAckLevel))
+		for k, v := range info.ClusterTimerAckLevel {
+			result.ClusterTimerAckLevel[k] = v
+		}
+	}
+	if info.ClusterReplicationLevel != nil {
+		result.ClusterReplicationLevel = make(map[string]int64, len(info.ClusterReplicationLevel))
+		for k, v := range info.ClusterReplicationLevel {
+			result.ClusterReplicationLevel[k] = v
+		}
+	}
+	// failover levels are only kept in memory by the shard context and are never persisted
+	result.TransferFailoverLevels = nil
+	result.TimerFailoverLevels = nil
+	return &result
+}
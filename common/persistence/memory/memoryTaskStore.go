@@ -0,0 +1,273 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	memoryTaskStore struct {
+		memoryStore
+	}
+
+	// taskListPageToken is the name and type of the last task list of a page
+	taskListPageToken struct {
+		Name     string
+		TaskType int
+	}
+)
+
+const (
+	initialRangeID = 1 // Id of the first range of a new task list
+)
+
+func newTaskPersistence(db *database, logger bark.Logger) p.TaskStore {
+	return &memoryTaskStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}
+}
+
+func (m *memoryTaskStore) LeaseTaskList(request *p.LeaseTaskListRequest) (*p.LeaseTaskListResponse, error) {
+	if len(request.TaskList) == 0 {
+		return nil, &workflow.InternalServiceError{
+			Message: "LeaseTaskList requires non empty task list",
+		}
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := taskListKey{domainID: request.DomainID, name: request.TaskList, taskType: request.TaskType}
+	tli, ok := m.db.taskLists[key]
+	if !ok {
+		// First time task list is used
+		tli = &p.TaskListInfo{
+			DomainID: request.DomainID,
+			Name:     request.TaskList,
+			TaskType: request.TaskType,
+			RangeID:  initialRangeID,
+			AckLevel: 0,
+			Kind:     request.TaskListKind,
+		}
+		m.db.taskLists[key] = tli
+	} else {
+		tli.RangeID++
+	}
+	tli.LastUpdated = time.Now()
+
+	result := *tli
+	result.Kind = request.TaskListKind
+	return &p.LeaseTaskListResponse{TaskListInfo: &result}, nil
+}
+
+func (m *memoryTaskStore) UpdateTaskList(request *p.UpdateTaskListRequest) (*p.UpdateTaskListResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	tli := request.TaskListInfo
+	key := taskListKey{domainID: tli.DomainID, name: tli.Name, taskType: tli.TaskType}
+	if tli.Kind != p.TaskListKindSticky {
+		// sticky task lists are updated unconditionally
+		if err := m.assertTaskListRangeID(key, tli.RangeID, "UpdateTaskList"); err != nil {
+			return nil, err
+		}
+	}
+
+	updated := *tli
+	updated.LastUpdated = time.Now()
+	m.db.taskLists[key] = &updated
+	return &p.UpdateTaskListResponse{}, nil
+}
+
+func (m *memoryTaskStore) CreateTasks(request *p.CreateTasksRequest) (*p.CreateTasksResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	tli := request.TaskListInfo
+	key := taskListKey{domainID: tli.DomainID, name: tli.Name, taskType: tli.TaskType}
+	if err := m.assertTaskListRangeID(key, tli.RangeID, "CreateTasks"); err != nil {
+		return nil, err
+	}
+
+	tasks, ok := m.db.tasks[key]
+	if !ok {
+		tasks = make(map[int64]*p.TaskInfo)
+		m.db.tasks[key] = tasks
+	}
+	for _, task := range request.Tasks {
+		tasks[task.TaskID] = &p.TaskInfo{
+			DomainID:               tli.DomainID,
+			WorkflowID:             task.Execution.GetWorkflowId(),
+			RunID:                  task.Execution.GetRunId(),
+			TaskID:                 task.TaskID,
+			ScheduleID:             task.Data.ScheduleID,
+			ScheduleToStartTimeout: task.Data.ScheduleToStartTimeout,
+			CreatedTime:            task.Data.CreatedTime,
+			Expiry:                 task.Data.Expiry,
+			Priority:               task.Data.Priority,
+		}
+	}
+
+	// only the range of the task list is checked, as in the other stores, the task list info is
+	// written by UpdateTaskList
+	return &p.CreateTasksResponse{}, nil
+}
+
+func (m *memoryTaskStore) GetTaskList(request *p.GetTaskListRequest) (*p.GetTaskListResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	key := taskListKey{domainID: request.DomainID, name: request.TaskList, taskType: request.TaskType}
+	tli, ok := m.db.taskLists[key]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Task list %v of type %v does not exist", request.TaskList, request.TaskType),
+		}
+	}
+	info := *tli
+	return &p.GetTaskListResponse{TaskListInfo: &info}, nil
+}
+
+func (m *memoryTaskStore) ListTaskLists(request *p.ListTaskListsRequest) (*p.ListTaskListsResponse, error) {
+	pageToken := &taskListPageToken{TaskType: -1}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, pageToken); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("error deserializing taskListPageToken: %v", err),
+			}
+		}
+	}
+	after := func(key taskListKey) bool {
+		return key.name > pageToken.Name || (key.name == pageToken.Name && key.taskType > pageToken.TaskType)
+	}
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	var keys []taskListKey
+	for key := range m.db.taskLists {
+		if key.domainID == request.DomainID && after(key) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].name != keys[j].name {
+			return keys[i].name < keys[j].name
+		}
+		return keys[i].taskType < keys[j].taskType
+	})
+
+	response := &p.ListTaskListsResponse{}
+	for i, key := range keys {
+		if request.PageSize > 0 && i == request.PageSize {
+			last := keys[i-1]
+			nextToken, err := json.Marshal(&taskListPageToken{Name: last.name, TaskType: last.taskType})
+			if err != nil {
+				return nil, &workflow.InternalServiceError{
+					Message: fmt.Sprintf("ListTaskLists: error serializing page token: %v", err),
+				}
+			}
+			response.NextPageToken = nextToken
+			break
+		}
+		info := *m.db.taskLists[key]
+		response.Items = append(response.Items, &info)
+	}
+	return response, nil
+}
+
+func (m *memoryTaskStore) DeleteTaskList(request *p.DeleteTaskListRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := taskListKey{domainID: request.DomainID, name: request.TaskListName, taskType: request.TaskListType}
+	if err := m.assertTaskListRangeID(key, request.RangeID, "DeleteTaskList"); err != nil {
+		return err
+	}
+	delete(m.db.taskLists, key)
+	return nil
+}
+
+func (m *memoryTaskStore) GetTasks(request *p.GetTasksRequest) (*p.GetTasksResponse, error) {
+	if request.ReadLevel > request.MaxReadLevel {
+		return &p.GetTasksResponse{}, nil
+	}
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	key := taskListKey{domainID: request.DomainID, name: request.TaskList, taskType: request.TaskType}
+	var taskIDs []int64
+	for taskID := range m.db.tasks[key] {
+		if taskID > request.ReadLevel && taskID <= request.MaxReadLevel {
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	sort.Slice(taskIDs, func(i, j int) bool { return taskIDs[i] < taskIDs[j] })
+	if request.BatchSize > 0 && len(taskIDs) > request.BatchSize {
+		taskIDs = taskIDs[:request.BatchSize]
+	}
+
+	response := &p.GetTasksResponse{}
+	for _, taskID := range taskIDs {
+		task := *m.db.tasks[key][taskID]
+		response.Tasks = append(response.Tasks, &task)
+	}
+	return response, nil
+}
+
+func (m *memoryTaskStore) CompleteTask(request *p.CompleteTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	tli := request.TaskList
+	key := taskListKey{domainID: tli.DomainID, name: tli.Name, taskType: tli.TaskType}
+	delete(m.db.tasks[key], request.TaskID)
+	return nil
+}
+
+// assertTaskListRangeID verifies the task list exists and is owned by the given rangeID,
+// the caller must hold the database lock
+func (m *memoryTaskStore) assertTaskListRangeID(key taskListKey, rangeID int64, operation string) error {
+	tli, ok := m.db.taskLists[key]
+	if !ok || tli.RangeID != rangeID {
+		var actualRangeID interface{}
+		if ok {
+			actualRangeID = tli.RangeID
+		}
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("%v failed. TaskList: %v, taskListType: %v, rangeID: %v, db rangeID: %v",
+				operation, key.name, key.taskType, rangeID, actualRangeID),
+		}
+	}
+	return nil
+}
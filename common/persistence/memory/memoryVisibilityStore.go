@@ -0,0 +1,228 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	memoryVisibilityStore struct {
+		memoryStore
+	}
+
+	visibilityRecord struct {
+		domainID         string
+		workflowID       string
+		runID            string
+		workflowTypeName string
+		startTime        int64
+		closeTime        int64
+		closeStatus      workflow.WorkflowExecutionCloseStatus
+		historyLength    int64
+		closed           bool
+	}
+
+	visibilityPageToken struct {
+		Time  int64
+		RunID string
+	}
+)
+
+func newVisibilityPersistence(db *database, logger bark.Logger) p.VisibilityStore {
+	return &memoryVisibilityStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}
+}
+
+func (v *memoryVisibilityStore) RecordWorkflowExecutionStarted(request *p.RecordWorkflowExecutionStartedRequest) error {
+	v.db.Lock()
+	defer v.db.Unlock()
+
+	key := visibilityKey{domainID: request.DomainUUID, runID: request.Execution.GetRunId()}
+	v.db.openVisibilityRecords[key] = &visibilityRecord{
+		domainID:         request.DomainUUID,
+		workflowID:       request.Execution.GetWorkflowId(),
+		runID:            request.Execution.GetRunId(),
+		workflowTypeName: request.WorkflowTypeName,
+		startTime:        request.StartTimestamp,
+	}
+	return nil
+}
+
+func (v *memoryVisibilityStore) RecordWorkflowExecutionClosed(request *p.RecordWorkflowExecutionClosedRequest) error {
+	v.db.Lock()
+	defer v.db.Unlock()
+
+	key := visibilityKey{domainID: request.DomainUUID, runID: request.Execution.GetRunId()}
+	delete(v.db.openVisibilityRecords, key)
+	v.db.closedVisibilityRecords[key] = &visibilityRecord{
+		domainID:         request.DomainUUID,
+		workflowID:       request.Execution.GetWorkflowId(),
+		runID:            request.Execution.GetRunId(),
+		workflowTypeName: request.WorkflowTypeName,
+		startTime:        request.StartTimestamp,
+		closeTime:        request.CloseTimestamp,
+		closeStatus:      request.Status,
+		historyLength:    request.HistoryLength,
+		closed:           true,
+	}
+	return nil
+}
+
+func (v *memoryVisibilityStore) ListOpenWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions(v.db.openVisibilityRecords, request, func(r *visibilityRecord) bool {
+		return true
+	})
+}
+
+func (v *memoryVisibilityStore) ListClosedWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions(v.db.closedVisibilityRecords, request, func(r *visibilityRecord) bool {
+		return true
+	})
+}
+
+func (v *memoryVisibilityStore) ListOpenWorkflowExecutionsByType(request *p.ListWorkflowExecutionsByTypeRequest) (*p.ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions(v.db.openVisibilityRecords, &request.ListWorkflowExecutionsRequest, func(r *visibilityRecord) bool {
+		return r.workflowTypeName == request.WorkflowTypeName
+	})
+}
+
+func (v *memoryVisibilityStore) ListClosedWorkflowExecutionsByType(request *p.ListWorkflowExecutionsByTypeRequest) (*p.ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions(v.db.closedVisibilityRecords, &request.ListWorkflowExecutionsRequest, func(r *visibilityRecord) bool {
+		return r.workflowTypeName == request.WorkflowTypeName
+	})
+}
+
+func (v *memoryVisibilityStore) ListOpenWorkflowExecutionsByWorkflowID(request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions(v.db.openVisibilityRecords, &request.ListWorkflowExecutionsRequest, func(r *visibilityRecord) bool {
+		return r.workflowID == request.WorkflowID
+	})
+}
+
+func (v *memoryVisibilityStore) ListClosedWorkflowExecutionsByWorkflowID(request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions(v.db.closedVisibilityRecords, &request.ListWorkflowExecutionsRequest, func(r *visibilityRecord) bool {
+		return r.workflowID == request.WorkflowID
+	})
+}
+
+func (v *memoryVisibilityStore) ListClosedWorkflowExecutionsByStatus(request *p.ListClosedWorkflowExecutionsByStatusRequest) (*p.ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions(v.db.closedVisibilityRecords, &request.ListWorkflowExecutionsRequest, func(r *visibilityRecord) bool {
+		return r.closeStatus == request.Status
+	})
+}
+
+func (v *memoryVisibilityStore) GetClosedWorkflowExecution(request *p.GetClosedWorkflowExecutionRequest) (*p.GetClosedWorkflowExecutionResponse, error) {
+	v.db.RLock()
+	defer v.db.RUnlock()
+
+	record, ok := v.db.closedVisibilityRecords[visibilityKey{domainID: request.DomainUUID, runID: request.Execution.GetRunId()}]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+				request.Execution.GetWorkflowId(), request.Execution.GetRunId()),
+		}
+	}
+	return &p.GetClosedWorkflowExecutionResponse{Execution: record.toExecutionInfo()}, nil
+}
+
+// listWorkflowExecutions returns a page of the matching records, ordered by start time desc and then run ID
+func (v *memoryVisibilityStore) listWorkflowExecutions(
+	records map[visibilityKey]*visibilityRecord,
+	request *p.ListWorkflowExecutionsRequest,
+	filter func(r *visibilityRecord) bool,
+) (*p.ListWorkflowExecutionsResponse, error) {
+	var token *visibilityPageToken
+	if len(request.NextPageToken) > 0 {
+		token = &visibilityPageToken{}
+		if err := json.Unmarshal(request.NextPageToken, token); err != nil {
+			return nil, &workflow.BadRequestError{
+				Message: fmt.Sprintf("Unable to deserialize page token. err: %v", err),
+			}
+		}
+	}
+
+	v.db.RLock()
+	defer v.db.RUnlock()
+
+	var matched []*visibilityRecord
+	for _, r := range records {
+		if r.domainID != request.DomainUUID ||
+			r.startTime < request.EarliestStartTime || r.startTime > request.LatestStartTime || !filter(r) {
+			continue
+		}
+		if token != nil && !(r.startTime < token.Time || (r.startTime == token.Time && r.runID > token.RunID)) {
+			continue
+		}
+		matched = append(matched, r)
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		if matched[i].startTime == matched[j].startTime {
+			return matched[i].runID < matched[j].runID
+		}
+		return matched[i].startTime > matched[j].startTime
+	})
+
+	response := &p.ListWorkflowExecutionsResponse{}
+	for i, r := range matched {
+		if request.PageSize > 0 && i == request.PageSize {
+			last := matched[i-1]
+			data, err := json.Marshal(&visibilityPageToken{Time: last.startTime, RunID: last.runID})
+			if err != nil {
+				return nil, &workflow.InternalServiceError{
+					Message: fmt.Sprintf("Unable to serialize page token. err: %v", err),
+				}
+			}
+			response.NextPageToken = data
+			break
+		}
+		response.Executions = append(response.Executions, r.toExecutionInfo())
+	}
+	return response, nil
+}
+
+func (r *visibilityRecord) toExecutionInfo() *workflow.WorkflowExecutionInfo {
+	info := &workflow.WorkflowExecutionInfo{
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(r.workflowID),
+			RunId:      common.StringPtr(r.runID),
+		},
+		Type:      &workflow.WorkflowType{Name: common.StringPtr(r.workflowTypeName)},
+		StartTime: common.Int64Ptr(r.startTime),
+	}
+	if r.closed {
+		status := r.closeStatus
+		info.CloseStatus = &status
+		info.CloseTime = common.Int64Ptr(r.closeTime)
+		info.HistoryLength = common.Int64Ptr(r.historyLength)
+	}
+	return info
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	p "github.com/uber/cadence/common/persistence"
+)
+
+// The helpers below deep copy the mutable state so that the rows kept in memory are never
+// shared with callers, which are free to mutate the objects they pass in or get back.
+
+func newMutableState(executionInfo *p.InternalWorkflowExecutionInfo,
+	replicationState *p.ReplicationState) *p.InternalWorkflowMutableState {
+	return &p.InternalWorkflowMutableState{
+		ActivitInfos:             make(map[int64]*p.InternalActivityInfo),
+		TimerInfos:               make(map[string]*p.TimerInfo),
+		ChildExecutionInfos:      make(map[int64]*p.InternalChildExecutionInfo),
+		RequestCancelInfos:       make(map[int64]*p.RequestCancelInfo),
+		SignalInfos:              make(map[int64]*p.SignalInfo),
+		SignalRequestedIDs:       make(map[string]struct{}),
+		ExecutionInfo:            executionInfo,
+		ReplicationState:         replicationState,
+		BufferedReplicationTasks: make(map[int64]*p.InternalBufferedReplicationTask),
+	}
+}
+
+func insertMutableStateInfos(
+	state *p.InternalWorkflowMutableState,
+	activityInfos []*p.InternalActivityInfo,
+	timerInfos []*p.TimerInfo,
+	childExecutionInfos []*p.InternalChildExecutionInfo,
+	requestCancelInfos []*p.RequestCancelInfo,
+	signalInfos []*p.SignalInfo,
+	signalRequestedIDs []string,
+) {
+	for _, ai := range activityInfos {
+		state.ActivitInfos[ai.ScheduleID] = copyActivityInfo(ai)
+	}
+	for _, ti := range timerInfos {
+		state.TimerInfos[ti.TimerID] = copyTimerInfo(ti)
+	}
+	for _, ci := range childExecutionInfos {
+		state.ChildExecutionInfos[ci.InitiatedID] = copyChildExecutionInfo(ci)
+	}
+	for _, rci := range requestCancelInfos {
+		state.RequestCancelInfos[rci.InitiatedID] = copyRequestCancelInfo(rci)
+	}
+	for _, si := range signalInfos {
+		state.SignalInfos[si.InitiatedID] = copySignalInfo(si)
+	}
+	for _, signalRequestedID := range signalRequestedIDs {
+		state.SignalRequestedIDs[signalRequestedID] = struct{}{}
+	}
+}
+
+func copyMutableState(state *p.InternalWorkflowMutableState) *p.InternalWorkflowMutableState {
+	result := newMutableState(copyExecutionInfo(state.ExecutionInfo), copyReplicationState(state.ReplicationState))
+	for k, v := range state.ActivitInfos {
+		result.ActivitInfos[k] = copyActivityInfo(v)
+	}
+	for k, v := range state.TimerInfos {
+		result.TimerInfos[k] = copyTimerInfo(v)
+	}
+	for k, v := range state.ChildExecutionInfos {
+		result.ChildExecutionInfos[k] = copyChildExecutionInfo(v)
+	}
+	for k, v := range state.RequestCancelInfos {
+		result.RequestCancelInfos[k] = copyRequestCancelInfo(v)
+	}
+	for k, v := range state.SignalInfos {
+		result.SignalInfos[k] = copySignalInfo(v)
+	}
+	for k := range state.SignalRequestedIDs {
+		result.SignalRequestedIDs[k] = struct{}{}
+	}
+	for k, v := range state.BufferedReplicationTasks {
+		result.BufferedReplicationTasks[k] = copyBufferedReplicationTask(v)
+	}
+	result.BufferedEvents = append([]*p.DataBlob{}, state.BufferedEvents...)
+	return result
+}
+
+func copyExecutionInfo(info *p.InternalWorkflowExecutionInfo) *p.InternalWorkflowExecutionInfo {
+	result := *info
+	result.ExecutionContext = copyBytes(info.ExecutionContext)
+	result.NonRetriableErrors = copyStrings(info.NonRetriableErrors)
+	result.BranchToken = copyBytes(info.BranchToken)
+	return &result
+}
+
+func copyReplicationState(state *p.ReplicationState) *p.ReplicationState {
+	if state == nil {
+		return nil
+	}
+	result := *state
+	result.LastReplicationInfo = copyReplicationInfo(state.LastReplicationInfo)
+	return &result
+}
+
+func copyReplicationInfo(infos map[string]*p.ReplicationInfo) map[string]*p.ReplicationInfo {
+	if infos == nil {
+		return nil
+	}
+	result := make(map[string]*p.ReplicationInfo, len(infos))
+	for k, v := range infos {
+		info := *v
+		result[k] = &info
+	}
+	return result
+}
+
+func copyActivityInfo(info *p.InternalActivityInfo) *p.InternalActivityInfo {
+	result := *info
+	result.Details = copyBytes(info.Details)
+	result.NonRetriableErrors = copyStrings(info.NonRetriableErrors)
+	// not written to database
+	result.LastHeartbeatTimeoutVisibility = 0
+	return &result
+}
+
+func copyTimerInfo(info *p.TimerInfo) *p.TimerInfo {
+	result := *info
+	return &result
+}
+
+func copyChildExecutionInfo(info *p.InternalChildExecutionInfo) *p.InternalChildExecutionInfo {
+	result := *info
+	return &result
+}
+
+func copyRequestCancelInfo(info *p.RequestCancelInfo) *p.RequestCancelInfo {
+	result := *info
+	return &result
+}
+
+func copySignalInfo(info *p.SignalInfo) *p.SignalInfo {
+	result := *info
+	result.Input = copyBytes(info.Input)
+	result.Control = copyBytes(info.Control)
+	return &result
+}
+
+func copyBufferedReplicationTask(task *p.InternalBufferedReplicationTask) *p.InternalBufferedReplicationTask {
+	result := *task
+	return &result
+}
+
+func copyBytes(source []byte) []byte {
+	if source == nil {
+		return nil
+	}
+	return append([]byte{}, source...)
+}
+
+func copyStrings(source []string) []string {
+	if source == nil {
+		return nil
+	}
+	return append([]string{}, source...)
+}
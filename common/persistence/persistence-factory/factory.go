@@ -28,6 +28,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/cassandra"
+	"github.com/uber/cadence/common/persistence/memory"
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/service/config"
 )
@@ -157,6 +158,9 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 	if err != nil {
 		return nil, err
 	}
+	if f.faultInjectionEnabled() {
+		result = p.NewTaskPersistenceFaultInjectionClient(result, &f.config.FaultInjection, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewTaskPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -173,6 +177,9 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 	if err != nil {
 		return nil, err
 	}
+	if f.faultInjectionEnabled() {
+		result = p.NewShardPersistenceFaultInjectionClient(result, &f.config.FaultInjection, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewShardPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -191,6 +198,9 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 		return nil, err
 	}
 	result := p.NewHistoryManagerImpl(store, f.logger)
+	if f.faultInjectionEnabled() {
+		result = p.NewHistoryPersistenceFaultInjectionClient(result, &f.config.FaultInjection, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewHistoryPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -208,6 +218,9 @@ func (f *factoryImpl) NewHistoryV2Manager() (p.HistoryV2Manager, error) {
 		return nil, err
 	}
 	result := p.NewHistoryV2ManagerImpl(store, f.logger)
+	if f.faultInjectionEnabled() {
+		result = p.NewHistoryV2PersistenceFaultInjectionClient(result, &f.config.FaultInjection, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewHistoryV2PersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -236,6 +249,9 @@ func (f *factoryImpl) NewMetadataManager(version MetadataVersion) (p.MetadataMan
 	}
 
 	result := p.MetadataManager(store)
+	if f.faultInjectionEnabled() {
+		result = p.NewMetadataPersistenceFaultInjectionClient(result, &f.config.FaultInjection, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewMetadataPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -253,6 +269,9 @@ func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, erro
 		return nil, err
 	}
 	result := p.NewExecutionManagerImpl(store, f.logger)
+	if f.faultInjectionEnabled() {
+		result = p.NewWorkflowExecutionPersistenceFaultInjectionClient(result, &f.config.FaultInjection, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewWorkflowExecutionPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -269,6 +288,9 @@ func (f *factoryImpl) NewVisibilityManager(enableSampling bool) (p.VisibilityMan
 	if err != nil {
 		return nil, err
 	}
+	if f.faultInjectionEnabled() {
+		result = p.NewVisibilityPersistenceFaultInjectionClient(result, &f.config.FaultInjection, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewVisibilityPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -281,6 +303,10 @@ func (f *factoryImpl) NewVisibilityManager(enableSampling bool) (p.VisibilityMan
 	return result, nil
 }
 
+func (f *factoryImpl) faultInjectionEnabled() bool {
+	return f.config.FaultInjection.Enabled != nil && f.config.FaultInjection.Enabled()
+}
+
 // Close closes this factory
 func (f *factoryImpl) Close() {
 	ds := f.datastores[storeTypeExecution]
@@ -294,6 +320,10 @@ func newStore(cfg config.DataStore, tb common.TokenBucket, clusterName string, m
 		ds.factory = newSQLStore(*cfg.SQL, clusterName, maxConnsOverride, logger)
 		return ds
 	}
+	if cfg.Memory != nil {
+		ds.factory = newMemoryStore(*cfg.Memory, clusterName, logger)
+		return ds
+	}
 	ds.factory = newCassandraStore(*cfg.Cassandra, clusterName, maxConnsOverride, logger)
 	return ds
 }
@@ -305,6 +335,10 @@ func newSQLStore(cfg config.SQL, clusterName string, maxConnsOverride int, logge
 	return sql.NewFactory(cfg, clusterName, logger)
 }
 
+func newMemoryStore(cfg config.Memory, clusterName string, logger bark.Logger) DataStoreFactory {
+	return memory.NewFactory(cfg, clusterName, logger)
+}
+
 func newCassandraStore(cfg config.Cassandra, clusterName string, maxConnsOverride int, logger bark.Logger) DataStoreFactory {
 	if maxConnsOverride > 0 {
 		cfg.MaxConns = maxConnsOverride
@@ -322,6 +356,9 @@ func buildRatelimiters(cfg *config.Persistence) map[string]common.TokenBucket {
 		if ds.SQL != nil {
 			qps = ds.SQL.MaxQPS
 		}
+		if ds.Memory != nil {
+			qps = ds.Memory.MaxQPS
+		}
 		if qps > 0 {
 			result[dsName] = common.NewTokenBucket(qps, common.NewRealTimeSource())
 		}
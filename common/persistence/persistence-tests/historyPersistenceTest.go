@@ -23,6 +23,7 @@ package persistencetests
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/pborman/uuid"
 	log "github.com/sirupsen/logrus"
@@ -345,6 +346,85 @@ func (s *HistoryPersistenceSuite) TestOverwriteAndShadowingHistoryEvents() {
 	}
 }
 
+// TestConflictResolutionAudits test
+func (s *HistoryPersistenceSuite) TestConflictResolutionAudits() {
+	domainID := uuid.New()
+	workflowID := "conflict-resolution-audit-test-workflow"
+	now := time.Now().Truncate(time.Millisecond)
+
+	var audits []*p.ConflictResolutionAuditInfo
+	for i := 0; i < 3; i++ {
+		audits = append(audits, &p.ConflictResolutionAuditInfo{
+			AuditID:               uuid.New(),
+			DomainID:              domainID,
+			WorkflowID:            workflowID,
+			RunID:                 uuid.New(),
+			SourceCluster:         "standby",
+			ResolvedTime:          now.Add(time.Duration(i) * time.Second),
+			Reason:                "diverged-events",
+			ResetEventID:          int64(10 + i),
+			DiscardedNextEventID:  int64(20 + i),
+			DiscardedStartVersion: 1,
+			DiscardedEndVersion:   1,
+			AdoptedFirstEventID:   int64(11 + i),
+			AdoptedNextEventID:    int64(30 + i),
+			AdoptedStartVersion:   2,
+			AdoptedEndVersion:     2,
+		})
+		err := s.HistoryMgr.CreateConflictResolutionAudit(&p.CreateConflictResolutionAuditRequest{Info: audits[i]})
+		s.NoError(err)
+	}
+
+	resp, err := s.HistoryMgr.ListConflictResolutionAudits(&p.ListConflictResolutionAuditsRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		PageSize:   10,
+	})
+	s.NoError(err)
+	s.Equal(3, len(resp.Audits))
+	for i, audit := range resp.Audits {
+		expected := audits[len(audits)-1-i]
+		s.Equal(expected.AuditID, audit.AuditID)
+		s.Equal(expected.RunID, audit.RunID)
+		s.Equal(expected.SourceCluster, audit.SourceCluster)
+		s.Equal(expected.Reason, audit.Reason)
+		s.Equal(expected.ResetEventID, audit.ResetEventID)
+		s.Equal(expected.DiscardedNextEventID, audit.DiscardedNextEventID)
+		s.Equal(expected.AdoptedNextEventID, audit.AdoptedNextEventID)
+		s.Equal(expected.AdoptedEndVersion, audit.AdoptedEndVersion)
+		s.True(expected.ResolvedTime.Equal(audit.ResolvedTime))
+	}
+
+	// paging returns the same audits in the same order
+	var paged []*p.ConflictResolutionAuditInfo
+	var nextPageToken []byte
+	for pages := 0; pages == 0 || len(nextPageToken) > 0; pages++ {
+		s.True(pages < 3)
+		resp, err = s.HistoryMgr.ListConflictResolutionAudits(&p.ListConflictResolutionAuditsRequest{
+			DomainID:      domainID,
+			WorkflowID:    workflowID,
+			PageSize:      2,
+			NextPageToken: nextPageToken,
+		})
+		s.NoError(err)
+		s.True(len(resp.Audits) <= 2)
+		paged = append(paged, resp.Audits...)
+		nextPageToken = resp.NextPageToken
+	}
+	s.Equal(3, len(paged))
+	for i, audit := range paged {
+		s.Equal(audits[len(audits)-1-i].AuditID, audit.AuditID)
+	}
+
+	resp, err = s.HistoryMgr.ListConflictResolutionAudits(&p.ListConflictResolutionAuditsRequest{
+		DomainID:   domainID,
+		WorkflowID: "some-other-workflow",
+		PageSize:   10,
+	})
+	s.NoError(err)
+	s.Equal(0, len(resp.Audits))
+}
+
 // AppendHistoryEvents helper
 func (s *HistoryPersistenceSuite) AppendHistoryEvents(domainID string, workflowExecution gen.WorkflowExecution,
 	firstEventID, eventBatchVersion int64, rangeID, txID int64, eventsBatch *gen.History, overwrite bool) error {
@@ -114,6 +114,133 @@ func (s *MatchingPersistenceSuite) TestGetDecisionTasks() {
 	s.Equal(int64(5), tasks1Response.Tasks[0].ScheduleID)
 }
 
+// TestGetTasksWithExpiry test
+func (s *MatchingPersistenceSuite) TestGetTasksWithExpiry() {
+	domainID := "7b3f8b7e-4f3e-4d0c-9a3b-6b0b8c5c7e21"
+	workflowExecution := gen.WorkflowExecution{WorkflowId: common.StringPtr("get-tasks-with-expiry-test"),
+		RunId: common.StringPtr("0f8c4f0e-2d8e-4a52-9d1b-3c6a2f1e4b9d")}
+	taskList := "3c6a2f1e4b9d"
+	leaseResponse, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+
+	createdTime := time.Now()
+	expiry := createdTime.Add(time.Minute)
+	taskID := s.GetNextSequenceNumber()
+	_, err = s.TaskMgr.CreateTasks(&p.CreateTasksRequest{
+		TaskListInfo: leaseResponse.TaskListInfo,
+		Tasks: []*p.CreateTaskInfo{
+			{
+				TaskID:    taskID,
+				Execution: workflowExecution,
+				Data: &p.TaskInfo{
+					DomainID:               domainID,
+					WorkflowID:             workflowExecution.GetWorkflowId(),
+					RunID:                  workflowExecution.GetRunId(),
+					TaskID:                 taskID,
+					ScheduleID:             7,
+					ScheduleToStartTimeout: 60,
+					CreatedTime:            createdTime,
+					Expiry:                 expiry,
+				},
+			},
+		},
+	})
+	s.NoError(err)
+
+	response, err := s.GetTasks(domainID, taskList, p.TaskListTypeActivity, 1)
+	s.NoError(err)
+	s.Equal(1, len(response.Tasks))
+	s.Equal(taskID, response.Tasks[0].TaskID)
+	s.WithinDuration(createdTime, response.Tasks[0].CreatedTime, TimePrecision)
+	s.WithinDuration(expiry, response.Tasks[0].Expiry, TimePrecision)
+}
+
+// TestGetTasksWithoutCreatedTime test
+func (s *MatchingPersistenceSuite) TestGetTasksWithoutCreatedTime() {
+	domainID := "5d0c9a3b-7e21-4f3e-8b7e-3c6a2f1e4b9d"
+	workflowExecution := gen.WorkflowExecution{WorkflowId: common.StringPtr("get-tasks-without-created-time-test"),
+		RunId: common.StringPtr("2d8e4a52-0f8c-4f0e-9d1b-4b9d3c6a2f1e")}
+	taskList := "2f1e4b9d3c6a"
+	leaseResponse, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+
+	taskID := s.GetNextSequenceNumber()
+	_, err = s.TaskMgr.CreateTasks(&p.CreateTasksRequest{
+		TaskListInfo: leaseResponse.TaskListInfo,
+		Tasks: []*p.CreateTaskInfo{
+			{
+				TaskID:    taskID,
+				Execution: workflowExecution,
+				Data: &p.TaskInfo{
+					DomainID:               domainID,
+					WorkflowID:             workflowExecution.GetWorkflowId(),
+					RunID:                  workflowExecution.GetRunId(),
+					TaskID:                 taskID,
+					ScheduleID:             7,
+					ScheduleToStartTimeout: 60,
+				},
+			},
+		},
+	})
+	s.NoError(err)
+
+	// a task without a created time is not reported as created at some arbitrary time,
+	// which would make it look like the oldest task of the backlog
+	response, err := s.GetTasks(domainID, taskList, p.TaskListTypeActivity, 1)
+	s.NoError(err)
+	s.Equal(1, len(response.Tasks))
+	s.Equal(taskID, response.Tasks[0].TaskID)
+	s.True(response.Tasks[0].CreatedTime.IsZero())
+}
+
+// TestGetTasksWithPriority test
+func (s *MatchingPersistenceSuite) TestGetTasksWithPriority() {
+	domainID := "2d6f1c3a-8b5e-4f7a-9c2d-1e4b7a9c3f58"
+	workflowExecution := gen.WorkflowExecution{WorkflowId: common.StringPtr("get-tasks-with-priority-test"),
+		RunId: common.StringPtr("5a9e2c7b-3f1d-4b8a-8e6c-9d2f4a1b7c3e")}
+	taskList := "9d2f4a1b7c3e"
+	leaseResponse, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+
+	taskID := s.GetNextSequenceNumber()
+	_, err = s.TaskMgr.CreateTasks(&p.CreateTasksRequest{
+		TaskListInfo: leaseResponse.TaskListInfo,
+		Tasks: []*p.CreateTaskInfo{
+			{
+				TaskID:    taskID,
+				Execution: workflowExecution,
+				Data: &p.TaskInfo{
+					DomainID:   domainID,
+					WorkflowID: workflowExecution.GetWorkflowId(),
+					RunID:      workflowExecution.GetRunId(),
+					TaskID:     taskID,
+					ScheduleID: 5,
+					Priority:   3,
+				},
+			},
+		},
+	})
+	s.NoError(err)
+
+	response, err := s.GetTasks(domainID, taskList, p.TaskListTypeActivity, 1)
+	s.NoError(err)
+	s.Equal(1, len(response.Tasks))
+	s.Equal(taskID, response.Tasks[0].TaskID)
+	s.Equal(int32(3), response.Tasks[0].Priority)
+}
+
 // TestCompleteDecisionTask test
 func (s *MatchingPersistenceSuite) TestCompleteDecisionTask() {
 	domainID := "f1116985-d1f1-40e0-aba9-83344db915bc"
@@ -196,6 +323,317 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 	s.Error(err)
 }
 
+// TestTaskListDispatchRate test
+func (s *MatchingPersistenceSuite) TestTaskListDispatchRate() {
+	domainID := uuid.New()
+	taskList := "dispatch-rate-tl"
+	response, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeDecision,
+	})
+	s.NoError(err)
+	tli := response.TaskListInfo
+	s.Equal(float64(0), tli.DispatchRate)
+
+	tli.DispatchRate = 12.5
+	_, err = s.TaskMgr.UpdateTaskList(&p.UpdateTaskListRequest{
+		TaskListInfo: tli,
+	})
+	s.NoError(err)
+
+	// the dispatch rate survives the task list moving to another host
+	response, err = s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeDecision,
+	})
+	s.NoError(err)
+	s.Equal(12.5, response.TaskListInfo.DispatchRate)
+}
+
+// TestCreateTasksKeepsTaskListInfo test
+func (s *MatchingPersistenceSuite) TestCreateTasksKeepsTaskListInfo() {
+	domainID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{WorkflowId: common.StringPtr("create-tasks-keeps-task-list-info-test"),
+		RunId: common.StringPtr(uuid.New())}
+	taskList := "create-tasks-tl"
+	response, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	leased := *response.TaskListInfo
+	tli := response.TaskListInfo
+	tli.AckLevel = 5
+	tli.DispatchRate = 3
+	tli.Paused = true
+	_, err = s.TaskMgr.UpdateTaskList(&p.UpdateTaskListRequest{
+		TaskListInfo: tli,
+	})
+	s.NoError(err)
+
+	// creating tasks only checks the range of the task list, it does not write the task list info
+	taskID := s.GetNextSequenceNumber()
+	_, err = s.TaskMgr.CreateTasks(&p.CreateTasksRequest{
+		TaskListInfo: &leased,
+		Tasks: []*p.CreateTaskInfo{
+			{
+				TaskID:    taskID,
+				Execution: workflowExecution,
+				Data: &p.TaskInfo{
+					DomainID:   domainID,
+					WorkflowID: workflowExecution.GetWorkflowId(),
+					RunID:      workflowExecution.GetRunId(),
+					TaskID:     taskID,
+					ScheduleID: 3,
+				},
+			},
+		},
+	})
+	s.NoError(err)
+
+	getResponse, err := s.TaskMgr.GetTaskList(&p.GetTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	s.Equal(int64(5), getResponse.TaskListInfo.AckLevel)
+	s.Equal(float64(3), getResponse.TaskListInfo.DispatchRate)
+	s.True(getResponse.TaskListInfo.Paused)
+}
+
+// TestGetTaskList test
+func (s *MatchingPersistenceSuite) TestGetTaskList() {
+	domainID := uuid.New()
+	taskList := "get-tl"
+	_, err := s.TaskMgr.GetTaskList(&p.GetTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.IsType(&gen.EntityNotExistsError{}, err)
+
+	response, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	tli := response.TaskListInfo
+	tli.DispatchRate = 7
+	_, err = s.TaskMgr.UpdateTaskList(&p.UpdateTaskListRequest{
+		TaskListInfo: tli,
+	})
+	s.NoError(err)
+
+	// reading the task list does not lease it
+	for i := 0; i < 2; i++ {
+		getResponse, err := s.TaskMgr.GetTaskList(&p.GetTaskListRequest{
+			DomainID: domainID,
+			TaskList: taskList,
+			TaskType: p.TaskListTypeActivity,
+		})
+		s.NoError(err)
+		s.Equal(tli.RangeID, getResponse.TaskListInfo.RangeID)
+		s.Equal(float64(7), getResponse.TaskListInfo.DispatchRate)
+	}
+}
+
+// TestTaskListPaused test
+func (s *MatchingPersistenceSuite) TestTaskListPaused() {
+	domainID := uuid.New()
+	taskList := "paused-tl"
+	response, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	tli := response.TaskListInfo
+	s.False(tli.Paused)
+
+	tli.Paused = true
+	_, err = s.TaskMgr.UpdateTaskList(&p.UpdateTaskListRequest{
+		TaskListInfo: tli,
+	})
+	s.NoError(err)
+
+	// the pause survives the task list moving to another host
+	response, err = s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	s.True(response.TaskListInfo.Paused)
+}
+
+// TestTaskListPollers test
+func (s *MatchingPersistenceSuite) TestTaskListPollers() {
+	domainID := uuid.New()
+	taskList := "pollers-tl"
+	response, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	tli := response.TaskListInfo
+	s.Empty(tli.Pollers)
+
+	// timestamps are stored with millisecond precision
+	lastAccessTime := time.Now().Truncate(time.Millisecond).UTC()
+	tli.Pollers = []*p.TaskListPollerInfo{{
+		Identity:       "test-poller",
+		BinaryChecksum: "checksum",
+		SDKVersion:     "1.0.0",
+		Hostname:       "worker-host",
+		RatePerSecond:  5,
+		LastAccessTime: lastAccessTime,
+	}}
+	_, err = s.TaskMgr.UpdateTaskList(&p.UpdateTaskListRequest{
+		TaskListInfo: tli,
+	})
+	s.NoError(err)
+
+	// creating tasks leaves the persisted pollers alone
+	tli.Pollers = nil
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("pollers-workflow"),
+		RunId:      common.StringPtr("2c4f9d3a-1b5e-4c7f-8a2d-3e6b7c8d9f01"),
+	}
+	taskID := s.GetNextSequenceNumber()
+	_, err = s.TaskMgr.CreateTasks(&p.CreateTasksRequest{
+		TaskListInfo: tli,
+		Tasks: []*p.CreateTaskInfo{{
+			TaskID:    taskID,
+			Execution: workflowExecution,
+			Data: &p.TaskInfo{
+				DomainID:   domainID,
+				WorkflowID: *workflowExecution.WorkflowId,
+				RunID:      *workflowExecution.RunId,
+				TaskID:     taskID,
+				ScheduleID: 3,
+			},
+		}},
+	})
+	s.NoError(err)
+
+	// the pollers survive the task list moving to another host
+	response, err = s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	s.Equal(1, len(response.TaskListInfo.Pollers))
+	poller := response.TaskListInfo.Pollers[0]
+	s.Equal("test-poller", poller.Identity)
+	s.Equal("checksum", poller.BinaryChecksum)
+	s.Equal("1.0.0", poller.SDKVersion)
+	s.Equal("worker-host", poller.Hostname)
+	s.Equal(float64(5), poller.RatePerSecond)
+	s.True(lastAccessTime.Equal(poller.LastAccessTime))
+}
+
+// TestListTaskLists test
+func (s *MatchingPersistenceSuite) TestListTaskLists() {
+	domainID := uuid.New()
+	expected := map[string]bool{"list-tl-1": true, "list-tl-2": true, "list-tl-3": true}
+	for taskList := range expected {
+		_, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+			DomainID: domainID,
+			TaskList: taskList,
+			TaskType: p.TaskListTypeDecision,
+		})
+		s.NoError(err)
+	}
+	// task lists of other domains are not listed
+	_, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: uuid.New(),
+		TaskList: "list-tl-1",
+		TaskType: p.TaskListTypeDecision,
+	})
+	s.NoError(err)
+
+	listed := make(map[string]bool)
+	var nextPageToken []byte
+	for {
+		response, err := s.TaskMgr.ListTaskLists(&p.ListTaskListsRequest{
+			DomainID:      domainID,
+			PageSize:      2,
+			NextPageToken: nextPageToken,
+		})
+		s.NoError(err)
+		for _, info := range response.Items {
+			s.Equal(domainID, info.DomainID)
+			s.Equal(p.TaskListTypeDecision, info.TaskType)
+			s.False(info.LastUpdated.IsZero())
+			listed[info.Name] = true
+		}
+		nextPageToken = response.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	s.Equal(expected, listed)
+}
+
+// TestDeleteTaskList test
+func (s *MatchingPersistenceSuite) TestDeleteTaskList() {
+	domainID := uuid.New()
+	taskList := "delete-tl"
+	response, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	rangeID := response.TaskListInfo.RangeID
+
+	// the task list is leased again, the delete with the stale range fails
+	_, err = s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	err = s.TaskMgr.DeleteTaskList(&p.DeleteTaskListRequest{
+		DomainID:     domainID,
+		TaskListName: taskList,
+		TaskListType: p.TaskListTypeActivity,
+		RangeID:      rangeID,
+	})
+	s.IsType(&p.ConditionFailedError{}, err)
+
+	err = s.TaskMgr.DeleteTaskList(&p.DeleteTaskListRequest{
+		DomainID:     domainID,
+		TaskListName: taskList,
+		TaskListType: p.TaskListTypeActivity,
+		RangeID:      rangeID + 1,
+	})
+	s.NoError(err)
+
+	listResponse, err := s.TaskMgr.ListTaskLists(&p.ListTaskListsRequest{
+		DomainID: domainID,
+		PageSize: 10,
+	})
+	s.NoError(err)
+	s.Empty(listResponse.Items)
+
+	// the task list starts over when it is leased after being deleted
+	response, err = s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	s.EqualValues(1, response.TaskListInfo.RangeID)
+}
+
 // TestLeaseAndUpdateTaskListSticky test
 func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskListSticky() {
 	domainID := uuid.New()
@@ -28,6 +28,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/cluster"
@@ -593,6 +594,9 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 			ClusterName: updateClusterStandby,
 		},
 	}
+	failoverStartTime := time.Now().UnixNano()
+	failoverExpireTime := failoverStartTime + int64(time.Minute)
+	failoverMarkers := map[int32]int64{1: 101, 3: 303}
 
 	err3 := m.UpdateDomain(
 		&p.DomainInfo{
@@ -610,8 +614,12 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 			ArchivalStatus: updatedArchivalStatus,
 		},
 		&p.DomainReplicationConfig{
-			ActiveClusterName: updateClusterActive,
-			Clusters:          updateClusters,
+			ActiveClusterName:        updateClusterActive,
+			Clusters:                 updateClusters,
+			PendingActiveClusterName: updateClusterStandby,
+			FailoverStartTime:        failoverStartTime,
+			FailoverExpireTime:       failoverExpireTime,
+			FailoverMarkers:          failoverMarkers,
 		},
 		updateConfigVersion,
 		updateFailoverVersion,
@@ -634,6 +642,10 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 	m.Equal(archivalBucketName, resp4.Config.ArchivalBucket)
 	m.Equal(updatedArchivalStatus, resp4.Config.ArchivalStatus)
 	m.Equal(updateClusterActive, resp4.ReplicationConfig.ActiveClusterName)
+	m.Equal(updateClusterStandby, resp4.ReplicationConfig.PendingActiveClusterName)
+	m.Equal(failoverStartTime, resp4.ReplicationConfig.FailoverStartTime)
+	m.Equal(failoverExpireTime, resp4.ReplicationConfig.FailoverExpireTime)
+	m.Equal(failoverMarkers, resp4.ReplicationConfig.FailoverMarkers)
 	m.Equal(len(updateClusters), len(resp4.ReplicationConfig.Clusters))
 	for index := range clusters {
 		m.Equal(updateClusters[index], resp4.ReplicationConfig.Clusters[index])
@@ -657,6 +669,10 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 	m.Equal(archivalBucketName, resp5.Config.ArchivalBucket)
 	m.Equal(updatedArchivalStatus, resp5.Config.ArchivalStatus)
 	m.Equal(updateClusterActive, resp5.ReplicationConfig.ActiveClusterName)
+	m.Equal(updateClusterStandby, resp5.ReplicationConfig.PendingActiveClusterName)
+	m.Equal(failoverStartTime, resp5.ReplicationConfig.FailoverStartTime)
+	m.Equal(failoverExpireTime, resp5.ReplicationConfig.FailoverExpireTime)
+	m.Equal(failoverMarkers, resp5.ReplicationConfig.FailoverMarkers)
 	m.Equal(len(updateClusters), len(resp5.ReplicationConfig.Clusters))
 	for index := range clusters {
 		m.Equal(updateClusters[index], resp5.ReplicationConfig.Clusters[index])
@@ -889,6 +905,53 @@ ListLoop:
 	}
 }
 
+// TestClusterMetadata test
+func (m *MetadataPersistenceSuiteV2) TestClusterMetadata() {
+	info := &p.ClusterMetadataInfo{
+		ClusterName:            "cluster-" + uuid.New(),
+		InitialFailoverVersion: 2,
+		RPCName:                "cadence-frontend",
+		RPCAddress:             "127.0.0.1:7933",
+		Enabled:                true,
+	}
+	err := m.MetadataManagerV2.CreateClusterMetadata(&p.CreateClusterMetadataRequest{Info: info})
+	m.NoError(err)
+
+	err = m.MetadataManagerV2.CreateClusterMetadata(&p.CreateClusterMetadataRequest{Info: info})
+	m.Error(err)
+	m.IsType(&gen.BadRequestError{}, err)
+
+	m.Equal(info, m.getClusterMetadata(info.ClusterName))
+
+	updatedInfo := &p.ClusterMetadataInfo{
+		ClusterName:            info.ClusterName,
+		InitialFailoverVersion: 2,
+		RPCName:                "cadence-frontend",
+		RPCAddress:             "127.0.0.2:7933",
+		Enabled:                false,
+	}
+	err = m.MetadataManagerV2.UpdateClusterMetadata(&p.UpdateClusterMetadataRequest{Info: updatedInfo})
+	m.NoError(err)
+	m.Equal(updatedInfo, m.getClusterMetadata(info.ClusterName))
+
+	err = m.MetadataManagerV2.UpdateClusterMetadata(&p.UpdateClusterMetadataRequest{
+		Info: &p.ClusterMetadataInfo{ClusterName: "cluster-" + uuid.New()},
+	})
+	m.Error(err)
+	m.IsType(&gen.EntityNotExistsError{}, err)
+}
+
+func (m *MetadataPersistenceSuiteV2) getClusterMetadata(clusterName string) *p.ClusterMetadataInfo {
+	resp, err := m.MetadataManagerV2.ListClusterMetadata()
+	m.NoError(err)
+	for _, info := range resp.Clusters {
+		if info.ClusterName == clusterName {
+			return info
+		}
+	}
+	return nil
+}
+
 // CreateDomain helper method
 func (m *MetadataPersistenceSuiteV2) CreateDomain(info *p.DomainInfo, config *p.DomainConfig,
 	replicationConfig *p.DomainReplicationConfig, isGlobaldomain bool, configVersion int64, failoverVersion int64) (*p.CreateDomainResponse, error) {
@@ -38,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/cluster"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/cassandra"
+	"github.com/uber/cadence/common/persistence/memory"
 	pfactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/service/config"
@@ -66,6 +67,8 @@ type (
 		EnableGlobalDomain bool // is global domain enabled
 		IsMasterCluster    bool // is master cluster
 		ClusterMetadata    cluster.Metadata
+		// FaultInjection, when set, wraps the persistence managers with fault injection clients
+		FaultInjection *config.FaultInjectionConfig
 	}
 
 	// TestBase wraps the base setup needed to create workflows over persistence layer.
@@ -88,6 +91,7 @@ type (
 		ReplicationReadLevel  int64
 		DefaultTestCluster    PersistenceTestCluster
 		VisibilityTestCluster PersistenceTestCluster
+		FaultInjection        *config.FaultInjectionConfig
 	}
 
 	// PersistenceTestCluster exposes management operations on a database
@@ -127,6 +131,15 @@ func NewTestBaseWithSQL(options *TestBaseOptions) TestBase {
 	return newTestBase(options, testCluster)
 }
 
+// NewTestBaseWithMemory returns a new persistence test base backed by an in-memory datastore
+func NewTestBaseWithMemory(options *TestBaseOptions) TestBase {
+	if options.DBName == "" {
+		options.DBName = GenerateRandomDBName(10)
+	}
+	testCluster := memory.NewTestCluster(options.DBName)
+	return newTestBase(options, testCluster)
+}
+
 func newTestBase(options *TestBaseOptions, testCluster PersistenceTestCluster) TestBase {
 	metadata := options.ClusterMetadata
 	if metadata == nil {
@@ -137,6 +150,7 @@ func newTestBase(options *TestBaseOptions, testCluster PersistenceTestCluster) T
 		DefaultTestCluster:    testCluster,
 		VisibilityTestCluster: testCluster,
 		ClusterMetadata:       metadata,
+		FaultInjection:        options.FaultInjection,
 	}
 }
 
@@ -153,6 +167,9 @@ func (s *TestBase) Setup() {
 	}
 
 	cfg := s.DefaultTestCluster.Config()
+	if s.FaultInjection != nil {
+		cfg.FaultInjection = *s.FaultInjection
+	}
 	factory := pfactory.New(&cfg, clusterName, nil, log)
 
 	s.TaskMgr, err = factory.NewTaskManager()
@@ -186,6 +203,7 @@ func (s *TestBase) Setup() {
 	visibilityFactory := factory
 	if s.VisibilityTestCluster != s.DefaultTestCluster {
 		vCfg := s.VisibilityTestCluster.Config()
+		vCfg.FaultInjection = cfg.FaultInjection
 		visibilityFactory = pfactory.New(&vCfg, clusterName, nil, log)
 	}
 	// SQL currently doesn't have support for visibility manager
@@ -154,6 +154,30 @@ func (s *ShardPersistenceSuite) TestUpdateShard() {
 	s.EqualTimes(updatedTimerAckLevel, info1.TimerAckLevel)
 }
 
+// TestUpdateShardClusterReplicationLevel test
+func (s *ShardPersistenceSuite) TestUpdateShardClusterReplicationLevel() {
+	shardID := 31
+	rangeID := int64(151)
+	err0 := s.CreateShard(shardID, "test_update_shard_replication_level", rangeID)
+	s.Nil(err0, "No error expected.")
+
+	shardInfo, err1 := s.GetShard(shardID)
+	s.Nil(err1)
+	s.NotNil(shardInfo)
+
+	updatedInfo := copyShardInfo(shardInfo)
+	updatedInfo.RangeID = rangeID + 1
+	updatedInfo.ClusterReplicationLevel = map[string]int64{"standby": 1234}
+	err2 := s.UpdateShard(updatedInfo, shardInfo.RangeID)
+	s.Nil(err2)
+
+	info1, err3 := s.GetShard(shardID)
+	s.Nil(err3)
+	s.NotNil(info1)
+	s.Equal(rangeID+1, info1.RangeID)
+	s.Equal(map[string]int64{"standby": 1234}, info1.ClusterReplicationLevel)
+}
+
 func copyShardInfo(sourceInfo *p.ShardInfo) *p.ShardInfo {
 	return &p.ShardInfo{
 		ShardID:             sourceInfo.ShardID,
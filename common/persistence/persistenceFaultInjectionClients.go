@@ -0,0 +1,657 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	// FaultInjectionErrorTypeTimeout injects TimeoutError
+	FaultInjectionErrorTypeTimeout = "timeout"
+	// FaultInjectionErrorTypeConditionFailed injects ConditionFailedError
+	FaultInjectionErrorTypeConditionFailed = "conditionFailed"
+	// FaultInjectionErrorTypeShardOwnershipLost injects ShardOwnershipLostError
+	FaultInjectionErrorTypeShardOwnershipLost = "shardOwnershipLost"
+	// FaultInjectionErrorTypeGeneric injects InternalServiceError
+	FaultInjectionErrorTypeGeneric = "generic"
+)
+
+type (
+	shardFaultInjectionPersistenceClient struct {
+		config      *config.FaultInjectionConfig
+		persistence ShardManager
+		logger      bark.Logger
+	}
+
+	workflowExecutionFaultInjectionPersistenceClient struct {
+		config      *config.FaultInjectionConfig
+		persistence ExecutionManager
+		logger      bark.Logger
+	}
+
+	taskFaultInjectionPersistenceClient struct {
+		config      *config.FaultInjectionConfig
+		persistence TaskManager
+		logger      bark.Logger
+	}
+
+	historyFaultInjectionPersistenceClient struct {
+		config      *config.FaultInjectionConfig
+		persistence HistoryManager
+		logger      bark.Logger
+	}
+
+	historyV2FaultInjectionPersistenceClient struct {
+		config      *config.FaultInjectionConfig
+		persistence HistoryV2Manager
+		logger      bark.Logger
+	}
+
+	metadataFaultInjectionPersistenceClient struct {
+		config      *config.FaultInjectionConfig
+		persistence MetadataManager
+		logger      bark.Logger
+	}
+
+	visibilityFaultInjectionPersistenceClient struct {
+		config      *config.FaultInjectionConfig
+		persistence VisibilityManager
+		logger      bark.Logger
+	}
+)
+
+var _ ShardManager = (*shardFaultInjectionPersistenceClient)(nil)
+var _ ExecutionManager = (*workflowExecutionFaultInjectionPersistenceClient)(nil)
+var _ TaskManager = (*taskFaultInjectionPersistenceClient)(nil)
+var _ HistoryManager = (*historyFaultInjectionPersistenceClient)(nil)
+var _ HistoryV2Manager = (*historyV2FaultInjectionPersistenceClient)(nil)
+var _ MetadataManager = (*metadataFaultInjectionPersistenceClient)(nil)
+var _ VisibilityManager = (*visibilityFaultInjectionPersistenceClient)(nil)
+
+// NewShardPersistenceFaultInjectionClient creates a client to manage shards which injects errors and latency
+func NewShardPersistenceFaultInjectionClient(persistence ShardManager, config *config.FaultInjectionConfig, logger bark.Logger) ShardManager {
+	return &shardFaultInjectionPersistenceClient{
+		persistence: persistence,
+		config:      config,
+		logger:      logger,
+	}
+}
+
+// NewWorkflowExecutionPersistenceFaultInjectionClient creates a client to manage executions which injects errors and latency
+func NewWorkflowExecutionPersistenceFaultInjectionClient(persistence ExecutionManager, config *config.FaultInjectionConfig, logger bark.Logger) ExecutionManager {
+	return &workflowExecutionFaultInjectionPersistenceClient{
+		persistence: persistence,
+		config:      config,
+		logger:      logger,
+	}
+}
+
+// NewTaskPersistenceFaultInjectionClient creates a client to manage tasks which injects errors and latency
+func NewTaskPersistenceFaultInjectionClient(persistence TaskManager, config *config.FaultInjectionConfig, logger bark.Logger) TaskManager {
+	return &taskFaultInjectionPersistenceClient{
+		persistence: persistence,
+		config:      config,
+		logger:      logger,
+	}
+}
+
+// NewHistoryPersistenceFaultInjectionClient creates a client to manage workflow execution history which injects errors and latency
+func NewHistoryPersistenceFaultInjectionClient(persistence HistoryManager, config *config.FaultInjectionConfig, logger bark.Logger) HistoryManager {
+	return &historyFaultInjectionPersistenceClient{
+		persistence: persistence,
+		config:      config,
+		logger:      logger,
+	}
+}
+
+// NewHistoryV2PersistenceFaultInjectionClient creates a client to manage history branches which injects errors and latency
+func NewHistoryV2PersistenceFaultInjectionClient(persistence HistoryV2Manager, config *config.FaultInjectionConfig, logger bark.Logger) HistoryV2Manager {
+	return &historyV2FaultInjectionPersistenceClient{
+		persistence: persistence,
+		config:      config,
+		logger:      logger,
+	}
+}
+
+// NewMetadataPersistenceFaultInjectionClient creates a client to manage domains which injects errors and latency
+func NewMetadataPersistenceFaultInjectionClient(persistence MetadataManager, config *config.FaultInjectionConfig, logger bark.Logger) MetadataManager {
+	return &metadataFaultInjectionPersistenceClient{
+		persistence: persistence,
+		config:      config,
+		logger:      logger,
+	}
+}
+
+// NewVisibilityPersistenceFaultInjectionClient creates a client to manage visibility records which injects errors and latency
+func NewVisibilityPersistenceFaultInjectionClient(persistence VisibilityManager, config *config.FaultInjectionConfig, logger bark.Logger) VisibilityManager {
+	return &visibilityFaultInjectionPersistenceClient{
+		persistence: persistence,
+		config:      config,
+		logger:      logger,
+	}
+}
+
+// injectFault sleeps for the configured latency and then, based on the configured
+// error rate, returns the error to fail the given persistence operation with
+func injectFault(cfg *config.FaultInjectionConfig, operation string, shardID int, logger bark.Logger) error {
+	filter := dynamicconfig.PersistenceOperationFilter(operation)
+	if latency := cfg.Latency(filter); latency > 0 {
+		time.Sleep(latency)
+	}
+	if rand.Float64() >= cfg.ErrorRate(filter) {
+		return nil
+	}
+
+	msg := fmt.Sprintf("Injected persistence fault for %v.", operation)
+	logger.WithField("operation", operation).Debug(msg)
+	switch cfg.ErrorType(filter) {
+	case FaultInjectionErrorTypeTimeout:
+		return &TimeoutError{Msg: msg}
+	case FaultInjectionErrorTypeConditionFailed:
+		return &ConditionFailedError{Msg: msg}
+	case FaultInjectionErrorTypeShardOwnershipLost:
+		return &ShardOwnershipLostError{ShardID: shardID, Msg: msg}
+	default:
+		return &workflow.InternalServiceError{Message: msg}
+	}
+}
+
+func (p *shardFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *shardFaultInjectionPersistenceClient) CreateShard(request *CreateShardRequest) error {
+	if err := injectFault(p.config, "CreateShard", request.ShardInfo.ShardID, p.logger); err != nil {
+		return err
+	}
+	return p.persistence.CreateShard(request)
+}
+
+func (p *shardFaultInjectionPersistenceClient) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
+	if err := injectFault(p.config, "GetShard", request.ShardID, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.GetShard(request)
+}
+
+func (p *shardFaultInjectionPersistenceClient) UpdateShard(request *UpdateShardRequest) error {
+	if err := injectFault(p.config, "UpdateShard", request.ShardInfo.ShardID, p.logger); err != nil {
+		return err
+	}
+	return p.persistence.UpdateShard(request)
+}
+
+func (p *shardFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetShardID() int {
+	return p.persistence.GetShardID()
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+	if err := injectFault(p.config, "CreateWorkflowExecution", p.persistence.GetShardID(), p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.CreateWorkflowExecution(request)
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+	if err := injectFault(p.config, "GetWorkflowExecution", p.persistence.GetShardID(), p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.GetWorkflowExecution(request)
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	if err := injectFault(p.config, "UpdateWorkflowExecution", p.persistence.GetShardID(), p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.UpdateWorkflowExecution(request)
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) ResetMutableState(request *ResetMutableStateRequest) error {
+	if err := injectFault(p.config, "ResetMutableState", p.persistence.GetShardID(), p.logger); err != nil {
+		return err
+	}
+	return p.persistence.ResetMutableState(request)
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) ResetWorkflowExecution(request *ResetWorkflowExecutionRequest) error {
+	if err := injectFault(p.config, "ResetWorkflowExecution", p.persistence.GetShardID(), p.logger); err != nil {
+		return err
+	}
+	return p.persistence.ResetWorkflowExecution(request)
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	if err := injectFault(p.config, "DeleteWorkflowExecution", p.persistence.GetShardID(), p.logger); err != nil {
+		return err
+	}
+	return p.persistence.DeleteWorkflowExecution(request)
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
+	if err := injectFault(p.config, "GetCurrentExecution", p.persistence.GetShardID(), p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.GetCurrentExecution(request)
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	if err := injectFault(p.config, "GetTransferTasks", p.persistence.GetShardID(), p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.GetTransferTasks(request)
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
+	if err := injectFault(p.config, "GetReplicationTasks", p.persistence.GetShardID(), p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.GetReplicationTasks(request)
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	if err := injectFault(p.config, "CompleteTransferTask", p.persistence.GetShardID(), p.logger); err != nil {
+		return err
+	}
+	return p.persistence.CompleteTransferTask(request)
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
+	if err := injectFault(p.config, "RangeCompleteTransferTask", p.persistence.GetShardID(), p.logger); err != nil {
+		return err
+	}
+	return p.persistence.RangeCompleteTransferTask(request)
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
+	if err := injectFault(p.config, "CompleteReplicationTask", p.persistence.GetShardID(), p.logger); err != nil {
+		return err
+	}
+	return p.persistence.CompleteReplicationTask(request)
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
+	if err := injectFault(p.config, "GetTimerIndexTasks", p.persistence.GetShardID(), p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.GetTimerIndexTasks(request)
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	if err := injectFault(p.config, "CompleteTimerTask", p.persistence.GetShardID(), p.logger); err != nil {
+		return err
+	}
+	return p.persistence.CompleteTimerTask(request)
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+	if err := injectFault(p.config, "RangeCompleteTimerTask", p.persistence.GetShardID(), p.logger); err != nil {
+		return err
+	}
+	return p.persistence.RangeCompleteTimerTask(request)
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *taskFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *taskFaultInjectionPersistenceClient) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	if err := injectFault(p.config, "CreateTasks", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.CreateTasks(request)
+}
+
+func (p *taskFaultInjectionPersistenceClient) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+	if err := injectFault(p.config, "GetTasks", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.GetTasks(request)
+}
+
+func (p *taskFaultInjectionPersistenceClient) CompleteTask(request *CompleteTaskRequest) error {
+	if err := injectFault(p.config, "CompleteTask", 0, p.logger); err != nil {
+		return err
+	}
+	return p.persistence.CompleteTask(request)
+}
+
+func (p *taskFaultInjectionPersistenceClient) GetTaskList(request *GetTaskListRequest) (*GetTaskListResponse, error) {
+	if err := injectFault(p.config, "GetTaskList", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.GetTaskList(request)
+}
+
+func (p *taskFaultInjectionPersistenceClient) ListTaskLists(request *ListTaskListsRequest) (*ListTaskListsResponse, error) {
+	if err := injectFault(p.config, "ListTaskLists", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.ListTaskLists(request)
+}
+
+func (p *taskFaultInjectionPersistenceClient) DeleteTaskList(request *DeleteTaskListRequest) error {
+	if err := injectFault(p.config, "DeleteTaskList", 0, p.logger); err != nil {
+		return err
+	}
+	return p.persistence.DeleteTaskList(request)
+}
+
+func (p *taskFaultInjectionPersistenceClient) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
+	if err := injectFault(p.config, "LeaseTaskList", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.LeaseTaskList(request)
+}
+
+func (p *taskFaultInjectionPersistenceClient) UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
+	if err := injectFault(p.config, "UpdateTaskList", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.UpdateTaskList(request)
+}
+
+func (p *taskFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *historyFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *historyFaultInjectionPersistenceClient) AppendHistoryEvents(request *AppendHistoryEventsRequest) (*AppendHistoryEventsResponse, error) {
+	if err := injectFault(p.config, "AppendHistoryEvents", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.AppendHistoryEvents(request)
+}
+
+func (p *historyFaultInjectionPersistenceClient) GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error) {
+	if err := injectFault(p.config, "GetWorkflowExecutionHistory", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.GetWorkflowExecutionHistory(request)
+}
+
+func (p *historyFaultInjectionPersistenceClient) GetWorkflowExecutionHistoryByBatch(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryByBatchResponse, error) {
+	if err := injectFault(p.config, "GetWorkflowExecutionHistoryByBatch", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.GetWorkflowExecutionHistoryByBatch(request)
+}
+
+func (p *historyFaultInjectionPersistenceClient) DeleteWorkflowExecutionHistory(request *DeleteWorkflowExecutionHistoryRequest) error {
+	if err := injectFault(p.config, "DeleteWorkflowExecutionHistory", 0, p.logger); err != nil {
+		return err
+	}
+	return p.persistence.DeleteWorkflowExecutionHistory(request)
+}
+
+func (p *historyFaultInjectionPersistenceClient) CreateConflictResolutionAudit(request *CreateConflictResolutionAuditRequest) error {
+	if err := injectFault(p.config, "CreateConflictResolutionAudit", 0, p.logger); err != nil {
+		return err
+	}
+	return p.persistence.CreateConflictResolutionAudit(request)
+}
+
+func (p *historyFaultInjectionPersistenceClient) ListConflictResolutionAudits(
+	request *ListConflictResolutionAuditsRequest) (*ListConflictResolutionAuditsResponse, error) {
+	if err := injectFault(p.config, "ListConflictResolutionAudits", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.ListConflictResolutionAudits(request)
+}
+
+func (p *historyFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *historyV2FaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *historyV2FaultInjectionPersistenceClient) CompleteForkBranch(request *CompleteForkBranchRequest) error {
+	if err := injectFault(p.config, "CompleteForkBranch", 0, p.logger); err != nil {
+		return err
+	}
+	return p.persistence.CompleteForkBranch(request)
+}
+
+func (p *historyV2FaultInjectionPersistenceClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
+	if err := injectFault(p.config, "AppendHistoryNodes", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.AppendHistoryNodes(request)
+}
+
+func (p *historyV2FaultInjectionPersistenceClient) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+	if err := injectFault(p.config, "ReadHistoryBranch", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.ReadHistoryBranch(request)
+}
+
+func (p *historyV2FaultInjectionPersistenceClient) ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
+	if err := injectFault(p.config, "ReadHistoryBranchByBatch", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.ReadHistoryBranchByBatch(request)
+}
+
+func (p *historyV2FaultInjectionPersistenceClient) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
+	if err := injectFault(p.config, "ForkHistoryBranch", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.ForkHistoryBranch(request)
+}
+
+func (p *historyV2FaultInjectionPersistenceClient) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
+	if err := injectFault(p.config, "DeleteHistoryBranch", 0, p.logger); err != nil {
+		return err
+	}
+	return p.persistence.DeleteHistoryBranch(request)
+}
+
+func (p *historyV2FaultInjectionPersistenceClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	if err := injectFault(p.config, "GetHistoryTree", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.GetHistoryTree(request)
+}
+
+func (p *historyV2FaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *metadataFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *metadataFaultInjectionPersistenceClient) CreateDomain(request *CreateDomainRequest) (*CreateDomainResponse, error) {
+	if err := injectFault(p.config, "CreateDomain", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.CreateDomain(request)
+}
+
+func (p *metadataFaultInjectionPersistenceClient) GetDomain(request *GetDomainRequest) (*GetDomainResponse, error) {
+	if err := injectFault(p.config, "GetDomain", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.GetDomain(request)
+}
+
+func (p *metadataFaultInjectionPersistenceClient) UpdateDomain(request *UpdateDomainRequest) error {
+	if err := injectFault(p.config, "UpdateDomain", 0, p.logger); err != nil {
+		return err
+	}
+	return p.persistence.UpdateDomain(request)
+}
+
+func (p *metadataFaultInjectionPersistenceClient) DeleteDomain(request *DeleteDomainRequest) error {
+	if err := injectFault(p.config, "DeleteDomain", 0, p.logger); err != nil {
+		return err
+	}
+	return p.persistence.DeleteDomain(request)
+}
+
+func (p *metadataFaultInjectionPersistenceClient) DeleteDomainByName(request *DeleteDomainByNameRequest) error {
+	if err := injectFault(p.config, "DeleteDomainByName", 0, p.logger); err != nil {
+		return err
+	}
+	return p.persistence.DeleteDomainByName(request)
+}
+
+func (p *metadataFaultInjectionPersistenceClient) ListDomains(request *ListDomainsRequest) (*ListDomainsResponse, error) {
+	if err := injectFault(p.config, "ListDomains", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.ListDomains(request)
+}
+
+func (p *metadataFaultInjectionPersistenceClient) GetMetadata() (*GetMetadataResponse, error) {
+	if err := injectFault(p.config, "GetMetadata", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.GetMetadata()
+}
+
+func (p *metadataFaultInjectionPersistenceClient) CreateClusterMetadata(request *CreateClusterMetadataRequest) error {
+	if err := injectFault(p.config, "CreateClusterMetadata", 0, p.logger); err != nil {
+		return err
+	}
+	return p.persistence.CreateClusterMetadata(request)
+}
+
+func (p *metadataFaultInjectionPersistenceClient) UpdateClusterMetadata(request *UpdateClusterMetadataRequest) error {
+	if err := injectFault(p.config, "UpdateClusterMetadata", 0, p.logger); err != nil {
+		return err
+	}
+	return p.persistence.UpdateClusterMetadata(request)
+}
+
+func (p *metadataFaultInjectionPersistenceClient) ListClusterMetadata() (*ListClusterMetadataResponse, error) {
+	if err := injectFault(p.config, "ListClusterMetadata", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.ListClusterMetadata()
+}
+
+func (p *metadataFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error {
+	if err := injectFault(p.config, "RecordWorkflowExecutionStarted", 0, p.logger); err != nil {
+		return err
+	}
+	return p.persistence.RecordWorkflowExecutionStarted(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error {
+	if err := injectFault(p.config, "RecordWorkflowExecutionClosed", 0, p.logger); err != nil {
+		return err
+	}
+	return p.persistence.RecordWorkflowExecutionClosed(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := injectFault(p.config, "ListOpenWorkflowExecutions", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.ListOpenWorkflowExecutions(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := injectFault(p.config, "ListClosedWorkflowExecutions", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.ListClosedWorkflowExecutions(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := injectFault(p.config, "ListOpenWorkflowExecutionsByType", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.ListOpenWorkflowExecutionsByType(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := injectFault(p.config, "ListClosedWorkflowExecutionsByType", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.ListClosedWorkflowExecutionsByType(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := injectFault(p.config, "ListOpenWorkflowExecutionsByWorkflowID", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.ListOpenWorkflowExecutionsByWorkflowID(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := injectFault(p.config, "ListClosedWorkflowExecutionsByWorkflowID", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.ListClosedWorkflowExecutionsByWorkflowID(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := injectFault(p.config, "ListClosedWorkflowExecutionsByStatus", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.ListClosedWorkflowExecutionsByStatus(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+	if err := injectFault(p.config, "GetClosedWorkflowExecution", 0, p.logger); err != nil {
+		return nil, err
+	}
+	return p.persistence.GetClosedWorkflowExecution(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
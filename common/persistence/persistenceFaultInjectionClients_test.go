@@ -0,0 +1,128 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	faultInjectionClientSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		logger    bark.Logger
+		errorRate float64
+		errorType string
+		latency   time.Duration
+		config    *config.FaultInjectionConfig
+	}
+)
+
+func TestFaultInjectionClientSuite(t *testing.T) {
+	s := new(faultInjectionClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *faultInjectionClientSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+	s.logger = bark.NewLoggerFromLogrus(log.New())
+}
+
+func (s *faultInjectionClientSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+	s.errorRate = 0
+	s.errorType = FaultInjectionErrorTypeGeneric
+	s.latency = 0
+	s.config = &config.FaultInjectionConfig{
+		Enabled: dynamicconfig.GetBoolPropertyFn(true),
+		ErrorRate: func(opts ...dynamicconfig.FilterOption) float64 {
+			return s.errorRate
+		},
+		ErrorType: func(opts ...dynamicconfig.FilterOption) string {
+			return s.errorType
+		},
+		Latency: func(opts ...dynamicconfig.FilterOption) time.Duration {
+			return s.latency
+		},
+	}
+}
+
+func (s *faultInjectionClientSuite) TestNoErrorRate() {
+	s.NoError(injectFault(s.config, "GetShard", 1, s.logger))
+}
+
+func (s *faultInjectionClientSuite) TestErrorTypes() {
+	s.errorRate = 1
+
+	s.errorType = FaultInjectionErrorTypeTimeout
+	err := injectFault(s.config, "CreateTasks", 0, s.logger)
+	s.IsType(&TimeoutError{}, err)
+
+	s.errorType = FaultInjectionErrorTypeConditionFailed
+	err = injectFault(s.config, "UpdateWorkflowExecution", 0, s.logger)
+	s.IsType(&ConditionFailedError{}, err)
+
+	s.errorType = FaultInjectionErrorTypeShardOwnershipLost
+	err = injectFault(s.config, "UpdateWorkflowExecution", 5, s.logger)
+	s.IsType(&ShardOwnershipLostError{}, err)
+	s.Equal(5, err.(*ShardOwnershipLostError).ShardID)
+
+	s.errorType = FaultInjectionErrorTypeGeneric
+	err = injectFault(s.config, "GetDomain", 0, s.logger)
+	s.IsType(&workflow.InternalServiceError{}, err)
+}
+
+func (s *faultInjectionClientSuite) TestPerOperationErrorRate() {
+	s.config.ErrorRate = func(opts ...dynamicconfig.FilterOption) float64 {
+		filterMap := make(map[dynamicconfig.Filter]interface{})
+		for _, opt := range opts {
+			opt(filterMap)
+		}
+		if filterMap[dynamicconfig.PersistenceOperation] == "CompleteTask" {
+			return 1
+		}
+		return 0
+	}
+	s.Error(injectFault(s.config, "CompleteTask", 0, s.logger))
+	s.NoError(injectFault(s.config, "CreateTasks", 0, s.logger))
+}
+
+func (s *faultInjectionClientSuite) TestLatency() {
+	s.latency = 20 * time.Millisecond
+	start := time.Now()
+	s.NoError(injectFault(s.config, "GetTasks", 0, s.logger))
+	s.True(time.Since(start) >= s.latency)
+}
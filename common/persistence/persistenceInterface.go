@@ -89,6 +89,8 @@ type (
 		GetWorkflowExecutionHistory(request *InternalGetWorkflowExecutionHistoryRequest) (*InternalGetWorkflowExecutionHistoryResponse, error)
 		//DEPRECATED in favor of V2 APIs-DeleteHistoryBranch
 		DeleteWorkflowExecutionHistory(request *DeleteWorkflowExecutionHistoryRequest) error
+		CreateConflictResolutionAudit(request *CreateConflictResolutionAuditRequest) error
+		ListConflictResolutionAudits(request *ListConflictResolutionAuditsRequest) (*ListConflictResolutionAuditsResponse, error)
 	}
 
 	// HistoryV2Store is to manager workflow history events
@@ -174,6 +176,8 @@ type (
 		BranchToken       []byte
 		CronSchedule      string
 		ExpirationSeconds int32
+		// Priority of the decision tasks of the workflow
+		Priority int32
 	}
 
 	// InternalWorkflowMutableState indicates workflow related state for Persistence Interface
@@ -509,6 +509,48 @@ func (p *taskPersistenceClient) CompleteTask(request *CompleteTaskRequest) error
 	return err
 }
 
+func (p *taskPersistenceClient) GetTaskList(request *GetTaskListRequest) (*GetTaskListResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetTaskListScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetTaskListScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetTaskList(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetTaskListScope, err)
+	}
+
+	return response, err
+}
+
+func (p *taskPersistenceClient) ListTaskLists(request *ListTaskListsRequest) (*ListTaskListsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceListTaskListsScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceListTaskListsScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ListTaskLists(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceListTaskListsScope, err)
+	}
+
+	return response, err
+}
+
+func (p *taskPersistenceClient) DeleteTaskList(request *DeleteTaskListRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceDeleteTaskListScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteTaskListScope, metrics.PersistenceLatency)
+	err := p.persistence.DeleteTaskList(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceDeleteTaskListScope, err)
+	}
+
+	return err
+}
+
 func (p *taskPersistenceClient) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceLeaseTaskListScope, metrics.PersistenceRequests)
 
@@ -623,6 +665,35 @@ func (p *historyPersistenceClient) DeleteWorkflowExecutionHistory(
 	return err
 }
 
+func (p *historyPersistenceClient) CreateConflictResolutionAudit(request *CreateConflictResolutionAuditRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceCreateConflictResolutionAuditScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceCreateConflictResolutionAuditScope, metrics.PersistenceLatency)
+	err := p.persistence.CreateConflictResolutionAudit(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceCreateConflictResolutionAuditScope, err)
+	}
+
+	return err
+}
+
+func (p *historyPersistenceClient) ListConflictResolutionAudits(
+	request *ListConflictResolutionAuditsRequest) (*ListConflictResolutionAuditsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceListConflictResolutionAuditsScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceListConflictResolutionAuditsScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ListConflictResolutionAudits(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceListConflictResolutionAuditsScope, err)
+	}
+
+	return response, err
+}
+
 func (p *historyPersistenceClient) updateErrorMetric(scope int, err error) {
 	switch err.(type) {
 	case *workflow.EntityNotExistsError:
@@ -750,6 +821,48 @@ func (p *metadataPersistenceClient) GetMetadata() (*GetMetadataResponse, error)
 	return response, err
 }
 
+func (p *metadataPersistenceClient) CreateClusterMetadata(request *CreateClusterMetadataRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceCreateClusterMetadataScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceCreateClusterMetadataScope, metrics.PersistenceLatency)
+	err := p.persistence.CreateClusterMetadata(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceCreateClusterMetadataScope, err)
+	}
+
+	return err
+}
+
+func (p *metadataPersistenceClient) UpdateClusterMetadata(request *UpdateClusterMetadataRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceUpdateClusterMetadataScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateClusterMetadataScope, metrics.PersistenceLatency)
+	err := p.persistence.UpdateClusterMetadata(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceUpdateClusterMetadataScope, err)
+	}
+
+	return err
+}
+
+func (p *metadataPersistenceClient) ListClusterMetadata() (*ListClusterMetadataResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceListClusterMetadataScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceListClusterMetadataScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ListClusterMetadata()
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceListClusterMetadataScope, err)
+	}
+
+	return response, err
+}
+
 func (p *metadataPersistenceClient) Close() {
 	p.persistence.Close()
 }
@@ -370,6 +370,33 @@ func (p *taskRateLimitedPersistenceClient) CompleteTask(request *CompleteTaskReq
 	return err
 }
 
+func (p *taskRateLimitedPersistenceClient) GetTaskList(request *GetTaskListRequest) (*GetTaskListResponse, error) {
+	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.GetTaskList(request)
+	return response, err
+}
+
+func (p *taskRateLimitedPersistenceClient) ListTaskLists(request *ListTaskListsRequest) (*ListTaskListsResponse, error) {
+	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.ListTaskLists(request)
+	return response, err
+}
+
+func (p *taskRateLimitedPersistenceClient) DeleteTaskList(request *DeleteTaskListRequest) error {
+	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.DeleteTaskList(request)
+	return err
+}
+
 func (p *taskRateLimitedPersistenceClient) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
 	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
 		return nil, ErrPersistenceLimitExceeded
@@ -432,6 +459,25 @@ func (p *historyRateLimitedPersistenceClient) DeleteWorkflowExecutionHistory(req
 	return err
 }
 
+func (p *historyRateLimitedPersistenceClient) CreateConflictResolutionAudit(request *CreateConflictResolutionAuditRequest) error {
+	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.CreateConflictResolutionAudit(request)
+	return err
+}
+
+func (p *historyRateLimitedPersistenceClient) ListConflictResolutionAudits(
+	request *ListConflictResolutionAuditsRequest) (*ListConflictResolutionAuditsResponse, error) {
+	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.ListConflictResolutionAudits(request)
+	return response, err
+}
+
 func (p *historyRateLimitedPersistenceClient) Close() {
 	p.persistence.Close()
 }
@@ -503,6 +549,33 @@ func (p *metadataRateLimitedPersistenceClient) GetMetadata() (*GetMetadataRespon
 	return response, err
 }
 
+func (p *metadataRateLimitedPersistenceClient) CreateClusterMetadata(request *CreateClusterMetadataRequest) error {
+	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.CreateClusterMetadata(request)
+	return err
+}
+
+func (p *metadataRateLimitedPersistenceClient) UpdateClusterMetadata(request *UpdateClusterMetadataRequest) error {
+	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.UpdateClusterMetadata(request)
+	return err
+}
+
+func (p *metadataRateLimitedPersistenceClient) ListClusterMetadata() (*ListClusterMetadataResponse, error) {
+	if ok, _ := p.rateLimiter.TryConsume(1); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.ListClusterMetadata()
+	return response, err
+}
+
 func (p *metadataRateLimitedPersistenceClient) Close() {
 	p.persistence.Close()
 }
@@ -65,6 +65,7 @@ func (m *sqlStore) txExecute(operation string, f func(tx *sqlx.Tx) error) error
 			*workflow.InternalServiceError,
 			*persistence.WorkflowExecutionAlreadyStartedError,
 			*workflow.DomainAlreadyExistsError,
+			*workflow.EntityNotExistsError,
 			*persistence.ShardOwnershipLostError:
 			return err
 		default:
@@ -87,7 +88,7 @@ const ErrDupEntry = 1062
 
 func isDupEntry(err error) bool {
 	sqlErr, ok := err.(*mysql.MySQLError)
-	return ok && sqlErr.Number == ErrDupEntry
+	return (ok && sqlErr.Number == ErrDupEntry) || isSQLiteDupEntry(err)
 }
 
 func gobSerialize(x interface{}) ([]byte, error) {
@@ -106,6 +106,7 @@ type (
 		ShardID                      int64
 		SignalCount                  int
 		CronSchedule                 string
+		Priority                     int32
 	}
 
 	currentExecutionRow struct {
@@ -190,7 +191,8 @@ client_feature_version,
 client_impl,
 signal_count,
 completion_event_encoding,
-cron_schedule`
+cron_schedule,
+priority`
 
 	executionsNonNullableColumnsTags = `:shard_id,
 :domain_id,
@@ -221,7 +223,8 @@ cron_schedule`
 :client_impl,
 :signal_count,
 :completion_event_encoding,
-:cron_schedule`
+:cron_schedule,
+:priority`
 
 	executionsBlobColumns = `completion_event,
 execution_context`
@@ -308,7 +311,8 @@ last_write_version = :last_write_version,
 last_write_event_id = :last_write_event_id,
 last_replication_info = :last_replication_info,
 signal_count = :signal_count,
-cron_schedule = :cron_schedule
+cron_schedule = :cron_schedule,
+priority = :priority
 WHERE
 shard_id = :shard_id AND
 domain_id = :domain_id AND
@@ -697,6 +701,7 @@ func (m *sqlExecutionManager) GetWorkflowExecution(request *p.GetWorkflowExecuti
 		ClientImpl:                   execution.ClientImpl,
 		SignalCount:                  int32(execution.SignalCount),
 		CronSchedule:                 execution.CronSchedule,
+		Priority:                     execution.Priority,
 	}
 
 	if execution.ExecutionContext != nil && len(*execution.ExecutionContext) > 0 {
@@ -1560,6 +1565,7 @@ func createExecution(tx *sqlx.Tx, request *p.CreateWorkflowExecutionRequest, sha
 		ClientImpl:                   "",
 		SignalCount:                  int(request.SignalCount),
 		CronSchedule:                 request.CronSchedule,
+		Priority:                     request.Priority,
 	}
 
 	if request.ReplicationState != nil {
@@ -2035,6 +2041,7 @@ func updateExecution(tx *sqlx.Tx,
 			CurrentVersion:               common.EmptyVersion,
 			SignalCount:                  int(executionInfo.SignalCount),
 			CronSchedule:                 executionInfo.CronSchedule,
+			Priority:                     executionInfo.Priority,
 		},
 		condition,
 	}
@@ -33,6 +33,9 @@ import (
 const defaultDriverName = "mysql"
 
 func newConnection(cfg config.SQL) (*sqlx.DB, error) {
+	if cfg.DriverName == SQLiteDriverName {
+		return newSQLiteConnection(cfg)
+	}
 	var db, err = sqlx.Connect(cfg.DriverName,
 		fmt.Sprintf(dataSourceName, cfg.User, cfg.Password, cfg.ConnectProtocol, cfg.ConnectAddr, cfg.DatabaseName))
 	if err != nil {
@@ -21,11 +21,12 @@
 package sql
 
 import (
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"database/sql"
 
-	"github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
 	"github.com/uber-common/bark"
 	workflow "github.com/uber/cadence/.gen/go/shared"
@@ -51,6 +52,12 @@ type (
 		Data         []byte
 		DataEncoding string
 	}
+
+	// conflictResolutionAuditPageToken is the primary key of the last audit of a page
+	conflictResolutionAuditPageToken struct {
+		ResolvedTime time.Time
+		AuditID      string
+	}
 )
 
 const (
@@ -81,6 +88,70 @@ first_event_id = :first_event_id`
 	lockEventSQLQuery = `SELECT range_id, tx_id FROM events ` +
 		`WHERE domain_id = ? AND workflow_id = ? AND run_id = ? AND first_event_id = ? ` +
 		`FOR UPDATE`
+
+	createConflictResolutionAuditSQLQuery = `INSERT INTO conflict_resolution_audits (
+		domain_id,
+		workflow_id,
+		resolved_time,
+		audit_id,
+		run_id,
+		source_cluster,
+		reason,
+		reset_event_id,
+		discarded_next_event_id,
+		discarded_start_version,
+		discarded_end_version,
+		adopted_first_event_id,
+		adopted_next_event_id,
+		adopted_start_version,
+		adopted_end_version
+		)
+		VALUES(
+		:domain_id,
+		:workflow_id,
+		:resolved_time,
+		:audit_id,
+		:run_id,
+		:source_cluster,
+		:reason,
+		:reset_event_id,
+		:discarded_next_event_id,
+		:discarded_start_version,
+		:discarded_end_version,
+		:adopted_first_event_id,
+		:adopted_next_event_id,
+		:adopted_start_version,
+		:adopted_end_version
+		)`
+
+	listConflictResolutionAuditsSQLQuery = conflictResolutionAuditsSelectPart + `
+WHERE domain_id = ? AND workflow_id = ?
+ORDER BY resolved_time DESC, audit_id
+LIMIT ?`
+
+	// the audits of a page are the ones after the last audit of the previous page, in the order they are listed
+	listConflictResolutionAuditsPageSQLQuery = conflictResolutionAuditsSelectPart + `
+WHERE domain_id = ? AND workflow_id = ? AND (resolved_time < ? OR (resolved_time = ? AND audit_id > ?))
+ORDER BY resolved_time DESC, audit_id
+LIMIT ?`
+
+	conflictResolutionAuditsSelectPart = `SELECT
+		domain_id,
+		workflow_id,
+		resolved_time,
+		audit_id,
+		run_id,
+		source_cluster,
+		reason,
+		reset_event_id,
+		discarded_next_event_id,
+		discarded_start_version,
+		discarded_end_version,
+		adopted_first_event_id,
+		adopted_next_event_id,
+		adopted_start_version,
+		adopted_end_version
+FROM conflict_resolution_audits`
 )
 
 // newHistoryPersistence creates an instance of HistoryManager
@@ -113,7 +184,7 @@ func (m *sqlHistoryManager) AppendHistoryEvents(request *p.InternalAppendHistory
 		return m.overWriteHistoryEvents(request, arg)
 	}
 	if _, err := m.db.NamedExec(appendHistorySQLQuery, arg); err != nil {
-		if sqlErr, ok := err.(*mysql.MySQLError); ok && sqlErr.Number == ErrDupEntry {
+		if isDupEntry(err) {
 			return &p.ConditionFailedError{Msg: fmt.Sprintf("AppendHistoryEvents: event already exist: %v", err)}
 		}
 		return &workflow.InternalServiceError{Message: fmt.Sprintf("AppendHistoryEvents: %v", err)}
@@ -193,6 +264,57 @@ func (m *sqlHistoryManager) DeleteWorkflowExecutionHistory(request *p.DeleteWork
 	return nil
 }
 
+func (m *sqlHistoryManager) CreateConflictResolutionAudit(request *p.CreateConflictResolutionAuditRequest) error {
+	if _, err := m.db.NamedExec(createConflictResolutionAuditSQLQuery, request.Info); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CreateConflictResolutionAudit operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+// ListConflictResolutionAudits returns the conflict resolutions of a workflow, latest first
+func (m *sqlHistoryManager) ListConflictResolutionAudits(
+	request *p.ListConflictResolutionAuditsRequest) (*p.ListConflictResolutionAuditsResponse, error) {
+	var audits []*p.ConflictResolutionAuditInfo
+	var err error
+	if len(request.NextPageToken) == 0 {
+		err = m.db.Select(&audits, listConflictResolutionAuditsSQLQuery,
+			request.DomainID, request.WorkflowID, request.PageSize)
+	} else {
+		pageToken := &conflictResolutionAuditPageToken{}
+		if err := json.Unmarshal(request.NextPageToken, pageToken); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("error deserializing conflictResolutionAuditPageToken: %v", err),
+			}
+		}
+		err = m.db.Select(&audits, listConflictResolutionAuditsPageSQLQuery,
+			request.DomainID, request.WorkflowID, pageToken.ResolvedTime, pageToken.ResolvedTime, pageToken.AuditID,
+			request.PageSize)
+	}
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListConflictResolutionAudits operation failed. Error: %v", err),
+		}
+	}
+
+	response := &p.ListConflictResolutionAuditsResponse{Audits: audits}
+	if len(audits) == request.PageSize {
+		last := audits[len(audits)-1]
+		nextPageToken, err := json.Marshal(&conflictResolutionAuditPageToken{
+			ResolvedTime: last.ResolvedTime,
+			AuditID:      last.AuditID,
+		})
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("error serializing conflictResolutionAuditPageToken: %v", err),
+			}
+		}
+		response.NextPageToken = nextPageToken
+	}
+	return response, nil
+}
+
 func (m *sqlHistoryManager) overWriteHistoryEvents(request *p.InternalAppendHistoryEventsRequest, row *eventsRow) error {
 	return m.txExecute("AppendHistoryEvents", func(tx *sqlx.Tx) error {
 		if err := lockEventForUpdate(tx, request); err != nil {
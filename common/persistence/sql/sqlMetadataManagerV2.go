@@ -24,7 +24,6 @@ import (
 	"database/sql"
 	"fmt"
 
-	"github.com/go-sql-driver/mysql"
 	"github.com/uber-common/bark"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
@@ -54,10 +53,14 @@ type (
 		// TODO Extracting the fields from DomainReplicationConfig since we don't currently support
 		// TODO scanning into DomainReplicationConfig.Clusters
 		//DomainReplicationConfig: *(request.ReplicationConfig),
-		ActiveClusterName string
-		Clusters          *[]byte
-		ConfigVersion     int64
-		FailoverVersion   int64
+		ActiveClusterName        string
+		Clusters                 *[]byte
+		PendingActiveClusterName string
+		FailoverStartTime        int64
+		FailoverExpireTime       int64
+		FailoverMarkers          *[]byte
+		ConfigVersion            int64
+		FailoverVersion          int64
 	}
 
 	flatUpdateDomainRequest struct {
@@ -90,6 +93,10 @@ const (
 		is_global_domain,
 		active_cluster_name, 
 		clusters, 
+		pending_active_cluster_name,
+		failover_start_time,
+		failover_expire_time,
+		failover_markers,
 		notification_version,
 		failover_notification_version,
 		data
@@ -109,6 +116,10 @@ const (
 		:is_global_domain,
 		:active_cluster_name, 
 		:clusters,
+		:pending_active_cluster_name,
+		:failover_start_time,
+		:failover_expire_time,
+		:failover_markers,
 		:notification_version,
 		:failover_notification_version,
 		:data
@@ -129,6 +140,10 @@ const (
 		is_global_domain,
 		active_cluster_name, 
 		clusters,
+		pending_active_cluster_name,
+		failover_start_time,
+		failover_expire_time,
+		failover_markers,
 		notification_version,
 		failover_notification_version,
 		data
@@ -152,6 +167,10 @@ SET
 		failover_version = :failover_version, 
 		active_cluster_name = :active_cluster_name,  
 		clusters = :clusters,
+		pending_active_cluster_name = :pending_active_cluster_name,
+		failover_start_time = :failover_start_time,
+		failover_expire_time = :failover_expire_time,
+		failover_markers = :failover_markers,
 		notification_version = :notification_version,
 		failover_notification_version = :failover_notification_version,
 		data = :data
@@ -164,6 +183,40 @@ id = :id`
 
 	listDomainsSQLQuery = getDomainPart
 
+	createClusterMetadataSQLQuery = `INSERT INTO cluster_metadata (
+		cluster_name,
+		initial_failover_version,
+		rpc_name,
+		rpc_address,
+		enabled
+		)
+		VALUES(
+		:cluster_name,
+		:initial_failover_version,
+		:rpc_name,
+		:rpc_address,
+		:enabled
+		)`
+
+	updateClusterMetadataSQLQuery = `UPDATE cluster_metadata
+SET
+		initial_failover_version = :initial_failover_version,
+		rpc_name = :rpc_name,
+		rpc_address = :rpc_address,
+		enabled = :enabled
+WHERE
+cluster_name = :cluster_name`
+
+	lockClusterMetadataSQLQuery = `SELECT COUNT(*) FROM cluster_metadata WHERE cluster_name = ? FOR UPDATE`
+
+	listClusterMetadataSQLQuery = `SELECT
+		cluster_name,
+		initial_failover_version,
+		rpc_name,
+		rpc_address,
+		enabled
+FROM cluster_metadata`
+
 	getMetadataSQLQuery    = `SELECT notification_version FROM domain_metadata`
 	lockMetadataSQLQuery   = `SELECT notification_version FROM domain_metadata FOR UPDATE`
 	updateMetadataSQLQuery = `UPDATE domain_metadata
@@ -240,6 +293,13 @@ func (m *sqlMetadataManagerV2) CreateDomain(request *persistence.CreateDomainReq
 		}
 	}
 
+	failoverMarkers, err := serializeFailoverMarkers(request.ReplicationConfig.FailoverMarkers)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CreateDomain operation failed. Failed to encode ReplicationConfig.FailoverMarkers. Error: %v", err),
+		}
+	}
+
 	metadata, err := m.GetMetadata()
 	if err != nil {
 		return nil, err
@@ -258,8 +318,12 @@ func (m *sqlMetadataManagerV2) CreateDomain(request *persistence.CreateDomainReq
 
 				DomainConfig: *(request.Config),
 
-				ActiveClusterName: request.ReplicationConfig.ActiveClusterName,
-				Clusters:          &clusters,
+				ActiveClusterName:        request.ReplicationConfig.ActiveClusterName,
+				Clusters:                 &clusters,
+				PendingActiveClusterName: request.ReplicationConfig.PendingActiveClusterName,
+				FailoverStartTime:        request.ReplicationConfig.FailoverStartTime,
+				FailoverExpireTime:       request.ReplicationConfig.FailoverExpireTime,
+				FailoverMarkers:          failoverMarkers,
 
 				ConfigVersion:   request.ConfigVersion,
 				FailoverVersion: request.FailoverVersion,
@@ -269,7 +333,7 @@ func (m *sqlMetadataManagerV2) CreateDomain(request *persistence.CreateDomainReq
 			FailoverNotificationVersion: persistence.InitialFailoverNotificationVersion,
 			IsGlobalDomain:              request.IsGlobalDomain,
 		}); err1 != nil {
-			if sqlErr, ok := err1.(*mysql.MySQLError); ok && sqlErr.Number == ErrDupEntry {
+			if isDupEntry(err1) {
 				return &workflow.DomainAlreadyExistsError{
 					Message: fmt.Sprintf("name: %v", request.Info.Name),
 				}
@@ -359,6 +423,15 @@ func (m *sqlMetadataManagerV2) domainRowToGetDomainResponse(result *domainRow) (
 		}
 	}
 
+	var failoverMarkers map[int32]int64
+	if result.FailoverMarkers != nil {
+		if err := gobDeserialize(*result.FailoverMarkers, &failoverMarkers); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("Error in deserializing ReplicationConfig.FailoverMarkers. Error: %v", err),
+			}
+		}
+	}
+
 	return &persistence.GetDomainResponse{
 		TableVersion: persistence.DomainTableVersionV2,
 		Info: &persistence.DomainInfo{
@@ -371,8 +444,12 @@ func (m *sqlMetadataManagerV2) domainRowToGetDomainResponse(result *domainRow) (
 		},
 		Config: &result.DomainConfig,
 		ReplicationConfig: &persistence.DomainReplicationConfig{
-			ActiveClusterName: persistence.GetOrUseDefaultActiveCluster(m.activeClusterName, result.ActiveClusterName),
-			Clusters:          persistence.GetOrUseDefaultClusters(m.activeClusterName, persistence.DeserializeClusterConfigs(clusters)),
+			ActiveClusterName:        persistence.GetOrUseDefaultActiveCluster(m.activeClusterName, result.ActiveClusterName),
+			Clusters:                 persistence.GetOrUseDefaultClusters(m.activeClusterName, persistence.DeserializeClusterConfigs(clusters)),
+			PendingActiveClusterName: result.PendingActiveClusterName,
+			FailoverStartTime:        result.FailoverStartTime,
+			FailoverExpireTime:       result.FailoverExpireTime,
+			FailoverMarkers:          failoverMarkers,
 		},
 		IsGlobalDomain:              result.IsGlobalDomain,
 		FailoverVersion:             result.FailoverVersion,
@@ -397,6 +474,13 @@ func (m *sqlMetadataManagerV2) UpdateDomain(request *persistence.UpdateDomainReq
 		}
 	}
 
+	failoverMarkers, err := serializeFailoverMarkers(request.ReplicationConfig.FailoverMarkers)
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateDomain operation failed. Failed to encode ReplicationConfig.FailoverMarkers. Value: %v", request.ReplicationConfig.FailoverMarkers),
+		}
+	}
+
 	return m.txExecute("UpdateDomain", func(tx *sqlx.Tx) error {
 		result, err := tx.NamedExec(updateDomainSQLQuery, &flatUpdateDomainRequest{
 			domainCommon: domainCommon{
@@ -409,10 +493,14 @@ func (m *sqlMetadataManagerV2) UpdateDomain(request *persistence.UpdateDomainReq
 
 				DomainConfig: *(request.Config),
 
-				ActiveClusterName: request.ReplicationConfig.ActiveClusterName,
-				Clusters:          &clusters,
-				ConfigVersion:     request.ConfigVersion,
-				FailoverVersion:   request.FailoverVersion,
+				ActiveClusterName:        request.ReplicationConfig.ActiveClusterName,
+				Clusters:                 &clusters,
+				PendingActiveClusterName: request.ReplicationConfig.PendingActiveClusterName,
+				FailoverStartTime:        request.ReplicationConfig.FailoverStartTime,
+				FailoverExpireTime:       request.ReplicationConfig.FailoverExpireTime,
+				FailoverMarkers:          failoverMarkers,
+				ConfigVersion:            request.ConfigVersion,
+				FailoverVersion:          request.FailoverVersion,
 			},
 			FailoverNotificationVersion: request.FailoverNotificationVersion,
 			NotificationVersion:         request.NotificationVersion,
@@ -498,3 +586,55 @@ func (m *sqlMetadataManagerV2) ListDomains(request *persistence.ListDomainsReque
 		Domains: domains,
 	}, nil
 }
+
+func (m *sqlMetadataManagerV2) CreateClusterMetadata(request *persistence.CreateClusterMetadataRequest) error {
+	if _, err := m.db.NamedExec(createClusterMetadataSQLQuery, request.Info); err != nil {
+		if isDupEntry(err) {
+			return &workflow.BadRequestError{
+				Message: fmt.Sprintf("Cluster %v already exists.", request.Info.ClusterName),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CreateClusterMetadata operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (m *sqlMetadataManagerV2) UpdateClusterMetadata(request *persistence.UpdateClusterMetadataRequest) error {
+	return m.txExecute("UpdateClusterMetadata", func(tx *sqlx.Tx) error {
+		var count int
+		if err := tx.Get(&count, lockClusterMetadataSQLQuery, request.Info.ClusterName); err != nil {
+			return err
+		}
+		if count == 0 {
+			return &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("Cluster %v does not exist.", request.Info.ClusterName),
+			}
+		}
+		_, err := tx.NamedExec(updateClusterMetadataSQLQuery, request.Info)
+		return err
+	})
+}
+
+func (m *sqlMetadataManagerV2) ListClusterMetadata() (*persistence.ListClusterMetadataResponse, error) {
+	var clusters []*persistence.ClusterMetadataInfo
+	if err := m.db.Select(&clusters, listClusterMetadataSQLQuery); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListClusterMetadata operation failed. Error: %v", err),
+		}
+	}
+	return &persistence.ListClusterMetadataResponse{Clusters: clusters}, nil
+}
+
+// serializeFailoverMarkers encodes the failover markers of a domain, no markers are stored as NULL
+func serializeFailoverMarkers(markers map[int32]int64) (*[]byte, error) {
+	if len(markers) == 0 {
+		return nil, nil
+	}
+	data, err := gobSerialize(markers)
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
@@ -21,6 +21,7 @@
 package sql
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/uber-common/bark"
 
@@ -47,27 +48,41 @@ type (
 		TaskListName string
 		TaskListType int64
 		ExpiryTs     time.Time
+		// CreatedTs is nil for the tasks created without a created time
+		CreatedTs *time.Time
+		Priority  int32
 	}
 
 	tasksListsRow struct {
-		DomainID string
-		RangeID  int64
-		Name     string
-		TaskType int64
-		AckLevel int64
-		Kind     int64
-		ExpiryTs time.Time
+		DomainID     string
+		RangeID      int64
+		Name         string
+		TaskType     int64
+		AckLevel     int64
+		Kind         int64
+		ExpiryTs     time.Time
+		DispatchRate float64
+		Paused       bool
+		LastUpdated  time.Time
+		// Pollers is the gob encoded poller history, nil if there is none
+		Pollers []byte
 	}
 
 	updateTaskListsRow struct {
 		tasksListsRow
 		OldRangeID int64
 	}
+
+	// taskListPageToken is the primary key of the last task list of a page
+	taskListPageToken struct {
+		Name     string
+		TaskType int64
+	}
 )
 
 const (
-	taskListCreatePart = `INTO task_lists(domain_id, range_id, name, task_type, ack_level, kind, expiry_ts) ` +
-		`VALUES (:domain_id, :range_id, :name, :task_type, :ack_level, :kind, :expiry_ts)`
+	taskListCreatePart = `INTO task_lists(domain_id, range_id, name, task_type, ack_level, kind, expiry_ts, dispatch_rate, paused, last_updated, pollers) ` +
+		`VALUES (:domain_id, :range_id, :name, :task_type, :ack_level, :kind, :expiry_ts, :dispatch_rate, :paused, :last_updated, :pollers)`
 
 	// (default range ID: initialRangeID == 1)
 	createTaskListSQLQuery = `INSERT ` + taskListCreatePart
@@ -81,27 +96,41 @@ name = :name,
 task_type = :task_type,
 ack_level = :ack_level,
 kind = :kind,
-expiry_ts = :expiry_ts
+expiry_ts = :expiry_ts,
+dispatch_rate = :dispatch_rate,
+paused = :paused,
+last_updated = :last_updated,
+pollers = :pollers
 WHERE
 domain_id = :domain_id AND
 name = :name AND
 task_type = :task_type
 `
 
-	getTaskListSQLQuery = `SELECT domain_id, range_id, name, task_type, ack_level, kind, expiry_ts ` +
+	taskListColumns = `domain_id, range_id, name, task_type, ack_level, kind, expiry_ts, dispatch_rate, paused, last_updated, pollers `
+
+	getTaskListSQLQuery = `SELECT ` + taskListColumns +
 		`FROM task_lists ` +
 		`WHERE domain_id = ? AND name = ? AND task_type = ?`
 
+	listTaskListsSQLQuery = `SELECT ` + taskListColumns +
+		`FROM task_lists ` +
+		`WHERE domain_id = ? AND (name > ? OR (name = ? AND task_type > ?)) ` +
+		`ORDER BY name, task_type LIMIT ?`
+
+	deleteTaskListSQLQuery = `DELETE FROM task_lists ` +
+		`WHERE domain_id = ? AND name = ? AND task_type = ? AND range_id = ?`
+
 	lockTaskListSQLQuery = `SELECT range_id FROM task_lists ` +
 		`WHERE domain_id = ? AND name = ? AND task_type = ? FOR UPDATE`
 
-	getTaskSQLQuery = `SELECT workflow_id, run_id, schedule_id, task_id ` +
+	getTaskSQLQuery = `SELECT workflow_id, run_id, schedule_id, task_id, expiry_ts, created_ts, priority ` +
 		`FROM tasks ` +
 		`WHERE domain_id = ? AND task_list_name = ? AND task_list_type = ? AND task_id > ? AND task_id <= ?`
 
 	createTaskSQLQuery = `INSERT INTO ` +
-		`tasks(domain_id, workflow_id, run_id, schedule_id, task_list_name, task_list_type, task_id, expiry_ts) ` +
-		`VALUES(:domain_id, :workflow_id, :run_id, :schedule_id, :task_list_name, :task_list_type, :task_id, :expiry_ts)`
+		`tasks(domain_id, workflow_id, run_id, schedule_id, task_list_name, task_list_type, task_id, expiry_ts, created_ts, priority) ` +
+		`VALUES(:domain_id, :workflow_id, :run_id, :schedule_id, :task_list_name, :task_list_type, :task_id, :expiry_ts, :created_ts, :priority)`
 
 	deleteTaskSQLQuery = `DELETE FROM tasks ` +
 		`WHERE domain_id = ? AND task_list_name = ? AND task_list_type = ? AND task_id = ?`
@@ -128,12 +157,13 @@ func (m *sqlTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest
 	if err := m.db.Get(&row, getTaskListSQLQuery, request.DomainID, request.TaskList, request.TaskType); err != nil {
 		if err == sql.ErrNoRows {
 			row = tasksListsRow{
-				DomainID: request.DomainID,
-				Name:     request.TaskList,
-				TaskType: int64(request.TaskType),
-				AckLevel: ackLevel,
-				Kind:     int64(request.TaskListKind),
-				ExpiryTs: time.Time{},
+				DomainID:    request.DomainID,
+				Name:        request.TaskList,
+				TaskType:    int64(request.TaskType),
+				AckLevel:    ackLevel,
+				Kind:        int64(request.TaskListKind),
+				ExpiryTs:    time.Time{},
+				LastUpdated: time.Now(),
 			}
 			if _, err := m.db.NamedExec(createTaskListSQLQuery, &row); err != nil {
 				return nil, &workflow.InternalServiceError{
@@ -148,6 +178,7 @@ func (m *sqlTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest
 	}
 
 	var resp *persistence.LeaseTaskListResponse
+	now := time.Now()
 	err := m.txExecute("LeaseTaskList", func(tx *sqlx.Tx) error {
 		rangeID = row.RangeID
 		ackLevel = row.AckLevel
@@ -160,13 +191,17 @@ func (m *sqlTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest
 		result, err1 := tx.NamedExec(updateTaskListSQLQuery,
 			&updateTaskListsRow{
 				tasksListsRow{
-					DomainID: row.DomainID,
-					RangeID:  row.RangeID + 1,
-					Name:     row.Name,
-					TaskType: row.TaskType,
-					AckLevel: row.AckLevel,
-					Kind:     row.Kind,
-					ExpiryTs: row.ExpiryTs,
+					DomainID:     row.DomainID,
+					RangeID:      row.RangeID + 1,
+					Name:         row.Name,
+					TaskType:     row.TaskType,
+					AckLevel:     row.AckLevel,
+					Kind:         row.Kind,
+					ExpiryTs:     row.ExpiryTs,
+					DispatchRate: row.DispatchRate,
+					Paused:       row.Paused,
+					LastUpdated:  now,
+					Pollers:      row.Pollers,
 				},
 				row.RangeID,
 			})
@@ -180,13 +215,21 @@ func (m *sqlTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest
 		if rowsAffected == 0 {
 			return fmt.Errorf("%v rows affected instead of 1", rowsAffected)
 		}
+		pollers, err1 := deserializeTaskListPollers(row.Pollers)
+		if err1 != nil {
+			return err1
+		}
 		resp = &persistence.LeaseTaskListResponse{TaskListInfo: &persistence.TaskListInfo{
-			DomainID: request.DomainID,
-			Name:     request.TaskList,
-			TaskType: request.TaskType,
-			RangeID:  rangeID + 1,
-			AckLevel: ackLevel,
-			Kind:     request.TaskListKind,
+			DomainID:     request.DomainID,
+			Name:         request.TaskList,
+			TaskType:     request.TaskType,
+			RangeID:      rangeID + 1,
+			AckLevel:     ackLevel,
+			Kind:         request.TaskListKind,
+			DispatchRate: row.DispatchRate,
+			Paused:       row.Paused,
+			LastUpdated:  now,
+			Pollers:      pollers,
 		}}
 		return nil
 	})
@@ -194,16 +237,24 @@ func (m *sqlTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest
 }
 
 func (m *sqlTaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequest) (*persistence.UpdateTaskListResponse, error) {
+	pollers, err := serializeTaskListPollers(request.TaskListInfo.Pollers)
+	if err != nil {
+		return nil, err
+	}
 	if request.TaskListInfo.Kind == persistence.TaskListKindSticky {
 		// If sticky, update with TTL
 		if _, err := m.db.NamedExec(updateTaskListWithTTLSQLQuery, &tasksListsRow{
-			DomainID: request.TaskListInfo.DomainID,
-			RangeID:  request.TaskListInfo.RangeID,
-			Name:     request.TaskListInfo.Name,
-			TaskType: int64(request.TaskListInfo.TaskType),
-			AckLevel: request.TaskListInfo.AckLevel,
-			Kind:     int64(request.TaskListInfo.Kind),
-			ExpiryTs: stickyTaskListTTL(),
+			DomainID:     request.TaskListInfo.DomainID,
+			RangeID:      request.TaskListInfo.RangeID,
+			Name:         request.TaskListInfo.Name,
+			TaskType:     int64(request.TaskListInfo.TaskType),
+			AckLevel:     request.TaskListInfo.AckLevel,
+			Kind:         int64(request.TaskListInfo.Kind),
+			ExpiryTs:     stickyTaskListTTL(),
+			DispatchRate: request.TaskListInfo.DispatchRate,
+			Paused:       request.TaskListInfo.Paused,
+			LastUpdated:  time.Now(),
+			Pollers:      pollers,
 		}); err != nil {
 			return nil, &workflow.InternalServiceError{
 				Message: fmt.Sprintf("UpdateTaskList operation failed. Failed to make sticky task list. Error: %v", err),
@@ -211,7 +262,7 @@ func (m *sqlTaskManager) UpdateTaskList(request *persistence.UpdateTaskListReque
 		}
 	}
 	var resp *persistence.UpdateTaskListResponse
-	err := m.txExecute("UpdateTaskList", func(tx *sqlx.Tx) error {
+	err = m.txExecute("UpdateTaskList", func(tx *sqlx.Tx) error {
 		err1 := lockTaskList(
 			tx, request.TaskListInfo.DomainID, request.TaskListInfo.Name, request.TaskListInfo.TaskType, request.TaskListInfo.RangeID)
 		if err1 != nil {
@@ -227,6 +278,10 @@ func (m *sqlTaskManager) UpdateTaskList(request *persistence.UpdateTaskListReque
 					request.TaskListInfo.AckLevel,
 					int64(request.TaskListInfo.Kind),
 					time.Time{},
+					request.TaskListInfo.DispatchRate,
+					request.TaskListInfo.Paused,
+					time.Now(),
+					pollers,
 				},
 				request.TaskListInfo.RangeID,
 			})
@@ -246,11 +301,114 @@ func (m *sqlTaskManager) UpdateTaskList(request *persistence.UpdateTaskListReque
 	return resp, err
 }
 
+func (m *sqlTaskManager) GetTaskList(request *persistence.GetTaskListRequest) (*persistence.GetTaskListResponse, error) {
+	var row tasksListsRow
+	if err := m.db.Get(&row, getTaskListSQLQuery, request.DomainID, request.TaskList, request.TaskType); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("Task list %v of type %v does not exist", request.TaskList, request.TaskType),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetTaskList operation failed. Error: %v", err),
+		}
+	}
+	pollers, err := deserializeTaskListPollers(row.Pollers)
+	if err != nil {
+		return nil, err
+	}
+	return &persistence.GetTaskListResponse{TaskListInfo: &persistence.TaskListInfo{
+		DomainID:     row.DomainID,
+		Name:         row.Name,
+		TaskType:     int(row.TaskType),
+		RangeID:      row.RangeID,
+		AckLevel:     row.AckLevel,
+		Kind:         int(row.Kind),
+		DispatchRate: row.DispatchRate,
+		Paused:       row.Paused,
+		LastUpdated:  row.LastUpdated,
+		Pollers:      pollers,
+	}}, nil
+}
+
+func (m *sqlTaskManager) ListTaskLists(request *persistence.ListTaskListsRequest) (*persistence.ListTaskListsResponse, error) {
+	pageToken := &taskListPageToken{TaskType: -1}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, pageToken); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("error deserializing taskListPageToken: %v", err),
+			}
+		}
+	}
+
+	var rows []tasksListsRow
+	if err := m.db.Select(&rows, listTaskListsSQLQuery,
+		request.DomainID, pageToken.Name, pageToken.Name, pageToken.TaskType, request.PageSize); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListTaskLists operation failed. Failed to get rows. Error: %v", err),
+		}
+	}
+
+	response := &persistence.ListTaskListsResponse{}
+	for _, row := range rows {
+		pollers, err := deserializeTaskListPollers(row.Pollers)
+		if err != nil {
+			return nil, err
+		}
+		response.Items = append(response.Items, &persistence.TaskListInfo{
+			DomainID:     row.DomainID,
+			Name:         row.Name,
+			TaskType:     int(row.TaskType),
+			RangeID:      row.RangeID,
+			AckLevel:     row.AckLevel,
+			Kind:         int(row.Kind),
+			DispatchRate: row.DispatchRate,
+			Paused:       row.Paused,
+			LastUpdated:  row.LastUpdated,
+			Pollers:      pollers,
+		})
+	}
+	if len(rows) == request.PageSize {
+		last := rows[len(rows)-1]
+		nextPageToken, err := json.Marshal(&taskListPageToken{Name: last.Name, TaskType: last.TaskType})
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("error serializing taskListPageToken: %v", err),
+			}
+		}
+		response.NextPageToken = nextPageToken
+	}
+	return response, nil
+}
+
+func (m *sqlTaskManager) DeleteTaskList(request *persistence.DeleteTaskListRequest) error {
+	result, err := m.db.Exec(deleteTaskListSQLQuery,
+		request.DomainID, request.TaskListName, int64(request.TaskListType), request.RangeID)
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteTaskList operation failed. Error: %v", err),
+		}
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteTaskList operation failed. Failed to verify number of rows deleted. Error: %v", err),
+		}
+	}
+	if rowsAffected != 1 {
+		return &persistence.ConditionFailedError{
+			Msg: fmt.Sprintf("Failed to delete task list. name: %v, type: %v, rangeID: %v",
+				request.TaskListName, request.TaskListType, request.RangeID),
+		}
+	}
+	return nil
+}
+
 func (m *sqlTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*persistence.CreateTasksResponse, error) {
 	tasksRows := make([]tasksRow, len(request.Tasks))
 	for i, v := range request.Tasks {
-		var expiryTime time.Time
-		if v.Data.ScheduleToStartTimeout > 0 {
+		expiryTime := v.Data.Expiry
+		if expiryTime.IsZero() && v.Data.ScheduleToStartTimeout > 0 {
 			expiryTime = time.Now().Add(time.Second * time.Duration(v.Data.ScheduleToStartTimeout))
 		}
 		tasksRows[i] = tasksRow{
@@ -262,6 +420,11 @@ func (m *sqlTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*
 			TaskListType: int64(request.TaskListInfo.TaskType),
 			TaskID:       v.TaskID,
 			ExpiryTs:     expiryTime,
+			Priority:     v.Data.Priority,
+		}
+		if !v.Data.CreatedTime.IsZero() {
+			createdTime := v.Data.CreatedTime
+			tasksRows[i].CreatedTs = &createdTime
 		}
 	}
 	var resp *persistence.CreateTasksResponse
@@ -301,6 +464,11 @@ func (m *sqlTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persis
 			RunID:      v.RunID,
 			TaskID:     v.TaskID,
 			ScheduleID: v.ScheduleID,
+			Expiry:     v.ExpiryTs,
+			Priority:   v.Priority,
+		}
+		if v.CreatedTs != nil {
+			tasks[i].CreatedTime = *v.CreatedTs
 		}
 	}
 
@@ -338,3 +506,22 @@ func lockTaskList(tx *sqlx.Tx, domainID, name string, taskListType int, oldRange
 func stickyTaskListTTL() time.Time {
 	return time.Now().Add(24 * time.Hour)
 }
+
+func serializeTaskListPollers(pollers []*persistence.TaskListPollerInfo) ([]byte, error) {
+	if len(pollers) == 0 {
+		return nil, nil
+	}
+	return gobSerialize(pollers)
+}
+
+func deserializeTaskListPollers(b []byte) ([]*persistence.TaskListPollerInfo, error) {
+	// pollers is null for task lists not updated since it was added
+	if len(b) == 0 {
+		return nil, nil
+	}
+	var pollers []*persistence.TaskListPollerInfo
+	if err := gobDeserialize(b, &pollers); err != nil {
+		return nil, err
+	}
+	return pollers, nil
+}
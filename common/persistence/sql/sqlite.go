@@ -0,0 +1,162 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/iancoleman/strcase"
+	"github.com/jmoiron/sqlx"
+	"github.com/mattn/go-sqlite3"
+	"github.com/uber/cadence/common/service/config"
+)
+
+// SQLiteDriverName is the driver name of a SQL datastore backed by a SQLite database file,
+// whose path is the DatabaseName of the config. The schema is created when the database is empty.
+// SQLite serializes the writes, it is meant for tests and local development.
+const SQLiteDriverName = "cadence-sqlite3"
+
+const sqliteBusyTimeout = 10 * time.Second
+
+// sqliteQueryReplacer rewrites the MySQL specific parts of the queries. Transactions take the
+// database write lock when they begin, so the row locks are not needed.
+var sqliteQueryReplacer = strings.NewReplacer(
+	" FOR UPDATE", "",
+	"\nFOR UPDATE", "",
+	" LOCK IN SHARE MODE", "",
+	"INSERT IGNORE INTO", "INSERT OR IGNORE INTO",
+)
+
+type (
+	// sqliteDriver wraps the sqlite3 driver to run the MySQL queries of the SQL datastore
+	sqliteDriver struct {
+		sqlite3.SQLiteDriver
+	}
+
+	sqliteConn struct {
+		conn *sqlite3.SQLiteConn
+	}
+
+	sqliteStmt struct {
+		driver.Stmt
+	}
+)
+
+func init() {
+	sql.Register(SQLiteDriverName, &sqliteDriver{})
+}
+
+func newSQLiteConnection(cfg config.SQL) (*sqlx.DB, error) {
+	dataSource := fmt.Sprintf("file:%v?_txlock=immediate&_journal_mode=WAL&_busy_timeout=%v",
+		cfg.DatabaseName, int64(sqliteBusyTimeout/time.Millisecond))
+	db, err := sqlx.Connect(SQLiteDriverName, dataSource)
+	if err != nil {
+		return nil, err
+	}
+	// Maps struct names in CamelCase to snake without need for db struct tags.
+	db.MapperFunc(strcase.ToSnake)
+	if err := createSQLiteSchema(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// createSQLiteSchema creates the tables of an empty database, the transaction keeps concurrent
+// connections from creating them twice
+func createSQLiteSchema(db *sqlx.DB) error {
+	tx, err := db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	var count int
+	if err := tx.Get(&count, `SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'domains'`); err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	if _, err := tx.Exec(sqliteSchema); err != nil {
+		return fmt.Errorf("error creating the sqlite schema: %v", err)
+	}
+	return tx.Commit()
+}
+
+func (d *sqliteDriver) Open(dataSource string) (driver.Conn, error) {
+	conn, err := d.SQLiteDriver.Open(dataSource)
+	if err != nil {
+		return nil, err
+	}
+	return &sqliteConn{conn: conn.(*sqlite3.SQLiteConn)}, nil
+}
+
+func (c *sqliteConn) Prepare(query string) (driver.Stmt, error) {
+	stmt, err := c.conn.Prepare(sqliteQueryReplacer.Replace(query))
+	if err != nil {
+		return nil, err
+	}
+	return &sqliteStmt{Stmt: stmt}, nil
+}
+
+func (c *sqliteConn) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return c.conn.Exec(sqliteQueryReplacer.Replace(query), sqliteValues(args))
+}
+
+func (c *sqliteConn) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return c.conn.Query(sqliteQueryReplacer.Replace(query), sqliteValues(args))
+}
+
+func (c *sqliteConn) Begin() (driver.Tx, error) {
+	return c.conn.Begin()
+}
+
+func (c *sqliteConn) Close() error {
+	return c.conn.Close()
+}
+
+func (s *sqliteStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.Stmt.Exec(sqliteValues(args))
+}
+
+func (s *sqliteStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.Stmt.Query(sqliteValues(args))
+}
+
+// sqliteValues converts the times to UTC, SQLite stores them as text and compares them as such
+func sqliteValues(args []driver.Value) []driver.Value {
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			args[i] = t.UTC()
+		}
+	}
+	return args
+}
+
+func isSQLiteDupEntry(err error) bool {
+	sqliteErr, ok := err.(sqlite3.Error)
+	return ok && (sqliteErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey ||
+		sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique)
+}
@@ -113,6 +113,8 @@ type (
 		DataStores map[string]DataStore `yaml:"datastores"`
 		// SamplingConfig is config for visibility sampling
 		SamplingConfig SamplingConfig
+		// FaultInjection is config for injecting persistence errors and latency
+		FaultInjection FaultInjectionConfig
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -121,6 +123,8 @@ type (
 		Cassandra *Cassandra `yaml:"cassandra"`
 		// SQL contains the config for a SQL based datastore
 		SQL *SQL `yaml:"sql"`
+		// Memory contains the config for an in-memory datastore
+		Memory *Memory `yaml:"memory"`
 	}
 
 	// SamplingConfig is config for visibility sampling
@@ -133,6 +137,18 @@ type (
 		VisibilityListMaxQPS dynamicconfig.IntPropertyFnWithDomainFilter
 	}
 
+	// FaultInjectionConfig is config for persistence fault injection
+	FaultInjectionConfig struct {
+		// Enabled turns on fault injection clients when persistence managers are created
+		Enabled dynamicconfig.BoolPropertyFn
+		// ErrorRate is the rate [0, 1] at which persistence calls fail
+		ErrorRate dynamicconfig.FloatPropertyFn
+		// ErrorType is the type of error returned: timeout, conditionFailed, shardOwnershipLost or generic
+		ErrorType dynamicconfig.StringPropertyFn
+		// Latency is added to every persistence call before it is executed
+		Latency dynamicconfig.DurationPropertyFn
+	}
+
 	// Cassandra contains configuration to connect to Cassandra cluster
 	Cassandra struct {
 		// Hosts is a csv of cassandra endpoints
@@ -175,6 +191,17 @@ type (
 		MaxConns int `yaml:"maxConns"`
 	}
 
+	// Memory is the configuration for an in-memory datastore. The data held by
+	// this datastore lives only as long as the process and is meant for tests
+	// and local development
+	Memory struct {
+		// DatabaseName identifies the in-memory database, datastores configured
+		// with the same name within a process share the same data
+		DatabaseName string `yaml:"databaseName"`
+		// MaxQPS the max request rate on this datastore
+		MaxQPS int `yaml:"maxQPS"`
+	}
+
 	// Replicator describes the configuration of replicator
 	Replicator struct{}
 
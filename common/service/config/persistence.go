@@ -20,7 +20,11 @@
 
 package config
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
 
 // SetMaxQPS sets the MaxQPS value for the given datastore
 func (c *Persistence) SetMaxQPS(key string, qps int) {
@@ -32,9 +36,23 @@ func (c *Persistence) SetMaxQPS(key string, qps int) {
 		ds.Cassandra.MaxQPS = qps
 		return
 	}
+	if ds.Memory != nil {
+		ds.Memory.MaxQPS = qps
+		return
+	}
 	ds.SQL.MaxQPS = qps
 }
 
+// SetFaultInjection binds the fault injection config to the given dynamic config collection
+func (c *Persistence) SetFaultInjection(dc *dynamicconfig.Collection) {
+	c.FaultInjection = FaultInjectionConfig{
+		Enabled:   dc.GetBoolProperty(dynamicconfig.EnablePersistenceFaultInjection, false),
+		ErrorRate: dc.GetFloat64Property(dynamicconfig.PersistenceFaultInjectionErrorRate, 0),
+		ErrorType: dc.GetStringProperty(dynamicconfig.PersistenceFaultInjectionErrorType, "generic"),
+		Latency:   dc.GetDurationProperty(dynamicconfig.PersistenceFaultInjectionLatency, 0),
+	}
+}
+
 // Validate validates the persistence config
 func (c *Persistence) Validate() error {
 	stores := []string{c.DefaultStore, c.VisibilityStore}
@@ -43,11 +61,21 @@ func (c *Persistence) Validate() error {
 		if !ok {
 			return fmt.Errorf("persistence: missing config for datastore %v", st)
 		}
-		if ds.SQL == nil && ds.Cassandra == nil {
-			return fmt.Errorf("persistence: datastore %v: must provide config for one of cassandra or sql stores", st)
+		count := 0
+		if ds.Cassandra != nil {
+			count++
+		}
+		if ds.SQL != nil {
+			count++
+		}
+		if ds.Memory != nil {
+			count++
+		}
+		if count == 0 {
+			return fmt.Errorf("persistence: datastore %v: must provide config for one of cassandra, sql or memory stores", st)
 		}
-		if ds.SQL != nil && ds.Cassandra != nil {
-			return fmt.Errorf("persistce: datastore %v: only one of SQL or cassandra can be specified", st)
+		if count > 1 {
+			return fmt.Errorf("persistence: datastore %v: only one of cassandra, sql or memory can be specified", st)
 		}
 	}
 	return nil
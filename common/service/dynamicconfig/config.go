@@ -74,6 +74,9 @@ type IntPropertyFnWithDomainFilter func(domain string) int
 // IntPropertyFnWithTaskListInfoFilters is a wrapper to get int property from dynamic config with three filters: domain, taskList, taskType
 type IntPropertyFnWithTaskListInfoFilters func(domain string, taskList string, taskType int) int
 
+// IntPropertyFnWithDomainAndClusterFilters is a wrapper to get int property from dynamic config with domain and cluster as filters
+type IntPropertyFnWithDomainAndClusterFilters func(domain string, cluster string) int
+
 // FloatPropertyFn is a wrapper to get float property from dynamic config
 type FloatPropertyFn func(opts ...FilterOption) float64
 
@@ -101,6 +104,9 @@ type BoolPropertyFnWithDomainFilter func(domain string) bool
 // BoolPropertyFnWithTaskListInfoFilters is a wrapper to get bool property from dynamic config with three filters: domain, taskList, taskType
 type BoolPropertyFnWithTaskListInfoFilters func(domain string, taskList string, taskType int) bool
 
+// BoolPropertyFnWithDomainAndClusterFilters is a wrapper to get bool property from dynamic config with domain and cluster as filters
+type BoolPropertyFnWithDomainAndClusterFilters func(domain string, cluster string) bool
+
 // GetProperty gets a interface property and returns defaultValue if property is not found
 func (c *Collection) GetProperty(key Key, defaultValue interface{}) PropertyFn {
 	return func() interface{} {
@@ -162,6 +168,18 @@ func (c *Collection) GetIntPropertyFilteredByTaskListInfo(key Key, defaultValue
 	}
 }
 
+// GetIntPropertyFilteredByDomainAndCluster gets property with domain and cluster as filters and asserts that it's an integer
+func (c *Collection) GetIntPropertyFilteredByDomainAndCluster(key Key, defaultValue int) IntPropertyFnWithDomainAndClusterFilters {
+	return func(domain string, cluster string) int {
+		val, err := c.client.GetIntValue(key, getFilterMap(DomainFilter(domain), ClusterFilter(cluster)), defaultValue)
+		if err != nil {
+			c.logNoValue(key, err)
+		}
+		c.logValue(key, val, defaultValue)
+		return val
+	}
+}
+
 // GetFloat64Property gets property and asserts that it's a float64
 func (c *Collection) GetFloat64Property(key Key, defaultValue float64) FloatPropertyFn {
 	return func(opts ...FilterOption) float64 {
@@ -277,3 +295,15 @@ func (c *Collection) GetBoolPropertyFilteredByTaskListInfo(key Key, defaultValue
 		return val
 	}
 }
+
+// GetBoolPropertyFilteredByDomainAndCluster gets property with domain and cluster as filters and asserts that it's a bool
+func (c *Collection) GetBoolPropertyFilteredByDomainAndCluster(key Key, defaultValue bool) BoolPropertyFnWithDomainAndClusterFilters {
+	return func(domain string, cluster string) bool {
+		val, err := c.client.GetBoolValue(key, getFilterMap(DomainFilter(domain), ClusterFilter(cluster)), defaultValue)
+		if err != nil {
+			c.logNoValue(key, err)
+		}
+		c.logValue(key, val, defaultValue)
+		return val
+	}
+}
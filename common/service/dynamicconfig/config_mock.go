@@ -39,6 +39,11 @@ func GetIntPropertyFilteredByTaskListInfo(value int) func(domain string, taskLis
 	return func(domain string, taskList string, taskType int) int { return value }
 }
 
+// GetIntPropertyFilteredByDomainAndCluster returns value as IntPropertyFnWithDomainAndClusterFilters
+func GetIntPropertyFilteredByDomainAndCluster(value int) func(domain string, cluster string) int {
+	return func(domain string, cluster string) int { return value }
+}
+
 // GetFloatPropertyFn returns value as FloatPropertyFn
 func GetFloatPropertyFn(value float64) func(opts ...FilterOption) float64 {
 	return func(...FilterOption) float64 { return value }
@@ -54,11 +59,21 @@ func GetBoolPropertyFnFilteredByDomain(value bool) func(domain string) bool {
 	return func(domain string) bool { return value }
 }
 
+// GetBoolPropertyFilteredByDomainAndCluster returns value as BoolPropertyFnWithDomainAndClusterFilters
+func GetBoolPropertyFilteredByDomainAndCluster(value bool) func(domain string, cluster string) bool {
+	return func(domain string, cluster string) bool { return value }
+}
+
 // GetDurationPropertyFn returns value as DurationPropertyFn
 func GetDurationPropertyFn(value time.Duration) func(opts ...FilterOption) time.Duration {
 	return func(...FilterOption) time.Duration { return value }
 }
 
+// GetDurationPropertyFnFilteredByDomain returns value as DurationPropertyFnWithDomainFilter
+func GetDurationPropertyFnFilteredByDomain(value time.Duration) func(domain string) time.Duration {
+	return func(domain string) time.Duration { return value }
+}
+
 // GetDurationPropertyFnFilteredByTaskListInfo returns value as DurationPropertyFnWithTaskListInfoFilters
 func GetDurationPropertyFnFilteredByTaskListInfo(value time.Duration) func(domain string, taskList string, taskType int) time.Duration {
 	return func(domain string, taskList string, taskType int) time.Duration { return value }
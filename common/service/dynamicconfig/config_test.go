@@ -175,6 +175,16 @@ func (s *configSuite) TestGetIntPropertyFilteredByTaskListInfo() {
 	s.Equal(50, value(domain, taskList, taskType))
 }
 
+func (s *configSuite) TestGetIntPropertyFilteredByDomainAndCluster() {
+	key := testGetIntPropertyFilteredByDomainAndClusterKey
+	domain := "testDomain"
+	cluster := "testCluster"
+	value := s.cln.GetIntPropertyFilteredByDomainAndCluster(key, 10)
+	s.Equal(10, value(domain, cluster))
+	s.client.SetValue(key, 50)
+	s.Equal(50, value(domain, cluster))
+}
+
 func (s *configSuite) TestGetFloat64Property() {
 	key := testGetFloat64PropertyKey
 	value := s.cln.GetFloat64Property(key, 0.1)
@@ -202,6 +212,16 @@ func (s *configSuite) TestGetBoolPropertyFilteredByTaskListInfo() {
 	s.Equal(true, value(domain, taskList, taskType))
 }
 
+func (s *configSuite) TestGetBoolPropertyFilteredByDomainAndCluster() {
+	key := testGetBoolPropertyFilteredByDomainAndClusterKey
+	domain := "testDomain"
+	cluster := "testCluster"
+	value := s.cln.GetBoolPropertyFilteredByDomainAndCluster(key, false)
+	s.Equal(false, value(domain, cluster))
+	s.client.SetValue(key, true)
+	s.Equal(true, value(domain, cluster))
+}
+
 func (s *configSuite) TestGetDurationProperty() {
 	key := testGetDurationPropertyKey
 	value := s.cln.GetDurationProperty(key, time.Second)
@@ -46,6 +46,8 @@ var keys = map[Key]string{
 	testGetIntPropertyFilteredByTaskListInfoKey:      "testGetIntPropertyFilteredByTaskListInfoKey",
 	testGetDurationPropertyFilteredByTaskListInfoKey: "testGetDurationPropertyFilteredByTaskListInfoKey",
 	testGetBoolPropertyFilteredByTaskListInfoKey:     "testGetBoolPropertyFilteredByTaskListInfoKey",
+	testGetIntPropertyFilteredByDomainAndClusterKey:  "testGetIntPropertyFilteredByDomainAndClusterKey",
+	testGetBoolPropertyFilteredByDomainAndClusterKey: "testGetBoolPropertyFilteredByDomainAndClusterKey",
 
 	// system settings
 	EnableGlobalDomain:       "system.enableGlobalDomain",
@@ -54,6 +56,14 @@ var keys = map[Key]string{
 	EnableVisibilityToKafka:  "system.enableVisibilityToKafka",
 	EnableArchival:           "system.enableArchival",
 
+	ClusterMetadataRefreshInterval: "system.clusterMetadataRefreshInterval",
+
+	// persistence fault injection
+	EnablePersistenceFaultInjection:    "system.enablePersistenceFaultInjection",
+	PersistenceFaultInjectionErrorRate: "system.persistenceFaultInjectionErrorRate",
+	PersistenceFaultInjectionErrorType: "system.persistenceFaultInjectionErrorType",
+	PersistenceFaultInjectionLatency:   "system.persistenceFaultInjectionLatency",
+
 	// size limit
 	BlobSizeLimitError:     "limit.blobSize.error",
 	BlobSizeLimitWarn:      "limit.blobSize.warn",
@@ -64,14 +74,15 @@ var keys = map[Key]string{
 	MaxIDLengthLimit:       "limit.maxIDLength",
 
 	// frontend settings
-	FrontendPersistenceMaxQPS:      "frontend.persistenceMaxQPS",
-	FrontendVisibilityMaxPageSize:  "frontend.visibilityMaxPageSize",
-	FrontendVisibilityListMaxQPS:   "frontend.visibilityListMaxQPS",
-	FrontendHistoryMaxPageSize:     "frontend.historyMaxPageSize",
-	FrontendRPS:                    "frontend.rps",
-	FrontendHistoryMgrNumConns:     "frontend.historyMgrNumConns",
-	MaxDecisionStartToCloseTimeout: "frontend.maxDecisionStartToCloseTimeout",
-	DisableListVisibilityByFilter:  "frontend.disableListVisibilityByFilter",
+	FrontendPersistenceMaxQPS:           "frontend.persistenceMaxQPS",
+	FrontendVisibilityMaxPageSize:       "frontend.visibilityMaxPageSize",
+	FrontendVisibilityListMaxQPS:        "frontend.visibilityListMaxQPS",
+	FrontendHistoryMaxPageSize:          "frontend.historyMaxPageSize",
+	FrontendRPS:                         "frontend.rps",
+	FrontendHistoryMgrNumConns:          "frontend.historyMgrNumConns",
+	MaxDecisionStartToCloseTimeout:      "frontend.maxDecisionStartToCloseTimeout",
+	DisableListVisibilityByFilter:       "frontend.disableListVisibilityByFilter",
+	FrontendDomainFailoverDrainInterval: "frontend.domainFailoverDrainInterval",
 
 	// matching settings
 	MatchingRPS:                             "matching.rps",
@@ -85,6 +96,19 @@ var keys = map[Key]string{
 	MaxTasklistIdleTime:                     "matching.maxTasklistIdleTime",
 	MatchingOutstandingTaskAppendsThreshold: "matching.outstandingTaskAppendsThreshold",
 	MatchingMaxTaskBatchSize:                "matching.maxTaskBatchSize",
+	MatchingExpiredTaskSweepInterval:        "matching.expiredTaskSweepInterval",
+	MatchingNumTasklistPartitions:           "matching.numTasklistPartitions",
+	MatchingForwarderMaxOutstandingPolls:    "matching.forwarderMaxOutstandingPolls",
+	MatchingForwarderMaxOutstandingTasks:    "matching.forwarderMaxOutstandingTasks",
+	MatchingForwarderBacklogInterval:        "matching.forwarderBacklogInterval",
+	MatchingDomainMaxTaskLists:              "matching.domainMaxTaskLists",
+	MatchingDomainMaxBufferedTasks:          "matching.domainMaxBufferedTasks",
+	MatchingDomainPersistenceMaxQPS:         "matching.domainPersistenceMaxQPS",
+	MatchingMaxConcurrentTaskListReads:      "matching.maxConcurrentTaskListReads",
+	MatchingTaskListMetricsInterval:         "matching.taskListMetricsInterval",
+	MatchingStarvedTaskListBacklogAge:       "matching.starvedTaskListBacklogAge",
+	MatchingStarvedTaskListNoPollInterval:   "matching.starvedTaskListNoPollInterval",
+	MatchingTaskPriorityStarvationLimit:     "matching.taskPriorityStarvationLimit",
 
 	// history settings
 	EnableSyncActivityHeartbeat:                           "history.enableSyncActivityHeartbeat",
@@ -139,6 +163,11 @@ var keys = map[Key]string{
 	ReplicatorProcessorMaxPollIntervalJitterCoefficient:   "history.replicatorProcessorMaxPollIntervalJitterCoefficient",
 	ReplicatorProcessorUpdateAckInterval:                  "history.replicatorProcessorUpdateAckInterval",
 	ReplicatorProcessorUpdateAckIntervalJitterCoefficient: "history.replicatorProcessorUpdateAckIntervalJitterCoefficient",
+	ReplicatorDomainPaused:                                "history.replicatorDomainPaused",
+	ReplicatorDomainMaxRPS:                                "history.replicatorDomainMaxRPS",
+	EnableReplicationTaskPull:                             "history.enableReplicationTaskPull",
+	ReplicationTaskPullInterval:                           "history.replicationTaskPullInterval",
+	ReplicationTaskPullErrorRetryWait:                     "history.replicationTaskPullErrorRetryWait",
 	ExecutionMgrNumConns:                                  "history.executionMgrNumConns",
 	HistoryMgrNumConns:                                    "history.historyMgrNumConns",
 	MaximumBufferedEventsBatch:                            "history.maximumBufferedEventsBatch",
@@ -156,11 +185,18 @@ var keys = map[Key]string{
 	WorkerReplicatorActivityBufferRetryCount: "worker.replicatorActivityBufferRetryCount",
 	WorkerReplicatorHistoryBufferRetryCount:  "worker.replicatorHistoryBufferRetryCount",
 	WorkerReplicationTaskMaxRetry:            "worker.replicationTaskMaxRetry",
+	WorkerReplicationDomainPaused:            "worker.replicationDomainPaused",
+	WorkerReplicationDomainMaxRPS:            "worker.replicationDomainMaxRPS",
 	WorkerIndexerConcurrency:                 "worker.indexerConcurrency",
 	WorkerESProcessorNumOfWorkers:            "worker.ESProcessorNumOfWorkers",
 	WorkerESProcessorBulkActions:             "worker.ESProcessorBulkActions",
 	WorkerESProcessorBulkSize:                "worker.ESProcessorBulkSize",
 	WorkerESProcessorFlushInterval:           "worker.ESProcessorFlushInterval",
+	WorkerBootstrapMaxRPS:                    "worker.bootstrapMaxRPS",
+	WorkerTaskListScavengerEnabled:           "worker.taskListScavengerEnabled",
+	WorkerTaskListScavengerMaxRPS:            "worker.taskListScavengerMaxRPS",
+	WorkerTaskListScavengerBatchSize:         "worker.taskListScavengerBatchSize",
+	WorkerTaskListScavengerIdleRetention:     "worker.taskListScavengerIdleRetention",
 }
 
 const (
@@ -177,6 +213,8 @@ const (
 	testGetIntPropertyFilteredByTaskListInfoKey
 	testGetDurationPropertyFilteredByTaskListInfoKey
 	testGetBoolPropertyFilteredByTaskListInfoKey
+	testGetIntPropertyFilteredByDomainAndClusterKey
+	testGetBoolPropertyFilteredByDomainAndClusterKey
 
 	// EnableGlobalDomain is key for enable global domain
 	EnableGlobalDomain
@@ -191,6 +229,19 @@ const (
 	// EnableArchival is key for enable archival
 	EnableArchival
 
+	// ClusterMetadataRefreshInterval is the interval at which clusters persisted in the metadata store are reloaded
+	ClusterMetadataRefreshInterval
+
+	// EnablePersistenceFaultInjection is key for wrapping persistence managers with fault injection clients
+	EnablePersistenceFaultInjection
+	// PersistenceFaultInjectionErrorRate is the rate [0, 1] at which persistence calls fail, can be filtered by persistence operation
+	PersistenceFaultInjectionErrorRate
+	// PersistenceFaultInjectionErrorType is the type of error returned on injected failures:
+	// timeout, conditionFailed, shardOwnershipLost or generic, can be filtered by persistence operation
+	PersistenceFaultInjectionErrorType
+	// PersistenceFaultInjectionLatency is the latency added to persistence calls, can be filtered by persistence operation
+	PersistenceFaultInjectionLatency
+
 	// BlobSizeLimitError is the per event blob size limit
 	BlobSizeLimitError
 	// BlobSizeLimitWarn is the per event blob size limit for warning
@@ -224,6 +275,8 @@ const (
 	FrontendHistoryMgrNumConns
 	// MaxDecisionStartToCloseTimeout is max decision timeout in seconds
 	MaxDecisionStartToCloseTimeout
+	// FrontendDomainFailoverDrainInterval is the interval to check the progress of graceful domain failovers
+	FrontendDomainFailoverDrainInterval
 
 	// key for matching
 
@@ -249,6 +302,33 @@ const (
 	MatchingOutstandingTaskAppendsThreshold
 	// MatchingMaxTaskBatchSize is max batch size for task writer
 	MatchingMaxTaskBatchSize
+	// MatchingExpiredTaskSweepInterval is the interval to sweep expired tasks out of the task buffer
+	MatchingExpiredTaskSweepInterval
+	// MatchingNumTasklistPartitions is the number of partitions a task list is split into
+	MatchingNumTasklistPartitions
+	// MatchingForwarderMaxOutstandingPolls is the max number of polls a task list partition forwards to the root partition at a time
+	MatchingForwarderMaxOutstandingPolls
+	// MatchingForwarderMaxOutstandingTasks is the max number of tasks a task list partition forwards to the root partition at a time
+	MatchingForwarderMaxOutstandingTasks
+	// MatchingForwarderBacklogInterval is how often a task list partition tries to forward a backlog task to the root partition
+	MatchingForwarderBacklogInterval
+	// MatchingDomainMaxTaskLists is the max number of task lists a domain can have loaded on a matching host
+	MatchingDomainMaxTaskLists
+	// MatchingDomainMaxBufferedTasks is the max number of tasks a domain can have buffered in memory on a matching host
+	MatchingDomainMaxBufferedTasks
+	// MatchingDomainPersistenceMaxQPS is the max qps the task lists of a domain can use on the task persistence of a matching host
+	MatchingDomainPersistenceMaxQPS
+	// MatchingMaxConcurrentTaskListReads is the max number of task lists reading tasks from persistence at a time on a matching host
+	MatchingMaxConcurrentTaskListReads
+	// MatchingTaskListMetricsInterval is how often a task list emits its backlog and poller gauges
+	MatchingTaskListMetricsInterval
+	// MatchingStarvedTaskListBacklogAge is the backlog age above which a task list without polls is starved, 0 disables it
+	MatchingStarvedTaskListBacklogAge
+	// MatchingStarvedTaskListNoPollInterval is how long a task list must go without polls to be starved
+	MatchingStarvedTaskListNoPollInterval
+	// MatchingTaskPriorityStarvationLimit is how many times in a row higher priority tasks can be dispatched
+	// ahead of an older task before the older task is dispatched, 0 disables the starvation protection
+	MatchingTaskPriorityStarvationLimit
 
 	// key for history
 
@@ -356,6 +436,18 @@ const (
 	ReplicatorProcessorUpdateAckInterval
 	// ReplicatorProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient
 	ReplicatorProcessorUpdateAckIntervalJitterCoefficient
+	// ReplicatorDomainPaused holds the replication tasks of a domain to a target cluster, filtered by domain and cluster
+	ReplicatorDomainPaused
+	// ReplicatorDomainMaxRPS is the max rate at which replication tasks of a domain are sent to a target cluster,
+	// filtered by domain and cluster, zero means unlimited
+	ReplicatorDomainMaxRPS
+	// EnableReplicationTaskPull is whether standby shards pull replication tasks from remote clusters over RPC
+	// instead of receiving them through Kafka
+	EnableReplicationTaskPull
+	// ReplicationTaskPullInterval is the interval between pulls when a shard has caught up with the remote cluster
+	ReplicationTaskPullInterval
+	// ReplicationTaskPullErrorRetryWait is the wait time before pulling again after a failed pull
+	ReplicationTaskPullErrorRetryWait
 	// ExecutionMgrNumConns is persistence connections number for ExecutionManager
 	ExecutionMgrNumConns
 	// HistoryMgrNumConns is persistence connections number for HistoryManager
@@ -393,6 +485,11 @@ const (
 	WorkerReplicatorHistoryBufferRetryCount
 	// WorkerReplicationTaskMaxRetry is the max retry for any task
 	WorkerReplicationTaskMaxRetry
+	// WorkerReplicationDomainPaused holds the replication tasks of a domain from a source cluster, filtered by domain and cluster
+	WorkerReplicationDomainPaused
+	// WorkerReplicationDomainMaxRPS is the max rate at which replication tasks of a domain from a source cluster are applied,
+	// filtered by domain and cluster, zero means unlimited
+	WorkerReplicationDomainMaxRPS
 	// WorkerIndexerConcurrency is the max concurrent messages to be processed at any given time
 	WorkerIndexerConcurrency
 	// WorkerESProcessorNumOfWorkers is num of workers for esProcessor
@@ -403,6 +500,17 @@ const (
 	WorkerESProcessorBulkSize
 	// WorkerESProcessorFlushInterval is flush interval for esProcessor
 	WorkerESProcessorFlushInterval
+	// WorkerBootstrapMaxRPS is the max rate at which a bootstrap workflow replicates executions, zero means no limit
+	WorkerBootstrapMaxRPS
+	// WorkerTaskListScavengerEnabled starts the task list scavenger workflow
+	WorkerTaskListScavengerEnabled
+	// WorkerTaskListScavengerMaxRPS is the max rate at which the task list scavenger makes persistence and history calls
+	WorkerTaskListScavengerMaxRPS
+	// WorkerTaskListScavengerBatchSize is the number of task lists or tasks the task list scavenger reads per page
+	WorkerTaskListScavengerBatchSize
+	// WorkerTaskListScavengerIdleRetention is the time after which the task list scavenger deletes a task list
+	// which has not been updated and has no tasks left
+	WorkerTaskListScavengerIdleRetention
 
 	// lastKeyForTest must be the last one in this const group for testing purpose
 	lastKeyForTest
@@ -412,7 +520,7 @@ const (
 type Filter int
 
 func (f Filter) String() string {
-	if f <= unknownFilter || f > TaskListName {
+	if f <= unknownFilter || f >= lastFilterTypeForTest {
 		return filters[unknownFilter]
 	}
 	return filters[f]
@@ -423,6 +531,8 @@ var filters = []string{
 	"domainName",
 	"taskListName",
 	"taskType",
+	"persistenceOperation",
+	"clusterName",
 }
 
 const (
@@ -433,6 +543,10 @@ const (
 	TaskListName
 	// TaskType is the task type (0:Decision, 1:Activity)
 	TaskType
+	// PersistenceOperation is the persistence API name, e.g. UpdateWorkflowExecution
+	PersistenceOperation
+	// ClusterName is the cluster name
+	ClusterName
 
 	// lastFilterTypeForTest must be the last one in this const group for testing purpose
 	lastFilterTypeForTest
@@ -461,3 +575,17 @@ func TaskTypeFilter(taskType int) FilterOption {
 		filterMap[TaskType] = taskType
 	}
 }
+
+// ClusterFilter filters by cluster name
+func ClusterFilter(name string) FilterOption {
+	return func(filterMap map[Filter]interface{}) {
+		filterMap[ClusterName] = name
+	}
+}
+
+// PersistenceOperationFilter filters by persistence API name
+func PersistenceOperationFilter(operation string) FilterOption {
+	return func(filterMap map[Filter]interface{}) {
+		filterMap[PersistenceOperation] = operation
+	}
+}
@@ -73,6 +73,8 @@ type (
 		DynamicConfig      dynamicconfig.Client
 		DispatcherProvider client.DispatcherProvider
 		BlobstoreClient    blobstore.Client
+		// TimeSource is the clock used by the service, defaults to the wall clock
+		TimeSource common.TimeSource
 	}
 
 	// RingpopFactory provides a bootstrapped ringpop
@@ -103,6 +105,7 @@ type (
 		messagingClient        messaging.Client
 		dynamicCollection      *dynamicconfig.Collection
 		dispatcherProvider     client.DispatcherProvider
+		timeSource             common.TimeSource
 	}
 )
 
@@ -123,6 +126,10 @@ func New(params *BootstrapParams) Service {
 		messagingClient:       params.MessagingClient,
 		dispatcherProvider:    params.DispatcherProvider,
 		dynamicCollection:     dynamicconfig.NewCollection(params.DynamicConfig, params.Logger),
+		timeSource:            params.TimeSource,
+	}
+	if sVice.timeSource == nil {
+		sVice.timeSource = common.NewRealTimeSource()
 	}
 	sVice.runtimeMetricsReporter = metrics.NewRuntimeMetricsReporter(params.MetricScope, time.Minute, sVice.logger)
 	sVice.dispatcher = sVice.rpcFactory.CreateDispatcher()
@@ -196,7 +203,7 @@ func (h *serviceImpl) Start() {
 	h.hostInfo = hostInfo
 
 	h.clientBean, err = client.NewClientBean(
-		client.NewRPCClientFactory(h.rpcFactory, h.membershipMonitor, h.metricsClient, h.numberOfHistoryShards),
+		client.NewRPCClientFactory(h.rpcFactory, h.membershipMonitor, h.metricsClient, h.dynamicCollection, h.numberOfHistoryShards),
 		h.dispatcherProvider,
 		h.clusterMetadata,
 	)
@@ -267,6 +274,11 @@ func (h *serviceImpl) GetMessagingClient() messaging.Client {
 	return h.messagingClient
 }
 
+// GetTimeSource returns the clock used by the service
+func (h *serviceImpl) GetTimeSource() common.TimeSource {
+	return h.timeSource
+}
+
 // GetMetricsServiceIdx returns the metrics name
 func GetMetricsServiceIdx(serviceName string, logger bark.Logger) metrics.ServiceIdx {
 	switch serviceName {
@@ -22,6 +22,7 @@ package service
 
 import (
 	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
@@ -122,3 +123,8 @@ func (s *serviceTestBase) GetClusterMetadata() cluster.Metadata {
 func (s *serviceTestBase) GetMessagingClient() messaging.Client {
 	return s.messagingClient
 }
+
+// GetTimeSource returns the clock used by the service
+func (s *serviceTestBase) GetTimeSource() common.TimeSource {
+	return common.NewRealTimeSource()
+}
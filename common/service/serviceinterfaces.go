@@ -23,6 +23,7 @@ package service
 import (
 	"github.com/uber-common/bark"
 	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
@@ -59,5 +60,8 @@ type (
 
 		// GetMessagingClient returns the messaging client against Kafka
 		GetMessagingClient() messaging.Client
+
+		// GetTimeSource returns the clock used by the service
+		GetTimeSource() common.TimeSource
 	}
 )
@@ -20,7 +20,10 @@
 
 package common
 
-import "time"
+import (
+	"sync"
+	"time"
+)
 
 type (
 	// TimeSource is an interface for any
@@ -37,6 +40,14 @@ type (
 	EventTimeSource struct {
 		now time.Time
 	}
+
+	// ControlledTimeSource serves wall-clock time shifted by an offset
+	// which can be moved forward, it is used to skip time in tests
+	ControlledTimeSource struct {
+		sync.RWMutex
+		offset    time.Duration
+		listeners map[chan struct{}]struct{}
+	}
 )
 
 // NewRealTimeSource returns a time source that servers
@@ -66,3 +77,68 @@ func (ts *EventTimeSource) Update(now time.Time) *EventTimeSource {
 	ts.now = now
 	return ts
 }
+
+// NewControlledTimeSource returns a time source that serves
+// wall clock time until it is advanced
+func NewControlledTimeSource() *ControlledTimeSource {
+	return &ControlledTimeSource{
+		listeners: make(map[chan struct{}]struct{}),
+	}
+}
+
+// Now return the current time shifted by the skipped duration
+func (ts *ControlledTimeSource) Now() time.Time {
+	ts.RLock()
+	defer ts.RUnlock()
+	return time.Now().Add(ts.offset)
+}
+
+// Offset returns the total duration skipped so far
+func (ts *ControlledTimeSource) Offset() time.Duration {
+	ts.RLock()
+	defer ts.RUnlock()
+	return ts.offset
+}
+
+// Advance moves the time forward by the given duration and notifies all
+// subscribers, negative durations are ignored
+func (ts *ControlledTimeSource) Advance(d time.Duration) time.Time {
+	ts.Lock()
+	defer ts.Unlock()
+	if d > 0 {
+		ts.offset += d
+		for ch := range ts.listeners {
+			select {
+			case ch <- struct{}{}:
+			default:
+			}
+		}
+	}
+	return time.Now().Add(ts.offset)
+}
+
+// AdvanceTo moves the time forward to the given time, if it is in the future
+func (ts *ControlledTimeSource) AdvanceTo(now time.Time) time.Time {
+	return ts.Advance(now.Sub(ts.Now()))
+}
+
+// Subscribe returns a channel which is notified every time the time source is advanced
+func (ts *ControlledTimeSource) Subscribe() <-chan struct{} {
+	ts.Lock()
+	defer ts.Unlock()
+	ch := make(chan struct{}, 1)
+	ts.listeners[ch] = struct{}{}
+	return ch
+}
+
+// Unsubscribe stops notifications on a channel returned by Subscribe
+func (ts *ControlledTimeSource) Unsubscribe(ch <-chan struct{}) {
+	ts.Lock()
+	defer ts.Unlock()
+	for listener := range ts.listeners {
+		if listener == ch {
+			delete(ts.listeners, listener)
+			return
+		}
+	}
+}
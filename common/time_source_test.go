@@ -0,0 +1,86 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	ControlledTimeSourceSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+	}
+)
+
+func TestControlledTimeSourceSuite(t *testing.T) {
+	suite.Run(t, new(ControlledTimeSourceSuite))
+}
+
+func (s *ControlledTimeSourceSuite) SetupTest() {
+	s.Assertions = require.New(s.T()) // Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+}
+
+func (s *ControlledTimeSourceSuite) TestAdvance() {
+	ts := NewControlledTimeSource()
+	s.Equal(time.Duration(0), ts.Offset())
+
+	now := ts.Advance(30 * 24 * time.Hour)
+	s.Equal(30*24*time.Hour, ts.Offset())
+	s.True(now.After(time.Now().Add(29 * 24 * time.Hour)))
+	s.False(ts.Now().Before(now))
+
+	ts.Advance(-time.Hour)
+	s.Equal(30*24*time.Hour, ts.Offset())
+
+	ts.AdvanceTo(time.Now())
+	s.Equal(30*24*time.Hour, ts.Offset())
+}
+
+func (s *ControlledTimeSourceSuite) TestSubscribe() {
+	ts := NewControlledTimeSource()
+	ch := ts.Subscribe()
+
+	ts.Advance(time.Hour)
+	ts.Advance(time.Hour)
+	select {
+	case <-ch:
+	default:
+		s.Fail("subscriber is not notified")
+	}
+	select {
+	case <-ch:
+		s.Fail("notifications are not coalesced")
+	default:
+	}
+
+	ts.Unsubscribe(ch)
+	ts.Advance(time.Hour)
+	select {
+	case <-ch:
+		s.Fail("unsubscribed channel is notified")
+	default:
+	}
+}
@@ -22,6 +22,7 @@ package common
 
 import (
 	"encoding/json"
+	"fmt"
 	"sync"
 	"time"
 
@@ -335,6 +336,16 @@ func ValidateCronSchedule(cronSchedule string) error {
 	return nil
 }
 
+// ValidateTaskPriority validates the priority of the tasks of a workflow or an activity
+func ValidateTaskPriority(priority int32) error {
+	if priority < MinTaskPriority || priority > MaxTaskPriority {
+		return &workflow.BadRequestError{
+			Message: fmt.Sprintf("Priority must be between %v and %v.", MinTaskPriority, MaxTaskPriority),
+		}
+	}
+	return nil
+}
+
 // CreateHistoryStartWorkflowRequest create a start workflow request for history
 func CreateHistoryStartWorkflowRequest(domainID string, startRequest *workflow.StartWorkflowExecutionRequest) *h.StartWorkflowExecutionRequest {
 	histRequest := &h.StartWorkflowExecutionRequest{
@@ -58,6 +58,11 @@ type (
 	// historyReplicationFn provides the functionality to deliver replication raw history request to history
 	historyReplicationFn func(ctx context.Context, request *history.ReplicateRawEventsRequest) error
 
+	// adminClientFn provides the admin client of the source cluster, it is resolved for every request
+	// so that changes to the source cluster address are picked up at runtime. It returns an error if
+	// the source cluster is no longer known.
+	adminClientFn func() (a.Client, error)
+
 	// HistoryRereplicator is the interface for resending history events to remote
 	HistoryRereplicator interface {
 		// SendMultiWorkflowHistory sends multiple run IDs's history events to remote
@@ -69,7 +74,7 @@ type (
 	HistoryRereplicatorImpl struct {
 		targetClusterName    string
 		domainCache          cache.DomainCache
-		adminClient          a.Client
+		adminClientFn        adminClientFn
 		historyReplicationFn historyReplicationFn
 		serializer           persistence.HistorySerializer
 		replicationTimeout   time.Duration
@@ -105,13 +110,13 @@ func newHistoryRereplicationContext(domainID string, workflowID string,
 }
 
 // NewHistoryRereplicator create a new HistoryRereplicatorImpl
-func NewHistoryRereplicator(targetClusterName string, domainCache cache.DomainCache, adminClient a.Client, historyReplicationFn historyReplicationFn,
+func NewHistoryRereplicator(targetClusterName string, domainCache cache.DomainCache, adminClientFn adminClientFn, historyReplicationFn historyReplicationFn,
 	serializer persistence.HistorySerializer, replicationTimeout time.Duration, logger bark.Logger) *HistoryRereplicatorImpl {
 
 	return &HistoryRereplicatorImpl{
 		targetClusterName:    targetClusterName,
 		domainCache:          domainCache,
-		adminClient:          adminClient,
+		adminClientFn:        adminClientFn,
 		historyReplicationFn: historyReplicationFn,
 		serializer:           serializer,
 		replicationTimeout:   replicationTimeout,
@@ -396,7 +401,12 @@ func (c *historyRereplicationContext) getHistory(domainID string, workflowID str
 
 	ctx, cancel := context.WithTimeout(context.Background(), c.rereplicator.replicationTimeout)
 	defer cancel()
-	response, err := c.rereplicator.adminClient.GetWorkflowExecutionRawHistory(ctx, &admin.GetWorkflowExecutionRawHistoryRequest{
+	adminClient, err := c.rereplicator.adminClientFn()
+	if err != nil {
+		logger.WithField(logging.TagErr, err).Error("error getting admin client of source cluster")
+		return nil, err
+	}
+	response, err := adminClient.GetWorkflowExecutionRawHistory(ctx, &admin.GetWorkflowExecutionRawHistoryRequest{
 		Domain: common.StringPtr(domainName),
 		Execution: &shared.WorkflowExecution{
 			WorkflowId: common.StringPtr(workflowID),
@@ -35,6 +35,7 @@ import (
 	"github.com/uber/cadence/.gen/go/admin"
 	"github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/shared"
+	a "github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
@@ -109,7 +110,7 @@ func (s *historyRereplicatorSuite) SetupTest() {
 	s.rereplicator = NewHistoryRereplicator(
 		s.targetClusterName,
 		domainCache,
-		s.mockAdminClient,
+		func() (a.Client, error) { return s.mockAdminClient, nil },
 		func(ctx context.Context, request *history.ReplicateRawEventsRequest) error {
 			return s.mockHistoryClient.ReplicateRawEvents(ctx, request)
 		},
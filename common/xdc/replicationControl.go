@@ -0,0 +1,112 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package xdc
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// ReplicationControl decides, based on dynamic config, whether the replication of a domain
+	// between the current cluster and a remote cluster is paused or throttled
+	ReplicationControl interface {
+		// IsPaused returns true if the replication tasks of the domain for the remote cluster must be held
+		IsPaused(domainName string, clusterName string) bool
+		// MaxRPS returns the max rate of replication tasks of the domain for the remote cluster, zero means unlimited
+		MaxRPS(domainName string, clusterName string) int
+		// Consume waits up to timeout for the rate limit of the domain and remote cluster to allow one
+		// replication task, returns false if the timeout expires first
+		Consume(domainName string, clusterName string, timeout time.Duration) bool
+	}
+
+	replicationControlImpl struct {
+		paused dynamicconfig.BoolPropertyFnWithDomainAndClusterFilters
+		maxRPS dynamicconfig.IntPropertyFnWithDomainAndClusterFilters
+
+		sync.Mutex
+		rateLimiters map[replicationControlKey]*replicationRateLimiter
+	}
+
+	replicationControlKey struct {
+		domainName  string
+		clusterName string
+	}
+
+	replicationRateLimiter struct {
+		rps         int
+		tokenBucket common.TokenBucket
+	}
+)
+
+// NewReplicationControl creates a ReplicationControl backed by the given dynamic config properties
+func NewReplicationControl(
+	paused dynamicconfig.BoolPropertyFnWithDomainAndClusterFilters,
+	maxRPS dynamicconfig.IntPropertyFnWithDomainAndClusterFilters,
+) ReplicationControl {
+	return &replicationControlImpl{
+		paused:       paused,
+		maxRPS:       maxRPS,
+		rateLimiters: make(map[replicationControlKey]*replicationRateLimiter),
+	}
+}
+
+func (c *replicationControlImpl) IsPaused(domainName string, clusterName string) bool {
+	return c.paused(domainName, clusterName)
+}
+
+func (c *replicationControlImpl) MaxRPS(domainName string, clusterName string) int {
+	rps := c.maxRPS(domainName, clusterName)
+	if rps < 0 {
+		return 0
+	}
+	return rps
+}
+
+func (c *replicationControlImpl) Consume(domainName string, clusterName string, timeout time.Duration) bool {
+	rps := c.MaxRPS(domainName, clusterName)
+	if rps == 0 {
+		return true
+	}
+	return c.getTokenBucket(domainName, clusterName, rps).Consume(1, timeout)
+}
+
+// getTokenBucket returns the token bucket of the domain and cluster, the bucket is recreated when the
+// dynamic config rate changes
+func (c *replicationControlImpl) getTokenBucket(domainName string, clusterName string, rps int) common.TokenBucket {
+	key := replicationControlKey{domainName: domainName, clusterName: clusterName}
+
+	c.Lock()
+	defer c.Unlock()
+
+	limiter, ok := c.rateLimiters[key]
+	if !ok || limiter.rps != rps {
+		limiter = &replicationRateLimiter{
+			rps:         rps,
+			tokenBucket: common.NewTokenBucket(rps, common.NewRealTimeSource()),
+		}
+		c.rateLimiters[key] = limiter
+	}
+	return limiter.tokenBucket
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package xdc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	replicationControlSuite struct {
+		suite.Suite
+	}
+)
+
+func TestReplicationControlSuite(t *testing.T) {
+	s := new(replicationControlSuite)
+	suite.Run(t, s)
+}
+
+func (s *replicationControlSuite) TestIsPaused() {
+	control := NewReplicationControl(
+		func(domain string, cluster string) bool { return domain == "paused-domain" && cluster == "standby" },
+		dynamicconfig.GetIntPropertyFilteredByDomainAndCluster(0),
+	)
+	s.True(control.IsPaused("paused-domain", "standby"))
+	s.False(control.IsPaused("paused-domain", "other"))
+	s.False(control.IsPaused("other-domain", "standby"))
+}
+
+func (s *replicationControlSuite) TestConsume_Unlimited() {
+	control := NewReplicationControl(
+		dynamicconfig.GetBoolPropertyFilteredByDomainAndCluster(false),
+		dynamicconfig.GetIntPropertyFilteredByDomainAndCluster(-1),
+	)
+	s.Equal(0, control.MaxRPS("some-domain", "standby"))
+	for i := 0; i < 1000; i++ {
+		s.True(control.Consume("some-domain", "standby", 0))
+	}
+}
+
+func (s *replicationControlSuite) TestConsume_Throttled() {
+	rps := 1
+	control := NewReplicationControl(
+		dynamicconfig.GetBoolPropertyFilteredByDomainAndCluster(false),
+		func(domain string, cluster string) int { return rps },
+	)
+	s.True(control.Consume("some-domain", "standby", 0))
+	s.False(control.Consume("some-domain", "standby", 0))
+	// other domains have their own rate limit
+	s.True(control.Consume("other-domain", "standby", 0))
+
+	// a rate change takes effect right away
+	rps = 100
+	s.True(control.Consume("some-domain", "standby", time.Second))
+}
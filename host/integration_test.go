@@ -48,6 +48,11 @@ import (
 	"github.com/uber/cadence/service/matching"
 )
 
+var (
+	integration  = flag.Bool("integration", true, "run integration tests")
+	testEventsV2 = flag.Bool("eventsV2", false, "run integration tests with eventsV2")
+)
+
 type (
 	integrationSuite struct {
 		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
@@ -137,7 +142,7 @@ func (s *integrationSuite) setupSuite(enableGlobalDomain bool, isMasterCluster b
 	s.mockMessagingClient = mocks.NewMockMessagingClient(s.mockProducer, nil)
 
 	s.host = NewCadence(s.ClusterMetadata, client.NewIPYarpcDispatcherProvider(), s.mockMessagingClient, s.MetadataProxy, s.MetadataManagerV2, s.ShardMgr, s.HistoryMgr, s.HistoryV2Mgr, s.ExecutionMgrFactory, s.TaskMgr,
-		s.VisibilityMgr, testNumberOfHistoryShards, testNumberOfHistoryHosts, s.logger, 0, false, s.enableEventsV2, false)
+		s.VisibilityMgr, testNumberOfHistoryShards, testNumberOfHistoryHosts, s.logger, 0, false, s.enableEventsV2, false, nil, nil)
 	s.host.Start()
 
 	s.engine = s.host.GetFrontendClient()
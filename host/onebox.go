@@ -22,7 +22,6 @@ package host
 
 import (
 	"errors"
-	"flag"
 	"fmt"
 	"reflect"
 	"sync"
@@ -61,11 +60,7 @@ import (
 const rpAppNamePrefix string = "cadence"
 const maxRpJoinTimeout = 30 * time.Second
 
-var (
-	integration  = flag.Bool("integration", true, "run integration tests")
-	testEventsV2 = flag.Bool("eventsV2", false, "run integration tests with eventsV2")
-	topicName    = []string{"active", "standby"}
-)
+var topicName = []string{"active", "standby"}
 
 const (
 	testNumberOfHistoryShards = 4
@@ -110,6 +105,22 @@ type (
 		enableWorkerService     bool // tmp flag used to tell if onebox should create worker service
 		enableEventsV2          bool
 		enableVisibilityToKafka bool
+		ports                   *ServicePorts
+		timeSource              common.TimeSource
+	}
+
+	// ServicePorts are the ports the services hosted by Cadence listen on. When not
+	// provided, fixed ports derived from the cluster number are used. A zero pprof
+	// port disables pprof for that service
+	ServicePorts struct {
+		Frontend      int
+		FrontendPProf int
+		History       []int
+		HistoryPProf  []int
+		Matching      int
+		MatchingPProf int
+		Worker        int
+		WorkerPProf   int
 	}
 
 	ringpopFactoryImpl struct {
@@ -122,7 +133,8 @@ func NewCadence(clusterMetadata cluster.Metadata, dispatcherProvider client.Disp
 	metadataMgrV2 persistence.MetadataManager, shardMgr persistence.ShardManager, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager,
 	executionMgrFactory persistence.ExecutionManagerFactory, taskMgr persistence.TaskManager,
 	visibilityMgr persistence.VisibilityManager, numberOfHistoryShards, numberOfHistoryHosts int,
-	logger bark.Logger, clusterNo int, enableWorker, enableEventsV2, enableVisibilityToKafka bool) Cadence {
+	logger bark.Logger, clusterNo int, enableWorker, enableEventsV2, enableVisibilityToKafka bool, ports *ServicePorts,
+	timeSource common.TimeSource) Cadence {
 
 	return &cadenceImpl{
 		numberOfHistoryShards:   numberOfHistoryShards,
@@ -144,6 +156,8 @@ func NewCadence(clusterMetadata cluster.Metadata, dispatcherProvider client.Disp
 		enableWorkerService:     enableWorker,
 		enableEventsV2:          enableEventsV2,
 		enableVisibilityToKafka: enableVisibilityToKafka,
+		ports:                   ports,
+		timeSource:              timeSource,
 	}
 }
 
@@ -198,6 +212,9 @@ func (c *cadenceImpl) Stop() {
 }
 
 func (c *cadenceImpl) FrontendAddress() string {
+	if c.ports != nil {
+		return localHostPort(c.ports.Frontend)
+	}
 	if c.clusterNo != 0 {
 		return cluster.TestAlternativeClusterFrontendAddress
 	}
@@ -205,6 +222,9 @@ func (c *cadenceImpl) FrontendAddress() string {
 }
 
 func (c *cadenceImpl) FrontendPProfPort() int {
+	if c.ports != nil {
+		return c.ports.FrontendPProf
+	}
 	if c.clusterNo != 0 {
 		return 8105
 	}
@@ -213,6 +233,12 @@ func (c *cadenceImpl) FrontendPProfPort() int {
 
 func (c *cadenceImpl) HistoryServiceAddress() []string {
 	hosts := []string{}
+	if c.ports != nil {
+		for _, port := range c.ports.History {
+			hosts = append(hosts, localHostPort(port))
+		}
+		return hosts
+	}
 	startPort := 7200
 	if c.clusterNo != 0 {
 		startPort = 8200
@@ -228,6 +254,9 @@ func (c *cadenceImpl) HistoryServiceAddress() []string {
 
 func (c *cadenceImpl) HistoryPProfPort() []int {
 	ports := []int{}
+	if c.ports != nil {
+		return append(ports, c.ports.HistoryPProf...)
+	}
 	startPort := 7300
 	if c.clusterNo != 0 {
 		startPort = 8300
@@ -242,6 +271,9 @@ func (c *cadenceImpl) HistoryPProfPort() []int {
 }
 
 func (c *cadenceImpl) MatchingServiceAddress() string {
+	if c.ports != nil {
+		return localHostPort(c.ports.Matching)
+	}
 	if c.clusterNo != 0 {
 		return "127.0.0.1:8106"
 	}
@@ -249,6 +281,9 @@ func (c *cadenceImpl) MatchingServiceAddress() string {
 }
 
 func (c *cadenceImpl) MatchingPProfPort() int {
+	if c.ports != nil {
+		return c.ports.MatchingPProf
+	}
 	if c.clusterNo != 0 {
 		return 8107
 	}
@@ -256,6 +291,9 @@ func (c *cadenceImpl) MatchingPProfPort() int {
 }
 
 func (c *cadenceImpl) WorkerServiceAddress() string {
+	if c.ports != nil {
+		return localHostPort(c.ports.Worker)
+	}
 	if c.clusterNo != 0 {
 		return "127.0.0.1:8108"
 	}
@@ -263,6 +301,9 @@ func (c *cadenceImpl) WorkerServiceAddress() string {
 }
 
 func (c *cadenceImpl) WorkerPProfPort() int {
+	if c.ports != nil {
+		return c.ports.WorkerPProf
+	}
 	if c.clusterNo != 0 {
 		return 8109
 	}
@@ -286,6 +327,7 @@ func (c *cadenceImpl) startFrontend(rpHosts []string, startWG *sync.WaitGroup) {
 	params := new(service.BootstrapParams)
 	params.Name = common.FrontendServiceName
 	params.Logger = c.logger
+	params.TimeSource = c.timeSource
 	params.PProfInitializer = newPProfInitializerImpl(c.logger, c.FrontendPProfPort())
 	params.RPCFactory = newRPCFactoryImpl(common.FrontendServiceName, c.FrontendAddress(), c.logger)
 	params.MetricScope = tally.NewTestScope(common.FrontendServiceName, make(map[string]string))
@@ -317,10 +359,11 @@ func (c *cadenceImpl) startFrontend(rpHosts []string, startWG *sync.WaitGroup) {
 	}
 
 	c.frontEndService = service.New(params)
+	frontendConfig := frontend.NewConfig(dynamicconfig.NewNopCollection(), c.numberOfHistoryShards)
 	c.adminHandler = frontend.NewAdminHandler(
-		c.frontEndService, c.numberOfHistoryShards, c.metadataMgr, c.historyMgr, c.historyV2Mgr)
+		c.frontEndService, c.numberOfHistoryShards, frontendConfig, c.metadataMgr, c.historyMgr, c.historyV2Mgr)
 	c.frontendHandler = frontend.NewWorkflowHandler(
-		c.frontEndService, frontend.NewConfig(dynamicconfig.NewNopCollection()),
+		c.frontEndService, frontendConfig,
 		c.metadataMgr, c.historyMgr, c.historyV2Mgr, c.visibilityMgr, kafkaProducer, params.BlobstoreClient)
 	err = c.frontendHandler.Start()
 	if err != nil {
@@ -342,7 +385,12 @@ func (c *cadenceImpl) startHistory(rpHosts []string, startWG *sync.WaitGroup, en
 		params := new(service.BootstrapParams)
 		params.Name = common.HistoryServiceName
 		params.Logger = c.logger
-		params.PProfInitializer = newPProfInitializerImpl(c.logger, pprofPorts[i])
+		params.TimeSource = c.timeSource
+		pprofPort := 0 // pprof is disabled for the hosts without a pprof port
+		if i < len(pprofPorts) {
+			pprofPort = pprofPorts[i]
+		}
+		params.PProfInitializer = newPProfInitializerImpl(c.logger, pprofPort)
 		params.RPCFactory = newRPCFactoryImpl(common.HistoryServiceName, hostport, c.logger)
 		params.MetricScope = tally.NewTestScope(common.HistoryServiceName, make(map[string]string))
 		params.RingpopFactory = newRingpopFactory(rpHosts)
@@ -362,6 +410,11 @@ func (c *cadenceImpl) startHistory(rpHosts []string, startWG *sync.WaitGroup, en
 		historyConfig.HistoryMgrNumConns = dynamicconfig.GetIntPropertyFn(c.numberOfHistoryShards)
 		historyConfig.ExecutionMgrNumConns = dynamicconfig.GetIntPropertyFn(c.numberOfHistoryShards)
 		historyConfig.EnableEventsV2 = dynamicconfig.GetBoolPropertyFnFilteredByDomain(enableEventsV2)
+		if c.messagingClient == nil && c.clusterMetadata.IsGlobalDomainEnabled() {
+			// without Kafka, remote clusters pull replication tasks over RPC
+			historyConfig.EnableReplicationTaskPull = dynamicconfig.GetBoolPropertyFn(true)
+			historyConfig.ReplicationTaskPullInterval = dynamicconfig.GetDurationPropertyFn(200 * time.Millisecond)
+		}
 		handler := history.NewHandler(service, historyConfig, c.shardMgr, c.metadataMgr,
 			c.visibilityMgr, c.historyMgr, c.historyV2Mgr, c.executionMgrFactory)
 		handler.Start()
@@ -377,6 +430,7 @@ func (c *cadenceImpl) startMatching(rpHosts []string, startWG *sync.WaitGroup) {
 	params := new(service.BootstrapParams)
 	params.Name = common.MatchingServiceName
 	params.Logger = c.logger
+	params.TimeSource = c.timeSource
 	params.PProfInitializer = newPProfInitializerImpl(c.logger, c.MatchingPProfPort())
 	params.RPCFactory = newRPCFactoryImpl(common.MatchingServiceName, c.MatchingServiceAddress(), c.logger)
 	params.MetricScope = tally.NewTestScope(common.MatchingServiceName, make(map[string]string))
@@ -405,6 +459,7 @@ func (c *cadenceImpl) startWorker(rpHosts []string, startWG *sync.WaitGroup) {
 	params := new(service.BootstrapParams)
 	params.Name = common.WorkerServiceName
 	params.Logger = c.logger
+	params.TimeSource = c.timeSource
 	params.PProfInitializer = newPProfInitializerImpl(c.logger, c.WorkerPProfPort())
 	params.RPCFactory = newRPCFactoryImpl(common.WorkerServiceName, c.WorkerServiceAddress(), c.logger)
 	params.MetricScope = tally.NewTestScope(common.WorkerServiceName, make(map[string]string))
@@ -495,6 +550,10 @@ type rpcFactoryImpl struct {
 	logger      bark.Logger
 }
 
+func localHostPort(port int) string {
+	return fmt.Sprintf("127.0.0.1:%v", port)
+}
+
 func newPProfInitializerImpl(logger bark.Logger, port int) common.PProfInitializer {
 	return &config.PProfInitializerImpl{
 		PProf: &config.PProf{
@@ -0,0 +1,165 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package testserver
+
+import (
+	"errors"
+
+	"github.com/pborman/uuid"
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/memory"
+	pfactory "github.com/uber/cadence/common/persistence/persistence-factory"
+	"github.com/uber/cadence/common/persistence/sql"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const testServerStoreName = "testserver"
+
+var errEventsV2NotSupported = errors.New("events v2 is not supported by the SQLite persistence")
+
+type (
+	// stores holds the persistence managers the services of the test server share
+	stores struct {
+		clusterMetadata cluster.Metadata
+		factory         pfactory.Factory
+		metadataMgr     persistence.MetadataManager
+		metadataMgrV2   persistence.MetadataManager
+		shardMgr        persistence.ShardManager
+		historyMgr      persistence.HistoryManager
+		historyV2Mgr    persistence.HistoryV2Manager
+		taskMgr         persistence.TaskManager
+		visibilityMgr   persistence.VisibilityManager
+		// memoryDatabase is the name of the in-memory database, empty for SQLite
+		memoryDatabase string
+	}
+)
+
+// newStores creates the persistence managers on an in-memory database, or on a SQLite
+// database when sqliteFile is set
+func newStores(options Options, logger bark.Logger) (*stores, error) {
+	s := &stores{clusterMetadata: cluster.GetTestClusterMetadata(false, false)}
+	var dataStore config.DataStore
+	if options.SQLiteFile != "" {
+		if options.EnableEventsV2 {
+			return nil, errEventsV2NotSupported
+		}
+		dataStore.SQL = &config.SQL{DriverName: sql.SQLiteDriverName, DatabaseName: options.SQLiteFile}
+	} else {
+		s.memoryDatabase = uuid.New()
+		dataStore.Memory = &config.Memory{DatabaseName: s.memoryDatabase}
+	}
+	cfg := &config.Persistence{
+		DefaultStore:    testServerStoreName,
+		VisibilityStore: testServerStoreName,
+		DataStores:      map[string]config.DataStore{testServerStoreName: dataStore},
+	}
+	s.factory = pfactory.New(cfg, s.clusterMetadata.GetCurrentClusterName(), nil, logger)
+
+	var err error
+	if s.metadataMgr, err = s.factory.NewMetadataManager(pfactory.MetadataV1V2); err != nil {
+		s.close()
+		return nil, err
+	}
+	if s.metadataMgrV2, err = s.factory.NewMetadataManager(pfactory.MetadataV2); err != nil {
+		s.close()
+		return nil, err
+	}
+	if s.shardMgr, err = s.factory.NewShardManager(); err != nil {
+		s.close()
+		return nil, err
+	}
+	if s.historyMgr, err = s.factory.NewHistoryManager(); err != nil {
+		s.close()
+		return nil, err
+	}
+	if options.EnableEventsV2 {
+		if s.historyV2Mgr, err = s.factory.NewHistoryV2Manager(); err != nil {
+			s.close()
+			return nil, err
+		}
+	}
+	if s.taskMgr, err = s.factory.NewTaskManager(); err != nil {
+		s.close()
+		return nil, err
+	}
+	if s.visibilityMgr, err = s.factory.NewVisibilityManager(false); err != nil {
+		s.close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// setup creates the history shards and registers the domain, what already exists in a reused
+// SQLite database is kept
+func (s *stores) setup(numHistoryShards int, domain string) error {
+	for shardID := 0; shardID < numHistoryShards; shardID++ {
+		err := s.shardMgr.CreateShard(&persistence.CreateShardRequest{
+			ShardInfo: &persistence.ShardInfo{ShardID: shardID},
+		})
+		if _, ok := err.(*persistence.ShardAlreadyExistError); err != nil && !ok {
+			return err
+		}
+	}
+
+	_, err := s.metadataMgr.CreateDomain(&persistence.CreateDomainRequest{
+		Info: &persistence.DomainInfo{
+			ID:          uuid.New(),
+			Name:        domain,
+			Status:      persistence.DomainStatusRegistered,
+			Description: "Domain registered by the cadence test server",
+		},
+		Config: &persistence.DomainConfig{
+			Retention:  defaultRetentionDays,
+			EmitMetric: false,
+		},
+		ReplicationConfig: &persistence.DomainReplicationConfig{
+			ActiveClusterName: s.clusterMetadata.GetCurrentClusterName(),
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: s.clusterMetadata.GetCurrentClusterName()},
+			},
+		},
+		IsGlobalDomain:  false,
+		FailoverVersion: common.EmptyVersion,
+	})
+	if _, ok := err.(*workflow.DomainAlreadyExistsError); ok {
+		return nil
+	}
+	return err
+}
+
+// close closes the managers and drops the in-memory database
+func (s *stores) close() {
+	for _, mgr := range []interface{ Close() }{
+		s.metadataMgr, s.metadataMgrV2, s.shardMgr, s.historyMgr, s.historyV2Mgr, s.taskMgr, s.visibilityMgr,
+	} {
+		if mgr != nil {
+			mgr.Close()
+		}
+	}
+	s.factory.Close()
+	if s.memoryDatabase != "" {
+		memory.DropDatabase(s.memoryDatabase)
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package testserver starts a complete cadence cluster in the current process, backed
+// by in-memory or SQLite persistence and listening on ephemeral ports, so that end-to-end
+// workflow tests can be written without any external services.
+package testserver
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/host"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/transport/tchannel"
+)
+
+const (
+	// DefaultDomain is the domain registered by the test server when none is specified
+	DefaultDomain = "default"
+
+	defaultNumberOfHistoryShards = 4
+	defaultRetentionDays         = 1
+
+	testServerClientName   = "cadence-testserver-client"
+	cadenceFrontendService = "cadence-frontend"
+)
+
+type (
+	// Options are the options to start a TestServer with
+	Options struct {
+		// Domain is registered when the server starts, defaults to DefaultDomain
+		Domain string
+		// NumHistoryShards is the number of history shards, defaults to 4
+		NumHistoryShards int
+		// EnableEventsV2 stores workflow history using the events v2 format, it is not
+		// supported with SQLite
+		EnableEventsV2 bool
+		// SQLiteFile, when set, is the path of a SQLite database the data is stored in instead
+		// of memory. The schema is created if the database is empty, the data of a previous
+		// server is kept
+		SQLiteFile string
+		// Logger is used by all of the services, defaults to a logrus logger
+		Logger bark.Logger
+		// EnableTimeSkipping runs the cluster on a clock which can be moved forward
+		// with AdvanceTime, so that timers fire without waiting for them
+		EnableTimeSkipping bool
+		// AutoSkipIdleDuration, when time skipping is enabled and the value is positive,
+		// moves the clock to the next pending timer whenever the cluster has not made
+		// progress for this long. Activities which neither heartbeat nor complete
+		// within this duration are not considered progress, so their timeouts may fire
+		AutoSkipIdleDuration time.Duration
+	}
+
+	// TestServer is a single process cadence cluster with a frontend, history and
+	// matching service, backed by in-memory or SQLite persistence
+	TestServer struct {
+		cadence    host.Cadence
+		stores     *stores
+		domain     string
+		dispatcher *yarpc.Dispatcher
+		logger     bark.Logger
+
+		timeSource    *common.ControlledTimeSource
+		tracker       *activityTracker
+		executionMgrs []persistence.ExecutionManager
+		shutdownCh    chan struct{}
+		shutdownWG    sync.WaitGroup
+	}
+)
+
+// New starts a new TestServer, it must be stopped by calling Stop
+func New(options Options) (*TestServer, error) {
+	if options.Domain == "" {
+		options.Domain = DefaultDomain
+	}
+	if options.NumHistoryShards == 0 {
+		options.NumHistoryShards = defaultNumberOfHistoryShards
+	}
+	if options.Logger == nil {
+		options.Logger = bark.NewLoggerFromLogrus(log.New())
+	}
+
+	ports, err := newServicePorts()
+	if err != nil {
+		return nil, err
+	}
+
+	stores, err := newStores(options, options.Logger)
+	if err != nil {
+		return nil, err
+	}
+	s := &TestServer{
+		stores:     stores,
+		domain:     options.Domain,
+		logger:     options.Logger,
+		tracker:    newActivityTracker(),
+		shutdownCh: make(chan struct{}),
+	}
+	if err := stores.setup(options.NumHistoryShards, options.Domain); err != nil {
+		stores.close()
+		return nil, err
+	}
+
+	var timeSource common.TimeSource
+	if options.EnableTimeSkipping {
+		s.timeSource = common.NewControlledTimeSource()
+		timeSource = s.timeSource
+	}
+
+	// the test server has no kafka, replication and visibility to kafka are disabled
+	messagingClient := mocks.NewMockMessagingClient(&mocks.KafkaProducer{}, nil)
+	s.cadence = host.NewCadence(stores.clusterMetadata, client.NewIPYarpcDispatcherProvider(), messagingClient,
+		stores.metadataMgr, stores.metadataMgrV2, stores.shardMgr, stores.historyMgr, stores.historyV2Mgr,
+		&trackingExecutionManagerFactory{ExecutionManagerFactory: stores.factory, tracker: s.tracker},
+		&trackingTaskManager{TaskManager: stores.taskMgr, tracker: s.tracker}, stores.visibilityMgr,
+		options.NumHistoryShards, len(ports.History), options.Logger, 0, false, options.EnableEventsV2, false, ports,
+		timeSource)
+	if err := s.cadence.Start(); err != nil {
+		stores.close()
+		return nil, err
+	}
+
+	if options.EnableTimeSkipping && options.AutoSkipIdleDuration > 0 {
+		for shardID := 0; shardID < options.NumHistoryShards; shardID++ {
+			mgr, err := stores.factory.NewExecutionManager(shardID)
+			if err != nil {
+				s.Stop()
+				return nil, err
+			}
+			s.executionMgrs = append(s.executionMgrs, mgr)
+		}
+		s.shutdownWG.Add(1)
+		go s.autoSkipLoop(options.AutoSkipIdleDuration)
+	}
+	return s, nil
+}
+
+// Stop stops all of the services and drops the in-memory data, the data of a SQLite database is kept
+func (s *TestServer) Stop() {
+	close(s.shutdownCh)
+	s.shutdownWG.Wait()
+	for _, mgr := range s.executionMgrs {
+		mgr.Close()
+	}
+	if s.dispatcher != nil {
+		s.dispatcher.Stop()
+	}
+	s.cadence.Stop()
+	s.stores.close()
+}
+
+// FrontendAddress returns the host:port the frontend service listens on
+func (s *TestServer) FrontendAddress() string {
+	return s.cadence.FrontendAddress()
+}
+
+// Domain returns the domain registered by the test server
+func (s *TestServer) Domain() string {
+	return s.domain
+}
+
+// ServiceClient returns a frontend client which can be used to create cadence clients and workers
+func (s *TestServer) ServiceClient() (workflowserviceclient.Interface, error) {
+	if s.dispatcher == nil {
+		ch, err := tchannel.NewChannelTransport(tchannel.ServiceName(testServerClientName), tchannel.ListenAddr("127.0.0.1:0"))
+		if err != nil {
+			return nil, err
+		}
+		dispatcher := yarpc.NewDispatcher(yarpc.Config{
+			Name: testServerClientName,
+			Outbounds: yarpc.Outbounds{
+				cadenceFrontendService: {Unary: ch.NewSingleOutbound(s.FrontendAddress())},
+			},
+		})
+		if err := dispatcher.Start(); err != nil {
+			return nil, err
+		}
+		s.dispatcher = dispatcher
+	}
+	return workflowserviceclient.New(s.dispatcher.ClientConfig(cadenceFrontendService)), nil
+}
+
+// newServicePorts reserves an ephemeral port for every service, pprof is disabled
+func newServicePorts() (*host.ServicePorts, error) {
+	ports := make([]int, 4)
+	for i := range ports {
+		port, err := freePort()
+		if err != nil {
+			return nil, err
+		}
+		ports[i] = port
+	}
+	return &host.ServicePorts{
+		Frontend:     ports[0],
+		History:      []int{ports[1]},
+		HistoryPProf: []int{0},
+		Matching:     ports[2],
+		Worker:       ports[3],
+	}, nil
+}
+
+func freePort() (int, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
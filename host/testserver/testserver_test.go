@@ -0,0 +1,206 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package testserver
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/client"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+)
+
+type (
+	testServerSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+		server *TestServer
+	}
+)
+
+const testServerTaskList = "testserver-tasklist"
+
+func init() {
+	workflow.Register(testServerWorkflow)
+	workflow.Register(testServerLongTimerWorkflow)
+	activity.Register(testServerActivity)
+}
+
+func testServerWorkflow(ctx workflow.Context, name string) (string, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	})
+	if err := workflow.Sleep(ctx, time.Second); err != nil {
+		return "", err
+	}
+	var result string
+	err := workflow.ExecuteActivity(ctx, testServerActivity, name).Get(ctx, &result)
+	return result, err
+}
+
+func testServerLongTimerWorkflow(ctx workflow.Context) (time.Time, error) {
+	if err := workflow.Sleep(ctx, 30*24*time.Hour); err != nil {
+		return time.Time{}, err
+	}
+	return workflow.Now(ctx), nil
+}
+
+func testServerActivity(ctx context.Context, name string) (string, error) {
+	return "hello " + name, nil
+}
+
+func TestTestServerSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	suite.Run(t, new(testServerSuite))
+}
+
+func (s *testServerSuite) SetupSuite() {
+	var err error
+	s.server, err = New(Options{})
+	require.NoError(s.T(), err)
+}
+
+func (s *testServerSuite) TearDownSuite() {
+	s.server.Stop()
+}
+
+func (s *testServerSuite) SetupTest() {
+	s.Assertions = require.New(s.T()) // Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+}
+
+func (s *testServerSuite) TestExecuteWorkflow() {
+	s.Equal(DefaultDomain, s.server.Domain())
+	s.executeWorkflow(s.server)
+}
+
+func (s *testServerSuite) TestExecuteWorkflow_SQLite() {
+	dir, err := ioutil.TempDir("", "testserver")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	options := Options{SQLiteFile: filepath.Join(dir, "cadence.db")}
+
+	server, err := New(options)
+	s.NoError(err)
+	s.executeWorkflow(server)
+	server.Stop()
+
+	// the server starts again on the data left by the previous one
+	server, err = New(options)
+	s.NoError(err)
+	defer server.Stop()
+	s.executeWorkflow(server)
+}
+
+func (s *testServerSuite) TestNew_SQLiteWithEventsV2() {
+	_, err := New(Options{SQLiteFile: "cadence.db", EnableEventsV2: true})
+	s.Equal(errEventsV2NotSupported, err)
+}
+
+func (s *testServerSuite) executeWorkflow(server *TestServer) {
+	service, err := server.ServiceClient()
+	s.NoError(err)
+
+	w := worker.New(service, server.Domain(), testServerTaskList, worker.Options{})
+	s.NoError(w.Start())
+	defer w.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	c := client.NewClient(service, server.Domain(), &client.Options{})
+	run, err := c.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		TaskList:                        testServerTaskList,
+		ExecutionStartToCloseTimeout:    time.Minute,
+		DecisionTaskStartToCloseTimeout: 10 * time.Second,
+	}, testServerWorkflow, "cadence")
+	s.NoError(err)
+
+	var result string
+	s.NoError(run.Get(ctx, &result))
+	s.Equal("hello cadence", result)
+}
+
+func (s *testServerSuite) TestAutoSkipTime() {
+	server, err := New(Options{
+		EnableTimeSkipping:   true,
+		AutoSkipIdleDuration: time.Second,
+	})
+	s.NoError(err)
+	defer server.Stop()
+
+	service, err := server.ServiceClient()
+	s.NoError(err)
+	w := worker.New(service, server.Domain(), testServerTaskList, worker.Options{})
+	s.NoError(w.Start())
+	defer w.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	start := server.Now()
+	c := client.NewClient(service, server.Domain(), &client.Options{})
+	run, err := c.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		TaskList:                        testServerTaskList,
+		ExecutionStartToCloseTimeout:    60 * 24 * time.Hour,
+		DecisionTaskStartToCloseTimeout: 10 * time.Second,
+	}, testServerLongTimerWorkflow)
+	s.NoError(err)
+
+	var firedAt time.Time
+	s.NoError(run.Get(ctx, &firedAt))
+	s.True(firedAt.Sub(start) >= 30*24*time.Hour)
+}
+
+func (s *testServerSuite) TestAdvanceTimeDisabled() {
+	_, err := s.server.AdvanceTime(time.Hour)
+	s.Equal(errTimeSkippingDisabled, err)
+
+	_, err = s.server.cadence.GetAdminClient().AdvanceTime(context.Background(), &admin.AdvanceTimeRequest{
+		DurationNanos: common.Int64Ptr(int64(time.Hour)),
+	})
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *testServerSuite) TestAdvanceTimeAdminAPI() {
+	server, err := New(Options{EnableTimeSkipping: true})
+	s.NoError(err)
+	defer server.Stop()
+
+	before := server.Now()
+	resp, err := server.cadence.GetAdminClient().AdvanceTime(context.Background(), &admin.AdvanceTimeRequest{
+		DurationNanos: common.Int64Ptr(int64(time.Hour)),
+	})
+	s.NoError(err)
+	s.True(time.Unix(0, resp.GetTimestamp()).Sub(before) >= time.Hour)
+	s.True(server.Now().Sub(before) >= time.Hour)
+}
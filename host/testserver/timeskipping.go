@@ -0,0 +1,194 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package testserver
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	// idleCheckInterval is how often the cluster is checked for idleness when auto skip is enabled
+	idleCheckInterval = 100 * time.Millisecond
+	// timerSkipPadding moves the clock slightly past a timer, timers are fired strictly before the current time
+	timerSkipPadding = time.Millisecond
+)
+
+var (
+	errTimeSkippingDisabled = errors.New("time skipping is not enabled on the test server")
+	maxTimerTimestamp       = time.Unix(0, 0).Add(1<<63 - 1)
+)
+
+type (
+	// activityTracker records the last (wall clock) time the cluster made progress,
+	// which is approximated by writes to workflow executions and task lists
+	activityTracker struct {
+		sync.Mutex
+		lastActivity time.Time
+	}
+
+	trackingExecutionManagerFactory struct {
+		persistence.ExecutionManagerFactory
+		tracker *activityTracker
+	}
+
+	trackingExecutionManager struct {
+		persistence.ExecutionManager
+		tracker *activityTracker
+	}
+
+	trackingTaskManager struct {
+		persistence.TaskManager
+		tracker *activityTracker
+	}
+)
+
+func newActivityTracker() *activityTracker {
+	return &activityTracker{lastActivity: time.Now()}
+}
+
+func (t *activityTracker) touch() {
+	t.Lock()
+	defer t.Unlock()
+	t.lastActivity = time.Now()
+}
+
+func (t *activityTracker) idleFor(d time.Duration) bool {
+	t.Lock()
+	defer t.Unlock()
+	return time.Since(t.lastActivity) >= d
+}
+
+func (f *trackingExecutionManagerFactory) NewExecutionManager(shardID int) (persistence.ExecutionManager, error) {
+	mgr, err := f.ExecutionManagerFactory.NewExecutionManager(shardID)
+	if err != nil {
+		return nil, err
+	}
+	return &trackingExecutionManager{ExecutionManager: mgr, tracker: f.tracker}, nil
+}
+
+func (m *trackingExecutionManager) CreateWorkflowExecution(request *persistence.CreateWorkflowExecutionRequest) (*persistence.CreateWorkflowExecutionResponse, error) {
+	m.tracker.touch()
+	return m.ExecutionManager.CreateWorkflowExecution(request)
+}
+
+func (m *trackingExecutionManager) UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+	m.tracker.touch()
+	return m.ExecutionManager.UpdateWorkflowExecution(request)
+}
+
+func (m *trackingExecutionManager) ResetMutableState(request *persistence.ResetMutableStateRequest) error {
+	m.tracker.touch()
+	return m.ExecutionManager.ResetMutableState(request)
+}
+
+func (m *trackingExecutionManager) ResetWorkflowExecution(request *persistence.ResetWorkflowExecutionRequest) error {
+	m.tracker.touch()
+	return m.ExecutionManager.ResetWorkflowExecution(request)
+}
+
+func (m *trackingExecutionManager) CompleteTransferTask(request *persistence.CompleteTransferTaskRequest) error {
+	m.tracker.touch()
+	return m.ExecutionManager.CompleteTransferTask(request)
+}
+
+func (m *trackingExecutionManager) CompleteTimerTask(request *persistence.CompleteTimerTaskRequest) error {
+	m.tracker.touch()
+	return m.ExecutionManager.CompleteTimerTask(request)
+}
+
+func (m *trackingTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*persistence.CreateTasksResponse, error) {
+	m.tracker.touch()
+	return m.TaskManager.CreateTasks(request)
+}
+
+func (m *trackingTaskManager) CompleteTask(request *persistence.CompleteTaskRequest) error {
+	m.tracker.touch()
+	return m.TaskManager.CompleteTask(request)
+}
+
+// Now returns the current time of the cluster, which is ahead of the wall clock
+// by the total duration skipped so far
+func (s *TestServer) Now() time.Time {
+	if s.timeSource == nil {
+		return time.Now()
+	}
+	return s.timeSource.Now()
+}
+
+// AdvanceTime moves the clock of the cluster forward by the given duration, firing
+// all timers which became due, and returns the new cluster time
+func (s *TestServer) AdvanceTime(d time.Duration) (time.Time, error) {
+	if s.timeSource == nil {
+		return time.Time{}, errTimeSkippingDisabled
+	}
+	return s.timeSource.Advance(d), nil
+}
+
+func (s *TestServer) autoSkipLoop(idleDuration time.Duration) {
+	defer s.shutdownWG.Done()
+
+	ticker := time.NewTicker(idleCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.shutdownCh:
+			return
+		case <-ticker.C:
+			if s.tracker.idleFor(idleDuration) {
+				s.skipToNextTimer()
+			}
+		}
+	}
+}
+
+// skipToNextTimer advances the clock to the earliest pending timer across all shards,
+// nothing is done if there is no timer or if a timer is already due
+func (s *TestServer) skipToNextTimer() {
+	var next time.Time
+	for _, mgr := range s.executionMgrs {
+		response, err := mgr.GetTimerIndexTasks(&persistence.GetTimerIndexTasksRequest{
+			MinTimestamp: time.Unix(0, 0),
+			MaxTimestamp: maxTimerTimestamp,
+			BatchSize:    1,
+		})
+		if err != nil {
+			s.logger.Warnf("Test server failed to read timers: %v", err)
+			return
+		}
+		for _, timer := range response.Timers {
+			if next.IsZero() || timer.VisibilityTimestamp.Before(next) {
+				next = timer.VisibilityTimestamp
+			}
+		}
+	}
+
+	if next.IsZero() || !next.After(s.timeSource.Now()) {
+		return
+	}
+	now := s.timeSource.AdvanceTo(next.Add(timerSkipPadding))
+	s.logger.Debugf("Test server skipped time to %v", now)
+	// give the cluster the chance to process the timer before skipping again
+	s.tracker.touch()
+}
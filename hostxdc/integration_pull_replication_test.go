@@ -0,0 +1,217 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// +build !race
+// need to run xdc tests with race detector off because of ringpop bug causing data race issue
+
+package hostxdc
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/replicator"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/persistence"
+	persistencetests "github.com/uber/cadence/common/persistence/persistence-tests"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/host"
+	workerReplicator "github.com/uber/cadence/service/worker/replicator"
+)
+
+type (
+	// integrationPullReplicationTestSuite runs two clusters without Kafka, the standby cluster
+	// pulls replication tasks from the active cluster over RPC
+	integrationPullReplicationTestSuite struct {
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		suite.Suite
+		cluster1 *testCluster
+		cluster2 *testCluster
+		logger   bark.Logger
+	}
+)
+
+func TestIntegrationPullReplicationTestSuite(t *testing.T) {
+	if *integration && !*testEventsV2Xdc {
+		s := new(integrationPullReplicationTestSuite)
+		suite.Run(t, s)
+	} else {
+		t.Skip()
+	}
+}
+
+func (s *integrationPullReplicationTestSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+
+	logger := log.New()
+	formatter := &log.TextFormatter{}
+	formatter.FullTimestamp = true
+	logger.Formatter = formatter
+	s.logger = bark.NewLoggerFromLogrus(logger)
+
+	s.cluster1 = s.newPullTestCluster(0)
+	s.cluster2 = s.newPullTestCluster(1)
+}
+
+func (s *integrationPullReplicationTestSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+func (s *integrationPullReplicationTestSuite) TearDownSuite() {
+	s.cluster1.tearDownCluster()
+	s.cluster2.tearDownCluster()
+}
+
+func (s *integrationPullReplicationTestSuite) newPullTestCluster(no int) *testCluster {
+	c := &testCluster{logger: s.logger.WithField("Cluster", clusterName[no])}
+	options := persistencetests.TestBaseOptions{}
+	options.DBName = "integration_pull_" + clusterName[no]
+	clusterInfo := clustersInfo[no]
+	options.ClusterMetadata = cluster.NewMetadata(
+		dynamicconfig.GetBoolPropertyFn(clusterInfo.EnableGlobalDomain),
+		clusterInfo.FailoverVersionIncrement,
+		clusterInfo.MasterClusterName,
+		clusterInfo.CurrentClusterName,
+		clusterInfo.ClusterInitialFailoverVersions,
+		clusterInfo.ClusterAddress,
+		dynamicconfig.GetBoolPropertyFn(false),
+		"",
+	)
+	c.TestBase = persistencetests.NewTestBaseWithCassandra(&options)
+	c.TestBase.Setup()
+	c.setupShards()
+	// no messaging client and no worker, history falls back to pulling replication tasks
+	c.host = host.NewCadence(c.ClusterMetadata, client.NewIPYarpcDispatcherProvider(), nil, c.MetadataProxy, c.MetadataManagerV2, c.ShardMgr, c.HistoryMgr, c.HistoryV2Mgr, c.ExecutionMgrFactory, c.TaskMgr,
+		c.VisibilityMgr, testNumberOfHistoryShards, testNumberOfHistoryHosts, c.logger, no, false, false, false, nil, nil)
+	c.host.Start()
+	return c
+}
+
+func (s *integrationPullReplicationTestSuite) TestSimpleWorkflowReplication() {
+	domainName := "test-pull-replication-" + common.GenerateRandomString(5)
+	client1 := s.cluster1.host.GetFrontendClient() // active
+	client2 := s.cluster2.host.GetFrontendClient() // standby
+	regReq := &workflow.RegisterDomainRequest{
+		Name:                                   common.StringPtr(domainName),
+		Clusters:                               clusterReplicationConfig,
+		ActiveClusterName:                      common.StringPtr(clusterName[0]),
+		WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(1),
+	}
+	err := client1.RegisterDomain(createContext(), regReq)
+	s.NoError(err)
+
+	descReq := &workflow.DescribeDomainRequest{
+		Name: common.StringPtr(domainName),
+	}
+	resp, err := client1.DescribeDomain(createContext(), descReq)
+	s.NoError(err)
+	domain, err := s.cluster1.MetadataManagerV2.GetDomain(&persistence.GetDomainRequest{Name: domainName})
+	s.NoError(err)
+
+	// domain replication still goes through Kafka, so apply the domain replication task to the standby directly
+	domainReplicator := workerReplicator.NewDomainReplicator(s.cluster2.MetadataManagerV2, s.logger)
+	err = domainReplicator.HandleReceivingTask(&replicator.DomainTaskAttributes{
+		DomainOperation:   replicator.DomainOperationCreate.Ptr(),
+		ID:                common.StringPtr(domain.Info.ID),
+		Info:              resp.DomainInfo,
+		Config:            resp.Configuration,
+		ReplicationConfig: resp.ReplicationConfiguration,
+		ConfigVersion:     common.Int64Ptr(domain.ConfigVersion),
+		FailoverVersion:   resp.FailoverVersion,
+	})
+	s.NoError(err)
+	// Wait for domain cache to pick the change
+	time.Sleep(cacheRefreshInterval)
+
+	id := "integration-pull-replication-test"
+	startReq := &workflow.StartWorkflowExecutionRequest{
+		RequestId:                           common.StringPtr(uuid.New()),
+		Domain:                              common.StringPtr(domainName),
+		WorkflowId:                          common.StringPtr(id),
+		WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("integration-pull-replication-test-type")},
+		TaskList:                            &workflow.TaskList{Name: common.StringPtr("integration-pull-replication-test-tasklist")},
+		Input:                               nil,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+		Identity:                            common.StringPtr("worker1"),
+	}
+	we, err := client1.StartWorkflowExecution(createContext(), startReq)
+	s.NoError(err)
+	s.NotNil(we.GetRunId())
+
+	terminateReason := "terminate reason."
+	err = client1.TerminateWorkflowExecution(createContext(), &workflow.TerminateWorkflowExecutionRequest{
+		Domain: common.StringPtr(domainName),
+		WorkflowExecution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(id),
+			RunId:      we.RunId,
+		},
+		Reason:   common.StringPtr(terminateReason),
+		Identity: common.StringPtr("worker1"),
+	})
+	s.NoError(err)
+
+	// check the history got replicated to the standby cluster
+	getHistoryReq := &workflow.GetWorkflowExecutionHistoryRequest{
+		Domain: common.StringPtr(domainName),
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(id),
+			RunId:      we.RunId,
+		},
+	}
+	eventsReplicated := false
+GetHistoryLoop:
+	for i := 0; i < 30; i++ {
+		historyResponse, err := client2.GetWorkflowExecutionHistory(createContext(), getHistoryReq)
+		if err != nil {
+			s.logger.Warnf("History not replicated yet: %v", err)
+			time.Sleep(200 * time.Millisecond)
+			continue GetHistoryLoop
+		}
+
+		history := historyResponse.History
+		lastEvent := history.Events[len(history.Events)-1]
+		if lastEvent.GetEventType() != workflow.EventTypeWorkflowExecutionTerminated {
+			s.logger.Warn("Execution not terminated yet in standby cluster.")
+			time.Sleep(200 * time.Millisecond)
+			continue GetHistoryLoop
+		}
+
+		s.Equal(workflow.EventTypeWorkflowExecutionStarted, history.Events[0].GetEventType())
+		s.Equal(terminateReason, lastEvent.WorkflowExecutionTerminatedEventAttributes.GetReason())
+		eventsReplicated = true
+		break GetHistoryLoop
+	}
+	s.True(eventsReplicated)
+}
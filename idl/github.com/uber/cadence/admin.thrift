@@ -21,6 +21,7 @@
 namespace java com.uber.cadence.admin
 
 include "shared.thrift"
+include "replicator.thrift"
 
 /**
 * AdminService provides advanced APIs for debugging and analysis with admin privillege
@@ -58,6 +59,147 @@ service AdminService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
     )
+
+  /**
+  * GetReplicationMessages returns the replication tasks of the requested shards, starting after the last
+  * message each shard has processed. It is used by remote clusters to pull replication tasks without Kafka.
+  **/
+  replicator.GetReplicationMessagesResponse GetReplicationMessages(1: replicator.GetReplicationMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.LimitExceededError limitExceededError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * DescribeReplicationStatus returns the replication ack levels of the requested shards, compared with
+  * the max replication task ID of each shard, and the number of replication tasks still pending per domain.
+  **/
+  replicator.DescribeReplicationStatusResponse DescribeReplicationStatus(1: replicator.DescribeReplicationStatusRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.LimitExceededError limitExceededError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * AddCluster persists a new remote cluster in the metadata store. The cluster is picked up at runtime by
+  * every host of the current cluster, without changing the static config.
+  **/
+  void AddCluster(1: AddClusterRequest addRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * UpdateCluster updates the address of a cluster, or enables / disables a remote cluster. The change is
+  * persisted in the metadata store and picked up at runtime by every host of the current cluster.
+  **/
+  void UpdateCluster(1: UpdateClusterRequest updateRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ListClusters returns all clusters known to the current cluster, from both the static config and the
+  * metadata store.
+  **/
+  ListClustersResponse ListClusters(1: ListClustersRequest listRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ApplyReplicationTask applies a history replication task, e.g. one read back from the replication DLQ,
+  * to the current cluster through the history ReplicateEvents API.
+  **/
+  void ApplyReplicationTask(1: ApplyReplicationTaskRequest applyRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.RetryTaskError retryTaskError,
+    )
+
+  /**
+  * ListConflictResolutions returns the audit trail of the conflict resolutions which reset the mutable state
+  * of a workflow, because its history diverged between clusters. Latest resolutions are returned first.
+  **/
+  ListConflictResolutionsResponse ListConflictResolutions(1: ListConflictResolutionsRequest listRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * UpdateTaskListDispatchRate sets the dispatch rate of a task list, which is persisted and takes precedence
+  * over the rate pollers ask for, e.g. to protect a downstream dependency during an incident. Leaving the
+  * rate unset clears it.
+  **/
+  void UpdateTaskListDispatchRate(1: shared.UpdateTaskListDispatchRateRequest updateRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * DescribeTaskListDispatchRate returns the dispatch rate set on a task list with UpdateTaskListDispatchRate.
+  **/
+  shared.DescribeTaskListDispatchRateResponse DescribeTaskListDispatchRate(1: shared.DescribeTaskListDispatchRateRequest describeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * PauseTaskList stops dispatching the tasks of a task list, e.g. during an outage of a downstream dependency.
+  * The task list keeps accepting and persisting tasks, and its polls return empty until it is resumed. The
+  * pause is persisted, so it survives the task list moving to another host.
+  **/
+  void PauseTaskList(1: shared.PauseTaskListRequest pauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ResumeTaskList resumes dispatching the tasks of a task list paused with PauseTaskList.
+  **/
+  void ResumeTaskList(1: shared.ResumeTaskListRequest resumeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * AdvanceTime moves the clock of the cluster forward, firing the timers which became due. It is only
+  * available on test clusters which run all services in process with a controlled clock, e.g. the test server.
+  **/
+  AdvanceTimeResponse AdvanceTime(1: AdvanceTimeRequest advanceRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+    )
 }
 
 struct DescribeWorkflowExecutionRequest {
@@ -86,4 +228,75 @@ struct GetWorkflowExecutionRawHistoryResponse {
   20: optional list<shared.DataBlob> historyBatches
   30: optional map<string, shared.ReplicationInfo> replicationInfo
   40: optional i32 eventStoreVersion
-}
\ No newline at end of file
+}
+
+struct ClusterMetadata {
+  10: optional string clusterName
+  20: optional i64 initialFailoverVersion
+  30: optional string rpcName
+  40: optional string rpcAddress
+  50: optional bool enabled
+}
+
+struct AddClusterRequest {
+  10: optional string clusterName
+  20: optional i64 initialFailoverVersion
+  30: optional string rpcName
+  40: optional string rpcAddress
+}
+
+struct UpdateClusterRequest {
+  10: optional string clusterName
+  20: optional string rpcName
+  30: optional string rpcAddress
+  40: optional bool enabled
+}
+
+struct ListClustersRequest {
+}
+
+struct ListClustersResponse {
+  10: optional list<ClusterMetadata> clusters
+}
+
+struct ApplyReplicationTaskRequest {
+  10: optional string sourceCluster
+  20: optional replicator.ReplicationTask replicationTask
+  30: optional bool forceBufferEvents
+}
+
+struct ConflictResolutionAudit {
+  10: optional string auditId
+  20: optional shared.WorkflowExecution execution
+  30: optional string sourceCluster
+  40: optional i64 (js.type = "Long") resolvedTimestamp
+  50: optional string reason
+  60: optional i64 (js.type = "Long") resetEventId
+  70: optional i64 (js.type = "Long") discardedNextEventId
+  80: optional i64 (js.type = "Long") discardedStartVersion
+  90: optional i64 (js.type = "Long") discardedEndVersion
+  100: optional i64 (js.type = "Long") adoptedFirstEventId
+  110: optional i64 (js.type = "Long") adoptedNextEventId
+  120: optional i64 (js.type = "Long") adoptedStartVersion
+  130: optional i64 (js.type = "Long") adoptedEndVersion
+}
+
+struct ListConflictResolutionsRequest {
+  10: optional string domain
+  20: optional shared.WorkflowExecution execution
+  30: optional i32 maximumPageSize
+  40: optional binary nextPageToken
+}
+
+struct ListConflictResolutionsResponse {
+  10: optional list<ConflictResolutionAudit> conflictResolutions
+  20: optional binary nextPageToken
+}
+
+struct AdvanceTimeRequest {
+  10: optional i64 (js.type = "Long") durationNanos
+}
+
+struct AdvanceTimeResponse {
+  10: optional i64 (js.type = "Long") timestamp
+}
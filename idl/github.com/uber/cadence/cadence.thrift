@@ -500,4 +500,17 @@ service WorkflowService {
       5: shared.ServiceBusyError serviceBusyError,
     )
 
+  /**
+  * ListTaskLists returns a page of the task lists of a domain along with their kind, ack level and the
+  * time they were last updated.
+  **/
+  shared.ListTaskListsResponse ListTaskLists(1: shared.ListTaskListsRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.LimitExceededError limitExceededError,
+      5: shared.ServiceBusyError serviceBusyError,
+    )
+
 }
@@ -19,6 +19,7 @@
 // THE SOFTWARE.
 
 include "shared.thrift"
+include "replicator.thrift"
 
 namespace java com.uber.cadence.history
 
@@ -685,4 +686,30 @@ service HistoryService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.AccessDeniedError accessDeniedError,
     )
+
+  /**
+  * GetReplicationMessages returns the replication tasks of the requested shards owned by this host, starting
+  * after the last message each shard has processed.
+  **/
+  replicator.GetReplicationMessagesResponse GetReplicationMessages(1: replicator.GetReplicationMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.LimitExceededError limitExceededError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * DescribeReplicationStatus returns the replication ack levels of the requested shards owned by this host, compared with
+  * the max replication task ID of each shard, and the number of replication tasks still pending per domain.
+  **/
+  replicator.DescribeReplicationStatusResponse DescribeReplicationStatus(1: replicator.DescribeReplicationStatusRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.LimitExceededError limitExceededError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: ShardOwnershipLostError shardOwnershipLostError,
+    )
 }
@@ -26,6 +26,7 @@ struct PollForDecisionTaskRequest {
   10: optional string domainUUID
   15: optional string pollerID
   20: optional shared.PollForDecisionTaskRequest pollRequest
+  30: optional string forwardedFrom
 }
 
 struct PollForDecisionTaskResponse {
@@ -49,6 +50,7 @@ struct PollForActivityTaskRequest {
   10: optional string domainUUID
   15: optional string pollerID
   20: optional shared.PollForActivityTaskRequest pollRequest
+  30: optional string forwardedFrom
 }
 
 struct AddDecisionTaskRequest {
@@ -57,6 +59,8 @@ struct AddDecisionTaskRequest {
   30: optional shared.TaskList taskList
   40: optional i64 (js.type = "Long") scheduleId
   50: optional i32 scheduleToStartTimeoutSeconds
+  60: optional string forwardedFrom
+  70: optional i32 priority
 }
 
 struct AddActivityTaskRequest {
@@ -66,6 +70,8 @@ struct AddActivityTaskRequest {
   40: optional shared.TaskList taskList
   50: optional i64 (js.type = "Long") scheduleId
   60: optional i32 scheduleToStartTimeoutSeconds
+  70: optional string forwardedFrom
+  80: optional i32 priority
 }
 
 struct QueryWorkflowRequest {
@@ -93,6 +99,31 @@ struct DescribeTaskListRequest {
   20: optional shared.DescribeTaskListRequest descRequest
 }
 
+struct UpdateTaskListDispatchRateRequest {
+  10: optional string domainUUID
+  20: optional shared.UpdateTaskListDispatchRateRequest updateRequest
+}
+
+struct DescribeTaskListDispatchRateRequest {
+  10: optional string domainUUID
+  20: optional shared.DescribeTaskListDispatchRateRequest descRequest
+}
+
+struct PauseTaskListRequest {
+  10: optional string domainUUID
+  20: optional shared.PauseTaskListRequest pauseRequest
+}
+
+struct ResumeTaskListRequest {
+  10: optional string domainUUID
+  20: optional shared.ResumeTaskListRequest resumeRequest
+}
+
+struct ListTaskListsRequest {
+  10: optional string domainUUID
+  20: optional shared.ListTaskListsRequest listRequest
+}
+
 /**
 * MatchingService API is exposed to provide support for polling from long running applications.
 * Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each
@@ -203,4 +234,56 @@ service MatchingService {
         3: shared.EntityNotExistsError entityNotExistError,
         4: shared.ServiceBusyError serviceBusyError,
       )
+
+  /**
+  * UpdateTaskListDispatchRate persists the dispatch rate of a task list set by an operator, which takes
+  * precedence over the rate pollers ask for. Leaving the rate unset clears it.
+  **/
+  void UpdateTaskListDispatchRate(1: UpdateTaskListDispatchRateRequest request)
+    throws (
+        1: shared.BadRequestError badRequestError,
+        2: shared.InternalServiceError internalServiceError,
+        3: shared.ServiceBusyError serviceBusyError,
+      )
+
+  /**
+  * DescribeTaskListDispatchRate returns the dispatch rate set by an operator on a task list.
+  **/
+  shared.DescribeTaskListDispatchRateResponse DescribeTaskListDispatchRate(1: DescribeTaskListDispatchRateRequest request)
+    throws (
+        1: shared.BadRequestError badRequestError,
+        2: shared.InternalServiceError internalServiceError,
+        3: shared.ServiceBusyError serviceBusyError,
+      )
+
+  /**
+  * PauseTaskList persists that a task list is paused. A paused task list keeps accepting and persisting tasks,
+  * but returns empty polls until it is resumed.
+  **/
+  void PauseTaskList(1: PauseTaskListRequest request)
+    throws (
+        1: shared.BadRequestError badRequestError,
+        2: shared.InternalServiceError internalServiceError,
+        3: shared.ServiceBusyError serviceBusyError,
+      )
+
+  /**
+  * ResumeTaskList resumes dispatching the tasks of a task list paused with PauseTaskList.
+  **/
+  void ResumeTaskList(1: ResumeTaskListRequest request)
+    throws (
+        1: shared.BadRequestError badRequestError,
+        2: shared.InternalServiceError internalServiceError,
+        3: shared.ServiceBusyError serviceBusyError,
+      )
+
+  /**
+  * ListTaskLists returns a page of the task lists persisted for a domain.
+  **/
+  shared.ListTaskListsResponse ListTaskLists(1: ListTaskListsRequest request)
+    throws (
+        1: shared.BadRequestError badRequestError,
+        2: shared.InternalServiceError internalServiceError,
+        3: shared.ServiceBusyError serviceBusyError,
+      )
 }
@@ -21,7 +21,6 @@
 namespace java com.uber.cadence.replicator
 
 include "shared.thrift"
-include "history.thrift"
 
 enum ReplicationTaskType {
   Domain
@@ -87,5 +86,64 @@ struct ReplicationTask {
   30: optional HistoryTaskAttributes historyTaskAttributes
   40: optional SyncShardStatusTaskAttributes syncShardStatusTaskAttributes
   50: optional SyncActicvityTaskAttributes syncActicvityTaskAttributes
+  60: optional i64 (js.type = "Long") sourceTaskId
 }
 
+struct ReplicationToken {
+  10: optional i32 shardID
+  20: optional i64 (js.type = "Long") lastProcessedMessageId
+}
+
+struct ReplicationMessages {
+  10: optional list<ReplicationTask> replicationTasks
+  20: optional i64 (js.type = "Long") lastRetrievedMessageId
+  30: optional bool hasMore
+  40: optional SyncShardStatusTaskAttributes syncShardStatus
+}
+
+struct GetReplicationMessagesRequest {
+  10: optional list<ReplicationToken> tokens
+  20: optional string clusterName
+}
+
+struct GetReplicationMessagesResponse {
+  10: optional map<i32, ReplicationMessages> messagesByShard
+}
+
+
+struct ShardReplicationStatus {
+  10: optional i32 shardID
+  20: optional i64 (js.type = "Long") maxReplicationTaskId
+  30: optional i64 (js.type = "Long") replicatorAckLevel
+  40: optional map<string, i64> remoteClusterAckLevels
+  50: optional map<string, i64> sourceClusterReplicationLevels
+  60: optional map<string, i64> remoteClusterShardTimes
+  70: optional map<string, i64> pendingTasksByDomainId
+  80: optional bool pendingTasksTruncated
+  90: optional map<string, i64> heldTasksByDomainId
+}
+
+struct ReplicationControlStatus {
+  10: optional string cluster
+  20: optional bool outboundPaused
+  30: optional i32 outboundMaxRPS
+  40: optional bool inboundPaused
+  50: optional i32 inboundMaxRPS
+}
+
+struct DomainReplicationStatus {
+  10: optional string domain
+  20: optional i64 (js.type = "Long") pendingReplicationTasks
+  30: optional i64 (js.type = "Long") heldReplicationTasks
+  40: optional list<ReplicationControlStatus> controls
+}
+
+struct DescribeReplicationStatusRequest {
+  10: optional list<i32> shardIDs
+  20: optional string domain
+}
+
+struct DescribeReplicationStatusResponse {
+  10: optional list<ShardReplicationStatus> shards
+  20: optional list<DomainReplicationStatus> domains
+}
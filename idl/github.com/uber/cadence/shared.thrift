@@ -320,6 +320,8 @@ struct ScheduleActivityTaskDecisionAttributes {
   55: optional i32 startToCloseTimeoutSeconds
   60: optional i32 heartbeatTimeoutSeconds
   70: optional RetryPolicy retryPolicy
+  // tasks of higher priority are dispatched first within a task list, 0 (the default) is the lowest
+  80: optional i32 priority
 }
 
 struct RequestCancelActivityTaskDecisionAttributes {
@@ -438,6 +440,7 @@ struct WorkflowExecutionStartedEventAttributes {
   90: optional i64 (js.type = "Long") expirationTimestamp
   100: optional string cronSchedule
   110: optional i32 firstDecisionTaskBackoffSeconds
+  120: optional i32 priority
 }
 
 struct WorkflowExecutionCompletedEventAttributes {
@@ -526,6 +529,7 @@ struct ActivityTaskScheduledEventAttributes {
   60: optional i32 heartbeatTimeoutSeconds
   90: optional i64 (js.type = "Long") decisionTaskCompletedEventId
   110: optional RetryPolicy retryPolicy
+  120: optional i32 priority
 }
 
 struct ActivityTaskStartedEventAttributes {
@@ -887,12 +891,20 @@ struct DescribeDomainRequest {
   10: optional string name
 }
 
+struct DomainFailoverInfo {
+  10: optional string pendingActiveClusterName
+  20: optional i64 (js.type = "Long") startTimestamp
+  30: optional i64 (js.type = "Long") expireTimestamp
+  40: optional i64 (js.type = "Long") pendingReplicationTasks
+}
+
 struct DescribeDomainResponse {
   10: optional DomainInfo domainInfo
   20: optional DomainConfiguration configuration
   30: optional DomainReplicationConfiguration replicationConfiguration
   40: optional i64 (js.type = "Long") failoverVersion
   50: optional bool isGlobalDomain
+  60: optional DomainFailoverInfo failoverInfo
 }
 
 struct UpdateDomainRequest {
@@ -901,6 +913,10 @@ struct UpdateDomainRequest {
  30: optional DomainConfiguration configuration
  40: optional DomainReplicationConfiguration replicationConfiguration
  50: optional string securityToken
+ // when set together with a new active cluster, the domain is failed over gracefully: the current active
+ // cluster stops accepting new workflows and decisions, waits for replication to catch up and then hands over,
+ // falling back to a forced failover once the timeout expires
+ 60: optional i32 failoverTimeoutInSeconds
 }
 
 struct UpdateDomainResponse {
@@ -909,6 +925,7 @@ struct UpdateDomainResponse {
   30: optional DomainReplicationConfiguration replicationConfiguration
   40: optional i64 (js.type = "Long") failoverVersion
   50: optional bool isGlobalDomain
+  60: optional DomainFailoverInfo failoverInfo
 }
 
 struct DeprecateDomainRequest {
@@ -930,16 +947,25 @@ struct StartWorkflowExecutionRequest {
   110: optional ChildPolicy childPolicy
   120: optional RetryPolicy retryPolicy
   130: optional string cronSchedule
+  // priority of the decision tasks of the workflow, see ScheduleActivityTaskDecisionAttributes.priority
+  140: optional i32 priority
 }
 
 struct StartWorkflowExecutionResponse {
   10: optional string runId
 }
 
+struct PollerMetadata {
+  10: optional string binaryChecksum
+  20: optional string sdkVersion
+  30: optional string hostname
+}
+
 struct PollForDecisionTaskRequest {
   10: optional string domain
   20: optional TaskList taskList
   30: optional string identity
+  40: optional PollerMetadata pollerMetadata
 }
 
 struct PollForDecisionTaskResponse {
@@ -988,6 +1014,7 @@ struct PollForActivityTaskRequest {
   20: optional TaskList taskList
   30: optional string identity
   40: optional TaskListMetadata taskListMetadata
+  50: optional PollerMetadata pollerMetadata
 }
 
 struct PollForActivityTaskResponse {
@@ -1121,6 +1148,7 @@ struct SignalWithStartWorkflowExecutionRequest {
   130: optional binary control
   140: optional RetryPolicy retryPolicy
   150: optional string cronSchedule
+  160: optional i32 priority
 }
 
 struct TerminateWorkflowExecutionRequest {
@@ -1217,6 +1245,7 @@ struct PendingActivityInfo {
   50: optional i64 (js.type = "Long") lastHeartbeatTimestamp
   60: optional i64 (js.type = "Long") lastStartedTimestamp
   70: optional i32 attempt
+  80: optional i64 (js.type = "Long") scheduleID
 }
 
 struct DescribeWorkflowExecutionResponse {
@@ -1233,6 +1262,58 @@ struct DescribeTaskListRequest {
 
 struct DescribeTaskListResponse {
   10: optional list<PollerInfo> pollers
+  20: optional bool paused
+}
+
+struct UpdateTaskListDispatchRateRequest {
+  10: optional string domain
+  20: optional TaskList taskList
+  30: optional TaskListType taskListType
+  // the rate takes precedence over the maxTasksPerSecond of pollers, leave it unset to clear it
+  40: optional double dispatchRatePerSecond
+}
+
+struct DescribeTaskListDispatchRateRequest {
+  10: optional string domain
+  20: optional TaskList taskList
+  30: optional TaskListType taskListType
+}
+
+struct DescribeTaskListDispatchRateResponse {
+  // unset when no dispatch rate is set on the task list
+  10: optional double dispatchRatePerSecond
+}
+
+struct PauseTaskListRequest {
+  10: optional string domain
+  20: optional TaskList taskList
+  30: optional TaskListType taskListType
+}
+
+struct ResumeTaskListRequest {
+  10: optional string domain
+  20: optional TaskList taskList
+  30: optional TaskListType taskListType
+}
+
+struct ListTaskListsRequest {
+  10: optional string domain
+  20: optional i32 pageSize
+  30: optional binary nextPageToken
+}
+
+struct ListTaskListsResponse {
+  10: optional list<TaskListInfo> taskLists
+  20: optional binary nextPageToken
+}
+
+struct TaskListInfo {
+  10: optional TaskList taskList
+  20: optional TaskListType taskListType
+  30: optional TaskListKind kind
+  40: optional i64 (js.type = "Long") ackLevel
+  // Unix Nano
+  50: optional i64 (js.type = "Long") lastUpdatedTime
 }
 
 //At least one of the parameters needs to be provided
@@ -1270,6 +1351,10 @@ struct PollerInfo {
   // Unix Nano
   10: optional i64 (js.type = "Long")  lastAccessTime
   20: optional string identity
+  30: optional double ratePerSecond
+  40: optional string binaryChecksum
+  50: optional string sdkVersion
+  60: optional string hostname
 }
 
 struct RetryPolicy {
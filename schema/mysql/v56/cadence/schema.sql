@@ -19,7 +19,11 @@ CREATE TABLE domains(
   is_global_domain TINYINT(1) NOT NULL,
 /* domain_replication_config */
   active_cluster_name VARCHAR(255) NOT NULL,
-  clusters BLOB
+  clusters BLOB,
+  pending_active_cluster_name VARCHAR(255) NOT NULL,
+  failover_start_time BIGINT NOT NULL,
+  failover_expire_time BIGINT NOT NULL,
+  failover_markers BLOB
 /* end domain_replication_config */
 ) DEFAULT CHARACTER SET utf8 COLLATE utf8_unicode_ci;
 
@@ -29,6 +33,34 @@ CREATE TABLE domain_metadata (
 
 INSERT INTO domain_metadata (notification_version) VALUES (0);
 
+CREATE TABLE cluster_metadata (
+  cluster_name VARCHAR(255) NOT NULL,
+  initial_failover_version BIGINT NOT NULL,
+  rpc_name VARCHAR(255) NOT NULL,
+  rpc_address VARCHAR(255) NOT NULL,
+  enabled TINYINT(1) NOT NULL,
+  PRIMARY KEY (cluster_name)
+);
+
+CREATE TABLE conflict_resolution_audits (
+  domain_id CHAR(64) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  resolved_time DATETIME(6) NOT NULL,
+  audit_id CHAR(64) NOT NULL,
+  run_id CHAR(64) NOT NULL,
+  source_cluster VARCHAR(255) NOT NULL,
+  reason VARCHAR(255) NOT NULL,
+  reset_event_id BIGINT NOT NULL,
+  discarded_next_event_id BIGINT NOT NULL,
+  discarded_start_version BIGINT NOT NULL,
+  discarded_end_version BIGINT NOT NULL,
+  adopted_first_event_id BIGINT NOT NULL,
+  adopted_next_event_id BIGINT NOT NULL,
+  adopted_start_version BIGINT NOT NULL,
+  adopted_end_version BIGINT NOT NULL,
+  PRIMARY KEY (domain_id, workflow_id, resolved_time, audit_id)
+);
+
 CREATE TABLE shards (
 	shard_id INT NOT NULL,
 	owner VARCHAR(255) NOT NULL,
@@ -40,6 +72,7 @@ CREATE TABLE shards (
 	timer_ack_level DATETIME(6) NOT NULL,
 	cluster_transfer_ack_level BLOB NOT NULL,
 	cluster_timer_ack_level BLOB NOT NULL,
+	cluster_replication_level BLOB NOT NULL,
 	domain_notification_version BIGINT NOT NULL,
 	PRIMARY KEY (shard_id)
 );
@@ -110,6 +143,7 @@ CREATE TABLE executions(
 	client_impl VARCHAR(255) NOT NULL, -- 5.
 	signal_count INT NOT NULL,
 	cron_schedule VARCHAR(255),
+	priority INT NOT NULL DEFAULT 0, -- priority of the decision tasks
 	-- TODO: fix sql to support workflow retry. https://github.com/uber/cadence/issues/1339
 	PRIMARY KEY (shard_id, domain_id, workflow_id, run_id)
 );
@@ -152,6 +186,8 @@ CREATE TABLE tasks (
   task_list_type TINYINT NOT NULL,
   task_id BIGINT NOT NULL,
   expiry_ts DATETIME(6) NOT NULL,
+  created_ts DATETIME(6), -- NULL if the task was created without a created time
+  priority INT NOT NULL DEFAULT 0, -- tasks of higher priority are dispatched first
   PRIMARY KEY (shard_id, domain_id, task_list_name, task_list_type, task_id)
 );
 
@@ -162,7 +198,11 @@ CREATE TABLE task_lists (
 	task_type TINYINT NOT NULL, -- {Activity, Decision}
 	ack_level BIGINT NOT NULL DEFAULT 0,
 	kind TINYINT NOT NULL, -- {Normal, Sticky}
+	dispatch_rate DOUBLE NOT NULL DEFAULT 0, -- set by an operator, 0 if not set
+	paused TINYINT(1) NOT NULL DEFAULT 0,
 	expiry_ts DATETIME(6) NOT NULL,
+	last_updated DATETIME(6) NOT NULL DEFAULT '1970-01-01 00:00:01.000000',
+	pollers BLOB, -- poller history kept across changes of ownership
 	PRIMARY KEY (domain_id, name, task_type)
 );
 
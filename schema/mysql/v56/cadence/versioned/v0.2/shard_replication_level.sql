@@ -0,0 +1,2 @@
+-- left empty for the existing shards, which are read as having no replication level
+ALTER TABLE shards ADD COLUMN cluster_replication_level BLOB NOT NULL;
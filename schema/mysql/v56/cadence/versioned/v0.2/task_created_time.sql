@@ -0,0 +1,2 @@
+-- NULL for the existing tasks, which are read as having no created time
+ALTER TABLE tasks ADD COLUMN created_ts DATETIME(6);
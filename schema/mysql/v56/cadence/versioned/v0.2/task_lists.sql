@@ -0,0 +1,4 @@
+ALTER TABLE task_lists ADD COLUMN dispatch_rate DOUBLE NOT NULL DEFAULT 0;
+ALTER TABLE task_lists ADD COLUMN paused TINYINT(1) NOT NULL DEFAULT 0;
+ALTER TABLE task_lists ADD COLUMN last_updated DATETIME(6) NOT NULL DEFAULT '1970-01-01 00:00:01.000000';
+ALTER TABLE task_lists ADD COLUMN pollers BLOB;
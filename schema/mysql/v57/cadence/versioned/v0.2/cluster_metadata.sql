@@ -0,0 +1,8 @@
+CREATE TABLE cluster_metadata (
+  cluster_name VARCHAR(255) NOT NULL,
+  initial_failover_version BIGINT NOT NULL,
+  rpc_name VARCHAR(255) NOT NULL,
+  rpc_address VARCHAR(255) NOT NULL,
+  enabled TINYINT(1) NOT NULL,
+  PRIMARY KEY (cluster_name)
+);
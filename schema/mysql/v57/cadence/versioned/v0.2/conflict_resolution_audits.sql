@@ -0,0 +1,18 @@
+CREATE TABLE conflict_resolution_audits (
+  domain_id CHAR(64) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  resolved_time TIMESTAMP(3) NOT NULL,
+  audit_id CHAR(64) NOT NULL,
+  run_id CHAR(64) NOT NULL,
+  source_cluster VARCHAR(255) NOT NULL,
+  reason VARCHAR(255) NOT NULL,
+  reset_event_id BIGINT NOT NULL,
+  discarded_next_event_id BIGINT NOT NULL,
+  discarded_start_version BIGINT NOT NULL,
+  discarded_end_version BIGINT NOT NULL,
+  adopted_first_event_id BIGINT NOT NULL,
+  adopted_next_event_id BIGINT NOT NULL,
+  adopted_start_version BIGINT NOT NULL,
+  adopted_end_version BIGINT NOT NULL,
+  PRIMARY KEY (domain_id, workflow_id, resolved_time, audit_id)
+);
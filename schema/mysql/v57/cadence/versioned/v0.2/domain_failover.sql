@@ -0,0 +1,4 @@
+ALTER TABLE domains ADD COLUMN pending_active_cluster_name VARCHAR(255) NOT NULL;
+ALTER TABLE domains ADD COLUMN failover_start_time BIGINT NOT NULL;
+ALTER TABLE domains ADD COLUMN failover_expire_time BIGINT NOT NULL;
+ALTER TABLE domains ADD COLUMN failover_markers BLOB;
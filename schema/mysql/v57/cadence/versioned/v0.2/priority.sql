@@ -0,0 +1,2 @@
+ALTER TABLE executions ADD COLUMN priority INT NOT NULL DEFAULT 0;
+ALTER TABLE tasks ADD COLUMN priority INT NOT NULL DEFAULT 0;
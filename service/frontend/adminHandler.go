@@ -22,6 +22,8 @@ package frontend
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -34,8 +36,11 @@ import (
 	"github.com/uber/cadence/.gen/go/admin/adminserviceserver"
 	h "github.com/uber/cadence/.gen/go/history"
 	hist "github.com/uber/cadence/.gen/go/history"
+	m "github.com/uber/cadence/.gen/go/matching"
+	"github.com/uber/cadence/.gen/go/replicator"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/logging"
@@ -52,10 +57,13 @@ type (
 	AdminHandler struct {
 		status                int32
 		numberOfHistoryShards int
+		config                *Config
 		service.Service
 		history       history.Client
+		matching      matching.Client
 		domainCache   cache.DomainCache
 		metricsClient metrics.Client
+		metadataMgr   persistence.MetadataManager
 		historyMgr    persistence.HistoryManager
 		historyV2Mgr  persistence.HistoryV2Manager
 		startWG       sync.WaitGroup
@@ -64,13 +72,15 @@ type (
 
 // NewAdminHandler creates a thrift handler for the cadence admin service
 func NewAdminHandler(
-	sVice service.Service, numberOfHistoryShards int, metadataMgr persistence.MetadataManager,
+	sVice service.Service, numberOfHistoryShards int, config *Config, metadataMgr persistence.MetadataManager,
 	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager) *AdminHandler {
 	handler := &AdminHandler{
 		status:                common.DaemonStatusInitialized,
 		numberOfHistoryShards: numberOfHistoryShards,
+		config:                config,
 		Service:               sVice,
 		domainCache:           cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetMetricsClient(), sVice.GetLogger()),
+		metadataMgr:           metadataMgr,
 		historyMgr:            historyMgr,
 		historyV2Mgr:          historyV2Mgr,
 	}
@@ -90,6 +100,12 @@ func (adh *AdminHandler) Start() error {
 	adh.Service.Start()
 
 	adh.history = adh.Service.GetClientBean().GetHistoryClient()
+	matchingRawClient, err := adh.Service.GetClientBean().GetMatchingClient(adh.domainCache.GetDomainName)
+	if err != nil {
+		return err
+	}
+	adh.matching = matching.NewRetryableClient(matchingRawClient, common.CreateMatchingServiceRetryPolicy(),
+		common.IsWhitelistServiceTransientError)
 	adh.metricsClient = adh.Service.GetMetricsClient()
 	adh.startWG.Done()
 	return nil
@@ -322,6 +338,613 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 	return result, nil
 }
 
+// GetReplicationMessages returns new replication tasks for the shards in the request, used by a remote
+// cluster pulling replication tasks from this cluster
+func (adh *AdminHandler) GetReplicationMessages(
+	ctx context.Context, request *replicator.GetReplicationMessagesRequest) (*replicator.GetReplicationMessagesResponse, error) {
+
+	scope := metrics.AdminGetReplicationMessagesScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.ClusterName == nil {
+		return nil, adh.error(errClusterNameNotSet, scope)
+	}
+
+	resp, err := adh.history.GetReplicationMessages(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// DescribeReplicationStatus returns the replication status of the requested shards, or of all shards if none
+// are specified, together with the number of pending and held replication tasks and the replication controls
+// of each domain. When a domain is specified only that domain is reported.
+func (adh *AdminHandler) DescribeReplicationStatus(
+	ctx context.Context, request *replicator.DescribeReplicationStatusRequest) (*replicator.DescribeReplicationStatusResponse, error) {
+
+	scope := metrics.AdminDescribeReplicationStatusScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	var domainEntry *cache.DomainCacheEntry
+	if request.GetDomain() != "" {
+		entry, err := adh.domainCache.GetDomain(request.GetDomain())
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		domainEntry = entry
+	}
+
+	shardIDs := request.ShardIDs
+	if len(shardIDs) == 0 {
+		shardIDs = allHistoryShardIDs(adh.numberOfHistoryShards)
+	}
+
+	resp, err := adh.history.DescribeReplicationStatus(ctx, &replicator.DescribeReplicationStatusRequest{
+		ShardIDs: shardIDs,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	pendingTasks := make(map[string]int64)
+	heldTasks := make(map[string]int64)
+	for _, shard := range resp.Shards {
+		for domainID, count := range shard.PendingTasksByDomainId {
+			pendingTasks[domainID] += count
+		}
+		for domainID, count := range shard.HeldTasksByDomainId {
+			heldTasks[domainID] += count
+		}
+	}
+
+	domainIDs := make(map[string]struct{})
+	if domainEntry != nil {
+		domainIDs[domainEntry.GetInfo().ID] = struct{}{}
+	} else {
+		for domainID := range pendingTasks {
+			domainIDs[domainID] = struct{}{}
+		}
+		for domainID := range heldTasks {
+			domainIDs[domainID] = struct{}{}
+		}
+	}
+
+	resp.Domains = nil
+	for domainID := range domainIDs {
+		status := &replicator.DomainReplicationStatus{
+			Domain:                  common.StringPtr(domainID),
+			PendingReplicationTasks: common.Int64Ptr(pendingTasks[domainID]),
+			HeldReplicationTasks:    common.Int64Ptr(heldTasks[domainID]),
+		}
+		if entry, err := adh.domainCache.GetDomainByID(domainID); err == nil {
+			status.Domain = common.StringPtr(entry.GetInfo().Name)
+			status.Controls = adh.getReplicationControls(entry)
+		}
+		resp.Domains = append(resp.Domains, status)
+	}
+	sort.Slice(resp.Domains, func(i, j int) bool {
+		return resp.Domains[i].GetDomain() < resp.Domains[j].GetDomain()
+	})
+	return resp, nil
+}
+
+// getReplicationControls returns the dynamic config replication controls of the domain for each of its remote clusters
+func (adh *AdminHandler) getReplicationControls(domainEntry *cache.DomainCacheEntry) []*replicator.ReplicationControlStatus {
+	domainName := domainEntry.GetInfo().Name
+	currentCluster := adh.GetClusterMetadata().GetCurrentClusterName()
+
+	var controls []*replicator.ReplicationControlStatus
+	for _, cluster := range domainEntry.GetReplicationConfig().Clusters {
+		if cluster.ClusterName == currentCluster {
+			continue
+		}
+		controls = append(controls, &replicator.ReplicationControlStatus{
+			Cluster:        common.StringPtr(cluster.ClusterName),
+			OutboundPaused: common.BoolPtr(adh.config.ReplicatorDomainPaused(domainName, cluster.ClusterName)),
+			OutboundMaxRPS: common.Int32Ptr(int32(adh.config.ReplicatorDomainMaxRPS(domainName, cluster.ClusterName))),
+			InboundPaused:  common.BoolPtr(adh.config.WorkerReplicationDomainPaused(domainName, cluster.ClusterName)),
+			InboundMaxRPS:  common.Int32Ptr(int32(adh.config.WorkerReplicationDomainMaxRPS(domainName, cluster.ClusterName))),
+		})
+	}
+	return controls
+}
+
+// AddCluster persists a new remote cluster in the metadata store, the cluster is picked up at runtime
+// by every host of the current cluster
+func (adh *AdminHandler) AddCluster(ctx context.Context, request *admin.AddClusterRequest) error {
+
+	scope := metrics.AdminAddClusterScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetClusterName() == "" {
+		return adh.error(errClusterNameNotSet, scope)
+	}
+
+	clusters, err := adh.listClusterMetadata()
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	if _, ok := adh.GetClusterMetadata().GetAllClusterFailoverVersions()[request.GetClusterName()]; ok {
+		return adh.error(&gen.BadRequestError{
+			Message: fmt.Sprintf("Cluster %v already exists.", request.GetClusterName()),
+		}, scope)
+	}
+
+	info := &persistence.ClusterMetadataInfo{
+		ClusterName:            request.GetClusterName(),
+		InitialFailoverVersion: request.GetInitialFailoverVersion(),
+		RPCName:                request.GetRpcName(),
+		RPCAddress:             request.GetRpcAddress(),
+		Enabled:                true,
+	}
+	if err := adh.validateClusters(append(clusters, info)); err != nil {
+		return adh.error(err, scope)
+	}
+	if err := adh.metadataMgr.CreateClusterMetadata(&persistence.CreateClusterMetadataRequest{Info: info}); err != nil {
+		return adh.error(err, scope)
+	}
+	if err := adh.refreshClusters(); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// UpdateCluster updates the address of a cluster, or enables / disables a remote cluster, the change is
+// picked up at runtime by every host of the current cluster
+func (adh *AdminHandler) UpdateCluster(ctx context.Context, request *admin.UpdateClusterRequest) error {
+
+	scope := metrics.AdminUpdateClusterScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetClusterName() == "" {
+		return adh.error(errClusterNameNotSet, scope)
+	}
+
+	clusters, err := adh.listClusterMetadata()
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	var info *persistence.ClusterMetadataInfo
+	persisted := false
+	for _, cluster := range clusters {
+		if cluster.ClusterName == request.GetClusterName() {
+			info = cluster
+			persisted = true
+			break
+		}
+	}
+	if info == nil {
+		// the cluster only exists in the static config so far, it is persisted with this update
+		clusterMetadata := adh.GetClusterMetadata()
+		initialFailoverVersion, ok := clusterMetadata.GetAllClusterFailoverVersions()[request.GetClusterName()]
+		if !ok {
+			return adh.error(&gen.EntityNotExistsError{
+				Message: fmt.Sprintf("Cluster %v does not exist.", request.GetClusterName()),
+			}, scope)
+		}
+		address := clusterMetadata.GetAllClientAddress()[request.GetClusterName()]
+		info = &persistence.ClusterMetadataInfo{
+			ClusterName:            request.GetClusterName(),
+			InitialFailoverVersion: initialFailoverVersion,
+			RPCName:                address.RPCName,
+			RPCAddress:             address.RPCAddress,
+			Enabled:                true,
+		}
+		clusters = append(clusters, info)
+	}
+	if request.RpcName != nil {
+		info.RPCName = request.GetRpcName()
+	}
+	if request.RpcAddress != nil {
+		info.RPCAddress = request.GetRpcAddress()
+	}
+	if request.Enabled != nil {
+		info.Enabled = request.GetEnabled()
+	}
+
+	if err := adh.validateClusters(clusters); err != nil {
+		return adh.error(err, scope)
+	}
+	if persisted {
+		err = adh.metadataMgr.UpdateClusterMetadata(&persistence.UpdateClusterMetadataRequest{Info: info})
+	} else {
+		err = adh.metadataMgr.CreateClusterMetadata(&persistence.CreateClusterMetadataRequest{Info: info})
+	}
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	if err := adh.refreshClusters(); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// ListClusters returns all clusters known to the current cluster, from both the static config and the
+// metadata store
+func (adh *AdminHandler) ListClusters(
+	ctx context.Context, request *admin.ListClustersRequest) (*admin.ListClustersResponse, error) {
+
+	scope := metrics.AdminListClustersScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	clusters, err := adh.listClusterMetadata()
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	persisted := make(map[string]struct{})
+	resp := &admin.ListClustersResponse{}
+	for _, cluster := range clusters {
+		persisted[cluster.ClusterName] = struct{}{}
+		resp.Clusters = append(resp.Clusters, &admin.ClusterMetadata{
+			ClusterName:            common.StringPtr(cluster.ClusterName),
+			InitialFailoverVersion: common.Int64Ptr(cluster.InitialFailoverVersion),
+			RpcName:                common.StringPtr(cluster.RPCName),
+			RpcAddress:             common.StringPtr(cluster.RPCAddress),
+			Enabled:                common.BoolPtr(cluster.Enabled),
+		})
+	}
+	clusterMetadata := adh.GetClusterMetadata()
+	addresses := clusterMetadata.GetAllClientAddress()
+	for clusterName, initialFailoverVersion := range clusterMetadata.GetAllClusterFailoverVersions() {
+		if _, ok := persisted[clusterName]; ok {
+			continue
+		}
+		resp.Clusters = append(resp.Clusters, &admin.ClusterMetadata{
+			ClusterName:            common.StringPtr(clusterName),
+			InitialFailoverVersion: common.Int64Ptr(initialFailoverVersion),
+			RpcName:                common.StringPtr(addresses[clusterName].RPCName),
+			RpcAddress:             common.StringPtr(addresses[clusterName].RPCAddress),
+			Enabled:                common.BoolPtr(true),
+		})
+	}
+	sort.Slice(resp.Clusters, func(i, j int) bool {
+		return resp.Clusters[i].GetInitialFailoverVersion() < resp.Clusters[j].GetInitialFailoverVersion()
+	})
+	return resp, nil
+}
+
+// ApplyReplicationTask applies a history replication task to the current cluster through the history
+// ReplicateEvents API
+func (adh *AdminHandler) ApplyReplicationTask(
+	ctx context.Context, request *admin.ApplyReplicationTaskRequest) error {
+
+	scope := metrics.AdminApplyReplicationTaskScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.SourceCluster == nil {
+		return adh.error(&gen.BadRequestError{Message: "Source cluster is not set on request."}, scope)
+	}
+	task := request.ReplicationTask
+	if task == nil || task.GetTaskType() != replicator.ReplicationTaskTypeHistory || task.HistoryTaskAttributes == nil {
+		return adh.error(&gen.BadRequestError{Message: "Only history replication tasks can be applied."}, scope)
+	}
+
+	attr := task.HistoryTaskAttributes
+	targeted := false
+	currentCluster := adh.GetClusterMetadata().GetCurrentClusterName()
+	for _, cluster := range attr.TargetClusters {
+		if cluster == currentCluster {
+			targeted = true
+			break
+		}
+	}
+	if !targeted {
+		return adh.error(&gen.BadRequestError{
+			Message: fmt.Sprintf("Replication task does not target cluster %v.", currentCluster),
+		}, scope)
+	}
+
+	err := adh.history.ReplicateEvents(ctx, &h.ReplicateEventsRequest{
+		SourceCluster: request.SourceCluster,
+		DomainUUID:    attr.DomainId,
+		WorkflowExecution: &gen.WorkflowExecution{
+			WorkflowId: attr.WorkflowId,
+			RunId:      attr.RunId,
+		},
+		FirstEventId:            attr.FirstEventId,
+		NextEventId:             attr.NextEventId,
+		Version:                 attr.Version,
+		ReplicationInfo:         attr.ReplicationInfo,
+		History:                 attr.History,
+		NewRunHistory:           attr.NewRunHistory,
+		ForceBufferEvents:       common.BoolPtr(request.GetForceBufferEvents()),
+		EventStoreVersion:       attr.EventStoreVersion,
+		NewRunEventStoreVersion: attr.NewRunEventStoreVersion,
+		ResetWorkflow:           attr.ResetWorkflow,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// ListConflictResolutions returns the audit trail of the conflict resolutions of a workflow, latest first
+func (adh *AdminHandler) ListConflictResolutions(
+	ctx context.Context, request *admin.ListConflictResolutionsRequest) (*admin.ListConflictResolutionsResponse, error) {
+
+	scope := metrics.AdminListConflictResolutionsScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.Execution == nil {
+		return nil, adh.error(errExecutionNotSet, scope)
+	}
+	if request.Execution.GetWorkflowId() == "" {
+		return nil, adh.error(errWorkflowIDNotSet, scope)
+	}
+	if request.GetMaximumPageSize() < 0 {
+		return nil, adh.error(&gen.BadRequestError{Message: "Invalid PageSize."}, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	pageSize := 100
+	if request.GetMaximumPageSize() > 0 {
+		pageSize = int(request.GetMaximumPageSize())
+	}
+	resp, err := adh.historyMgr.ListConflictResolutionAudits(&persistence.ListConflictResolutionAuditsRequest{
+		DomainID:      domainID,
+		WorkflowID:    request.Execution.GetWorkflowId(),
+		PageSize:      pageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	// audits are keyed by workflow ID, filtering by run ID may return pages smaller than the page size
+	runID := request.Execution.GetRunId()
+	result := &admin.ListConflictResolutionsResponse{NextPageToken: resp.NextPageToken}
+	for _, audit := range resp.Audits {
+		if runID != "" && audit.RunID != runID {
+			continue
+		}
+		result.ConflictResolutions = append(result.ConflictResolutions, &admin.ConflictResolutionAudit{
+			AuditId: common.StringPtr(audit.AuditID),
+			Execution: &gen.WorkflowExecution{
+				WorkflowId: common.StringPtr(audit.WorkflowID),
+				RunId:      common.StringPtr(audit.RunID),
+			},
+			SourceCluster:         common.StringPtr(audit.SourceCluster),
+			ResolvedTimestamp:     common.Int64Ptr(audit.ResolvedTime.UnixNano()),
+			Reason:                common.StringPtr(audit.Reason),
+			ResetEventId:          common.Int64Ptr(audit.ResetEventID),
+			DiscardedNextEventId:  common.Int64Ptr(audit.DiscardedNextEventID),
+			DiscardedStartVersion: common.Int64Ptr(audit.DiscardedStartVersion),
+			DiscardedEndVersion:   common.Int64Ptr(audit.DiscardedEndVersion),
+			AdoptedFirstEventId:   common.Int64Ptr(audit.AdoptedFirstEventID),
+			AdoptedNextEventId:    common.Int64Ptr(audit.AdoptedNextEventID),
+			AdoptedStartVersion:   common.Int64Ptr(audit.AdoptedStartVersion),
+			AdoptedEndVersion:     common.Int64Ptr(audit.AdoptedEndVersion),
+		})
+	}
+	return result, nil
+}
+
+// UpdateTaskListDispatchRate sets the dispatch rate of a task list, which takes precedence over the rate
+// pollers ask for. Leaving the rate unset clears it.
+func (adh *AdminHandler) UpdateTaskListDispatchRate(
+	ctx context.Context, request *gen.UpdateTaskListDispatchRateRequest) error {
+
+	scope := metrics.AdminUpdateTaskListDispatchRateScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateTaskListRequest(request.GetDomain(), request.TaskList, request.TaskListType); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.DispatchRatePerSecond != nil && request.GetDispatchRatePerSecond() <= 0 {
+		return adh.error(&gen.BadRequestError{
+			Message: "DispatchRatePerSecond must be positive, leave it unset to clear the dispatch rate."}, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.matching.UpdateTaskListDispatchRate(ctx, &m.UpdateTaskListDispatchRateRequest{
+		DomainUUID:    common.StringPtr(domainID),
+		UpdateRequest: request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// DescribeTaskListDispatchRate returns the dispatch rate set on a task list
+func (adh *AdminHandler) DescribeTaskListDispatchRate(
+	ctx context.Context, request *gen.DescribeTaskListDispatchRateRequest) (*gen.DescribeTaskListDispatchRateResponse, error) {
+
+	scope := metrics.AdminDescribeTaskListDispatchRateScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateTaskListRequest(request.GetDomain(), request.TaskList, request.TaskListType); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	response, err := adh.matching.DescribeTaskListDispatchRate(ctx, &m.DescribeTaskListDispatchRateRequest{
+		DomainUUID:  common.StringPtr(domainID),
+		DescRequest: request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return response, nil
+}
+
+// PauseTaskList stops dispatching the tasks of a task list, which keeps accepting and persisting tasks
+func (adh *AdminHandler) PauseTaskList(ctx context.Context, request *gen.PauseTaskListRequest) error {
+
+	scope := metrics.AdminPauseTaskListScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateTaskListRequest(request.GetDomain(), request.TaskList, request.TaskListType); err != nil {
+		return adh.error(err, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.matching.PauseTaskList(ctx, &m.PauseTaskListRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		PauseRequest: request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// ResumeTaskList resumes dispatching the tasks of a task list paused with PauseTaskList
+func (adh *AdminHandler) ResumeTaskList(ctx context.Context, request *gen.ResumeTaskListRequest) error {
+
+	scope := metrics.AdminResumeTaskListScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateTaskListRequest(request.GetDomain(), request.TaskList, request.TaskListType); err != nil {
+		return adh.error(err, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.matching.ResumeTaskList(ctx, &m.ResumeTaskListRequest{
+		DomainUUID:    common.StringPtr(domainID),
+		ResumeRequest: request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// AdvanceTime moves the clock of the cluster forward, it is only available when the
+// services run in process with a controlled clock, which is the case of the test server
+func (adh *AdminHandler) AdvanceTime(ctx context.Context, request *admin.AdvanceTimeRequest) (*admin.AdvanceTimeResponse, error) {
+
+	scope := metrics.AdminAdvanceTimeScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDurationNanos() < 0 {
+		return nil, adh.error(&gen.BadRequestError{Message: "Duration must not be negative."}, scope)
+	}
+	timeSource, ok := adh.GetTimeSource().(*common.ControlledTimeSource)
+	if !ok {
+		return nil, adh.error(&gen.BadRequestError{Message: "Time can only be advanced on a test cluster."}, scope)
+	}
+
+	now := timeSource.Advance(time.Duration(request.GetDurationNanos()))
+	return &admin.AdvanceTimeResponse{
+		Timestamp: common.Int64Ptr(now.UnixNano()),
+	}, nil
+}
+
+func (adh *AdminHandler) validateTaskListRequest(domain string, taskList *gen.TaskList, taskListType *gen.TaskListType) error {
+	if domain == "" {
+		return errDomainNotSet
+	}
+	if taskList == nil || taskList.GetName() == "" {
+		return errTaskListNotSet
+	}
+	if taskListType == nil {
+		return errTaskListTypeNotSet
+	}
+	return nil
+}
+
+func (adh *AdminHandler) listClusterMetadata() ([]*persistence.ClusterMetadataInfo, error) {
+	resp, err := adh.metadataMgr.ListClusterMetadata()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Clusters, nil
+}
+
+func (adh *AdminHandler) validateClusters(clusters []*persistence.ClusterMetadataInfo) error {
+	if err := adh.GetClusterMetadata().ValidateClusters(clusters); err != nil {
+		return &gen.BadRequestError{Message: err.Error()}
+	}
+	return nil
+}
+
+// refreshClusters applies the persisted clusters to this host right away, other hosts pick them up
+// with the next cluster metadata refresh
+func (adh *AdminHandler) refreshClusters() error {
+	clusters, err := adh.listClusterMetadata()
+	if err != nil {
+		return err
+	}
+	return adh.GetClusterMetadata().UpdateClusters(clusters)
+}
+
 // startRequestProfile initiates recording of request metrics
 func (adh *AdminHandler) startRequestProfile(scope int) tally.Stopwatch {
 	adh.startWG.Wait()
@@ -341,6 +964,8 @@ func (adh *AdminHandler) error(err error, scope int) error {
 		return err
 	case *gen.EntityNotExistsError:
 		return err
+	case *gen.RetryTaskError:
+		return err
 	default:
 		logging.LogUncategorizedError(adh.Service.GetLogger(), err)
 		return &gen.InternalServiceError{Message: err.Error()}
@@ -0,0 +1,236 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/admin"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	cs "github.com/uber/cadence/common/service"
+	dc "github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	adminHandlerSuite struct {
+		suite.Suite
+		mockClusterMetadata *mocks.ClusterMetadata
+		mockHistoryClient   *mocks.HistoryClient
+		mockDomainCache     *cache.DomainCacheMock
+		handler             *AdminHandler
+	}
+)
+
+func TestAdminHandlerSuite(t *testing.T) {
+	s := new(adminHandlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *adminHandlerSuite) SetupTest() {
+	logger := bark.NewNopLogger()
+	s.mockClusterMetadata = &mocks.ClusterMetadata{}
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return("active")
+	s.mockHistoryClient = &mocks.HistoryClient{}
+	s.mockDomainCache = &cache.DomainCacheMock{}
+
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.Frontend)
+	mockService := cs.NewTestService(s.mockClusterMetadata, nil, metricsClient, &client.MockClientBean{}, logger)
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), logger), numHistoryShards)
+	s.handler = NewAdminHandler(mockService, numHistoryShards, config, &mocks.MetadataManager{},
+		&mocks.HistoryManager{}, &mocks.HistoryV2Manager{})
+	s.handler.history = s.mockHistoryClient
+	s.handler.domainCache = s.mockDomainCache
+	s.handler.metricsClient = metricsClient
+	// the handler is used without being started
+	s.handler.startWG.Done()
+}
+
+func (s *adminHandlerSuite) TearDownTest() {
+	s.mockHistoryClient.AssertExpectations(s.T())
+	s.mockDomainCache.AssertExpectations(s.T())
+}
+
+func (s *adminHandlerSuite) TestDescribeReplicationStatus_AllShards() {
+	s.mockHistoryClient.On("DescribeReplicationStatus", mock.Anything, &replicator.DescribeReplicationStatusRequest{
+		ShardIDs: allHistoryShardIDs(numHistoryShards),
+	}).Return(&replicator.DescribeReplicationStatusResponse{
+		Shards: []*replicator.ShardReplicationStatus{
+			{PendingTasksByDomainId: map[string]int64{"domain-a-id": 3}},
+			{
+				PendingTasksByDomainId: map[string]int64{"domain-a-id": 2},
+				HeldTasksByDomainId:    map[string]int64{"domain-b-id": 1},
+			},
+		},
+	}, nil).Once()
+	s.mockDomainCache.On("GetDomainByID", "domain-a-id").Return(cache.NewDomainCacheEntryWithReplicationForTest(
+		&persistence.DomainInfo{ID: "domain-a-id", Name: "domain-a"},
+		&persistence.DomainConfig{},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: "active",
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: "active"},
+				{ClusterName: "standby"},
+			},
+		},
+		s.mockClusterMetadata,
+	), nil).Once()
+	s.mockDomainCache.On("GetDomainByID", "domain-b-id").Return(nil, &shared.EntityNotExistsError{}).Once()
+
+	response, err := s.handler.DescribeReplicationStatus(context.Background(), &replicator.DescribeReplicationStatusRequest{})
+	s.NoError(err)
+	s.Equal(2, len(response.Shards))
+	s.Equal(2, len(response.Domains))
+
+	domainA := response.Domains[0]
+	s.Equal("domain-a", domainA.GetDomain())
+	s.Equal(int64(5), domainA.GetPendingReplicationTasks())
+	s.Equal(int64(0), domainA.GetHeldReplicationTasks())
+	s.Equal(1, len(domainA.Controls))
+	s.Equal("standby", domainA.Controls[0].GetCluster())
+
+	// a domain missing from the cache is reported by its ID
+	domainB := response.Domains[1]
+	s.Equal("domain-b-id", domainB.GetDomain())
+	s.Equal(int64(0), domainB.GetPendingReplicationTasks())
+	s.Equal(int64(1), domainB.GetHeldReplicationTasks())
+}
+
+func (s *adminHandlerSuite) TestDescribeReplicationStatus_Domain() {
+	s.mockDomainCache.On("GetDomain", "domain-a").Return(cache.NewDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: "domain-a-id", Name: "domain-a"},
+		&persistence.DomainConfig{},
+	), nil).Once()
+	s.mockHistoryClient.On("DescribeReplicationStatus", mock.Anything, &replicator.DescribeReplicationStatusRequest{
+		ShardIDs: []int32{2},
+	}).Return(&replicator.DescribeReplicationStatusResponse{
+		Shards: []*replicator.ShardReplicationStatus{
+			{PendingTasksByDomainId: map[string]int64{"domain-a-id": 3, "domain-b-id": 4}},
+		},
+	}, nil).Once()
+	s.mockDomainCache.On("GetDomainByID", "domain-a-id").Return(cache.NewDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: "domain-a-id", Name: "domain-a"},
+		&persistence.DomainConfig{},
+	), nil).Once()
+
+	response, err := s.handler.DescribeReplicationStatus(context.Background(), &replicator.DescribeReplicationStatusRequest{
+		Domain:   common.StringPtr("domain-a"),
+		ShardIDs: []int32{2},
+	})
+	s.NoError(err)
+	s.Equal(1, len(response.Domains))
+	s.Equal("domain-a", response.Domains[0].GetDomain())
+	s.Equal(int64(3), response.Domains[0].GetPendingReplicationTasks())
+}
+
+func (s *adminHandlerSuite) TestApplyReplicationTask_NilRequest() {
+	err := s.handler.ApplyReplicationTask(context.Background(), nil)
+	s.Equal(errRequestNotSet, err)
+}
+
+func (s *adminHandlerSuite) TestApplyReplicationTask_SourceClusterNotSet() {
+	request := applyReplicationTaskRequest([]string{"active"})
+	request.SourceCluster = nil
+
+	err := s.handler.ApplyReplicationTask(context.Background(), request)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *adminHandlerSuite) TestApplyReplicationTask_NotHistoryTask() {
+	request := applyReplicationTaskRequest([]string{"active"})
+	request.ReplicationTask.TaskType = replicator.ReplicationTaskTypeSyncActivity.Ptr()
+
+	err := s.handler.ApplyReplicationTask(context.Background(), request)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *adminHandlerSuite) TestApplyReplicationTask_MissingAttributes() {
+	request := applyReplicationTaskRequest([]string{"active"})
+	request.ReplicationTask.HistoryTaskAttributes = nil
+
+	err := s.handler.ApplyReplicationTask(context.Background(), request)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *adminHandlerSuite) TestApplyReplicationTask_NotTargetingCurrentCluster() {
+	request := applyReplicationTaskRequest([]string{"standby"})
+
+	err := s.handler.ApplyReplicationTask(context.Background(), request)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *adminHandlerSuite) TestApplyReplicationTask_Success() {
+	request := applyReplicationTaskRequest([]string{"standby", "active"})
+	request.ForceBufferEvents = common.BoolPtr(true)
+	attr := request.ReplicationTask.HistoryTaskAttributes
+	s.mockHistoryClient.On("ReplicateEvents", mock.Anything, mock.MatchedBy(func(req *h.ReplicateEventsRequest) bool {
+		return req.GetSourceCluster() == "standby" &&
+			req.GetDomainUUID() == attr.GetDomainId() &&
+			req.WorkflowExecution.GetWorkflowId() == attr.GetWorkflowId() &&
+			req.WorkflowExecution.GetRunId() == attr.GetRunId() &&
+			req.GetFirstEventId() == attr.GetFirstEventId() &&
+			req.GetNextEventId() == attr.GetNextEventId() &&
+			req.GetVersion() == attr.GetVersion() &&
+			req.GetForceBufferEvents()
+	})).Return(nil).Once()
+
+	err := s.handler.ApplyReplicationTask(context.Background(), request)
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestApplyReplicationTask_HistoryError() {
+	request := applyReplicationTaskRequest([]string{"active"})
+	s.mockHistoryClient.On("ReplicateEvents", mock.Anything, mock.Anything).
+		Return(&shared.EntityNotExistsError{}).Once()
+
+	err := s.handler.ApplyReplicationTask(context.Background(), request)
+	s.IsType(&shared.EntityNotExistsError{}, err)
+}
+
+func applyReplicationTaskRequest(targetClusters []string) *admin.ApplyReplicationTaskRequest {
+	return &admin.ApplyReplicationTaskRequest{
+		SourceCluster: common.StringPtr("standby"),
+		ReplicationTask: &replicator.ReplicationTask{
+			TaskType: replicator.ReplicationTaskTypeHistory.Ptr(),
+			HistoryTaskAttributes: &replicator.HistoryTaskAttributes{
+				TargetClusters: targetClusters,
+				DomainId:       common.StringPtr("domain-id"),
+				WorkflowId:     common.StringPtr("workflow-id"),
+				RunId:          common.StringPtr("run-id"),
+				FirstEventId:   common.Int64Ptr(5),
+				NextEventId:    common.Int64Ptr(8),
+				Version:        common.Int64Ptr(10),
+			},
+		},
+	}
+}
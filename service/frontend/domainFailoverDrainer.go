@@ -0,0 +1,359 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/replicator"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	domainFailoverDrainListPageSize = 100
+	domainFailoverDrainTimeout      = 30 * time.Second
+	// domainFailoverDrainerKey is the membership key of the drainer, only the frontend host owning it drains
+	domainFailoverDrainerKey = "domain-failover-drainer"
+)
+
+type (
+	// domainFailoverDrainer completes the graceful failovers of domains active in the current cluster.
+	// When a graceful failover is started, the replication task ID of each history shard with pending
+	// replication tasks of the domain is persisted with the domain as its failover marker, and the domain
+	// is failed over once the target cluster has applied the replication tasks up to the marker of every
+	// shard, or once the failover expires. The drainer runs on a single frontend host of the cluster.
+	domainFailoverDrainer struct {
+		status     int32
+		handler    *WorkflowHandler
+		config     *Config
+		timeSource common.TimeSource
+		logger     bark.Logger
+		resolver   membership.ServiceResolver
+		shutdownCh chan struct{}
+		shutdownWG sync.WaitGroup
+	}
+)
+
+func newDomainFailoverDrainer(handler *WorkflowHandler, config *Config, timeSource common.TimeSource,
+	logger bark.Logger) *domainFailoverDrainer {
+	return &domainFailoverDrainer{
+		status:     common.DaemonStatusInitialized,
+		handler:    handler,
+		config:     config,
+		timeSource: timeSource,
+		logger: logger.WithFields(bark.Fields{
+			logging.TagWorkflowComponent: logging.TagValueDomainFailoverDrainerComponent,
+		}),
+		shutdownCh: make(chan struct{}),
+	}
+}
+
+func (d *domainFailoverDrainer) Start() {
+	if !atomic.CompareAndSwapInt32(&d.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	resolver, err := d.handler.GetMembershipMonitor().GetResolver(common.FrontendServiceName)
+	if err != nil {
+		d.logger.Fatalf("Unable to get frontend service resolver: %v", err)
+	}
+	d.resolver = resolver
+
+	d.shutdownWG.Add(1)
+	go d.drainLoop()
+	d.logger.Info("Domain failover drainer started.")
+}
+
+func (d *domainFailoverDrainer) Stop() {
+	if !atomic.CompareAndSwapInt32(&d.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(d.shutdownCh)
+	if success := common.AwaitWaitGroup(&d.shutdownWG, time.Minute); !success {
+		d.logger.Warn("Domain failover drainer timed out on shutdown.")
+	}
+	d.logger.Info("Domain failover drainer stopped.")
+}
+
+func (d *domainFailoverDrainer) drainLoop() {
+	defer d.shutdownWG.Done()
+
+	timer := time.NewTimer(d.config.DomainFailoverDrainInterval())
+	defer timer.Stop()
+	for {
+		select {
+		case <-d.shutdownCh:
+			return
+		case <-timer.C:
+			if err := d.drain(); err != nil {
+				d.handler.metricsClient.IncCounter(metrics.FrontendDomainFailoverDrainerScope, metrics.CadenceFailures)
+				d.logger.WithField(logging.TagErr, err).Warn("Failed to drain domain failovers.")
+			}
+			timer.Reset(d.config.DomainFailoverDrainInterval())
+		}
+	}
+}
+
+func (d *domainFailoverDrainer) drain() error {
+	owner, err := d.isOwner()
+	if err != nil || !owner {
+		return err
+	}
+
+	domains, err := d.listDrainingDomains()
+	if err != nil {
+		return err
+	}
+	if len(domains) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), domainFailoverDrainTimeout)
+	defer cancel()
+
+	// expired failovers are forced without waiting on replication, the others only need the status of the shards
+	// in their markers, or of all shards when the drain has just started and the markers are yet to be recorded
+	now := d.timeSource.Now().UnixNano()
+	var draining []*persistence.GetDomainResponse
+	shardIDs := make(map[int32]struct{})
+	for _, domain := range domains {
+		replicationConfig := domain.ReplicationConfig
+		if now >= replicationConfig.FailoverExpireTime {
+			d.domainLogger(domain).Warnf("Graceful failover to %v timed out, forcing failover.",
+				replicationConfig.PendingActiveClusterName)
+			if err := d.failover(ctx, domain); err != nil {
+				d.domainLogger(domain).WithField(logging.TagErr, err).Warn("Failed to fail over domain.")
+				continue
+			}
+			d.handler.metricsClient.IncCounter(metrics.FrontendDomainFailoverDrainerScope, metrics.DomainFailoverDrainTimeoutCounter)
+			continue
+		}
+
+		draining = append(draining, domain)
+		if len(replicationConfig.FailoverMarkers) == 0 {
+			for _, shardID := range allHistoryShardIDs(d.config.NumHistoryShards) {
+				shardIDs[shardID] = struct{}{}
+			}
+		}
+		for shardID := range replicationConfig.FailoverMarkers {
+			shardIDs[shardID] = struct{}{}
+		}
+	}
+	if len(draining) == 0 {
+		return nil
+	}
+
+	status, err := d.handler.history.DescribeReplicationStatus(ctx, &replicator.DescribeReplicationStatusRequest{
+		ShardIDs: sortedShardIDs(shardIDs),
+	})
+	if err != nil {
+		return err
+	}
+	shards := make(map[int32]*replicator.ShardReplicationStatus, len(status.Shards))
+	for _, shard := range status.Shards {
+		shards[shard.GetShardID()] = shard
+	}
+
+	for _, domain := range draining {
+		replicationConfig := domain.ReplicationConfig
+		logger := d.domainLogger(domain)
+
+		if len(replicationConfig.FailoverMarkers) == 0 {
+			markers := d.createMarkers(domain.Info.ID, replicationConfig.PendingActiveClusterName, shards, logger)
+			if len(markers) != 0 {
+				if err := d.recordMarkers(domain, markers); err != nil {
+					logger.WithField(logging.TagErr, err).Warn("Failed to record graceful failover markers.")
+					continue
+				}
+				logger.Infof("Graceful failover to %v started draining %v shards.",
+					replicationConfig.PendingActiveClusterName, len(markers))
+				continue
+			}
+			// nothing of the domain is left to replicate
+		} else if !d.isReplicationCaughtUp(replicationConfig.FailoverMarkers, shards, replicationConfig.PendingActiveClusterName) {
+			continue
+		}
+
+		logger.Infof("Replication caught up, failing over to %v.", replicationConfig.PendingActiveClusterName)
+		if err := d.failover(ctx, domain); err != nil {
+			logger.WithField(logging.TagErr, err).Warn("Failed to fail over domain.")
+			continue
+		}
+		d.handler.metricsClient.IncCounter(metrics.FrontendDomainFailoverDrainerScope, metrics.DomainFailoverDrainCompletedCounter)
+	}
+	return nil
+}
+
+// isOwner returns whether the current frontend host owns the drainer
+func (d *domainFailoverDrainer) isOwner() (bool, error) {
+	owner, err := d.resolver.Lookup(domainFailoverDrainerKey)
+	if err != nil {
+		return false, err
+	}
+	return owner.Identity() == d.handler.GetHostInfo().Identity(), nil
+}
+
+// listDrainingDomains returns the domains which have a graceful failover in progress and are active in the current cluster
+func (d *domainFailoverDrainer) listDrainingDomains() ([]*persistence.GetDomainResponse, error) {
+	currentClusterName := d.handler.GetClusterMetadata().GetCurrentClusterName()
+	var domains []*persistence.GetDomainResponse
+	var token []byte
+	for {
+		resp, err := d.handler.metadataMgr.ListDomains(&persistence.ListDomainsRequest{
+			PageSize:      domainFailoverDrainListPageSize,
+			NextPageToken: token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, domain := range resp.Domains {
+			if domain.IsGlobalDomain &&
+				len(domain.ReplicationConfig.PendingActiveClusterName) != 0 &&
+				domain.ReplicationConfig.ActiveClusterName == currentClusterName {
+				domains = append(domains, domain)
+			}
+		}
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			return domains, nil
+		}
+	}
+}
+
+// createMarkers returns the max replication task ID of every shard which may have pending replication tasks of the domain
+func (d *domainFailoverDrainer) createMarkers(domainID string, targetClusterName string,
+	shards map[int32]*replicator.ShardReplicationStatus, logger bark.Logger) map[int32]int64 {
+
+	markers := make(map[int32]int64)
+	pulled := true
+	for shardID, shard := range shards {
+		if _, ok := shard.RemoteClusterAckLevels[targetClusterName]; !ok {
+			pulled = false
+		}
+		if shard.GetPendingTasksTruncated() || shard.PendingTasksByDomainId[domainID] > 0 {
+			markers[shardID] = shard.GetMaxReplicationTaskId()
+		}
+	}
+	if !pulled && len(markers) != 0 {
+		logger.Warnf("Replication tasks are not pulled by %v, so the graceful failover can not confirm "+
+			"they are applied and fails over when it expires.", targetClusterName)
+	}
+	return markers
+}
+
+// recordMarkers persists the markers with the domain, as long as the same graceful failover is still in progress
+func (d *domainFailoverDrainer) recordMarkers(domain *persistence.GetDomainResponse, markers map[int32]int64) error {
+	// the notification version is the lock of the v2 domain table, so it has to be read before the domain
+	metadata, err := d.handler.metadataMgr.GetMetadata()
+	if err != nil {
+		return err
+	}
+	current, err := d.handler.metadataMgr.GetDomain(&persistence.GetDomainRequest{ID: domain.Info.ID})
+	if err != nil {
+		return err
+	}
+	if current.ReplicationConfig.PendingActiveClusterName != domain.ReplicationConfig.PendingActiveClusterName ||
+		current.ReplicationConfig.FailoverStartTime != domain.ReplicationConfig.FailoverStartTime {
+		return nil
+	}
+
+	current.ReplicationConfig.FailoverMarkers = markers
+	updateReq := &persistence.UpdateDomainRequest{
+		Info:                        current.Info,
+		Config:                      current.Config,
+		ReplicationConfig:           current.ReplicationConfig,
+		ConfigVersion:               current.ConfigVersion,
+		FailoverVersion:             current.FailoverVersion,
+		FailoverNotificationVersion: current.FailoverNotificationVersion,
+		TableVersion:                current.TableVersion,
+	}
+	switch current.TableVersion {
+	case persistence.DomainTableVersionV1:
+		updateReq.NotificationVersion = current.NotificationVersion
+	default:
+		updateReq.NotificationVersion = metadata.NotificationVersion
+	}
+	return d.handler.metadataMgr.UpdateDomain(updateReq)
+}
+
+// isReplicationCaughtUp returns whether the target cluster has applied the replication tasks up to the marker
+// of every shard, which is only known when the target cluster pulls the replication tasks
+func (d *domainFailoverDrainer) isReplicationCaughtUp(markers map[int32]int64,
+	shards map[int32]*replicator.ShardReplicationStatus, targetClusterName string) bool {
+
+	for shardID, marker := range markers {
+		shard, ok := shards[shardID]
+		if !ok {
+			return false
+		}
+		ackLevel, ok := shard.RemoteClusterAckLevels[targetClusterName]
+		if !ok || ackLevel < marker {
+			return false
+		}
+	}
+	return true
+}
+
+// failover hands the domain over to the pending active cluster, which also clears the graceful failover state
+func (d *domainFailoverDrainer) failover(ctx context.Context, domain *persistence.GetDomainResponse) error {
+	_, err := d.handler.UpdateDomain(ctx, &gen.UpdateDomainRequest{
+		Name: common.StringPtr(domain.Info.Name),
+		ReplicationConfiguration: &gen.DomainReplicationConfiguration{
+			ActiveClusterName: common.StringPtr(domain.ReplicationConfig.PendingActiveClusterName),
+		},
+	})
+	return err
+}
+
+func (d *domainFailoverDrainer) domainLogger(domain *persistence.GetDomainResponse) bark.Logger {
+	return d.logger.WithFields(bark.Fields{
+		logging.TagDomainID:      domain.Info.ID,
+		logging.TagSourceCluster: domain.ReplicationConfig.ActiveClusterName,
+	})
+}
+
+func allHistoryShardIDs(numHistoryShards int) []int32 {
+	var shardIDs []int32
+	for shardID := 0; shardID < numHistoryShards; shardID++ {
+		shardIDs = append(shardIDs, int32(shardID))
+	}
+	return shardIDs
+}
+
+func sortedShardIDs(shardIDs map[int32]struct{}) []int32 {
+	result := make([]int32, 0, len(shardIDs))
+	for shardID := range shardIDs {
+		result = append(result, shardID)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	cs "github.com/uber/cadence/common/service"
+	dc "github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	domainFailoverDrainerSuite struct {
+		suite.Suite
+		mockClusterMetadata *mocks.ClusterMetadata
+		mockProducer        *mocks.KafkaProducer
+		mockMetadataMgr     *mocks.MetadataManager
+		mockHistoryClient   *mocks.HistoryClient
+		mockResolver        *mocks.ServiceResolver
+		timeSource          *common.ControlledTimeSource
+		handler             *WorkflowHandler
+		drainer             *domainFailoverDrainer
+	}
+)
+
+func TestDomainFailoverDrainerSuite(t *testing.T) {
+	s := new(domainFailoverDrainerSuite)
+	suite.Run(t, s)
+}
+
+func (s *domainFailoverDrainerSuite) SetupTest() {
+	logger := bark.NewNopLogger()
+	s.mockClusterMetadata = &mocks.ClusterMetadata{}
+	s.mockClusterMetadata.On("IsGlobalDomainEnabled").Return(true)
+	s.mockClusterMetadata.On("IsMasterCluster").Return(true)
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return("active")
+	s.mockClusterMetadata.On("GetAllClusterFailoverVersions").Return(map[string]int64{"active": 0, "standby": 1})
+	s.mockClusterMetadata.On("GetNextFailoverVersion", "standby", mock.Anything).Return(int64(11))
+	s.mockProducer = &mocks.KafkaProducer{}
+	s.mockMetadataMgr = &mocks.MetadataManager{}
+	s.mockHistoryClient = &mocks.HistoryClient{}
+	s.mockResolver = &mocks.ServiceResolver{}
+
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.Frontend)
+	mockService := cs.NewTestService(s.mockClusterMetadata, mocks.NewMockMessagingClient(s.mockProducer, nil),
+		metricsClient, &client.MockClientBean{}, logger)
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), logger), numHistoryShards)
+	s.handler = NewWorkflowHandler(mockService, config, s.mockMetadataMgr, &mocks.HistoryManager{},
+		&mocks.HistoryV2Manager{}, &mocks.VisibilityManager{}, s.mockProducer, &mocks.Client{})
+	s.handler.history = s.mockHistoryClient
+	s.handler.metricsClient = metricsClient
+
+	s.timeSource = common.NewControlledTimeSource()
+	s.drainer = newDomainFailoverDrainer(s.handler, config, s.timeSource, logger)
+	s.drainer.resolver = s.mockResolver
+	s.mockResolver.On("Lookup", domainFailoverDrainerKey).Return(mockService.GetHostInfo(), nil)
+}
+
+func (s *domainFailoverDrainerSuite) TearDownTest() {
+	s.mockProducer.AssertExpectations(s.T())
+	s.mockMetadataMgr.AssertExpectations(s.T())
+	s.mockHistoryClient.AssertExpectations(s.T())
+}
+
+func (s *domainFailoverDrainerSuite) TestDrain_NotOwner() {
+	s.mockResolver = &mocks.ServiceResolver{}
+	s.mockResolver.On("Lookup", domainFailoverDrainerKey).Return(membership.NewHostInfo("other_host", nil), nil)
+	s.drainer.resolver = s.mockResolver
+
+	s.NoError(s.drainer.drain())
+}
+
+func (s *domainFailoverDrainerSuite) TestDrain_RecordsMarkers() {
+	domain := drainingDomainResponse(nil)
+	s.mockListDomains(domain)
+	truncated := shardReplicationStatus(3, 400, nil, 0)
+	truncated.PendingTasksTruncated = common.BoolPtr(true)
+	s.mockHistoryClient.On("DescribeReplicationStatus", mock.Anything, &replicator.DescribeReplicationStatusRequest{
+		ShardIDs: allHistoryShardIDs(numHistoryShards),
+	}).Return(&replicator.DescribeReplicationStatusResponse{
+		Shards: []*replicator.ShardReplicationStatus{
+			shardReplicationStatus(0, 100, nil, 0),
+			shardReplicationStatus(1, 200, map[string]int64{"other-id": 5}, 0),
+			shardReplicationStatus(2, 300, map[string]int64{domain.Info.ID: 3}, 0),
+			truncated,
+		},
+	}, nil).Once()
+	s.mockMetadataMgr.On("GetMetadata").Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{ID: domain.Info.ID}).Return(drainingDomainResponse(nil), nil).Once()
+	s.mockMetadataMgr.On("UpdateDomain", mock.MatchedBy(func(request *persistence.UpdateDomainRequest) bool {
+		return request.NotificationVersion == 7 &&
+			request.ReplicationConfig.ActiveClusterName == "active" &&
+			request.ReplicationConfig.PendingActiveClusterName == "standby" &&
+			len(request.ReplicationConfig.FailoverMarkers) == 2 &&
+			request.ReplicationConfig.FailoverMarkers[2] == 300 &&
+			request.ReplicationConfig.FailoverMarkers[3] == 400
+	})).Return(nil).Once()
+
+	s.NoError(s.drainer.drain())
+}
+
+func (s *domainFailoverDrainerSuite) TestDrain_WaitsForTargetClusterAckLevel() {
+	markers := map[int32]int64{2: 300, 5: 500}
+	s.mockListDomains(drainingDomainResponse(markers))
+	s.mockHistoryClient.On("DescribeReplicationStatus", mock.Anything, &replicator.DescribeReplicationStatusRequest{
+		ShardIDs: []int32{2, 5},
+	}).Return(&replicator.DescribeReplicationStatusResponse{
+		Shards: []*replicator.ShardReplicationStatus{
+			shardReplicationStatus(2, 310, nil, 300),
+			shardReplicationStatus(5, 510, nil, 499),
+		},
+	}, nil).Once()
+
+	s.NoError(s.drainer.drain())
+}
+
+func (s *domainFailoverDrainerSuite) TestDrain_IgnoresReplicatorAckLevel() {
+	markers := map[int32]int64{2: 300}
+	s.mockListDomains(drainingDomainResponse(markers))
+	status := shardReplicationStatus(2, 310, nil, 0)
+	status.ReplicatorAckLevel = common.Int64Ptr(310)
+	status.RemoteClusterAckLevels = nil
+	s.mockHistoryClient.On("DescribeReplicationStatus", mock.Anything, &replicator.DescribeReplicationStatusRequest{
+		ShardIDs: []int32{2},
+	}).Return(&replicator.DescribeReplicationStatusResponse{
+		Shards: []*replicator.ShardReplicationStatus{status},
+	}, nil).Once()
+
+	s.NoError(s.drainer.drain())
+}
+
+func (s *domainFailoverDrainerSuite) TestDrain_FailsOverWhenCaughtUp() {
+	markers := map[int32]int64{2: 300, 5: 500}
+	domain := drainingDomainResponse(markers)
+	s.mockListDomains(domain)
+	s.mockHistoryClient.On("DescribeReplicationStatus", mock.Anything, &replicator.DescribeReplicationStatusRequest{
+		ShardIDs: []int32{2, 5},
+	}).Return(&replicator.DescribeReplicationStatusResponse{
+		Shards: []*replicator.ShardReplicationStatus{
+			shardReplicationStatus(2, 310, nil, 300),
+			shardReplicationStatus(5, 510, nil, 505),
+		},
+	}, nil).Once()
+	s.mockFailover(domain)
+
+	s.NoError(s.drainer.drain())
+}
+
+func (s *domainFailoverDrainerSuite) TestDrain_FailsOverWithNothingToReplicate() {
+	domain := drainingDomainResponse(nil)
+	s.mockListDomains(domain)
+	s.mockHistoryClient.On("DescribeReplicationStatus", mock.Anything, &replicator.DescribeReplicationStatusRequest{
+		ShardIDs: allHistoryShardIDs(numHistoryShards),
+	}).Return(&replicator.DescribeReplicationStatusResponse{
+		Shards: []*replicator.ShardReplicationStatus{
+			shardReplicationStatus(0, 100, map[string]int64{"other-id": 5}, 0),
+		},
+	}, nil).Once()
+	s.mockFailover(domain)
+
+	s.NoError(s.drainer.drain())
+}
+
+func (s *domainFailoverDrainerSuite) TestDrain_FailsOverOnExpiry() {
+	domain := drainingDomainResponse(map[int32]int64{2: 300})
+	s.mockListDomains(domain)
+	s.mockFailover(domain)
+	s.timeSource.Advance(2 * time.Minute)
+
+	s.NoError(s.drainer.drain())
+}
+
+func (s *domainFailoverDrainerSuite) TestDrain_SkipsDomainsNotDraining() {
+	notDraining := drainingDomainResponse(nil)
+	notDraining.ReplicationConfig.PendingActiveClusterName = ""
+	activeElsewhere := drainingDomainResponse(nil)
+	activeElsewhere.ReplicationConfig.ActiveClusterName = "standby"
+	activeElsewhere.ReplicationConfig.PendingActiveClusterName = "active"
+	s.mockListDomains(notDraining, activeElsewhere)
+
+	s.NoError(s.drainer.drain())
+}
+
+func (s *domainFailoverDrainerSuite) mockListDomains(domains ...*persistence.GetDomainResponse) {
+	s.mockMetadataMgr.On("ListDomains", &persistence.ListDomainsRequest{
+		PageSize: domainFailoverDrainListPageSize,
+	}).Return(&persistence.ListDomainsResponse{Domains: domains}, nil).Once()
+}
+
+func (s *domainFailoverDrainerSuite) mockFailover(domain *persistence.GetDomainResponse) {
+	s.mockMetadataMgr.On("GetMetadata").Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: domain.Info.Name}).
+		Return(drainingDomainResponse(domain.ReplicationConfig.FailoverMarkers), nil).Once()
+	s.mockMetadataMgr.On("UpdateDomain", mock.MatchedBy(func(request *persistence.UpdateDomainRequest) bool {
+		return request.ReplicationConfig.ActiveClusterName == "standby" &&
+			request.ReplicationConfig.PendingActiveClusterName == "" &&
+			request.ReplicationConfig.FailoverMarkers == nil &&
+			request.FailoverVersion == 11
+	})).Return(nil).Once()
+	s.mockProducer.On("Publish", mock.Anything).Return(nil).Once()
+}
+
+func drainingDomainResponse(markers map[int32]int64) *persistence.GetDomainResponse {
+	domain := persistenceGetDomainResponse("", shared.ArchivalStatusNeverEnabled)
+	domain.IsGlobalDomain = true
+	domain.TableVersion = persistence.DomainTableVersionV2
+	domain.ReplicationConfig.PendingActiveClusterName = "standby"
+	domain.ReplicationConfig.FailoverStartTime = time.Now().UnixNano()
+	domain.ReplicationConfig.FailoverExpireTime = time.Now().Add(time.Minute).UnixNano()
+	domain.ReplicationConfig.FailoverMarkers = markers
+	return domain
+}
+
+func shardReplicationStatus(shardID int32, maxTaskID int64, pendingTasks map[string]int64,
+	standbyAckLevel int64) *replicator.ShardReplicationStatus {
+	return &replicator.ShardReplicationStatus{
+		ShardID:                common.Int32Ptr(shardID),
+		MaxReplicationTaskId:   common.Int64Ptr(maxTaskID),
+		ReplicatorAckLevel:     common.Int64Ptr(0),
+		RemoteClusterAckLevels: map[string]int64{"standby": standbyAckLevel},
+		PendingTasksByDomainId: pendingTasks,
+		PendingTasksTruncated:  common.BoolPtr(false),
+	}
+}
@@ -21,7 +21,10 @@
 package frontend
 
 import (
+	"time"
+
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/mocks"
 	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
@@ -52,11 +55,28 @@ type Config struct {
 	// size limit system protection
 	BlobSizeLimitError dynamicconfig.IntPropertyFnWithDomainFilter
 	BlobSizeLimitWarn  dynamicconfig.IntPropertyFnWithDomainFilter
+
+	// ClusterMetadataRefreshInterval is the interval to reload clusters persisted in the metadata store
+	ClusterMetadataRefreshInterval dynamicconfig.DurationPropertyFn
+
+	NumHistoryShards int
+	// DomainFailoverDrainInterval is the interval to check the progress of graceful domain failovers
+	DomainFailoverDrainInterval dynamicconfig.DurationPropertyFn
+
+	// replication controls of the history and worker services, only read to report the replication status
+	ReplicatorDomainPaused        dynamicconfig.BoolPropertyFnWithDomainAndClusterFilters
+	ReplicatorDomainMaxRPS        dynamicconfig.IntPropertyFnWithDomainAndClusterFilters
+	WorkerReplicationDomainPaused dynamicconfig.BoolPropertyFnWithDomainAndClusterFilters
+	WorkerReplicationDomainMaxRPS dynamicconfig.IntPropertyFnWithDomainAndClusterFilters
+	// EnableReplicationTaskPull is whether the history service pulls replication tasks, graceful failover
+	// needs it to learn when the target cluster has applied the replication tasks of the domain
+	EnableReplicationTaskPull dynamicconfig.BoolPropertyFn
 }
 
 // NewConfig returns new service config with default values
-func NewConfig(dc *dynamicconfig.Collection) *Config {
+func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int) *Config {
 	return &Config{
+		NumHistoryShards:               numHistoryShards,
 		PersistenceMaxQPS:              dc.GetIntProperty(dynamicconfig.FrontendPersistenceMaxQPS, 2000),
 		VisibilityMaxPageSize:          dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendVisibilityMaxPageSize, 1000),
 		EnableVisibilitySampling:       dc.GetBoolProperty(dynamicconfig.EnableVisibilitySampling, true),
@@ -71,6 +91,13 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		DisableListVisibilityByFilter:  dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.DisableListVisibilityByFilter, false),
 		BlobSizeLimitError:             dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
 		BlobSizeLimitWarn:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitWarn, 256*1204),
+		ClusterMetadataRefreshInterval: dc.GetDurationProperty(dynamicconfig.ClusterMetadataRefreshInterval, time.Minute),
+		DomainFailoverDrainInterval:    dc.GetDurationProperty(dynamicconfig.FrontendDomainFailoverDrainInterval, 10*time.Second),
+		ReplicatorDomainPaused:         dc.GetBoolPropertyFilteredByDomainAndCluster(dynamicconfig.ReplicatorDomainPaused, false),
+		ReplicatorDomainMaxRPS:         dc.GetIntPropertyFilteredByDomainAndCluster(dynamicconfig.ReplicatorDomainMaxRPS, 0),
+		WorkerReplicationDomainPaused:  dc.GetBoolPropertyFilteredByDomainAndCluster(dynamicconfig.WorkerReplicationDomainPaused, false),
+		WorkerReplicationDomainMaxRPS:  dc.GetIntPropertyFilteredByDomainAndCluster(dynamicconfig.WorkerReplicationDomainMaxRPS, 0),
+		EnableReplicationTaskPull:      dc.GetBoolProperty(dynamicconfig.EnableReplicationTaskPull, false),
 	}
 }
 
@@ -86,7 +113,7 @@ func NewService(params *service.BootstrapParams) common.Daemon {
 	params.UpdateLoggerWithServiceName(common.FrontendServiceName)
 	return &Service{
 		params: params,
-		config: NewConfig(dynamicconfig.NewCollection(params.DynamicConfig, params.Logger)),
+		config: NewConfig(dynamicconfig.NewCollection(params.DynamicConfig, params.Logger), params.PersistenceConfig.NumHistoryShards),
 		stopC:  make(chan struct{}),
 	}
 }
@@ -105,6 +132,7 @@ func (s *Service) Start() {
 	pConfig.HistoryMaxConns = s.config.HistoryMgrNumConns()
 	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.PersistenceMaxQPS())
 	pConfig.SamplingConfig.VisibilityListMaxQPS = s.config.VisibilityListMaxQPS
+	pConfig.SetFaultInjection(dynamicconfig.NewCollection(params.DynamicConfig, log))
 	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), log)
 
 	metadata, err := pFactory.NewMetadataManager(persistencefactory.MetadataV1V2)
@@ -112,6 +140,11 @@ func (s *Service) Start() {
 		log.Fatalf("failed to create metadata manager: %v", err)
 	}
 
+	clusterMetadataRefresher := cluster.NewMetadataRefresher(params.ClusterMetadata, metadata, s.config.ClusterMetadataRefreshInterval, log)
+	if params.ClusterMetadata.IsGlobalDomainEnabled() {
+		clusterMetadataRefresher.Start()
+	}
+
 	visibility, err := pFactory.NewVisibilityManager(s.config.EnableVisibilitySampling()) // enable rate limit on list operations
 	if err != nil {
 		log.Fatalf("failed to create visibility manager: %v", err)
@@ -140,13 +173,20 @@ func (s *Service) Start() {
 	wfHandler := NewWorkflowHandler(base, s.config, metadata, history, historyV2, visibility, kafkaProducer, params.BlobstoreClient)
 	wfHandler.Start()
 
-	adminHandler := NewAdminHandler(base, pConfig.NumHistoryShards, metadata, history, historyV2)
+	domainFailoverDrainer := newDomainFailoverDrainer(wfHandler, s.config, base.GetTimeSource(), log)
+	if params.ClusterMetadata.IsGlobalDomainEnabled() {
+		domainFailoverDrainer.Start()
+	}
+
+	adminHandler := NewAdminHandler(base, pConfig.NumHistoryShards, s.config, metadata, history, historyV2)
 	adminHandler.Start()
 
 	log.Infof("%v started", common.FrontendServiceName)
 
 	<-s.stopC
 
+	domainFailoverDrainer.Stop()
+	clusterMetadataRefresher.Stop()
 	base.Stop()
 }
 
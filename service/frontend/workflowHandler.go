@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -106,6 +107,7 @@ var (
 	errQueryNotSet                                = &gen.BadRequestError{Message: "WorkflowQuery is not set on request."}
 	errQueryTypeNotSet                            = &gen.BadRequestError{Message: "QueryType is not set on request."}
 	errRequestNotSet                              = &gen.BadRequestError{Message: "Request is nil."}
+	errClusterNameNotSet                          = &gen.BadRequestError{Message: "Cluster name is not set on request."}
 	errNoPermission                               = &gen.BadRequestError{Message: "No permission to do this operation."}
 	errRequestIDNotSet                            = &gen.BadRequestError{Message: "RequestId is not set on request."}
 	errWorkflowTypeNotSet                         = &gen.BadRequestError{Message: "WorkflowType is not set on request."}
@@ -125,6 +127,7 @@ var (
 	errWorkflowIDTooLong   = &gen.BadRequestError{Message: "WorkflowID length exceeds limit."}
 	errSignalNameTooLong   = &gen.BadRequestError{Message: "SignalName length exceeds limit."}
 	errTaskListTooLong     = &gen.BadRequestError{Message: "TaskList length exceeds limit."}
+	errTaskListReserved    = &gen.BadRequestError{Message: "TaskList name uses a reserved prefix."}
 	errRequestIDTooLong    = &gen.BadRequestError{Message: "RequestID length exceeds limit."}
 	errIdentityTooLong     = &gen.BadRequestError{Message: "Identity length exceeds limit."}
 
@@ -135,6 +138,15 @@ var (
 	errActiveClusterNotInClusters      = &gen.BadRequestError{Message: "Active cluster is not contained in all clusters."}
 	errCannotDoDomainFailoverAndUpdate = &gen.BadRequestError{Message: "Cannot set active cluster to current cluster when other parameters are set."}
 
+	// errs for graceful domain failover
+	errInvalidFailoverTimeout             = &gen.BadRequestError{Message: "FailoverTimeoutInSeconds must be positive."}
+	errGracefulFailoverOnLocalDomain      = &gen.BadRequestError{Message: "Cannot do graceful failover on a local domain."}
+	errGracefulFailoverFromStandbyCluster = &gen.BadRequestError{Message: "Graceful failover can only be started from the active cluster of the domain."}
+	errGracefulFailoverToActiveCluster    = &gen.BadRequestError{Message: "Domain is already active in the target cluster."}
+	errGracefulFailoverWithoutTaskPull    = &gen.BadRequestError{Message: "Graceful failover requires the replication tasks to be pulled by the target cluster."}
+	errDomainFailoverAlreadyInProgress    = &gen.BadRequestError{Message: "Domain failover is already in progress."}
+	errDomainFailoverInProgress           = &gen.ServiceBusyError{Message: "Domain failover is in progress, retry after the failover completes."}
+
 	frontendServiceRetryPolicy = common.CreateFrontendServiceRetryPolicy()
 )
 
@@ -168,7 +180,11 @@ func (wh *WorkflowHandler) Start() error {
 	wh.domainCache.Start()
 
 	wh.history = wh.Service.GetClientBean().GetHistoryClient()
-	wh.matchingRawClient = wh.Service.GetClientBean().GetMatchingClient()
+	matchingRawClient, err := wh.Service.GetClientBean().GetMatchingClient(wh.domainCache.GetDomainName)
+	if err != nil {
+		return err
+	}
+	wh.matchingRawClient = matchingRawClient
 	wh.matching = matching.NewRetryableClient(wh.matchingRawClient, common.CreateMatchingServiceRetryPolicy(),
 		common.IsWhitelistServiceTransientError)
 	wh.metricsClient = wh.Service.GetMetricsClient()
@@ -363,6 +379,7 @@ func (wh *WorkflowHandler) ListDomains(ctx context.Context,
 		desc := &gen.DescribeDomainResponse{
 			IsGlobalDomain:  common.BoolPtr(d.IsGlobalDomain),
 			FailoverVersion: common.Int64Ptr(d.FailoverVersion),
+			FailoverInfo:    wh.createDomainFailoverInfo(d.ReplicationConfig),
 		}
 		desc.DomainInfo, desc.Configuration, desc.ReplicationConfiguration = wh.createDomainResponse(d.Info, d.Config, d.ReplicationConfig)
 		domains = append(domains, desc)
@@ -401,10 +418,25 @@ func (wh *WorkflowHandler) DescribeDomain(ctx context.Context,
 	response := &gen.DescribeDomainResponse{
 		IsGlobalDomain:  common.BoolPtr(resp.IsGlobalDomain),
 		FailoverVersion: common.Int64Ptr(resp.FailoverVersion),
+		FailoverInfo:    wh.createDomainFailoverInfo(resp.ReplicationConfig),
 	}
 	response.DomainInfo, response.Configuration, response.ReplicationConfiguration = wh.createDomainResponse(
 		resp.Info, resp.Config, resp.ReplicationConfig)
 
+	if response.FailoverInfo != nil {
+		// the progress of the failover is the number of replication tasks of the domain yet to be replicated,
+		// which is best effort since the history shards may not all be reachable
+		pendingTasks, err := wh.getPendingReplicationTasks(ctx, resp.Info.ID)
+		if err != nil {
+			wh.GetLogger().WithFields(bark.Fields{
+				logging.TagDomainID: resp.Info.ID,
+				logging.TagErr:      err,
+			}).Warn("Failed to get pending replication tasks of domain failover.")
+		} else {
+			response.FailoverInfo.PendingReplicationTasks = common.Int64Ptr(pendingTasks)
+		}
+	}
+
 	return response, nil
 }
 
@@ -469,6 +501,7 @@ func (wh *WorkflowHandler) UpdateDomain(ctx context.Context,
 	info := getResponse.Info
 	config := getResponse.Config
 	replicationConfig := getResponse.ReplicationConfig
+	previousActiveClusterName := replicationConfig.ActiveClusterName
 	configVersion := getResponse.ConfigVersion
 	failoverVersion := getResponse.FailoverVersion
 	failoverNotificationVersion := getResponse.FailoverNotificationVersion
@@ -614,9 +647,34 @@ func (wh *WorkflowHandler) UpdateDomain(ctx context.Context,
 		}
 	}
 
-	if configurationChanged && activeClusterChanged {
+	// whether a graceful failover is started, which only records the pending failover on this cluster,
+	// the failover itself is done by the domainFailoverDrainer once replication catches up
+	gracefulFailoverStarted := false
+	if activeClusterChanged && updateRequest.FailoverTimeoutInSeconds != nil {
+		if err := wh.validateGracefulFailover(getResponse, previousActiveClusterName,
+			replicationConfig.ActiveClusterName, updateRequest.GetFailoverTimeoutInSeconds()); err != nil {
+			return nil, wh.error(err, scope)
+		}
+		now := wh.GetTimeSource().Now()
+		timeout := time.Duration(updateRequest.GetFailoverTimeoutInSeconds()) * time.Second
+		replicationConfig.PendingActiveClusterName = replicationConfig.ActiveClusterName
+		replicationConfig.ActiveClusterName = previousActiveClusterName
+		replicationConfig.FailoverStartTime = now.UnixNano()
+		replicationConfig.FailoverExpireTime = now.Add(timeout).UnixNano()
+		replicationConfig.FailoverMarkers = nil
+		activeClusterChanged = false
+		gracefulFailoverStarted = true
+	} else if activeClusterChanged {
+		// a forced failover supersedes any graceful failover in progress
+		replicationConfig.PendingActiveClusterName = ""
+		replicationConfig.FailoverStartTime = 0
+		replicationConfig.FailoverExpireTime = 0
+		replicationConfig.FailoverMarkers = nil
+	}
+
+	if configurationChanged && (activeClusterChanged || gracefulFailoverStarted) {
 		return nil, wh.error(errCannotDoDomainFailoverAndUpdate, scope)
-	} else if configurationChanged || activeClusterChanged {
+	} else if configurationChanged || activeClusterChanged || gracefulFailoverStarted {
 		if configurationChanged && getResponse.IsGlobalDomain && !clusterMetadata.IsMasterCluster() {
 			return nil, wh.error(errNotMasterCluster, scope)
 		}
@@ -654,7 +712,8 @@ func (wh *WorkflowHandler) UpdateDomain(ctx context.Context,
 			return nil, wh.error(err, scope)
 		}
 
-		if getResponse.IsGlobalDomain {
+		// the pending graceful failover is local to the active cluster, so there is nothing to replicate
+		if getResponse.IsGlobalDomain && !gracefulFailoverStarted {
 			err = wh.domainReplicator.HandleTransmissionTask(replicator.DomainOperationUpdate,
 				info, config, replicationConfig, configVersion, failoverVersion, getResponse.IsGlobalDomain)
 			if err != nil {
@@ -670,12 +729,66 @@ func (wh *WorkflowHandler) UpdateDomain(ctx context.Context,
 	response := &gen.UpdateDomainResponse{
 		IsGlobalDomain:  common.BoolPtr(getResponse.IsGlobalDomain),
 		FailoverVersion: common.Int64Ptr(failoverVersion),
+		FailoverInfo:    wh.createDomainFailoverInfo(replicationConfig),
 	}
 	response.DomainInfo, response.Configuration, response.ReplicationConfiguration = wh.createDomainResponse(
 		info, config, replicationConfig)
 	return response, nil
 }
 
+func (wh *WorkflowHandler) validateGracefulFailover(existingDomain *persistence.GetDomainResponse,
+	activeClusterName string, targetClusterName string, failoverTimeoutInSeconds int32) error {
+
+	if failoverTimeoutInSeconds <= 0 {
+		return errInvalidFailoverTimeout
+	}
+	if !existingDomain.IsGlobalDomain {
+		return errGracefulFailoverOnLocalDomain
+	}
+	if !wh.config.EnableReplicationTaskPull() {
+		// the replication tasks published to kafka are not acknowledged by the target cluster,
+		// so the failover could only complete by timing out
+		return errGracefulFailoverWithoutTaskPull
+	}
+	if activeClusterName != wh.GetClusterMetadata().GetCurrentClusterName() {
+		return errGracefulFailoverFromStandbyCluster
+	}
+	if targetClusterName == activeClusterName {
+		return errGracefulFailoverToActiveCluster
+	}
+	if len(existingDomain.ReplicationConfig.PendingActiveClusterName) != 0 {
+		return errDomainFailoverAlreadyInProgress
+	}
+	return nil
+}
+
+// checkDomainFailoverInProgress returns errDomainFailoverInProgress if a graceful failover of the domain is
+// in progress, which stops the domain from accepting new workflows and decisions
+func (wh *WorkflowHandler) checkDomainFailoverInProgress(domainName string) error {
+	domainEntry, err := wh.domainCache.GetDomain(domainName)
+	if err != nil {
+		return err
+	}
+	if domainEntry.IsDomainFailoverInProgress() {
+		return errDomainFailoverInProgress
+	}
+	return nil
+}
+
+func (wh *WorkflowHandler) getPendingReplicationTasks(ctx context.Context, domainID string) (int64, error) {
+	resp, err := wh.history.DescribeReplicationStatus(ctx, &replicator.DescribeReplicationStatusRequest{
+		ShardIDs: allHistoryShardIDs(wh.config.NumHistoryShards),
+	})
+	if err != nil {
+		return 0, err
+	}
+	var pendingTasks int64
+	for _, shard := range resp.Shards {
+		pendingTasks += shard.PendingTasksByDomainId[domainID]
+	}
+	return pendingTasks, nil
+}
+
 func (wh *WorkflowHandler) mergeDomainData(old map[string]string, new map[string]string) map[string]string {
 	if old == nil {
 		old = map[string]string{}
@@ -860,6 +973,9 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	if err := wh.checkDomainFailoverInProgress(domainName); err != nil {
+		return nil, wh.error(err, scope)
+	}
 
 	wh.Service.GetLogger().Debugf("Poll for decision. DomainName: %v, DomainID: %v", domainName, domainID)
 
@@ -1747,6 +1863,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(err, scope)
 	}
 
+	if err := common.ValidateTaskPriority(startRequest.GetPriority()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	wh.Service.GetLogger().Debugf(
 		"Received StartWorkflowExecution. WorkflowID: %v",
 		startRequest.GetWorkflowId())
@@ -1811,6 +1931,9 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	if err := wh.checkDomainFailoverInProgress(domainName); err != nil {
+		return nil, wh.error(err, scope)
+	}
 
 	sizeLimitError := wh.config.BlobSizeLimitError(startRequest.GetDomain())
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(startRequest.GetDomain())
@@ -2142,6 +2265,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(err, scope)
 	}
 
+	if err := common.ValidateTaskPriority(signalWithStartRequest.GetPriority()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	maxDecisionTimeout := int32(wh.config.MaxDecisionStartToCloseTimeout(signalWithStartRequest.GetDomain()))
 	// TODO: remove this assignment and logging in future, so that frontend will just return bad request for large decision timeout
 	if signalWithStartRequest.GetTaskStartToCloseTimeoutSeconds() > signalWithStartRequest.GetExecutionStartToCloseTimeoutSeconds() {
@@ -2172,6 +2299,9 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	if err := wh.checkDomainFailoverInProgress(signalWithStartRequest.GetDomain()); err != nil {
+		return nil, wh.error(err, scope)
+	}
 
 	sizeLimitError := wh.config.BlobSizeLimitError(signalWithStartRequest.GetDomain())
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(signalWithStartRequest.GetDomain())
@@ -2753,6 +2883,53 @@ func (wh *WorkflowHandler) DescribeTaskList(ctx context.Context, request *gen.De
 	return response, nil
 }
 
+// ListTaskLists returns a page of the task lists of a domain along with their kind, ack level and the
+// time they were last updated.
+func (wh *WorkflowHandler) ListTaskLists(ctx context.Context, request *gen.ListTaskListsRequest) (*gen.ListTaskListsResponse, error) {
+
+	scope := metrics.FrontendListTaskListsScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if request.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+	domainID, err := wh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	listRequest := *request
+	if listRequest.GetPageSize() <= 0 {
+		listRequest.PageSize = common.Int32Ptr(100)
+	}
+
+	var response *gen.ListTaskListsResponse
+	op := func() error {
+		var err error
+		response, err = wh.matching.ListTaskLists(ctx, &m.ListTaskListsRequest{
+			DomainUUID:  common.StringPtr(domainID),
+			ListRequest: &listRequest,
+		})
+		return err
+	}
+
+	err = backoff.Retry(op, frontendServiceRetryPolicy, common.IsServiceTransientError)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return response, nil
+}
+
 func (wh *WorkflowHandler) readFullPageV2Events(req *persistence.ReadHistoryBranchRequest) ([]*gen.HistoryEvent, int, []byte, error) {
 	// NOTE: because V2 history stores events in different branch ranges due to forking operation, each ReadHistoryBranch cannot guarantee to return pageSize
 	historyEvents := []*gen.HistoryEvent{}
@@ -2912,6 +3089,9 @@ func (wh *WorkflowHandler) validateTaskList(t *gen.TaskList, scope int) error {
 	if len(t.GetName()) > wh.config.MaxIDLengthLimit() {
 		return wh.error(errTaskListTooLong, scope)
 	}
+	if strings.HasPrefix(t.GetName(), common.ReservedTaskListPrefix) {
+		return wh.error(errTaskListReserved, scope)
+	}
 	return nil
 }
 
@@ -2952,6 +3132,17 @@ func getDomainStatus(info *persistence.DomainInfo) *gen.DomainStatus {
 	return nil
 }
 
+func (wh *WorkflowHandler) createDomainFailoverInfo(replicationConfig *persistence.DomainReplicationConfig) *gen.DomainFailoverInfo {
+	if len(replicationConfig.PendingActiveClusterName) == 0 {
+		return nil
+	}
+	return &gen.DomainFailoverInfo{
+		PendingActiveClusterName: common.StringPtr(replicationConfig.PendingActiveClusterName),
+		StartTimestamp:           common.Int64Ptr(replicationConfig.FailoverStartTime),
+		ExpireTimestamp:          common.Int64Ptr(replicationConfig.FailoverExpireTime),
+	}
+}
+
 func (wh *WorkflowHandler) createDomainResponse(info *persistence.DomainInfo, config *persistence.DomainConfig,
 	replicationConfig *persistence.DomainReplicationConfig) (*gen.DomainInfo,
 	*gen.DomainConfiguration, *gen.DomainReplicationConfiguration) {
@@ -66,6 +66,10 @@ type (
 	}
 )
 
+const (
+	numHistoryShards = 10
+)
+
 func TestWorkflowHandlerSuite(t *testing.T) {
 	s := new(workflowHandlerSuite)
 	suite.Run(t, s)
@@ -176,7 +180,7 @@ func (s *workflowHandlerSuite) TestMergeDomainData_Nil() {
 func (s *workflowHandlerSuite) TestDisableListVisibilityByFilter() {
 	domain := "test-domain"
 	domainID := uuid.New()
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	config.DisableListVisibilityByFilter = dc.GetBoolPropertyFnFilteredByDomain(true)
 
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr,
@@ -246,7 +250,7 @@ func (s *workflowHandlerSuite) TestDisableListVisibilityByFilter() {
 }
 
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_RequestIdNotSet() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	config.RPS = dc.GetIntPropertyFn(10)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr,
 		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
@@ -278,7 +282,7 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_RequestIdNotSet
 }
 
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_StartRequestNotSet() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	config.RPS = dc.GetIntPropertyFn(10)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr,
 		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
@@ -291,7 +295,7 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_StartRequestNot
 }
 
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_DomainNotSet() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	config.RPS = dc.GetIntPropertyFn(10)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr,
 		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
@@ -323,7 +327,7 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_DomainNotSet()
 }
 
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_WorkflowIdNotSet() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	config.RPS = dc.GetIntPropertyFn(10)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
 		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
@@ -355,7 +359,7 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_WorkflowIdNotSe
 }
 
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_WorkflowTypeNotSet() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	config.RPS = dc.GetIntPropertyFn(10)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
 		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
@@ -388,7 +392,7 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_WorkflowTypeNot
 }
 
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_TaskListNotSet() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	config.RPS = dc.GetIntPropertyFn(10)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
 		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
@@ -421,7 +425,7 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_TaskListNotSet(
 }
 
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidExecutionStartToCloseTimeout() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	config.RPS = dc.GetIntPropertyFn(10)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
 		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
@@ -454,7 +458,7 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidExecutio
 }
 
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidTaskStartToCloseTimeout() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	config.RPS = dc.GetIntPropertyFn(10)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
 		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
@@ -487,7 +491,7 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidTaskStar
 }
 
 func (s *workflowHandlerSuite) TestRegisterDomain_Failed_CustomBucketGivenButArchivalNotEnabled() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
 		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
 	wh.metricsClient = wh.Service.GetMetricsClient()
@@ -501,7 +505,7 @@ func (s *workflowHandlerSuite) TestRegisterDomain_Failed_CustomBucketGivenButArc
 }
 
 func (s *workflowHandlerSuite) TestRegisterDomain_Success_CustomBucketAndArchivalEnabled() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	clusterMetadata := &mocks.ClusterMetadata{}
 	clusterMetadata.On("IsGlobalDomainEnabled").Return(false)
 	clusterMetadata.On("GetCurrentClusterName").Return("active")
@@ -526,7 +530,7 @@ func (s *workflowHandlerSuite) TestRegisterDomain_Success_CustomBucketAndArchiva
 }
 
 func (s *workflowHandlerSuite) TestRegisterDomain_Success_ArchivalEnabledWithoutCustomBucket() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	clusterMetadata := &mocks.ClusterMetadata{}
 	clusterMetadata.On("IsGlobalDomainEnabled").Return(false)
 	clusterMetadata.On("GetCurrentClusterName").Return("active")
@@ -552,7 +556,7 @@ func (s *workflowHandlerSuite) TestRegisterDomain_Success_ArchivalEnabledWithout
 }
 
 func (s *workflowHandlerSuite) TestRegisterDomain_Success_ArchivalNotEnabled() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	clusterMetadata := &mocks.ClusterMetadata{}
 	clusterMetadata.On("IsGlobalDomainEnabled").Return(false)
 	clusterMetadata.On("GetCurrentClusterName").Return("active")
@@ -577,7 +581,7 @@ func (s *workflowHandlerSuite) TestRegisterDomain_Success_ArchivalNotEnabled() {
 }
 
 func (s *workflowHandlerSuite) TestDescribeDomain_Success_ArchivalNeverEnabled() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	mMetadataManager := &mocks.MetadataManager{}
 	mMetadataManager.On("GetDomain", mock.Anything).Return(persistenceGetDomainResponse("", shared.ArchivalStatusNeverEnabled), nil)
 	mBlobstore := &mocks.Client{}
@@ -601,8 +605,34 @@ func (s *workflowHandlerSuite) TestDescribeDomain_Success_ArchivalNeverEnabled()
 	mBlobstore.AssertNotCalled(s.T(), "BucketMetadata", mock.Anything, mock.Anything)
 }
 
+func (s *workflowHandlerSuite) TestDescribeDomain_Success_PendingReplicationTasksUnavailable() {
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
+	domain := persistenceGetDomainResponse("", shared.ArchivalStatusNeverEnabled)
+	domain.IsGlobalDomain = true
+	domain.ReplicationConfig.PendingActiveClusterName = "standby"
+	mMetadataManager := &mocks.MetadataManager{}
+	mMetadataManager.On("GetDomain", mock.Anything).Return(domain, nil)
+	mHistoryClient := &mocks.HistoryClient{}
+	mHistoryClient.On("DescribeReplicationStatus", mock.Anything, mock.Anything).
+		Return(nil, &shared.InternalServiceError{Message: "shard unavailable"})
+	wh := NewWorkflowHandler(s.mockService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+	wh.history = mHistoryClient
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	result, err := wh.DescribeDomain(context.Background(), &shared.DescribeDomainRequest{
+		Name: common.StringPtr("test-domain"),
+	})
+
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), result.FailoverInfo)
+	assert.Equal(s.T(), "standby", result.FailoverInfo.GetPendingActiveClusterName())
+	assert.Nil(s.T(), result.FailoverInfo.PendingReplicationTasks)
+}
+
 func (s *workflowHandlerSuite) TestDescribeDomain_Success_ArchivalEnabled() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	mMetadataManager := &mocks.MetadataManager{}
 	mMetadataManager.On("GetDomain", mock.Anything).Return(persistenceGetDomainResponse("bucket-name", shared.ArchivalStatusEnabled), nil)
 	mBlobstore := &mocks.Client{}
@@ -628,7 +658,7 @@ func (s *workflowHandlerSuite) TestDescribeDomain_Success_ArchivalEnabled() {
 }
 
 func (s *workflowHandlerSuite) TestDescribeDomain_Success_ArchivalDisabled() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	mMetadataManager := &mocks.MetadataManager{}
 	mMetadataManager.On("GetDomain", mock.Anything).Return(persistenceGetDomainResponse("bucket-name", shared.ArchivalStatusDisabled), nil)
 	mBlobstore := &mocks.Client{}
@@ -654,7 +684,7 @@ func (s *workflowHandlerSuite) TestDescribeDomain_Success_ArchivalDisabled() {
 }
 
 func (s *workflowHandlerSuite) TestDescribeDomain_Success_BlobstoreReturnsError() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	mMetadataManager := &mocks.MetadataManager{}
 	mMetadataManager.On("GetDomain", mock.Anything).Return(persistenceGetDomainResponse("bucket-name", shared.ArchivalStatusDisabled), nil)
 	mBlobstore := &mocks.Client{}
@@ -680,7 +710,7 @@ func (s *workflowHandlerSuite) TestDescribeDomain_Success_BlobstoreReturnsError(
 }
 
 func (s *workflowHandlerSuite) TestUpdateDomain_Failure_StatusChangeToNeverEnabled() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
 		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
 	wh.metricsClient = wh.Service.GetMetricsClient()
@@ -692,7 +722,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Failure_StatusChangeToNeverEnabl
 }
 
 func (s *workflowHandlerSuite) TestUpdateDomain_Failure_IllegalBucketOwnerUpdate() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
 		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
 	wh.metricsClient = wh.Service.GetMetricsClient()
@@ -704,7 +734,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Failure_IllegalBucketOwnerUpdate
 }
 
 func (s *workflowHandlerSuite) TestUpdateDomain_Failure_IllegalArchivalRetentionUpdate() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
 		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
 	wh.metricsClient = wh.Service.GetMetricsClient()
@@ -716,7 +746,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Failure_IllegalArchivalRetention
 }
 
 func (s *workflowHandlerSuite) TestUpdateDomain_Failure_ProvidedBucketWithoutEnabling() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	wh := NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
 		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
 	wh.metricsClient = wh.Service.GetMetricsClient()
@@ -728,7 +758,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Failure_ProvidedBucketWithoutEna
 }
 
 func (s *workflowHandlerSuite) TestUpdateDomain_Failure_UpdateExistingBucketName() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	mMetadataManager := &mocks.MetadataManager{}
 	mMetadataManager.On("GetMetadata").Return(&persistence.GetMetadataResponse{
 		NotificationVersion: int64(0),
@@ -749,7 +779,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Failure_UpdateExistingBucketName
 }
 
 func (s *workflowHandlerSuite) TestUpdateDomain_Failure_ArchivalEnabledToArchivalEnabled() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	mMetadataManager := &mocks.MetadataManager{}
 	mMetadataManager.On("GetMetadata").Return(&persistence.GetMetadataResponse{
 		NotificationVersion: int64(0),
@@ -770,7 +800,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Failure_ArchivalEnabledToArchiva
 }
 
 func (s *workflowHandlerSuite) TestUpdateDomain_Failure_ArchivalDisabledToArchivalDisabled() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	mMetadataManager := &mocks.MetadataManager{}
 	mMetadataManager.On("GetMetadata").Return(&persistence.GetMetadataResponse{
 		NotificationVersion: int64(0),
@@ -791,7 +821,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Failure_ArchivalDisabledToArchiv
 }
 
 func (s *workflowHandlerSuite) TestUpdateDomain_Failure_ArchivalNeverEnabledToArchivalDisabled() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	mMetadataManager := &mocks.MetadataManager{}
 	mMetadataManager.On("GetMetadata").Return(&persistence.GetMetadataResponse{
 		NotificationVersion: int64(0),
@@ -812,7 +842,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Failure_ArchivalNeverEnabledToAr
 }
 
 func (s *workflowHandlerSuite) TestUpdateDomain_Success_ArchivalEnabledToArchivalDisabled() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	mMetadataManager := &mocks.MetadataManager{}
 	mMetadataManager.On("GetMetadata").Return(&persistence.GetMetadataResponse{
 		NotificationVersion: int64(0),
@@ -840,7 +870,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Success_ArchivalEnabledToArchiva
 }
 
 func (s *workflowHandlerSuite) TestUpdateDomain_Success_ArchivalDisabledToArchivalEnabled() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	mMetadataManager := &mocks.MetadataManager{}
 	mMetadataManager.On("GetMetadata").Return(&persistence.GetMetadataResponse{
 		NotificationVersion: int64(0),
@@ -868,7 +898,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Success_ArchivalDisabledToArchiv
 }
 
 func (s *workflowHandlerSuite) TestUpdateDomain_Success_ArchivalNeverEnabledToEnabledWithoutCustomBucket() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	mMetadataManager := &mocks.MetadataManager{}
 	mMetadataManager.On("GetMetadata").Return(&persistence.GetMetadataResponse{
 		NotificationVersion: int64(0),
@@ -897,7 +927,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Success_ArchivalNeverEnabledToEn
 }
 
 func (s *workflowHandlerSuite) TestUpdateDomain_Success_ArchivalNeverEnabledToEnabledWithCustomBucket() {
-	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger))
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
 	mMetadataManager := &mocks.MetadataManager{}
 	mMetadataManager.On("GetMetadata").Return(&persistence.GetMetadataResponse{
 		NotificationVersion: int64(0),
@@ -925,6 +955,30 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Success_ArchivalNeverEnabledToEn
 	clusterMetadata.AssertNotCalled(s.T(), "GetDefaultArchivalBucket")
 }
 
+func (s *workflowHandlerSuite) TestValidateGracefulFailover() {
+	config := NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards)
+	clusterMetadata := &mocks.ClusterMetadata{}
+	clusterMetadata.On("GetCurrentClusterName").Return("active")
+	mService := cs.NewTestService(clusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean, s.logger)
+	wh := NewWorkflowHandler(mService, config, s.mockMetadataMgr, s.mockHistoryMgr, s.mockHistoryV2Mgr,
+		s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
+
+	domain := persistenceGetDomainResponse("", shared.ArchivalStatusNeverEnabled)
+	assert.Equal(s.T(), errGracefulFailoverOnLocalDomain, wh.validateGracefulFailover(domain, "active", "standby", 60))
+
+	domain.IsGlobalDomain = true
+	assert.Equal(s.T(), errGracefulFailoverWithoutTaskPull, wh.validateGracefulFailover(domain, "active", "standby", 60))
+
+	config.EnableReplicationTaskPull = dc.GetBoolPropertyFn(true)
+	assert.NoError(s.T(), wh.validateGracefulFailover(domain, "active", "standby", 60))
+	assert.Equal(s.T(), errInvalidFailoverTimeout, wh.validateGracefulFailover(domain, "active", "standby", 0))
+	assert.Equal(s.T(), errGracefulFailoverFromStandbyCluster, wh.validateGracefulFailover(domain, "standby", "active", 60))
+	assert.Equal(s.T(), errGracefulFailoverToActiveCluster, wh.validateGracefulFailover(domain, "active", "active", 60))
+
+	domain.ReplicationConfig.PendingActiveClusterName = "standby"
+	assert.Equal(s.T(), errDomainFailoverAlreadyInProgress, wh.validateGracefulFailover(domain, "active", "standby", 60))
+}
+
 func bucketMetadataResponse(owner string, retentionDays int) *blobstore.BucketMetadataResponse {
 	return &blobstore.BucketMetadataResponse{
 		Owner:         owner,
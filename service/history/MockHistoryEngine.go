@@ -25,6 +25,7 @@ import (
 
 	"github.com/stretchr/testify/mock"
 	gohistory "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 )
 
@@ -493,4 +494,50 @@ func (_m *MockHistoryEngine) SyncActivity(ctx context.Context, request *gohistor
 	return r0
 }
 
+// GetReplicationMessages is mock implementation for GetReplicationMessages of HistoryEngine
+func (_m *MockHistoryEngine) GetReplicationMessages(ctx context.Context, pollingCluster string, lastProcessedTaskID int64) (*replicator.ReplicationMessages, error) {
+	ret := _m.Called(pollingCluster, lastProcessedTaskID)
+
+	var r0 *replicator.ReplicationMessages
+	if rf, ok := ret.Get(0).(func(string, int64) *replicator.ReplicationMessages); ok {
+		r0 = rf(pollingCluster, lastProcessedTaskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.ReplicationMessages)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(pollingCluster, lastProcessedTaskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeReplicationStatus is mock implementation for DescribeReplicationStatus of HistoryEngine
+func (_m *MockHistoryEngine) DescribeReplicationStatus(ctx context.Context) (*replicator.ShardReplicationStatus, error) {
+	ret := _m.Called()
+
+	var r0 *replicator.ShardReplicationStatus
+	if rf, ok := ret.Get(0).(func() *replicator.ShardReplicationStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicator.ShardReplicationStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 var _ Engine = (*MockHistoryEngine)(nil)
@@ -33,6 +33,7 @@ import (
 	"github.com/uber/cadence/.gen/go/health/metaserver"
 	hist "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/history/historyserviceserver"
+	"github.com/uber/cadence/.gen/go/replicator"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/frontend"
 	hc "github.com/uber/cadence/client/history"
@@ -84,6 +85,7 @@ var (
 	errWorkflowIDNotSet        = &gen.BadRequestError{Message: "WorkflowId is not set on request."}
 	errRunIDNotValid           = &gen.BadRequestError{Message: "RunID is not valid UUID."}
 	errSourceClusterNotSet     = &gen.BadRequestError{Message: "Source Cluster not set on request."}
+	errClusterNameNotSet       = &gen.BadRequestError{Message: "Cluster name not set on request."}
 	errShardIDNotSet           = &gen.BadRequestError{Message: "Shard ID not set on request."}
 	errTimestampNotSet         = &gen.BadRequestError{Message: "Timestamp not set on request."}
 	errHistoryHostThrottle     = &gen.ServiceBusyError{Message: "History host rps exceeded"}
@@ -118,8 +120,15 @@ func (h *Handler) Start() error {
 	h.Service.GetDispatcher().Register(metaserver.New(h))
 	h.Service.Start()
 
+	h.domainCache = cache.NewDomainCache(h.metadataMgr, h.GetClusterMetadata(), h.GetMetricsClient(), h.GetLogger())
+	h.domainCache.Start()
+
+	matchingRawClient, err := h.Service.GetClientBean().GetMatchingClient(h.domainCache.GetDomainName)
+	if err != nil {
+		h.GetLogger().Fatalf("Creating matching client failed: %v", err)
+	}
 	h.matchingServiceClient = matching.NewRetryableClient(
-		h.Service.GetClientBean().GetMatchingClient(),
+		matchingRawClient,
 		common.CreateMatchingServiceRetryPolicy(),
 		common.IsWhitelistServiceTransientError,
 	)
@@ -143,20 +152,18 @@ func (h *Handler) Start() error {
 	h.hServiceResolver = hServiceResolver
 
 	// TODO when global domain is enabled, uncomment the line below and remove the line after
-	if h.GetClusterMetadata().IsGlobalDomainEnabled() {
-		var err error
+	// when replication tasks are pulled by remote clusters, there is nothing to publish
+	if h.GetClusterMetadata().IsGlobalDomainEnabled() && !h.config.EnableReplicationTaskPull() {
 		h.publisher, err = h.GetMessagingClient().NewProducerWithClusterName(h.GetClusterMetadata().GetCurrentClusterName())
 		if err != nil {
 			h.GetLogger().Fatalf("Creating kafka producer failed: %v", err)
 		}
 	}
 
-	h.domainCache = cache.NewDomainCache(h.metadataMgr, h.GetClusterMetadata(), h.GetMetricsClient(), h.GetLogger())
-	h.domainCache.Start()
 	h.controller = newShardController(h.Service, h.GetHostInfo(), hServiceResolver, h.shardManager, h.historyMgr, h.historyV2Mgr,
 		h.domainCache, h.executionMgrFactory, h, h.config, h.GetLogger(), h.GetMetricsClient())
 	h.metricsClient = h.GetMetricsClient()
-	h.historyEventNotifier = newHistoryEventNotifier(h.GetMetricsClient(), h.config.GetShardID)
+	h.historyEventNotifier = newHistoryEventNotifier(h.GetTimeSource(), h.GetMetricsClient(), h.config.GetShardID)
 	// events notifier must starts before controller
 	h.historyEventNotifier.Start()
 	h.controller.Start()
@@ -1199,6 +1206,76 @@ func (h *Handler) SyncActivity(ctx context.Context, syncActivityRequest *hist.Sy
 	return nil
 }
 
+// GetReplicationMessages is called by remote clusters, through the admin API, to pull replication tasks
+func (h *Handler) GetReplicationMessages(ctx context.Context,
+	request *replicator.GetReplicationMessagesRequest) (*replicator.GetReplicationMessagesResponse, error) {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryGetReplicationMessagesScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	if request.ClusterName == nil {
+		return nil, h.error(errClusterNameNotSet, scope, "", "")
+	}
+
+	messagesByShard := make(map[int32]*replicator.ReplicationMessages)
+	for _, token := range request.Tokens {
+		if token.ShardID == nil {
+			return nil, h.error(errShardIDNotSet, scope, "", "")
+		}
+
+		engine, err := h.controller.getEngineForShard(int(token.GetShardID()))
+		if err != nil {
+			return nil, h.error(err, scope, "", "")
+		}
+
+		messages, err := engine.GetReplicationMessages(ctx, request.GetClusterName(), token.GetLastProcessedMessageId())
+		if err != nil {
+			return nil, h.error(err, scope, "", "")
+		}
+		messagesByShard[token.GetShardID()] = messages
+	}
+
+	return &replicator.GetReplicationMessagesResponse{MessagesByShard: messagesByShard}, nil
+}
+
+// DescribeReplicationStatus returns the replication status of the requested shards owned by this host
+func (h *Handler) DescribeReplicationStatus(ctx context.Context,
+	request *replicator.DescribeReplicationStatusRequest) (*replicator.DescribeReplicationStatusResponse, error) {
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDescribeReplicationStatusScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	shards := []*replicator.ShardReplicationStatus{}
+	for _, shardID := range request.ShardIDs {
+		engine, err := h.controller.getEngineForShard(int(shardID))
+		if err != nil {
+			return nil, h.error(err, scope, "", "")
+		}
+
+		status, err := engine.DescribeReplicationStatus(ctx)
+		if err != nil {
+			return nil, h.error(err, scope, "", "")
+		}
+		shards = append(shards, status)
+	}
+
+	return &replicator.DescribeReplicationStatusResponse{Shards: shards}, nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
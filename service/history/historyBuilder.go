@@ -466,6 +466,7 @@ func (b *historyBuilder) newWorkflowExecutionStartedEvent(
 	attributes.ContinuedFailureDetails = startRequest.ContinuedFailureDetails
 	attributes.Initiator = startRequest.ContinueAsNewInitiator
 	attributes.FirstDecisionTaskBackoffSeconds = startRequest.FirstDecisionTaskBackoffSeconds
+	attributes.Priority = request.Priority
 
 	parentInfo := startRequest.ParentExecutionInfo
 	if parentInfo != nil {
@@ -551,6 +552,7 @@ func (b *historyBuilder) newActivityTaskScheduledEvent(decisionTaskCompletedEven
 	attributes.HeartbeatTimeoutSeconds = common.Int32Ptr(common.Int32Default(scheduleAttributes.HeartbeatTimeoutSeconds))
 	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
 	attributes.RetryPolicy = scheduleAttributes.RetryPolicy
+	attributes.Priority = scheduleAttributes.Priority
 	historyEvent.ActivityTaskScheduledEventAttributes = attributes
 
 	return historyEvent
@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/uber/cadence/service/worker/sysworkflow"
@@ -32,6 +33,7 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/uber-common/bark"
 	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/frontend"
 	hc "github.com/uber/cadence/client/history"
@@ -53,6 +55,7 @@ const (
 	activityCancelationMsgActivityIDUnknown  = "ACTIVITY_ID_UNKNOWN"
 	activityCancelationMsgActivityNotStarted = "ACTIVITY_ID_NOT_STARTED"
 	timerCancelationMsgTimerIDUnknown        = "TIMER_ID_UNKNOWN"
+	replicationStatusMaxScannedTasks         = 10000
 )
 
 type (
@@ -66,7 +69,7 @@ type (
 		timerProcessor       timerQueueProcessor
 		taskAllocator        taskAllocator
 		replicator           *historyReplicator
-		replicatorProcessor  queueProcessor
+		replicatorProcessor  replicatorQueueProcessor
 		historyEventNotifier historyEventNotifier
 		tokenSerializer      common.TaskTokenSerializer
 		historyCache         *historyCache
@@ -75,6 +78,13 @@ type (
 		config               *Config
 		archivalClient       sysworkflow.ArchivalClient
 		resetor              *workflowResetor
+
+		// when set, remote clusters pull replication tasks instead of the processor publishing them
+		pullReplicationTasks bool
+		// replicationTaskPullers has a puller per enabled remote cluster, it is updated when clusters change
+		pullersLock            sync.Mutex
+		pullersStarted         bool
+		replicationTaskPullers map[string]*replicationTaskPuller
 	}
 
 	// shardContextWrapper wraps ShardContext to notify transferQueueProcessor on new tasks.
@@ -95,6 +105,8 @@ var (
 	ErrTaskDiscarded = errors.New("passive task pending for too long")
 	// ErrTaskRetry is the error indicating that the timer / transfer task should be retried.
 	ErrTaskRetry = errors.New("passive task should retry due to condition in mutable state is not met")
+	// ErrTaskHeld is the error indicating that the replication task is held because the replication of its domain is paused or throttled
+	ErrTaskHeld = errors.New("replication task is held due to paused or throttled replication")
 	// ErrDuplicate is exported temporarily for integration test
 	ErrDuplicate = errors.New("Duplicate task, completing it")
 	// ErrConflict is exported temporarily for integration test
@@ -119,6 +131,8 @@ var (
 	ErrBufferedEventsLimitExceeded = &workflow.LimitExceededError{Message: "Exceeded workflow execution limit for buffered events"}
 	// ErrSignalsLimitExceeded is the error indicating limit reached for maximum number of signal events
 	ErrSignalsLimitExceeded = &workflow.LimitExceededError{Message: "Exceeded workflow execution limit for signal events"}
+	// ErrReplicationTaskPullDisabled is the error indicating that remote clusters cannot pull replication tasks from this cluster
+	ErrReplicationTaskPullDisabled = &workflow.BadRequestError{Message: "Replication task pull is not enabled."}
 	// FailedWorkflowCloseState is a set of failed workflow close states, used for start workflow policy
 	// for start workflow execution API
 	FailedWorkflowCloseState = map[int]bool{
@@ -178,14 +192,16 @@ func NewEngineWithShardContext(
 	historyEngImpl.txProcessor = txProcessor
 	shardWrapper.txProcessor = txProcessor
 
-	// Only start the replicator processor if valid publisher is passed in
-	if publisher != nil {
+	// Only start the replicator processor if valid publisher is passed in, or if remote clusters pull replication tasks
+	historyEngImpl.pullReplicationTasks = config.EnableReplicationTaskPull()
+	if publisher != nil || historyEngImpl.pullReplicationTasks {
 		replicatorProcessor := newReplicatorQueueProcessor(shard, historyEngImpl.historyCache, publisher, executionManager, historyManager, historyV2Manager, logger)
 		historyEngImpl.replicatorProcessor = replicatorProcessor
 		shardWrapper.replcatorProcessor = replicatorProcessor
 		historyEngImpl.replicator = newHistoryReplicator(shard, historyEngImpl, historyCache, shard.GetDomainCache(), historyManager, historyV2Manager,
 			logger)
 	}
+	historyEngImpl.replicationTaskPullers = make(map[string]*replicationTaskPuller)
 	historyEngImpl.resetor = newWorkflowResetor(historyEngImpl)
 
 	return historyEngImpl
@@ -202,9 +218,12 @@ func (e *historyEngineImpl) Start() {
 
 	e.txProcessor.Start()
 	e.timerProcessor.Start()
-	if e.replicatorProcessor != nil {
+	if e.replicatorProcessor != nil && !e.pullReplicationTasks {
 		e.replicatorProcessor.Start()
 	}
+	if e.pullReplicationTasks {
+		e.startReplicationTaskPullers()
+	}
 }
 
 // Stop the service.
@@ -217,11 +236,70 @@ func (e *historyEngineImpl) Stop() {
 	if e.replicatorProcessor != nil {
 		e.replicatorProcessor.Stop()
 	}
+	if e.pullReplicationTasks {
+		e.stopReplicationTaskPullers()
+	}
 
 	// unset the failover callback
 	e.shard.GetDomainCache().UnregisterDomainChangeCallback(e.shard.GetShardID())
 }
 
+// startReplicationTaskPullers starts a puller per remote cluster and keeps them in line with the
+// enabled clusters, so the pullers of a disabled cluster stop instead of looking up its client
+func (e *historyEngineImpl) startReplicationTaskPullers() {
+	e.pullersLock.Lock()
+	e.pullersStarted = true
+	e.pullersLock.Unlock()
+
+	e.updateReplicationTaskPullers()
+	e.shard.GetService().GetClusterMetadata().RegisterClusterChangeCallback(
+		e.clusterChangeCallbackID(),
+		e.updateReplicationTaskPullers,
+	)
+}
+
+func (e *historyEngineImpl) stopReplicationTaskPullers() {
+	e.shard.GetService().GetClusterMetadata().UnregisterClusterChangeCallback(e.clusterChangeCallbackID())
+
+	e.pullersLock.Lock()
+	defer e.pullersLock.Unlock()
+	e.pullersStarted = false
+	for clusterName, puller := range e.replicationTaskPullers {
+		puller.Stop()
+		delete(e.replicationTaskPullers, clusterName)
+	}
+}
+
+// updateReplicationTaskPullers starts a puller for each remote cluster which does not have one yet,
+// and stops the pullers of remote clusters which are no longer enabled
+func (e *historyEngineImpl) updateReplicationTaskPullers() {
+	e.pullersLock.Lock()
+	defer e.pullersLock.Unlock()
+	if !e.pullersStarted {
+		return
+	}
+
+	clusters := e.shard.GetService().GetClusterMetadata().GetAllClusterFailoverVersions()
+	for clusterName, puller := range e.replicationTaskPullers {
+		if _, ok := clusters[clusterName]; !ok {
+			puller.Stop()
+			delete(e.replicationTaskPullers, clusterName)
+		}
+	}
+	for clusterName := range clusters {
+		if _, ok := e.replicationTaskPullers[clusterName]; ok || clusterName == e.currentClusterName {
+			continue
+		}
+		puller := newReplicationTaskPuller(clusterName, e.shard, e, e.config, e.logger)
+		puller.Start()
+		e.replicationTaskPullers[clusterName] = puller
+	}
+}
+
+func (e *historyEngineImpl) clusterChangeCallbackID() string {
+	return fmt.Sprintf("history-replication-task-puller-%v", e.shard.GetShardID())
+}
+
 func (e *historyEngineImpl) registerDomainFailoverCallback() {
 
 	failoverPredicate := func(shardNotificationVersion int64, nextDomain *cache.DomainCacheEntry, action func()) {
@@ -291,6 +369,7 @@ func (e *historyEngineImpl) createMutableState(clusterMetadata cluster.Metadata,
 		msBuilder = newMutableStateBuilderWithReplicationState(
 			clusterMetadata.GetCurrentClusterName(),
 			e.shard.GetConfig(),
+			e.shard.GetTimeSource(),
 			e.logger,
 			domainEntry.GetFailoverVersion(),
 		)
@@ -298,6 +377,7 @@ func (e *historyEngineImpl) createMutableState(clusterMetadata cluster.Metadata,
 		msBuilder = newMutableStateBuilder(
 			clusterMetadata.GetCurrentClusterName(),
 			e.shard.GetConfig(),
+			e.shard.GetTimeSource(),
 			e.logger,
 		)
 	}
@@ -441,6 +521,7 @@ func (e *historyEngineImpl) createWorkflow(startRequest *h.StartWorkflowExecutio
 		BranchToken:                 msBuilder.GetCurrentBranch(),
 		CreateWorkflowMode:          createMode,
 		CronSchedule:                request.GetCronSchedule(),
+		Priority:                    request.GetPriority(),
 	}
 
 	if createRequest.HasRetryPolicy {
@@ -514,7 +595,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(ctx context.Context, startReq
 	// generate first replication task
 	replicationTasks := generateFirstReplicationTask(msBuilder, clusterMetadata, domainEntry)
 	// set versions and timestamp for timer and transfer tasks
-	setTaskInfo(msBuilder.GetCurrentVersion(), time.Now(), transferTasks, timerTasks)
+	setTaskInfo(msBuilder.GetCurrentVersion(), e.shard.GetTimeSource().Now(), transferTasks, timerTasks)
 
 	needDeleteHistory := true
 	historySize, retError := e.appendFirstBatchHistoryEvents(msBuilder, domainID, execution)
@@ -818,6 +899,7 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(ctx context.Context,
 		for _, ai := range msBuilder.GetPendingActivityInfos() {
 			p := &workflow.PendingActivityInfo{
 				ActivityID: common.StringPtr(ai.ActivityID),
+				ScheduleID: common.Int64Ptr(ai.ScheduleID),
 			}
 			state := workflow.PendingActivityStateScheduled
 			if ai.CancelRequested {
@@ -2359,7 +2441,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 	// first replication task
 	replicationTasks := generateFirstReplicationTask(msBuilder, clusterMetadata, domainEntry)
 	// set versions and timestamp for timer and transfer tasks
-	setTaskInfo(msBuilder.GetCurrentVersion(), time.Now(), transferTasks, timerTasks)
+	setTaskInfo(msBuilder.GetCurrentVersion(), e.shard.GetTimeSource().Now(), transferTasks, timerTasks)
 
 	needDeleteHistory := true
 	historySize, retError := e.appendFirstBatchHistoryEvents(msBuilder, domainID, execution)
@@ -2555,6 +2637,7 @@ func (e *historyEngineImpl) SyncShardStatus(ctx context.Context, request *h.Sync
 	// 2. notify the timer gate in the timer queue standby processor
 	// 3, notify the transfer (essentially a no op, just put it here so it looks symmetric)
 	e.shard.SetCurrentTime(clusterName, now)
+	e.metricsClient.RecordTimer(metrics.HistorySyncShardStatusScope, metrics.ReplicationShardTimeLag, e.shard.GetTimeSource().Now().Sub(now))
 	e.txProcessor.NotifyNewTask(clusterName, []persistence.Task{})
 	e.timerProcessor.NotifyNewTimers(clusterName, now, []persistence.Task{})
 	return nil
@@ -2564,6 +2647,53 @@ func (e *historyEngineImpl) SyncActivity(ctx context.Context, request *h.SyncAct
 	return e.replicator.SyncActivity(ctx, request)
 }
 
+func (e *historyEngineImpl) GetReplicationMessages(ctx context.Context, pollingCluster string,
+	lastProcessedTaskID int64) (*replicator.ReplicationMessages, error) {
+	if !e.pullReplicationTasks {
+		return nil, ErrReplicationTaskPullDisabled
+	}
+	return e.replicatorProcessor.getTasks(pollingCluster, lastProcessedTaskID)
+}
+
+func (e *historyEngineImpl) DescribeReplicationStatus(ctx context.Context) (*replicator.ShardReplicationStatus, error) {
+	status := &replicator.ShardReplicationStatus{
+		ShardID:                        common.Int32Ptr(int32(e.shard.GetShardID())),
+		MaxReplicationTaskId:           common.Int64Ptr(e.shard.GetTransferMaxReadLevel()),
+		ReplicatorAckLevel:             common.Int64Ptr(e.shard.GetReplicatorAckLevel()),
+		SourceClusterReplicationLevels: make(map[string]int64),
+		RemoteClusterShardTimes:        make(map[string]int64),
+	}
+	for clusterName := range e.shard.GetService().GetClusterMetadata().GetAllClusterFailoverVersions() {
+		if clusterName == e.currentClusterName {
+			continue
+		}
+		status.SourceClusterReplicationLevels[clusterName] = e.shard.GetClusterReplicationLevel(clusterName)
+		status.RemoteClusterShardTimes[clusterName] = e.shard.GetCurrentTime(clusterName).UnixNano()
+	}
+
+	if e.replicatorProcessor == nil {
+		return status, nil
+	}
+	if e.pullReplicationTasks {
+		status.RemoteClusterAckLevels = e.replicatorProcessor.getRemoteAckLevels()
+	}
+	pendingTasks, truncated, err := e.replicatorProcessor.getPendingTasksByDomain(replicationStatusMaxScannedTasks)
+	if err != nil {
+		return nil, err
+	}
+	status.PendingTasksByDomainId = pendingTasks
+	status.PendingTasksTruncated = common.BoolPtr(truncated)
+	status.HeldTasksByDomainId = e.replicatorProcessor.getHeldTasksByDomain()
+
+	totalPendingTasks := int64(0)
+	for _, count := range pendingTasks {
+		totalPendingTasks += count
+	}
+	e.metricsClient.UpdateGauge(metrics.HistoryDescribeReplicationStatusScope, metrics.ReplicationPendingTasks,
+		float64(totalPendingTasks))
+	return status, nil
+}
+
 func (e *historyEngineImpl) ResetWorkflowExecution(ctx context.Context, resetRequest *h.ResetWorkflowExecutionRequest) (response *workflow.ResetWorkflowExecutionResponse, retError error) {
 	return e.resetor.ResetWorkflowExecution(ctx, resetRequest)
 }
@@ -2777,7 +2907,7 @@ func (e *historyEngineImpl) getTimerBuilder(we *workflow.WorkflowExecution) *tim
 		logging.TagWorkflowExecutionID: we.WorkflowId,
 		logging.TagWorkflowRunID:       we.RunId,
 	})
-	return newTimerBuilder(e.shard.GetConfig(), lg, common.NewRealTimeSource())
+	return newTimerBuilder(e.shard.GetConfig(), lg, e.shard.GetTimeSource())
 }
 
 func (s *shardContextWrapper) UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
@@ -2830,6 +2960,10 @@ func validateActivityScheduleAttributes(attributes *workflow.ScheduleActivityTas
 		return err
 	}
 
+	if err := common.ValidateTaskPriority(attributes.GetPriority()); err != nil {
+		return err
+	}
+
 	if len(attributes.GetActivityId()) > maxIDLengthLimit {
 		return &workflow.BadRequestError{Message: "ActivityID exceeds length limit."}
 	}
@@ -3210,6 +3344,7 @@ func getStartRequest(domainID string,
 		WorkflowIdReusePolicy:               request.WorkflowIdReusePolicy,
 		RetryPolicy:                         request.RetryPolicy,
 		CronSchedule:                        request.CronSchedule,
+		Priority:                            request.Priority,
 	}
 
 	startRequest := common.CreateHistoryStartWorkflowRequest(domainID, req)
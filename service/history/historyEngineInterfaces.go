@@ -25,6 +25,7 @@ import (
 	"time"
 
 	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/definition"
@@ -73,6 +74,8 @@ type (
 		ReplicateRawEvents(ctx context.Context, request *h.ReplicateRawEventsRequest) error
 		SyncShardStatus(ctx context.Context, request *h.SyncShardStatusRequest) error
 		SyncActivity(ctx context.Context, request *h.SyncActivityRequest) error
+		GetReplicationMessages(ctx context.Context, pollingCluster string, lastProcessedTaskID int64) (*replicator.ReplicationMessages, error)
+		DescribeReplicationStatus(ctx context.Context) (*replicator.ShardReplicationStatus, error)
 	}
 
 	// EngineFactory is used to create an instance of sharded history engine
@@ -85,6 +88,14 @@ type (
 		notifyNewTask()
 	}
 
+	replicatorQueueProcessor interface {
+		queueProcessor
+		getTasks(pollingCluster string, lastProcessedTaskID int64) (*replicator.ReplicationMessages, error)
+		getRemoteAckLevels() map[string]int64
+		getPendingTasksByDomain(maxTasks int) (map[string]int64, bool, error)
+		getHeldTasksByDomain() map[string]int64
+	}
+
 	queueAckMgr interface {
 		getFinishedChan() <-chan struct{}
 		readQueueTasks() ([]queueTaskInfo, bool, error)
@@ -22,7 +22,6 @@ package history
 
 import (
 	"sync/atomic"
-	"time"
 
 	"github.com/pborman/uuid"
 	gen "github.com/uber/cadence/.gen/go/shared"
@@ -38,7 +37,8 @@ const (
 
 type (
 	historyEventNotifierImpl struct {
-		metrics metrics.Client
+		timeSource common.TimeSource
+		metrics    metrics.Client
 		// internal status indicator
 		status int32
 		// stop signal channel
@@ -73,7 +73,7 @@ func newHistoryEventNotification(domainID string, workflowExecution *gen.Workflo
 	}
 }
 
-func newHistoryEventNotifier(metrics metrics.Client, workflowIDToShardID func(string) int) *historyEventNotifierImpl {
+func newHistoryEventNotifier(timeSource common.TimeSource, metrics metrics.Client, workflowIDToShardID func(string) int) *historyEventNotifierImpl {
 	hashFn := func(key interface{}) uint32 {
 		notification, ok := key.(historyEventNotification)
 		if !ok {
@@ -82,6 +82,7 @@ func newHistoryEventNotifier(metrics metrics.Client, workflowIDToShardID func(st
 		return uint32(workflowIDToShardID(notification.id.WorkflowID))
 	}
 	return &historyEventNotifierImpl{
+		timeSource: timeSource,
 		metrics:    metrics,
 		status:     common.DaemonStatusInitialized,
 		closeChan:  make(chan bool),
@@ -171,7 +172,7 @@ func (notifier *historyEventNotifierImpl) dispatchHistoryEventNotification(event
 
 func (notifier *historyEventNotifierImpl) enqueueHistoryEventNotification(event *historyEventNotification) {
 	// set the timestamp just before enqueuing the event
-	event.timestamp = time.Now()
+	event.timestamp = notifier.timeSource.Now()
 	select {
 	case notifier.eventsChan <- event:
 	default:
@@ -190,7 +191,7 @@ func (notifier *historyEventNotifierImpl) dequeueHistoryEventNotifications() {
 		select {
 		case event := <-notifier.eventsChan:
 			// send out metrics about message processing delay
-			timeelapsed := time.Since(event.timestamp)
+			timeelapsed := notifier.timeSource.Now().Sub(event.timestamp)
 			notifier.metrics.RecordTimer(metrics.HistoryEventNotificationScope,
 				metrics.HistoryEventNotificationQueueingLatency, timeelapsed)
 
@@ -60,6 +60,7 @@ func (s *historyEventNotifierSuite) TearDownSuite() {
 
 func (s *historyEventNotifierSuite) SetupTest() {
 	s.historyEventNotifier = newHistoryEventNotifier(
+		common.NewRealTimeSource(),
 		metrics.NewClient(tally.NoopScope, metrics.History),
 		func(workflowID string) int {
 			return len(workflowID)
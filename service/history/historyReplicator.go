@@ -104,6 +104,13 @@ var (
 	ErrUnknownEncodingType = &shared.BadRequestError{Message: "unknown encoding type"}
 )
 
+// reasons recorded in the audit trail when conflict resolution resets a workflow
+const (
+	conflictReasonEventsRejectedByRemote = "events-rejected-by-remote"
+	conflictReasonBufferedEvents         = "buffered-events"
+	conflictReasonDivergedEvents         = "diverged-events"
+)
+
 func newHistoryReplicator(shard ShardContext, historyEngine *historyEngineImpl, historyCache *historyCache, domainCache cache.DomainCache,
 	historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, logger bark.Logger) *historyReplicator {
 	replicator := &historyReplicator{
@@ -127,6 +134,7 @@ func newHistoryReplicator(shard ShardContext, historyEngine *historyEngineImpl,
 			return newMutableStateBuilderWithReplicationState(
 				shard.GetService().GetClusterMetadata().GetCurrentClusterName(),
 				shard.GetConfig(),
+				shard.GetTimeSource(),
 				logger,
 				version,
 			)
@@ -507,7 +515,9 @@ func (r *historyReplicator) ApplyOtherEventsVersionChecking(ctx context.Context,
 		// NOTE: this conflict resolution do not handle fast >= 2 failover
 		lastEvent := request.History.Events[len(request.History.Events)-1]
 		incomingTimestamp := lastEvent.GetTimestamp()
-		return r.resetMutableState(ctx, context, msBuilder, lastValidEventID, incomingVersion, incomingTimestamp, logger)
+		audit := r.newConflictResolutionAudit(msBuilder, request, conflictReasonEventsRejectedByRemote,
+			lastValidEventID, lastValidVersion, rState.LastWriteVersion)
+		return r.resetMutableState(ctx, context, msBuilder, lastValidEventID, incomingVersion, incomingTimestamp, audit, logger)
 	}
 	if rState.LastWriteVersion < ri.GetVersion() {
 		err = ErrImpossibleRemoteClaimSeenHigherVersion
@@ -529,7 +539,8 @@ func (r *historyReplicator) ApplyOtherEventsVersionChecking(ctx context.Context,
 		return nil, err
 	}
 
-	if ri.GetLastEventId() < msBuilder.GetReplicationState().LastWriteEventID || msBuilder.HasBufferedEvents() {
+	lastWriteEventID := msBuilder.GetReplicationState().LastWriteEventID
+	if ri.GetLastEventId() < lastWriteEventID || msBuilder.HasBufferedEvents() {
 		// the reason to reset mutable state if mutable state has buffered events
 		// is: what buffered event actually do is delay generation of event ID,
 		// the actual action of those buffered event are already applied to mutable state.
@@ -537,7 +548,13 @@ func (r *historyReplicator) ApplyOtherEventsVersionChecking(ctx context.Context,
 		logger.Info("Conflict detected.")
 		lastEvent := request.History.Events[len(request.History.Events)-1]
 		incomingTimestamp := lastEvent.GetTimestamp()
-		return r.resetMutableState(ctx, context, msBuilder, ri.GetLastEventId(), incomingVersion, incomingTimestamp, logger)
+		reason := conflictReasonDivergedEvents
+		if ri.GetLastEventId() >= lastWriteEventID {
+			reason = conflictReasonBufferedEvents
+		}
+		audit := r.newConflictResolutionAudit(msBuilder, request, reason, ri.GetLastEventId(), ri.GetVersion(),
+			rState.LastWriteVersion)
+		return r.resetMutableState(ctx, context, msBuilder, ri.GetLastEventId(), incomingVersion, incomingTimestamp, audit, logger)
 	}
 
 	// event ID match, no reset
@@ -861,6 +878,7 @@ func (r *historyReplicator) replicateWorkflowStarted(ctx context.Context, contex
 			ReplicationState:            replicationState,
 			EventStoreVersion:           msBuilder.GetEventStoreVersion(),
 			BranchToken:                 msBuilder.GetCurrentBranch(),
+			Priority:                    executionInfo.Priority,
 		}
 		createRequest.CreateWorkflowMode = persistence.CreateWorkflowModeBrandNew
 		if !isBrandNew {
@@ -1116,9 +1134,12 @@ func (r *historyReplicator) getLatestCheckpoint(replicationInfoRemote map[string
 }
 
 func (r *historyReplicator) resetMutableState(ctx context.Context, context workflowExecutionContext,
-	msBuilder mutableState, lastEventID int64, incomingVersion int64, incomingTimestamp int64, logger bark.Logger) (mutableState, error) {
+	msBuilder mutableState, lastEventID int64, incomingVersion int64, incomingTimestamp int64,
+	audit *persistence.ConflictResolutionAuditInfo, logger bark.Logger) (mutableState, error) {
 
-	r.metricsClient.IncCounter(metrics.ReplicateHistoryEventsScope, metrics.HistoryConflictsCounter)
+	r.metricsClient.Tagged(map[string]string{
+		metrics.DomainTagName: r.getDomainName(audit.DomainID),
+	}).IncCounter(metrics.ReplicateHistoryEventsScope, metrics.HistoryConflictsCounter)
 
 	// handling edge case when resetting a workflow, and this workflow has done continue as new
 	// we need to terminate the continue as new-ed workflow
@@ -1133,9 +1154,56 @@ func (r *historyReplicator) resetMutableState(ctx context.Context, context workf
 	if err != nil {
 		return nil, err
 	}
+
+	// the audit trail is best effort, failing to record it must not fail the replication task
+	audit.ResolvedTime = r.shard.GetTimeSource().Now()
+	if err := r.historyMgr.CreateConflictResolutionAudit(&persistence.CreateConflictResolutionAuditRequest{
+		Info: audit,
+	}); err != nil {
+		logError(logger, "Fail to record conflict resolution audit.", err)
+	}
 	return msBuilder, nil
 }
 
+// newConflictResolutionAudit describes the events discarded from the local mutable state and the
+// events adopted from the replication task when resetting the workflow to resetEventID
+func (r *historyReplicator) newConflictResolutionAudit(msBuilder mutableState, request *h.ReplicateEventsRequest,
+	reason string, resetEventID int64, resetVersion int64, lastWriteVersion int64) *persistence.ConflictResolutionAuditInfo {
+
+	// the events of a batch can carry several versions, the audit records the range of versions adopted
+	adoptedStartVersion, adoptedEndVersion := request.GetVersion(), request.GetVersion()
+	if events := request.History.GetEvents(); len(events) > 0 {
+		adoptedStartVersion = events[0].GetVersion()
+		adoptedEndVersion = events[len(events)-1].GetVersion()
+	}
+
+	executionInfo := msBuilder.GetExecutionInfo()
+	return &persistence.ConflictResolutionAuditInfo{
+		AuditID:               uuid.New(),
+		DomainID:              executionInfo.DomainID,
+		WorkflowID:            executionInfo.WorkflowID,
+		RunID:                 executionInfo.RunID,
+		SourceCluster:         request.GetSourceCluster(),
+		Reason:                reason,
+		ResetEventID:          resetEventID,
+		DiscardedNextEventID:  msBuilder.GetNextEventID(),
+		DiscardedStartVersion: resetVersion,
+		DiscardedEndVersion:   lastWriteVersion,
+		AdoptedFirstEventID:   request.GetFirstEventId(),
+		AdoptedNextEventID:    request.GetNextEventId(),
+		AdoptedStartVersion:   adoptedStartVersion,
+		AdoptedEndVersion:     adoptedEndVersion,
+	}
+}
+
+func (r *historyReplicator) getDomainName(domainID string) string {
+	domainEntry, err := r.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return domainID
+	}
+	return domainEntry.GetInfo().Name
+}
+
 func (r *historyReplicator) updateMutableStateOnly(context workflowExecutionContext, msBuilder mutableState) error {
 	return r.updateMutableStateWithTimer(context, msBuilder, time.Time{}, nil)
 }
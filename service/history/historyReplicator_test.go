@@ -140,7 +140,8 @@ func (s *historyReplicatorSuite) SetupTest() {
 		txProcessor:        s.mockTxProcessor,
 		timerProcessor:     s.mockTimerProcessor,
 	}
-	s.historyReplicator = newHistoryReplicator(s.mockShard, h, historyCache, s.mockShard.domainCache, s.mockHistoryMgr, s.mockHistoryV2Mgr, s.logger)
+	s.historyReplicator = newHistoryReplicator(s.mockShard, h, historyCache, s.mockShard.domainCache, s.mockHistoryMgr, s.mockHistoryV2Mgr,
+		s.logger)
 }
 
 func (s *historyReplicatorSuite) TearDownTest() {
@@ -995,7 +996,7 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGre
 		Version:         common.Int64Ptr(incomingVersion),
 		ReplicationInfo: map[string]*shared.ReplicationInfo{},
 		History: &shared.History{Events: []*shared.HistoryEvent{
-			&shared.HistoryEvent{Timestamp: common.Int64Ptr(time.Now().UnixNano())},
+			&shared.HistoryEvent{Version: common.Int64Ptr(incomingVersion), Timestamp: common.Int64Ptr(time.Now().UnixNano())},
 		}},
 	}
 	startTimeStamp := time.Now()
@@ -1024,6 +1025,11 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGre
 	msBuilderMid := &mockMutableState{}
 	msBuilderMid.On("GetNextEventID").Return(int64(12345)) // this is used by log
 	mockConflictResolver.On("reset", runID, mock.Anything, currentReplicationInfoLastEventID, exeInfo).Return(msBuilderMid, nil)
+	msBuilderIn.On("GetNextEventID").Return(currentLastEventID + 1)
+	s.mockHistoryMgr.On("CreateConflictResolutionAudit", mock.MatchedBy(func(request *persistence.CreateConflictResolutionAuditRequest) bool {
+		return request.Info.RunID == runID && request.Info.ResetEventID == currentReplicationInfoLastEventID &&
+			request.Info.Reason == conflictReasonEventsRejectedByRemote && request.Info.AdoptedStartVersion == incomingVersion
+	})).Return(nil).Once()
 	msBuilderOut, err := s.historyReplicator.ApplyOtherEventsVersionChecking(ctx.Background(), context, msBuilderIn, request, s.logger)
 	s.Equal(msBuilderMid, msBuilderOut)
 	s.Nil(err)
@@ -1056,7 +1062,7 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGre
 			},
 		},
 		History: &shared.History{Events: []*shared.HistoryEvent{
-			&shared.HistoryEvent{Timestamp: common.Int64Ptr(time.Now().UnixNano())},
+			&shared.HistoryEvent{Version: common.Int64Ptr(incomingVersion), Timestamp: common.Int64Ptr(time.Now().UnixNano())},
 		}},
 	}
 	startTimeStamp := time.Now()
@@ -1085,6 +1091,11 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGre
 	msBuilderMid := &mockMutableState{}
 	msBuilderMid.On("GetNextEventID").Return(int64(12345)) // this is used by log
 	mockConflictResolver.On("reset", runID, mock.Anything, currentReplicationInfoLastEventID, exeInfo).Return(msBuilderMid, nil)
+	msBuilderIn.On("GetNextEventID").Return(currentLastEventID + 1)
+	s.mockHistoryMgr.On("CreateConflictResolutionAudit", mock.MatchedBy(func(request *persistence.CreateConflictResolutionAuditRequest) bool {
+		return request.Info.RunID == runID && request.Info.ResetEventID == currentReplicationInfoLastEventID &&
+			request.Info.Reason == conflictReasonEventsRejectedByRemote && request.Info.AdoptedStartVersion == incomingVersion
+	})).Return(nil).Once()
 	msBuilderOut, err := s.historyReplicator.ApplyOtherEventsVersionChecking(ctx.Background(), context, msBuilderIn, request, s.logger)
 	s.Equal(msBuilderMid, msBuilderOut)
 	s.Nil(err)
@@ -1150,7 +1161,8 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGre
 			},
 		},
 		History: &shared.History{Events: []*shared.HistoryEvent{
-			&shared.HistoryEvent{Timestamp: common.Int64Ptr(time.Now().UnixNano())},
+			&shared.HistoryEvent{Version: common.Int64Ptr(incomingVersion - 1), Timestamp: common.Int64Ptr(time.Now().UnixNano())},
+			&shared.HistoryEvent{Version: common.Int64Ptr(incomingVersion), Timestamp: common.Int64Ptr(time.Now().UnixNano())},
 		}},
 	}
 	startTimeStamp := time.Now()
@@ -1174,6 +1186,12 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGre
 	msBuilderMid := &mockMutableState{}
 	msBuilderMid.On("GetNextEventID").Return(int64(12345)) // this is used by log
 	mockConflictResolver.On("reset", runID, mock.Anything, incomingReplicationInfoLastEventID, exeInfo).Return(msBuilderMid, nil)
+	msBuilderIn.On("GetNextEventID").Return(currentLastEventID + 1)
+	s.mockHistoryMgr.On("CreateConflictResolutionAudit", mock.MatchedBy(func(request *persistence.CreateConflictResolutionAuditRequest) bool {
+		return request.Info.RunID == runID && request.Info.ResetEventID == incomingReplicationInfoLastEventID &&
+			request.Info.Reason == conflictReasonDivergedEvents && request.Info.AdoptedStartVersion == incomingVersion-1 &&
+			request.Info.AdoptedEndVersion == incomingVersion
+	})).Return(nil).Once()
 	msBuilderOut, err := s.historyReplicator.ApplyOtherEventsVersionChecking(ctx.Background(), context, msBuilderIn, request, s.logger)
 	s.Equal(msBuilderMid, msBuilderOut)
 	s.Nil(err)
@@ -1283,7 +1301,7 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGre
 			},
 		},
 		History: &shared.History{Events: []*shared.HistoryEvent{
-			&shared.HistoryEvent{Timestamp: common.Int64Ptr(time.Now().UnixNano())},
+			&shared.HistoryEvent{Version: common.Int64Ptr(incomingVersion), Timestamp: common.Int64Ptr(time.Now().UnixNano())},
 		}},
 	}
 	startTimeStamp := time.Now()
@@ -1325,6 +1343,11 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGre
 	msBuilderMid := &mockMutableState{}
 	msBuilderMid.On("GetNextEventID").Return(int64(12345)) // this is used by log
 	mockConflictResolver.On("reset", runID, mock.Anything, incomingReplicationInfoLastEventID, exeInfo).Return(msBuilderMid, nil)
+	msBuilderIn.On("GetNextEventID").Return(currentLastEventID + 1)
+	s.mockHistoryMgr.On("CreateConflictResolutionAudit", mock.MatchedBy(func(request *persistence.CreateConflictResolutionAuditRequest) bool {
+		return request.Info.RunID == runID && request.Info.ResetEventID == incomingReplicationInfoLastEventID &&
+			request.Info.Reason == conflictReasonDivergedEvents && request.Info.AdoptedStartVersion == incomingVersion
+	})).Return(nil).Once()
 	msBuilderOut, err := s.historyReplicator.ApplyOtherEventsVersionChecking(ctx.Background(), context, msBuilderIn, request, s.logger)
 	s.Equal(msBuilderMid, msBuilderOut)
 	s.Nil(err)
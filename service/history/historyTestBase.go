@@ -222,6 +222,26 @@ func (s *TestShardContext) UpdateReplicatorAckLevel(ackLevel int64) error {
 	return nil
 }
 
+// GetClusterReplicationLevel test implementation
+func (s *TestShardContext) GetClusterReplicationLevel(cluster string) int64 {
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.shardInfo.ClusterReplicationLevel[cluster]
+}
+
+// UpdateClusterReplicationLevel test implementation
+func (s *TestShardContext) UpdateClusterReplicationLevel(cluster string, lastTaskID int64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.shardInfo.ClusterReplicationLevel == nil {
+		s.shardInfo.ClusterReplicationLevel = make(map[string]int64)
+	}
+	s.shardInfo.ClusterReplicationLevel[cluster] = lastTaskID
+	return nil
+}
+
 // GetTimerAckLevel test implementation
 func (s *TestShardContext) GetTimerAckLevel() time.Time {
 	s.RLock()
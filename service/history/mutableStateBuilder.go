@@ -82,13 +82,14 @@ type (
 		currentCluster   string
 		historySize      int
 		config           *Config
+		timeSource       common.TimeSource
 		logger           bark.Logger
 	}
 )
 
 var _ mutableState = (*mutableStateBuilder)(nil)
 
-func newMutableStateBuilder(currentCluster string, config *Config, logger bark.Logger) *mutableStateBuilder {
+func newMutableStateBuilder(currentCluster string, config *Config, timeSource common.TimeSource, logger bark.Logger) *mutableStateBuilder {
 	s := &mutableStateBuilder{
 		updateActivityInfos:             make(map[*persistence.ActivityInfo]struct{}),
 		pendingActivityInfoIDs:          make(map[int64]*persistence.ActivityInfo),
@@ -118,6 +119,7 @@ func newMutableStateBuilder(currentCluster string, config *Config, logger bark.L
 
 		currentCluster: currentCluster,
 		config:         config,
+		timeSource:     timeSource,
 		logger:         logger,
 	}
 	s.executionInfo = &persistence.WorkflowExecutionInfo{
@@ -131,8 +133,9 @@ func newMutableStateBuilder(currentCluster string, config *Config, logger bark.L
 	return s
 }
 
-func newMutableStateBuilderWithReplicationState(currentCluster string, config *Config, logger bark.Logger, version int64) *mutableStateBuilder {
-	s := newMutableStateBuilder(currentCluster, config, logger)
+func newMutableStateBuilderWithReplicationState(currentCluster string, config *Config, timeSource common.TimeSource,
+	logger bark.Logger, version int64) *mutableStateBuilder {
+	s := newMutableStateBuilder(currentCluster, config, timeSource, logger)
 	s.replicationState = &persistence.ReplicationState{
 		StartVersion:        version,
 		CurrentVersion:      version,
@@ -660,7 +663,7 @@ func (e *mutableStateBuilder) IsStickyTaskListEnabled() bool {
 }
 
 func (e *mutableStateBuilder) CreateNewHistoryEvent(eventType workflow.EventType) *workflow.HistoryEvent {
-	return e.CreateNewHistoryEventWithTimestamp(eventType, time.Now().UnixNano())
+	return e.CreateNewHistoryEventWithTimestamp(eventType, e.timeSource.Now().UnixNano())
 }
 
 func (e *mutableStateBuilder) CreateNewHistoryEventWithTimestamp(eventType workflow.EventType,
@@ -833,7 +836,7 @@ func (e *mutableStateBuilder) GetRetryBackoffDuration(errReason string) time.Dur
 		return common.NoRetryBackoff
 	}
 
-	return getBackoffInterval(info.Attempt, info.MaximumAttempts, info.InitialInterval, info.MaximumInterval, info.BackoffCoefficient, time.Now(), info.ExpirationTime, errReason, info.NonRetriableErrors)
+	return getBackoffInterval(info.Attempt, info.MaximumAttempts, info.InitialInterval, info.MaximumInterval, info.BackoffCoefficient, e.timeSource.Now(), info.ExpirationTime, errReason, info.NonRetriableErrors)
 }
 
 func (e *mutableStateBuilder) GetCronBackoffDuration() time.Duration {
@@ -841,7 +844,7 @@ func (e *mutableStateBuilder) GetCronBackoffDuration() time.Duration {
 	if len(info.CronSchedule) == 0 {
 		return common.NoRetryBackoff
 	}
-	return getBackoffForNextCronSchedule(info.CronSchedule, time.Now())
+	return getBackoffForNextCronSchedule(info.CronSchedule, e.timeSource.Now())
 }
 
 // GetSignalInfo get details about a signal request that is currently in progress.
@@ -904,7 +907,7 @@ func (e *mutableStateBuilder) UpdateActivityProgress(ai *persistence.ActivityInf
 	request *workflow.RecordActivityTaskHeartbeatRequest) {
 	ai.Version = e.GetCurrentVersion()
 	ai.Details = request.Details
-	ai.LastHeartBeatUpdatedTime = time.Now()
+	ai.LastHeartBeatUpdatedTime = e.timeSource.Now()
 	e.updateActivityInfos[ai] = struct{}{}
 	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
 }
@@ -1191,6 +1194,7 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(d
 		ExecutionStartToCloseTimeoutSeconds: attributes.ExecutionStartToCloseTimeoutSeconds,
 		Input:                               attributes.Input,
 		RetryPolicy:                         attributes.RetryPolicy,
+		Priority:                            common.Int32Ptr(previousExecutionInfo.Priority),
 	}
 
 	req := &h.StartWorkflowExecutionRequest{
@@ -1215,7 +1219,7 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(d
 		if attributes.RetryPolicy != nil && attributes.RetryPolicy.GetExpirationIntervalInSeconds() > 0 {
 			// has retry policy and expiration time.
 			expirationSeconds := attributes.RetryPolicy.GetExpirationIntervalInSeconds() + req.GetFirstDecisionTaskBackoffSeconds()
-			expirationTime := time.Now().Add(time.Second * time.Duration(expirationSeconds))
+			expirationTime := e.timeSource.Now().Add(time.Second * time.Duration(expirationSeconds))
 			req.ExpirationTimestamp = common.Int64Ptr(expirationTime.UnixNano())
 		}
 	}
@@ -1263,6 +1267,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(domainID st
 	e.executionInfo.WorkflowTypeName = event.WorkflowType.GetName()
 	e.executionInfo.WorkflowTimeout = event.GetExecutionStartToCloseTimeoutSeconds()
 	e.executionInfo.DecisionTimeoutValue = event.GetTaskStartToCloseTimeoutSeconds()
+	e.executionInfo.Priority = event.GetPriority()
 
 	e.executionInfo.State = persistence.WorkflowStateCreated
 	e.executionInfo.CloseStatus = persistence.WorkflowCloseStatusNone
@@ -1388,7 +1393,7 @@ func (e *mutableStateBuilder) AddDecisionTaskStartedEvent(scheduleEventID int64,
 	scheduleID := di.ScheduleID
 	startedID := scheduleID + 1
 	tasklist := request.TaskList.GetName()
-	timestamp := time.Now().UnixNano()
+	timestamp := e.timeSource.Now().UnixNano()
 	// First check to see if new events came since transient decision was scheduled
 	if di.Attempt > 0 && di.ScheduleID != e.GetNextEventID() {
 		// Also create a new DecisionTaskScheduledEvent since new events came in when it was scheduled
@@ -1658,7 +1663,7 @@ func (e *mutableStateBuilder) AddActivityTaskStartedEvent(ai *persistence.Activi
 	ai.Version = e.GetCurrentVersion()
 	ai.StartedID = common.TransientEventID
 	ai.RequestID = requestID
-	ai.StartedTime = time.Now()
+	ai.StartedTime = e.timeSource.Now()
 	ai.StartedIdentity = identity
 	e.UpdateActivity(ai)
 	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
@@ -2235,9 +2240,9 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(decisionCompletedEventID int
 	if domainEntry.IsGlobalDomain() {
 		// all workflows within a global domain should have replication state, no matter whether it will be replicated to multiple
 		// target clusters or not
-		newStateBuilder = newMutableStateBuilderWithReplicationState(e.currentCluster, e.config, e.logger, domainEntry.GetFailoverVersion())
+		newStateBuilder = newMutableStateBuilderWithReplicationState(e.currentCluster, e.config, e.timeSource, e.logger, domainEntry.GetFailoverVersion())
 	} else {
-		newStateBuilder = newMutableStateBuilder(e.currentCluster, e.config, e.logger)
+		newStateBuilder = newMutableStateBuilder(e.currentCluster, e.config, e.timeSource, e.logger)
 	}
 	domainID := domainEntry.GetInfo().ID
 	startedEvent := newStateBuilder.addWorkflowExecutionStartedEventForContinueAsNew(domainID, parentInfo, newExecution, e, attributes)
@@ -2325,6 +2330,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionContinuedAsNewEvent(sour
 		BranchToken:          newStateBuilder.GetCurrentBranch(),
 		CronSchedule:         e.executionInfo.CronSchedule,
 		ExpirationSeconds:    e.executionInfo.ExpirationSeconds,
+		Priority:             newExecutionInfo.Priority,
 	}
 	if continueAsNewAttributes.GetInitiator() == workflow.ContinueAsNewInitiatorRetryPolicy {
 		// retry
@@ -2335,14 +2341,14 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionContinuedAsNewEvent(sour
 		continueAsNew.Attempt = 0
 		if startedAttributes.RetryPolicy != nil && continueAsNew.ExpirationSeconds > 0 {
 			expirationInSeconds := startedAttributes.RetryPolicy.GetExpirationIntervalInSeconds() + continueAsNewAttributes.GetBackoffStartIntervalInSeconds()
-			continueAsNew.ExpirationTime = time.Now().Add(time.Second * time.Duration(expirationInSeconds))
+			continueAsNew.ExpirationTime = e.timeSource.Now().Add(time.Second * time.Duration(expirationInSeconds))
 		}
 	}
 
 	// timeout includes workflow_timeout + backoff_interval
 	timeoutInSeconds := continueAsNewAttributes.GetExecutionStartToCloseTimeoutSeconds() + continueAsNewAttributes.GetBackoffStartIntervalInSeconds()
 	timeoutDuration := time.Duration(timeoutInSeconds) * time.Second
-	startedTime := time.Now()
+	startedTime := e.timeSource.Now()
 	timeoutDeadline := startedTime.Add(timeoutDuration)
 	if !continueAsNew.ExpirationTime.IsZero() && timeoutDeadline.After(continueAsNew.ExpirationTime) {
 		// expire before timeout
@@ -2381,7 +2387,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionContinuedAsNewEvent(sour
 			newStateBuilder.UpdateReplicationStateLastEventID(sourceClusterName, startedEvent.GetVersion(), startedEvent.GetEventId())
 		}
 		backoffTimer := &persistence.WorkflowBackoffTimerTask{
-			VisibilityTimestamp: time.Now().Add(time.Second * time.Duration(continueAsNewAttributes.GetBackoffStartIntervalInSeconds())),
+			VisibilityTimestamp: e.timeSource.Now().Add(time.Second * time.Duration(continueAsNewAttributes.GetBackoffStartIntervalInSeconds())),
 		}
 		if continueAsNewAttributes.GetInitiator() == workflow.ContinueAsNewInitiatorRetryPolicy {
 			backoffTimer.TimeoutType = persistence.WorkflowBackoffTimeoutTypeRetry
@@ -2619,7 +2625,7 @@ func (e *mutableStateBuilder) ReplicateChildWorkflowExecutionTimedOutEvent(event
 }
 
 func (e *mutableStateBuilder) CreateActivityRetryTimer(ai *persistence.ActivityInfo, failureReason string) persistence.Task {
-	retryTask := prepareActivityNextRetry(e.GetCurrentVersion(), ai, failureReason)
+	retryTask := prepareActivityNextRetryWithNowTime(e.GetCurrentVersion(), ai, failureReason, e.timeSource.Now())
 	if retryTask != nil {
 		e.updateActivityInfos[ai] = struct{}{}
 	}
@@ -22,6 +22,7 @@ package history
 
 import (
 	"errors"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -72,6 +73,13 @@ type (
 		status     int32
 		shutdownWG sync.WaitGroup
 		shutdownCh chan struct{}
+
+		// tasks held because the replication of their domain is paused or throttled are parked per domain,
+		// off the worker coroutines, until a task of their domain goes through
+		heldTasksLock   sync.Mutex
+		heldTasks       map[string][]queueTaskInfo
+		releasedDomains map[string]struct{}
+		releaseCh       chan struct{}
 	}
 )
 
@@ -79,6 +87,7 @@ var (
 	errUnexpectedQueueTask = errors.New("unexpected queue task")
 
 	loadQueueTaskThrottleRetryDelay = 5 * time.Second
+	heldTaskRetryInterval           = time.Second
 )
 
 func newQueueProcessorBase(clusterName string, shard ShardContext, options *QueueProcessorOptions, processor processor, queueAckMgr queueAckMgr, logger bark.Logger) *queueProcessorBase {
@@ -102,6 +111,9 @@ func newQueueProcessorBase(clusterName string, shard ShardContext, options *Queu
 		ackMgr:                  queueAckMgr,
 		retryPolicy:             common.CreatePersistanceRetryPolicy(),
 		lastPollTime:            time.Time{},
+		heldTasks:               make(map[string][]queueTaskInfo),
+		releasedDomains:         make(map[string]struct{}),
+		releaseCh:               make(chan struct{}, 1),
 	}
 
 	return p
@@ -170,6 +182,9 @@ func (p *queueProcessorBase) processorPump() {
 	))
 	defer updateAckTimer.Stop()
 
+	heldTaskTicker := time.NewTicker(heldTaskRetryInterval)
+	defer heldTaskTicker.Stop()
+
 processorPumpLoop:
 	for {
 		select {
@@ -185,7 +200,7 @@ processorPumpLoop:
 				p.options.MaxPollInterval(),
 				p.options.MaxPollIntervalJitterCoefficient(),
 			))
-			if p.lastPollTime.Add(p.options.MaxPollInterval()).Before(time.Now()) {
+			if p.lastPollTime.Add(p.options.MaxPollInterval()).Before(p.shard.GetTimeSource().Now()) {
 				p.processBatch(tasksCh)
 			}
 		case <-updateAckTimer.C:
@@ -194,6 +209,10 @@ processorPumpLoop:
 				p.options.UpdateAckIntervalJitterCoefficient(),
 			))
 			p.ackMgr.updateQueueAckLevel()
+		case <-heldTaskTicker.C:
+			p.probeHeldTasks(tasksCh)
+		case <-p.releaseCh:
+			p.releaseHeldTasks(tasksCh)
 		}
 	}
 
@@ -213,7 +232,7 @@ func (p *queueProcessorBase) processBatch(tasksCh chan<- queueTaskInfo) {
 		return
 	}
 
-	p.lastPollTime = time.Now()
+	p.lastPollTime = p.shard.GetTimeSource().Now()
 	tasks, more, err := p.ackMgr.readQueueTasks()
 
 	if err != nil {
@@ -272,7 +291,7 @@ func (p *queueProcessorBase) processTaskAndAck(notificationChan <-chan struct{},
 
 	var scope int
 	var err error
-	startTime := time.Now()
+	startTime := p.shard.GetTimeSource().Now()
 	logger := p.initializeLoggerForTask(task)
 	attempt := 0
 	op := func() error {
@@ -280,6 +299,10 @@ func (p *queueProcessorBase) processTaskAndAck(notificationChan <-chan struct{},
 		return p.handleTaskError(scope, startTime, notificationChan, err, logger)
 	}
 	retryCondition := func(err error) bool {
+		if err == ErrTaskHeld {
+			// held tasks are parked instead of retried on the worker
+			return false
+		}
 		select {
 		case <-p.shutdownCh:
 			return false
@@ -287,7 +310,9 @@ func (p *queueProcessorBase) processTaskAndAck(notificationChan <-chan struct{},
 			return true
 		}
 	}
-	defer func() { p.metricsClient.RecordTimer(scope, metrics.TaskLatency, time.Since(startTime)) }()
+	defer func() {
+		p.metricsClient.RecordTimer(scope, metrics.TaskLatency, p.shard.GetTimeSource().Now().Sub(startTime))
+	}()
 
 	for {
 		select {
@@ -299,6 +324,13 @@ func (p *queueProcessorBase) processTaskAndAck(notificationChan <-chan struct{},
 			if err == nil {
 				p.metricsClient.RecordTimer(scope, metrics.TaskAttemptTimer, time.Duration(attempt))
 				p.ackTaskOnce(task, scope)
+				p.notifyDomainProgress(task)
+				return
+			}
+
+			if err == ErrTaskHeld {
+				// held tasks wait until replication is resumed without an ack, they do not count as failed attempts
+				p.holdTask(task)
 				return
 			}
 
@@ -324,10 +356,10 @@ func (p *queueProcessorBase) processTaskOnce(notificationChan <-chan struct{}, t
 	default:
 	}
 
-	startTime := time.Now()
+	startTime := p.shard.GetTimeSource().Now()
 	scope, err := p.processor.process(task)
 	p.metricsClient.IncCounter(scope, metrics.TaskRequests)
-	p.metricsClient.RecordTimer(scope, metrics.TaskProcessingLatency, time.Since(startTime))
+	p.metricsClient.RecordTimer(scope, metrics.TaskProcessingLatency, p.shard.GetTimeSource().Now().Sub(startTime))
 
 	return scope, err
 }
@@ -350,6 +382,12 @@ func (p *queueProcessorBase) handleTaskError(scope int, startTime time.Time,
 		return err
 	}
 
+	// the replication of the task's domain is paused or throttled, the task is parked until it is released
+	if err == ErrTaskHeld {
+		p.metricsClient.IncCounter(scope, metrics.TaskHeldCounter)
+		return err
+	}
+
 	if err == ErrTaskDiscarded {
 		p.metricsClient.IncCounter(scope, metrics.TaskDiscarded)
 		err = nil
@@ -357,7 +395,7 @@ func (p *queueProcessorBase) handleTaskError(scope int, startTime time.Time,
 
 	// this is a transient error
 	if _, ok := err.(*workflow.DomainNotActiveError); ok {
-		if time.Now().Sub(startTime) > cache.DomainCacheRefreshInterval {
+		if p.shard.GetTimeSource().Now().Sub(startTime) > cache.DomainCacheRefreshInterval {
 			p.metricsClient.IncCounter(scope, metrics.TaskNotActiveCounter)
 			return nil
 		}
@@ -387,10 +425,97 @@ func (p *queueProcessorBase) ackTaskOnce(task queueTaskInfo, scope int) {
 	p.metricsClient.RecordTimer(
 		scope,
 		metrics.TaskQueueLatency,
-		time.Since(task.GetVisibilityTimestamp()),
+		p.shard.GetTimeSource().Now().Sub(task.GetVisibilityTimestamp()),
 	)
 }
 
+// holdTask parks a held task with the other held tasks of its domain, in task ID order
+func (p *queueProcessorBase) holdTask(task queueTaskInfo) {
+	domainID := getQueueTaskDomainID(task)
+
+	p.heldTasksLock.Lock()
+	defer p.heldTasksLock.Unlock()
+
+	tasks := p.heldTasks[domainID]
+	index := sort.Search(len(tasks), func(i int) bool {
+		return tasks[i].GetTaskID() > task.GetTaskID()
+	})
+	tasks = append(tasks, nil)
+	copy(tasks[index+1:], tasks[index:])
+	tasks[index] = task
+	p.heldTasks[domainID] = tasks
+}
+
+// notifyDomainProgress releases the held tasks of the domain of a task which went through
+func (p *queueProcessorBase) notifyDomainProgress(task queueTaskInfo) {
+	domainID := getQueueTaskDomainID(task)
+
+	p.heldTasksLock.Lock()
+	defer p.heldTasksLock.Unlock()
+
+	if len(p.heldTasks[domainID]) == 0 {
+		return
+	}
+	p.releasedDomains[domainID] = struct{}{}
+	select {
+	case p.releaseCh <- struct{}{}:
+	default: // channel already has an event, don't block
+	}
+}
+
+// probeHeldTasks dispatches the first held task of each domain, the other tasks of the domain are
+// released once it goes through
+func (p *queueProcessorBase) probeHeldTasks(tasksCh chan<- queueTaskInfo) {
+	p.heldTasksLock.Lock()
+	var tasks []queueTaskInfo
+	for domainID, held := range p.heldTasks {
+		tasks = append(tasks, held[0])
+		if len(held) == 1 {
+			delete(p.heldTasks, domainID)
+		} else {
+			p.heldTasks[domainID] = held[1:]
+		}
+	}
+	p.heldTasksLock.Unlock()
+
+	p.dispatchTasks(tasksCh, tasks)
+}
+
+// releaseHeldTasks dispatches all held tasks of the released domains
+func (p *queueProcessorBase) releaseHeldTasks(tasksCh chan<- queueTaskInfo) {
+	p.heldTasksLock.Lock()
+	var tasks []queueTaskInfo
+	for domainID := range p.releasedDomains {
+		tasks = append(tasks, p.heldTasks[domainID]...)
+		delete(p.heldTasks, domainID)
+		delete(p.releasedDomains, domainID)
+	}
+	p.heldTasksLock.Unlock()
+
+	p.dispatchTasks(tasksCh, tasks)
+}
+
+func (p *queueProcessorBase) dispatchTasks(tasksCh chan<- queueTaskInfo, tasks []queueTaskInfo) {
+	for _, task := range tasks {
+		select {
+		case tasksCh <- task:
+		case <-p.shutdownCh:
+			return
+		}
+	}
+}
+
+func getQueueTaskDomainID(task queueTaskInfo) string {
+	switch task := task.(type) {
+	case *persistence.TransferTaskInfo:
+		return task.DomainID
+	case *persistence.ReplicationTaskInfo:
+		return task.DomainID
+	default:
+		return ""
+	}
+}
+
 func (p *queueProcessorBase) initializeLoggerForTask(task queueTaskInfo) bark.Logger {
 	logger := p.logger.WithFields(bark.Fields{
 		logging.TagHistoryShardID: p.shard.GetShardID(),
@@ -150,6 +150,67 @@ func (s *queueProcessorSuite) TestProcessTaskAndAck_ErrNoErr() {
 	s.queueProcessor.processTaskAndAck(s.notificationChan, task)
 }
 
+func (s *queueProcessorSuite) TestProcessTaskAndAck_HeldTaskDoesNotBlockOtherDomains() {
+	heldTask := &persistence.ReplicationTaskInfo{TaskID: 1, DomainID: "paused domain ID"}
+	otherTask := &persistence.ReplicationTaskInfo{TaskID: 2, DomainID: "other domain ID"}
+	s.mockProcessor.On("process", heldTask).Return(s.scope, ErrTaskHeld).Once()
+	s.mockProcessor.On("process", otherTask).Return(s.scope, nil).Once()
+	s.mockQueueAckMgr.On("completeQueueTask", otherTask.GetTaskID()).Once()
+
+	// the held task is parked without ack and the single worker moves on to the other domain
+	done := make(chan struct{})
+	go func() {
+		s.queueProcessor.processTaskAndAck(s.notificationChan, heldTask)
+		s.queueProcessor.processTaskAndAck(s.notificationChan, otherTask)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		s.Fail("the held task blocked the worker")
+	}
+	s.Equal(map[string][]queueTaskInfo{heldTask.DomainID: {heldTask}}, s.queueProcessor.heldTasks)
+	// the other domain does not release the held task
+	s.Empty(s.queueProcessor.releasedDomains)
+}
+
+func (s *queueProcessorSuite) TestHeldTasks_ReleasedOnceProbeGoesThrough() {
+	domainID := "paused domain ID"
+	tasks := []*persistence.ReplicationTaskInfo{
+		{TaskID: 3, DomainID: domainID},
+		{TaskID: 1, DomainID: domainID},
+		{TaskID: 2, DomainID: domainID},
+	}
+	for _, task := range tasks {
+		s.mockProcessor.On("process", task).Return(s.scope, ErrTaskHeld).Once()
+		s.queueProcessor.processTaskAndAck(s.notificationChan, task)
+	}
+	tasksCh := make(chan queueTaskInfo, len(tasks))
+
+	// the probe is still held and goes back in front of the other tasks
+	s.queueProcessor.probeHeldTasks(tasksCh)
+	s.Equal(tasks[1], <-tasksCh)
+	s.mockProcessor.On("process", tasks[1]).Return(s.scope, ErrTaskHeld).Once()
+	s.queueProcessor.processTaskAndAck(s.notificationChan, tasks[1])
+	s.Equal([]queueTaskInfo{tasks[1], tasks[2], tasks[0]}, s.queueProcessor.heldTasks[domainID])
+
+	// the replication is resumed, the probe goes through and the other tasks are released in order
+	s.queueProcessor.probeHeldTasks(tasksCh)
+	s.Equal(tasks[1], <-tasksCh)
+	s.mockProcessor.On("process", tasks[1]).Return(s.scope, nil).Once()
+	s.mockQueueAckMgr.On("completeQueueTask", tasks[1].GetTaskID()).Once()
+	s.queueProcessor.processTaskAndAck(s.notificationChan, tasks[1])
+	select {
+	case <-s.queueProcessor.releaseCh:
+	default:
+		s.Fail("the held tasks are not released")
+	}
+	s.queueProcessor.releaseHeldTasks(tasksCh)
+	s.Equal(tasks[2], <-tasksCh)
+	s.Equal(tasks[0], <-tasksCh)
+	s.Empty(s.queueProcessor.heldTasks)
+}
+
 func (s *queueProcessorSuite) TestHandleTaskError_EntiryNotExists() {
 	err := &workflow.EntityNotExistsError{}
 	s.Nil(s.queueProcessor.handleTaskError(s.scope, time.Now(), s.notificationChan, err, s.logger))
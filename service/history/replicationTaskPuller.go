@@ -0,0 +1,362 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/xdc"
+)
+
+const (
+	replicationTaskPullTimeout  = 30 * time.Second
+	replicationTaskApplyTimeout = 30 * time.Second
+)
+
+var (
+	errNoReplicationMessages      = errors.New("remote cluster returned no replication messages for shard")
+	errUnsupportedReplicationTask = &workflow.BadRequestError{Message: "Unsupported replication task."}
+)
+
+type (
+	// replicationTaskPuller pulls the replication tasks of one shard from a remote cluster over RPC, and applies
+	// them to the same shard of the current cluster, in order. This replaces publishing through Kafka and
+	// consuming in the worker replicator when history.enableReplicationTaskPull is set.
+	replicationTaskPuller struct {
+		status              int32
+		sourceCluster       string
+		currentCluster      string
+		shard               ShardContext
+		historyEngine       *historyEngineImpl
+		adminClientFn       func() (admin.Client, error)
+		historyRereplicator xdc.HistoryRereplicator
+		config              *Config
+		metricsClient       metrics.Client
+		logger              bark.Logger
+		shutdownCh          chan struct{}
+		shutdownWG          sync.WaitGroup
+	}
+)
+
+// newReplicationTaskPuller relies on both clusters having the same number of history shards, so replication
+// tasks pulled from a shard of the source cluster always belong to the same shard of the current cluster.
+func newReplicationTaskPuller(sourceCluster string, shard ShardContext, historyEngine *historyEngineImpl,
+	config *Config, logger bark.Logger) *replicationTaskPuller {
+	currentCluster := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
+	adminClientFn := remoteAdminClientFn(shard, sourceCluster)
+	logger = logger.WithFields(bark.Fields{
+		logging.TagWorkflowComponent: logging.TagValueReplicationTaskPullerComponent,
+		logging.TagSourceCluster:     sourceCluster,
+	})
+
+	return &replicationTaskPuller{
+		status:         common.DaemonStatusInitialized,
+		sourceCluster:  sourceCluster,
+		currentCluster: currentCluster,
+		shard:          shard,
+		historyEngine:  historyEngine,
+		adminClientFn:  adminClientFn,
+		historyRereplicator: xdc.NewHistoryRereplicator(
+			currentCluster,
+			shard.GetDomainCache(),
+			adminClientFn,
+			func(ctx context.Context, request *h.ReplicateRawEventsRequest) error {
+				return historyEngine.ReplicateRawEvents(ctx, request)
+			},
+			persistence.NewHistorySerializer(),
+			historyRereplicationTimeout,
+			logger,
+		),
+		config:        config,
+		metricsClient: shard.GetMetricsClient(),
+		logger:        logger,
+		shutdownCh:    make(chan struct{}),
+	}
+}
+
+func (p *replicationTaskPuller) Start() {
+	if !atomic.CompareAndSwapInt32(&p.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	p.shutdownWG.Add(1)
+	go p.pullLoop()
+	p.logger.Info("Replication task puller started.")
+}
+
+func (p *replicationTaskPuller) Stop() {
+	if !atomic.CompareAndSwapInt32(&p.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(p.shutdownCh)
+	if success := common.AwaitWaitGroup(&p.shutdownWG, time.Minute); !success {
+		p.logger.Warn("Replication task puller timed out on shutdown.")
+	}
+	p.logger.Info("Replication task puller stopped.")
+}
+
+func (p *replicationTaskPuller) pullLoop() {
+	defer p.shutdownWG.Done()
+
+	for {
+		hasMore, err := p.pullAndApplyTasks()
+		var wait time.Duration
+		if err != nil {
+			p.metricsClient.IncCounter(metrics.ReplicationTaskPullerScope, metrics.ReplicationTasksFailed)
+			p.logger.WithField(logging.TagErr, err).Warn("Failed to pull and apply replication tasks.")
+			wait = p.config.ReplicationTaskPullErrorRetryWait()
+		} else if !hasMore {
+			wait = p.config.ReplicationTaskPullInterval()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-p.shutdownCh:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// pullAndApplyTasks pulls one batch of replication tasks after the persisted replication level of the source
+// cluster, applies them and moves the replication level forward. It returns whether more tasks are available.
+func (p *replicationTaskPuller) pullAndApplyTasks() (bool, error) {
+	shardID := int32(p.shard.GetShardID())
+	lastProcessedTaskID := p.shard.GetClusterReplicationLevel(p.sourceCluster)
+
+	sw := p.metricsClient.StartTimer(metrics.ReplicationTaskPullerScope, metrics.ReplicationTaskPullLatency)
+	adminClient, err := p.adminClientFn()
+	if err != nil {
+		sw.Stop()
+		return false, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), replicationTaskPullTimeout)
+	response, err := adminClient.GetReplicationMessages(ctx, &replicator.GetReplicationMessagesRequest{
+		Tokens: []*replicator.ReplicationToken{{
+			ShardID:                common.Int32Ptr(shardID),
+			LastProcessedMessageId: common.Int64Ptr(lastProcessedTaskID),
+		}},
+		ClusterName: common.StringPtr(p.currentCluster),
+	})
+	cancel()
+	sw.Stop()
+	if err != nil {
+		return false, err
+	}
+
+	messages, ok := response.MessagesByShard[shardID]
+	if !ok {
+		return false, errNoReplicationMessages
+	}
+	p.metricsClient.AddCounter(metrics.ReplicationTaskPullerScope, metrics.ReplicationTasksPulled,
+		int64(len(messages.ReplicationTasks)))
+
+	readLevel := lastProcessedTaskID
+	for _, task := range messages.ReplicationTasks {
+		if err := p.applyTask(task); err != nil {
+			// keep the progress made so far, the failed task will be pulled again
+			p.updateReplicationLevel(lastProcessedTaskID, readLevel)
+			return false, err
+		}
+		p.metricsClient.IncCounter(metrics.ReplicationTaskPullerScope, metrics.ReplicationTasksApplied)
+		readLevel = task.GetSourceTaskId()
+	}
+	if messages.GetLastRetrievedMessageId() > readLevel {
+		readLevel = messages.GetLastRetrievedMessageId()
+	}
+	if err := p.updateReplicationLevel(lastProcessedTaskID, readLevel); err != nil {
+		return false, err
+	}
+
+	if attr := messages.SyncShardStatus; attr != nil {
+		err := p.historyEngine.SyncShardStatus(context.Background(), &h.SyncShardStatusRequest{
+			SourceCluster: attr.SourceCluster,
+			ShardId:       attr.ShardId,
+			Timestamp:     attr.Timestamp,
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return messages.GetHasMore(), nil
+}
+
+func (p *replicationTaskPuller) updateReplicationLevel(lastProcessedTaskID int64, readLevel int64) error {
+	if readLevel <= lastProcessedTaskID {
+		return nil
+	}
+
+	err := p.shard.UpdateClusterReplicationLevel(p.sourceCluster, readLevel)
+	if err != nil {
+		p.logger.WithFields(bark.Fields{
+			logging.TagErr:    err,
+			logging.TagTaskID: readLevel,
+		}).Warn("Failed to update cluster replication level.")
+	}
+	return err
+}
+
+// applyTask applies a replication task, tasks which can never be applied are logged and skipped so they do not
+// block replication of the whole shard.
+func (p *replicationTaskPuller) applyTask(task *replicator.ReplicationTask) error {
+	var err error
+	switch task.GetTaskType() {
+	case replicator.ReplicationTaskTypeHistory:
+		err = p.applyHistoryTask(task)
+	case replicator.ReplicationTaskTypeSyncActivity:
+		err = p.applySyncActivityTask(task)
+	default:
+		err = errUnsupportedReplicationTask
+	}
+
+	switch err.(type) {
+	case *workflow.BadRequestError, *workflow.EntityNotExistsError:
+		p.metricsClient.IncCounter(metrics.ReplicationTaskPullerScope, metrics.ReplicationTasksFailed)
+		p.logger.WithFields(bark.Fields{
+			logging.TagErr:    err,
+			logging.TagTaskID: task.GetSourceTaskId(),
+		}).Error("Dropping replication task which cannot be applied.")
+		return nil
+	}
+	return err
+}
+
+func (p *replicationTaskPuller) applyHistoryTask(task *replicator.ReplicationTask) error {
+	attr := task.HistoryTaskAttributes
+	if attr.GetResetWorkflow() {
+		return errUnsupportedReplicationTask
+	}
+
+	request := &h.ReplicateEventsRequest{
+		SourceCluster: common.StringPtr(p.sourceCluster),
+		DomainUUID:    attr.DomainId,
+		WorkflowExecution: &workflow.WorkflowExecution{
+			WorkflowId: attr.WorkflowId,
+			RunId:      attr.RunId,
+		},
+		FirstEventId:            attr.FirstEventId,
+		NextEventId:             attr.NextEventId,
+		Version:                 attr.Version,
+		ReplicationInfo:         attr.ReplicationInfo,
+		History:                 attr.History,
+		NewRunHistory:           attr.NewRunHistory,
+		ForceBufferEvents:       common.BoolPtr(false),
+		EventStoreVersion:       attr.EventStoreVersion,
+		NewRunEventStoreVersion: attr.NewRunEventStoreVersion,
+		ResetWorkflow:           attr.ResetWorkflow,
+	}
+
+	err := p.replicateEvents(request)
+	retryErr, ok := err.(*workflow.RetryTaskError)
+	if !ok {
+		return err
+	}
+
+	// events before this task are missing, resend them from the source cluster and try again
+	if retryErr.GetRunId() != "" {
+		p.resendHistory(attr.GetDomainId(), attr.GetWorkflowId(), retryErr, metrics.HistoryRereplicationByHistoryReplicationScope,
+			attr.GetRunId(), attr.GetFirstEventId())
+	}
+	request.ForceBufferEvents = common.BoolPtr(true)
+	return p.replicateEvents(request)
+}
+
+func (p *replicationTaskPuller) applySyncActivityTask(task *replicator.ReplicationTask) error {
+	attr := task.SyncActicvityTaskAttributes
+	request := &h.SyncActivityRequest{
+		DomainId:          attr.DomainId,
+		WorkflowId:        attr.WorkflowId,
+		RunId:             attr.RunId,
+		Version:           attr.Version,
+		ScheduledId:       attr.ScheduledId,
+		ScheduledTime:     attr.ScheduledTime,
+		StartedId:         attr.StartedId,
+		StartedTime:       attr.StartedTime,
+		LastHeartbeatTime: attr.LastHeartbeatTime,
+		Details:           attr.Details,
+		Attempt:           attr.Attempt,
+	}
+
+	err := p.syncActivity(request)
+	retryErr, ok := err.(*workflow.RetryTaskError)
+	if !ok || retryErr.GetRunId() == "" {
+		return err
+	}
+
+	// the next event ID should be at least schedule ID + 1
+	p.resendHistory(attr.GetDomainId(), attr.GetWorkflowId(), retryErr, metrics.HistoryRereplicationByActivityReplicationScope,
+		attr.GetRunId(), attr.GetScheduledId()+1)
+	return p.syncActivity(request)
+}
+
+func (p *replicationTaskPuller) replicateEvents(request *h.ReplicateEventsRequest) error {
+	ctx, cancel := context.WithTimeout(context.Background(), replicationTaskApplyTimeout)
+	defer cancel()
+	return p.historyEngine.ReplicateEvents(ctx, request)
+}
+
+func (p *replicationTaskPuller) syncActivity(request *h.SyncActivityRequest) error {
+	ctx, cancel := context.WithTimeout(context.Background(), replicationTaskApplyTimeout)
+	defer cancel()
+	return p.historyEngine.SyncActivity(ctx, request)
+}
+
+func (p *replicationTaskPuller) resendHistory(domainID string, workflowID string, retryErr *workflow.RetryTaskError,
+	scope int, endRunID string, endEventID int64) {
+	p.metricsClient.IncCounter(scope, metrics.CadenceClientRequests)
+	sw := p.metricsClient.StartTimer(scope, metrics.CadenceClientLatency)
+	defer sw.Stop()
+
+	err := p.historyRereplicator.SendMultiWorkflowHistory(
+		domainID, workflowID,
+		retryErr.GetRunId(), retryErr.GetNextEventId(), endRunID, endEventID,
+	)
+	if err != nil {
+		p.logger.WithFields(bark.Fields{
+			logging.TagErr:                 err,
+			logging.TagWorkflowExecutionID: workflowID,
+		}).Error("Error resending history.")
+	}
+}
+
+// remoteAdminClientFn resolves the admin client of the remote cluster on every call, so that changes
+// to the remote cluster address are picked up at runtime
+func remoteAdminClientFn(shard ShardContext, clusterName string) func() (admin.Client, error) {
+	return func() (admin.Client, error) {
+		return shard.GetService().GetClientBean().GetRemoteAdminClient(clusterName)
+	}
+}
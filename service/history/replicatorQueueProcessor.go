@@ -22,6 +22,7 @@ package history
 
 import (
 	"errors"
+	"math"
 	"sync"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/xdc"
 )
 
 type (
@@ -44,6 +46,7 @@ type (
 		historyMgr          persistence.HistoryManager
 		historyV2Mgr        persistence.HistoryV2Manager
 		replicator          messaging.Producer
+		replicationControl  xdc.ReplicationControl
 		metricsClient       metrics.Client
 		options             *QueueProcessorOptions
 		logger              bark.Logger
@@ -52,6 +55,10 @@ type (
 
 		sync.Mutex
 		lastShardSyncTimestamp time.Time
+		// remote cluster -> last replication task ID applied, only used when remote clusters pull tasks
+		remoteAckLevels map[string]int64
+		// replication task ID -> domain ID of the tasks held because the replication of their domain is paused or throttled
+		heldTasks map[int64]string
 	}
 )
 
@@ -59,10 +66,12 @@ var (
 	errUnknownReplicationTask = errors.New("Unknown replication task")
 	errHistoryNotFoundTask    = errors.New("History not found")
 	defaultHistoryPageSize    = 1000
+	// replicationThrottleTimeout is how long a task waits for the domain rate limit before it is held and retried
+	replicationThrottleTimeout = 100 * time.Millisecond
 )
 
 func newReplicatorQueueProcessor(shard ShardContext, historyCache *historyCache, replicator messaging.Producer,
-	executionMgr persistence.ExecutionManager, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, logger bark.Logger) queueProcessor {
+	executionMgr persistence.ExecutionManager, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, logger bark.Logger) replicatorQueueProcessor {
 
 	currentClusterNamer := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
 
@@ -92,9 +101,12 @@ func newReplicatorQueueProcessor(shard ShardContext, historyCache *historyCache,
 		historyMgr:          historyMgr,
 		historyV2Mgr:        historyV2Mgr,
 		replicator:          replicator,
+		replicationControl:  xdc.NewReplicationControl(config.ReplicatorDomainPaused, config.ReplicatorDomainMaxRPS),
 		metricsClient:       shard.GetMetricsClient(),
 		options:             options,
 		logger:              logger,
+		remoteAckLevels:     make(map[string]int64),
+		heldTasks:           make(map[int64]string),
 	}
 
 	queueAckMgr := newQueueAckMgr(shard, options, processor, shard.GetReplicatorAckLevel(), logger)
@@ -111,6 +123,10 @@ func (p *replicatorQueueProcessorImpl) process(qTask queueTaskInfo) (int, error)
 		return metrics.ReplicatorQueueProcessorScope, errUnexpectedQueueTask
 	}
 
+	if err := p.holdTask(task); err != nil {
+		return metrics.ReplicatorQueueProcessorScope, err
+	}
+
 	switch task.TaskType {
 	case persistence.ReplicationTaskTypeSyncActivity:
 		err := p.processSyncActivityTask(task)
@@ -132,12 +148,89 @@ func (p *replicatorQueueProcessorImpl) process(qTask queueTaskInfo) (int, error)
 	}
 }
 
+// holdTask returns ErrTaskHeld if the replication of the task's domain to any of its remote clusters is paused,
+// or if the domain rate limit does not allow the task to be published yet
+func (p *replicatorQueueProcessorImpl) holdTask(task *persistence.ReplicationTaskInfo) error {
+	domainEntry, err := p.shard.GetDomainCache().GetDomainByID(task.DomainID)
+	if err != nil {
+		return err
+	}
+	domainName := domainEntry.GetInfo().Name
+	remoteClusters := []string{}
+	for _, cluster := range domainEntry.GetReplicationConfig().Clusters {
+		if cluster.ClusterName != p.currentClusterNamer {
+			remoteClusters = append(remoteClusters, cluster.ClusterName)
+		}
+	}
+
+	held := false
+	for _, cluster := range remoteClusters {
+		if p.replicationControl.IsPaused(domainName, cluster) {
+			held = true
+			break
+		}
+	}
+	if !held {
+		for _, cluster := range remoteClusters {
+			if !p.replicationControl.Consume(domainName, cluster, replicationThrottleTimeout) {
+				held = true
+				break
+			}
+		}
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	if held {
+		p.heldTasks[task.GetTaskID()] = task.DomainID
+		return ErrTaskHeld
+	}
+	delete(p.heldTasks, task.GetTaskID())
+	return nil
+}
+
+// getHeldTasksByDomain counts the replication tasks currently held per domain
+func (p *replicatorQueueProcessorImpl) getHeldTasksByDomain() map[string]int64 {
+	p.Lock()
+	defer p.Unlock()
+
+	heldTasks := make(map[string]int64)
+	for _, domainID := range p.heldTasks {
+		heldTasks[domainID]++
+	}
+	return heldTasks
+}
+
+// isPulledTaskHeld returns true if the replication of the task's domain to the polling cluster is paused,
+// or if the domain rate limit does not allow the task to be sent right now
+func (p *replicatorQueueProcessorImpl) isPulledTaskHeld(task *persistence.ReplicationTaskInfo, pollingCluster string) (bool, error) {
+	domainEntry, err := p.shard.GetDomainCache().GetDomainByID(task.DomainID)
+	if err != nil {
+		return false, err
+	}
+	domainName := domainEntry.GetInfo().Name
+	if p.replicationControl.IsPaused(domainName, pollingCluster) {
+		return true, nil
+	}
+	return !p.replicationControl.Consume(domainName, pollingCluster, 0), nil
+}
+
 func (p *replicatorQueueProcessorImpl) queueShutdown() error {
 	// there is no shutdown specific behavior for replication queue
 	return nil
 }
 
-func (p *replicatorQueueProcessorImpl) processSyncActivityTask(task *persistence.ReplicationTaskInfo) (retError error) {
+func (p *replicatorQueueProcessorImpl) processSyncActivityTask(task *persistence.ReplicationTaskInfo) error {
+	replicationTask, err := p.generateSyncActivityTask(task)
+	if err != nil || replicationTask == nil {
+		return err
+	}
+
+	return p.replicator.Publish(replicationTask)
+}
+
+func (p *replicatorQueueProcessorImpl) generateSyncActivityTask(task *persistence.ReplicationTaskInfo) (
+	replicationTask *replicator.ReplicationTask, retError error) {
 	domainID := task.DomainID
 	execution := shared.WorkflowExecution{
 		WorkflowId: common.StringPtr(task.WorkflowID),
@@ -145,25 +238,25 @@ func (p *replicatorQueueProcessorImpl) processSyncActivityTask(task *persistence
 	}
 	context, release, err := p.historyCache.getOrCreateWorkflowExecution(domainID, execution)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer func() { release(retError) }()
 
 	msBuilder, err := context.loadWorkflowExecution()
 	if err != nil {
 		if _, ok := err.(*shared.EntityNotExistsError); ok {
-			return nil
+			return nil, nil
 		}
-		return err
+		return nil, err
 	}
 	if !msBuilder.IsWorkflowExecutionRunning() {
 		// workflow already finished, no need to process the timer
-		return nil
+		return nil, nil
 	}
 
 	activityInfo, ok := msBuilder.GetActivityInfo(task.ScheduledID)
 	if !ok {
-		return nil
+		return nil, nil
 	}
 
 	// int64 can only represent several hundred years of time
@@ -176,7 +269,7 @@ func (p *replicatorQueueProcessorImpl) processSyncActivityTask(task *persistence
 		heartbeatTime = activityInfo.StartedTime.UnixNano()
 	}
 
-	replicationTask := &replicator.ReplicationTask{
+	replicationTask = &replicator.ReplicationTask{
 		TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeSyncActivity),
 		SyncActicvityTaskAttributes: &replicator.SyncActicvityTaskAttributes{
 			DomainId:          common.StringPtr(task.DomainID),
@@ -193,32 +286,232 @@ func (p *replicatorQueueProcessorImpl) processSyncActivityTask(task *persistence
 		},
 	}
 
-	return p.replicator.Publish(replicationTask)
+	return replicationTask, nil
 }
 
 func (p *replicatorQueueProcessorImpl) processHistoryReplicationTask(task *persistence.ReplicationTaskInfo) error {
 
-	domainEntry, err := p.shard.GetDomainCache().GetDomainByID(task.DomainID)
+	replicationTask, err := p.generateHistoryReplicationTask(task)
 	if err != nil {
 		return err
 	}
+
+	err = p.replicator.Publish(replicationTask)
+	if err == nil {
+		p.Lock()
+		p.lastShardSyncTimestamp = common.NewRealTimeSource().Now()
+		p.Unlock()
+	}
+	return err
+}
+
+func (p *replicatorQueueProcessorImpl) generateHistoryReplicationTask(task *persistence.ReplicationTaskInfo) (*replicator.ReplicationTask, error) {
+	domainEntry, err := p.shard.GetDomainCache().GetDomainByID(task.DomainID)
+	if err != nil {
+		return nil, err
+	}
 	targetClusters := []string{}
 	for _, cluster := range domainEntry.GetReplicationConfig().Clusters {
 		targetClusters = append(targetClusters, cluster.ClusterName)
 	}
 
-	replicationTask, err := GenerateReplicationTask(targetClusters, task, p.historyMgr, p.historyV2Mgr, p.metricsClient, p.logger, nil)
+	return GenerateReplicationTask(targetClusters, task, p.historyMgr, p.historyV2Mgr, p.metricsClient, p.logger, nil)
+}
+
+// getTasks returns the replication tasks after lastProcessedTaskID to a remote cluster pulling them over RPC.
+// lastProcessedTaskID is also the level the remote cluster has applied, which is used to complete the tasks
+// every remote cluster has moved past.
+func (p *replicatorQueueProcessorImpl) getTasks(pollingCluster string, lastProcessedTaskID int64) (*replicator.ReplicationMessages, error) {
+	if err := p.updateRemoteAckLevel(pollingCluster, lastProcessedTaskID); err != nil {
+		// failing to clean up completed tasks should not stop the remote cluster from making progress
+		p.logger.WithFields(bark.Fields{
+			logging.TagErr:            err,
+			logging.TagPollingCluster: pollingCluster,
+		}).Warn("Failed to complete replication tasks acknowledged by remote clusters.")
+	}
+
+	maxReadLevel := p.shard.GetTransferMaxReadLevel()
+	p.metricsClient.UpdateGauge(metrics.ReplicatorQueueProcessorScope, metrics.ReplicationTasksLag,
+		float64(maxReadLevel-lastProcessedTaskID))
+
+	response, err := p.executionMgr.GetReplicationTasks(&persistence.GetReplicationTasksRequest{
+		ReadLevel:    lastProcessedTaskID,
+		MaxReadLevel: maxReadLevel,
+		BatchSize:    p.options.BatchSize(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	readLevel := lastProcessedTaskID
+	replicationTasks := []*replicator.ReplicationTask{}
+	held := false
+	for _, task := range response.Tasks {
+		// a held task stops the page so that the read level does not move past it, and it is sent again
+		// with the next poll once the replication of its domain is resumed
+		if held, err = p.isPulledTaskHeld(task, pollingCluster); err != nil {
+			if _, ok := err.(*shared.EntityNotExistsError); !ok {
+				return nil, err
+			}
+			held = false
+		}
+		if held {
+			p.metricsClient.IncCounter(metrics.ReplicatorQueueProcessorScope, metrics.TaskHeldCounter)
+			break
+		}
+
+		replicationTask, err := p.generateReplicationTask(task)
+		if err != nil {
+			if _, ok := err.(*shared.EntityNotExistsError); !ok {
+				return nil, err
+			}
+			// the history is gone, there is nothing left to replicate for this task
+			p.logger.WithFields(bark.Fields{
+				logging.TagTaskID:              task.GetTaskID(),
+				logging.TagWorkflowExecutionID: task.WorkflowID,
+				logging.TagWorkflowRunID:       task.RunID,
+			}).Warn("Skipping replication task, history not found.")
+			replicationTask = nil
+		}
+		readLevel = task.GetTaskID()
+		if replicationTask == nil || !isTargetCluster(replicationTask, pollingCluster) {
+			continue
+		}
+		replicationTask.SourceTaskId = common.Int64Ptr(task.GetTaskID())
+		replicationTasks = append(replicationTasks, replicationTask)
+	}
+
+	// a held task is not worth polling for again right away, and the remote cluster has not caught up either
+	hasMore := len(response.NextPageToken) != 0 && !held
+	messages := &replicator.ReplicationMessages{
+		ReplicationTasks:       replicationTasks,
+		LastRetrievedMessageId: common.Int64Ptr(readLevel),
+		HasMore:                common.BoolPtr(hasMore),
+	}
+	if !hasMore && !held {
+		// the remote cluster has caught up with this shard, let it know the current time of this shard
+		messages.SyncShardStatus = &replicator.SyncShardStatusTaskAttributes{
+			SourceCluster: common.StringPtr(p.currentClusterNamer),
+			ShardId:       common.Int64Ptr(int64(p.shard.GetShardID())),
+			Timestamp:     common.Int64Ptr(p.shard.GetTimeSource().Now().UnixNano()),
+		}
+	}
+	return messages, nil
+}
+
+func (p *replicatorQueueProcessorImpl) generateReplicationTask(task *persistence.ReplicationTaskInfo) (*replicator.ReplicationTask, error) {
+	switch task.TaskType {
+	case persistence.ReplicationTaskTypeSyncActivity:
+		return p.generateSyncActivityTask(task)
+	case persistence.ReplicationTaskTypeHistory:
+		return p.generateHistoryReplicationTask(task)
+	default:
+		return nil, errUnknownReplicationTask
+	}
+}
+
+// updateRemoteAckLevel records the level a remote cluster has applied, and completes the replication tasks
+// once every remote cluster has moved past them.
+func (p *replicatorQueueProcessorImpl) updateRemoteAckLevel(pollingCluster string, lastProcessedTaskID int64) error {
+	p.Lock()
+	defer p.Unlock()
+
+	if lastProcessedTaskID > p.remoteAckLevels[pollingCluster] {
+		p.remoteAckLevels[pollingCluster] = lastProcessedTaskID
+	}
+
+	minAckLevel := int64(math.MaxInt64)
+	for cluster := range p.shard.GetService().GetClusterMetadata().GetAllClusterFailoverVersions() {
+		if cluster == p.currentClusterNamer {
+			continue
+		}
+		ackLevel, ok := p.remoteAckLevels[cluster]
+		if !ok {
+			// not every remote cluster has pulled from this shard yet
+			return nil
+		}
+		if ackLevel < minAckLevel {
+			minAckLevel = ackLevel
+		}
+	}
+
+	ackLevel := p.shard.GetReplicatorAckLevel()
+	if minAckLevel <= ackLevel {
+		return nil
+	}
+
+	response, err := p.executionMgr.GetReplicationTasks(&persistence.GetReplicationTasksRequest{
+		ReadLevel:    ackLevel,
+		MaxReadLevel: minAckLevel,
+		BatchSize:    p.options.BatchSize(),
+	})
 	if err != nil {
 		return err
 	}
+	for _, task := range response.Tasks {
+		err := p.executionMgr.CompleteReplicationTask(&persistence.CompleteReplicationTaskRequest{TaskID: task.GetTaskID()})
+		if err != nil {
+			return err
+		}
+		ackLevel = task.GetTaskID()
+	}
+	if len(response.NextPageToken) == 0 {
+		ackLevel = minAckLevel
+	}
+	return p.shard.UpdateReplicatorAckLevel(ackLevel)
+}
 
-	err = p.replicator.Publish(replicationTask)
-	if err == nil {
-		p.Lock()
-		p.lastShardSyncTimestamp = common.NewRealTimeSource().Now()
-		p.Unlock()
+func (p *replicatorQueueProcessorImpl) getRemoteAckLevels() map[string]int64 {
+	p.Lock()
+	defer p.Unlock()
+
+	ackLevels := make(map[string]int64, len(p.remoteAckLevels))
+	for cluster, ackLevel := range p.remoteAckLevels {
+		ackLevels[cluster] = ackLevel
 	}
-	return err
+	return ackLevels
+}
+
+// getPendingTasksByDomain counts the replication tasks above the replicator ack level per domain, scanning at
+// most maxTasks tasks. The returned flag tells whether the scan stopped before reaching the max read level.
+func (p *replicatorQueueProcessorImpl) getPendingTasksByDomain(maxTasks int) (map[string]int64, bool, error) {
+	pendingTasks := make(map[string]int64)
+	request := &persistence.GetReplicationTasksRequest{
+		ReadLevel:    p.shard.GetReplicatorAckLevel(),
+		MaxReadLevel: p.shard.GetTransferMaxReadLevel(),
+		BatchSize:    p.options.BatchSize(),
+	}
+
+	scanned := 0
+	for {
+		response, err := p.executionMgr.GetReplicationTasks(request)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, task := range response.Tasks {
+			pendingTasks[task.DomainID]++
+		}
+		scanned += len(response.Tasks)
+		if len(response.NextPageToken) == 0 {
+			return pendingTasks, false, nil
+		}
+		if scanned >= maxTasks {
+			return pendingTasks, true, nil
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+}
+
+func isTargetCluster(task *replicator.ReplicationTask, cluster string) bool {
+	if task.GetTaskType() != replicator.ReplicationTaskTypeHistory {
+		return true
+	}
+	for _, targetCluster := range task.HistoryTaskAttributes.TargetClusters {
+		if targetCluster == cluster {
+			return true
+		}
+	}
+	return false
 }
 
 // GenerateReplicationTask generate replication task
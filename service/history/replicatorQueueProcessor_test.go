@@ -27,6 +27,7 @@ import (
 
 	"github.com/pborman/uuid"
 	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-common/bark"
 	"github.com/uber-go/tally"
@@ -41,6 +42,8 @@ import (
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/common/xdc"
 )
 
 type (
@@ -90,6 +93,23 @@ func (s *replicatorQueueProcessorSuite) SetupTest() {
 	s.mockHistoryV2Mgr = &mocks.HistoryV2Manager{}
 	s.mockProducer = &mocks.KafkaProducer{}
 	s.mockMetadataMgr = &mocks.MetadataManager{}
+	// replication controls use the domain information
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: validDomainID, Name: "some random domain name"},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestAlternativeClusterName},
+				},
+			},
+			IsGlobalDomain: true,
+			TableVersion:   persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
 	s.mockClusterMetadata = &mocks.ClusterMetadata{}
 	s.mockMessagingClient = mocks.NewMockMessagingClient(s.mockProducer, nil)
 	s.mockClientBean = &client.MockClientBean{}
@@ -344,3 +364,123 @@ func (s *replicatorQueueProcessorSuite) TestSyncActivity_ActivityRunning() {
 	_, err := s.replicatorQueueProcessor.process(task)
 	s.Nil(err)
 }
+
+func (s *replicatorQueueProcessorSuite) TestGetTasks() {
+	domainID := validDomainID
+	workflowID := "some random workflow ID"
+	runID := uuid.New()
+	lastProcessedTaskID := int64(5)
+	mockShardManager := &mocks.ShardManager{}
+	s.mockShard.(*shardContextImpl).shardManager = mockShardManager
+	s.mockShard.(*shardContextImpl).transferMaxReadLevel = 100
+	s.mockClusterMetadata.On("GetAllClusterFailoverVersions").Return(cluster.TestAllClusterFailoverVersions)
+	mockShardManager.On("UpdateShard", mock.Anything).Return(nil).Once()
+
+	// tasks the only remote cluster has applied are completed
+	s.mockExecutionMgr.On("GetReplicationTasks", &persistence.GetReplicationTasksRequest{
+		ReadLevel:    0,
+		MaxReadLevel: lastProcessedTaskID,
+		BatchSize:    s.replicatorQueueProcessor.options.BatchSize(),
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{{TaskID: 3}},
+	}, nil).Once()
+	s.mockExecutionMgr.On("CompleteReplicationTask", &persistence.CompleteReplicationTaskRequest{TaskID: 3}).Return(nil).Once()
+
+	// tasks of workflows which are gone are skipped
+	s.mockExecutionMgr.On("GetReplicationTasks", &persistence.GetReplicationTasksRequest{
+		ReadLevel:    lastProcessedTaskID,
+		MaxReadLevel: 100,
+		BatchSize:    s.replicatorQueueProcessor.options.BatchSize(),
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{{
+			TaskType:    persistence.ReplicationTaskTypeSyncActivity,
+			TaskID:      7,
+			DomainID:    domainID,
+			WorkflowID:  workflowID,
+			RunID:       runID,
+			ScheduledID: 144,
+		}},
+	}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", &persistence.GetWorkflowExecutionRequest{
+		DomainID: domainID,
+		Execution: shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(runID),
+		},
+	}).Return(nil, &shared.EntityNotExistsError{})
+
+	messages, err := s.replicatorQueueProcessor.getTasks(cluster.TestAlternativeClusterName, lastProcessedTaskID)
+	s.Nil(err)
+	s.Empty(messages.ReplicationTasks)
+	s.Equal(int64(7), messages.GetLastRetrievedMessageId())
+	s.False(messages.GetHasMore())
+	s.NotNil(messages.SyncShardStatus)
+	s.Equal(s.currentClusterNamer, messages.SyncShardStatus.GetSourceCluster())
+	s.Equal(lastProcessedTaskID, s.mockShard.GetReplicatorAckLevel())
+	mockShardManager.AssertExpectations(s.T())
+}
+
+func (s *replicatorQueueProcessorSuite) TestProcess_ReplicationPaused() {
+	s.replicatorQueueProcessor.replicationControl = xdc.NewReplicationControl(
+		dynamicconfig.GetBoolPropertyFilteredByDomainAndCluster(true),
+		dynamicconfig.GetIntPropertyFilteredByDomainAndCluster(0),
+	)
+	task := &persistence.ReplicationTaskInfo{
+		TaskType:    persistence.ReplicationTaskTypeSyncActivity,
+		TaskID:      int64(1444),
+		DomainID:    validDomainID,
+		WorkflowID:  "some random workflow ID",
+		RunID:       uuid.New(),
+		ScheduledID: int64(144),
+	}
+
+	_, err := s.replicatorQueueProcessor.process(task)
+	s.Equal(ErrTaskHeld, err)
+	s.Equal(map[string]int64{validDomainID: 1}, s.replicatorQueueProcessor.getHeldTasksByDomain())
+
+	// the task is released once the replication is resumed
+	s.replicatorQueueProcessor.replicationControl = xdc.NewReplicationControl(
+		dynamicconfig.GetBoolPropertyFilteredByDomainAndCluster(false),
+		dynamicconfig.GetIntPropertyFilteredByDomainAndCluster(0),
+	)
+	s.mockExecutionMgr.On("CompleteReplicationTask", &persistence.CompleteReplicationTaskRequest{TaskID: task.TaskID}).Return(nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, &shared.EntityNotExistsError{})
+
+	_, err = s.replicatorQueueProcessor.process(task)
+	s.Nil(err)
+	s.Empty(s.replicatorQueueProcessor.getHeldTasksByDomain())
+}
+
+func (s *replicatorQueueProcessorSuite) TestGetTasks_ReplicationPaused() {
+	lastProcessedTaskID := int64(5)
+	s.mockShard.(*shardContextImpl).transferMaxReadLevel = 100
+	s.mockShard.(*shardContextImpl).shardInfo.ReplicationAckLevel = lastProcessedTaskID
+	s.mockClusterMetadata.On("GetAllClusterFailoverVersions").Return(cluster.TestAllClusterFailoverVersions)
+	s.replicatorQueueProcessor.replicationControl = xdc.NewReplicationControl(
+		dynamicconfig.GetBoolPropertyFilteredByDomainAndCluster(true),
+		dynamicconfig.GetIntPropertyFilteredByDomainAndCluster(0),
+	)
+
+	s.mockExecutionMgr.On("GetReplicationTasks", &persistence.GetReplicationTasksRequest{
+		ReadLevel:    lastProcessedTaskID,
+		MaxReadLevel: 100,
+		BatchSize:    s.replicatorQueueProcessor.options.BatchSize(),
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{{
+			TaskType:    persistence.ReplicationTaskTypeSyncActivity,
+			TaskID:      7,
+			DomainID:    validDomainID,
+			WorkflowID:  "some random workflow ID",
+			RunID:       uuid.New(),
+			ScheduledID: 144,
+		}},
+	}, nil).Once()
+
+	// the held task is not sent and the remote cluster keeps polling from the same level
+	messages, err := s.replicatorQueueProcessor.getTasks(cluster.TestAlternativeClusterName, lastProcessedTaskID)
+	s.Nil(err)
+	s.Empty(messages.ReplicationTasks)
+	s.Equal(lastProcessedTaskID, messages.GetLastRetrievedMessageId())
+	s.False(messages.GetHasMore())
+	s.Nil(messages.SyncShardStatus)
+}
@@ -25,6 +25,7 @@ import (
 
 	"fmt"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/metrics"
 	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/service"
@@ -100,6 +101,16 @@ type Config struct {
 	ReplicatorProcessorMaxPollIntervalJitterCoefficient   dynamicconfig.FloatPropertyFn
 	ReplicatorProcessorUpdateAckInterval                  dynamicconfig.DurationPropertyFn
 	ReplicatorProcessorUpdateAckIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
+	ReplicatorDomainPaused                                dynamicconfig.BoolPropertyFnWithDomainAndClusterFilters
+	ReplicatorDomainMaxRPS                                dynamicconfig.IntPropertyFnWithDomainAndClusterFilters
+
+	// Replication task pull settings, used when replication does not go through Kafka
+	EnableReplicationTaskPull         dynamicconfig.BoolPropertyFn
+	ReplicationTaskPullInterval       dynamicconfig.DurationPropertyFn
+	ReplicationTaskPullErrorRetryWait dynamicconfig.DurationPropertyFn
+
+	// ClusterMetadataRefreshInterval is the interval to reload clusters persisted in the metadata store
+	ClusterMetadataRefreshInterval dynamicconfig.DurationPropertyFn
 
 	// Persistence settings
 	ExecutionMgrNumConns dynamicconfig.IntPropertyFn
@@ -189,6 +200,12 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 		ReplicatorProcessorMaxPollIntervalJitterCoefficient:   dc.GetFloat64Property(dynamicconfig.ReplicatorProcessorMaxPollIntervalJitterCoefficient, 0.15),
 		ReplicatorProcessorUpdateAckInterval:                  dc.GetDurationProperty(dynamicconfig.ReplicatorProcessorUpdateAckInterval, 5*time.Second),
 		ReplicatorProcessorUpdateAckIntervalJitterCoefficient: dc.GetFloat64Property(dynamicconfig.ReplicatorProcessorUpdateAckIntervalJitterCoefficient, 0.15),
+		ReplicatorDomainPaused:                                dc.GetBoolPropertyFilteredByDomainAndCluster(dynamicconfig.ReplicatorDomainPaused, false),
+		ReplicatorDomainMaxRPS:                                dc.GetIntPropertyFilteredByDomainAndCluster(dynamicconfig.ReplicatorDomainMaxRPS, 0),
+		EnableReplicationTaskPull:                             dc.GetBoolProperty(dynamicconfig.EnableReplicationTaskPull, false),
+		ReplicationTaskPullInterval:                           dc.GetDurationProperty(dynamicconfig.ReplicationTaskPullInterval, 2*time.Second),
+		ReplicationTaskPullErrorRetryWait:                     dc.GetDurationProperty(dynamicconfig.ReplicationTaskPullErrorRetryWait, 1*time.Second),
+		ClusterMetadataRefreshInterval:                        dc.GetDurationProperty(dynamicconfig.ClusterMetadataRefreshInterval, 1*time.Minute),
 		ExecutionMgrNumConns:                                  dc.GetIntProperty(dynamicconfig.ExecutionMgrNumConns, 50),
 		HistoryMgrNumConns:                                    dc.GetIntProperty(dynamicconfig.HistoryMgrNumConns, 50),
 		MaximumBufferedEventsBatch:                            dc.GetIntProperty(dynamicconfig.MaximumBufferedEventsBatch, 100),
@@ -257,6 +274,7 @@ func (s *Service) Start() {
 	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.PersistenceMaxQPS())
 	pConfig.SamplingConfig.VisibilityOpenMaxQPS = s.config.VisibilityOpenMaxQPS
 	pConfig.SamplingConfig.VisibilityClosedMaxQPS = s.config.VisibilityClosedMaxQPS
+	pConfig.SetFaultInjection(dynamicconfig.NewCollection(params.DynamicConfig, log))
 	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, log)
 
 	shardMgr, err := pFactory.NewShardManager()
@@ -269,6 +287,11 @@ func (s *Service) Start() {
 		log.Fatalf("failed to create metadata manager: %v", err)
 	}
 
+	clusterMetadataRefresher := cluster.NewMetadataRefresher(params.ClusterMetadata, metadata, s.config.ClusterMetadataRefreshInterval, log)
+	if params.ClusterMetadata.IsGlobalDomainEnabled() {
+		clusterMetadataRefresher.Start()
+	}
+
 	visibility, err := pFactory.NewVisibilityManager(s.config.EnableVisibilitySampling())
 	if err != nil {
 		log.Fatalf("failed to create visibility manager: %v", err)
@@ -299,6 +322,7 @@ func (s *Service) Start() {
 	log.Infof("%v started", common.HistoryServiceName)
 
 	<-s.stopC
+	clusterMetadataRefresher.Stop()
 	base.Stop()
 }
 
@@ -54,6 +54,8 @@ type (
 		UpdateTransferClusterAckLevel(cluster string, ackLevel int64) error
 		GetReplicatorAckLevel() int64
 		UpdateReplicatorAckLevel(ackLevel int64) error
+		GetClusterReplicationLevel(cluster string) int64
+		UpdateClusterReplicationLevel(cluster string, lastTaskID int64) error
 		GetTimerAckLevel() time.Time
 		UpdateTimerAckLevel(ackLevel time.Time) error
 		GetTimerClusterAckLevel(cluster string) time.Time
@@ -211,6 +213,31 @@ func (s *shardContextImpl) UpdateReplicatorAckLevel(ackLevel int64) error {
 	return s.updateShardInfoLocked()
 }
 
+func (s *shardContextImpl) GetClusterReplicationLevel(cluster string) int64 {
+	s.RLock()
+	defer s.RUnlock()
+
+	// if we can find corresponding replication level
+	if replicationLevel, ok := s.shardInfo.ClusterReplicationLevel[cluster]; ok {
+		return replicationLevel
+	}
+
+	// nothing has been pulled from this cluster yet
+	return 0
+}
+
+func (s *shardContextImpl) UpdateClusterReplicationLevel(cluster string, lastTaskID int64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.shardInfo.ClusterReplicationLevel == nil {
+		s.shardInfo.ClusterReplicationLevel = make(map[string]int64)
+	}
+	s.shardInfo.ClusterReplicationLevel[cluster] = lastTaskID
+	s.shardInfo.StolenSinceRenew = 0
+	return s.updateShardInfoLocked()
+}
+
 func (s *shardContextImpl) GetTimerAckLevel() time.Time {
 	s.RLock()
 	defer s.RUnlock()
@@ -262,7 +289,7 @@ func (s *shardContextImpl) DeleteTransferFailoverLevel(failoverID string) error
 	defer s.Unlock()
 
 	if level, ok := s.shardInfo.TransferFailoverLevels[failoverID]; ok {
-		s.metricsClient.RecordTimer(metrics.ShardInfoScope, metrics.ShardInfoTransferFailoverLatencyTimer, time.Since(level.StartTime))
+		s.metricsClient.RecordTimer(metrics.ShardInfoScope, metrics.ShardInfoTransferFailoverLatencyTimer, s.GetTimeSource().Now().Sub(level.StartTime))
 		delete(s.shardInfo.TransferFailoverLevels, failoverID)
 	}
 	return s.updateShardInfoLocked()
@@ -292,7 +319,7 @@ func (s *shardContextImpl) DeleteTimerFailoverLevel(failoverID string) error {
 	defer s.Unlock()
 
 	if level, ok := s.shardInfo.TimerFailoverLevels[failoverID]; ok {
-		s.metricsClient.RecordTimer(metrics.ShardInfoScope, metrics.ShardInfoTimerFailoverLatencyTimer, time.Since(level.StartTime))
+		s.metricsClient.RecordTimer(metrics.ShardInfoScope, metrics.ShardInfoTimerFailoverLatencyTimer, s.GetTimeSource().Now().Sub(level.StartTime))
 		delete(s.shardInfo.TimerFailoverLevels, failoverID)
 	}
 	return s.updateShardInfoLocked()
@@ -906,7 +933,7 @@ func (s *shardContextImpl) emitShardInfoMetricsLogsLocked() {
 
 	replicationLag := s.transferMaxReadLevel - s.shardInfo.ReplicationAckLevel
 	transferLag := s.transferMaxReadLevel - s.shardInfo.TransferAckLevel
-	timerLag := time.Since(s.shardInfo.TimerAckLevel)
+	timerLag := s.GetTimeSource().Now().Sub(s.shardInfo.TimerAckLevel)
 
 	transferFailoverInProgress := len(s.shardInfo.TransferFailoverLevels)
 	timerFailoverInProgress := len(s.shardInfo.TimerFailoverLevels)
@@ -973,7 +1000,7 @@ func (s *shardContextImpl) allocateTimerIDsLocked(timerTasks []persistence.Task,
 }
 
 func (s *shardContextImpl) GetTimeSource() common.TimeSource {
-	return common.NewRealTimeSource()
+	return s.GetService().GetTimeSource()
 }
 
 func (s *shardContextImpl) SetCurrentTime(cluster string, currentTime time.Time) {
@@ -1113,6 +1140,10 @@ func copyShardInfo(shardInfo *persistence.ShardInfo) *persistence.ShardInfo {
 	for k, v := range shardInfo.ClusterTimerAckLevel {
 		clusterTimerAckLevel[k] = v
 	}
+	clusterReplicationLevel := make(map[string]int64)
+	for k, v := range shardInfo.ClusterReplicationLevel {
+		clusterReplicationLevel[k] = v
+	}
 	shardInfoCopy := &persistence.ShardInfo{
 		ShardID:                   shardInfo.ShardID,
 		Owner:                     shardInfo.Owner,
@@ -1125,6 +1156,7 @@ func copyShardInfo(shardInfo *persistence.ShardInfo) *persistence.ShardInfo {
 		TimerFailoverLevels:       timerFailoverLevels,
 		ClusterTransferAckLevel:   clusterTransferAckLevel,
 		ClusterTimerAckLevel:      clusterTimerAckLevel,
+		ClusterReplicationLevel:   clusterReplicationLevel,
 		DomainNotificationVersion: shardInfo.DomainNotificationVersion,
 	}
 
@@ -413,6 +413,7 @@ func (b *stateBuilderImpl) applyEvents(domainID, requestID string, execution sha
 			newRunStateBuilder = newMutableStateBuilderWithReplicationState(
 				b.clusterMetadata.GetCurrentClusterName(),
 				b.shard.GetConfig(),
+				b.shard.GetTimeSource(),
 				b.logger,
 				startedEvent.GetVersion(),
 			)
@@ -498,6 +498,7 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionContinuedA
 	expectedNewRunStateBuilder := newMutableStateBuilderWithReplicationState(
 		s.mockClusterMetadata.GetCurrentClusterName(),
 		s.mockShard.GetConfig(),
+		s.mockShard.GetTimeSource(),
 		s.logger,
 		newRunStartedEvent.GetVersion(),
 	)
@@ -692,6 +693,7 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionContinuedA
 	msBuilder := newMutableStateBuilderWithReplicationState(
 		"currentCluster",
 		s.mockShard.GetConfig(),
+		s.mockShard.GetTimeSource(),
 		s.logger,
 		version,
 	)
@@ -805,6 +807,7 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionContinuedA
 	expectedNewRunStateBuilder := newMutableStateBuilderWithReplicationState(
 		s.mockClusterMetadata.GetCurrentClusterName(),
 		s.mockShard.GetConfig(),
+		s.mockShard.GetTimeSource(),
 		s.logger,
 		newRunStartedEvent.GetVersion(),
 	)
@@ -23,6 +23,8 @@ package history
 import (
 	"sync"
 	"time"
+
+	"github.com/uber/cadence/common"
 )
 
 type (
@@ -56,6 +58,8 @@ type (
 		timer *time.Timer
 		// variable indicating when the above timer will fire
 		nextWakeupTime time.Time
+		// the clock the next wake up time is based on
+		timeSource common.TimeSource
 	}
 
 	// RemoteTimerGate interface
@@ -84,12 +88,20 @@ type (
 )
 
 // NewLocalTimerGate create a new timer gate instance
-func NewLocalTimerGate() LocalTimerGate {
+func NewLocalTimerGate(timeSource common.TimeSource) LocalTimerGate {
 	timer := &LocalTimerGateImpl{
 		timer:          time.NewTimer(0),
 		nextWakeupTime: time.Time{},
 		fireChan:       make(chan struct{}, 1),
 		closeChan:      make(chan struct{}),
+		timeSource:     timeSource,
+	}
+	// when time is skipped the timer has to be re-evaluated against the new time,
+	// the gate is fired and the owner updates it with the next wake up time
+	var advanceChan <-chan struct{}
+	controlledTimeSource, isControlled := timeSource.(*common.ControlledTimeSource)
+	if isControlled {
+		advanceChan = controlledTimeSource.Subscribe()
 	}
 	// the timer should be stopped when initialized
 	if !timer.timer.Stop() {
@@ -100,6 +112,9 @@ func NewLocalTimerGate() LocalTimerGate {
 	go func() {
 		defer close(timer.fireChan)
 		defer timer.timer.Stop()
+		if isControlled {
+			defer controlledTimeSource.Unsubscribe(advanceChan)
+		}
 	loop:
 		for {
 			select {
@@ -110,6 +125,12 @@ func NewLocalTimerGate() LocalTimerGate {
 				default:
 				}
 
+			case <-advanceChan:
+				select {
+				case timer.fireChan <- struct{}{}:
+				default:
+				}
+
 			case <-timer.closeChan:
 				// closed; cleanup and quit
 				break loop
@@ -134,7 +155,7 @@ func (timerGate *LocalTimerGateImpl) FireAfter(now time.Time) bool {
 // success means timer is idle or timer is set with a sooner time to fire
 func (timerGate *LocalTimerGateImpl) Update(nextTime time.Time) bool {
 	// NOTE: negative duration will make the timer fire immediately
-	now := time.Now()
+	now := timerGate.timeSource.Now()
 
 	if timerGate.timer.Stop() && timerGate.nextWakeupTime.Before(nextTime) {
 		// this means the timer, before stopped, is active && next wake up time do not have to be updated
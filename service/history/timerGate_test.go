@@ -27,6 +27,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common"
 )
 
 type (
@@ -43,7 +44,7 @@ type (
 )
 
 func BenchmarkLocalTimer(b *testing.B) {
-	timer := NewLocalTimerGate()
+	timer := NewLocalTimerGate(common.NewRealTimeSource())
 
 	for i := 0; i < b.N; i++ {
 		timer.Update(time.Now())
@@ -71,7 +72,7 @@ func (s *localTimerGateSuite) TearDownSuite() {
 }
 
 func (s *localTimerGateSuite) SetupTest() {
-	s.localTimerGate = NewLocalTimerGate()
+	s.localTimerGate = NewLocalTimerGate(common.NewRealTimeSource())
 }
 
 func (s *localTimerGateSuite) TearDownTest() {
@@ -111,6 +112,25 @@ func (s *localTimerGateSuite) TestTimerFire() {
 	}
 }
 
+func (s *localTimerGateSuite) TestTimerFireAfterTimeSkipped() {
+	timeSource := common.NewControlledTimeSource()
+	timerGate := NewLocalTimerGate(timeSource)
+	defer timerGate.Close()
+
+	now := timeSource.Now()
+	newTimer := now.Add(24 * time.Hour)
+	deadline := now.Add(2 * time.Second)
+	s.True(timerGate.Update(newTimer))
+
+	timeSource.Advance(24 * time.Hour)
+	select {
+	case <-timerGate.FireChan():
+	case <-time.NewTimer(deadline.Sub(now)).C:
+		s.Fail("timer should fire once time is skipped")
+	}
+	s.False(timerGate.FireAfter(timeSource.Now()))
+}
+
 func (s *localTimerGateSuite) TestTimerFireAfterUpdate_Active_Updated_BeforeNow() {
 	now := time.Now()
 	newTimer := now.Add(9 * time.Second)
@@ -79,7 +79,7 @@ func newTimerQueueActiveProcessor(shard ShardContext, historyService *historyEng
 		currentClusterName,
 	)
 
-	timerGate := NewLocalTimerGate()
+	timerGate := NewLocalTimerGate(shard.GetTimeSource())
 	processor := &timerQueueActiveProcessorImpl{
 		shard:              shard,
 		historyService:     historyService,
@@ -114,7 +114,7 @@ func newTimerQueueFailoverProcessor(shard ShardContext, historyService *historyE
 		// should use current cluster's time when doing domain failover
 		return shard.GetCurrentTime(currentClusterName)
 	}
-	failoverStartTime := time.Now()
+	failoverStartTime := shard.GetTimeSource().Now()
 	failoverUUID := uuid.New()
 
 	updateShardAckLevel := func(ackLevel TimerSequenceID) error {
@@ -153,7 +153,7 @@ func newTimerQueueFailoverProcessor(shard ShardContext, historyService *historyE
 		logger,
 	)
 
-	timerGate := NewLocalTimerGate()
+	timerGate := NewLocalTimerGate(shard.GetTimeSource())
 	processor := &timerQueueActiveProcessorImpl{
 		shard:              shard,
 		historyService:     historyService,
@@ -645,6 +645,7 @@ func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(task *persiste
 			TaskList:                      taskList,
 			ScheduleId:                    &scheduledID,
 			ScheduleToStartTimeoutSeconds: common.Int32Ptr(scheduleToStartTimeout),
+			Priority:                      scheduledEvent.ActivityTaskScheduledEventAttributes.Priority,
 		})
 
 		t.logger.Debugf("Adding ActivityTask for retry, WorkflowID: %v, RunID: %v, ScheduledID: %v, TaskList: %v, Attempt: %v, Err: %v",
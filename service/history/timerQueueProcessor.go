@@ -76,7 +76,7 @@ func newTimerQueueProcessor(shard ShardContext, historyService *historyEngineImp
 			historyRereplicator := xdc.NewHistoryRereplicator(
 				currentClusterName,
 				shard.GetDomainCache(),
-				shard.GetService().GetClientBean().GetRemoteAdminClient(clusterName),
+				remoteAdminClientFn(shard, clusterName),
 				func(ctx context.Context, request *h.ReplicateRawEventsRequest) error {
 					return historyService.ReplicateRawEvents(ctx, request)
 				},
@@ -326,7 +326,7 @@ func (t *timerQueueProcessorBase) internalProcessor() error {
 				t.config.TimerProcessorMaxPollInterval(),
 				t.config.TimerProcessorMaxPollIntervalJitterCoefficient(),
 			))
-			if t.lastPollTime.Add(t.config.TimerProcessorMaxPollInterval()).Before(time.Now()) {
+			if t.lastPollTime.Add(t.config.TimerProcessorMaxPollInterval()).Before(t.shard.GetTimeSource().Now()) {
 				lookAheadTimer, err := t.readAndFanoutTimerTasks()
 				if err != nil {
 					return err
@@ -359,7 +359,7 @@ func (t *timerQueueProcessorBase) readAndFanoutTimerTasks() (*persistence.TimerT
 		return nil, nil
 	}
 
-	t.lastPollTime = time.Now()
+	t.lastPollTime = t.shard.GetTimeSource().Now()
 	timerTasks, lookAheadTask, moreTasks, err := t.timerQueueAckMgr.readTimerTasks()
 	if err != nil {
 		t.notifyNewTimer(time.Time{}) // re-enqueue the event
@@ -396,7 +396,7 @@ func (t *timerQueueProcessorBase) processTaskAndAck(notificationChan <-chan stru
 
 	var scope int
 	var err error
-	startTime := time.Now()
+	startTime := t.shard.GetTimeSource().Now()
 	logger := t.initializeLoggerForTask(task)
 	attempt := 0
 	op := func() error {
@@ -411,7 +411,9 @@ func (t *timerQueueProcessorBase) processTaskAndAck(notificationChan <-chan stru
 			return true
 		}
 	}
-	defer func() { t.metricsClient.RecordTimer(scope, metrics.TaskLatency, time.Since(startTime)) }()
+	defer func() {
+		t.metricsClient.RecordTimer(scope, metrics.TaskLatency, t.shard.GetTimeSource().Now().Sub(startTime))
+	}()
 
 	for {
 		select {
@@ -443,10 +445,10 @@ func (t *timerQueueProcessorBase) processTaskOnce(notificationChan <-chan struct
 	default:
 	}
 
-	startTime := time.Now()
+	startTime := t.shard.GetTimeSource().Now()
 	scope, err := t.timerProcessor.process(task)
 	t.metricsClient.IncCounter(scope, metrics.TaskRequests)
-	t.metricsClient.RecordTimer(scope, metrics.TaskProcessingLatency, time.Since(startTime))
+	t.metricsClient.RecordTimer(scope, metrics.TaskProcessingLatency, t.shard.GetTimeSource().Now().Sub(startTime))
 
 	return scope, err
 }
@@ -476,7 +478,7 @@ func (t *timerQueueProcessorBase) handleTaskError(scope int, startTime time.Time
 
 	// this is a transient error
 	if _, ok := err.(*workflow.DomainNotActiveError); ok {
-		if time.Now().Sub(startTime) > cache.DomainCacheRefreshInterval {
+		if t.shard.GetTimeSource().Now().Sub(startTime) > cache.DomainCacheRefreshInterval {
 			t.metricsClient.IncCounter(scope, metrics.TaskNotActiveCounter)
 			return nil
 		}
@@ -506,7 +508,7 @@ func (t *timerQueueProcessorBase) ackTaskOnce(task *persistence.TimerTaskInfo, s
 	t.metricsClient.RecordTimer(
 		scope,
 		metrics.TaskQueueLatency,
-		time.Since(task.GetVisibilityTimestamp()),
+		t.shard.GetTimeSource().Now().Sub(task.GetVisibilityTimestamp()),
 	)
 	atomic.AddUint64(&t.timerFiredCount, 1)
 }
@@ -28,6 +28,7 @@ import (
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/suite"
@@ -118,7 +119,7 @@ func (s *timerQueueProcessorBaseSuite) SetupTest() {
 			metricsClient: metricsClient,
 		},
 		s.mockQueueAckMgr,
-		NewLocalTimerGate(),
+		NewLocalTimerGate(common.NewRealTimeSource()),
 		dynamicconfig.GetIntPropertyFn(10),
 		dynamicconfig.GetDurationPropertyFn(0*time.Second),
 		s.logger,
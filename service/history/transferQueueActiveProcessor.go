@@ -21,8 +21,6 @@
 package history
 
 import (
-	"time"
-
 	"github.com/pborman/uuid"
 	"github.com/uber-common/bark"
 	h "github.com/uber/cadence/.gen/go/history"
@@ -143,7 +141,7 @@ func newTransferQueueFailoverProcessor(shard ShardContext, historyService *histo
 	maxReadAckLevel := func() int64 {
 		return maxLevel // this is a const
 	}
-	failoverStartTime := time.Now()
+	failoverStartTime := shard.GetTimeSource().Now()
 	updateTransferAckLevel := func(ackLevel int64) error {
 		return shard.UpdateTransferFailoverLevel(
 			failoverUUID,
@@ -259,10 +257,11 @@ func (t *transferQueueActiveProcessorImpl) processActivityTask(task *persistence
 	}
 
 	timeout := common.MinInt32(ai.ScheduleToStartTimeout, common.MaxTaskTimeout)
+	priority := ai.ScheduledEvent.GetActivityTaskScheduledEventAttributes().GetPriority()
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushActivity(task, timeout)
+	return t.pushActivity(task, timeout, priority)
 }
 
 func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence.TransferTaskInfo) (retError error) {
@@ -320,6 +319,7 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence
 		decisionTimeout = executionInfo.StickyScheduleToStartTimeout
 	}
 	nextEventID := executionInfo.NextEventID
+	priority := executionInfo.Priority
 
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
@@ -331,7 +331,7 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence
 		}
 	}
 
-	return t.pushDecision(task, tasklist, decisionTimeout)
+	return t.pushDecision(task, tasklist, decisionTimeout, priority)
 }
 
 func (t *transferQueueActiveProcessorImpl) processCloseExecution(task *persistence.TransferTaskInfo) (retError error) {
@@ -748,6 +748,8 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(task *pers
 				WorkflowIdReusePolicy: attributes.WorkflowIdReusePolicy,
 				ChildPolicy:           attributes.ChildPolicy,
 				RetryPolicy:           attributes.RetryPolicy,
+				// children inherit the priority of their parent
+				Priority: common.Int32Ptr(msBuilder.GetExecutionInfo().Priority),
 			},
 			ParentExecutionInfo: &h.ParentExecutionInfo{
 				DomainUUID: common.StringPtr(domainID),
@@ -81,7 +81,7 @@ func newTransferQueueProcessor(shard ShardContext, historyService *historyEngine
 			historyRereplicator := xdc.NewHistoryRereplicator(
 				currentClusterName,
 				shard.GetDomainCache(),
-				shard.GetService().GetClientBean().GetRemoteAdminClient(clusterName),
+				remoteAdminClientFn(shard, clusterName),
 				func(ctx context.Context, request *h.ReplicateRawEventsRequest) error {
 					return historyService.ReplicateRawEvents(ctx, request)
 				},
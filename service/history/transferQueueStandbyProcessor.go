@@ -153,6 +153,7 @@ func (t *transferQueueStandbyProcessorImpl) process(qTask queueTaskInfo) (int, e
 func (t *transferQueueStandbyProcessorImpl) processActivityTask(transferTask *persistence.TransferTaskInfo) error {
 
 	var activityScheduleToStartTimeout *int32
+	var activityPriority int32
 	processTaskIfClosed := false
 	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
 		activityInfo, isPending := msBuilder.GetActivityInfo(transferTask.ScheduleID)
@@ -175,6 +176,7 @@ func (t *transferQueueStandbyProcessorImpl) processActivityTask(transferTask *pe
 			}
 
 			activityScheduleToStartTimeout = common.Int32Ptr(common.MinInt32(activityInfo.ScheduleToStartTimeout, common.MaxTaskTimeout))
+			activityPriority = activityInfo.ScheduledEvent.GetActivityTaskScheduledEventAttributes().GetPriority()
 			return nil
 		}
 
@@ -185,13 +187,14 @@ func (t *transferQueueStandbyProcessorImpl) processActivityTask(transferTask *pe
 		}
 
 		timeout := common.MinInt32(*activityScheduleToStartTimeout, common.MaxTaskTimeout)
-		err := t.pushActivity(transferTask, timeout)
+		err := t.pushActivity(transferTask, timeout, activityPriority)
 		return err
 	})
 }
 
 func (t *transferQueueStandbyProcessorImpl) processDecisionTask(transferTask *persistence.TransferTaskInfo) error {
 	var decisionScheduleToStartTimeout *int32
+	var decisionPriority int32
 	var tasklist *workflow.TaskList
 	processTaskIfClosed := false
 
@@ -241,6 +244,7 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(transferTask *pe
 
 			decisionScheduleToStartTimeout = common.Int32Ptr(decisionTimeout)
 			tasklist = &workflow.TaskList{Name: &transferTask.TaskList}
+			decisionPriority = executionInfo.Priority
 			return nil
 		}
 
@@ -251,7 +255,7 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(transferTask *pe
 		}
 
 		timeout := common.MinInt32(*decisionScheduleToStartTimeout, common.MaxTaskTimeout)
-		err := t.pushDecision(transferTask, tasklist, timeout)
+		err := t.pushDecision(transferTask, tasklist, timeout, decisionPriority)
 		return err
 	})
 }
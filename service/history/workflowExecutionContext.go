@@ -155,7 +155,7 @@ func (c *workflowExecutionContextImpl) loadWorkflowExecutionInternal() error {
 		return err
 	}
 
-	msBuilder := newMutableStateBuilder(c.clusterMetadata.GetCurrentClusterName(), c.shard.GetConfig(), c.logger)
+	msBuilder := newMutableStateBuilder(c.clusterMetadata.GetCurrentClusterName(), c.shard.GetConfig(), c.shard.GetTimeSource(), c.logger)
 	if response != nil && response.State != nil {
 		state := response.State
 		msBuilder.Load(state)
@@ -354,7 +354,7 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNewRun(transfe
 			c.msBuilder.GetExecutionInfo().LastFirstEventID, c.msBuilder.GetExecutionInfo().NextEventID)
 	}
 
-	now := time.Now()
+	now := c.shard.GetTimeSource().Now()
 	return c.update(transferTasks, timerTasks, transactionID, now, c.createReplicationTask, nil, "", newStateBuilder)
 }
 
@@ -616,7 +616,7 @@ func (c *workflowExecutionContextImpl) update(transferTasks []persistence.Task,
 
 	// Update went through so update the condition for new updates
 	c.updateCondition = c.msBuilder.GetNextEventID()
-	c.msBuilder.GetExecutionInfo().LastUpdatedTimestamp = time.Now()
+	c.msBuilder.GetExecutionInfo().LastUpdatedTimestamp = c.shard.GetTimeSource().Now()
 
 	// for any change in the workflow, send a event
 	c.shard.NotifyNewHistoryEvent(newHistoryEventNotification(
@@ -797,7 +797,7 @@ func (c *workflowExecutionContextImpl) scheduleNewDecision(transferTasks []persi
 			ScheduleID: di.ScheduleID,
 		})
 		if msBuilder.IsStickyTaskListEnabled() {
-			tBuilder := newTimerBuilder(c.shard.GetConfig(), c.logger, common.NewRealTimeSource())
+			tBuilder := newTimerBuilder(c.shard.GetConfig(), c.logger, c.shard.GetTimeSource())
 			stickyTaskTimeoutTimer := tBuilder.AddScheduleToStartDecisionTimoutTask(di.ScheduleID, di.Attempt,
 				executionInfo.StickyScheduleToStartTimeout)
 			timerTasks = append(timerTasks, stickyTaskTimeoutTimer)
@@ -501,11 +501,12 @@ func (w *workflowResetor) replayHistoryEvents(decisionFinishEventID int64, reque
 					resetMutableState = newMutableStateBuilderWithReplicationState(
 						clusterMetadata.GetCurrentClusterName(),
 						w.eng.shard.GetConfig(),
+						w.eng.shard.GetTimeSource(),
 						w.eng.logger,
 						firstEvent.GetVersion(),
 					)
 				} else {
-					resetMutableState = newMutableStateBuilder(clusterMetadata.GetCurrentClusterName(), w.eng.shard.GetConfig(), w.eng.logger)
+					resetMutableState = newMutableStateBuilder(clusterMetadata.GetCurrentClusterName(), w.eng.shard.GetConfig(), w.eng.shard.GetTimeSource(), w.eng.logger)
 				}
 
 				resetMutableState.executionInfo.EventStoreVersion = persistence.EventStoreVersionV2
@@ -538,7 +539,7 @@ func (w *workflowResetor) replayHistoryEvents(decisionFinishEventID int64, reque
 		return
 	}
 
-	startTime := time.Now()
+	startTime := w.eng.shard.GetTimeSource().Now()
 	resetMutableState.executionInfo.RunID = newRunID
 	resetMutableState.executionInfo.StartTimestamp = startTime
 	resetMutableState.executionInfo.LastUpdatedTimestamp = startTime
@@ -21,6 +21,8 @@
 package matching
 
 import (
+	"time"
+
 	"github.com/uber-common/bark"
 	"go.uber.org/atomic"
 )
@@ -33,24 +35,50 @@ type ackManager struct {
 	readLevel        int64          // Maximum TaskID inserted into outstandingTasks
 	ackLevel         int64          // Maximum TaskID below which all tasks are acked
 	backlogCounter   atomic.Int64
+	createdTimes     map[int64]time.Time // TaskID->created time of the tasks not acked yet
 }
 
 // Registers task as in-flight and moves read level to it. Tasks can be added in increasing order of taskID only.
-func (m *ackManager) addTask(taskID int64) {
+// Returns false if the task was already registered by addTaskAhead, in which case it must not be dispatched again.
+func (m *ackManager) addTask(taskID int64, createdTime time.Time) bool {
 	if m.readLevel >= taskID {
 		m.logger.Fatalf("Next task ID is less than current read level.  TaskID: %v, ReadLevel: %v", taskID,
 			m.readLevel)
 	}
 	m.readLevel = taskID
 	if _, ok := m.outstandingTasks[taskID]; ok {
-		m.logger.Fatalf("Already present in outstanding tasks: taskID=%v", taskID)
+		return false
 	}
 	m.outstandingTasks[taskID] = false // true is for acked
+	m.createdTimes[taskID] = createdTime
 	m.backlogCounter.Inc()
+	return true
+}
+
+// Registers a task read ahead of the read level as in-flight, without moving the read level, so that the
+// ack level does not move past the tasks below it that are not read yet. Returns false if the task is
+// already registered.
+func (m *ackManager) addTaskAhead(taskID int64, createdTime time.Time) bool {
+	if m.readLevel >= taskID {
+		return false
+	}
+	if _, ok := m.outstandingTasks[taskID]; ok {
+		return false
+	}
+	m.outstandingTasks[taskID] = false // true is for acked
+	m.createdTimes[taskID] = createdTime
+	m.backlogCounter.Inc()
+	return true
 }
 
 func newAckManager(logger bark.Logger) ackManager {
-	return ackManager{logger: logger, outstandingTasks: make(map[int64]bool), readLevel: -1, ackLevel: -1}
+	return ackManager{
+		logger:           logger,
+		outstandingTasks: make(map[int64]bool),
+		createdTimes:     make(map[int64]time.Time),
+		readLevel:        -1,
+		ackLevel:         -1,
+	}
 }
 
 func (m *ackManager) getReadLevel() int64 {
@@ -79,6 +107,7 @@ func (m *ackManager) setAckLevel(ackLevel int64) {
 func (m *ackManager) completeTask(taskID int64) (ackLevel int64) {
 	if completed, ok := m.outstandingTasks[taskID]; ok && !completed {
 		m.outstandingTasks[taskID] = true
+		delete(m.createdTimes, taskID)
 		m.backlogCounter.Dec()
 	}
 	// Update ackLevel
@@ -98,3 +127,17 @@ func (m *ackManager) completeTask(taskID int64) (ackLevel int64) {
 func (m *ackManager) getBacklogCountHint() int64 {
 	return m.backlogCounter.Load()
 }
+
+// getBacklogAge returns how long the oldest task not acked yet has been waiting, 0 if there is none
+func (m *ackManager) getBacklogAge(now time.Time) time.Duration {
+	var oldest time.Time
+	for _, createdTime := range m.createdTimes {
+		if !createdTime.IsZero() && (oldest.IsZero() || createdTime.Before(oldest)) {
+			oldest = createdTime
+		}
+	}
+	if oldest.IsZero() || oldest.After(now) {
+		return 0
+	}
+	return now.Sub(oldest)
+}
@@ -0,0 +1,299 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	s "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	// domainThrottleRetryInterval is how long a task list waits before reading from persistence
+	// again when its domain has too many tasks buffered in memory
+	domainThrottleRetryInterval = 100 * time.Millisecond
+	// domainPersistenceMaxWait is how long a persistence call waits on the rate limit of its
+	// domain before failing with errDomainPersistenceThrottled
+	domainPersistenceMaxWait = time.Second
+)
+
+var (
+	// errDomainTaskListLimitExceeded is returned when a task list cannot be loaded as its domain
+	// already has as many task lists loaded on this host as it is allowed
+	errDomainTaskListLimitExceeded = &s.ServiceBusyError{Message: "too many task lists loaded for domain"}
+	// errDomainPersistenceThrottled is returned when a task list call to persistence is throttled
+	// by the rate limit of its domain
+	errDomainPersistenceThrottled = &s.ServiceBusyError{Message: "domain task persistence rate limit exceeded"}
+)
+
+type (
+	// domainResources tracks the resources a domain uses on this matching host, so that a single
+	// domain with many task lists cannot exhaust the task lists, memory and persistence capacity
+	// shared with all other domains
+	domainResources struct {
+		domainID      string
+		domainName    string
+		config        *Config
+		metricsClient metrics.Client // tagged with the domain name
+		// bufferedTasks is the number of tasks read from persistence by the task lists of the domain
+		// which are waiting in memory to be dispatched, accessed atomically
+		bufferedTasks int64
+
+		sync.Mutex
+		taskLists          map[taskListID]*taskListManagerImpl
+		persistenceQPS     int
+		persistenceLimiter common.TokenBucket
+	}
+
+	// domainTaskManager is the persistence.TaskManager used by the task lists of a domain, it
+	// applies the persistence rate limit of the domain to the calls reading and writing tasks.
+	// Leases are not throttled as they are taken while holding the task list lock, and neither
+	// are task completions, which free up the resources of the domain.
+	domainTaskManager struct {
+		persistence.TaskManager
+		resources *domainResources
+	}
+
+	// fairReadScheduler bounds the number of task lists reading tasks from persistence at a
+	// time and hands out read slots to domains in round robin order, so that a domain with
+	// many backlogged task lists cannot starve the reads of other domains
+	fairReadScheduler struct {
+		maxConcurrentReads dynamicconfig.IntPropertyFn
+
+		sync.Mutex
+		inFlight int
+		waiters  map[string][]chan struct{}
+		order    []string // domains with waiting reads, in the order they are served
+	}
+)
+
+func newDomainResources(domainID string, domainName string, config *Config, metricsClient metrics.Client) *domainResources {
+	return &domainResources{
+		domainID:   domainID,
+		domainName: domainName,
+		config:     config,
+		metricsClient: metricsClient.Tagged(map[string]string{
+			metrics.DomainTagName: domainName,
+		}),
+		taskLists: make(map[taskListID]*taskListManagerImpl),
+	}
+}
+
+// addTaskList registers a task list loaded for the domain
+func (r *domainResources) addTaskList(tlMgr *taskListManagerImpl) {
+	r.Lock()
+	defer r.Unlock()
+	r.taskLists[*tlMgr.taskListID] = tlMgr
+}
+
+// removeTaskList unregisters a task list, unless it was already replaced by a newer instance,
+// and returns the number of task lists still loaded for the domain
+func (r *domainResources) removeTaskList(tlMgr *taskListManagerImpl) int {
+	r.Lock()
+	defer r.Unlock()
+	if r.taskLists[*tlMgr.taskListID] == tlMgr {
+		delete(r.taskLists, *tlMgr.taskListID)
+	}
+	return len(r.taskLists)
+}
+
+func (r *domainResources) taskListCount() int {
+	r.Lock()
+	defer r.Unlock()
+	return len(r.taskLists)
+}
+
+// addBufferedTasks updates the number of tasks of the domain waiting in memory to be dispatched
+func (r *domainResources) addBufferedTasks(delta int) {
+	atomic.AddInt64(&r.bufferedTasks, int64(delta))
+}
+
+// bufferedTaskCount returns the number of tasks read from persistence by the task lists of
+// the domain which are waiting in memory to be dispatched
+func (r *domainResources) bufferedTaskCount() int {
+	return int(atomic.LoadInt64(&r.bufferedTasks))
+}
+
+// isTaskListLimitReached returns true if the domain has as many task lists loaded as it is allowed
+func (r *domainResources) isTaskListLimitReached() bool {
+	return r.taskListCount() >= r.config.DomainMaxTaskLists(r.domainName)
+}
+
+// isTaskListLimitExceeded returns true if the domain cannot load any more task lists
+func (r *domainResources) isTaskListLimitExceeded() bool {
+	if !r.isTaskListLimitReached() {
+		return false
+	}
+	r.metricsClient.IncCounter(metrics.MatchingTaskListMgrScope, metrics.DomainTaskListLimitCounter)
+	return true
+}
+
+// isBufferLimitExceeded returns true if the task lists of the domain should stop reading tasks
+// from persistence until some of the tasks already in memory are dispatched
+func (r *domainResources) isBufferLimitExceeded() bool {
+	if r.bufferedTaskCount() < r.config.DomainMaxBufferedTasks(r.domainName) {
+		return false
+	}
+	r.metricsClient.IncCounter(metrics.MatchingTaskListMgrScope, metrics.DomainBufferThrottleCounter)
+	return true
+}
+
+// acquirePersistenceToken waits for the persistence rate limit of the domain to allow one more call
+func (r *domainResources) acquirePersistenceToken() error {
+	limiter := r.getPersistenceLimiter()
+	if ok, _ := limiter.TryConsume(1); ok {
+		return nil
+	}
+	r.metricsClient.IncCounter(metrics.MatchingTaskListMgrScope, metrics.DomainPersistenceThrottleCounter)
+	if !limiter.Consume(1, domainPersistenceMaxWait) {
+		return errDomainPersistenceThrottled
+	}
+	return nil
+}
+
+// getPersistenceLimiter returns the rate limiter of the domain, recreating it when the
+// configured qps changed
+func (r *domainResources) getPersistenceLimiter() common.TokenBucket {
+	qps := r.config.DomainPersistenceMaxQPS(r.domainName)
+	r.Lock()
+	defer r.Unlock()
+	if r.persistenceLimiter == nil || r.persistenceQPS != qps {
+		r.persistenceQPS = qps
+		r.persistenceLimiter = common.NewTokenBucket(qps, common.NewRealTimeSource())
+	}
+	return r.persistenceLimiter
+}
+
+func newDomainTaskManager(taskManager persistence.TaskManager, resources *domainResources) persistence.TaskManager {
+	return &domainTaskManager{
+		TaskManager: taskManager,
+		resources:   resources,
+	}
+}
+
+func (m *domainTaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequest) (*persistence.UpdateTaskListResponse, error) {
+	if err := m.resources.acquirePersistenceToken(); err != nil {
+		return nil, err
+	}
+	return m.TaskManager.UpdateTaskList(request)
+}
+
+func (m *domainTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*persistence.CreateTasksResponse, error) {
+	if err := m.resources.acquirePersistenceToken(); err != nil {
+		return nil, err
+	}
+	return m.TaskManager.CreateTasks(request)
+}
+
+func (m *domainTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+	if err := m.resources.acquirePersistenceToken(); err != nil {
+		return nil, err
+	}
+	return m.TaskManager.GetTasks(request)
+}
+
+func newFairReadScheduler(maxConcurrentReads dynamicconfig.IntPropertyFn) *fairReadScheduler {
+	return &fairReadScheduler{
+		maxConcurrentReads: maxConcurrentReads,
+		waiters:            make(map[string][]chan struct{}),
+	}
+}
+
+// acquire blocks until the domain is given a read slot, which must be returned with release.
+// Returns false without a slot if stopCh is closed first.
+func (rs *fairReadScheduler) acquire(domainID string, stopCh <-chan struct{}) bool {
+	rs.Lock()
+	if len(rs.order) == 0 && rs.inFlight < rs.maxConcurrentReads() {
+		rs.inFlight++
+		rs.Unlock()
+		return true
+	}
+	grantCh := make(chan struct{})
+	if len(rs.waiters[domainID]) == 0 {
+		rs.order = append(rs.order, domainID)
+	}
+	rs.waiters[domainID] = append(rs.waiters[domainID], grantCh)
+	rs.Unlock()
+
+	select {
+	case <-grantCh:
+		return true
+	case <-stopCh:
+		if !rs.removeWaiter(domainID, grantCh) {
+			// the slot was granted while stopping, pass it on
+			rs.release()
+		}
+		return false
+	}
+}
+
+// release returns a read slot and hands out free slots to the domains waiting for one
+func (rs *fairReadScheduler) release() {
+	rs.Lock()
+	defer rs.Unlock()
+	rs.inFlight--
+	for len(rs.order) > 0 && rs.inFlight < rs.maxConcurrentReads() {
+		domainID := rs.order[0]
+		rs.order = rs.order[1:]
+		waiters := rs.waiters[domainID]
+		if len(waiters) > 1 {
+			rs.waiters[domainID] = waiters[1:]
+			// the domain goes to the back of the line for its next read
+			rs.order = append(rs.order, domainID)
+		} else {
+			delete(rs.waiters, domainID)
+		}
+		rs.inFlight++
+		close(waiters[0])
+	}
+}
+
+// removeWaiter returns false if the read was already given a slot
+func (rs *fairReadScheduler) removeWaiter(domainID string, grantCh chan struct{}) bool {
+	rs.Lock()
+	defer rs.Unlock()
+	waiters := rs.waiters[domainID]
+	for i, ch := range waiters {
+		if ch != grantCh {
+			continue
+		}
+		waiters = append(waiters[:i], waiters[i+1:]...)
+		if len(waiters) > 0 {
+			rs.waiters[domainID] = waiters
+			return true
+		}
+		delete(rs.waiters, domainID)
+		for j, id := range rs.order {
+			if id == domainID {
+				rs.order = append(rs.order[:j], rs.order[j+1:]...)
+				break
+			}
+		}
+		return true
+	}
+	return false
+}
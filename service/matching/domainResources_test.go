@@ -0,0 +1,171 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+func TestDomainTaskListLimit(t *testing.T) {
+	logger := bark.NewLoggerFromLogrus(log.New())
+	cfg := defaultTestConfig()
+	cfg.DomainMaxTaskLists = dynamicconfig.GetIntPropertyFilteredByDomain(1)
+	mockDomainCache := &cache.DomainCacheMock{}
+	mockDomainCache.On("GetDomainByID", mock.Anything).Return(cache.CreateDomainCacheEntry("domainName"), nil)
+	me := newMatchingEngine(cfg, newTestTaskManager(logger), &mocks.HistoryClient{}, logger, mockDomainCache)
+	defer me.Stop()
+	kind := common.TaskListKindPtr(0)
+
+	tlMgr, err := me.getTaskListManager(newTaskListID("domain", "tl1", persistence.TaskListTypeActivity), kind)
+	require.NoError(t, err)
+	_, err = me.getTaskListManager(newTaskListID("domain", "tl2", persistence.TaskListTypeActivity), kind)
+	require.Equal(t, errDomainTaskListLimitExceeded, err)
+	// the limit does not apply to other domains
+	_, err = me.getTaskListManager(newTaskListID("domain2", "tl2", persistence.TaskListTypeActivity), kind)
+	require.NoError(t, err)
+
+	tlMgr.Stop()
+	_, err = me.getTaskListManager(newTaskListID("domain", "tl2", persistence.TaskListTypeActivity), kind)
+	require.NoError(t, err)
+}
+
+func TestDomainBufferLimit(t *testing.T) {
+	cfg := defaultTestConfig()
+	cfg.DomainMaxBufferedTasks = dynamicconfig.GetIntPropertyFilteredByDomain(1)
+	tlm := createTestTaskListManagerWithConfig(cfg)
+	require.False(t, tlm.domainResources.isBufferLimitExceeded())
+	tlm.domainResources.addBufferedTasks(1)
+	require.True(t, tlm.domainResources.isBufferLimitExceeded())
+}
+
+func TestDomainBufferedTasks_ExpiredTasksReleased(t *testing.T) {
+	tlm := createTestTaskListManager()
+	expired := time.Now().Add(-time.Second)
+	for taskID := int64(1); taskID <= 3; taskID++ {
+		tlm.domainResources.addBufferedTasks(1)
+		task := &persistence.TaskInfo{TaskID: taskID}
+		if taskID != 2 {
+			task.Expiry = expired
+		}
+		tlm.taskBuffer <- task
+	}
+	require.Equal(t, 3, tlm.domainResources.bufferedTaskCount())
+
+	tlm.sweepExpiredTasks()
+	require.Equal(t, 1, tlm.domainResources.bufferedTaskCount())
+	require.Equal(t, 1, len(tlm.taskBuffer))
+}
+
+func TestDomainResourcesRemovedWithLastTaskList(t *testing.T) {
+	logger := bark.NewLoggerFromLogrus(log.New())
+	mockDomainCache := &cache.DomainCacheMock{}
+	mockDomainCache.On("GetDomainByID", mock.Anything).Return(cache.CreateDomainCacheEntry("domainName"), nil)
+	me := newMatchingEngine(defaultTestConfig(), newTestTaskManager(logger), &mocks.HistoryClient{}, logger, mockDomainCache)
+	defer me.Stop()
+	kind := common.TaskListKindPtr(0)
+
+	tlMgr1, err := me.getTaskListManager(newTaskListID("domain", "tl1", persistence.TaskListTypeActivity), kind)
+	require.NoError(t, err)
+	tlMgr2, err := me.getTaskListManager(newTaskListID("domain", "tl2", persistence.TaskListTypeActivity), kind)
+	require.NoError(t, err)
+
+	tlMgr1.Stop()
+	require.Contains(t, me.domainResources, "domain")
+	tlMgr2.Stop()
+	require.NotContains(t, me.domainResources, "domain")
+}
+
+func TestFairReadScheduler_RoundRobin(t *testing.T) {
+	rs := newFairReadScheduler(dynamicconfig.GetIntPropertyFn(1))
+	stopCh := make(chan struct{})
+	require.True(t, rs.acquire("a", stopCh))
+
+	granted := make(chan string, 3)
+	for _, domainID := range []string{"a", "a", "b"} {
+		waitForReadWaiters(t, rs, domainID, func() {
+			go func(domainID string) {
+				if rs.acquire(domainID, stopCh) {
+					granted <- domainID
+				}
+			}(domainID)
+		})
+	}
+
+	// domain b gets its turn before the second read of domain a
+	for _, expected := range []string{"a", "b", "a"} {
+		rs.release()
+		select {
+		case domainID := <-granted:
+			require.Equal(t, expected, domainID)
+		case <-time.After(time.Second):
+			require.FailNow(t, "read slot not granted")
+		}
+	}
+	rs.release()
+	require.Equal(t, 0, rs.inFlight)
+}
+
+func TestFairReadScheduler_Stop(t *testing.T) {
+	rs := newFairReadScheduler(dynamicconfig.GetIntPropertyFn(1))
+	require.True(t, rs.acquire("a", nil))
+
+	stopCh := make(chan struct{})
+	resultCh := make(chan bool, 1)
+	waitForReadWaiters(t, rs, "b", func() {
+		go func() { resultCh <- rs.acquire("b", stopCh) }()
+	})
+	close(stopCh)
+	require.False(t, <-resultCh)
+
+	rs.release()
+	require.Equal(t, 0, rs.inFlight)
+	require.Empty(t, rs.order)
+	require.Empty(t, rs.waiters)
+}
+
+// waitForReadWaiters calls enqueue and waits until it added a read waiting for the domain
+func waitForReadWaiters(t *testing.T, rs *fairReadScheduler, domainID string, enqueue func()) {
+	rs.Lock()
+	count := len(rs.waiters[domainID])
+	rs.Unlock()
+	enqueue()
+	for i := 0; i < 100; i++ {
+		rs.Lock()
+		added := len(rs.waiters[domainID]) > count
+		rs.Unlock()
+		if added {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.FailNow(t, "read was not queued")
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	m "github.com/uber/cadence/.gen/go/matching"
+	s "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// forwarder forwards tasks and polls from a task list partition to the root partition
+	// of the task list, so that tasks added to a partition without pollers can still be
+	// matched with pollers waiting on another partition. Pollers waiting on the root
+	// partition are forwarded polls from all the partitions
+	forwarder struct {
+		taskListID   *taskListID
+		taskListKind s.TaskListKind
+		rootName     string
+		client       matching.Client
+		timeSource   common.TimeSource
+		// token channels bound the number of requests outstanding against the root partition
+		addReqToken  chan struct{}
+		pollReqToken chan struct{}
+	}
+)
+
+var errForwarderSlowDown = errors.New("too many outstanding forwarded requests")
+
+// newForwarder returns a forwarder for the task list, or nil if the task list is not a
+// partition that can forward to a root partition
+func newForwarder(
+	config *taskListConfig,
+	taskListID *taskListID,
+	taskListKind s.TaskListKind,
+	client matching.Client,
+	timeSource common.TimeSource,
+) *forwarder {
+	if client == nil || taskListKind == s.TaskListKindSticky || !matching.IsTaskListPartition(taskListID.taskListName) {
+		return nil
+	}
+	return &forwarder{
+		taskListID:   taskListID,
+		taskListKind: taskListKind,
+		rootName:     matching.RootTaskListName(taskListID.taskListName),
+		client:       client,
+		timeSource:   timeSource,
+		addReqToken:  newTokenChannel(config.ForwarderMaxOutstandingTasks()),
+		pollReqToken: newTokenChannel(config.ForwarderMaxOutstandingPolls()),
+	}
+}
+
+func newTokenChannel(size int) chan struct{} {
+	if size < 1 {
+		size = 1
+	}
+	tokenC := make(chan struct{}, size)
+	for i := 0; i < size; i++ {
+		tokenC <- struct{}{}
+	}
+	return tokenC
+}
+
+// ForwardTask forwards the task to the root partition, which only accepts it if it can be
+// sync matched with a waiting poller. Returns nil if the task was delivered to a poller.
+func (fwdr *forwarder) ForwardTask(ctx context.Context, task *persistence.TaskInfo) error {
+	select {
+	case <-fwdr.addReqToken:
+		defer func() { fwdr.addReqToken <- struct{}{} }()
+	default:
+		return errForwarderSlowDown
+	}
+
+	execution := &s.WorkflowExecution{
+		WorkflowId: common.StringPtr(task.WorkflowID),
+		RunId:      common.StringPtr(task.RunID),
+	}
+	taskList := &s.TaskList{
+		Name: common.StringPtr(fwdr.rootName),
+		Kind: common.TaskListKindPtr(fwdr.taskListKind),
+	}
+	scheduleToStartTimeout := fwdr.remainingScheduleToStartTimeout(task)
+
+	switch fwdr.taskListID.taskType {
+	case persistence.TaskListTypeDecision:
+		return fwdr.client.AddDecisionTask(ctx, &m.AddDecisionTaskRequest{
+			DomainUUID:                    common.StringPtr(task.DomainID),
+			Execution:                     execution,
+			TaskList:                      taskList,
+			ScheduleId:                    common.Int64Ptr(task.ScheduleID),
+			ScheduleToStartTimeoutSeconds: scheduleToStartTimeout,
+			ForwardedFrom:                 common.StringPtr(fwdr.taskListID.taskListName),
+			Priority:                      common.Int32Ptr(task.Priority),
+		})
+	default:
+		return fwdr.client.AddActivityTask(ctx, &m.AddActivityTaskRequest{
+			DomainUUID:                    common.StringPtr(fwdr.taskListID.domainID),
+			SourceDomainUUID:              common.StringPtr(task.DomainID),
+			Execution:                     execution,
+			TaskList:                      taskList,
+			ScheduleId:                    common.Int64Ptr(task.ScheduleID),
+			ScheduleToStartTimeoutSeconds: scheduleToStartTimeout,
+			ForwardedFrom:                 common.StringPtr(fwdr.taskListID.taskListName),
+			Priority:                      common.Int32Ptr(task.Priority),
+		})
+	}
+}
+
+// ForwardPollForDecisionTask forwards the poll to the root partition
+func (fwdr *forwarder) ForwardPollForDecisionTask(
+	ctx context.Context,
+	request *m.PollForDecisionTaskRequest,
+) (*m.PollForDecisionTaskResponse, error) {
+	forwardedRequest := *request
+	pollRequest := *request.PollRequest
+	pollRequest.TaskList = &s.TaskList{
+		Name: common.StringPtr(fwdr.rootName),
+		Kind: common.TaskListKindPtr(fwdr.taskListKind),
+	}
+	forwardedRequest.PollRequest = &pollRequest
+	forwardedRequest.ForwardedFrom = common.StringPtr(fwdr.taskListID.taskListName)
+	return fwdr.client.PollForDecisionTask(ctx, &forwardedRequest)
+}
+
+// ForwardPollForActivityTask forwards the poll to the root partition
+func (fwdr *forwarder) ForwardPollForActivityTask(
+	ctx context.Context,
+	request *m.PollForActivityTaskRequest,
+) (*s.PollForActivityTaskResponse, error) {
+	forwardedRequest := *request
+	pollRequest := *request.PollRequest
+	pollRequest.TaskList = &s.TaskList{
+		Name: common.StringPtr(fwdr.rootName),
+		Kind: common.TaskListKindPtr(fwdr.taskListKind),
+	}
+	forwardedRequest.PollRequest = &pollRequest
+	forwardedRequest.ForwardedFrom = common.StringPtr(fwdr.taskListID.taskListName)
+	return fwdr.client.PollForActivityTask(ctx, &forwardedRequest)
+}
+
+// PollReqTokenC returns the channel to acquire a token from before forwarding a poll.
+// A nil forwarder returns a nil channel, which blocks forever.
+func (fwdr *forwarder) PollReqTokenC() <-chan struct{} {
+	if fwdr == nil {
+		return nil
+	}
+	return fwdr.pollReqToken
+}
+
+// ReleasePollReqToken returns the token acquired from PollReqTokenC
+func (fwdr *forwarder) ReleasePollReqToken() {
+	fwdr.pollReqToken <- struct{}{}
+}
+
+// remainingScheduleToStartTimeout returns the schedule to start timeout left for the task, so
+// that the root partition stamps the forwarded task with the same expiry
+func (fwdr *forwarder) remainingScheduleToStartTimeout(task *persistence.TaskInfo) *int32 {
+	if task.Expiry.IsZero() {
+		return common.Int32Ptr(task.ScheduleToStartTimeout)
+	}
+	remaining := task.Expiry.Sub(fwdr.timeSource.Now())
+	if remaining <= 0 {
+		remaining = time.Second
+	}
+	return common.Int32Ptr(int32(math.Ceil(remaining.Seconds())))
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	m "github.com/uber/cadence/.gen/go/matching"
+	s "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+func newTestForwarder(taskListName string, taskType int, client matching.Client) *forwarder {
+	cfg := NewConfig(dynamicconfig.NewNopCollection())
+	tlID := newTaskListID("domain", taskListName, taskType)
+	tlConfig := &taskListConfig{
+		ForwarderMaxOutstandingPolls: func() int { return cfg.ForwarderMaxOutstandingPolls("domain", taskListName, taskType) },
+		ForwarderMaxOutstandingTasks: func() int { return cfg.ForwarderMaxOutstandingTasks("domain", taskListName, taskType) },
+	}
+	return newForwarder(tlConfig, tlID, s.TaskListKindNormal, client, common.NewRealTimeSource())
+}
+
+func TestTaskListPartitionName(t *testing.T) {
+	require.Equal(t, "tl", matching.TaskListPartitionName("tl", 0))
+	partition := matching.TaskListPartitionName("a/b", 3)
+	require.Equal(t, common.ReservedTaskListPrefix+"a/b/3", partition)
+	require.True(t, matching.IsTaskListPartition(partition))
+	require.False(t, matching.IsTaskListPartition("a/b"))
+	require.Equal(t, "a/b", matching.RootTaskListName(partition))
+	require.Equal(t, "a/b", matching.RootTaskListName("a/b"))
+}
+
+func TestNewForwarder_RootPartition(t *testing.T) {
+	require.Nil(t, newTestForwarder("tl", persistence.TaskListTypeActivity, &mocks.MatchingClient{}))
+	require.Nil(t, newTestForwarder(matching.TaskListPartitionName("tl", 1), persistence.TaskListTypeActivity, nil))
+	require.NotNil(t, newTestForwarder(matching.TaskListPartitionName("tl", 1), persistence.TaskListTypeActivity, &mocks.MatchingClient{}))
+}
+
+func TestForwardTask(t *testing.T) {
+	client := &mocks.MatchingClient{}
+	partition := matching.TaskListPartitionName("tl", 2)
+	fwdr := newTestForwarder(partition, persistence.TaskListTypeActivity, client)
+
+	var request *m.AddActivityTaskRequest
+	client.On("AddActivityTask", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		request = args.Get(1).(*m.AddActivityTaskRequest)
+	}).Return(nil).Once()
+
+	task := &persistence.TaskInfo{
+		DomainID:   "source-domain",
+		WorkflowID: "wid",
+		RunID:      "rid",
+		ScheduleID: 5,
+		Expiry:     time.Now().Add(30 * time.Second),
+	}
+	require.NoError(t, fwdr.ForwardTask(context.Background(), task))
+	require.Equal(t, "tl", request.TaskList.GetName())
+	require.Equal(t, partition, request.GetForwardedFrom())
+	require.Equal(t, "domain", request.GetDomainUUID())
+	require.Equal(t, "source-domain", request.GetSourceDomainUUID())
+	require.Equal(t, int64(5), request.GetScheduleId())
+	require.True(t, request.GetScheduleToStartTimeoutSeconds() > 0 && request.GetScheduleToStartTimeoutSeconds() <= 30)
+	client.AssertExpectations(t)
+}
+
+func TestForwardTask_SlowDown(t *testing.T) {
+	client := &mocks.MatchingClient{}
+	fwdr := newTestForwarder(matching.TaskListPartitionName("tl", 1), persistence.TaskListTypeDecision, client)
+
+	<-fwdr.addReqToken
+	require.Equal(t, errForwarderSlowDown, fwdr.ForwardTask(context.Background(), &persistence.TaskInfo{}))
+	fwdr.addReqToken <- struct{}{}
+	client.AssertNotCalled(t, "AddDecisionTask", mock.Anything, mock.Anything)
+}
+
+func TestForwardPollForDecisionTask(t *testing.T) {
+	client := &mocks.MatchingClient{}
+	partition := matching.TaskListPartitionName("tl", 1)
+	fwdr := newTestForwarder(partition, persistence.TaskListTypeDecision, client)
+
+	var request *m.PollForDecisionTaskRequest
+	client.On("PollForDecisionTask", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		request = args.Get(1).(*m.PollForDecisionTaskRequest)
+	}).Return(&m.PollForDecisionTaskResponse{}, nil).Once()
+
+	original := &m.PollForDecisionTaskRequest{
+		DomainUUID: common.StringPtr("domain"),
+		PollerID:   common.StringPtr("poller"),
+		PollRequest: &s.PollForDecisionTaskRequest{
+			TaskList: &s.TaskList{Name: common.StringPtr(partition)},
+			Identity: common.StringPtr("identity"),
+		},
+	}
+	_, err := fwdr.ForwardPollForDecisionTask(context.Background(), original)
+	require.NoError(t, err)
+	require.Equal(t, "tl", request.PollRequest.TaskList.GetName())
+	require.Equal(t, partition, request.GetForwardedFrom())
+	require.Equal(t, "poller", request.GetPollerID())
+	require.Equal(t, "identity", request.PollRequest.GetIdentity())
+	// the original request is left untouched
+	require.Equal(t, partition, original.PollRequest.TaskList.GetName())
+	require.Nil(t, original.ForwardedFrom)
+	client.AssertExpectations(t)
+}
@@ -78,8 +78,13 @@ func (h *Handler) Start() error {
 	h.domainCache = cache.NewDomainCache(h.metadataMgr, h.GetClusterMetadata(), h.GetMetricsClient(), h.GetLogger())
 	h.domainCache.Start()
 	h.metricsClient = h.Service.GetMetricsClient()
+	matchingClient, err := h.Service.GetClientBean().GetMatchingClient(h.domainCache.GetDomainName)
+	if err != nil {
+		return err
+	}
 	h.engine = NewEngine(
-		h.taskPersistence, h.Service.GetClientBean().GetHistoryClient(), h.config, h.Service.GetLogger(), h.Service.GetMetricsClient(), h.domainCache,
+		h.taskPersistence, h.Service.GetClientBean().GetHistoryClient(), matchingClient, h.config, h.Service.GetLogger(),
+		h.Service.GetMetricsClient(), h.domainCache, h.Service.GetTimeSource(),
 	)
 	h.startWG.Done()
 	return nil
@@ -237,6 +242,76 @@ func (h *Handler) DescribeTaskList(ctx context.Context, request *m.DescribeTaskL
 	return response, h.handleErr(err, scope)
 }
 
+// UpdateTaskListDispatchRate sets or clears the dispatch rate of the target tasklist
+func (h *Handler) UpdateTaskListDispatchRate(ctx context.Context, request *m.UpdateTaskListDispatchRateRequest) error {
+	scope := metrics.MatchingUpdateTaskListDispatchRateScope
+	sw := h.startRequestProfile("UpdateTaskListDispatchRate", scope)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.handleErr(errMatchingHostThrottle, scope)
+	}
+
+	err := h.engine.UpdateTaskListDispatchRate(ctx, request)
+	return h.handleErr(err, scope)
+}
+
+// DescribeTaskListDispatchRate returns the dispatch rate set on the target tasklist
+func (h *Handler) DescribeTaskListDispatchRate(ctx context.Context, request *m.DescribeTaskListDispatchRateRequest) (*gen.DescribeTaskListDispatchRateResponse, error) {
+	scope := metrics.MatchingDescribeTaskListDispatchRateScope
+	sw := h.startRequestProfile("DescribeTaskListDispatchRate", scope)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.handleErr(errMatchingHostThrottle, scope)
+	}
+
+	response, err := h.engine.DescribeTaskListDispatchRate(ctx, request)
+	return response, h.handleErr(err, scope)
+}
+
+// PauseTaskList stops dispatching the tasks of the target tasklist
+func (h *Handler) PauseTaskList(ctx context.Context, request *m.PauseTaskListRequest) error {
+	scope := metrics.MatchingPauseTaskListScope
+	sw := h.startRequestProfile("PauseTaskList", scope)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.handleErr(errMatchingHostThrottle, scope)
+	}
+
+	err := h.engine.PauseTaskList(ctx, request)
+	return h.handleErr(err, scope)
+}
+
+// ResumeTaskList resumes dispatching the tasks of the target tasklist
+func (h *Handler) ResumeTaskList(ctx context.Context, request *m.ResumeTaskListRequest) error {
+	scope := metrics.MatchingResumeTaskListScope
+	sw := h.startRequestProfile("ResumeTaskList", scope)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.handleErr(errMatchingHostThrottle, scope)
+	}
+
+	err := h.engine.ResumeTaskList(ctx, request)
+	return h.handleErr(err, scope)
+}
+
+// ListTaskLists returns a page of the tasklists persisted for a domain
+func (h *Handler) ListTaskLists(ctx context.Context, request *m.ListTaskListsRequest) (*gen.ListTaskListsResponse, error) {
+	scope := metrics.MatchingListTaskListsScope
+	sw := h.startRequestProfile("ListTaskLists", scope)
+	defer sw.Stop()
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.handleErr(errMatchingHostThrottle, scope)
+	}
+
+	response, err := h.engine.ListTaskLists(ctx, request)
+	return response, h.handleErr(err, scope)
+}
+
 func (h *Handler) handleErr(err error, scope int) error {
 
 	if err == nil {
@@ -25,11 +25,13 @@ import (
 	"errors"
 	"math"
 	"sync"
+	"time"
 
 	h "github.com/uber/cadence/.gen/go/history"
 	m "github.com/uber/cadence/.gen/go/matching"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/logging"
@@ -47,6 +49,7 @@ import (
 type matchingEngineImpl struct {
 	taskManager     persistence.TaskManager
 	historyService  history.Client
+	matchingClient  matching.Client
 	tokenSerializer common.TaskTokenSerializer
 	logger          bark.Logger
 	metricsClient   metrics.Client
@@ -59,6 +62,12 @@ type matchingEngineImpl struct {
 	// unblock QueryWorkflow() call.
 	queryTaskMap map[string]chan *queryResult
 	domainCache  cache.DomainCache
+	timeSource   common.TimeSource
+	// resources used by each domain on this host
+	domainResourcesLock sync.Mutex
+	domainResources     map[string]*domainResources
+	// schedules task list reads from persistence fairly across domains
+	readScheduler *fairReadScheduler
 }
 
 type taskListID struct {
@@ -104,24 +113,30 @@ var _ Engine = (*matchingEngineImpl)(nil) // Asserts that interface is indeed im
 // NewEngine creates an instance of matching engine
 func NewEngine(taskManager persistence.TaskManager,
 	historyService history.Client,
+	matchingClient matching.Client,
 	config *Config,
 	logger bark.Logger,
 	metricsClient metrics.Client,
 	domainCache cache.DomainCache,
+	timeSource common.TimeSource,
 ) Engine {
 
 	return &matchingEngineImpl{
 		taskManager:     taskManager,
 		historyService:  historyService,
+		matchingClient:  matchingClient,
 		tokenSerializer: common.NewJSONTaskTokenSerializer(),
 		taskLists:       make(map[taskListID]taskListManager),
 		logger: logger.WithFields(bark.Fields{
 			logging.TagWorkflowComponent: logging.TagValueMatchingEngineComponent,
 		}),
-		metricsClient: metricsClient,
-		config:        config,
-		queryTaskMap:  make(map[string]chan *queryResult),
-		domainCache:   domainCache,
+		metricsClient:   metricsClient,
+		config:          config,
+		queryTaskMap:    make(map[string]chan *queryResult),
+		domainCache:     domainCache,
+		timeSource:      timeSource,
+		domainResources: make(map[string]*domainResources),
+		readScheduler:   newFairReadScheduler(config.MaxConcurrentTaskListReads),
 	}
 }
 
@@ -161,6 +176,31 @@ func (e *matchingEngineImpl) String() string {
 	return r
 }
 
+// Returns taskListManager for a task list if it is already cached, never loads it.
+func (e *matchingEngineImpl) getLoadedTaskListManager(taskList *taskListID) (taskListManager, bool) {
+	e.taskListsLock.RLock()
+	defer e.taskListsLock.RUnlock()
+	result, ok := e.taskLists[*taskList]
+	return result, ok
+}
+
+// getPersistedTaskList reads the task list from persistence without leasing it, it returns nil if the
+// task list does not exist
+func (e *matchingEngineImpl) getPersistedTaskList(taskList *taskListID) (*persistence.TaskListInfo, error) {
+	resp, err := e.taskManager.GetTaskList(&persistence.GetTaskListRequest{
+		DomainID: taskList.domainID,
+		TaskList: taskList.taskListName,
+		TaskType: taskList.taskType,
+	})
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return resp.TaskListInfo, nil
+}
+
 // Returns taskListManager for a task list. If not already cached gets new range from DB and
 // if successful creates one.
 func (e *matchingEngineImpl) getTaskListManager(taskList *taskListID,
@@ -173,14 +213,23 @@ func (e *matchingEngineImpl) getTaskListManager(taskList *taskListID,
 		return result, nil
 	}
 	e.taskListsLock.RUnlock()
+	// the domain is resolved before taking the write lock, as the domain cache may have to read it from persistence
+	taskListConfig, err := newTaskListConfig(taskList, e.config, e.domainCache)
+	if err != nil {
+		return nil, err
+	}
 	// If it gets here, write lock and check again in case a task list is created between the two locks
 	e.taskListsLock.Lock()
 	if result, ok := e.taskLists[*taskList]; ok {
 		e.taskListsLock.Unlock()
 		return result, nil
 	}
+	if e.getDomainResources(taskList.domainID, taskListConfig.DomainName).isTaskListLimitExceeded() {
+		e.taskListsLock.Unlock()
+		return nil, errDomainTaskListLimitExceeded
+	}
 	logging.LogTaskListLoadingEvent(e.logger, taskList.taskListName, taskList.taskType)
-	mgr, err := newTaskListManager(e, taskList, taskListKind, e.config)
+	mgr, err := newTaskListManager(e, taskList, taskListKind, taskListConfig)
 	if err != nil {
 		e.taskListsLock.Unlock()
 		logging.LogTaskListLoadingFailedEvent(e.logger, taskList.taskListName, taskList.taskType, err)
@@ -197,6 +246,35 @@ func (e *matchingEngineImpl) getTaskListManager(taskList *taskListID,
 	return mgr, nil
 }
 
+// getDomainResources returns the tracker of the resources the domain uses on this host
+func (e *matchingEngineImpl) getDomainResources(domainID string, domainName string) *domainResources {
+	e.domainResourcesLock.Lock()
+	defer e.domainResourcesLock.Unlock()
+	if resources, ok := e.domainResources[domainID]; ok {
+		return resources
+	}
+	resources := newDomainResources(domainID, domainName, e.config, e.metricsClient)
+	e.domainResources[domainID] = resources
+	return resources
+}
+
+// removeDomainTaskList unregisters the task list from the resources of its domain, which are dropped
+// once the domain has no task list loaded. Holding taskListsLock keeps the resources from being dropped
+// while getTaskListManager registers a new task list of the domain.
+func (e *matchingEngineImpl) removeDomainTaskList(tlMgr *taskListManagerImpl) {
+	e.taskListsLock.Lock()
+	defer e.taskListsLock.Unlock()
+	resources := tlMgr.domainResources
+	if resources.removeTaskList(tlMgr) > 0 {
+		return
+	}
+	e.domainResourcesLock.Lock()
+	defer e.domainResourcesLock.Unlock()
+	if e.domainResources[resources.domainID] == resources {
+		delete(e.domainResources, resources.domainID)
+	}
+}
+
 // For use in tests
 func (e *matchingEngineImpl) updateTaskList(taskList *taskListID, mgr taskListManager) {
 	e.taskListsLock.Lock()
@@ -229,8 +307,10 @@ func (e *matchingEngineImpl) AddDecisionTask(addRequest *m.AddDecisionTaskReques
 		WorkflowID:             addRequest.Execution.GetWorkflowId(),
 		ScheduleID:             addRequest.GetScheduleId(),
 		ScheduleToStartTimeout: addRequest.GetScheduleToStartTimeoutSeconds(),
+		Priority:               addRequest.GetPriority(),
 	}
-	return tlMgr.AddTask(addRequest.Execution, taskInfo)
+	e.setTaskExpiry(taskInfo)
+	return tlMgr.AddTask(addRequest.Execution, taskInfo, addRequest.GetForwardedFrom())
 }
 
 // AddActivityTask either delivers task directly to waiting poller or save it into task list persistence.
@@ -252,8 +332,19 @@ func (e *matchingEngineImpl) AddActivityTask(addRequest *m.AddActivityTaskReques
 		WorkflowID:             addRequest.Execution.GetWorkflowId(),
 		ScheduleID:             addRequest.GetScheduleId(),
 		ScheduleToStartTimeout: addRequest.GetScheduleToStartTimeoutSeconds(),
+		Priority:               addRequest.GetPriority(),
+	}
+	e.setTaskExpiry(taskInfo)
+	return tlMgr.AddTask(addRequest.Execution, taskInfo, addRequest.GetForwardedFrom())
+}
+
+// setTaskExpiry stamps the task with its creation time and, if it has a schedule to start timeout,
+// the time after which history will have timed it out
+func (e *matchingEngineImpl) setTaskExpiry(taskInfo *persistence.TaskInfo) {
+	taskInfo.CreatedTime = e.timeSource.Now()
+	if taskInfo.ScheduleToStartTimeout > 0 {
+		taskInfo.Expiry = taskInfo.CreatedTime.Add(time.Duration(taskInfo.ScheduleToStartTimeout) * time.Second)
 	}
-	return tlMgr.AddTask(addRequest.Execution, taskInfo)
 }
 
 var errQueryBeforeFirstDecisionCompleted = errors.New("query cannot be handled before first decision task is processed, please retry later")
@@ -275,7 +366,9 @@ pollLoop:
 		// Add frontend generated pollerID to context so tasklistMgr can support cancellation of
 		// long-poll when frontend calls CancelOutstandingPoll API
 		pollerCtx := context.WithValue(ctx, pollerIDKey, pollerID)
-		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
+		pollerCtx = context.WithValue(pollerCtx, identityKey, pollerInfo{
+			pollerIdentity: newPollerIdentity(request.GetIdentity(), request.PollerMetadata),
+		})
 		taskList := newTaskListID(domainID, taskListName, persistence.TaskListTypeDecision)
 		taskListKind := common.TaskListKindPtr(request.TaskList.GetKind())
 		tCtx, err := e.getTask(pollerCtx, taskList, nil, taskListKind)
@@ -287,6 +380,15 @@ pollLoop:
 			return nil, err
 		}
 
+		if tCtx.forwardPoll {
+			resp, err := tCtx.tlMgr.forwardPollForDecisionTask(pollerCtx, req)
+			if err != nil {
+				e.logger.Debugf("Forwarding poll for decision task from taskList=%v failed: %v", taskListName, err)
+				return emptyPollForDecisionTaskResponse, nil
+			}
+			return resp, nil
+		}
+
 		if tCtx.queryTaskInfo != nil {
 			tCtx.completeTask(nil) // this only means query task sync match succeed.
 
@@ -383,7 +485,10 @@ pollLoop:
 		// Add frontend generated pollerID to context so tasklistMgr can support cancellation of
 		// long-poll when frontend calls CancelOutstandingPoll API
 		pollerCtx := context.WithValue(ctx, pollerIDKey, pollerID)
-		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
+		pollerCtx = context.WithValue(pollerCtx, identityKey, pollerInfo{
+			pollerIdentity: newPollerIdentity(request.GetIdentity(), request.PollerMetadata),
+			ratePerSecond:  request.TaskListMetadata.GetMaxTasksPerSecond(),
+		})
 		taskListKind := common.TaskListKindPtr(request.TaskList.GetKind())
 		tCtx, err := e.getTask(pollerCtx, taskList, maxDispatch, taskListKind)
 		if err != nil {
@@ -393,6 +498,15 @@ pollLoop:
 			}
 			return nil, err
 		}
+
+		if tCtx.forwardPoll {
+			resp, err := tCtx.tlMgr.forwardPollForActivityTask(pollerCtx, req)
+			if err != nil {
+				e.logger.Debugf("Forwarding poll for activity task from taskList=%v failed: %v", taskListName, err)
+				return emptyPollForActivityTaskResponse, nil
+			}
+			return resp, nil
+		}
 		// Generate a unique requestId for this task which will be used for all retries
 		requestID := uuid.New()
 		resp, err := tCtx.RecordActivityTaskStartedWithRetry(ctx, &h.RecordActivityTaskStartedRequest{
@@ -556,9 +670,156 @@ func (e *matchingEngineImpl) DescribeTaskList(ctx context.Context, request *m.De
 		pollers = append(pollers, &workflow.PollerInfo{
 			Identity:       common.StringPtr(poller.identity),
 			LastAccessTime: common.Int64Ptr(poller.lastAccessTime.UnixNano()),
+			RatePerSecond:  common.Float64Ptr(poller.ratePerSecond),
+			BinaryChecksum: common.StringPtr(poller.binaryChecksum),
+			SdkVersion:     common.StringPtr(poller.sdkVersion),
+			Hostname:       common.StringPtr(poller.hostname),
+		})
+	}
+	return &workflow.DescribeTaskListResponse{
+		Pollers: pollers,
+		Paused:  common.BoolPtr(tlMgr.IsPaused()),
+	}, nil
+}
+
+func (e *matchingEngineImpl) UpdateTaskListDispatchRate(ctx context.Context, request *m.UpdateTaskListDispatchRateRequest) error {
+	domainID := request.GetDomainUUID()
+	updateRequest := request.UpdateRequest
+	dispatchRate := updateRequest.GetDispatchRatePerSecond()
+	if dispatchRate < 0 {
+		return &workflow.BadRequestError{Message: "DispatchRatePerSecond cannot be negative."}
+	}
+	if matching.IsTaskListPartition(updateRequest.TaskList.GetName()) {
+		// the partitions dispatch their share of the rate set on the root partition
+		return &workflow.BadRequestError{Message: "DispatchRatePerSecond can only be set on the root partition."}
+	}
+
+	taskList := newTaskListID(domainID, updateRequest.TaskList.GetName(), toPersistenceTaskListType(updateRequest.GetTaskListType()))
+	if tlMgr, ok := e.getLoadedTaskListManager(taskList); ok {
+		return tlMgr.UpdateDispatchRate(dispatchRate)
+	}
+
+	// the task list is not loaded, so the rate is written to persistence without leasing the task list.
+	// The write is fenced on the range read, if the task list was leased in between it is updated
+	// through its manager instead. A task list that does not exist yet is created by loading it.
+	info, err := e.getPersistedTaskList(taskList)
+	if err != nil {
+		return err
+	}
+	if info != nil {
+		info.DispatchRate = dispatchRate
+		_, err = e.taskManager.UpdateTaskList(&persistence.UpdateTaskListRequest{TaskListInfo: info})
+		if _, ok := err.(*persistence.ConditionFailedError); !ok {
+			if err != nil {
+				return err
+			}
+			// a manager loaded right before the read would overwrite the rate with the one it loaded
+			if tlMgr, ok := e.getLoadedTaskListManager(taskList); ok {
+				return tlMgr.UpdateDispatchRate(dispatchRate)
+			}
+			return nil
+		}
+	}
+
+	taskListKind := common.TaskListKindPtr(updateRequest.TaskList.GetKind())
+	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
+	if err != nil {
+		return err
+	}
+	return tlMgr.UpdateDispatchRate(dispatchRate)
+}
+
+func (e *matchingEngineImpl) DescribeTaskListDispatchRate(ctx context.Context, request *m.DescribeTaskListDispatchRateRequest) (*workflow.DescribeTaskListDispatchRateResponse, error) {
+	domainID := request.GetDomainUUID()
+	descRequest := request.DescRequest
+
+	taskList := newTaskListID(domainID, descRequest.TaskList.GetName(), toPersistenceTaskListType(descRequest.GetTaskListType()))
+	var dispatchRate float64
+	if tlMgr, ok := e.getLoadedTaskListManager(taskList); ok {
+		dispatchRate = tlMgr.GetDispatchRate()
+	} else {
+		// the rate of a task list that is not loaded is read from persistence, describing it does not lease it
+		info, err := e.getPersistedTaskList(taskList)
+		if err != nil {
+			return nil, err
+		}
+		if info != nil {
+			dispatchRate = info.DispatchRate
+		}
+	}
+
+	response := &workflow.DescribeTaskListDispatchRateResponse{}
+	if dispatchRate > 0 {
+		response.DispatchRatePerSecond = common.Float64Ptr(dispatchRate)
+	}
+	return response, nil
+}
+
+func (e *matchingEngineImpl) PauseTaskList(ctx context.Context, request *m.PauseTaskListRequest) error {
+	pauseRequest := request.PauseRequest
+	return e.setTaskListPaused(request.GetDomainUUID(), pauseRequest.TaskList, pauseRequest.GetTaskListType(), true)
+}
+
+func (e *matchingEngineImpl) ResumeTaskList(ctx context.Context, request *m.ResumeTaskListRequest) error {
+	resumeRequest := request.ResumeRequest
+	return e.setTaskListPaused(request.GetDomainUUID(), resumeRequest.TaskList, resumeRequest.GetTaskListType(), false)
+}
+
+func (e *matchingEngineImpl) setTaskListPaused(
+	domainID string, taskList *workflow.TaskList, taskListType workflow.TaskListType, paused bool,
+) error {
+	id := newTaskListID(domainID, taskList.GetName(), toPersistenceTaskListType(taskListType))
+	tlMgr, err := e.getTaskListManager(id, common.TaskListKindPtr(taskList.GetKind()))
+	if err != nil {
+		return err
+	}
+	return tlMgr.SetPaused(paused)
+}
+
+func (e *matchingEngineImpl) ListTaskLists(ctx context.Context, request *m.ListTaskListsRequest) (*workflow.ListTaskListsResponse, error) {
+	listRequest := request.ListRequest
+	resp, err := e.taskManager.ListTaskLists(&persistence.ListTaskListsRequest{
+		DomainID:      request.GetDomainUUID(),
+		PageSize:      int(listRequest.GetPageSize()),
+		NextPageToken: listRequest.NextPageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &workflow.ListTaskListsResponse{
+		TaskLists:     make([]*workflow.TaskListInfo, 0, len(resp.Items)),
+		NextPageToken: resp.NextPageToken,
+	}
+	for _, info := range resp.Items {
+		if matching.IsTaskListPartition(info.Name) {
+			// partitions are reported through their root task list
+			continue
+		}
+		kind := workflow.TaskListKindNormal
+		if info.Kind == persistence.TaskListKindSticky {
+			kind = workflow.TaskListKindSticky
+		}
+		taskListType := workflow.TaskListTypeDecision
+		if info.TaskType == persistence.TaskListTypeActivity {
+			taskListType = workflow.TaskListTypeActivity
+		}
+		response.TaskLists = append(response.TaskLists, &workflow.TaskListInfo{
+			TaskList:        &workflow.TaskList{Name: common.StringPtr(info.Name), Kind: common.TaskListKindPtr(kind)},
+			TaskListType:    common.TaskListTypePtr(taskListType),
+			Kind:            common.TaskListKindPtr(kind),
+			AckLevel:        common.Int64Ptr(info.AckLevel),
+			LastUpdatedTime: common.Int64Ptr(info.LastUpdated.UnixNano()),
 		})
 	}
-	return &workflow.DescribeTaskListResponse{Pollers: pollers}, nil
+	return response, nil
+}
+
+func toPersistenceTaskListType(taskListType workflow.TaskListType) int {
+	if taskListType == workflow.TaskListTypeActivity {
+		return persistence.TaskListTypeActivity
+	}
+	return persistence.TaskListTypeDecision
 }
 
 // Loads a task from persistence and wraps it in a task context
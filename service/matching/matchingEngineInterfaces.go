@@ -39,5 +39,10 @@ type (
 		RespondQueryTaskCompleted(ctx context.Context, request *m.RespondQueryTaskCompletedRequest) error
 		CancelOutstandingPoll(ctx context.Context, request *m.CancelOutstandingPollRequest) error
 		DescribeTaskList(ctx context.Context, request *m.DescribeTaskListRequest) (*workflow.DescribeTaskListResponse, error)
+		UpdateTaskListDispatchRate(ctx context.Context, request *m.UpdateTaskListDispatchRateRequest) error
+		DescribeTaskListDispatchRate(ctx context.Context, request *m.DescribeTaskListDispatchRateRequest) (*workflow.DescribeTaskListDispatchRateResponse, error)
+		PauseTaskList(ctx context.Context, request *m.PauseTaskListRequest) error
+		ResumeTaskList(ctx context.Context, request *m.ResumeTaskListRequest) error
+		ListTaskLists(ctx context.Context, request *m.ListTaskListsRequest) (*workflow.ListTaskListsResponse, error)
 	}
 )
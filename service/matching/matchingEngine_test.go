@@ -35,6 +35,7 @@ import (
 	"github.com/uber/cadence/.gen/go/matching"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
+	matchingclient "github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
@@ -133,6 +134,9 @@ func newMatchingEngine(
 		tokenSerializer: common.NewJSONTaskTokenSerializer(),
 		config:          config,
 		domainCache:     domainCache,
+		timeSource:      common.NewRealTimeSource(),
+		domainResources: make(map[string]*domainResources),
+		readScheduler:   newFairReadScheduler(config.MaxConcurrentTaskListReads),
 	}
 }
 
@@ -152,11 +156,11 @@ func (s *matchingEngineSuite) TestAckManager() {
 	const t4 = 340
 	const t5 = 360
 
-	m.addTask(t1)
+	m.addTask(t1, time.Time{})
 	s.EqualValues(100, m.getAckLevel())
 	s.EqualValues(t1, m.getReadLevel())
 
-	m.addTask(t2)
+	m.addTask(t2, time.Time{})
 	s.EqualValues(100, m.getAckLevel())
 	s.EqualValues(t2, m.getReadLevel())
 
@@ -172,11 +176,11 @@ func (s *matchingEngineSuite) TestAckManager() {
 	s.EqualValues(300, m.getAckLevel())
 	s.EqualValues(300, m.getReadLevel())
 
-	m.addTask(t3)
+	m.addTask(t3, time.Time{})
 	s.EqualValues(300, m.getAckLevel())
 	s.EqualValues(t3, m.getReadLevel())
 
-	m.addTask(t4)
+	m.addTask(t4, time.Time{})
 	s.EqualValues(300, m.getAckLevel())
 	s.EqualValues(t4, m.getReadLevel())
 
@@ -581,10 +585,11 @@ func (s *matchingEngineSuite) TestSyncMatchActivities() {
 	dPtr := _defaultTaskDispatchRPS
 	tlConfig, err := newTaskListConfig(tlID, s.matchingEngine.config, s.domainCache)
 	s.NoError(err)
-	mgr := newTaskListManagerWithRateLimiter(
+	mgr, err := newTaskListManagerWithRateLimiter(
 		s.matchingEngine, tlID, tlKind, s.domainCache, tlConfig,
 		newRateLimiter(&dPtr, dispatchTTL, _minBurst),
 	)
+	s.NoError(err)
 	s.matchingEngine.updateTaskList(tlID, mgr)
 	s.taskManager.getTaskListManager(tlID).rangeID = initialRangeID
 	s.NoError(mgr.Start())
@@ -738,10 +743,11 @@ func (s *matchingEngineSuite) concurrentPublishConsumeActivities(
 	dPtr := _defaultTaskDispatchRPS
 	tlConfig, err := newTaskListConfig(tlID, s.matchingEngine.config, s.domainCache)
 	s.NoError(err)
-	mgr := newTaskListManagerWithRateLimiter(
+	mgr, err := newTaskListManagerWithRateLimiter(
 		s.matchingEngine, tlID, tlKind, s.domainCache, tlConfig,
 		newRateLimiter(&dPtr, dispatchTTL, _minBurst),
 	)
+	s.NoError(err)
 	s.matchingEngine.updateTaskList(tlID, mgr)
 	s.taskManager.getTaskListManager(tlID).rangeID = initialRangeID
 	s.NoError(mgr.Start())
@@ -1343,6 +1349,92 @@ func (s *matchingEngineSuite) TestAddTaskAfterStartFailure() {
 	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
 }
 
+func (s *matchingEngineSuite) TestListTaskLists_SkipsPartitions() {
+	domainID := "domainId"
+	tl := "makeToast"
+	partitions := []string{common.ReservedTaskListPrefix + tl + "/1", common.ReservedTaskListPrefix + tl + "/2"}
+	for _, name := range append(partitions, tl) {
+		s.taskManager.getTaskListManager(newTaskListID(domainID, name, persistence.TaskListTypeDecision))
+	}
+
+	response, err := s.matchingEngine.ListTaskLists(context.Background(), &matching.ListTaskListsRequest{
+		DomainUUID:  common.StringPtr(domainID),
+		ListRequest: &workflow.ListTaskListsRequest{PageSize: common.Int32Ptr(10)},
+	})
+	s.NoError(err)
+	s.Equal(1, len(response.TaskLists))
+	s.Equal(tl, response.TaskLists[0].TaskList.GetName())
+}
+
+func (s *matchingEngineSuite) TestTaskListDispatchRate_NotLoaded() {
+	domainID := "domainId"
+	tl := "makeToast"
+	taskList := &workflow.TaskList{Name: common.StringPtr(tl)}
+	persisted := s.taskManager.getTaskListManager(newTaskListID(domainID, tl, persistence.TaskListTypeActivity))
+	persisted.rangeID = 3
+
+	err := s.matchingEngine.UpdateTaskListDispatchRate(context.Background(), &matching.UpdateTaskListDispatchRateRequest{
+		DomainUUID: common.StringPtr(domainID),
+		UpdateRequest: &workflow.UpdateTaskListDispatchRateRequest{
+			TaskList:              taskList,
+			TaskListType:          common.TaskListTypePtr(workflow.TaskListTypeActivity),
+			DispatchRatePerSecond: common.Float64Ptr(10),
+		},
+	})
+	s.NoError(err)
+	s.Equal(float64(10), persisted.dispatchRate)
+
+	response, err := s.matchingEngine.DescribeTaskListDispatchRate(context.Background(), &matching.DescribeTaskListDispatchRateRequest{
+		DomainUUID: common.StringPtr(domainID),
+		DescRequest: &workflow.DescribeTaskListDispatchRateRequest{
+			TaskList:     taskList,
+			TaskListType: common.TaskListTypePtr(workflow.TaskListTypeActivity),
+		},
+	})
+	s.NoError(err)
+	s.Equal(float64(10), response.GetDispatchRatePerSecond())
+
+	// neither call leased the task list
+	s.Equal(int64(3), persisted.rangeID)
+	s.Empty(s.matchingEngine.getTaskLists(10))
+}
+
+func (s *matchingEngineSuite) TestTaskListDispatchRate_Loaded() {
+	domainID := "domainId"
+	tl := "makeToast"
+	taskList := &workflow.TaskList{Name: common.StringPtr(tl)}
+	tlMgr, err := s.matchingEngine.getTaskListManager(
+		newTaskListID(domainID, tl, persistence.TaskListTypeActivity), common.TaskListKindPtr(workflow.TaskListKindNormal))
+	s.NoError(err)
+
+	err = s.matchingEngine.UpdateTaskListDispatchRate(context.Background(), &matching.UpdateTaskListDispatchRateRequest{
+		DomainUUID: common.StringPtr(domainID),
+		UpdateRequest: &workflow.UpdateTaskListDispatchRateRequest{
+			TaskList:              taskList,
+			TaskListType:          common.TaskListTypePtr(workflow.TaskListTypeActivity),
+			DispatchRatePerSecond: common.Float64Ptr(10),
+		},
+	})
+	s.NoError(err)
+	s.Equal(float64(10), tlMgr.GetDispatchRate())
+	persisted := s.taskManager.getTaskListManager(newTaskListID(domainID, tl, persistence.TaskListTypeActivity))
+	s.Equal(float64(10), persisted.dispatchRate)
+}
+
+func (s *matchingEngineSuite) TestTaskListDispatchRate_Partition() {
+	// the rate is only set on the root partition, the other partitions derive their share from it
+	err := s.matchingEngine.UpdateTaskListDispatchRate(context.Background(), &matching.UpdateTaskListDispatchRateRequest{
+		DomainUUID: common.StringPtr("domainId"),
+		UpdateRequest: &workflow.UpdateTaskListDispatchRateRequest{
+			TaskList:              &workflow.TaskList{Name: common.StringPtr(matchingclient.TaskListPartitionName("makeToast", 1))},
+			TaskListType:          common.TaskListTypePtr(workflow.TaskListTypeActivity),
+			DispatchRatePerSecond: common.Float64Ptr(10),
+		},
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+	s.Empty(s.matchingEngine.getTaskLists(10))
+}
+
 func (s *matchingEngineSuite) TestTaskListManagerGetTaskBatch() {
 	runID := "run1"
 	workflowID := "workflow1"
@@ -1464,7 +1556,9 @@ func (s *matchingEngineSuite) TestTaskListManagerGetTaskBatch_ReadBatchDone() {
 	const maxReadLevel = int64(120)
 	config := defaultTestConfig()
 	config.RangeSize = rangeSize
-	tlMgr0, err := newTaskListManager(s.matchingEngine, tlID, &tlNormal, config)
+	tlConfig, err := newTaskListConfig(tlID, config, s.domainCache)
+	s.NoError(err)
+	tlMgr0, err := newTaskListManager(s.matchingEngine, tlID, &tlNormal, tlConfig)
 	s.NoError(err)
 	tlMgr, ok := tlMgr0.(*taskListManagerImpl)
 	s.True(ok, "taskListManger doesn't implement taskListManager interface")
@@ -1560,6 +1654,9 @@ type testTaskListManager struct {
 	sync.Mutex
 	rangeID         int64
 	ackLevel        int64
+	dispatchRate    float64
+	paused          bool
+	pollers         []*persistence.TaskListPollerInfo
 	createTaskCount int
 	tasks           *treemap.Map
 }
@@ -1591,16 +1688,80 @@ func (m *testTaskManager) LeaseTaskList(request *persistence.LeaseTaskListReques
 
 	return &persistence.LeaseTaskListResponse{
 		TaskListInfo: &persistence.TaskListInfo{
-			AckLevel: tlm.ackLevel,
-			DomainID: request.DomainID,
-			Name:     request.TaskList,
-			TaskType: request.TaskType,
-			RangeID:  tlm.rangeID,
-			Kind:     request.TaskListKind,
+			AckLevel:     tlm.ackLevel,
+			DomainID:     request.DomainID,
+			Name:         request.TaskList,
+			TaskType:     request.TaskType,
+			RangeID:      tlm.rangeID,
+			Kind:         request.TaskListKind,
+			DispatchRate: tlm.dispatchRate,
+			Paused:       tlm.paused,
+			Pollers:      tlm.pollers,
+		},
+	}, nil
+}
+
+// GetTaskList returns the task list without leasing it
+func (m *testTaskManager) GetTaskList(request *persistence.GetTaskListRequest) (*persistence.GetTaskListResponse, error) {
+	m.Lock()
+	tlm, ok := m.taskLists[*newTaskListID(request.DomainID, request.TaskList, request.TaskType)]
+	m.Unlock()
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{Message: "task list does not exist"}
+	}
+	tlm.Lock()
+	defer tlm.Unlock()
+	return &persistence.GetTaskListResponse{
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID:     request.DomainID,
+			Name:         request.TaskList,
+			TaskType:     request.TaskType,
+			RangeID:      tlm.rangeID,
+			AckLevel:     tlm.ackLevel,
+			DispatchRate: tlm.dispatchRate,
+			Paused:       tlm.paused,
+			Pollers:      tlm.pollers,
 		},
 	}, nil
 }
 
+// ListTaskLists returns all task lists of the domain in a single page
+func (m *testTaskManager) ListTaskLists(request *persistence.ListTaskListsRequest) (*persistence.ListTaskListsResponse, error) {
+	m.Lock()
+	defer m.Unlock()
+	response := &persistence.ListTaskListsResponse{}
+	for id, tlm := range m.taskLists {
+		if id.domainID != request.DomainID {
+			continue
+		}
+		tlm.Lock()
+		response.Items = append(response.Items, &persistence.TaskListInfo{
+			DomainID: id.domainID,
+			Name:     id.taskListName,
+			TaskType: id.taskType,
+			RangeID:  tlm.rangeID,
+			AckLevel: tlm.ackLevel,
+		})
+		tlm.Unlock()
+	}
+	return response, nil
+}
+
+// DeleteTaskList deletes the task list if it is still owned by the given range
+func (m *testTaskManager) DeleteTaskList(request *persistence.DeleteTaskListRequest) error {
+	m.Lock()
+	defer m.Unlock()
+	id := newTaskListID(request.DomainID, request.TaskListName, request.TaskListType)
+	tlm, ok := m.taskLists[*id]
+	if !ok || tlm.rangeID != request.RangeID {
+		return &persistence.ConditionFailedError{
+			Msg: fmt.Sprintf("DeleteTaskList failed. rangeID: %v", request.RangeID),
+		}
+	}
+	delete(m.taskLists, *id)
+	return nil
+}
+
 // UpdateTaskList provides a mock function with given fields: request
 func (m *testTaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequest) (*persistence.UpdateTaskListResponse, error) {
 	m.logger.Debugf("UpdateTaskList taskListInfo=%v, ackLevel=%v", request.TaskListInfo, request.TaskListInfo.AckLevel)
@@ -1616,6 +1777,9 @@ func (m *testTaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequ
 		}
 	}
 	tlm.ackLevel = tli.AckLevel
+	tlm.dispatchRate = tli.DispatchRate
+	tlm.paused = tli.Paused
+	tlm.pollers = tli.Pollers
 	return &persistence.UpdateTaskListResponse{}, nil
 }
 
@@ -1673,11 +1837,13 @@ func (m *testTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (
 	for _, task := range request.Tasks {
 		scheduleID := task.Data.ScheduleID
 		tlm.tasks.Put(task.TaskID, &persistence.TaskInfo{
-			DomainID:   domainID,
-			RunID:      *task.Execution.RunId,
-			ScheduleID: scheduleID,
-			TaskID:     task.TaskID,
-			WorkflowID: *task.Execution.WorkflowId,
+			DomainID:    domainID,
+			RunID:       *task.Execution.RunId,
+			ScheduleID:  scheduleID,
+			TaskID:      task.TaskID,
+			WorkflowID:  *task.Execution.WorkflowId,
+			Priority:    task.Data.Priority,
+			CreatedTime: task.Data.CreatedTime,
 		})
 		tlm.createTaskCount++
 	}
@@ -1706,7 +1872,7 @@ func (m *testTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persi
 		if taskID <= request.ReadLevel {
 			continue
 		}
-		if taskID > request.MaxReadLevel {
+		if taskID > request.MaxReadLevel || (request.BatchSize > 0 && len(tasks) >= request.BatchSize) {
 			break
 		}
 		tasks = append(tasks, it.Value().(*persistence.TaskInfo))
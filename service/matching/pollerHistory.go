@@ -21,36 +21,53 @@
 package matching
 
 import (
+	"sort"
 	"time"
 
+	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
 )
 
 const (
 	pollerHistoryInitSize    = 0
 	pollerHistoryInitMaxSize = 1000
 	pollerHistoryTTL         = 5 * time.Minute
+	// pollerHistoryMaxPersisted caps the number of pollers stored along with the task list
+	pollerHistoryMaxPersisted = 100
 )
 
 type (
+	// pollerIdentity distinguishes pollers sharing the same identity but running different worker versions
 	pollerIdentity struct {
-		identity string
-		// TODO add IP, T1396795
+		identity       string
+		binaryChecksum string
+		sdkVersion     string
+		hostname       string
 	}
 
 	pollerInfo struct {
-		identity string
-		// TODO add IP, T1396795
+		pollerIdentity
+		// ratePerSecond is the dispatch rate requested by the poller, 0 if not set
+		ratePerSecond  float64
 		lastAccessTime time.Time
 	}
 )
 
 type pollerHistory struct {
-	// poller ID -> last access time
-	// pollers map[pollerID]time.Time
+	// poller identity -> poller info
 	history cache.Cache
 }
 
+func newPollerIdentity(identity string, metadata *workflow.PollerMetadata) pollerIdentity {
+	return pollerIdentity{
+		identity:       identity,
+		binaryChecksum: metadata.GetBinaryChecksum(),
+		sdkVersion:     metadata.GetSdkVersion(),
+		hostname:       metadata.GetHostname(),
+	}
+}
+
 func newPollerHistory() *pollerHistory {
 	opts := &cache.Options{
 		InitialCapacity: pollerHistoryInitSize,
@@ -63,8 +80,12 @@ func newPollerHistory() *pollerHistory {
 	}
 }
 
-func (pollers *pollerHistory) updatePollerInfo(id pollerIdentity) {
-	pollers.history.Put(id, nil)
+func (pollers *pollerHistory) updatePollerInfo(id pollerIdentity, ratePerSecond float64) {
+	pollers.history.Put(id, &pollerInfo{
+		pollerIdentity: id,
+		ratePerSecond:  ratePerSecond,
+		lastAccessTime: time.Now(),
+	})
 }
 
 func (pollers *pollerHistory) getAllPollerInfo() []*pollerInfo {
@@ -72,16 +93,62 @@ func (pollers *pollerHistory) getAllPollerInfo() []*pollerInfo {
 
 	ite := pollers.history.Iterator()
 	defer ite.Close()
+	expiry := time.Now().Add(-pollerHistoryTTL)
 	for ite.HasNext() {
 		entry := ite.Next()
-		key := entry.Key().(pollerIdentity)
-		lastAccessTime := entry.CreateTime()
-		result = append(result, &pollerInfo{
-			identity: key.identity,
-			// TODO add IP, T1396795
-			lastAccessTime: lastAccessTime,
-		})
+		info := entry.Value().(*pollerInfo)
+		// restored pollers keep their original access time, which can be older than the cache entry
+		if info.lastAccessTime.Before(expiry) {
+			continue
+		}
+		result = append(result, info)
 	}
 
 	return result
 }
+
+// toPersistence returns the most recently seen pollers in the form stored along with the task list
+func (pollers *pollerHistory) toPersistence() []*persistence.TaskListPollerInfo {
+	infos := pollers.getAllPollerInfo()
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].lastAccessTime.After(infos[j].lastAccessTime)
+	})
+	if len(infos) > pollerHistoryMaxPersisted {
+		infos = infos[:pollerHistoryMaxPersisted]
+	}
+
+	result := make([]*persistence.TaskListPollerInfo, 0, len(infos))
+	for _, info := range infos {
+		result = append(result, &persistence.TaskListPollerInfo{
+			Identity:       info.identity,
+			BinaryChecksum: info.binaryChecksum,
+			SDKVersion:     info.sdkVersion,
+			Hostname:       info.hostname,
+			RatePerSecond:  info.ratePerSecond,
+			LastAccessTime: info.lastAccessTime,
+		})
+	}
+	return result
+}
+
+// restore loads the pollers persisted by the previous owner of the task list, skipping expired ones
+func (pollers *pollerHistory) restore(infos []*persistence.TaskListPollerInfo) {
+	expiry := time.Now().Add(-pollerHistoryTTL)
+	for _, info := range infos {
+		if info.LastAccessTime.Before(expiry) {
+			continue
+		}
+		id := pollerIdentity{
+			identity:       info.Identity,
+			binaryChecksum: info.BinaryChecksum,
+			sdkVersion:     info.SDKVersion,
+			hostname:       info.Hostname,
+		}
+		// never overwrite a poller seen by this host
+		pollers.history.PutIfNotExist(id, &pollerInfo{
+			pollerIdentity: id,
+			ratePerSecond:  info.RatePerSecond,
+			lastAccessTime: info.LastAccessTime,
+		})
+	}
+}
@@ -42,6 +42,7 @@ type Config struct {
 	UpdateAckInterval         dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 	IdleTasklistCheckInterval dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 	MaxTasklistIdleTime       dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+	ExpiredTaskSweepInterval  dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 	// Time to hold a poll request before returning an empty response if there are no tasks
 	LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 	MinTaskThrottlingBurstSize dynamicconfig.IntPropertyFnWithTaskListInfoFilters
@@ -49,6 +50,27 @@ type Config struct {
 	// taskWriter configuration
 	OutstandingTaskAppendsThreshold dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 	MaxTaskBatchSize                dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+
+	// forwarder configuration
+	ForwarderMaxOutstandingPolls dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+	ForwarderMaxOutstandingTasks dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+	ForwarderBacklogInterval     dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+	// NumTasklistPartitions is the number of partitions the dispatch rate of a task list is split between
+	NumTasklistPartitions dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+
+	// per domain limits on the resources of a matching host
+	DomainMaxTaskLists         dynamicconfig.IntPropertyFnWithDomainFilter
+	DomainMaxBufferedTasks     dynamicconfig.IntPropertyFnWithDomainFilter
+	DomainPersistenceMaxQPS    dynamicconfig.IntPropertyFnWithDomainFilter
+	MaxConcurrentTaskListReads dynamicconfig.IntPropertyFn
+
+	// task list health signals
+	TaskListMetricsInterval       dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+	StarvedTaskListBacklogAge     dynamicconfig.DurationPropertyFnWithDomainFilter
+	StarvedTaskListNoPollInterval dynamicconfig.DurationPropertyFnWithDomainFilter
+
+	// task priority
+	TaskPriorityStarvationLimit dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 }
 
 // NewConfig returns new service config with default values
@@ -62,10 +84,23 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		UpdateAckInterval:               dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingUpdateAckInterval, 1*time.Minute),
 		IdleTasklistCheckInterval:       dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingIdleTasklistCheckInterval, 5*time.Minute),
 		MaxTasklistIdleTime:             dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MaxTasklistIdleTime, 5*time.Minute),
+		ExpiredTaskSweepInterval:        dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingExpiredTaskSweepInterval, time.Minute),
 		LongPollExpirationInterval:      dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingLongPollExpirationInterval, time.Minute),
 		MinTaskThrottlingBurstSize:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMinTaskThrottlingBurstSize, 1),
 		OutstandingTaskAppendsThreshold: dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingOutstandingTaskAppendsThreshold, 250),
 		MaxTaskBatchSize:                dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskBatchSize, 100),
+		ForwarderMaxOutstandingPolls:    dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingPolls, 1),
+		ForwarderMaxOutstandingTasks:    dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingTasks, 1),
+		ForwarderBacklogInterval:        dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderBacklogInterval, time.Second),
+		NumTasklistPartitions:           dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistPartitions, 1),
+		DomainMaxTaskLists:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.MatchingDomainMaxTaskLists, 10000),
+		DomainMaxBufferedTasks:          dc.GetIntPropertyFilteredByDomain(dynamicconfig.MatchingDomainMaxBufferedTasks, 100000),
+		DomainPersistenceMaxQPS:         dc.GetIntPropertyFilteredByDomain(dynamicconfig.MatchingDomainPersistenceMaxQPS, 3000),
+		MaxConcurrentTaskListReads:      dc.GetIntProperty(dynamicconfig.MatchingMaxConcurrentTaskListReads, 100),
+		TaskListMetricsInterval:         dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingTaskListMetricsInterval, time.Minute),
+		StarvedTaskListBacklogAge:       dc.GetDurationPropertyFilteredByDomain(dynamicconfig.MatchingStarvedTaskListBacklogAge, 10*time.Minute),
+		StarvedTaskListNoPollInterval:   dc.GetDurationPropertyFilteredByDomain(dynamicconfig.MatchingStarvedTaskListNoPollInterval, 5*time.Minute),
+		TaskPriorityStarvationLimit:     dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingTaskPriorityStarvationLimit, 10),
 	}
 }
 
@@ -98,6 +133,7 @@ func (s *Service) Start() {
 
 	pConfig := params.PersistenceConfig
 	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.PersistenceMaxQPS())
+	pConfig.SetFaultInjection(dynamicconfig.NewCollection(params.DynamicConfig, log))
 	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), log)
 
 	taskPersistence, err := pFactory.NewTaskManager()
@@ -32,6 +32,7 @@ import (
 	h "github.com/uber/cadence/.gen/go/history"
 	m "github.com/uber/cadence/.gen/go/matching"
 	s "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/logging"
@@ -55,18 +56,27 @@ const (
 
 var errAddTasklistThrottled = errors.New("cannot add to tasklist, limit exceeded")
 
+// errRemoteSyncMatchFailed is returned for a task forwarded from a task list partition when there
+// is no poller to match it with, in which case the partition keeps the task
+var errRemoteSyncMatchFailed = &s.ServiceBusyError{Message: "no poller waiting for forwarded task"}
+
 type taskListManager interface {
 	Start() error
 	Stop()
-	AddTask(execution *s.WorkflowExecution, taskInfo *persistence.TaskInfo) (syncMatch bool, err error)
+	AddTask(execution *s.WorkflowExecution, taskInfo *persistence.TaskInfo, forwardedFrom string) (syncMatch bool, err error)
 	GetTaskContext(ctx context.Context, maxDispatchPerSecond *float64) (*taskContext, error)
 	SyncMatchQueryTask(ctx context.Context, queryTask *queryTaskInfo) error
 	CancelPoller(pollerID string)
 	GetAllPollerInfo() []*pollerInfo
+	UpdateDispatchRate(dispatchRate float64) error
+	GetDispatchRate() float64
+	SetPaused(paused bool) error
+	IsPaused() bool
 	String() string
 }
 
 type taskListConfig struct {
+	DomainName      string
 	EnableSyncMatch func() bool
 	// Time to hold a poll request before returning an empty response if there are no tasks
 	LongPollExpirationInterval func() time.Duration
@@ -75,10 +85,23 @@ type taskListConfig struct {
 	UpdateAckInterval          func() time.Duration
 	IdleTasklistCheckInterval  func() time.Duration
 	MaxTasklistIdleTime        func() time.Duration
+	ExpiredTaskSweepInterval   func() time.Duration
 	MinTaskThrottlingBurstSize func() int
 	// taskWriter configuration
 	OutstandingTaskAppendsThreshold func() int
 	MaxTaskBatchSize                func() int
+	// forwarder configuration
+	ForwarderMaxOutstandingPolls func() int
+	ForwarderMaxOutstandingTasks func() int
+	ForwarderBacklogInterval     func() time.Duration
+	// NumPartitions is the number of partitions of the task list, at least 1
+	NumPartitions func() int
+	// task list health signals
+	TaskListMetricsInterval       func() time.Duration
+	StarvedTaskListBacklogAge     func() time.Duration
+	StarvedTaskListNoPollInterval func() time.Duration
+	// task priority
+	TaskPriorityStarvationLimit func() int
 }
 
 func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainCache) (*taskListConfig, error) {
@@ -91,7 +114,8 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 	taskListName := id.taskListName
 	taskType := id.taskType
 	return &taskListConfig{
-		RangeSize: config.RangeSize,
+		DomainName: domain,
+		RangeSize:  config.RangeSize,
 		GetTasksBatchSize: func() int {
 			return config.GetTasksBatchSize(domain, taskListName, taskType)
 		},
@@ -104,6 +128,9 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 		MaxTasklistIdleTime: func() time.Duration {
 			return config.MaxTasklistIdleTime(domain, taskListName, taskType)
 		},
+		ExpiredTaskSweepInterval: func() time.Duration {
+			return config.ExpiredTaskSweepInterval(domain, taskListName, taskType)
+		},
 		MinTaskThrottlingBurstSize: func() int {
 			return config.MinTaskThrottlingBurstSize(domain, taskListName, taskType)
 		},
@@ -119,6 +146,36 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 		MaxTaskBatchSize: func() int {
 			return config.MaxTaskBatchSize(domain, taskListName, taskType)
 		},
+		ForwarderMaxOutstandingPolls: func() int {
+			return config.ForwarderMaxOutstandingPolls(domain, taskListName, taskType)
+		},
+		ForwarderMaxOutstandingTasks: func() int {
+			return config.ForwarderMaxOutstandingTasks(domain, taskListName, taskType)
+		},
+		ForwarderBacklogInterval: func() time.Duration {
+			return config.ForwarderBacklogInterval(domain, taskListName, taskType)
+		},
+		NumPartitions: func() int {
+			// the partitions of a task list are configured by the name of its root partition, as in
+			// the load balancer of the matching client
+			n := config.NumTasklistPartitions(domain, matching.RootTaskListName(taskListName), taskType)
+			if n <= 0 {
+				return 1
+			}
+			return n
+		},
+		TaskListMetricsInterval: func() time.Duration {
+			return config.TaskListMetricsInterval(domain, taskListName, taskType)
+		},
+		StarvedTaskListBacklogAge: func() time.Duration {
+			return config.StarvedTaskListBacklogAge(domain)
+		},
+		StarvedTaskListNoPollInterval: func() time.Duration {
+			return config.StarvedTaskListNoPollInterval(domain)
+		},
+		TaskPriorityStarvationLimit: func() int {
+			return config.TaskPriorityStarvationLimit(domain, taskListName, taskType)
+		},
 	}, nil
 }
 
@@ -181,6 +238,15 @@ func (rl *rateLimiter) shouldUpdate(maxDispatchPerSecond *float64) bool {
 	}
 }
 
+// SetMaxDispatch replaces the dispatch rate right away, while UpdateMaxDispatch only raises
+// the rate once the TTL expired
+func (rl *rateLimiter) SetMaxDispatch(maxDispatchPerSecond float64) {
+	rl.Lock()
+	defer rl.Unlock()
+	rl.maxDispatchPerSecond = &maxDispatchPerSecond
+	rl.storeLimiter(&maxDispatchPerSecond)
+}
+
 func (rl *rateLimiter) Wait(ctx context.Context) error {
 	limiter := rl.globalLimiter.Load().(*rate.Limiter)
 	return limiter.Wait(ctx)
@@ -192,32 +258,32 @@ func (rl *rateLimiter) Reserve() *rate.Reservation {
 }
 
 func newTaskListManager(
-	e *matchingEngineImpl, taskList *taskListID, taskListKind *s.TaskListKind, config *Config,
+	e *matchingEngineImpl, taskList *taskListID, taskListKind *s.TaskListKind, taskListConfig *taskListConfig,
 ) (taskListManager, error) {
 	dPtr := _defaultTaskDispatchRPS
-	taskListConfig, err := newTaskListConfig(taskList, config, e.domainCache)
-	if err != nil {
-		return nil, err
-	}
 	rl := newRateLimiter(
 		&dPtr, _defaultTaskDispatchRPSTTL, taskListConfig.MinTaskThrottlingBurstSize(),
 	)
 	return newTaskListManagerWithRateLimiter(
 		e, taskList, taskListKind, e.domainCache, taskListConfig, rl,
-	), nil
+	)
 }
 
 func newTaskListManagerWithRateLimiter(
 	e *matchingEngineImpl, taskList *taskListID, taskListKind *s.TaskListKind,
 	domainCache cache.DomainCache, config *taskListConfig, rl *rateLimiter,
-) taskListManager {
+) (taskListManager, error) {
+	resources := e.getDomainResources(taskList.domainID, config.DomainName)
 	// To perform one db operation if there are no pollers
 	taskBufferSize := config.GetTasksBatchSize() - 1
 	ctx, cancel := context.WithCancel(context.Background())
 	tlMgr := &taskListManagerImpl{
 		domainCache:             domainCache,
+		domainResources:         resources,
+		taskManager:             newDomainTaskManager(e.taskManager, resources),
 		engine:                  e,
 		taskBuffer:              make(chan *persistence.TaskInfo, taskBufferSize),
+		bufferedTasks:           newTaskPriorityQueue(),
 		notifyCh:                make(chan struct{}, 1),
 		shutdownCh:              make(chan struct{}),
 		deliverBufferShutdownCh: make(chan struct{}),
@@ -229,17 +295,26 @@ func newTaskListManagerWithRateLimiter(
 			logging.TagTaskListName: taskList.taskListName,
 		}),
 		metricsClient:       e.metricsClient,
+		lastPollTime:        e.timeSource.Now().UnixNano(),
 		taskAckManager:      newAckManager(e.logger),
 		tasksForPoll:        make(chan *getTaskResult),
+		queryTasksForPoll:   make(chan *getTaskResult),
+		pauseChangedC:       make(chan struct{}),
 		config:              config,
 		pollerHistory:       newPollerHistory(),
 		outstandingPollsMap: make(map[string]context.CancelFunc),
 		rateLimiter:         rl,
 		taskListKind:        taskListKind,
+		taskListMetricsClient: resources.metricsClient.Tagged(map[string]string{
+			metrics.TaskListTagName:     taskList.taskListName,
+			metrics.TaskListTypeTagName: taskListTypeTagValue(taskList.taskType),
+		}),
 	}
 	tlMgr.taskWriter = newTaskWriter(tlMgr)
+	tlMgr.forwarder = newForwarder(config, taskList, s.TaskListKind(tlMgr.getTaskListKind()), e.matchingClient, e.timeSource)
 	tlMgr.startWG.Add(1)
-	return tlMgr
+	resources.addTaskList(tlMgr)
+	return tlMgr, nil
 }
 
 // Contains information needed for current task transition from queue to Workflow execution history.
@@ -250,6 +325,8 @@ type taskContext struct {
 	workflowExecution s.WorkflowExecution
 	queryTaskInfo     *queryTaskInfo
 	backlogCountHint  int64
+	// forwardPoll is set when the poll should be forwarded to the root partition of the task list
+	forwardPoll bool
 }
 
 type queryTaskInfo struct {
@@ -265,6 +342,10 @@ type taskListManagerImpl struct {
 	metricsClient metrics.Client
 	engine        *matchingEngineImpl
 	config        *taskListConfig
+	// domainResources tracks the resources used by all the task lists of the domain on this host
+	domainResources *domainResources
+	// taskManager applies the persistence rate limit of the domain
+	taskManager persistence.TaskManager
 
 	// pollerHistory stores poller which poll from this tasklist in last few minutes
 	pollerHistory *pollerHistory
@@ -275,16 +356,24 @@ type taskListManagerImpl struct {
 	persistenceLock sync.Mutex
 	taskWriter      *taskWriter
 	taskBuffer      chan *persistence.TaskInfo // tasks loaded from persistence
+	// priorityScanLevel is the taskID up to which the pump read ahead for tasks of a higher priority
+	priorityScanLevel int64
+	// bufferedTasks orders the tasks moved out of taskBuffer by priority for deliverBufferTasksForPoll
+	bufferedTasks *taskPriorityQueue
 	// tasksForPoll is used to deliver tasks to pollers.
 	// It must to be unbuffered. addTask publishes to it asynchronously and expects publish to succeed
 	// only if there is waiting poll that consumes from it. Tasks in taskBuffer will blocking-add to
 	// this channel
 	tasksForPoll chan *getTaskResult
-	notifyCh     chan struct{} // Used as signal to notify pump of new tasks
+	// queryTasksForPoll delivers query tasks to pollers, it is unbuffered like tasksForPoll. Queries
+	// are kept apart from tasksForPoll as they are still dispatched while the task list is paused.
+	queryTasksForPoll chan *getTaskResult
+	notifyCh          chan struct{} // Used as signal to notify pump of new tasks
 	// Note: We need two shutdown channels so we can stop task pump independently of the deliverBuffer
 	// loop in getTasksPump in unit tests
 	shutdownCh              chan struct{}  // Delivers stop to the pump that populates taskBuffer
 	deliverBufferShutdownCh chan struct{}  // Delivers stop to the pump that populates taskBuffer
+	deliverBufferWG         sync.WaitGroup // Waits for deliverBufferTasksForPoll to exit
 	startWG                 sync.WaitGroup // ensures that background processes do not start until setup is ready
 	stopped                 int32
 	// The cancel objects are to cancel the ratelimiter Wait in deliverBufferTasksLoop. The ideal
@@ -300,6 +389,18 @@ type taskListManagerImpl struct {
 	rangeID                 int64      // Current range of the task list. Starts from 1.
 	taskSequenceNumber      int64      // Sequence number of the next task. Starts from 1.
 	nextRangeSequenceNumber int64      // Current range boundary
+	// dispatchRate is set by an operator and takes precedence over the rate of pollers, 0 if not set.
+	// It is persisted with the task list info, so writes of the task list info hold persistenceLock
+	// while reading it. It is only set on the root partition, as the total rate of the task list.
+	dispatchRate float64
+	// rootDispatchRate is the dispatchRate of the root partition, refreshed by the other partitions
+	rootDispatchRate float64
+	// appliedDispatchRate is the share of the total rate applied to rateLimiter, 0 if none
+	appliedDispatchRate float64
+	// paused task lists keep accepting tasks but do not dispatch them, persisted like dispatchRate
+	paused bool
+	// pauseChangedC is closed and replaced every time paused changes
+	pauseChangedC chan struct{}
 
 	// outstandingPollsMap is needed to keep track of all outstanding pollers for a
 	// particular tasklist.  PollerID generated by frontend is used as the key and
@@ -311,16 +412,28 @@ type taskListManagerImpl struct {
 	// Rate limiter for task dispatch
 	rateLimiter *rateLimiter
 
+	// taskListMetricsClient is tagged with the domain and the task list, for the health signals of the task list
+	taskListMetricsClient metrics.Client
+	// lastPollTime is the time of the last poll in unix nanos, accessed atomically
+	lastPollTime int64
+	// starved is whether the task list was starved when its health signals were last emitted,
+	// only accessed by getTasksPump
+	starved bool
+
 	taskListKind *s.TaskListKind // sticky taskList has different process in persistence
+
+	// forwarder is nil unless this is a partition of a task list that forwards to the root partition
+	forwarder *forwarder
 }
 
 // getTaskResult contains task info and optional channel to notify createTask caller
 // that task is successfully started and returned to a poller
 type getTaskResult struct {
-	task      *persistence.TaskInfo
-	C         chan *syncMatchResponse
-	queryTask *queryTaskInfo
-	syncMatch bool
+	task        *persistence.TaskInfo
+	C           chan *syncMatchResponse
+	queryTask   *queryTaskInfo
+	syncMatch   bool
+	forwardPoll bool
 }
 
 // syncMatchResponse result of sync match delivered to a createTask caller
@@ -341,6 +454,7 @@ func (c *taskListManagerImpl) Start() error {
 		return err
 	}
 
+	c.refreshDispatchRate()
 	c.taskWriter.Start()
 	c.signalNewTask()
 	go c.getTasksPump()
@@ -358,11 +472,23 @@ func (c *taskListManagerImpl) Stop() {
 	close(c.shutdownCh)
 	c.taskWriter.Stop()
 	c.engine.removeTaskListManager(c.taskListID)
+	c.engine.removeDomainTaskList(c)
+	if c.getTaskListKind() != persistence.TaskListKindSticky {
+		// the task list is no longer reported once unloaded, so it must not stay flagged as starved
+		c.taskListMetricsClient.UpdateGauge(metrics.MatchingTaskListMgrScope, metrics.StarvedTaskListGauge, 0)
+	}
 	logging.LogTaskListUnloadedEvent(c.logger)
 }
 
-func (c *taskListManagerImpl) AddTask(execution *s.WorkflowExecution, taskInfo *persistence.TaskInfo) (syncMatch bool, err error) {
+func (c *taskListManagerImpl) AddTask(
+	execution *s.WorkflowExecution,
+	taskInfo *persistence.TaskInfo,
+	forwardedFrom string,
+) (syncMatch bool, err error) {
 	c.startWG.Wait()
+	if forwardedFrom != "" {
+		return c.addForwardedTask(taskInfo)
+	}
 	_, err = c.executeWithRetry(func(rangeID int64) (interface{}, error) {
 
 		domainEntry, err := c.domainCache.GetDomainByID(taskInfo.DomainID)
@@ -381,6 +507,13 @@ func (c *taskListManagerImpl) AddTask(execution *s.WorkflowExecution, taskInfo *
 			syncMatch = true
 			return r, err
 		}
+		if c.forwarder != nil && !c.bufferedTasks.hasHigherPriority(taskInfo.Priority) {
+			// no local poller, try to hand the task to a poller waiting on the root partition
+			if err := c.forwarder.ForwardTask(context.Background(), taskInfo); err == nil {
+				syncMatch = true
+				return &persistence.CreateTasksResponse{}, nil
+			}
+		}
 		r, err = c.taskWriter.appendTask(execution, taskInfo, rangeID)
 		syncMatch = false
 		return r, err
@@ -391,6 +524,23 @@ func (c *taskListManagerImpl) AddTask(execution *s.WorkflowExecution, taskInfo *
 	return syncMatch, err
 }
 
+// addForwardedTask only sync matches a task forwarded from a task list partition, as the
+// partition persists the task itself when there is no poller waiting here
+func (c *taskListManagerImpl) addForwardedTask(taskInfo *persistence.TaskInfo) (bool, error) {
+	domainEntry, err := c.domainCache.GetDomainByID(taskInfo.DomainID)
+	if err != nil {
+		return false, err
+	}
+	if domainEntry.GetDomainNotActiveErr() != nil {
+		return false, errRemoteSyncMatchFailed
+	}
+	r, err := c.trySyncMatch(taskInfo)
+	if err == errAddTasklistThrottled || (err == nil && r == nil) {
+		return false, errRemoteSyncMatchFailed
+	}
+	return true, err
+}
+
 func (c *taskListManagerImpl) SyncMatchQueryTask(ctx context.Context, queryTask *queryTaskInfo) error {
 	c.startWG.Wait()
 
@@ -404,7 +554,7 @@ func (c *taskListManagerImpl) SyncMatchQueryTask(ctx context.Context, queryTask
 
 	request := &getTaskResult{task: taskInfo, C: make(chan *syncMatchResponse, 1), queryTask: queryTask}
 	select {
-	case c.tasksForPoll <- request:
+	case c.queryTasksForPoll <- request:
 		<-request.C
 		return nil
 	case <-ctx.Done():
@@ -417,11 +567,16 @@ func (c *taskListManagerImpl) GetTaskContext(
 	ctx context.Context,
 	maxDispatchPerSecond *float64,
 ) (*taskContext, error) {
-	c.rateLimiter.UpdateMaxDispatch(maxDispatchPerSecond)
+	if c.GetDispatchRate() == 0 {
+		c.rateLimiter.UpdateMaxDispatch(maxDispatchPerSecond)
+	}
 	result, err := c.getTask(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if result.forwardPoll {
+		return &taskContext{tlMgr: c, forwardPoll: true}, nil
+	}
 	task := result.task
 	workflowExecution := s.WorkflowExecution{
 		WorkflowId: common.StringPtr(task.WorkflowID),
@@ -452,22 +607,177 @@ func (c *taskListManagerImpl) isEqualRangeID(rangeID int64) bool {
 }
 
 func (c *taskListManagerImpl) persistAckLevel() error {
+	c.persistenceLock.Lock()
+	defer c.persistenceLock.Unlock()
 	c.Lock()
 	updateTaskListRequest := &persistence.UpdateTaskListRequest{
 		TaskListInfo: &persistence.TaskListInfo{
-			DomainID: c.taskListID.domainID,
-			Name:     c.taskListID.taskListName,
-			TaskType: c.taskListID.taskType,
-			AckLevel: c.taskAckManager.getAckLevel(),
-			RangeID:  c.rangeID,
-			Kind:     c.getTaskListKind(),
+			DomainID:     c.taskListID.domainID,
+			Name:         c.taskListID.taskListName,
+			TaskType:     c.taskListID.taskType,
+			AckLevel:     c.taskAckManager.getAckLevel(),
+			RangeID:      c.rangeID,
+			Kind:         c.getTaskListKind(),
+			DispatchRate: c.dispatchRate,
+			Paused:       c.paused,
+			Pollers:      c.pollerHistory.toPersistence(),
 		},
 	}
 	c.Unlock()
+	_, err := c.taskManager.UpdateTaskList(updateTaskListRequest)
+	return err
+}
+
+// UpdateDispatchRate persists the dispatch rate set by an operator and applies it right away.
+// A rate of 0 clears it, after which the rate pollers ask for applies again. The rate is the total
+// rate of the task list, so it is only set on the root partition.
+func (c *taskListManagerImpl) UpdateDispatchRate(dispatchRate float64) error {
+	return c.updateTaskListInfo(func(info *persistence.TaskListInfo) {
+		info.DispatchRate = dispatchRate
+	})
+}
+
+// refreshDispatchRate applies the share of the dispatch rate for the current number of partitions.
+// The partitions other than the root read the rate of the root partition first.
+func (c *taskListManagerImpl) refreshDispatchRate() {
+	if !matching.IsTaskListPartition(c.taskListID.taskListName) {
+		c.Lock()
+		c.applyDispatchRateLocked()
+		c.Unlock()
+		return
+	}
+
+	var rootDispatchRate float64
+	resp, err := c.taskManager.GetTaskList(&persistence.GetTaskListRequest{
+		DomainID: c.taskListID.domainID,
+		TaskList: matching.RootTaskListName(c.taskListID.taskListName),
+		TaskType: c.taskListID.taskType,
+	})
+	switch err.(type) {
+	case nil:
+		rootDispatchRate = resp.TaskListInfo.DispatchRate
+	case *s.EntityNotExistsError:
+		// the root partition was never loaded, so no rate was set on it
+	default:
+		// the share applied last stays until the next refresh
+		logging.LogPersistantStoreErrorEvent(c.logger, logging.TagValueStoreOperationGetTaskList, err,
+			"Get root partition failed")
+		return
+	}
+	c.Lock()
+	c.rootDispatchRate = rootDispatchRate
+	c.applyDispatchRateLocked()
+	c.Unlock()
+}
+
+// applyDispatchRateLocked applies the share of this partition of the total dispatch rate to rateLimiter,
+// as every partition dispatches its tasks independently
+func (c *taskListManagerImpl) applyDispatchRateLocked() {
+	total := c.getDispatchRateLocked()
+	share := 0.0
+	if total > 0 {
+		share = total / float64(c.config.NumPartitions())
+	}
+	if share == c.appliedDispatchRate {
+		return
+	}
+	c.appliedDispatchRate = share
+	if share == 0 {
+		// back to the default until pollers ask for a rate again
+		share = _defaultTaskDispatchRPS
+	}
+	c.rateLimiter.SetMaxDispatch(share)
+}
+
+// SetPaused persists whether the task list is paused. A paused task list keeps accepting and
+// persisting tasks, but its polls return empty. Query tasks are still dispatched.
+func (c *taskListManagerImpl) SetPaused(paused bool) error {
+	err := c.updateTaskListInfo(func(info *persistence.TaskListInfo) {
+		info.Paused = paused
+	})
+	if err != nil {
+		return err
+	}
+	if paused {
+		// outstanding polls return empty right away instead of waiting for a task until they expire
+		c.cancelOutstandingPolls()
+	}
+	return nil
+}
+
+// IsPaused returns whether the task list is paused
+func (c *taskListManagerImpl) IsPaused() bool {
+	c.Lock()
+	defer c.Unlock()
+	return c.paused
+}
+
+// pauseState returns whether the task list is paused, and a channel which is closed when that changes
+func (c *taskListManagerImpl) pauseState() (bool, <-chan struct{}) {
+	c.Lock()
+	defer c.Unlock()
+	return c.paused, c.pauseChangedC
+}
+
+// setPausedLocked updates paused and notifies the goroutines waiting on the pause state to change
+func (c *taskListManagerImpl) setPausedLocked(paused bool) {
+	if c.paused == paused {
+		return
+	}
+	c.paused = paused
+	close(c.pauseChangedC)
+	c.pauseChangedC = make(chan struct{})
+}
+
+// updateTaskListInfo persists the task list info with the change applied, and applies the change
+// to this task list manager once persisted
+func (c *taskListManagerImpl) updateTaskListInfo(change func(info *persistence.TaskListInfo)) error {
+	c.startWG.Wait()
 	c.persistenceLock.Lock()
 	defer c.persistenceLock.Unlock()
-	_, err := c.engine.taskManager.UpdateTaskList(updateTaskListRequest)
-	return err
+	c.Lock()
+	info := &persistence.TaskListInfo{
+		DomainID:     c.taskListID.domainID,
+		Name:         c.taskListID.taskListName,
+		TaskType:     c.taskListID.taskType,
+		AckLevel:     c.taskAckManager.getAckLevel(),
+		RangeID:      c.rangeID,
+		Kind:         c.getTaskListKind(),
+		DispatchRate: c.dispatchRate,
+		Paused:       c.paused,
+		Pollers:      c.pollerHistory.toPersistence(),
+	}
+	c.Unlock()
+	change(info)
+	_, err := c.taskManager.UpdateTaskList(&persistence.UpdateTaskListRequest{TaskListInfo: info})
+	if err != nil {
+		if _, ok := err.(*persistence.ConditionFailedError); ok {
+			// This indicates the task list may have moved to another host.
+			c.Stop()
+		}
+		return err
+	}
+
+	c.Lock()
+	c.dispatchRate = info.DispatchRate
+	c.setPausedLocked(info.Paused)
+	c.applyDispatchRateLocked()
+	c.Unlock()
+	return nil
+}
+
+// GetDispatchRate returns the total dispatch rate set by an operator on the root partition, 0 if not set
+func (c *taskListManagerImpl) GetDispatchRate() float64 {
+	c.Lock()
+	defer c.Unlock()
+	return c.getDispatchRateLocked()
+}
+
+func (c *taskListManagerImpl) getDispatchRateLocked() float64 {
+	if matching.IsTaskListPartition(c.taskListID.taskListName) {
+		return c.rootDispatchRate
+	}
+	return c.dispatchRate
 }
 
 // newTaskIDs taskID to use to persist the task
@@ -475,7 +785,7 @@ func (c *taskListManagerImpl) newTaskIDs(count int) (taskIDs []int64, err error)
 	c.Lock()
 	defer c.Unlock()
 	for i := 0; i < count; i++ {
-		err = c.updateRangeIfNeededLocked(c.engine)
+		err = c.updateRangeIfNeededLocked()
 		if err != nil {
 			return nil, err
 		}
@@ -520,6 +830,7 @@ func (c *taskListManagerImpl) getTask(ctx context.Context) (*getTaskResult, erro
 	scope := metrics.MatchingTaskListMgrScope
 	timer := time.NewTimer(c.config.LongPollExpirationInterval())
 	defer timer.Stop()
+	atomic.StoreInt64(&c.lastPollTime, c.engine.timeSource.Now().UnixNano())
 
 	pollerID, ok := ctx.Value(pollerIDKey).(string)
 	childCtx := ctx
@@ -539,24 +850,34 @@ func (c *taskListManagerImpl) getTask(ctx context.Context) (*getTaskResult, erro
 		}()
 	}
 
-	identity, ok := ctx.Value(identityKey).(string)
-	if ok && identity != "" {
-		c.pollerHistory.updatePollerInfo(pollerIdentity{
-			identity: identity,
-		})
+	poller, ok := ctx.Value(identityKey).(pollerInfo)
+	if ok && poller.identity != "" {
+		c.pollerHistory.updatePollerInfo(poller.pollerIdentity, poller.ratePerSecond)
 	}
 
 	var tasksForPoll chan *getTaskResult
+	var queryTasksForPoll chan *getTaskResult
+	var forwardPollTokenC <-chan struct{}
 	domainEntry, err := c.domainCache.GetDomainByID(c.taskListID.domainID)
 	if err != nil {
 		return nil, err
 	}
 	if domainEntry.GetDomainNotActiveErr() == nil {
-		// domain active
-		tasksForPoll = c.tasksForPoll
+		// domain active, queries are answered even if the task list is paused
+		queryTasksForPoll = c.queryTasksForPoll
+		if !c.IsPaused() {
+			tasksForPoll = c.tasksForPoll
+			forwardPollTokenC = c.forwarder.PollReqTokenC()
+		}
 	}
 
 	select {
+	case <-forwardPollTokenC:
+		// the poll holds the token until it returns from the root partition
+		return &getTaskResult{forwardPoll: true}, nil
+	case result := <-queryTasksForPoll:
+		c.metricsClient.IncCounter(scope, metrics.PollSuccessCounter)
+		return result, nil
 	case result := <-tasksForPoll:
 		if result.syncMatch {
 			c.metricsClient.IncCounter(scope, metrics.PollSuccessWithSyncCounter)
@@ -586,6 +907,15 @@ func (c *taskListManagerImpl) CancelPoller(pollerID string) {
 	}
 }
 
+// cancelOutstandingPolls unblocks all polls waiting for a task
+func (c *taskListManagerImpl) cancelOutstandingPolls() {
+	c.outstandingPollsLock.Lock()
+	defer c.outstandingPollsLock.Unlock()
+	for _, cancel := range c.outstandingPollsMap {
+		cancel()
+	}
+}
+
 // Returns a batch of tasks from persistence starting form current read level.
 // Also return a number that can be used to update readLevel
 // Also return a bool to indicate whether read is finished
@@ -613,6 +943,44 @@ func (c *taskListManagerImpl) getTaskBatch() ([]*persistence.TaskInfo, int64, bo
 	return tasks, readLevel, readLevel == maxReadLevel, nil // caller will update readLevel when no task grabbed
 }
 
+// Reads the tasks of a priority higher than the lowest one persisted after readLevel, so that they are
+// dispatched ahead of a backlog of lower priority tasks larger than a batch. Each task is scanned only
+// once: the scan resumes from where the previous one stopped.
+func (c *taskListManagerImpl) getPriorityTaskBatch(readLevel int64) ([]*persistence.TaskInfo, error) {
+	var result []*persistence.TaskInfo
+	scanLevel := c.priorityScanLevel
+	if scanLevel < readLevel {
+		scanLevel = readLevel
+	}
+	maxReadLevel := c.taskWriter.GetMaxReadLevel()
+	batchSize := c.config.GetTasksBatchSize()
+
+	// counter i is used to break and let the pump dispatch what it has read so far.
+	for i := 0; i < 10 && scanLevel < maxReadLevel && len(result) < batchSize; i++ {
+		upper := scanLevel + c.config.RangeSize
+		if upper > maxReadLevel {
+			upper = maxReadLevel
+		}
+		tasks, err := c.getTaskBatchWithRange(scanLevel, upper)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range tasks {
+			if t.Priority > common.MinTaskPriority {
+				result = append(result, t)
+			}
+		}
+		if len(tasks) >= batchSize {
+			// the range holds more tasks than a batch
+			scanLevel = tasks[len(tasks)-1].TaskID
+		} else {
+			scanLevel = upper
+		}
+	}
+	c.priorityScanLevel = scanLevel
+	return result, nil
+}
+
 func (c *taskListManagerImpl) getTaskBatchWithRange(readLevel int64, maxReadLevel int64) ([]*persistence.TaskInfo, error) {
 	response, err := c.executeWithRetry(func(rangeID int64) (interface{}, error) {
 		c.Lock()
@@ -626,7 +994,7 @@ func (c *taskListManagerImpl) getTaskBatchWithRange(readLevel int64, maxReadLeve
 			MaxReadLevel: maxReadLevel, // inclusive
 		}
 		c.Unlock()
-		return c.engine.taskManager.GetTasks(request)
+		return c.taskManager.GetTasks(request)
 	})
 	if err != nil {
 		return nil, err
@@ -637,18 +1005,18 @@ func (c *taskListManagerImpl) getTaskBatchWithRange(readLevel int64, maxReadLeve
 func (c *taskListManagerImpl) updateRangeIfNeeded() error {
 	c.Lock()
 	defer c.Unlock()
-	return c.updateRangeIfNeededLocked(c.engine)
+	return c.updateRangeIfNeededLocked()
 }
 
 // Check current sequence number and if it is on the range boundary performs conditional update on
 // persistence to grab the next range. Then updates sequence number and read offset to match the new range.
-func (c *taskListManagerImpl) updateRangeIfNeededLocked(e *matchingEngineImpl) error {
+func (c *taskListManagerImpl) updateRangeIfNeededLocked() error {
 	if c.taskSequenceNumber < c.nextRangeSequenceNumber { // also works for initial values of 0
 		return nil
 	}
 	var resp *persistence.LeaseTaskListResponse
 	op := func() (err error) {
-		resp, err = e.taskManager.LeaseTaskList(&persistence.LeaseTaskListRequest{
+		resp, err = c.taskManager.LeaseTaskList(&persistence.LeaseTaskListRequest{
 			DomainID:     c.taskListID.domainID,
 			TaskList:     c.taskListID.taskListName,
 			TaskType:     c.taskListID.taskType,
@@ -669,6 +1037,11 @@ func (c *taskListManagerImpl) updateRangeIfNeededLocked(e *matchingEngineImpl) e
 	tli := resp.TaskListInfo
 	c.rangeID = tli.RangeID // Starts from 1
 	c.taskAckManager.setAckLevel(tli.AckLevel)
+	c.dispatchRate = tli.DispatchRate
+	c.setPausedLocked(tli.Paused)
+	// pollers seen by the previous owner, so DescribeTaskList keeps reporting them after a move
+	c.pollerHistory.restore(tli.Pollers)
+	c.applyDispatchRateLocked()
 	c.taskSequenceNumber = (tli.RangeID-1)*c.config.RangeSize + 1
 	c.nextRangeSequenceNumber = (tli.RangeID)*c.config.RangeSize + 1
 	c.logger.Debugf("updateRangeLocked rangeID=%v, c.taskSequenceNumber=%v, c.nextRangeSequenceNumber=%v",
@@ -698,7 +1071,7 @@ func (c *taskListManagerImpl) String() string {
 
 // updatePollerInfo update the poller information for this tasklist
 func (c *taskListManagerImpl) updatePollerInfo(id pollerIdentity) {
-	c.pollerHistory.updatePollerInfo(id)
+	c.pollerHistory.updatePollerInfo(id, 0)
 }
 
 // getAllPollerInfo return poller which poll from this tasklist in last few minutes
@@ -711,7 +1084,11 @@ func (c *taskListManagerImpl) GetAllPollerInfo() []*pollerInfo {
 // and sent to a poller. So it not necessary to persist it.
 // Returns (nil, nil) if there is no waiting poller which indicates that task has to be persisted.
 func (c *taskListManagerImpl) trySyncMatch(task *persistence.TaskInfo) (*persistence.CreateTasksResponse, error) {
-	if !c.config.EnableSyncMatch() {
+	if !c.config.EnableSyncMatch() || c.IsPaused() {
+		return nil, nil
+	}
+	if c.bufferedTasks.hasHigherPriority(task.Priority) {
+		// the task has to wait for the buffered tasks of a higher priority to be dispatched first
 		return nil, nil
 	}
 	// Request from the point of view of Add(Activity|Decision)Task operation.
@@ -753,29 +1130,123 @@ deliverBufferTasksLoop:
 			runtime.Gosched()
 			continue
 		}
-		select {
-		case task, ok := <-c.taskBuffer:
-			if !ok { // Task list getTasks pump is shutdown
-				break deliverBufferTasksLoop
-			}
+		if c.bufferedTasks.len() == 0 {
 			select {
-			case c.tasksForPoll <- &getTaskResult{task: task}:
+			case task, ok := <-c.taskBuffer:
+				if !ok { // Task list getTasks pump is shutdown
+					break deliverBufferTasksLoop
+				}
+				c.bufferedTasks.add(task)
 			case <-c.deliverBufferShutdownCh:
 				break deliverBufferTasksLoop
 			}
-		case <-c.deliverBufferShutdownCh:
+		}
+		c.moveBufferedTasks()
+
+		task := c.bufferedTasks.pop(c.config.TaskPriorityStarvationLimit())
+		if task == nil { // sweepExpiredTasks removed the buffered tasks
+			continue deliverBufferTasksLoop
+		}
+		c.domainResources.addBufferedTasks(-1)
+		if c.isTaskExpired(task) {
+			c.bufferedTasks.done()
+			c.completeExpiredTask(task)
+			continue deliverBufferTasksLoop
+		}
+		delivered := c.deliverBufferTask(task)
+		c.bufferedTasks.done()
+		if !delivered {
 			break deliverBufferTasksLoop
 		}
 	}
 }
 
+// moveBufferedTasks moves the tasks waiting in taskBuffer to bufferedTasks, so that all of them are
+// considered for the next dispatch. It keeps at most as many tasks as taskBuffer holds in bufferedTasks,
+// the rest waits in taskBuffer.
+func (c *taskListManagerImpl) moveBufferedTasks() {
+	for c.bufferedTasks.len() < cap(c.taskBuffer) {
+		select {
+		case task, ok := <-c.taskBuffer:
+			if !ok {
+				return
+			}
+			c.bufferedTasks.add(task)
+		default:
+			return
+		}
+	}
+}
+
+// deliverBufferTask blocks until the task is handed to a local poller or, for a task list
+// partition, to a poller waiting on the root partition. The task is held while the task list
+// is paused. Returns false on shutdown.
+func (c *taskListManagerImpl) deliverBufferTask(task *persistence.TaskInfo) bool {
+	var forwardTickerC <-chan time.Time
+	if c.forwarder != nil {
+		forwardTicker := time.NewTicker(c.config.ForwarderBacklogInterval())
+		defer forwardTicker.Stop()
+		forwardTickerC = forwardTicker.C
+	}
+
+	for {
+		tasksForPoll := c.tasksForPoll
+		forwardC := forwardTickerC
+		paused, pauseChangedC := c.pauseState()
+		if paused {
+			tasksForPoll = nil
+			forwardC = nil
+		}
+
+		select {
+		case tasksForPoll <- &getTaskResult{task: task}:
+			return true
+		case <-forwardC:
+			if err := c.forwarder.ForwardTask(c.cancelCtx, task); err == nil {
+				c.completeTaskAndDelete(task.TaskID)
+				return true
+			}
+		case <-pauseChangedC:
+			// paused or resumed, check again
+		case <-c.deliverBufferShutdownCh:
+			return false
+		}
+	}
+}
+
+// forwardPollForDecisionTask forwards the poll to the root partition and releases the
+// forwarder token the poll acquired in getTask
+func (c *taskListManagerImpl) forwardPollForDecisionTask(
+	ctx context.Context,
+	request *m.PollForDecisionTaskRequest,
+) (*m.PollForDecisionTaskResponse, error) {
+	defer c.forwarder.ReleasePollReqToken()
+	return c.forwarder.ForwardPollForDecisionTask(ctx, request)
+}
+
+// forwardPollForActivityTask forwards the poll to the root partition and releases the
+// forwarder token the poll acquired in getTask
+func (c *taskListManagerImpl) forwardPollForActivityTask(
+	ctx context.Context,
+	request *m.PollForActivityTaskRequest,
+) (*s.PollForActivityTaskResponse, error) {
+	defer c.forwarder.ReleasePollReqToken()
+	return c.forwarder.ForwardPollForActivityTask(ctx, request)
+}
+
 func (c *taskListManagerImpl) getTasksPump() {
 	defer close(c.taskBuffer)
 	c.startWG.Wait()
 
-	go c.deliverBufferTasksForPoll()
+	c.deliverBufferWG.Add(1)
+	go func() {
+		defer c.deliverBufferWG.Done()
+		c.deliverBufferTasksForPoll()
+	}()
 	updateAckTimer := time.NewTimer(c.config.UpdateAckInterval())
 	checkIdleTaskListTimer := time.NewTimer(c.config.IdleTasklistCheckInterval())
+	expiredTaskSweepTimer := time.NewTimer(c.config.ExpiredTaskSweepInterval())
+	taskListMetricsTimer := time.NewTimer(c.config.TaskListMetricsInterval())
 	lastTimeWriteTask := time.Time{}
 getTasksPumpLoop:
 	for {
@@ -784,9 +1255,24 @@ getTasksPumpLoop:
 			break getTasksPumpLoop
 		case <-c.notifyCh:
 			{
-				lastTimeWriteTask = time.Now()
+				lastTimeWriteTask = c.engine.timeSource.Now()
 
+				if c.domainResources.isBufferLimitExceeded() {
+					// wait for the domain to dispatch some of the tasks it has in memory
+					time.AfterFunc(domainThrottleRetryInterval, c.signalNewTask)
+					continue getTasksPumpLoop
+				}
+				if !c.engine.readScheduler.acquire(c.taskListID.domainID, c.shutdownCh) {
+					break getTasksPumpLoop
+				}
 				tasks, readLevel, isReadBatchDone, err := c.getTaskBatch()
+				var aheadTasks []*persistence.TaskInfo
+				if err == nil && len(tasks) > 0 {
+					// the read ahead holds the same read slot, and like the batch each of its reads
+					// is charged to the persistence rate limit of the domain
+					aheadTasks, err = c.getPriorityTaskBatch(tasks[len(tasks)-1].TaskID)
+				}
+				c.engine.readScheduler.release()
 				if err != nil {
 					c.signalNewTask() // re-enqueue the event
 					// TODO: Should we ever stop retrying on db errors?
@@ -795,16 +1281,37 @@ getTasksPumpLoop:
 				c.Lock()
 				if len(tasks) == 0 {
 					c.taskAckManager.setReadLevel(readLevel)
-				} else {
-					for _, t := range tasks {
-						c.taskAckManager.addTask(t.TaskID)
+				}
+				var higherPriorityTasks, lowestPriorityTasks []*persistence.TaskInfo
+				for _, t := range tasks {
+					if !c.taskAckManager.addTask(t.TaskID, t.CreatedTime) {
+						continue // already read ahead
+					}
+					if t.Priority > common.MinTaskPriority {
+						higherPriorityTasks = append(higherPriorityTasks, t)
+					} else {
+						lowestPriorityTasks = append(lowestPriorityTasks, t)
+					}
+				}
+				for _, t := range aheadTasks {
+					if c.taskAckManager.addTaskAhead(t.TaskID, t.CreatedTime) {
+						higherPriorityTasks = append(higherPriorityTasks, t)
 					}
 				}
 				c.Unlock()
+				// the tasks of a higher priority go first, in order of taskID, as the lowest priority tasks
+				// may block on a full taskBuffer
+				tasks = append(higherPriorityTasks, lowestPriorityTasks...)
 				for _, t := range tasks {
+					if c.isTaskExpired(t) {
+						c.completeExpiredTask(t)
+						continue
+					}
+					c.domainResources.addBufferedTasks(1)
 					select {
 					case c.taskBuffer <- t:
 					case <-c.shutdownCh:
+						c.domainResources.addBufferedTasks(-1)
 						break getTasksPumpLoop
 					}
 				}
@@ -830,20 +1337,185 @@ getTasksPumpLoop:
 					// keep going as saving ack is not critical
 				}
 				c.signalNewTask() // periodically signal pump to check persistence for tasks
+				// picks up the rate set on the root partition, and changes of the number of partitions
+				c.refreshDispatchRate()
 				updateAckTimer = time.NewTimer(c.config.UpdateAckInterval())
 			}
 		case <-checkIdleTaskListTimer.C:
 			{
-				if !c.isTaskAddedRecently(lastTimeWriteTask) && len(c.GetAllPollerInfo()) == 0 {
+				// a task list with a backlog stays loaded to keep reporting it while no poller drains it,
+				// unless its domain ran out of task lists, as the domain needs room for task lists in use
+				if !c.isTaskAddedRecently(lastTimeWriteTask) && len(c.GetAllPollerInfo()) == 0 &&
+					(c.getBacklogSize() == 0 || c.domainResources.isTaskListLimitReached()) {
 					c.Stop()
 				}
 				checkIdleTaskListTimer = time.NewTimer(c.config.IdleTasklistCheckInterval())
 			}
+		case <-expiredTaskSweepTimer.C:
+			{
+				c.sweepExpiredTasks()
+				expiredTaskSweepTimer = time.NewTimer(c.config.ExpiredTaskSweepInterval())
+			}
+		case <-taskListMetricsTimer.C:
+			{
+				c.emitTaskListMetrics()
+				taskListMetricsTimer = time.NewTimer(c.config.TaskListMetricsInterval())
+			}
 		}
 	}
 
 	updateAckTimer.Stop()
 	checkIdleTaskListTimer.Stop()
+	expiredTaskSweepTimer.Stop()
+	taskListMetricsTimer.Stop()
+
+	// the tasks left in memory are dropped with the task list
+	c.deliverBufferWG.Wait()
+	c.domainResources.addBufferedTasks(-(len(c.taskBuffer) + c.bufferedTasks.len()))
+}
+
+// emitTaskListMetrics reports the backlog and the pollers of the task list. The task list is starved
+// when its backlog is older than the threshold of its domain and no poll arrived for a while.
+func (c *taskListManagerImpl) emitTaskListMetrics() {
+	if c.getTaskListKind() == persistence.TaskListKindSticky {
+		// sticky task lists belong to a single worker, reporting them would only add cardinality
+		return
+	}
+
+	now := c.engine.timeSource.Now()
+	c.Lock()
+	backlogAge := c.taskAckManager.getBacklogAge(now)
+	ackLevel := c.taskAckManager.getAckLevel()
+	c.Unlock()
+	backlogSize := c.getBacklogSize()
+	if backlogAge == 0 && backlogSize > 0 {
+		// none of the backlog is read yet, so its age is the age of the first task after the ack level
+		backlogAge = c.getUnreadBacklogAge(now, ackLevel)
+	}
+	pollerCount := len(c.GetAllPollerInfo())
+	lastPollTime := time.Unix(0, atomic.LoadInt64(&c.lastPollTime))
+
+	scope := metrics.MatchingTaskListMgrScope
+	c.taskListMetricsClient.UpdateGauge(scope, metrics.TaskListBacklogSizeGauge, float64(backlogSize))
+	c.taskListMetricsClient.UpdateGauge(scope, metrics.TaskListBacklogAgeGauge, backlogAge.Seconds())
+	c.taskListMetricsClient.UpdateGauge(scope, metrics.TaskListPollerCountGauge, float64(pollerCount))
+
+	threshold := c.config.StarvedTaskListBacklogAge()
+	starved := threshold > 0 && backlogAge > threshold &&
+		now.Sub(lastPollTime) > c.config.StarvedTaskListNoPollInterval()
+	if starved {
+		c.taskListMetricsClient.UpdateGauge(scope, metrics.StarvedTaskListGauge, 1)
+		if !c.starved {
+			c.logger.WithFields(bark.Fields{
+				logging.TagBacklogAge:   backlogAge,
+				logging.TagBacklogSize:  backlogSize,
+				logging.TagLastPollTime: lastPollTime,
+			}).Warn("Task list is starved, its backlog is not polled")
+		}
+	} else {
+		c.taskListMetricsClient.UpdateGauge(scope, metrics.StarvedTaskListGauge, 0)
+	}
+	c.starved = starved
+}
+
+// getBacklogSize estimates the number of tasks persisted and not completed yet, including the tasks
+// not read from persistence. Task IDs skipped when the task list moved between hosts are counted too.
+func (c *taskListManagerImpl) getBacklogSize() int64 {
+	c.Lock()
+	ackLevel := c.taskAckManager.getAckLevel()
+	c.Unlock()
+	if ackLevel < 0 {
+		// the task list is not leased yet
+		return 0
+	}
+	backlogSize := c.taskWriter.GetMaxReadLevel() - ackLevel
+	if backlogSize < 0 {
+		return 0
+	}
+	return backlogSize
+}
+
+// getUnreadBacklogAge returns how long the first task after the ack level has been waiting, 0 if there is none
+func (c *taskListManagerImpl) getUnreadBacklogAge(now time.Time, ackLevel int64) time.Duration {
+	c.Lock()
+	rangeID := c.rangeID
+	c.Unlock()
+	response, err := c.taskManager.GetTasks(&persistence.GetTasksRequest{
+		DomainID:     c.taskListID.domainID,
+		TaskList:     c.taskListID.taskListName,
+		TaskType:     c.taskListID.taskType,
+		BatchSize:    1,
+		RangeID:      rangeID,
+		ReadLevel:    ackLevel,
+		MaxReadLevel: c.taskWriter.GetMaxReadLevel(),
+	})
+	if err != nil || len(response.Tasks) == 0 || response.Tasks[0].CreatedTime.After(now) {
+		return 0
+	}
+	return now.Sub(response.Tasks[0].CreatedTime)
+}
+
+// sweepExpiredTasks drops tasks that expired while waiting in taskBuffer or bufferedTasks.
+// Must only be called from getTasksPump, which is the only writer of taskBuffer, so
+// putting the remaining tasks back never blocks. taskBuffer is drained before any task
+// is put back, so that the remaining tasks are still dispatched in task id order.
+func (c *taskListManagerImpl) sweepExpiredTasks() {
+	for _, task := range c.bufferedTasks.removeIf(c.isTaskExpired) {
+		c.domainResources.addBufferedTasks(-1)
+		c.completeExpiredTask(task)
+	}
+	var remaining []*persistence.TaskInfo
+DrainLoop:
+	for {
+		select {
+		case task := <-c.taskBuffer:
+			if c.isTaskExpired(task) {
+				c.domainResources.addBufferedTasks(-1)
+				c.completeExpiredTask(task)
+				continue
+			}
+			remaining = append(remaining, task)
+		default:
+			break DrainLoop
+		}
+	}
+	for _, task := range remaining {
+		c.taskBuffer <- task
+	}
+}
+
+// isTaskExpired returns true if the schedule to start timeout of the task has already passed,
+// in which case history has timed out the task and dispatching it would be wasted work
+func (c *taskListManagerImpl) isTaskExpired(task *persistence.TaskInfo) bool {
+	return !task.Expiry.IsZero() && task.Expiry.Before(c.engine.timeSource.Now())
+}
+
+// completeExpiredTask removes an expired task from the task list without dispatching it
+func (c *taskListManagerImpl) completeExpiredTask(task *persistence.TaskInfo) {
+	c.metricsClient.IncCounter(metrics.MatchingTaskListMgrScope, metrics.ExpiredTasksCounter)
+	c.completeTaskAndDelete(task.TaskID)
+}
+
+// completeTaskAndDelete acks the task and deletes it from persistence
+func (c *taskListManagerImpl) completeTaskAndDelete(taskID int64) {
+	c.completeTaskPoll(taskID)
+
+	// TODO: use range deletes to complete all tasks below ack level instead of completing
+	// tasks one by one.
+	err := c.taskManager.CompleteTask(&persistence.CompleteTaskRequest{
+		TaskList: &persistence.TaskListInfo{
+			DomainID: c.taskListID.domainID,
+			Name:     c.taskListID.taskListName,
+			TaskType: c.taskListID.taskType,
+		},
+		TaskID: taskID,
+	})
+
+	if err != nil {
+		logging.LogPersistantStoreErrorEvent(c.logger, logging.TagValueStoreOperationCompleteTask, err,
+			fmt.Sprintf("{taskID: %v, taskType: %v, taskList: %v}",
+				taskID, c.taskListID.taskType, c.taskListID.taskListName))
+	}
 }
 
 // Retry operation on transient error and on rangeID change. On rangeID update by another process calls c.Stop().
@@ -965,30 +1637,20 @@ func (c *taskContext) completeTask(err error) {
 		tlMgr.signalNewTask()
 	}
 
-	tlMgr.completeTaskPoll(c.info.TaskID)
-
-	// TODO: use range deletes to complete all tasks below ack level instead of completing
-	// tasks one by one.
-	err2 := tlMgr.engine.taskManager.CompleteTask(&persistence.CompleteTaskRequest{
-		TaskList: &persistence.TaskListInfo{
-			DomainID: tlMgr.taskListID.domainID,
-			Name:     tlMgr.taskListID.taskListName,
-			TaskType: tlMgr.taskListID.taskType,
-		},
-		TaskID: c.info.TaskID,
-	})
-
-	if err2 != nil {
-		logging.LogPersistantStoreErrorEvent(tlMgr.logger, logging.TagValueStoreOperationCompleteTask, err2,
-			fmt.Sprintf("{taskID: %v, taskType: %v, taskList: %v}",
-				c.info.TaskID, tlMgr.taskListID.taskType, tlMgr.taskListID.taskListName))
-	}
+	tlMgr.completeTaskAndDelete(c.info.TaskID)
 }
 
 func createServiceBusyError(msg string) *s.ServiceBusyError {
 	return &s.ServiceBusyError{Message: msg}
 }
 
+func taskListTypeTagValue(taskType int) string {
+	if taskType == persistence.TaskListTypeActivity {
+		return "activity"
+	}
+	return "decision"
+}
+
 func (c *taskListManagerImpl) isTaskAddedRecently(lastAddTime time.Time) bool {
-	return time.Now().Sub(lastAddTime) <= c.config.MaxTasklistIdleTime()
+	return c.engine.timeSource.Now().Sub(lastAddTime) <= c.config.MaxTasklistIdleTime()
 }
@@ -21,12 +21,14 @@
 package matching
 
 import (
+	"context"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
 	"golang.org/x/time/rate"
 
 	"github.com/uber/cadence/common/mocks"
@@ -35,9 +37,12 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/uber-common/bark"
+	m "github.com/uber/cadence/.gen/go/matching"
 	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 )
@@ -78,6 +83,167 @@ func TestDeliverBufferTasks_NoPollers(t *testing.T) {
 	wg.Wait()
 }
 
+func TestDeliverBufferTasks_DropsExpiredTask(t *testing.T) {
+	tlm := createTestTaskListManager()
+	tlm.taskAckManager.addTask(1, time.Time{})
+	tlm.taskBuffer <- &persistence.TaskInfo{TaskID: 1, Expiry: time.Now().Add(-time.Minute)}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		tlm.deliverBufferTasksForPoll()
+		wg.Done()
+	}()
+	select {
+	case <-tlm.tasksForPoll:
+		t.Fatal("expired task should not be dispatched")
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(tlm.deliverBufferShutdownCh)
+	wg.Wait()
+	require.Equal(t, int64(1), tlm.getAckLevel())
+}
+
+func TestSweepExpiredTasks(t *testing.T) {
+	tlm := createTestTaskListManager()
+	now := time.Now()
+	tasks := []*persistence.TaskInfo{
+		{TaskID: 1, Expiry: now.Add(-time.Minute)},
+		{TaskID: 2},
+		{TaskID: 3, Expiry: now.Add(time.Minute)},
+		{TaskID: 4, Expiry: now.Add(-time.Second)},
+	}
+	for _, task := range tasks {
+		tlm.taskAckManager.addTask(task.TaskID, time.Time{})
+		tlm.taskBuffer <- task
+	}
+
+	tlm.sweepExpiredTasks()
+	require.Equal(t, 2, len(tlm.taskBuffer))
+	require.Equal(t, int64(2), (<-tlm.taskBuffer).TaskID)
+	require.Equal(t, int64(3), (<-tlm.taskBuffer).TaskID)
+	require.Equal(t, int64(1), tlm.getAckLevel())
+}
+
+func TestDeliverBufferTasks_HighestPriorityFirst(t *testing.T) {
+	tlm := createTestTaskListManager()
+	for i, priority := range []int32{0, 2, 1} {
+		tlm.taskBuffer <- &persistence.TaskInfo{TaskID: int64(i + 1), Priority: priority}
+	}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		tlm.deliverBufferTasksForPoll()
+		wg.Done()
+	}()
+	for _, taskID := range []int64{2, 3, 1} {
+		result := <-tlm.tasksForPoll
+		require.Equal(t, taskID, result.task.TaskID)
+	}
+	close(tlm.deliverBufferShutdownCh)
+	wg.Wait()
+}
+
+func TestSyncMatch_SkippedBehindHigherPriority(t *testing.T) {
+	tlm := createTestTaskListManager()
+	// a buffered tasksForPoll stands for a poller that is already waiting when the task is added
+	tlm.tasksForPoll = make(chan *getTaskResult, 1)
+	go func() {
+		for result := range tlm.tasksForPoll {
+			result.C <- &syncMatchResponse{response: &persistence.CreateTasksResponse{}}
+		}
+	}()
+	defer close(tlm.tasksForPoll)
+	tlm.bufferedTasks.add(&persistence.TaskInfo{TaskID: 1, Priority: 2})
+
+	r, err := tlm.trySyncMatch(&persistence.TaskInfo{DomainID: "domain", Priority: 1})
+	require.NoError(t, err)
+	require.Nil(t, r)
+
+	r, err = tlm.trySyncMatch(&persistence.TaskInfo{DomainID: "domain", Priority: 2})
+	require.NoError(t, err)
+	require.NotNil(t, r)
+}
+
+func TestGetTasksPump_HigherPriorityBehindBacklog(t *testing.T) {
+	const batchSize = 10
+	cfg := defaultTestConfig()
+	cfg.GetTasksBatchSize = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(batchSize)
+	tlm := createTestTaskListManagerWithConfig(cfg)
+	tlm.Lock()
+	require.NoError(t, tlm.updateRangeIfNeededLocked())
+	rangeID := tlm.rangeID
+	tlm.Unlock()
+
+	// the task of a higher priority is persisted behind three batches of tasks of the lowest priority
+	const taskCount = 3*batchSize + 1
+	var tasks []*persistence.CreateTaskInfo
+	for id := int64(1); id <= taskCount; id++ {
+		priority := int32(common.MinTaskPriority)
+		if id == taskCount {
+			priority = 2
+		}
+		tasks = append(tasks, &persistence.CreateTaskInfo{
+			Execution: workflow.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")},
+			Data:      &persistence.TaskInfo{TaskID: id, Priority: priority},
+			TaskID:    id,
+		})
+	}
+	tm := tlm.engine.taskManager.(*testTaskManager)
+	_, err := tm.CreateTasks(&persistence.CreateTasksRequest{
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID: tlm.taskListID.domainID,
+			Name:     tlm.taskListID.taskListName,
+			TaskType: tlm.taskListID.taskType,
+			RangeID:  rangeID,
+		},
+		Tasks: tasks,
+	})
+	require.NoError(t, err)
+	tlm.taskAckManager.setReadLevel(0)
+	atomic.StoreInt64(&tlm.taskWriter.maxReadLevel, taskCount)
+	readSlots := &readSlotTaskManager{TaskManager: tlm.taskManager, readScheduler: tlm.engine.readScheduler}
+	tlm.taskManager = readSlots
+
+	tlMgrStartWithoutNotifyEvent(tlm)
+	tlm.signalNewTask()
+	defer tlm.Stop()
+
+	result := <-tlm.tasksForPoll
+	require.Equal(t, int64(taskCount), result.task.TaskID)
+	for id := int64(1); id < taskCount; id++ {
+		result = <-tlm.tasksForPoll
+		require.Equal(t, id, result.task.TaskID)
+		tlm.completeTaskPoll(id)
+	}
+	// the read ahead goes through the read scheduler like the reads of the batches
+	require.Equal(t, int32(0), atomic.LoadInt32(&readSlots.readsWithoutSlot))
+	// the task read ahead is not dispatched again once the read level reaches it
+	readLevel := func() int64 {
+		tlm.Lock()
+		defer tlm.Unlock()
+		return tlm.taskAckManager.getReadLevel()
+	}
+	for readLevel() < taskCount {
+		time.Sleep(time.Millisecond)
+	}
+	select {
+	case result = <-tlm.tasksForPoll:
+		t.Fatalf("task %v dispatched twice", result.task.TaskID)
+	case <-time.After(100 * time.Millisecond):
+	}
+	require.Equal(t, int64(taskCount-1), tlm.getAckLevel())
+	tlm.completeTaskPoll(taskCount)
+	require.Equal(t, int64(taskCount), tlm.getAckLevel())
+}
+
+func TestAddForwardedTask_NoPoller(t *testing.T) {
+	tlm := createTestTaskListManager()
+	tlm.startWG.Done()
+	syncMatch, err := tlm.AddTask(&workflow.WorkflowExecution{}, &persistence.TaskInfo{DomainID: "domain"}, "partition")
+	require.False(t, syncMatch)
+	require.Equal(t, errRemoteSyncMatchFailed, err)
+}
+
 func TestNewRateLimiter(t *testing.T) {
 	maxDispatch := float64(0.01)
 	rl := newRateLimiter(&maxDispatch, time.Second, _minBurst)
@@ -85,6 +251,235 @@ func TestNewRateLimiter(t *testing.T) {
 	assert.Equal(t, _minBurst, limiter.Burst())
 }
 
+func TestUpdateDispatchRate(t *testing.T) {
+	tlm := createTestTaskListManager()
+	tlm.Lock()
+	require.NoError(t, tlm.updateRangeIfNeededLocked())
+	tlm.Unlock()
+	tlm.startWG.Done()
+
+	require.NoError(t, tlm.UpdateDispatchRate(5))
+	require.Equal(t, float64(5), tlm.GetDispatchRate())
+	require.Equal(t, float64(5), *tlm.rateLimiter.maxDispatchPerSecond)
+	tm := tlm.engine.taskManager.(*testTaskManager)
+	require.Equal(t, float64(5), tm.getTaskListManager(tlm.taskListID).dispatchRate)
+
+	// the rate pollers ask for does not override the dispatch rate
+	pollerRate := float64(1)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	tlm.GetTaskContext(ctx, &pollerRate)
+	require.Equal(t, float64(5), *tlm.rateLimiter.maxDispatchPerSecond)
+
+	// the dispatch rate is restored when the task list is leased again
+	tlm.Lock()
+	tlm.dispatchRate = 0
+	tlm.nextRangeSequenceNumber = tlm.taskSequenceNumber
+	require.NoError(t, tlm.updateRangeIfNeededLocked())
+	tlm.Unlock()
+	require.Equal(t, float64(5), tlm.GetDispatchRate())
+
+	require.NoError(t, tlm.UpdateDispatchRate(0))
+	require.Equal(t, float64(0), tlm.GetDispatchRate())
+	require.Equal(t, float64(_defaultTaskDispatchRPS), *tlm.rateLimiter.maxDispatchPerSecond)
+	require.Equal(t, float64(0), tm.getTaskListManager(tlm.taskListID).dispatchRate)
+}
+
+func TestPartitionDispatchRate(t *testing.T) {
+	cfg := defaultTestConfig()
+	numPartitions := 2
+	cfg.NumTasklistPartitions = func(domain string, taskList string, taskType int) int {
+		return numPartitions
+	}
+	root := createTestTaskListManagerWithConfig(cfg)
+	root.Lock()
+	require.NoError(t, root.updateRangeIfNeededLocked())
+	root.Unlock()
+	root.startWG.Done()
+
+	// the root partition keeps the total rate and dispatches its share of it
+	require.NoError(t, root.UpdateDispatchRate(10))
+	require.Equal(t, float64(10), root.GetDispatchRate())
+	require.Equal(t, float64(5), *root.rateLimiter.maxDispatchPerSecond)
+
+	partitionID := &taskListID{
+		domainID:     root.taskListID.domainID,
+		taskListName: matching.TaskListPartitionName(root.taskListID.taskListName, 1),
+		taskType:     root.taskListID.taskType,
+	}
+	partitionCfg, err := newTaskListConfig(partitionID, cfg, root.domainCache)
+	require.NoError(t, err)
+	tlMgr, err := newTaskListManager(root.engine, partitionID, common.TaskListKindPtr(workflow.TaskListKindNormal), partitionCfg)
+	require.NoError(t, err)
+	partition := tlMgr.(*taskListManagerImpl)
+	partition.refreshDispatchRate()
+	require.Equal(t, float64(10), partition.GetDispatchRate())
+	require.Equal(t, float64(5), *partition.rateLimiter.maxDispatchPerSecond)
+
+	// the share follows the number of partitions
+	numPartitions = 4
+	partition.refreshDispatchRate()
+	require.Equal(t, float64(2.5), *partition.rateLimiter.maxDispatchPerSecond)
+
+	require.NoError(t, root.UpdateDispatchRate(0))
+	partition.refreshDispatchRate()
+	require.Equal(t, float64(0), partition.GetDispatchRate())
+	require.Equal(t, float64(_defaultTaskDispatchRPS), *partition.rateLimiter.maxDispatchPerSecond)
+}
+
+func TestPausedTaskList(t *testing.T) {
+	tlm := createTestTaskListManager()
+	tlm.Lock()
+	require.NoError(t, tlm.updateRangeIfNeededLocked())
+	tlm.Unlock()
+	tlm.startWG.Done()
+
+	require.NoError(t, tlm.SetPaused(true))
+	require.True(t, tlm.IsPaused())
+	tm := tlm.engine.taskManager.(*testTaskManager)
+	require.True(t, tm.getTaskListManager(tlm.taskListID).paused)
+
+	// polls of a paused task list return empty even though a task is ready
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	go func() {
+		for {
+			select {
+			case tlm.tasksForPoll <- &getTaskResult{task: &persistence.TaskInfo{TaskID: 1}}:
+			case <-doneCh:
+				return
+			}
+		}
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := tlm.getTask(ctx)
+	require.Equal(t, ErrNoTasks, err)
+
+	// the pause survives the task list being leased again
+	tlm.Lock()
+	tlm.paused = false
+	tlm.nextRangeSequenceNumber = tlm.taskSequenceNumber
+	require.NoError(t, tlm.updateRangeIfNeededLocked())
+	tlm.Unlock()
+	require.True(t, tlm.IsPaused())
+
+	require.NoError(t, tlm.SetPaused(false))
+	require.False(t, tm.getTaskListManager(tlm.taskListID).paused)
+	result, err := tlm.getTask(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), result.task.TaskID)
+}
+
+func TestPausedTaskList_CancelsOutstandingPolls(t *testing.T) {
+	tlm := createTestTaskListManager()
+	tlm.Lock()
+	require.NoError(t, tlm.updateRangeIfNeededLocked())
+	tlm.Unlock()
+	tlm.startWG.Done()
+
+	errC := make(chan error, 1)
+	go func() {
+		ctx := context.WithValue(context.Background(), pollerIDKey, "test-poller")
+		_, err := tlm.getTask(ctx)
+		errC <- err
+	}()
+	// wait for the poll to be registered
+	for {
+		tlm.outstandingPollsLock.Lock()
+		n := len(tlm.outstandingPollsMap)
+		tlm.outstandingPollsLock.Unlock()
+		if n > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	require.NoError(t, tlm.SetPaused(true))
+	select {
+	case err := <-errC:
+		require.Equal(t, ErrNoTasks, err)
+	case <-time.After(time.Second):
+		t.Fatal("outstanding poll should return when the task list is paused")
+	}
+}
+
+func TestPausedTaskList_HoldsTasks(t *testing.T) {
+	tlm := createTestTaskListManager()
+	tlm.Lock()
+	require.NoError(t, tlm.updateRangeIfNeededLocked())
+	tlm.Unlock()
+	tlm.startWG.Done()
+	require.NoError(t, tlm.SetPaused(true))
+
+	// a poller waiting from before the pause does not get sync matched tasks
+	tlm.tasksForPoll = make(chan *getTaskResult, 1)
+	resp, err := tlm.trySyncMatch(&persistence.TaskInfo{TaskID: 1})
+	require.NoError(t, err)
+	require.Nil(t, resp)
+	require.Equal(t, 0, len(tlm.tasksForPoll))
+	tlm.tasksForPoll = make(chan *getTaskResult)
+
+	// nor buffered tasks, until the task list is resumed
+	tlm.taskAckManager.addTask(2, time.Time{})
+	tlm.taskBuffer <- &persistence.TaskInfo{TaskID: 2}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		tlm.deliverBufferTasksForPoll()
+	}()
+	select {
+	case <-tlm.tasksForPoll:
+		t.Fatal("tasks of a paused task list should not be dispatched")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	require.NoError(t, tlm.SetPaused(false))
+	select {
+	case result := <-tlm.tasksForPoll:
+		require.Equal(t, int64(2), result.task.TaskID)
+	case <-time.After(time.Second):
+		t.Fatal("tasks should be dispatched after the task list is resumed")
+	}
+	close(tlm.deliverBufferShutdownCh)
+	wg.Wait()
+}
+
+func TestPausedTaskList_DispatchesQueries(t *testing.T) {
+	tlm := createTestTaskListManager()
+	tlm.Lock()
+	require.NoError(t, tlm.updateRangeIfNeededLocked())
+	tlm.Unlock()
+	tlm.startWG.Done()
+	require.NoError(t, tlm.SetPaused(true))
+
+	queryTask := &queryTaskInfo{
+		taskID: "test-query",
+		queryRequest: &m.QueryWorkflowRequest{
+			DomainUUID: common.StringPtr(tlm.taskListID.domainID),
+			QueryRequest: &workflow.QueryWorkflowRequest{
+				Execution: &workflow.WorkflowExecution{
+					WorkflowId: common.StringPtr("wid"),
+					RunId:      common.StringPtr("rid"),
+				},
+			},
+		},
+	}
+	errC := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		errC <- tlm.SyncMatchQueryTask(ctx, queryTask)
+	}()
+
+	result, err := tlm.getTask(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, queryTask, result.queryTask)
+	result.C <- &syncMatchResponse{}
+	require.NoError(t, <-errC)
+}
+
 func createTestTaskListManager() *taskListManagerImpl {
 	return createTestTaskListManagerWithConfig(defaultTestConfig())
 }
@@ -101,7 +496,11 @@ func createTestTaskListManagerWithConfig(cfg *Config) *taskListManagerImpl {
 	dID := "domain"
 	tlID := &taskListID{domainID: dID, taskListName: tl, taskType: persistence.TaskListTypeActivity}
 	tlKind := common.TaskListKindPtr(workflow.TaskListKindNormal)
-	tlMgr, err := newTaskListManager(me, tlID, tlKind, cfg)
+	tlCfg, err := newTaskListConfig(tlID, cfg, mockDomainCache)
+	if err != nil {
+		logger.Fatalf("error when createTestTaskListManager: %v", err)
+	}
+	tlMgr, err := newTaskListManager(me, tlID, tlKind, tlCfg)
 	if err != nil {
 		logger.Fatalf("error when createTestTaskListManager: %v", err)
 	}
@@ -122,6 +521,128 @@ func tlMgrStartWithoutNotifyEvent(tlm *taskListManagerImpl) {
 	go tlm.getTasksPump()
 }
 
+func TestPollerHistoryRestoredOnLease(t *testing.T) {
+	tlm := createTestTaskListManager()
+	tlm.Lock()
+	require.NoError(t, tlm.updateRangeIfNeededLocked())
+	tlm.Unlock()
+
+	poller := pollerIdentity{
+		identity:       "test-poll",
+		binaryChecksum: "checksum",
+		sdkVersion:     "1.0.0",
+		hostname:       "worker-host",
+	}
+	tlm.pollerHistory.updatePollerInfo(poller, 10)
+	require.NoError(t, tlm.persistAckLevel())
+	tm := tlm.engine.taskManager.(*testTaskManager)
+	pollers := tm.getTaskListManager(tlm.taskListID).pollers
+	require.Equal(t, 1, len(pollers))
+	require.Equal(t, "checksum", pollers[0].BinaryChecksum)
+
+	// a new owner starts with an empty history and restores it when leasing the task list
+	tlm.pollerHistory = newPollerHistory()
+	tlm.Lock()
+	tlm.nextRangeSequenceNumber = tlm.taskSequenceNumber
+	require.NoError(t, tlm.updateRangeIfNeededLocked())
+	tlm.Unlock()
+	infos := tlm.GetAllPollerInfo()
+	require.Equal(t, 1, len(infos))
+	require.Equal(t, poller, infos[0].pollerIdentity)
+	require.Equal(t, float64(10), infos[0].ratePerSecond)
+}
+
+func TestPollerHistoryRestoreSkipsExpired(t *testing.T) {
+	history := newPollerHistory()
+	history.restore([]*persistence.TaskListPollerInfo{
+		{Identity: "expired", LastAccessTime: time.Now().Add(-2 * pollerHistoryTTL)},
+		{Identity: "recent", LastAccessTime: time.Now()},
+	})
+	infos := history.getAllPollerInfo()
+	require.Equal(t, 1, len(infos))
+	require.Equal(t, "recent", infos[0].identity)
+}
+
+func TestEmitTaskListMetrics(t *testing.T) {
+	cfg := defaultTestConfig()
+	cfg.StarvedTaskListBacklogAge = dynamicconfig.GetDurationPropertyFnFilteredByDomain(time.Minute)
+	cfg.StarvedTaskListNoPollInterval = dynamicconfig.GetDurationPropertyFnFilteredByDomain(time.Minute)
+	tlm := createTestTaskListManagerWithConfig(cfg)
+	scope := tally.NewTestScope("test", nil)
+	tlm.taskListMetricsClient = metrics.NewClient(scope, metrics.Matching)
+	gauge := func(name string) float64 {
+		return scope.Snapshot().Gauges()["test."+name+"+operation=TaskListMgr"].Value()
+	}
+
+	// a fresh backlog is not starved, the tasks not read yet are part of the backlog
+	now := time.Now()
+	tlm.taskAckManager.setAckLevel(0)
+	tlm.taskAckManager.addTask(1, now.Add(-2*time.Minute))
+	tlm.taskAckManager.addTask(2, now)
+	atomic.StoreInt64(&tlm.taskWriter.maxReadLevel, 5)
+	tlm.emitTaskListMetrics()
+	require.Equal(t, float64(5), gauge("tasklist.backlog.size"))
+	require.True(t, gauge("tasklist.backlog.age-seconds") >= 120)
+	require.Equal(t, float64(0), gauge("tasklist.pollers"))
+	require.Equal(t, float64(0), gauge("tasklist.starved"))
+	require.False(t, tlm.starved)
+
+	// an old backlog without polls for a while is starved
+	atomic.StoreInt64(&tlm.lastPollTime, now.Add(-2*time.Minute).UnixNano())
+	tlm.emitTaskListMetrics()
+	require.Equal(t, float64(1), gauge("tasklist.starved"))
+	require.True(t, tlm.starved)
+
+	// completing the old task ends the starvation
+	tlm.completeTaskPoll(1)
+	tlm.emitTaskListMetrics()
+	require.Equal(t, float64(4), gauge("tasklist.backlog.size"))
+	require.Equal(t, float64(0), gauge("tasklist.starved"))
+	require.False(t, tlm.starved)
+
+	// unloading the task list clears the starved flag
+	atomic.StoreInt64(&tlm.lastPollTime, now.Add(-2*time.Minute).UnixNano())
+	tlm.taskAckManager.addTask(3, now.Add(-2*time.Minute))
+	tlm.emitTaskListMetrics()
+	require.Equal(t, float64(1), gauge("tasklist.starved"))
+	tlm.Stop()
+	require.Equal(t, float64(0), gauge("tasklist.starved"))
+}
+
+func TestEmitTaskListMetrics_UnreadBacklogAge(t *testing.T) {
+	tlm := createTestTaskListManager()
+	scope := tally.NewTestScope("test", nil)
+	tlm.taskListMetricsClient = metrics.NewClient(scope, metrics.Matching)
+	gauge := func(name string) float64 {
+		return scope.Snapshot().Gauges()["test."+name+"+operation=TaskListMgr"].Value()
+	}
+	tlm.Lock()
+	require.NoError(t, tlm.updateRangeIfNeededLocked())
+	tlm.Unlock()
+
+	_, err := tlm.engine.taskManager.CreateTasks(&persistence.CreateTasksRequest{
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID: tlm.taskListID.domainID,
+			Name:     tlm.taskListID.taskListName,
+			TaskType: tlm.taskListID.taskType,
+			RangeID:  tlm.rangeID,
+		},
+		Tasks: []*persistence.CreateTaskInfo{
+			{
+				Execution: workflow.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")},
+				Data:      &persistence.TaskInfo{TaskID: 1, CreatedTime: time.Now().Add(-time.Minute)},
+				TaskID:    1,
+			},
+		},
+	})
+	require.NoError(t, err)
+	atomic.StoreInt64(&tlm.taskWriter.maxReadLevel, 1)
+
+	tlm.emitTaskListMetrics()
+	require.Equal(t, float64(1), gauge("tasklist.backlog.size"))
+	require.True(t, gauge("tasklist.backlog.age-seconds") >= 60)
+}
+
 func TestCheckIdleTaskList(t *testing.T) {
 	cfg := NewConfig(dynamicconfig.NewNopCollection())
 	cfg.IdleTasklistCheckInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
@@ -152,3 +673,20 @@ func TestCheckIdleTaskList(t *testing.T) {
 	tlm.Stop()
 	require.Equal(t, int32(1), tlm.stopped)
 }
+
+// readSlotTaskManager counts the reads of tasks made without a slot of the read scheduler
+type readSlotTaskManager struct {
+	persistence.TaskManager
+	readScheduler    *fairReadScheduler
+	readsWithoutSlot int32
+}
+
+func (m *readSlotTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+	m.readScheduler.Lock()
+	inFlight := m.readScheduler.inFlight
+	m.readScheduler.Unlock()
+	if inFlight == 0 {
+		atomic.AddInt32(&m.readsWithoutSlot, 1)
+	}
+	return m.TaskManager.GetTasks(request)
+}
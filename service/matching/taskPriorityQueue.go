@@ -0,0 +1,158 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// Holds the tasks read from persistence in a FIFO per priority, so that tasks of a higher priority
+// are dispatched first. To keep a steady flow of tasks of a higher priority from starving the older
+// tasks of a lower priority, the oldest task is dispatched once starvationLimit tasks in a row were
+// dispatched ahead of it.
+type taskPriorityQueue struct {
+	sync.Mutex
+	queues [common.MaxTaskPriority - common.MinTaskPriority + 1][]*persistence.TaskInfo
+	size   int
+	// skipped is the number of tasks dispatched in a row ahead of the oldest task
+	skipped int
+	// dispatching is the last task popped, until the poller it is handed to takes it
+	dispatching *persistence.TaskInfo
+}
+
+func newTaskPriorityQueue() *taskPriorityQueue {
+	return &taskPriorityQueue{}
+}
+
+// Adds a task to the queue. Tasks must be added in increasing order of taskID.
+func (q *taskPriorityQueue) add(task *persistence.TaskInfo) {
+	q.Lock()
+	defer q.Unlock()
+	p := priorityIndex(task.Priority)
+	q.queues[p] = append(q.queues[p], task)
+	q.size++
+}
+
+// Returns the number of tasks in the queue
+func (q *taskPriorityQueue) len() int {
+	q.Lock()
+	defer q.Unlock()
+	return q.size
+}
+
+// Removes and returns the task to dispatch next, nil if the queue is empty. The task is tracked as
+// dispatching until done is called.
+func (q *taskPriorityQueue) pop(starvationLimit int) *persistence.TaskInfo {
+	q.Lock()
+	defer q.Unlock()
+	highest, oldest := -1, -1
+	for p := len(q.queues) - 1; p >= 0; p-- {
+		if len(q.queues[p]) == 0 {
+			continue
+		}
+		if highest == -1 {
+			highest = p
+		}
+		if oldest == -1 || q.queues[p][0].TaskID < q.queues[oldest][0].TaskID {
+			oldest = p
+		}
+	}
+	if highest == -1 {
+		return nil
+	}
+
+	next := highest
+	if oldest == highest {
+		q.skipped = 0
+	} else if starvationLimit > 0 && q.skipped >= starvationLimit {
+		next = oldest
+		q.skipped = 0
+	} else {
+		q.skipped++
+	}
+
+	task := q.queues[next][0]
+	q.queues[next][0] = nil
+	q.queues[next] = q.queues[next][1:]
+	q.size--
+	q.dispatching = task
+	return task
+}
+
+// Marks the last popped task as no longer dispatching
+func (q *taskPriorityQueue) done() {
+	q.Lock()
+	defer q.Unlock()
+	q.dispatching = nil
+}
+
+// Returns true if a task of a higher priority than the given one is waiting to be dispatched
+func (q *taskPriorityQueue) hasHigherPriority(priority int32) bool {
+	q.Lock()
+	defer q.Unlock()
+	p := priorityIndex(priority)
+	if q.dispatching != nil && priorityIndex(q.dispatching.Priority) > p {
+		return true
+	}
+	for i := p + 1; i < len(q.queues); i++ {
+		if len(q.queues[i]) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Removes and returns the tasks matching the predicate
+func (q *taskPriorityQueue) removeIf(predicate func(task *persistence.TaskInfo) bool) []*persistence.TaskInfo {
+	q.Lock()
+	defer q.Unlock()
+	var removed []*persistence.TaskInfo
+	for p, tasks := range q.queues {
+		remaining := tasks[:0]
+		for _, task := range tasks {
+			if predicate(task) {
+				removed = append(removed, task)
+				continue
+			}
+			remaining = append(remaining, task)
+		}
+		for i := len(remaining); i < len(tasks); i++ {
+			tasks[i] = nil
+		}
+		q.queues[p] = remaining
+	}
+	q.size -= len(removed)
+	return removed
+}
+
+// priorityIndex maps a priority to its queue, clamping priorities out of the valid range
+func priorityIndex(priority int32) int {
+	if priority < common.MinTaskPriority {
+		priority = common.MinTaskPriority
+	}
+	if priority > common.MaxTaskPriority {
+		priority = common.MaxTaskPriority
+	}
+	return int(priority - common.MinTaskPriority)
+}
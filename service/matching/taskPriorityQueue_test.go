@@ -0,0 +1,86 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestTaskPriorityQueue_HighestPriorityFirst(t *testing.T) {
+	q := newTaskPriorityQueue()
+	require.Nil(t, q.pop(0))
+	q.add(&persistence.TaskInfo{TaskID: 1, Priority: 0})
+	q.add(&persistence.TaskInfo{TaskID: 2, Priority: 2})
+	q.add(&persistence.TaskInfo{TaskID: 3, Priority: 1})
+	q.add(&persistence.TaskInfo{TaskID: 4, Priority: 2})
+	require.Equal(t, 4, q.len())
+
+	var order []int64
+	for task := q.pop(0); task != nil; task = q.pop(0) {
+		order = append(order, task.TaskID)
+	}
+	require.Equal(t, []int64{2, 4, 3, 1}, order)
+	require.Equal(t, 0, q.len())
+}
+
+func TestTaskPriorityQueue_StarvationLimit(t *testing.T) {
+	q := newTaskPriorityQueue()
+	q.add(&persistence.TaskInfo{TaskID: 1, Priority: 0})
+	for id := int64(2); id <= 6; id++ {
+		q.add(&persistence.TaskInfo{TaskID: id, Priority: 3})
+	}
+
+	var order []int64
+	for task := q.pop(2); task != nil; task = q.pop(2) {
+		order = append(order, task.TaskID)
+	}
+	// the old task of low priority is dispatched after two tasks were dispatched ahead of it
+	require.Equal(t, []int64{2, 3, 1, 4, 5, 6}, order)
+}
+
+func TestTaskPriorityQueue_HasHigherPriority(t *testing.T) {
+	q := newTaskPriorityQueue()
+	require.False(t, q.hasHigherPriority(0))
+	q.add(&persistence.TaskInfo{TaskID: 1, Priority: 2})
+	require.True(t, q.hasHigherPriority(1))
+	require.False(t, q.hasHigherPriority(2))
+
+	// the task being dispatched still counts until the dispatch is done
+	q.pop(0)
+	require.True(t, q.hasHigherPriority(1))
+	q.done()
+	require.False(t, q.hasHigherPriority(1))
+}
+
+func TestTaskPriorityQueue_RemoveIf(t *testing.T) {
+	q := newTaskPriorityQueue()
+	for id := int64(1); id <= 4; id++ {
+		q.add(&persistence.TaskInfo{TaskID: id, Priority: int32(id % 2)})
+	}
+	removed := q.removeIf(func(task *persistence.TaskInfo) bool { return task.TaskID <= 2 })
+	require.Equal(t, 2, len(removed))
+	require.Equal(t, 2, q.len())
+	require.Equal(t, int64(3), q.pop(0).TaskID)
+	require.Equal(t, int64(4), q.pop(0).TaskID)
+}
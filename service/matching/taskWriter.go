@@ -66,7 +66,7 @@ func newTaskWriter(tlMgr *taskListManagerImpl) *taskWriter {
 		tlMgr:       tlMgr,
 		config:      tlMgr.config,
 		taskListID:  tlMgr.taskListID,
-		taskManager: tlMgr.engine.taskManager,
+		taskManager: tlMgr.taskManager,
 		stopCh:      make(chan struct{}),
 		appendCh:    make(chan *writeTaskRequest, tlMgr.config.OutstandingTaskAppendsThreshold()),
 		logger:      tlMgr.logger,
@@ -156,18 +156,19 @@ writerLoop:
 					maxReadLevel = taskIDs[i]
 				}
 
+				w.tlMgr.persistenceLock.Lock()
 				tlInfo := &persistence.TaskListInfo{
 					DomainID: w.taskListID.domainID,
 					Name:     w.taskListID.taskListName,
 					TaskType: w.taskListID.taskType,
 					// Note that newTaskID could increment range, so rangeID parameter
 					// might be out of sync. This is OK as caller can just retry.
-					RangeID:  rangeID,
-					AckLevel: w.tlMgr.getAckLevel(),
-					Kind:     w.tlMgr.getTaskListKind(),
+					RangeID:      rangeID,
+					AckLevel:     w.tlMgr.getAckLevel(),
+					Kind:         w.tlMgr.getTaskListKind(),
+					DispatchRate: w.tlMgr.GetDispatchRate(),
+					Paused:       w.tlMgr.IsPaused(),
 				}
-
-				w.tlMgr.persistenceLock.Lock()
 				r, err := w.taskManager.CreateTasks(&persistence.CreateTasksRequest{
 					TaskListInfo: tlInfo,
 					Tasks:        tasks,
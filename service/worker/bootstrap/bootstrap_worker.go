@@ -0,0 +1,170 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bootstrap
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	bc "github.com/uber/cadence/common/bootstrap"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/common/xdc"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+	replicationTimeout = 30 * time.Second
+)
+
+type (
+	// Config for bootstrap worker
+	Config struct {
+		// MaxRPS caps the rate at which a single bootstrap replicates executions, zero or
+		// less does not cap the requested rate
+		MaxRPS dynamicconfig.IntPropertyFn
+	}
+
+	// Worker is the cadence client worker responsible for running the workflows
+	// bootstrapping the current cluster as a standby of existing domains
+	Worker struct {
+		worker worker.Worker
+	}
+
+	bootstrapContext struct {
+		clusterMetadata cluster.Metadata
+		domainCache     cache.DomainCache
+		clientBean      client.Bean
+		historyClient   history.Client
+		serializer      persistence.HistorySerializer
+		config          *Config
+		retryPolicy     backoff.RetryPolicy
+		logger          bark.Logger
+		newRereplicator func(sourceCluster string) xdc.HistoryRereplicator
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(BootstrapWorkflow, workflow.RegisterOptions{Name: bc.WorkflowFnName})
+	activity.RegisterWithOptions(ReplicatePageActivity, activity.RegisterOptions{Name: ReplicatePageActivityFnName})
+}
+
+// NewWorker returns a new bootstrap Worker
+func NewWorker(frontendClient frontend.Client, clusterMetadata cluster.Metadata, domainCache cache.DomainCache,
+	clientBean client.Bean, config *Config, scope tally.Scope, logger bark.Logger) *Worker {
+
+	zapLogger, _ := zap.NewProduction()
+	bootstrapCtx := &bootstrapContext{
+		clusterMetadata: clusterMetadata,
+		domainCache:     domainCache,
+		clientBean:      clientBean,
+		historyClient: history.NewRetryableClient(
+			clientBean.GetHistoryClient(),
+			common.CreateHistoryServiceRetryPolicy(),
+			common.IsWhitelistServiceTransientError,
+		),
+		serializer:  persistence.NewHistorySerializer(),
+		config:      config,
+		retryPolicy: createReplicationRetryPolicy(),
+		logger:      logger.WithField(logging.TagWorkflowComponent, logging.TagValueBootstrapComponent),
+	}
+	bootstrapCtx.newRereplicator = bootstrapCtx.newHistoryRereplicator
+	wo := worker.Options{
+		Logger:                    zapLogger,
+		MetricsScope:              scope.SubScope(BootstrapScope),
+		BackgroundActivityContext: context.WithValue(context.Background(), bootstrapContextKey, bootstrapCtx),
+	}
+	return &Worker{
+		worker: worker.New(frontendClient, bc.Domain, bc.TaskList, wo),
+	}
+}
+
+// Start the bootstrap Worker
+func (w *Worker) Start() error {
+	if err := w.worker.Start(); err != nil {
+		w.worker.Stop()
+		return err
+	}
+	return nil
+}
+
+// Stop the bootstrap Worker
+func (w *Worker) Stop() {
+	w.worker.Stop()
+}
+
+// rps returns the rate of replication of a bootstrap, bounded by the configured maximum
+func (c *bootstrapContext) rps(requested int) int {
+	maxRPS := c.config.MaxRPS()
+	if requested <= 0 {
+		requested = bc.DefaultRPS
+	}
+	if maxRPS > 0 && requested > maxRPS {
+		return maxRPS
+	}
+	return requested
+}
+
+func createReplicationRetryPolicy() backoff.RetryPolicy {
+	policy := backoff.NewExponentialRetryPolicy(replicationRetryInitialInterval)
+	policy.SetMaximumInterval(replicationRetryMaxInterval)
+	policy.SetExpirationInterval(replicationRetryExpirationInterval)
+	return policy
+}
+
+func (c *bootstrapContext) newHistoryRereplicator(sourceCluster string) xdc.HistoryRereplicator {
+	return xdc.NewHistoryRereplicator(
+		c.clusterMetadata.GetCurrentClusterName(),
+		c.domainCache,
+		func() (admin.Client, error) {
+			adminClient, err := c.clientBean.GetRemoteAdminClient(sourceCluster)
+			if err != nil {
+				return nil, err
+			}
+			return admin.NewRetryableClient(
+				adminClient,
+				common.CreateAdminServiceRetryPolicy(),
+				common.IsWhitelistServiceTransientError,
+			), nil
+		},
+		func(ctx context.Context, request *h.ReplicateRawEventsRequest) error {
+			return c.historyClient.ReplicateRawEvents(ctx, request)
+		},
+		c.serializer,
+		replicationTimeout,
+		c.logger.WithField(logging.TagSourceCluster, sourceCluster),
+	)
+}
@@ -0,0 +1,271 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	bc "github.com/uber/cadence/common/bootstrap"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/logging"
+	"go.uber.org/cadence"
+	cs "go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+type (
+	// ReplicatePageRequest is the input of the replicate page activity
+	ReplicatePageRequest struct {
+		DomainName      string
+		SourceCluster   string
+		RPS             int
+		PageSize        int
+		LatestStartTime int64
+		NextPageToken   []byte
+	}
+
+	// ReplicatePageResult is the result of the replicate page activity
+	ReplicatePageResult struct {
+		NextPageToken        []byte
+		ExecutionsReplicated int
+		ExecutionsSkipped    int
+		ExecutionsFailed     int
+	}
+
+	// pageProgress is recorded as heartbeat details of the replicate page activity, so that
+	// a retried attempt does not replicate the same executions again
+	pageProgress struct {
+		ReplicatedRunIDs     []string
+		ExecutionsReplicated int
+		ExecutionsSkipped    int
+		ExecutionsFailed     int
+	}
+)
+
+// BootstrapWorkflow replicates the histories of all open executions of a domain
+// from the source cluster to the current cluster
+func BootstrapWorkflow(ctx workflow.Context, params bc.Params) error {
+	logger := workflow.GetLogger(ctx).With(
+		zap.String(logging.TagDomainName, params.DomainName),
+		zap.String(logging.TagSourceCluster, params.SourceCluster))
+	scope := workflow.GetMetricsScope(ctx)
+
+	if err := workflow.SetQueryHandler(ctx, bc.ProgressQueryType, func() (bc.Params, error) {
+		return params, nil
+	}); err != nil {
+		return err
+	}
+
+	if params.LatestStartTime == 0 {
+		params.LatestStartTime = workflow.Now(ctx).UnixNano()
+	}
+
+	ao := workflow.ActivityOptions{
+		ScheduleToStartTimeout: 10 * time.Minute,
+		StartToCloseTimeout:    time.Hour,
+		HeartbeatTimeout:       time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          time.Second,
+			BackoffCoefficient:       2.0,
+			MaximumInterval:          5 * time.Minute,
+			ExpirationInterval:       time.Hour * 24,
+			MaximumAttempts:          0,
+			NonRetriableErrorReasons: []string{ErrReasonInvalidRequest},
+		},
+	}
+	actCtx := workflow.WithActivityOptions(ctx, ao)
+
+	for pages := 0; pages < PagesUntilContinueAsNew; pages++ {
+		request := ReplicatePageRequest{
+			DomainName:      params.DomainName,
+			SourceCluster:   params.SourceCluster,
+			RPS:             params.RPS,
+			PageSize:        params.PageSize,
+			LatestStartTime: params.LatestStartTime,
+			NextPageToken:   params.NextPageToken,
+		}
+		var result ReplicatePageResult
+		if err := workflow.ExecuteActivity(actCtx, ReplicatePageActivityFnName, request).Get(ctx, &result); err != nil {
+			logger.Error("failed to replicate page of open executions", zap.Error(err))
+			return err
+		}
+
+		params.NextPageToken = result.NextPageToken
+		params.Progress.PagesProcessed++
+		params.Progress.ExecutionsReplicated += result.ExecutionsReplicated
+		params.Progress.ExecutionsSkipped += result.ExecutionsSkipped
+		params.Progress.ExecutionsFailed += result.ExecutionsFailed
+		scope.Counter(ReplicatedExecutionCount).Inc(int64(result.ExecutionsReplicated))
+		scope.Counter(SkippedExecutionCount).Inc(int64(result.ExecutionsSkipped))
+		scope.Counter(FailedExecutionCount).Inc(int64(result.ExecutionsFailed))
+
+		if len(params.NextPageToken) == 0 {
+			params.Progress.Completed = true
+			logger.Info("bootstrap completed",
+				zap.Int("executions-replicated", params.Progress.ExecutionsReplicated),
+				zap.Int("executions-failed", params.Progress.ExecutionsFailed))
+			return nil
+		}
+	}
+
+	logger.Info("completed current set of pages, continuing as new",
+		zap.Int(logging.TagIterationsUntilContinueAsNew, PagesUntilContinueAsNew))
+
+	ctx = workflow.WithExecutionStartToCloseTimeout(ctx, bc.WorkflowStartToCloseTimeout)
+	ctx = workflow.WithWorkflowTaskStartToCloseTimeout(ctx, bc.DecisionTaskStartToCloseTimeout)
+	return workflow.NewContinueAsNewError(ctx, bc.WorkflowFnName, params)
+}
+
+// ReplicatePageActivity lists one page of open executions of the domain on the source cluster
+// and replicates the full history of each of them to the current cluster
+func ReplicatePageActivity(ctx context.Context, request ReplicatePageRequest) (ReplicatePageResult, error) {
+	bootstrapCtx := ctx.Value(bootstrapContextKey).(*bootstrapContext)
+	logger := activity.GetLogger(ctx).With(
+		zap.String(logging.TagDomainName, request.DomainName),
+		zap.String(logging.TagSourceCluster, request.SourceCluster))
+
+	domainEntry, err := bootstrapCtx.domainCache.GetDomain(request.DomainName)
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			return ReplicatePageResult{}, cadence.NewCustomError(ErrReasonInvalidRequest, err.Error())
+		}
+		return ReplicatePageResult{}, err
+	}
+	if err := bootstrapCtx.validate(domainEntry, request.SourceCluster); err != nil {
+		return ReplicatePageResult{}, cadence.NewCustomError(ErrReasonInvalidRequest, err.Error())
+	}
+
+	frontendClient, err := bootstrapCtx.clientBean.GetRemoteFrontendClient(request.SourceCluster)
+	if err != nil {
+		return ReplicatePageResult{}, cadence.NewCustomError(ErrReasonInvalidRequest, err.Error())
+	}
+	response, err := frontendClient.ListOpenWorkflowExecutions(
+		ctx,
+		&cs.ListOpenWorkflowExecutionsRequest{
+			Domain:          common.StringPtr(request.DomainName),
+			MaximumPageSize: common.Int32Ptr(int32(request.PageSize)),
+			NextPageToken:   request.NextPageToken,
+			StartTimeFilter: &cs.StartTimeFilter{
+				EarliestTime: common.Int64Ptr(0),
+				LatestTime:   common.Int64Ptr(request.LatestStartTime),
+			},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to list open executions", zap.Error(err))
+		return ReplicatePageResult{}, err
+	}
+
+	// the page is listed again when the activity is retried, skip the executions
+	// already replicated by the previous attempts
+	var progress pageProgress
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			logger.Warn("failed to load heartbeat details, replicating the whole page", zap.Error(err))
+			progress = pageProgress{}
+		}
+	}
+	replicated := make(map[string]struct{}, len(progress.ReplicatedRunIDs))
+	for _, runID := range progress.ReplicatedRunIDs {
+		replicated[runID] = struct{}{}
+	}
+
+	rateLimiter := common.NewTokenBucket(bootstrapCtx.rps(request.RPS), common.NewRealTimeSource())
+	rereplicator := bootstrapCtx.newRereplicator(request.SourceCluster)
+	domainID := domainEntry.GetInfo().ID
+	for _, info := range response.Executions {
+		workflowID := info.Execution.GetWorkflowId()
+		runID := info.Execution.GetRunId()
+		if _, ok := replicated[runID]; ok {
+			continue
+		}
+
+		for !rateLimiter.Consume(1, time.Second) {
+			if ctx.Err() != nil {
+				return ReplicatePageResult{}, ctx.Err()
+			}
+		}
+
+		op := func() error {
+			// the retries of an execution can outlast the heartbeat timeout of the activity
+			activity.RecordHeartbeat(ctx, progress)
+			return rereplicator.SendMultiWorkflowHistory(domainID, workflowID, runID, common.FirstEventID, runID, common.EndEventID)
+		}
+		err := backoff.Retry(op, bootstrapCtx.retryPolicy, func(err error) bool {
+			_, ok := err.(*shared.EntityNotExistsError)
+			return !ok && ctx.Err() == nil
+		})
+		if ctx.Err() != nil {
+			return ReplicatePageResult{}, ctx.Err()
+		}
+		switch err.(type) {
+		case nil:
+			progress.ExecutionsReplicated++
+		case *shared.EntityNotExistsError:
+			// the execution is closed and deleted on the source cluster since it was listed
+			progress.ExecutionsSkipped++
+		default:
+			// the execution is left to be rereplicated lazily by the standby task processors
+			logger.Error("failed to replicate execution",
+				zap.String(logging.TagWorkflowExecutionID, workflowID),
+				zap.String(logging.TagWorkflowRunID, runID),
+				zap.Error(err))
+			progress.ExecutionsFailed++
+		}
+		progress.ReplicatedRunIDs = append(progress.ReplicatedRunIDs, runID)
+		activity.RecordHeartbeat(ctx, progress)
+	}
+
+	return ReplicatePageResult{
+		NextPageToken:        response.NextPageToken,
+		ExecutionsReplicated: progress.ExecutionsReplicated,
+		ExecutionsSkipped:    progress.ExecutionsSkipped,
+		ExecutionsFailed:     progress.ExecutionsFailed,
+	}, nil
+}
+
+func (c *bootstrapContext) validate(domainEntry *cache.DomainCacheEntry, sourceCluster string) error {
+	currentCluster := c.clusterMetadata.GetCurrentClusterName()
+	if !domainEntry.IsGlobalDomain() {
+		return fmt.Errorf("domain %v is not a global domain", domainEntry.GetInfo().Name)
+	}
+	if sourceCluster == currentCluster {
+		return fmt.Errorf("source cluster %v is the current cluster", sourceCluster)
+	}
+
+	var sourceFound, currentFound bool
+	for _, cluster := range domainEntry.GetReplicationConfig().Clusters {
+		sourceFound = sourceFound || cluster.ClusterName == sourceCluster
+		currentFound = currentFound || cluster.ClusterName == currentCluster
+	}
+	if !sourceFound || !currentFound {
+		return fmt.Errorf("domain %v is not replicated between cluster %v and cluster %v",
+			domainEntry.GetInfo().Name, sourceCluster, currentCluster)
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	bc "github.com/uber/cadence/common/bootstrap"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/common/xdc"
+	"go.uber.org/cadence"
+	cs "go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/encoded"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+)
+
+type (
+	bootstrapSuite struct {
+		suite.Suite
+		bootstrapCtx *bootstrapContext
+	}
+
+	bootstrapWorkflowSuite struct {
+		suite.Suite
+		testsuite.WorkflowTestSuite
+		domainCache    *cache.DomainCacheMock
+		clientBean     *client.MockClientBean
+		frontendClient *mocks.FrontendClient
+		rereplicator   *xdc.MockHistoryRereplicator
+		domainInfo     *persistence.DomainInfo
+		env            *testsuite.TestWorkflowEnvironment
+	}
+)
+
+func TestBootstrapSuite(t *testing.T) {
+	s := new(bootstrapSuite)
+	suite.Run(t, s)
+}
+
+func TestBootstrapWorkflowSuite(t *testing.T) {
+	suite.Run(t, new(bootstrapWorkflowSuite))
+}
+
+func (s *bootstrapSuite) SetupTest() {
+	s.bootstrapCtx = &bootstrapContext{
+		clusterMetadata: cluster.GetTestClusterMetadata(true, true),
+		config: &Config{
+			MaxRPS: dynamicconfig.GetIntPropertyFn(50),
+		},
+	}
+}
+
+func (s *bootstrapSuite) TestRPS() {
+	s.Equal(bc.DefaultRPS, s.bootstrapCtx.rps(0))
+	s.Equal(20, s.bootstrapCtx.rps(20))
+	s.Equal(50, s.bootstrapCtx.rps(200))
+}
+
+func (s *bootstrapSuite) TestRPS_Unlimited() {
+	s.bootstrapCtx.config.MaxRPS = dynamicconfig.GetIntPropertyFn(0)
+	s.Equal(bc.DefaultRPS, s.bootstrapCtx.rps(0))
+	s.Equal(200, s.bootstrapCtx.rps(200))
+}
+func (s *bootstrapSuite) TestValidate() {
+	info := &persistence.DomainInfo{ID: "some random domain ID", Name: "some random domain name"}
+	clusters := []*persistence.ClusterReplicationConfig{
+		{ClusterName: cluster.TestCurrentClusterName},
+		{ClusterName: cluster.TestAlternativeClusterName},
+	}
+	entry := cache.NewDomainCacheEntryWithReplicationForTest(info, &persistence.DomainConfig{},
+		&persistence.DomainReplicationConfig{ActiveClusterName: cluster.TestAlternativeClusterName, Clusters: clusters},
+		s.bootstrapCtx.clusterMetadata)
+
+	s.NoError(s.bootstrapCtx.validate(entry, cluster.TestAlternativeClusterName))
+	s.Error(s.bootstrapCtx.validate(entry, cluster.TestCurrentClusterName))
+	s.Error(s.bootstrapCtx.validate(entry, "some random cluster name"))
+
+	localEntry := cache.NewDomainCacheEntryForTest(info, &persistence.DomainConfig{})
+	s.Error(s.bootstrapCtx.validate(localEntry, cluster.TestAlternativeClusterName))
+}
+
+func (s *bootstrapWorkflowSuite) SetupTest() {
+	s.domainCache = &cache.DomainCacheMock{}
+	s.clientBean = &client.MockClientBean{}
+	s.frontendClient = &mocks.FrontendClient{}
+	s.rereplicator = &xdc.MockHistoryRereplicator{}
+	s.domainInfo = &persistence.DomainInfo{ID: "some random domain ID", Name: "some random domain name"}
+
+	clusterMetadata := cluster.GetTestClusterMetadata(true, true)
+	retryPolicy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	retryPolicy.SetMaximumAttempts(3)
+	bootstrapCtx := &bootstrapContext{
+		clusterMetadata: clusterMetadata,
+		domainCache:     s.domainCache,
+		clientBean:      s.clientBean,
+		config: &Config{
+			MaxRPS: dynamicconfig.GetIntPropertyFn(0),
+		},
+		retryPolicy: retryPolicy,
+		newRereplicator: func(sourceCluster string) xdc.HistoryRereplicator {
+			return s.rereplicator
+		},
+	}
+	s.env = s.NewTestWorkflowEnvironment()
+	s.env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), bootstrapContextKey, bootstrapCtx),
+	})
+}
+
+func (s *bootstrapWorkflowSuite) TearDownTest() {
+	s.domainCache.AssertExpectations(s.T())
+	s.clientBean.AssertExpectations(s.T())
+	s.frontendClient.AssertExpectations(s.T())
+	s.rereplicator.AssertExpectations(s.T())
+}
+
+func (s *bootstrapWorkflowSuite) TestWorkflow_ReplicatesAllPages() {
+	s.domainCache.On("GetDomain", s.domainInfo.Name).Return(s.globalDomainEntry(), nil)
+	s.clientBean.On("GetRemoteFrontendClient", cluster.TestAlternativeClusterName).Return(s.frontendClient, nil)
+	s.frontendClient.On("ListOpenWorkflowExecutions", mock.Anything, mock.MatchedBy(func(request *cs.ListOpenWorkflowExecutionsRequest) bool {
+		return request.GetDomain() == s.domainInfo.Name && len(request.NextPageToken) == 0
+	})).Return(&cs.ListOpenWorkflowExecutionsResponse{
+		Executions:    []*cs.WorkflowExecutionInfo{s.executionInfo("replicated"), s.executionInfo("deleted"), s.executionInfo("retried")},
+		NextPageToken: []byte("next page"),
+	}, nil).Once()
+	s.frontendClient.On("ListOpenWorkflowExecutions", mock.Anything, mock.MatchedBy(func(request *cs.ListOpenWorkflowExecutionsRequest) bool {
+		return string(request.NextPageToken) == "next page"
+	})).Return(&cs.ListOpenWorkflowExecutionsResponse{
+		Executions: []*cs.WorkflowExecutionInfo{s.executionInfo("failed")},
+	}, nil).Once()
+
+	s.expectReplication("replicated").Return(nil).Once()
+	// the execution is deleted on the source cluster, it is not retried
+	s.expectReplication("deleted").Return(&shared.EntityNotExistsError{}).Once()
+	// a failed execution is retried
+	s.expectReplication("retried").Return(errors.New("some random error")).Once()
+	s.expectReplication("retried").Return(nil).Once()
+	// until the retries run out
+	s.expectReplication("failed").Return(errors.New("some random error"))
+
+	var results []ReplicatePageResult
+	s.env.SetOnActivityCompletedListener(func(activityInfo *activity.Info, result encoded.Value, err error) {
+		var pageResult ReplicatePageResult
+		if activityInfo.ActivityType.Name == ReplicatePageActivityFnName && result.Get(&pageResult) == nil {
+			results = append(results, pageResult)
+		}
+	})
+	s.env.ExecuteWorkflow(BootstrapWorkflow, bc.Params{
+		DomainName:    s.domainInfo.Name,
+		SourceCluster: cluster.TestAlternativeClusterName,
+		PageSize:      3,
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+	s.Equal([]ReplicatePageResult{
+		{NextPageToken: []byte("next page"), ExecutionsReplicated: 2, ExecutionsSkipped: 1},
+		{ExecutionsFailed: 1},
+	}, results)
+}
+
+func (s *bootstrapWorkflowSuite) TestWorkflow_InvalidRequest() {
+	// the current cluster is not a valid source cluster
+	s.domainCache.On("GetDomain", s.domainInfo.Name).Return(s.globalDomainEntry(), nil)
+
+	s.env.ExecuteWorkflow(BootstrapWorkflow, bc.Params{
+		DomainName:    s.domainInfo.Name,
+		SourceCluster: cluster.TestCurrentClusterName,
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	err, ok := s.env.GetWorkflowError().(*cadence.CustomError)
+	s.True(ok)
+	s.Equal(ErrReasonInvalidRequest, err.Reason())
+}
+
+func (s *bootstrapWorkflowSuite) globalDomainEntry() *cache.DomainCacheEntry {
+	clusters := []*persistence.ClusterReplicationConfig{
+		{ClusterName: cluster.TestCurrentClusterName},
+		{ClusterName: cluster.TestAlternativeClusterName},
+	}
+	return cache.NewDomainCacheEntryWithReplicationForTest(s.domainInfo, &persistence.DomainConfig{},
+		&persistence.DomainReplicationConfig{ActiveClusterName: cluster.TestAlternativeClusterName, Clusters: clusters},
+		cluster.GetTestClusterMetadata(true, true))
+}
+
+func (s *bootstrapWorkflowSuite) executionInfo(runID string) *cs.WorkflowExecutionInfo {
+	return &cs.WorkflowExecutionInfo{
+		Execution: &cs.WorkflowExecution{
+			WorkflowId: common.StringPtr("wid-" + runID),
+			RunId:      common.StringPtr(runID),
+		},
+	}
+}
+
+func (s *bootstrapWorkflowSuite) expectReplication(runID string) *mock.Call {
+	return s.rereplicator.On("SendMultiWorkflowHistory",
+		s.domainInfo.ID, "wid-"+runID, runID, common.FirstEventID, runID, common.EndEventID)
+}
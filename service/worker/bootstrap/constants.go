@@ -0,0 +1,58 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bootstrap
+
+import (
+	"time"
+)
+
+const (
+	// ReplicatePageActivityFnName name of the activity replicating one page of open executions
+	ReplicatePageActivityFnName = "BootstrapReplicatePageActivity"
+	// PagesUntilContinueAsNew is the number of pages a bootstrap workflow processes before continuing as new
+	PagesUntilContinueAsNew = 100
+
+	// BootstrapScope scope for all metrics emitted by bootstrap workflow
+	BootstrapScope = "bootstrap-workflow"
+	// ReplicatedExecutionCount counter of number of executions replicated
+	ReplicatedExecutionCount = "replicated-execution"
+	// SkippedExecutionCount counter of number of executions which no longer exist on the source cluster
+	SkippedExecutionCount = "skipped-execution"
+	// FailedExecutionCount counter of number of executions failed to be replicated
+	FailedExecutionCount = "failed-execution"
+
+	// ErrReasonInvalidRequest is the reason of the non retryable error returned for an invalid bootstrap request
+	ErrReasonInvalidRequest = "cadenceInternal:BootstrapInvalidRequest"
+
+	// replicationRetryInitialInterval is the initial backoff of the retries of a failed execution
+	replicationRetryInitialInterval = time.Second
+	// replicationRetryMaxInterval is the max backoff of the retries of a failed execution
+	replicationRetryMaxInterval = 10 * time.Second
+	// replicationRetryExpirationInterval bounds the retries of a failed execution, it is kept
+	// below the heartbeat timeout of the replicate page activity
+	replicationRetryExpirationInterval = 30 * time.Second
+)
+
+type contextKey int
+
+const (
+	bootstrapContextKey contextKey = iota
+)
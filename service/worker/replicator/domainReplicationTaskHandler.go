@@ -181,6 +181,11 @@ func (domainReplicator *domainReplicatorImpl) handleDomainUpdateReplicationTask(
 	if resp.FailoverVersion < task.GetFailoverVersion() {
 		recordUpdated = true
 		request.ReplicationConfig.ActiveClusterName = task.ReplicationConfig.GetActiveClusterName()
+		// a failover done by another cluster supersedes any graceful failover in progress
+		request.ReplicationConfig.PendingActiveClusterName = ""
+		request.ReplicationConfig.FailoverStartTime = 0
+		request.ReplicationConfig.FailoverExpireTime = 0
+		request.ReplicationConfig.FailoverMarkers = nil
 		request.FailoverVersion = task.GetFailoverVersion()
 		request.FailoverNotificationVersion = notificationVersion
 	}
@@ -0,0 +1,126 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicator
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/uber/cadence/common/messaging"
+)
+
+type (
+	// partitionAckMgr acks / nacks messages to the consumer strictly in offset order per partition,
+	// so that the committed offset of a partition never moves beyond its lowest unprocessed message,
+	// even though messages of a partition are processed out of order by different workers
+	partitionAckMgr struct {
+		sync.Mutex
+		partitions map[int32]*partitionAckLevel
+	}
+
+	partitionAckLevel struct {
+		// messages in the order they are received, which is the offset order within a partition
+		outstanding *list.List
+		elements    map[int64]*list.Element
+	}
+
+	outstandingMessage struct {
+		msg       messaging.Message
+		completed bool
+		acked     bool
+	}
+)
+
+func newPartitionAckMgr() *partitionAckMgr {
+	return &partitionAckMgr{
+		partitions: make(map[int32]*partitionAckLevel),
+	}
+}
+
+// addMessage starts tracking a message, messages of a partition must be added in offset order
+func (m *partitionAckMgr) addMessage(msg messaging.Message) {
+	m.Lock()
+	defer m.Unlock()
+
+	partition, ok := m.partitions[msg.Partition()]
+	if !ok {
+		partition = &partitionAckLevel{
+			outstanding: list.New(),
+			elements:    make(map[int64]*list.Element),
+		}
+		m.partitions[msg.Partition()] = partition
+	}
+	partition.elements[msg.Offset()] = partition.outstanding.PushBack(&outstandingMessage{msg: msg})
+}
+
+// ackMessage marks a message as successfully processed
+func (m *partitionAckMgr) ackMessage(msg messaging.Message) {
+	m.completeMessage(msg, true)
+}
+
+// nackMessage marks a message as failed to be processed
+func (m *partitionAckMgr) nackMessage(msg messaging.Message) {
+	m.completeMessage(msg, false)
+}
+
+func (m *partitionAckMgr) completeMessage(msg messaging.Message, acked bool) {
+	m.Lock()
+	defer m.Unlock()
+
+	partition, ok := m.partitions[msg.Partition()]
+	if !ok {
+		return
+	}
+	element, ok := partition.elements[msg.Offset()]
+	if !ok {
+		return
+	}
+	outstanding := element.Value.(*outstandingMessage)
+	outstanding.completed = true
+	outstanding.acked = acked
+
+	// move the ack level of the partition forward over all the completed messages
+	for front := partition.outstanding.Front(); front != nil; front = partition.outstanding.Front() {
+		outstanding := front.Value.(*outstandingMessage)
+		if !outstanding.completed {
+			break
+		}
+		if outstanding.acked {
+			outstanding.msg.Ack()
+		} else {
+			outstanding.msg.Nack()
+		}
+		partition.outstanding.Remove(front)
+		delete(partition.elements, outstanding.msg.Offset())
+	}
+}
+
+// getLowestUnprocessedOffset returns the offset of the lowest message of the partition not yet processed
+func (m *partitionAckMgr) getLowestUnprocessedOffset(partition int32) (int64, bool) {
+	m.Lock()
+	defer m.Unlock()
+
+	level, ok := m.partitions[partition]
+	if !ok || level.outstanding.Len() == 0 {
+		return 0, false
+	}
+	return level.outstanding.Front().Value.(*outstandingMessage).msg.Offset(), true
+}
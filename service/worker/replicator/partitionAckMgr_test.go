@@ -0,0 +1,115 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/messaging/mocks"
+)
+
+type (
+	partitionAckMgrSuite struct {
+		suite.Suite
+		ackMgr *partitionAckMgr
+	}
+)
+
+func TestPartitionAckMgrSuite(t *testing.T) {
+	s := new(partitionAckMgrSuite)
+	suite.Run(t, s)
+}
+
+func (s *partitionAckMgrSuite) SetupTest() {
+	s.ackMgr = newPartitionAckMgr()
+}
+
+func (s *partitionAckMgrSuite) newMessage(partition int32, offset int64) *mocks.Message {
+	msg := &mocks.Message{}
+	msg.On("Partition").Return(partition)
+	msg.On("Offset").Return(offset)
+	return msg
+}
+
+func (s *partitionAckMgrSuite) TestAckMessage_InOrder() {
+	msg1 := s.newMessage(0, 1)
+	msg2 := s.newMessage(0, 2)
+	msg1.On("Ack").Return(nil).Once()
+	msg2.On("Ack").Return(nil).Once()
+	s.ackMgr.addMessage(msg1)
+	s.ackMgr.addMessage(msg2)
+
+	s.ackMgr.ackMessage(msg1)
+	offset, ok := s.ackMgr.getLowestUnprocessedOffset(0)
+	s.True(ok)
+	s.Equal(int64(2), offset)
+
+	s.ackMgr.ackMessage(msg2)
+	_, ok = s.ackMgr.getLowestUnprocessedOffset(0)
+	s.False(ok)
+	msg1.AssertExpectations(s.T())
+	msg2.AssertExpectations(s.T())
+}
+
+func (s *partitionAckMgrSuite) TestAckMessage_OutOfOrder() {
+	msg1 := s.newMessage(0, 1)
+	msg2 := s.newMessage(0, 2)
+	msg3 := s.newMessage(0, 3)
+	s.ackMgr.addMessage(msg1)
+	s.ackMgr.addMessage(msg2)
+	s.ackMgr.addMessage(msg3)
+
+	// later messages are not acked to the consumer while the lowest one is still being processed
+	s.ackMgr.ackMessage(msg3)
+	s.ackMgr.nackMessage(msg2)
+	msg2.AssertNotCalled(s.T(), "Nack")
+	msg3.AssertNotCalled(s.T(), "Ack")
+	offset, ok := s.ackMgr.getLowestUnprocessedOffset(0)
+	s.True(ok)
+	s.Equal(int64(1), offset)
+
+	msg1.On("Ack").Return(nil).Once()
+	msg2.On("Nack").Return(nil).Once()
+	msg3.On("Ack").Return(nil).Once()
+	s.ackMgr.ackMessage(msg1)
+	_, ok = s.ackMgr.getLowestUnprocessedOffset(0)
+	s.False(ok)
+	msg1.AssertExpectations(s.T())
+	msg2.AssertExpectations(s.T())
+	msg3.AssertExpectations(s.T())
+}
+
+func (s *partitionAckMgrSuite) TestAckMessage_PartitionsAreIndependent() {
+	msg1 := s.newMessage(0, 1)
+	msg2 := s.newMessage(1, 1)
+	msg2.On("Ack").Return(nil).Once()
+	s.ackMgr.addMessage(msg1)
+	s.ackMgr.addMessage(msg2)
+
+	s.ackMgr.ackMessage(msg2)
+	msg2.AssertExpectations(s.T())
+	offset, ok := s.ackMgr.getLowestUnprocessedOffset(0)
+	s.True(ok)
+	s.Equal(int64(1), offset)
+	_, ok = s.ackMgr.getLowestUnprocessedOffset(1)
+	s.False(ok)
+}
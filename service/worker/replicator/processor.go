@@ -22,6 +22,7 @@ package replicator
 
 import (
 	"context"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -38,6 +39,7 @@ import (
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/messaging"
@@ -69,10 +71,19 @@ type (
 		domainReplicator    DomainReplicator
 		historyRereplicator xdc.HistoryRereplicator
 		historyClient       history.Client
+		domainCache         cache.DomainCache
+		replicationControl  xdc.ReplicationControl
 		msgEncoder          codec.BinaryEncoder
+		ackMgr              *partitionAckMgr
 
 		rereplicationLock locks.IDMutex
 	}
+
+	// replicationTaskMessage is a consumed message with its deserialized replication task
+	replicationTaskMessage struct {
+		msg  messaging.Message
+		task *replicator.ReplicationTask
+	}
 )
 
 const (
@@ -85,6 +96,11 @@ const (
 	replicationTaskExpirationInterval   = 10 * time.Second
 
 	rereplicationLockShards = uint32(32)
+
+	workerQueueSize = 100
+
+	// heldTaskRecheckInterval is how often a held replication task checks whether its domain replication is resumed
+	heldTaskRecheckInterval = time.Second
 )
 
 var (
@@ -92,15 +108,13 @@ var (
 	ErrEmptyReplicationTask = &shared.BadRequestError{Message: "empty replication task"}
 	// ErrUnknownReplicationTask is the error to indicate unknown replication task type
 	ErrUnknownReplicationTask = &shared.BadRequestError{Message: "unknown replication task"}
-	// ErrDeserializeReplicationTask is the error to indicate failure to deserialize replication task
-	ErrDeserializeReplicationTask = &shared.BadRequestError{Message: "Failed to deserialize replication task"}
 
 	replicationTaskRetryPolicy = createReplicatorRetryPolicy()
 )
 
 func newReplicationTaskProcessor(currentCluster, sourceCluster, consumer string, client messaging.Client, config *Config,
 	logger bark.Logger, metricsClient metrics.Client, domainReplicator DomainReplicator,
-	historyRereplicator xdc.HistoryRereplicator, historyClient history.Client) *replicationTaskProcessor {
+	historyRereplicator xdc.HistoryRereplicator, historyClient history.Client, domainCache cache.DomainCache) *replicationTaskProcessor {
 
 	retryableHistoryClient := history.NewRetryableClient(historyClient, common.CreateHistoryServiceRetryPolicy(),
 		common.IsWhitelistServiceTransientError)
@@ -117,7 +131,10 @@ func newReplicationTaskProcessor(currentCluster, sourceCluster, consumer string,
 		domainReplicator:    domainReplicator,
 		historyRereplicator: historyRereplicator,
 		historyClient:       retryableHistoryClient,
+		domainCache:         domainCache,
+		replicationControl:  xdc.NewReplicationControl(config.ReplicationDomainPaused, config.ReplicationDomainMaxRPS),
 		msgEncoder:          codec.NewThriftRWEncoder(),
+		ackMgr:              newPartitionAckMgr(),
 		rereplicationLock: locks.NewIDMutex(rereplicationLockShards, func(key interface{}) uint32 {
 			id, ok := key.(definition.WorkflowIdentifier)
 			if !ok {
@@ -173,40 +190,76 @@ func (p *replicationTaskProcessor) Stop() {
 func (p *replicationTaskProcessor) processorPump() {
 	defer p.shutdownWG.Done()
 
+	// each worker owns a queue, messages are dispatched to the queues hashed by workflow so that
+	// the replication tasks of a workflow are applied in order while different workflows proceed in parallel
 	var workerWG sync.WaitGroup
-	for workerID := 0; workerID < p.config.ReplicatorConcurrency(); workerID++ {
+	workerQueues := make([]chan *replicationTaskMessage, p.config.ReplicatorConcurrency())
+	for workerID := range workerQueues {
+		workerQueues[workerID] = make(chan *replicationTaskMessage, workerQueueSize)
 		workerWG.Add(1)
-		go p.messageProcessLoop(&workerWG, workerID)
+		go p.messageProcessLoop(&workerWG, workerID, workerQueues[workerID])
 	}
 
-	select {
-	case <-p.shutdownCh:
-		// Processor is shutting down, close the underlying consumer
-		p.consumer.Stop()
-	}
+	p.dispatchLoop(workerQueues)
 
 	p.logger.Info("Replication task processor pump shutting down.")
+	for _, workerQueue := range workerQueues {
+		close(workerQueue)
+	}
 	if success := common.AwaitWaitGroup(&workerWG, 10*time.Second); !success {
 		p.logger.Warn("Replication task processor timed out on worker shutdown.")
 	}
 }
 
-func (p *replicationTaskProcessor) messageProcessLoop(workerWG *sync.WaitGroup, workerID int) {
-	defer workerWG.Done()
-
+func (p *replicationTaskProcessor) dispatchLoop(workerQueues []chan *replicationTaskMessage) {
 	for {
 		select {
+		case <-p.shutdownCh:
+			// Processor is shutting down, close the underlying consumer
+			p.consumer.Stop()
+			return
 		case msg, ok := <-p.consumer.Messages():
 			if !ok {
-				p.logger.Info("Worker for replication task processor shutting down.")
+				p.logger.Info("Dispatcher for replication task processor shutting down.")
 				return // channel closed
 			}
-			p.processWithRetry(msg, workerID)
+
+			p.ackMgr.addMessage(msg)
+			task, err := p.deserialize(msg.Value())
+			if err != nil {
+				p.updateFailureMetric(metrics.ReplicatorScope, err)
+				p.logger.WithFields(bark.Fields{
+					logging.TagPartitionKey: msg.Partition(),
+					logging.TagOffset:       msg.Offset(),
+					logging.TagErr:          err,
+				}).Error("Failed to deserialize replication task.")
+				// Nack the message to move it to DLQ
+				p.ackMgr.nackMessage(msg)
+				continue
+			}
+
+			workerQueue := workerQueues[getWorkerIndex(task, len(workerQueues))]
+			select {
+			case workerQueue <- &replicationTaskMessage{msg: msg, task: task}:
+			case <-p.shutdownCh:
+				p.consumer.Stop()
+				return
+			}
 		}
 	}
 }
 
-func (p *replicationTaskProcessor) processWithRetry(msg messaging.Message, workerID int) {
+func (p *replicationTaskProcessor) messageProcessLoop(workerWG *sync.WaitGroup, workerID int,
+	workerQueue <-chan *replicationTaskMessage) {
+	defer workerWG.Done()
+
+	for taskMsg := range workerQueue {
+		p.processWithRetry(taskMsg.msg, taskMsg.task, workerID)
+	}
+	p.logger.Info("Worker for replication task processor shutting down.")
+}
+
+func (p *replicationTaskProcessor) processWithRetry(msg messaging.Message, task *replicator.ReplicationTask, workerID int) {
 	var err error
 	logger := p.logger.WithFields(bark.Fields{
 		logging.TagPartitionKey: msg.Partition(),
@@ -214,13 +267,18 @@ func (p *replicationTaskProcessor) processWithRetry(msg messaging.Message, worke
 		logging.TagAttemptStart: time.Now(),
 	})
 
+	if !p.waitUntilReleased(task, logger) {
+		// processor is shutting down, the message is not acked and will be delivered again
+		return
+	}
+
 	forceBuffer := false
 	remainingRetryCount := p.config.ReplicationTaskMaxRetry()
 
 	attempt := 0
 	op := func() error {
 		attempt++
-		logger, err = p.process(msg, logger, forceBuffer)
+		logger, err = p.process(task, logger, forceBuffer)
 		if err != nil && p.isRetryTaskError(err) {
 			// Enable buffering of replication tasks for next attempt
 			forceBuffer = true
@@ -269,8 +327,9 @@ ProcessRetryLoop:
 	}
 
 	if err == nil {
-		// Successfully processed replication task.  Ack message to move the cursor forward.
-		msg.Ack()
+		// Successfully processed replication task.  Ack message to move the cursor forward once all the
+		// preceding messages of the partition are processed.
+		p.ackMgr.ackMessage(msg)
 	} else {
 		// Task still failed after all retries.  This is most probably due to a bug in replication code.
 		// Nack the task to move it to DLQ to not block replication for other workflow executions.
@@ -279,23 +338,53 @@ ProcessRetryLoop:
 			logging.TagAttemptCount: attempt,
 			logging.TagAttemptEnd:   time.Now(),
 		}).Error("Error processing replication task.")
-		msg.Nack()
+		p.ackMgr.nackMessage(msg)
 	}
 }
 
-func (p *replicationTaskProcessor) process(msg messaging.Message, logger bark.Logger, inRetry bool) (bark.Logger, error) {
-	scope := metrics.ReplicatorScope
-	task, err := p.deserialize(msg.Value())
+// waitUntilReleased holds the task while the replication of its domain from the source cluster is paused, and
+// until the domain rate limit allows it to be applied. Returns false if the processor is shut down meanwhile.
+func (p *replicationTaskProcessor) waitUntilReleased(task *replicator.ReplicationTask, logger bark.Logger) bool {
+	domainID := getReplicationTaskDomainID(task)
+	if domainID == "" {
+		return true
+	}
+	domainEntry, err := p.domainCache.GetDomainByID(domainID)
 	if err != nil {
-		p.updateFailureMetric(scope, err)
-		logger.WithFields(bark.Fields{
-			logging.TagErr: err,
-		}).Error("Failed to deserialize replication task.")
+		// let the task processing surface the domain error
+		return true
+	}
+	domainName := domainEntry.GetInfo().Name
 
-		// return BadRequestError so processWithRetry can nack the message
-		return logger, ErrDeserializeReplicationTask
+	held := false
+	for p.replicationControl.IsPaused(domainName, p.sourceCluster) {
+		if !held {
+			held = true
+			p.metricsClient.IncCounter(metrics.ReplicatorScope, metrics.ReplicatorHeld)
+			logger.WithFields(bark.Fields{
+				logging.TagDomainName: domainName,
+			}).Info("Replication of domain is paused, holding replication task.")
+		}
+		select {
+		case <-p.shutdownCh:
+			return false
+		case <-time.After(heldTaskRecheckInterval):
+		}
 	}
 
+	for !p.replicationControl.Consume(domainName, p.sourceCluster, heldTaskRecheckInterval) {
+		select {
+		case <-p.shutdownCh:
+			return false
+		default:
+		}
+	}
+	return true
+}
+
+func (p *replicationTaskProcessor) process(task *replicator.ReplicationTask, logger bark.Logger, inRetry bool) (bark.Logger, error) {
+	var err error
+	scope := metrics.ReplicatorScope
 	if task.TaskType == nil {
 		p.updateFailureMetric(scope, ErrEmptyReplicationTask)
 		logger.WithFields(bark.Fields{
@@ -606,6 +695,38 @@ func (p *replicationTaskProcessor) deserialize(payload []byte) (*replicator.Repl
 	return &task, nil
 }
 
+// getWorkerIndex returns the index of the worker a replication task is dispatched to, all the tasks
+// of a workflow are dispatched to the same worker
+func getWorkerIndex(task *replicator.ReplicationTask, numWorkers int) int {
+	var key string
+	switch task.GetTaskType() {
+	case replicator.ReplicationTaskTypeDomain:
+		key = task.DomainTaskAttributes.GetID()
+	case replicator.ReplicationTaskTypeSyncShardStatus:
+		key = strconv.FormatInt(task.SyncShardStatusTaskAttributes.GetShardId(), 10)
+	case replicator.ReplicationTaskTypeSyncActivity:
+		attr := task.SyncActicvityTaskAttributes
+		key = attr.GetDomainId() + attr.GetWorkflowId()
+	case replicator.ReplicationTaskTypeHistory:
+		attr := task.HistoryTaskAttributes
+		key = attr.GetDomainId() + attr.GetWorkflowId()
+	}
+	return int(farm.Fingerprint32([]byte(key)) % uint32(numWorkers))
+}
+
+// getReplicationTaskDomainID returns the domain ID of the workflow replication tasks, which are subject to the
+// per domain replication controls, and an empty string for the other tasks
+func getReplicationTaskDomainID(task *replicator.ReplicationTask) string {
+	switch task.GetTaskType() {
+	case replicator.ReplicationTaskTypeSyncActivity:
+		return task.SyncActicvityTaskAttributes.GetDomainId()
+	case replicator.ReplicationTaskTypeHistory:
+		return task.HistoryTaskAttributes.GetDomainId()
+	default:
+		return ""
+	}
+}
+
 func createReplicatorRetryPolicy() backoff.RetryPolicy {
 	policy := backoff.NewExponentialRetryPolicy(replicationTaskInitialRetryInterval)
 	policy.SetMaximumInterval(replicationTaskMaxRetryInterval)
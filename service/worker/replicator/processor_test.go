@@ -0,0 +1,65 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	processorSuite struct {
+		suite.Suite
+	}
+)
+
+func TestProcessorSuite(t *testing.T) {
+	s := new(processorSuite)
+	suite.Run(t, s)
+}
+
+func (s *processorSuite) TestGetWorkerIndex() {
+	numWorkers := 16
+	historyTask := &replicator.ReplicationTask{
+		TaskType: replicator.ReplicationTaskTypeHistory.Ptr(),
+		HistoryTaskAttributes: &replicator.HistoryTaskAttributes{
+			DomainId:   common.StringPtr("some random domain ID"),
+			WorkflowId: common.StringPtr("some random workflow ID"),
+			RunId:      common.StringPtr("some random run ID"),
+		},
+	}
+	activityTask := &replicator.ReplicationTask{
+		TaskType: replicator.ReplicationTaskTypeSyncActivity.Ptr(),
+		SyncActicvityTaskAttributes: &replicator.SyncActicvityTaskAttributes{
+			DomainId:   common.StringPtr("some random domain ID"),
+			WorkflowId: common.StringPtr("some random workflow ID"),
+			RunId:      common.StringPtr("some other random run ID"),
+		},
+	}
+
+	index := getWorkerIndex(historyTask, numWorkers)
+	s.True(index >= 0 && index < numWorkers)
+	// all the tasks of a workflow go to the same worker regardless of run and task type
+	s.Equal(index, getWorkerIndex(activityTask, numWorkers))
+}
@@ -23,6 +23,7 @@ package replicator
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	h "github.com/uber/cadence/.gen/go/history"
@@ -46,6 +47,7 @@ import (
 type (
 	// Replicator is the processor for replication tasks
 	Replicator struct {
+		sync.Mutex
 		domainCache       cache.DomainCache
 		clusterMetadata   cluster.Metadata
 		domainReplicator  DomainReplicator
@@ -53,7 +55,7 @@ type (
 		historyClient     history.Client
 		config            *Config
 		client            messaging.Client
-		processors        []*replicationTaskProcessor
+		processors        map[string]*replicationTaskProcessor
 		logger            bark.Logger
 		metricsClient     metrics.Client
 		historySerializer persistence.HistorySerializer
@@ -68,11 +70,16 @@ type (
 		ReplicatorActivityBufferRetryCount dynamicconfig.IntPropertyFn
 		ReplicatorHistoryBufferRetryCount  dynamicconfig.IntPropertyFn
 		ReplicationTaskMaxRetry            dynamicconfig.IntPropertyFn
+		ClusterMetadataRefreshInterval     dynamicconfig.DurationPropertyFn
+		ReplicationDomainPaused            dynamicconfig.BoolPropertyFnWithDomainAndClusterFilters
+		ReplicationDomainMaxRPS            dynamicconfig.IntPropertyFnWithDomainAndClusterFilters
 	}
 )
 
 const (
 	replicationTimeout = 30 * time.Second
+
+	clusterChangeCallbackID = "worker-replicator"
 )
 
 // NewReplicator creates a new replicator for processing replication tasks
@@ -92,6 +99,7 @@ func NewReplicator(clusterMetadata cluster.Metadata, metadataManagerV2 persisten
 		historyClient:     clientBean.GetHistoryClient(),
 		config:            config,
 		client:            client,
+		processors:        make(map[string]*replicationTaskProcessor),
 		logger:            logger,
 		metricsClient:     metricsClient,
 		historySerializer: persistence.NewHistorySerializer(),
@@ -100,58 +108,96 @@ func NewReplicator(clusterMetadata cluster.Metadata, metadataManagerV2 persisten
 
 // Start is called to start replicator
 func (r *Replicator) Start() error {
-	currentClusterName := r.clusterMetadata.GetCurrentClusterName()
-	for cluster := range r.clusterMetadata.GetAllClusterFailoverVersions() {
-		if cluster != currentClusterName {
-			consumerName := getConsumerName(currentClusterName, cluster)
-			adminClient := admin.NewRetryableClient(
-				r.clientBean.GetRemoteAdminClient(cluster),
-				common.CreateAdminServiceRetryPolicy(),
-				common.IsWhitelistServiceTransientError,
-			)
-			historyClient := history.NewRetryableClient(
-				r.historyClient,
-				common.CreateHistoryServiceRetryPolicy(),
-				common.IsWhitelistServiceTransientError,
-			)
-			logger := r.logger.WithFields(bark.Fields{
-				logging.TagWorkflowComponent: logging.TagValueReplicationTaskProcessorComponent,
-				logging.TagSourceCluster:     cluster,
-				logging.TagConsumerName:      consumerName,
-			})
-			historyRereplicator := xdc.NewHistoryRereplicator(
-				currentClusterName,
-				r.domainCache,
-				adminClient,
-				func(ctx context.Context, request *h.ReplicateRawEventsRequest) error {
-					return historyClient.ReplicateRawEvents(ctx, request)
-				},
-				r.historySerializer,
-				replicationTimeout,
-				logger,
-			)
-			r.processors = append(r.processors, newReplicationTaskProcessor(currentClusterName, cluster, consumerName, r.client,
-				r.config, logger, r.metricsClient, r.domainReplicator, historyRereplicator, r.historyClient))
-		}
+	if err := r.updateProcessors(); err != nil {
+		return err
 	}
 
-	for _, processor := range r.processors {
-		if err := processor.Start(); err != nil {
-			return err
+	r.clusterMetadata.RegisterClusterChangeCallback(clusterChangeCallbackID, func() {
+		if err := r.updateProcessors(); err != nil {
+			r.logger.WithField(logging.TagErr, err).Error("Failed to update replication task processors on cluster change.")
 		}
-	}
-
+	})
 	return nil
 }
 
 // Stop is called to stop replicator
 func (r *Replicator) Stop() {
+	r.clusterMetadata.UnregisterClusterChangeCallback(clusterChangeCallbackID)
+
+	r.Lock()
+	defer r.Unlock()
 	for _, processor := range r.processors {
 		processor.Stop()
 	}
 	r.domainCache.Stop()
 }
 
+// updateProcessors starts a processor for each remote cluster which does not have one yet,
+// and stops the processors of remote clusters which are no longer enabled
+func (r *Replicator) updateProcessors() error {
+	r.Lock()
+	defer r.Unlock()
+
+	currentClusterName := r.clusterMetadata.GetCurrentClusterName()
+	clusters := r.clusterMetadata.GetAllClusterFailoverVersions()
+	for cluster, processor := range r.processors {
+		if _, ok := clusters[cluster]; !ok {
+			processor.Stop()
+			delete(r.processors, cluster)
+		}
+	}
+
+	for cluster := range clusters {
+		if _, ok := r.processors[cluster]; ok || cluster == currentClusterName {
+			continue
+		}
+
+		processor := r.newProcessor(currentClusterName, cluster)
+		if err := processor.Start(); err != nil {
+			return err
+		}
+		r.processors[cluster] = processor
+	}
+	return nil
+}
+
+func (r *Replicator) newProcessor(currentClusterName string, cluster string) *replicationTaskProcessor {
+	consumerName := getConsumerName(currentClusterName, cluster)
+	historyClient := history.NewRetryableClient(
+		r.historyClient,
+		common.CreateHistoryServiceRetryPolicy(),
+		common.IsWhitelistServiceTransientError,
+	)
+	logger := r.logger.WithFields(bark.Fields{
+		logging.TagWorkflowComponent: logging.TagValueReplicationTaskProcessorComponent,
+		logging.TagSourceCluster:     cluster,
+		logging.TagConsumerName:      consumerName,
+	})
+	historyRereplicator := xdc.NewHistoryRereplicator(
+		currentClusterName,
+		r.domainCache,
+		func() (admin.Client, error) {
+			adminClient, err := r.clientBean.GetRemoteAdminClient(cluster)
+			if err != nil {
+				return nil, err
+			}
+			return admin.NewRetryableClient(
+				adminClient,
+				common.CreateAdminServiceRetryPolicy(),
+				common.IsWhitelistServiceTransientError,
+			), nil
+		},
+		func(ctx context.Context, request *h.ReplicateRawEventsRequest) error {
+			return historyClient.ReplicateRawEvents(ctx, request)
+		},
+		r.historySerializer,
+		replicationTimeout,
+		logger,
+	)
+	return newReplicationTaskProcessor(currentClusterName, cluster, consumerName, r.client,
+		r.config, logger, r.metricsClient, r.domainReplicator, historyRereplicator, r.historyClient, r.domainCache)
+}
+
 func getConsumerName(currentCluster, remoteCluster string) string {
 	return fmt.Sprintf("%v_consumer_for_%v", currentCluster, remoteCluster)
 }
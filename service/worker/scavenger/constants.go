@@ -0,0 +1,67 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scavenger
+
+import (
+	"time"
+
+	"github.com/uber/cadence/service/worker/sysworkflow"
+)
+
+const (
+	// Domain is the domain the task list scavenger workflow runs in
+	Domain = sysworkflow.Domain
+	// TaskList is the task list the task list scavenger workflow and activities are dispatched on
+	TaskList = "cadsys-tl-scavenger-tl"
+	// WorkflowID is the id of the task list scavenger workflow, there is a single one per cluster
+	WorkflowID = "cadsys-tl-scavenger"
+	// WorkflowFnName name of task list scavenger workflow function
+	WorkflowFnName = "TaskListScavengerWorkflow"
+	// ListDomainsActivityFnName name of the activity listing one page of domains
+	ListDomainsActivityFnName = "TaskListScavengerListDomainsActivity"
+	// ScavengeDomainActivityFnName name of the activity scavenging the task lists of a domain
+	ScavengeDomainActivityFnName = "TaskListScavengerScavengeDomainActivity"
+	// DomainPageSize is the number of domains scavenged by a single run of the workflow
+	DomainPageSize = 100
+	// ScavengeInterval is the time the workflow waits between two passes over all domains
+	ScavengeInterval = 24 * time.Hour
+	// WorkflowStartToCloseTimeout is the time for a single run of the workflow to finish
+	WorkflowStartToCloseTimeout = time.Hour * 24 * 30
+	// DecisionTaskStartToCloseTimeout is the time for decision to finish
+	DecisionTaskStartToCloseTimeout = time.Minute
+
+	// ScavengerScope scope for all metrics emitted by task list scavenger workflow
+	ScavengerScope = "tasklist-scavenger-workflow"
+	// ScannedTaskListCount counter of number of task lists scanned
+	ScannedTaskListCount = "scanned-tasklist"
+	// DeletedTaskCount counter of number of tasks deleted
+	DeletedTaskCount = "deleted-task"
+	// DeletedTaskListCount counter of number of idle task lists deleted
+	DeletedTaskListCount = "deleted-tasklist"
+	// FailedDomainCount counter of number of domains failed to be scavenged
+	FailedDomainCount = "failed-domain"
+)
+
+type contextKey int
+
+const (
+	scavengerContextKey contextKey = iota
+)
@@ -0,0 +1,140 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scavenger
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/client"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+type (
+	// Config for task list scavenger
+	Config struct {
+		// Enabled starts the task list scavenger workflow
+		Enabled dynamicconfig.BoolPropertyFn
+		// MaxRPS caps the rate of persistence and history calls made by the scavenger
+		MaxRPS dynamicconfig.IntPropertyFn
+		// BatchSize is the number of task lists or tasks read per page
+		BatchSize dynamicconfig.IntPropertyFn
+		// IdleRetention is the time after which a task list with no tasks left and no update is deleted
+		IdleRetention dynamicconfig.DurationPropertyFn
+	}
+
+	// Worker is the cadence client worker responsible for running the task list scavenger workflow
+	Worker struct {
+		worker        worker.Worker
+		cadenceClient client.Client
+		logger        bark.Logger
+	}
+
+	scavengerContext struct {
+		metadataMgr   persistence.MetadataManager
+		taskManager   persistence.TaskManager
+		historyClient history.Client
+		config        *Config
+		logger        bark.Logger
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(TaskListScavengerWorkflow, workflow.RegisterOptions{Name: WorkflowFnName})
+	activity.RegisterWithOptions(ListDomainsActivity, activity.RegisterOptions{Name: ListDomainsActivityFnName})
+	activity.RegisterWithOptions(ScavengeDomainActivity, activity.RegisterOptions{Name: ScavengeDomainActivityFnName})
+}
+
+// NewWorker returns a new task list scavenger Worker
+func NewWorker(frontendClient frontend.Client, metadataMgr persistence.MetadataManager, taskManager persistence.TaskManager,
+	historyClient history.Client, config *Config, scope tally.Scope, logger bark.Logger) *Worker {
+
+	zapLogger, _ := zap.NewProduction()
+	logger = logger.WithField(logging.TagWorkflowComponent, logging.TagValueTaskListScavengerComponent)
+	scavengerCtx := &scavengerContext{
+		metadataMgr: metadataMgr,
+		taskManager: taskManager,
+		historyClient: history.NewRetryableClient(
+			historyClient,
+			common.CreateHistoryServiceRetryPolicy(),
+			common.IsWhitelistServiceTransientError,
+		),
+		config: config,
+		logger: logger,
+	}
+	wo := worker.Options{
+		Logger:                    zapLogger,
+		MetricsScope:              scope.SubScope(ScavengerScope),
+		BackgroundActivityContext: context.WithValue(context.Background(), scavengerContextKey, scavengerCtx),
+	}
+	return &Worker{
+		worker:        worker.New(frontendClient, Domain, TaskList, wo),
+		cadenceClient: client.NewClient(frontendClient, Domain, &client.Options{}),
+		logger:        logger,
+	}
+}
+
+// Start the task list scavenger Worker and the scavenger workflow if it is not running yet.
+// The Worker is stopped if it fails to start.
+func (w *Worker) Start() error {
+	if err := w.worker.Start(); err != nil {
+		w.worker.Stop()
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                              WorkflowID,
+		TaskList:                        TaskList,
+		ExecutionStartToCloseTimeout:    WorkflowStartToCloseTimeout,
+		DecisionTaskStartToCloseTimeout: DecisionTaskStartToCloseTimeout,
+		WorkflowIDReusePolicy:           client.WorkflowIDReusePolicyAllowDuplicate,
+	}
+	_, err := w.cadenceClient.StartWorkflow(ctx, workflowOptions, WorkflowFnName, Params{})
+	if _, ok := err.(*shared.WorkflowExecutionAlreadyStartedError); ok {
+		// the scavenger continues as new forever, it only needs to be started once per cluster
+		return nil
+	}
+	if err != nil {
+		w.worker.Stop()
+		return err
+	}
+	w.logger.Info("task list scavenger workflow started")
+	return nil
+}
+
+// Stop the task list scavenger Worker
+func (w *Worker) Stop() {
+	w.worker.Stop()
+}
@@ -0,0 +1,377 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scavenger
+
+import (
+	"context"
+	"math"
+	"time"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/persistence"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+type (
+	// Params is the input of the task list scavenger workflow, it carries the checkpoint of the
+	// current pass over all domains across continue as new
+	Params struct {
+		NextDomainPageToken []byte
+		Progress            ScavengeResult
+	}
+
+	// ListDomainsRequest is the input of the list domains activity
+	ListDomainsRequest struct {
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// ListDomainsResult is the result of the list domains activity
+	ListDomainsResult struct {
+		DomainIDs     []string
+		NextPageToken []byte
+	}
+
+	// ScavengeResult is what the scavenger deleted
+	ScavengeResult struct {
+		DomainsScanned   int
+		DomainsFailed    int
+		TaskListsScanned int
+		TasksDeleted     int
+		TaskListsDeleted int
+	}
+
+	// runState is what the scavenger knows about a workflow run, to tell whether its tasks are still dispatchable
+	runState struct {
+		running           bool
+		pendingActivities map[int64]struct{}
+	}
+
+	// domainProgress is recorded as heartbeat details of the scavenge domain activity, so that
+	// a retried attempt resumes from the last page of task lists
+	domainProgress struct {
+		NextPageToken []byte
+		Result        ScavengeResult
+	}
+)
+
+// TaskListScavengerWorkflow deletes the expired tasks, the tasks of closed executions and the idle task lists
+// of all domains, one page of domains per run, then waits for the next pass
+func TaskListScavengerWorkflow(ctx workflow.Context, params Params) error {
+	logger := workflow.GetLogger(ctx)
+	scope := workflow.GetMetricsScope(ctx)
+
+	ao := workflow.ActivityOptions{
+		ScheduleToStartTimeout: 10 * time.Minute,
+		StartToCloseTimeout:    6 * time.Hour,
+		HeartbeatTimeout:       5 * time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    5 * time.Minute,
+			ExpirationInterval: 12 * time.Hour,
+			MaximumAttempts:    0,
+		},
+	}
+	actCtx := workflow.WithActivityOptions(ctx, ao)
+
+	var domains ListDomainsResult
+	request := ListDomainsRequest{PageSize: DomainPageSize, NextPageToken: params.NextDomainPageToken}
+	if err := workflow.ExecuteActivity(actCtx, ListDomainsActivityFnName, request).Get(ctx, &domains); err != nil {
+		logger.Error("failed to list domains", zap.Error(err))
+		return err
+	}
+
+	for _, domainID := range domains.DomainIDs {
+		var result ScavengeResult
+		if err := workflow.ExecuteActivity(actCtx, ScavengeDomainActivityFnName, domainID).Get(ctx, &result); err != nil {
+			// the domain is scavenged again on the next pass
+			logger.Error("failed to scavenge task lists of domain", zap.String(logging.TagDomainID, domainID), zap.Error(err))
+			params.Progress.DomainsFailed++
+			scope.Counter(FailedDomainCount).Inc(1)
+			continue
+		}
+
+		params.Progress.DomainsScanned++
+		params.Progress.TaskListsScanned += result.TaskListsScanned
+		params.Progress.TasksDeleted += result.TasksDeleted
+		params.Progress.TaskListsDeleted += result.TaskListsDeleted
+		scope.Counter(ScannedTaskListCount).Inc(int64(result.TaskListsScanned))
+		scope.Counter(DeletedTaskCount).Inc(int64(result.TasksDeleted))
+		scope.Counter(DeletedTaskListCount).Inc(int64(result.TaskListsDeleted))
+	}
+
+	params.NextDomainPageToken = domains.NextPageToken
+	if len(params.NextDomainPageToken) == 0 {
+		logger.Info("task list scavenger pass completed",
+			zap.Int("domains-scanned", params.Progress.DomainsScanned),
+			zap.Int("domains-failed", params.Progress.DomainsFailed),
+			zap.Int("tasklists-scanned", params.Progress.TaskListsScanned),
+			zap.Int("tasks-deleted", params.Progress.TasksDeleted),
+			zap.Int("tasklists-deleted", params.Progress.TaskListsDeleted))
+		if err := workflow.Sleep(ctx, ScavengeInterval); err != nil {
+			return err
+		}
+		params = Params{}
+	}
+
+	ctx = workflow.WithExecutionStartToCloseTimeout(ctx, WorkflowStartToCloseTimeout)
+	ctx = workflow.WithWorkflowTaskStartToCloseTimeout(ctx, DecisionTaskStartToCloseTimeout)
+	return workflow.NewContinueAsNewError(ctx, WorkflowFnName, params)
+}
+
+// ListDomainsActivity lists one page of domains
+func ListDomainsActivity(ctx context.Context, request ListDomainsRequest) (ListDomainsResult, error) {
+	scavengerCtx := ctx.Value(scavengerContextKey).(*scavengerContext)
+	response, err := scavengerCtx.metadataMgr.ListDomains(&persistence.ListDomainsRequest{
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return ListDomainsResult{}, err
+	}
+
+	result := ListDomainsResult{NextPageToken: response.NextPageToken}
+	for _, domain := range response.Domains {
+		result.DomainIDs = append(result.DomainIDs, domain.Info.ID)
+	}
+	return result, nil
+}
+
+// ScavengeDomainActivity pages through the task lists of a domain, deletes their expired tasks
+// and the tasks of closed executions, and deletes the task lists which have been idle for long
+func ScavengeDomainActivity(ctx context.Context, domainID string) (ScavengeResult, error) {
+	scavengerCtx := ctx.Value(scavengerContextKey).(*scavengerContext)
+	logger := activity.GetLogger(ctx).With(zap.String(logging.TagDomainID, domainID))
+
+	var progress domainProgress
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			logger.Warn("failed to load heartbeat details, scavenging the domain from the start", zap.Error(err))
+			progress = domainProgress{}
+		}
+	}
+
+	rateLimiter := common.NewTokenBucket(scavengerCtx.config.MaxRPS(), common.NewRealTimeSource())
+	heartbeat := func() { activity.RecordHeartbeat(ctx, progress) }
+	for {
+		if err := wait(ctx, rateLimiter); err != nil {
+			return ScavengeResult{}, err
+		}
+		response, err := scavengerCtx.taskManager.ListTaskLists(&persistence.ListTaskListsRequest{
+			DomainID:      domainID,
+			PageSize:      scavengerCtx.config.BatchSize(),
+			NextPageToken: progress.NextPageToken,
+		})
+		if err != nil {
+			logger.Error("failed to list task lists", zap.Error(err))
+			return ScavengeResult{}, err
+		}
+
+		for _, info := range response.Items {
+			if info.Kind == persistence.TaskListKindSticky {
+				// sticky task lists expire by themselves
+				continue
+			}
+			tasksDeleted, taskListDeleted, err := scavengerCtx.scavengeTaskList(ctx, rateLimiter, info, heartbeat)
+			if err != nil {
+				logger.Error("failed to scavenge task list",
+					zap.String(logging.TagTaskListName, info.Name),
+					zap.Int(logging.TagTaskListType, info.TaskType),
+					zap.Error(err))
+				return ScavengeResult{}, err
+			}
+			progress.Result.TaskListsScanned++
+			progress.Result.TasksDeleted += tasksDeleted
+			if taskListDeleted {
+				progress.Result.TaskListsDeleted++
+			}
+		}
+
+		progress.NextPageToken = response.NextPageToken
+		heartbeat()
+		if len(progress.NextPageToken) == 0 {
+			return progress.Result, nil
+		}
+	}
+}
+
+// scavengeTaskList deletes the tasks of a task list which can no longer be dispatched, that is the tasks
+// past their expiry and the tasks of closed executions or activities, then deletes the task list itself
+// if it has no tasks left and has not been updated for longer than the idle retention
+func (c *scavengerContext) scavengeTaskList(
+	ctx context.Context, rateLimiter common.TokenBucket, info *persistence.TaskListInfo, heartbeat func(),
+) (tasksDeleted int, taskListDeleted bool, err error) {
+
+	now := time.Now()
+	batchSize := c.config.BatchSize()
+	runs := make(map[string]*runState)
+	tasksLeft := false
+	// tasks at or below the ack level are never dispatched again, they are left over by failed completions
+	readLevel := int64(-1)
+	for {
+		if err := wait(ctx, rateLimiter); err != nil {
+			return tasksDeleted, false, err
+		}
+		response, err := c.taskManager.GetTasks(&persistence.GetTasksRequest{
+			DomainID:     info.DomainID,
+			TaskList:     info.Name,
+			TaskType:     info.TaskType,
+			ReadLevel:    readLevel,
+			MaxReadLevel: math.MaxInt64,
+			BatchSize:    batchSize,
+		})
+		if err != nil {
+			return tasksDeleted, false, err
+		}
+
+		for _, task := range response.Tasks {
+			readLevel = task.TaskID
+			remove := task.TaskID <= info.AckLevel || (!task.Expiry.IsZero() && task.Expiry.Before(now))
+			if !remove {
+				closed, err := c.isTaskClosed(ctx, rateLimiter, info, task, runs)
+				if err != nil {
+					return tasksDeleted, false, err
+				}
+				remove = closed
+			}
+			if !remove {
+				tasksLeft = true
+				continue
+			}
+
+			if err := wait(ctx, rateLimiter); err != nil {
+				return tasksDeleted, false, err
+			}
+			if err := c.taskManager.CompleteTask(&persistence.CompleteTaskRequest{
+				TaskList: info,
+				TaskID:   task.TaskID,
+			}); err != nil {
+				return tasksDeleted, false, err
+			}
+			tasksDeleted++
+		}
+		heartbeat()
+
+		if len(response.Tasks) < batchSize {
+			break
+		}
+	}
+
+	if tasksLeft || info.LastUpdated.IsZero() || now.Sub(info.LastUpdated) < c.config.IdleRetention() {
+		return tasksDeleted, false, nil
+	}
+	if err := wait(ctx, rateLimiter); err != nil {
+		return tasksDeleted, false, err
+	}
+	err = c.taskManager.DeleteTaskList(&persistence.DeleteTaskListRequest{
+		DomainID:     info.DomainID,
+		TaskListName: info.Name,
+		TaskListType: info.TaskType,
+		RangeID:      info.RangeID,
+	})
+	if _, ok := err.(*persistence.ConditionFailedError); ok {
+		// the task list was leased by a matching host since it was listed, so it is not idle
+		return tasksDeleted, false, nil
+	}
+	if err != nil {
+		return tasksDeleted, false, err
+	}
+	return tasksDeleted, true, nil
+}
+
+// isTaskClosed returns whether the execution of a task is closed or no longer exists, or for an activity
+// task whether the activity is no longer pending, runs caches the state of a run for its other tasks
+func (c *scavengerContext) isTaskClosed(
+	ctx context.Context,
+	rateLimiter common.TokenBucket,
+	info *persistence.TaskListInfo,
+	task *persistence.TaskInfo,
+	runs map[string]*runState,
+) (bool, error) {
+
+	run, ok := runs[task.RunID]
+	if !ok {
+		var err error
+		if run, err = c.getRunState(ctx, rateLimiter, info.DomainID, task); err != nil {
+			return false, err
+		}
+		runs[task.RunID] = run
+	}
+
+	if !run.running {
+		return true, nil
+	}
+	if info.TaskType == persistence.TaskListTypeActivity {
+		_, pending := run.pendingActivities[task.ScheduleID]
+		return !pending, nil
+	}
+	return false, nil
+}
+
+func (c *scavengerContext) getRunState(
+	ctx context.Context, rateLimiter common.TokenBucket, domainID string, task *persistence.TaskInfo,
+) (*runState, error) {
+
+	if err := wait(ctx, rateLimiter); err != nil {
+		return nil, err
+	}
+	response, err := c.historyClient.DescribeWorkflowExecution(ctx, &h.DescribeWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request: &shared.DescribeWorkflowExecutionRequest{
+			Execution: &shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(task.WorkflowID),
+				RunId:      common.StringPtr(task.RunID),
+			},
+		},
+	})
+	switch err.(type) {
+	case nil:
+	case *shared.EntityNotExistsError:
+		return &runState{}, nil
+	default:
+		return nil, err
+	}
+
+	run := &runState{
+		running:           response.WorkflowExecutionInfo.CloseStatus == nil,
+		pendingActivities: make(map[int64]struct{}),
+	}
+	for _, pendingActivity := range response.PendingActivities {
+		run.pendingActivities[pendingActivity.GetScheduleID()] = struct{}{}
+	}
+	return run, nil
+}
+
+func wait(ctx context.Context, rateLimiter common.TokenBucket) error {
+	for !rateLimiter.Consume(1, time.Second) {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,305 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scavenger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/encoded"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+)
+
+type (
+	scavengerSuite struct {
+		suite.Suite
+		taskManager   *mocks.TaskManager
+		historyClient *mocks.HistoryClient
+		scavengerCtx  *scavengerContext
+		rateLimiter   common.TokenBucket
+	}
+
+	scavengerWorkflowSuite struct {
+		suite.Suite
+		testsuite.WorkflowTestSuite
+		metadataMgr   *mocks.MetadataManager
+		taskManager   *mocks.TaskManager
+		historyClient *mocks.HistoryClient
+		env           *testsuite.TestWorkflowEnvironment
+	}
+)
+
+func TestScavengerSuite(t *testing.T) {
+	s := new(scavengerSuite)
+	suite.Run(t, s)
+}
+
+func TestScavengerWorkflowSuite(t *testing.T) {
+	suite.Run(t, new(scavengerWorkflowSuite))
+}
+
+func (s *scavengerSuite) SetupTest() {
+	s.taskManager = &mocks.TaskManager{}
+	s.historyClient = &mocks.HistoryClient{}
+	s.scavengerCtx = &scavengerContext{
+		taskManager:   s.taskManager,
+		historyClient: s.historyClient,
+		config: &Config{
+			MaxRPS:        dynamicconfig.GetIntPropertyFn(1000),
+			BatchSize:     dynamicconfig.GetIntPropertyFn(10),
+			IdleRetention: dynamicconfig.GetDurationPropertyFn(time.Hour),
+		},
+	}
+	s.rateLimiter = common.NewTokenBucket(1000, common.NewRealTimeSource())
+}
+
+func (s *scavengerSuite) TearDownTest() {
+	s.taskManager.AssertExpectations(s.T())
+	s.historyClient.AssertExpectations(s.T())
+}
+
+func (s *scavengerSuite) TestScavengeTaskList_DeletesUndispatchableTasks() {
+	now := time.Now()
+	info := &persistence.TaskListInfo{
+		DomainID:    "some random domain ID",
+		Name:        "some random task list",
+		TaskType:    persistence.TaskListTypeDecision,
+		RangeID:     5,
+		AckLevel:    10,
+		LastUpdated: now.Add(-2 * time.Hour),
+	}
+	acked := &persistence.TaskInfo{TaskID: 9, WorkflowID: "wid", RunID: "acked-run", CreatedTime: now}
+	expired := &persistence.TaskInfo{TaskID: 11, WorkflowID: "wid", RunID: "expired-run", Expiry: now.Add(-time.Minute)}
+	closed := &persistence.TaskInfo{TaskID: 12, WorkflowID: "wid", RunID: "closed-run", CreatedTime: now}
+	deleted := &persistence.TaskInfo{TaskID: 13, WorkflowID: "wid", RunID: "deleted-run", CreatedTime: now}
+	// an old task without expiry is kept as long as its execution is running
+	running := &persistence.TaskInfo{TaskID: 14, WorkflowID: "wid", RunID: "running-run", CreatedTime: now.Add(-30 * 24 * time.Hour)}
+	s.taskManager.On("GetTasks", mock.Anything).Return(&persistence.GetTasksResponse{
+		Tasks: []*persistence.TaskInfo{acked, expired, closed, deleted, running},
+	}, nil).Once()
+	s.historyClient.On("DescribeWorkflowExecution", mock.Anything, s.describeRequest(info, closed)).
+		Return(s.describeResponse(false), nil).Once()
+	s.historyClient.On("DescribeWorkflowExecution", mock.Anything, s.describeRequest(info, deleted)).
+		Return(nil, &shared.EntityNotExistsError{}).Once()
+	s.historyClient.On("DescribeWorkflowExecution", mock.Anything, s.describeRequest(info, running)).
+		Return(s.describeResponse(true), nil).Once()
+	for _, task := range []*persistence.TaskInfo{acked, expired, closed, deleted} {
+		s.taskManager.On("CompleteTask", &persistence.CompleteTaskRequest{TaskList: info, TaskID: task.TaskID}).Return(nil).Once()
+	}
+
+	tasksDeleted, taskListDeleted, err := s.scavengerCtx.scavengeTaskList(context.Background(), s.rateLimiter, info, func() {})
+	s.NoError(err)
+	s.Equal(4, tasksDeleted)
+	// the task list still has a task of a running execution
+	s.False(taskListDeleted)
+}
+
+func (s *scavengerSuite) TestScavengeTaskList_DeletesTasksOfClosedActivities() {
+	now := time.Now()
+	info := &persistence.TaskListInfo{
+		DomainID: "some random domain ID",
+		Name:     "some random task list",
+		TaskType: persistence.TaskListTypeActivity,
+		RangeID:  5,
+	}
+	completed := &persistence.TaskInfo{TaskID: 1, WorkflowID: "wid", RunID: "rid", ScheduleID: 5, CreatedTime: now}
+	pending := &persistence.TaskInfo{TaskID: 2, WorkflowID: "wid", RunID: "rid", ScheduleID: 7, CreatedTime: now}
+	s.taskManager.On("GetTasks", mock.Anything).Return(&persistence.GetTasksResponse{
+		Tasks: []*persistence.TaskInfo{completed, pending},
+	}, nil).Once()
+	// the run is described once for both of its tasks
+	s.historyClient.On("DescribeWorkflowExecution", mock.Anything, s.describeRequest(info, completed)).
+		Return(s.describeResponse(true, pending.ScheduleID), nil).Once()
+	s.taskManager.On("CompleteTask", &persistence.CompleteTaskRequest{TaskList: info, TaskID: completed.TaskID}).Return(nil).Once()
+
+	tasksDeleted, taskListDeleted, err := s.scavengerCtx.scavengeTaskList(context.Background(), s.rateLimiter, info, func() {})
+	s.NoError(err)
+	s.Equal(1, tasksDeleted)
+	s.False(taskListDeleted)
+}
+
+func (s *scavengerSuite) TestScavengeTaskList_DeletesIdleTaskList() {
+	info := &persistence.TaskListInfo{
+		DomainID:    "some random domain ID",
+		Name:        "some random task list",
+		TaskType:    persistence.TaskListTypeActivity,
+		RangeID:     5,
+		LastUpdated: time.Now().Add(-2 * time.Hour),
+	}
+	s.taskManager.On("GetTasks", mock.Anything).Return(&persistence.GetTasksResponse{}, nil).Once()
+	s.taskManager.On("DeleteTaskList", &persistence.DeleteTaskListRequest{
+		DomainID:     info.DomainID,
+		TaskListName: info.Name,
+		TaskListType: info.TaskType,
+		RangeID:      info.RangeID,
+	}).Return(nil).Once()
+
+	tasksDeleted, taskListDeleted, err := s.scavengerCtx.scavengeTaskList(context.Background(), s.rateLimiter, info, func() {})
+	s.NoError(err)
+	s.Equal(0, tasksDeleted)
+	s.True(taskListDeleted)
+}
+
+func (s *scavengerSuite) TestScavengeTaskList_KeepsLeasedTaskList() {
+	info := &persistence.TaskListInfo{
+		DomainID:    "some random domain ID",
+		Name:        "some random task list",
+		RangeID:     5,
+		LastUpdated: time.Now().Add(-2 * time.Hour),
+	}
+	s.taskManager.On("GetTasks", mock.Anything).Return(&persistence.GetTasksResponse{}, nil).Once()
+	s.taskManager.On("DeleteTaskList", mock.Anything).Return(&persistence.ConditionFailedError{}).Once()
+
+	_, taskListDeleted, err := s.scavengerCtx.scavengeTaskList(context.Background(), s.rateLimiter, info, func() {})
+	s.NoError(err)
+	s.False(taskListDeleted)
+}
+
+func (s *scavengerSuite) TestScavengeTaskList_KeepsRecentlyUpdatedTaskList() {
+	info := &persistence.TaskListInfo{
+		DomainID:    "some random domain ID",
+		Name:        "some random task list",
+		RangeID:     5,
+		LastUpdated: time.Now(),
+	}
+	s.taskManager.On("GetTasks", mock.Anything).Return(&persistence.GetTasksResponse{}, nil).Once()
+
+	_, taskListDeleted, err := s.scavengerCtx.scavengeTaskList(context.Background(), s.rateLimiter, info, func() {})
+	s.NoError(err)
+	s.False(taskListDeleted)
+}
+
+func (s *scavengerSuite) describeRequest(
+	info *persistence.TaskListInfo, task *persistence.TaskInfo,
+) *h.DescribeWorkflowExecutionRequest {
+	return &h.DescribeWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(info.DomainID),
+		Request: &shared.DescribeWorkflowExecutionRequest{
+			Execution: &shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(task.WorkflowID),
+				RunId:      common.StringPtr(task.RunID),
+			},
+		},
+	}
+}
+
+func (s *scavengerSuite) describeResponse(running bool, pendingScheduleIDs ...int64) *shared.DescribeWorkflowExecutionResponse {
+	response := &shared.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &shared.WorkflowExecutionInfo{},
+	}
+	if !running {
+		response.WorkflowExecutionInfo.CloseStatus = shared.WorkflowExecutionCloseStatusCompleted.Ptr()
+	}
+	for _, scheduleID := range pendingScheduleIDs {
+		response.PendingActivities = append(response.PendingActivities, &shared.PendingActivityInfo{
+			ScheduleID: common.Int64Ptr(scheduleID),
+		})
+	}
+	return response
+}
+
+func (s *scavengerWorkflowSuite) SetupTest() {
+	s.metadataMgr = &mocks.MetadataManager{}
+	s.taskManager = &mocks.TaskManager{}
+	s.historyClient = &mocks.HistoryClient{}
+	scavengerCtx := &scavengerContext{
+		metadataMgr:   s.metadataMgr,
+		taskManager:   s.taskManager,
+		historyClient: s.historyClient,
+		config: &Config{
+			MaxRPS:        dynamicconfig.GetIntPropertyFn(1000),
+			BatchSize:     dynamicconfig.GetIntPropertyFn(10),
+			IdleRetention: dynamicconfig.GetDurationPropertyFn(time.Hour),
+		},
+	}
+	s.env = s.NewTestWorkflowEnvironment()
+	s.env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), scavengerContextKey, scavengerCtx),
+	})
+}
+
+func (s *scavengerWorkflowSuite) TearDownTest() {
+	s.metadataMgr.AssertExpectations(s.T())
+	s.taskManager.AssertExpectations(s.T())
+	s.historyClient.AssertExpectations(s.T())
+}
+
+func (s *scavengerWorkflowSuite) TestWorkflow_ScavengesAllDomains() {
+	now := time.Now()
+	s.metadataMgr.On("ListDomains", &persistence.ListDomainsRequest{PageSize: DomainPageSize}).
+		Return(&persistence.ListDomainsResponse{Domains: []*persistence.GetDomainResponse{
+			{Info: &persistence.DomainInfo{ID: "idle-domain"}},
+			{Info: &persistence.DomainInfo{ID: "busy-domain"}},
+		}}, nil).Once()
+
+	idle := &persistence.TaskListInfo{DomainID: "idle-domain", Name: "idle", RangeID: 1, LastUpdated: now.Add(-2 * time.Hour)}
+	s.taskManager.On("ListTaskLists", &persistence.ListTaskListsRequest{DomainID: idle.DomainID, PageSize: 10}).
+		Return(&persistence.ListTaskListsResponse{Items: []*persistence.TaskListInfo{idle}}, nil).Once()
+	s.taskManager.On("GetTasks", mock.MatchedBy(func(request *persistence.GetTasksRequest) bool {
+		return request.DomainID == idle.DomainID
+	})).Return(&persistence.GetTasksResponse{}, nil).Once()
+	s.taskManager.On("DeleteTaskList", &persistence.DeleteTaskListRequest{
+		DomainID:     idle.DomainID,
+		TaskListName: idle.Name,
+		TaskListType: idle.TaskType,
+		RangeID:      idle.RangeID,
+	}).Return(nil).Once()
+
+	busy := &persistence.TaskListInfo{DomainID: "busy-domain", Name: "busy", RangeID: 1, LastUpdated: now.Add(-2 * time.Hour)}
+	task := &persistence.TaskInfo{TaskID: 1, WorkflowID: "wid", RunID: "rid", CreatedTime: now}
+	s.taskManager.On("ListTaskLists", &persistence.ListTaskListsRequest{DomainID: busy.DomainID, PageSize: 10}).
+		Return(&persistence.ListTaskListsResponse{Items: []*persistence.TaskListInfo{busy}}, nil).Once()
+	s.taskManager.On("GetTasks", mock.MatchedBy(func(request *persistence.GetTasksRequest) bool {
+		return request.DomainID == busy.DomainID
+	})).Return(&persistence.GetTasksResponse{Tasks: []*persistence.TaskInfo{task}}, nil).Once()
+	s.historyClient.On("DescribeWorkflowExecution", mock.Anything, mock.Anything).
+		Return(&shared.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: &shared.WorkflowExecutionInfo{}}, nil).Once()
+
+	var results []ScavengeResult
+	s.env.SetOnActivityCompletedListener(func(activityInfo *activity.Info, result encoded.Value, err error) {
+		var scavengeResult ScavengeResult
+		if activityInfo.ActivityType.Name == ScavengeDomainActivityFnName && result.Get(&scavengeResult) == nil {
+			results = append(results, scavengeResult)
+		}
+	})
+	s.env.ExecuteWorkflow(TaskListScavengerWorkflow, Params{})
+
+	s.True(s.env.IsWorkflowCompleted())
+	// the workflow waits for the next pass once all domains are scavenged, then continues as new
+	_, ok := s.env.GetWorkflowError().(*workflow.ContinueAsNewError)
+	s.True(ok)
+	s.Equal([]ScavengeResult{
+		{TaskListsScanned: 1, TaskListsDeleted: 1},
+		{TaskListsScanned: 1},
+	}, results)
+}
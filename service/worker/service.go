@@ -31,12 +31,15 @@ import (
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/metrics"
 	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/service/worker/bootstrap"
 	"github.com/uber/cadence/service/worker/indexer"
 	"github.com/uber/cadence/service/worker/replicator"
+	"github.com/uber/cadence/service/worker/scavenger"
 	"github.com/uber/cadence/service/worker/sysworkflow"
 	"go.uber.org/cadence/.gen/go/shared"
 )
@@ -67,6 +70,8 @@ type (
 		ReplicationCfg *replicator.Config
 		SysWorkflowCfg *sysworkflow.Config
 		IndexerCfg     *indexer.Config
+		BootstrapCfg   *bootstrap.Config
+		ScavengerCfg   *scavenger.Config
 	}
 )
 
@@ -90,6 +95,9 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 			ReplicatorActivityBufferRetryCount: dc.GetIntProperty(dynamicconfig.WorkerReplicatorActivityBufferRetryCount, 8),
 			ReplicatorHistoryBufferRetryCount:  dc.GetIntProperty(dynamicconfig.WorkerReplicatorHistoryBufferRetryCount, 8),
 			ReplicationTaskMaxRetry:            dc.GetIntProperty(dynamicconfig.WorkerReplicationTaskMaxRetry, 50),
+			ReplicationDomainPaused:            dc.GetBoolPropertyFilteredByDomainAndCluster(dynamicconfig.WorkerReplicationDomainPaused, false),
+			ReplicationDomainMaxRPS:            dc.GetIntPropertyFilteredByDomainAndCluster(dynamicconfig.WorkerReplicationDomainMaxRPS, 0),
+			ClusterMetadataRefreshInterval:     dc.GetDurationProperty(dynamicconfig.ClusterMetadataRefreshInterval, time.Minute),
 		},
 		SysWorkflowCfg: &sysworkflow.Config{},
 		IndexerCfg: &indexer.Config{
@@ -99,6 +107,15 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 			ESProcessorBulkSize:      dc.GetIntProperty(dynamicconfig.WorkerESProcessorBulkSize, 2<<24), // 16MB
 			ESProcessorFlushInterval: dc.GetDurationProperty(dynamicconfig.WorkerESProcessorFlushInterval, 10*time.Second),
 		},
+		BootstrapCfg: &bootstrap.Config{
+			MaxRPS: dc.GetIntProperty(dynamicconfig.WorkerBootstrapMaxRPS, 100),
+		},
+		ScavengerCfg: &scavenger.Config{
+			Enabled:       dc.GetBoolProperty(dynamicconfig.WorkerTaskListScavengerEnabled, false),
+			MaxRPS:        dc.GetIntProperty(dynamicconfig.WorkerTaskListScavengerMaxRPS, 100),
+			BatchSize:     dc.GetIntProperty(dynamicconfig.WorkerTaskListScavengerBatchSize, 100),
+			IdleRetention: dc.GetDurationProperty(dynamicconfig.WorkerTaskListScavengerIdleRetention, 3*24*time.Hour),
+		},
 	}
 }
 
@@ -116,6 +133,7 @@ func (s *Service) Start() {
 
 	pConfig := params.PersistenceConfig
 	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.ReplicationCfg.PersistenceMaxQPS())
+	pConfig.SetFaultInjection(dynamicconfig.NewCollection(params.DynamicConfig, log))
 	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, log)
 	s.metadataV2Mgr, err = pFactory.NewMetadataManager(persistencefactory.MetadataV2)
 	if err != nil {
@@ -124,8 +142,12 @@ func (s *Service) Start() {
 	s.domainCache = cache.NewDomainCache(s.metadataV2Mgr, params.ClusterMetadata, s.metricsClient, log)
 	s.domainCache.Start()
 
+	clusterMetadataRefresher := cluster.NewMetadataRefresher(params.ClusterMetadata, s.metadataV2Mgr,
+		s.config.ReplicationCfg.ClusterMetadataRefreshInterval, log)
 	if params.ClusterMetadata.IsGlobalDomainEnabled() {
+		clusterMetadataRefresher.Start()
 		s.startReplicator(params, base, log)
+		s.startBootstrapWorker(params, base, log)
 	}
 
 	if params.ClusterMetadata.IsArchivalEnabled() {
@@ -136,8 +158,13 @@ func (s *Service) Start() {
 		s.startIndexer(params, base, log)
 	}
 
+	if s.config.ScavengerCfg.Enabled() {
+		s.startTaskListScavenger(params, base, pFactory, log)
+	}
+
 	log.Infof("%v started", common.WorkerServiceName)
 	<-s.stopC
+	clusterMetadataRefresher.Stop()
 	base.Stop()
 }
 
@@ -184,6 +211,45 @@ func (s *Service) startSysWorker(base service.Service, log bark.Logger, scope ta
 	}
 }
 
+func (s *Service) startBootstrapWorker(params *service.BootstrapParams, base service.Service, log bark.Logger) {
+
+	frontendClient := frontend.NewRetryableClient(
+		base.GetClientBean().GetFrontendClient(),
+		common.CreateFrontendServiceRetryPolicy(),
+		common.IsWhitelistServiceTransientError,
+	)
+
+	s.waitForFrontendStart(frontendClient, log)
+	bootstrapWorker := bootstrap.NewWorker(frontendClient, params.ClusterMetadata, s.domainCache, base.GetClientBean(),
+		s.config.BootstrapCfg, params.MetricScope, log)
+	if err := bootstrapWorker.Start(); err != nil {
+		bootstrapWorker.Stop()
+		log.Fatalf("failed to start bootstrap worker: %v", err)
+	}
+}
+
+func (s *Service) startTaskListScavenger(params *service.BootstrapParams, base service.Service,
+	pFactory persistencefactory.Factory, log bark.Logger) {
+
+	taskManager, err := pFactory.NewTaskManager()
+	if err != nil {
+		log.Fatalf("failed to create task manager: %v", err)
+	}
+	frontendClient := frontend.NewRetryableClient(
+		base.GetClientBean().GetFrontendClient(),
+		common.CreateFrontendServiceRetryPolicy(),
+		common.IsWhitelistServiceTransientError,
+	)
+
+	s.waitForFrontendStart(frontendClient, log)
+	scavengerWorker := scavenger.NewWorker(frontendClient, s.metadataV2Mgr, taskManager, base.GetClientBean().GetHistoryClient(),
+		s.config.ScavengerCfg, params.MetricScope, log)
+	if err := scavengerWorker.Start(); err != nil {
+		// the scavenger only cleans up task lists, the other workers of the service keep running without it
+		log.Errorf("failed to start task list scavenger: %v", err)
+	}
+}
+
 func (s *Service) waitForFrontendStart(frontendClient frontend.Client, log bark.Logger) {
 	name := sysworkflow.Domain
 	request := &shared.DescribeDomainRequest{
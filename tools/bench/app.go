@@ -0,0 +1,228 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bench
+
+import (
+	"context"
+	"os"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/host/testserver"
+	"github.com/urfave/cli"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/client"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/transport/tchannel"
+)
+
+const (
+	flagAddress       = "address"
+	flagOnebox        = "onebox"
+	flagDomain        = "domain"
+	flagTaskList      = "tasklist"
+	flagRate          = "rate"
+	flagDuration      = "duration"
+	flagConcurrency   = "concurrency"
+	flagActivities    = "activities"
+	flagSignals       = "signals"
+	flagTimers        = "timers"
+	flagTimerDuration = "timer_duration"
+	flagChildren      = "children"
+	flagNoWorker      = "no_worker"
+
+	cadenceClientName      = "cadence-bench"
+	cadenceFrontendService = "cadence-frontend"
+	benchRetentionDays     = 1
+)
+
+// RunTool runs the cadence-bench command line tool with the given command line arguments,
+// args[0] being the name of the program. It returns the error that failed the run or the
+// parsing of the arguments, the caller is expected to report it and exit with a non-zero code
+func RunTool(args []string) error {
+	app := buildCLIOptions()
+	return app.Run(args)
+}
+
+func buildCLIOptions() *cli.App {
+	app := cli.NewApp()
+	app.Name = "cadence-bench"
+	app.Usage = "Load generation tool for benchmarking a cadence cluster"
+	app.Version = "0.0.1"
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:   flagAddress,
+			Value:  "127.0.0.1:7933",
+			Usage:  "host:port for cadence frontend service",
+			EnvVar: "CADENCE_BENCH_ADDRESS",
+		},
+		cli.BoolFlag{
+			Name:  flagOnebox,
+			Usage: "start an in-process onebox backed by in-memory persistence and run against it",
+		},
+		cli.StringFlag{
+			Name:   flagDomain,
+			Value:  "cadence-bench",
+			Usage:  "domain to run the workload in, registered if it does not exist",
+			EnvVar: "CADENCE_BENCH_DOMAIN",
+		},
+		cli.StringFlag{
+			Name:  flagTaskList,
+			Value: "cadence-bench-tasklist",
+			Usage: "task list used by the benchmark workflows",
+		},
+		cli.Float64Flag{
+			Name:  flagRate,
+			Value: 10,
+			Usage: "workflow start rate per second",
+		},
+		cli.DurationFlag{
+			Name:  flagDuration,
+			Value: time.Minute,
+			Usage: "duration during which workflows are started",
+		},
+		cli.IntFlag{
+			Name:  flagConcurrency,
+			Value: 1000,
+			Usage: "max number of outstanding workflows, the start rate is throttled beyond it",
+		},
+		cli.IntFlag{
+			Name:  flagActivities,
+			Value: 1,
+			Usage: "number of activities per workflow",
+		},
+		cli.IntFlag{
+			Name:  flagSignals,
+			Value: 0,
+			Usage: "number of signals per workflow",
+		},
+		cli.IntFlag{
+			Name:  flagTimers,
+			Value: 0,
+			Usage: "number of timers per workflow",
+		},
+		cli.DurationFlag{
+			Name:  flagTimerDuration,
+			Value: time.Second,
+			Usage: "duration of each timer",
+		},
+		cli.IntFlag{
+			Name:  flagChildren,
+			Value: 0,
+			Usage: "number of child workflows per workflow",
+		},
+		cli.BoolFlag{
+			Name:  flagNoWorker,
+			Usage: "do not start the embedded worker, workflows are processed by workers started elsewhere",
+		},
+	}
+	app.Action = func(c *cli.Context) error {
+		return runBench(c)
+	}
+	return app
+}
+
+func runBench(c *cli.Context) error {
+	logger := bark.NewLoggerFromLogrus(log.New())
+	config := runConfig{
+		Domain:      c.String(flagDomain),
+		TaskList:    c.String(flagTaskList),
+		Rate:        c.Float64(flagRate),
+		Duration:    c.Duration(flagDuration),
+		Concurrency: c.Int(flagConcurrency),
+		Workload: workloadConfig{
+			Activities:    c.Int(flagActivities),
+			Signals:       c.Int(flagSignals),
+			Timers:        c.Int(flagTimers),
+			TimerDuration: c.Duration(flagTimerDuration),
+			Children:      c.Int(flagChildren),
+		},
+	}
+	if config.Rate <= 0 || config.Concurrency <= 0 {
+		return cli.NewExitError("rate and concurrency must be positive", 1)
+	}
+
+	address := c.String(flagAddress)
+	if c.Bool(flagOnebox) {
+		server, err := testserver.New(testserver.Options{Domain: config.Domain, Logger: logger})
+		if err != nil {
+			return err
+		}
+		defer server.Stop()
+		address = server.FrontendAddress()
+	}
+
+	service, err := buildServiceClient(address)
+	if err != nil {
+		return err
+	}
+	if err := registerDomain(service, config.Domain); err != nil {
+		return err
+	}
+
+	if !c.Bool(flagNoWorker) {
+		w := worker.New(service, config.Domain, config.TaskList, worker.Options{})
+		if err := w.Start(); err != nil {
+			return err
+		}
+		defer w.Stop()
+	}
+
+	r := newRunner(config, client.NewClient(service, config.Domain, &client.Options{}), logger)
+	r.run().print(os.Stdout)
+	return nil
+}
+
+func buildServiceClient(address string) (workflowserviceclient.Interface, error) {
+	ch, err := tchannel.NewChannelTransport(tchannel.ServiceName(cadenceClientName), tchannel.ListenAddr("127.0.0.1:0"))
+	if err != nil {
+		return nil, err
+	}
+	dispatcher := yarpc.NewDispatcher(yarpc.Config{
+		Name: cadenceClientName,
+		Outbounds: yarpc.Outbounds{
+			cadenceFrontendService: {Unary: ch.NewSingleOutbound(address)},
+		},
+	})
+	if err := dispatcher.Start(); err != nil {
+		return nil, err
+	}
+	return workflowserviceclient.New(dispatcher.ClientConfig(cadenceFrontendService)), nil
+}
+
+func registerDomain(service workflowserviceclient.Interface, domain string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	domainClient := client.NewDomainClient(service, &client.Options{})
+	err := domainClient.Register(ctx, &shared.RegisterDomainRequest{
+		Name:                                   common.StringPtr(domain),
+		WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(benchRetentionDays),
+	})
+	if _, ok := err.(*shared.DomainAlreadyExistsError); ok {
+		return nil
+	}
+	return err
+}
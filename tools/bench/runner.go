@@ -0,0 +1,192 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bench
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/pborman/uuid"
+	"github.com/uber-common/bark"
+	"go.uber.org/cadence/client"
+)
+
+type (
+	// runConfig is the configuration of a single benchmark run
+	runConfig struct {
+		Domain      string
+		TaskList    string
+		Rate        float64
+		Duration    time.Duration
+		Concurrency int
+		Workload    workloadConfig
+	}
+
+	// runner starts benchmark workflows at a fixed rate and records their latencies
+	runner struct {
+		config    runConfig
+		client    client.Client
+		logger    bark.Logger
+		started   int64
+		completed int64
+		failed    int64
+
+		startLatency    *latencyRecorder
+		signalLatency   *latencyRecorder
+		workflowLatency *latencyRecorder
+	}
+
+	// report is the result of a benchmark run
+	report struct {
+		Elapsed         time.Duration
+		Started         int64
+		Completed       int64
+		Failed          int64
+		StartLatency    latencySummary
+		SignalLatency   latencySummary
+		WorkflowLatency latencySummary
+	}
+)
+
+func newRunner(config runConfig, client client.Client, logger bark.Logger) *runner {
+	return &runner{
+		config:          config,
+		client:          client,
+		logger:          logger,
+		startLatency:    newLatencyRecorder(),
+		signalLatency:   newLatencyRecorder(),
+		workflowLatency: newLatencyRecorder(),
+	}
+}
+
+// run starts workflows at the configured rate until the configured duration elapses,
+// and then waits for all outstanding workflows to complete
+func (r *runner) run() *report {
+	interval := time.Duration(float64(time.Second) / r.config.Rate)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	outstanding := make(chan struct{}, r.config.Concurrency)
+	var wg sync.WaitGroup
+	start := time.Now()
+	deadline := start.Add(r.config.Duration)
+	for time.Now().Before(deadline) {
+		<-ticker.C
+		outstanding <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-outstanding
+				wg.Done()
+			}()
+			r.runWorkflow()
+		}()
+	}
+	wg.Wait()
+
+	return &report{
+		Elapsed:         time.Since(start),
+		Started:         atomic.LoadInt64(&r.started),
+		Completed:       atomic.LoadInt64(&r.completed),
+		Failed:          atomic.LoadInt64(&r.failed),
+		StartLatency:    r.startLatency.summary(),
+		SignalLatency:   r.signalLatency.summary(),
+		WorkflowLatency: r.workflowLatency.summary(),
+	}
+}
+
+func (r *runner) runWorkflow() {
+	timeout := workflowTimeout(r.config.Workload)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	options := client.StartWorkflowOptions{
+		ID:                              "bench-" + uuid.New(),
+		TaskList:                        r.config.TaskList,
+		ExecutionStartToCloseTimeout:    timeout,
+		DecisionTaskStartToCloseTimeout: decisionTimeout,
+	}
+	startTime := time.Now()
+	run, err := r.client.ExecuteWorkflow(ctx, options, benchWorkflow, r.config.Workload)
+	if err != nil {
+		r.logger.WithField("error", err).Warn("Failed to start workflow.")
+		atomic.AddInt64(&r.failed, 1)
+		return
+	}
+	r.startLatency.record(time.Since(startTime))
+	atomic.AddInt64(&r.started, 1)
+
+	for i := 0; i < r.config.Workload.Signals; i++ {
+		signalTime := time.Now()
+		if err := r.client.SignalWorkflow(ctx, run.GetID(), run.GetRunID(), benchSignalName, "signal"); err != nil {
+			r.logger.WithField("error", err).Warn("Failed to signal workflow.")
+			atomic.AddInt64(&r.failed, 1)
+			return
+		}
+		r.signalLatency.record(time.Since(signalTime))
+	}
+
+	if err := run.Get(ctx, nil); err != nil {
+		r.logger.WithField("error", err).Warn("Workflow failed.")
+		atomic.AddInt64(&r.failed, 1)
+		return
+	}
+	r.workflowLatency.record(time.Since(startTime))
+	atomic.AddInt64(&r.completed, 1)
+}
+
+// workflowTimeout returns an upper bound on the time a benchmark workflow is expected to take
+func workflowTimeout(workload workloadConfig) time.Duration {
+	timeout := time.Minute
+	timeout += time.Duration(workload.Activities) * activityTimeout
+	timeout += time.Duration(workload.Timers) * workload.TimerDuration
+	if workload.Children > 0 {
+		timeout += childWorkflowTimeout
+	}
+	return timeout
+}
+
+func (rp *report) print(w io.Writer) {
+	throughput := float64(rp.Completed) / rp.Elapsed.Seconds()
+	fmt.Fprintf(w, "Elapsed: %v, started: %v, completed: %v, failed: %v, throughput: %.2f workflows/s\n",
+		rp.Elapsed, rp.Started, rp.Completed, rp.Failed, throughput)
+
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Operation", "Count", "Mean", "P50", "P90", "P99", "Max"})
+	table.SetBorder(false)
+	for _, row := range []struct {
+		name    string
+		summary latencySummary
+	}{
+		{"StartWorkflow", rp.StartLatency},
+		{"SignalWorkflow", rp.SignalLatency},
+		{"Workflow", rp.WorkflowLatency},
+	} {
+		s := row.summary
+		table.Append([]string{row.name, fmt.Sprintf("%v", s.Count), s.Mean.String(), s.P50.String(), s.P90.String(), s.P99.String(), s.Max.String()})
+	}
+	table.Render()
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bench
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	statsSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+	}
+)
+
+func TestStatsSuite(t *testing.T) {
+	suite.Run(t, new(statsSuite))
+}
+
+func (s *statsSuite) SetupTest() {
+	s.Assertions = require.New(s.T()) // Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+}
+
+func (s *statsSuite) TestEmptySummary() {
+	s.Equal(latencySummary{}, newLatencyRecorder().summary())
+}
+
+func (s *statsSuite) TestSummary() {
+	recorder := newLatencyRecorder()
+	for i := 100; i >= 1; i-- {
+		recorder.record(time.Duration(i) * time.Millisecond)
+	}
+
+	summary := recorder.summary()
+	s.Equal(100, summary.Count)
+	s.Equal(50500*time.Microsecond, summary.Mean)
+	s.Equal(50*time.Millisecond, summary.P50)
+	s.Equal(90*time.Millisecond, summary.P90)
+	s.Equal(99*time.Millisecond, summary.P99)
+	s.Equal(100*time.Millisecond, summary.Max)
+}
+
+func (s *statsSuite) TestPercentileSingleSample() {
+	sorted := []time.Duration{time.Second}
+	s.Equal(time.Second, percentile(sorted, 0))
+	s.Equal(time.Second, percentile(sorted, 99))
+	s.Equal(time.Second, percentile(sorted, 100))
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bench
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+)
+
+const (
+	benchSignalName = "bench-signal"
+
+	activityTimeout      = time.Minute
+	childWorkflowTimeout = 5 * time.Minute
+	decisionTimeout      = 10 * time.Second
+)
+
+type (
+	// workloadConfig describes the work done by a single benchmark workflow
+	workloadConfig struct {
+		Activities    int
+		Signals       int
+		Timers        int
+		TimerDuration time.Duration
+		Children      int
+	}
+)
+
+func init() {
+	workflow.Register(benchWorkflow)
+	workflow.Register(benchChildWorkflow)
+	activity.Register(benchActivity)
+}
+
+// benchWorkflow runs the configured number of activities and timers sequentially, waits for the
+// configured number of signals and then fans out to the configured number of child workflows
+func benchWorkflow(ctx workflow.Context, config workloadConfig) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		ScheduleToStartTimeout: activityTimeout,
+		StartToCloseTimeout:    activityTimeout,
+	})
+	for i := 0; i < config.Activities; i++ {
+		if err := workflow.ExecuteActivity(ctx, benchActivity, i).Get(ctx, nil); err != nil {
+			return err
+		}
+	}
+
+	for i := 0; i < config.Timers; i++ {
+		if err := workflow.Sleep(ctx, config.TimerDuration); err != nil {
+			return err
+		}
+	}
+
+	signalCh := workflow.GetSignalChannel(ctx, benchSignalName)
+	for i := 0; i < config.Signals; i++ {
+		var signal string
+		signalCh.Receive(ctx, &signal)
+	}
+
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		ExecutionStartToCloseTimeout: childWorkflowTimeout,
+		TaskStartToCloseTimeout:      decisionTimeout,
+	})
+	var children []workflow.ChildWorkflowFuture
+	for i := 0; i < config.Children; i++ {
+		children = append(children, workflow.ExecuteChildWorkflow(ctx, benchChildWorkflow))
+	}
+	for _, child := range children {
+		if err := child.Get(ctx, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// benchChildWorkflow is a child workflow which runs a single activity
+func benchChildWorkflow(ctx workflow.Context) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		ScheduleToStartTimeout: activityTimeout,
+		StartToCloseTimeout:    activityTimeout,
+	})
+	return workflow.ExecuteActivity(ctx, benchActivity, 0).Get(ctx, nil)
+}
+
+// benchActivity is a no-op activity, the benchmark measures the server and not the worker
+func benchActivity(ctx context.Context, index int) error {
+	return nil
+}
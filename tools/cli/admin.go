@@ -20,7 +20,10 @@
 
 package cli
 
-import "github.com/urfave/cli"
+import (
+	"github.com/uber/cadence/common/bootstrap"
+	"github.com/urfave/cli"
+)
 
 func newAdminWorkflowCommands() []cli.Command {
 	return []cli.Command{
@@ -96,6 +99,29 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminDescribeWorkflow(c)
 			},
 		},
+		{
+			Name:    "conflicts",
+			Aliases: []string{"conf"},
+			Usage:   "List the conflict resolutions which reset the workflow after its history diverged between clusters",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, optional",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 100,
+					Usage: "Result page size",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListConflictResolutions(c)
+			},
+		},
 		{
 			Name:    "delete",
 			Aliases: []string{"del"},
@@ -315,6 +341,11 @@ func newAdminDomainCommands() []cli.Command {
 					Name:  FlagActiveClusterNameWithAlias,
 					Usage: "Active cluster name",
 				},
+				cli.IntFlag{
+					Name: FlagFailoverTimeoutWithAlias,
+					Usage: "Fail over gracefully to the active cluster: stop new workflows and decisions, wait for " +
+						"replication to catch up, and force the failover after this many seconds",
+				},
 				cli.StringFlag{ // use StringFlag instead of buggy StringSliceFlag
 					Name:  FlagClustersWithAlias,
 					Usage: "Clusters",
@@ -349,6 +380,160 @@ func newAdminDomainCommands() []cli.Command {
 				AdminDescribeDomain(c)
 			},
 		},
+		{
+			Name:        "bootstrap",
+			Aliases:     []string{"bs"},
+			Usage:       "Bootstrap the current cluster as a standby of a global domain, run against the new standby cluster",
+			Subcommands: newAdminDomainBootstrapCommands(),
+		},
+	}
+}
+
+func newAdminDomainBootstrapCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "start",
+			Usage: "Start replicating the histories of the open workflows of the domain from the source cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagSourceClusterWithAlias,
+					Usage: "Cluster to replicate the open workflows from",
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Value: bootstrap.DefaultRPS,
+					Usage: "Number of workflows replicated per second",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: bootstrap.DefaultPageSize,
+					Usage: "Number of open workflows listed per page",
+				},
+				cli.BoolFlag{
+					Name:  FlagResume,
+					Usage: "Resume from the checkpoint of the previous bootstrap of the domain",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminStartDomainBootstrap(c)
+			},
+		},
+		{
+			Name:    "status",
+			Aliases: []string{"st"},
+			Usage:   "Show the progress of the bootstrap of the domain",
+			Action: func(c *cli.Context) {
+				AdminDescribeDomainBootstrap(c)
+			},
+		},
+	}
+}
+
+func newAdminKafkaDLQCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the replication tasks in the DLQ summarised per domain and workflow",
+			Flags: append(getDLQFlags(),
+				cli.IntFlag{
+					Name:  FlagPartition,
+					Usage: "Only read this partition of the DLQ topic",
+				},
+				cli.Int64Flag{
+					Name:  FlagStartOffset,
+					Usage: "Starting offset for reading the DLQ topic",
+				},
+				cli.Int64Flag{
+					Name:  FlagEndOffset,
+					Usage: "Ending offset (inclusive) for reading the DLQ topic",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminListDLQ(c)
+			},
+		},
+		{
+			Name:  "show",
+			Usage: "Show a single replication task in the DLQ decoded as JSON",
+			Flags: append(getDLQFlags(),
+				cli.IntFlag{
+					Name:  FlagPartition,
+					Usage: "Partition of the replication task",
+				},
+				cli.Int64Flag{
+					Name:  FlagOffset,
+					Usage: "Offset of the replication task",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminShowDLQTask(c)
+			},
+		},
+		{
+			Name:    "replay",
+			Aliases: []string{"rp"},
+			Usage:   "Re-apply the selected history replication tasks in the DLQ to the current cluster, and report the result per task",
+			Flags: append(getDLQFlags(),
+				cli.StringFlag{
+					Name:  FlagSourceClusterWithAlias,
+					Usage: "Cluster the replication tasks in the DLQ were replicated from",
+				},
+				cli.StringFlag{
+					Name:  FlagDomainID,
+					Usage: "DomainID, if not provided then no filters by DomainID are applied",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID, if not provided then no filters by WorkflowID are applied",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, if not provided then no filters by RunID are applied",
+				},
+				cli.StringFlag{
+					Name:  FlagSkipWorkflowIDs,
+					Usage: "Comma separated WorkflowIDs whose replication tasks are skipped instead of applied",
+				},
+				cli.IntFlag{
+					Name:  FlagPartition,
+					Usage: "Only read this partition of the DLQ topic",
+				},
+				cli.Int64Flag{
+					Name:  FlagStartOffset,
+					Usage: "Starting offset for reading the DLQ topic",
+				},
+				cli.Int64Flag{
+					Name:  FlagEndOffset,
+					Usage: "Ending offset (inclusive) for reading the DLQ topic",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminReplayDLQ(c)
+			},
+		},
+	}
+}
+
+func getDLQFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagCluster,
+			Usage: "Name of the Kafka cluster of the DLQ topic",
+		},
+		cli.StringFlag{
+			Name:  FlagTopic,
+			Usage: "DLQ topic of replication tasks",
+		},
+		cli.StringFlag{
+			Name: FlagHostFile,
+			Usage: "Kafka host config file in format of: " + `
+clusters:
+	localKafka:
+		brokers:
+		- 127.0.0.1
+		- 127.0.0.2`,
+		},
 	}
 }
 
@@ -466,6 +651,11 @@ clusters:
 				AdminMergeDLQ(c)
 			},
 		},
+		{
+			Name:        "dlq",
+			Usage:       "Inspect and replay the replication tasks in the DLQ topic",
+			Subcommands: newAdminKafkaDLQCommands(),
+		},
 		{
 			Name:    "rereplicate",
 			Aliases: []string{"rrp"},
@@ -556,3 +746,189 @@ clusters:
 		},
 	}
 }
+
+func newAdminClusterCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "replication-status",
+			Aliases: []string{"rs"},
+			Usage:   "Describe replication ack levels, pending and held replication tasks and replication controls",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID, describe all shards if not provided",
+				},
+				cli.StringFlag{
+					Name:  FlagDomain,
+					Usage: "DomainName, describe all domains with pending replication tasks if not provided",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintFullyDetailWithAlias,
+					Usage: "Print fully detail in json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeReplicationStatus(c)
+			},
+		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List clusters from both the static config and the metadata store",
+			Action: func(c *cli.Context) {
+				AdminListClusters(c)
+			},
+		},
+		{
+			Name:    "add",
+			Aliases: []string{"a"},
+			Usage:   "Add a remote cluster, which is picked up at runtime by all hosts",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagCluster,
+					Usage: "Name of the cluster",
+				},
+				cli.Int64Flag{
+					Name:  FlagInitialFailoverVersion,
+					Usage: "Initial failover version of the cluster",
+				},
+				cli.StringFlag{
+					Name:  FlagRPCName,
+					Usage: "RPC service name of the cluster frontend",
+				},
+				cli.StringFlag{
+					Name:  FlagRPCAddress,
+					Usage: "RPC address of the cluster frontend",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminAddCluster(c)
+			},
+		},
+		{
+			Name:    "update",
+			Aliases: []string{"u"},
+			Usage:   "Update the frontend address of a cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagCluster,
+					Usage: "Name of the cluster",
+				},
+				cli.StringFlag{
+					Name:  FlagRPCName,
+					Usage: "RPC service name of the cluster frontend",
+				},
+				cli.StringFlag{
+					Name:  FlagRPCAddress,
+					Usage: "RPC address of the cluster frontend",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminUpdateCluster(c)
+			},
+		},
+		{
+			Name:  "enable",
+			Usage: "Enable a remote cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagCluster,
+					Usage: "Name of the cluster",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminSetClusterEnabled(c, true)
+			},
+		},
+		{
+			Name:  "disable",
+			Usage: "Disable a remote cluster, so that it is no longer replicated to or from",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagCluster,
+					Usage: "Name of the cluster",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminSetClusterEnabled(c, false)
+			},
+		},
+		{
+			Name:  "advance-time",
+			Usage: "Move the clock of a test cluster forward, firing the timers which became due",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDuration,
+					Usage: "Duration to advance the clock by, e.g. 1h30m",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminAdvanceTime(c)
+			},
+		},
+	}
+}
+
+func newAdminTaskListCommands() []cli.Command {
+	taskListFlags := []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagTaskListWithAlias,
+			Usage: "TaskList name",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskListTypeWithAlias,
+			Value: "decision",
+			Usage: "Optional TaskList type [decision|activity]",
+		},
+	}
+	return []cli.Command{
+		{
+			Name:    "describe-dispatch-rate",
+			Aliases: []string{"ddr"},
+			Usage:   "Describe the dispatch rate set on the tasklist",
+			Flags:   taskListFlags,
+			Action: func(c *cli.Context) {
+				AdminDescribeTaskListDispatchRate(c)
+			},
+		},
+		{
+			Name:    "set-dispatch-rate",
+			Aliases: []string{"sdr"},
+			Usage:   "Set the dispatch rate of the tasklist, which takes precedence over the rate workers ask for",
+			Flags: append([]cli.Flag{
+				cli.Float64Flag{
+					Name:  FlagDispatchRate,
+					Usage: "Maximum number of tasks dispatched per second across all pollers",
+				},
+			}, taskListFlags...),
+			Action: func(c *cli.Context) {
+				AdminSetTaskListDispatchRate(c)
+			},
+		},
+		{
+			Name:    "clear-dispatch-rate",
+			Aliases: []string{"cdr"},
+			Usage:   "Clear the dispatch rate of the tasklist, so that the rate workers ask for applies again",
+			Flags:   taskListFlags,
+			Action: func(c *cli.Context) {
+				AdminClearTaskListDispatchRate(c)
+			},
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause the tasklist, which keeps accepting tasks but returns empty polls until resumed",
+			Flags: taskListFlags,
+			Action: func(c *cli.Context) {
+				AdminPauseTaskList(c)
+			},
+		},
+		{
+			Name:  "resume",
+			Usage: "Resume dispatching the tasks of a paused tasklist",
+			Flags: taskListFlags,
+			Action: func(c *cli.Context) {
+				AdminResumeTaskList(c)
+			},
+		},
+	}
+}
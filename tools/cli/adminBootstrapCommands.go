@@ -0,0 +1,153 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pborman/uuid"
+	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/bootstrap"
+	"github.com/urfave/cli"
+)
+
+// AdminStartDomainBootstrap starts replicating the open executions of a domain from the source cluster
+func AdminStartDomainBootstrap(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+
+	params := bootstrap.Params{
+		DomainName:    domain,
+		SourceCluster: sourceCluster,
+		RPS:           c.Int(FlagRPS),
+		PageSize:      c.Int(FlagPageSize),
+	}
+	if c.Bool(FlagResume) {
+		checkpoint := queryDomainBootstrap(frontendClient, domain)
+		if checkpoint.Progress.Completed {
+			ErrorAndExit(fmt.Sprintf("Bootstrap of domain %s is already completed.", domain), nil)
+		}
+		if checkpoint.SourceCluster != sourceCluster {
+			ErrorAndExit(fmt.Sprintf("Bootstrap of domain %s was started from cluster %s.", domain, checkpoint.SourceCluster), nil)
+		}
+		params.LatestStartTime = checkpoint.LatestStartTime
+		params.NextPageToken = checkpoint.NextPageToken
+		params.Progress = checkpoint.Progress
+	}
+
+	input, err := json.Marshal(params)
+	if err != nil {
+		ErrorAndExit("Failed to serialize bootstrap input.", err)
+	}
+
+	ctx, cancel := newContext()
+	defer cancel()
+	wid := bootstrap.WorkflowID(domain)
+	resp, err := frontendClient.StartWorkflowExecution(ctx, &shared.StartWorkflowExecutionRequest{
+		RequestId:  common.StringPtr(uuid.New()),
+		Domain:     common.StringPtr(bootstrap.Domain),
+		WorkflowId: common.StringPtr(wid),
+		WorkflowType: &shared.WorkflowType{
+			Name: common.StringPtr(bootstrap.WorkflowFnName),
+		},
+		TaskList: &shared.TaskList{
+			Name: common.StringPtr(bootstrap.TaskList),
+		},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(bootstrap.WorkflowStartToCloseTimeout.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(bootstrap.DecisionTaskStartToCloseTimeout.Seconds())),
+		Identity:                            common.StringPtr(getCliIdentity()),
+		WorkflowIdReusePolicy:               shared.WorkflowIdReusePolicyAllowDuplicate.Ptr(),
+	})
+	if err != nil {
+		if _, ok := err.(*shared.WorkflowExecutionAlreadyStartedError); ok {
+			ErrorAndExit(fmt.Sprintf("Bootstrap of domain %s is already running.", domain), err)
+		}
+		ErrorAndExit("Failed to start bootstrap.", err)
+	}
+	fmt.Printf("Started bootstrap of domain %s from cluster %s, workflow Id: %s, run Id: %s\n",
+		domain, sourceCluster, wid, resp.GetRunId())
+}
+
+// AdminDescribeDomainBootstrap shows the progress of the bootstrap of a domain
+func AdminDescribeDomainBootstrap(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := frontendClient.DescribeWorkflowExecution(ctx, &shared.DescribeWorkflowExecutionRequest{
+		Domain: common.StringPtr(bootstrap.Domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(bootstrap.WorkflowID(domain)),
+		},
+	})
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			ErrorAndExit(fmt.Sprintf("Bootstrap of domain %s was never started.", domain), err)
+		}
+		ErrorAndExit("Describe bootstrap failed.", err)
+	}
+	status := "Running"
+	if resp.WorkflowExecutionInfo.CloseStatus != nil {
+		status = resp.WorkflowExecutionInfo.GetCloseStatus().String()
+	}
+
+	checkpoint := queryDomainBootstrap(frontendClient, domain)
+	fmt.Printf("Domain: %v\nSourceCluster: %v\nStatus: %v\nCompleted: %v\nPagesProcessed: %v\n"+
+		"ExecutionsReplicated: %v\nExecutionsSkipped: %v\nExecutionsFailed: %v\n",
+		checkpoint.DomainName,
+		checkpoint.SourceCluster,
+		status,
+		checkpoint.Progress.Completed,
+		checkpoint.Progress.PagesProcessed,
+		checkpoint.Progress.ExecutionsReplicated,
+		checkpoint.Progress.ExecutionsSkipped,
+		checkpoint.Progress.ExecutionsFailed,
+	)
+}
+
+func queryDomainBootstrap(frontendClient serverFrontend.Interface, domain string) *bootstrap.Params {
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := frontendClient.QueryWorkflow(ctx, &shared.QueryWorkflowRequest{
+		Domain: common.StringPtr(bootstrap.Domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(bootstrap.WorkflowID(domain)),
+		},
+		Query: &shared.WorkflowQuery{
+			QueryType: common.StringPtr(bootstrap.ProgressQueryType),
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Query bootstrap progress failed.", err)
+	}
+
+	var checkpoint bootstrap.Params
+	if err := json.Unmarshal(resp.QueryResult, &checkpoint); err != nil {
+		ErrorAndExit("Failed to deserialize bootstrap progress.", err)
+	}
+	return &checkpoint
+}
@@ -23,10 +23,17 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gocql/gocql"
+	"github.com/olekukonko/tablewriter"
 	"github.com/uber-common/bark"
 	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/codec"
@@ -152,6 +159,58 @@ func AdminDescribeWorkflow(c *cli.Context) {
 	}
 }
 
+// AdminListConflictResolutions lists the conflict resolutions of a workflow, latest first
+func AdminListConflictResolutions(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Resolved Time", "Run ID", "Source Cluster", "Reason", "Reset Event ID",
+		"Discarded Events", "Discarded Versions", "Adopted Events", "Adopted Versions"})
+
+	var nextPageToken []byte
+	for {
+		ctx, cancel := newContext()
+		resp, err := adminClient.ListConflictResolutions(ctx, &admin.ListConflictResolutionsRequest{
+			Domain: common.StringPtr(domain),
+			Execution: &shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(wid),
+				RunId:      common.StringPtr(rid),
+			},
+			MaximumPageSize: common.Int32Ptr(int32(c.Int(FlagPageSize))),
+			NextPageToken:   nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("List conflict resolutions failed", err)
+		}
+
+		for _, audit := range resp.ConflictResolutions {
+			table.Append([]string{
+				convertTime(audit.GetResolvedTimestamp(), false),
+				audit.Execution.GetRunId(),
+				audit.GetSourceCluster(),
+				audit.GetReason(),
+				strconv.FormatInt(audit.GetResetEventId(), 10),
+				fmt.Sprintf("(%v, %v)", audit.GetResetEventId(), audit.GetDiscardedNextEventId()),
+				fmt.Sprintf("[%v, %v]", audit.GetDiscardedStartVersion(), audit.GetDiscardedEndVersion()),
+				fmt.Sprintf("[%v, %v)", audit.GetAdoptedFirstEventId(), audit.GetAdoptedNextEventId()),
+				fmt.Sprintf("[%v, %v]", audit.GetAdoptedStartVersion(), audit.GetAdoptedEndVersion()),
+			})
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		nextPageToken = resp.NextPageToken
+	}
+	table.Render()
+}
+
 // AdminDeleteWorkflow describe a new workflow execution for admin
 func AdminDeleteWorkflow(c *cli.Context) {
 	domainID := getRequiredOption(c, FlagDomainID)
@@ -323,3 +382,233 @@ func AdminDescribeHistoryHost(c *cli.Context) {
 	}
 	prettyPrintJSONObject(resp)
 }
+
+// AdminDescribeReplicationStatus describes replication ack levels, pending and held replication tasks and
+// the replication controls of domains
+func AdminDescribeReplicationStatus(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	req := &replicator.DescribeReplicationStatusRequest{}
+	if c.IsSet(FlagShardID) {
+		req.ShardIDs = []int32{int32(c.Int(FlagShardID))}
+	}
+	if c.IsSet(FlagDomain) {
+		req.Domain = common.StringPtr(c.String(FlagDomain))
+	}
+
+	ctx, cancel := newContext()
+	defer cancel()
+
+	resp, err := adminClient.DescribeReplicationStatus(ctx, req)
+	if err != nil {
+		ErrorAndExit("Describe replication status failed", err)
+	}
+
+	if c.Bool(FlagPrintFullyDetail) {
+		prettyPrintJSONObject(resp)
+		return
+	}
+
+	fmt.Println(colorMagenta("Shards:"))
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Shard", "Max Task ID", "Ack Level", "Task Lag", "Remote Ack Levels", "Source Replication Levels"})
+	for _, shard := range resp.Shards {
+		table.Append([]string{
+			strconv.Itoa(int(shard.GetShardID())),
+			strconv.FormatInt(shard.GetMaxReplicationTaskId(), 10),
+			strconv.FormatInt(shard.GetReplicatorAckLevel(), 10),
+			strconv.FormatInt(shard.GetMaxReplicationTaskId()-shard.GetReplicatorAckLevel(), 10),
+			clusterLevelsToString(shard.RemoteClusterAckLevels),
+			clusterLevelsToString(shard.SourceClusterReplicationLevels),
+		})
+	}
+	table.Render()
+
+	truncated := false
+	for _, shard := range resp.Shards {
+		truncated = truncated || shard.GetPendingTasksTruncated()
+	}
+
+	fmt.Println(colorMagenta("Domains:"))
+	table = tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Domain", "Pending Replication Tasks", "Held Replication Tasks"})
+	for _, domain := range resp.Domains {
+		table.Append([]string{
+			domain.GetDomain(),
+			strconv.FormatInt(domain.GetPendingReplicationTasks(), 10),
+			strconv.FormatInt(domain.GetHeldReplicationTasks(), 10),
+		})
+	}
+	table.Render()
+	if truncated {
+		fmt.Println("Pending replication tasks of some shards are truncated, actual numbers may be larger.")
+	}
+
+	fmt.Println(colorMagenta("Replication Controls:"))
+	table = tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Domain", "Remote Cluster", "Outbound", "Inbound"})
+	for _, domain := range resp.Domains {
+		for _, control := range domain.Controls {
+			table.Append([]string{
+				domain.GetDomain(),
+				control.GetCluster(),
+				replicationControlToString(control.GetOutboundPaused(), control.GetOutboundMaxRPS()),
+				replicationControlToString(control.GetInboundPaused(), control.GetInboundMaxRPS()),
+			})
+		}
+	}
+	table.Render()
+}
+
+func replicationControlToString(paused bool, maxRPS int32) string {
+	if paused {
+		return "paused"
+	}
+	if maxRPS > 0 {
+		return fmt.Sprintf("throttled to %v rps", maxRPS)
+	}
+	return "running"
+}
+
+// AdminAdvanceTime moves the clock of a test cluster forward
+func AdminAdvanceTime(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	duration, err := time.ParseDuration(getRequiredOption(c, FlagDuration))
+	if err != nil {
+		ErrorAndExit("Invalid duration", err)
+	}
+
+	ctx, cancel := newContext()
+	defer cancel()
+
+	resp, err := adminClient.AdvanceTime(ctx, &admin.AdvanceTimeRequest{
+		DurationNanos: common.Int64Ptr(int64(duration)),
+	})
+	if err != nil {
+		ErrorAndExit("Advance time failed", err)
+	}
+	fmt.Printf("Cluster time is now %v\n", time.Unix(0, resp.GetTimestamp()))
+}
+
+// AdminListClusters lists clusters from both the static config and the metadata store
+func AdminListClusters(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	ctx, cancel := newContext()
+	defer cancel()
+
+	resp, err := adminClient.ListClusters(ctx, &admin.ListClustersRequest{})
+	if err != nil {
+		ErrorAndExit("List clusters failed", err)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Cluster", "Initial Failover Version", "RPC Name", "RPC Address", "Enabled"})
+	for _, cluster := range resp.Clusters {
+		table.Append([]string{
+			cluster.GetClusterName(),
+			strconv.FormatInt(cluster.GetInitialFailoverVersion(), 10),
+			cluster.GetRpcName(),
+			cluster.GetRpcAddress(),
+			strconv.FormatBool(cluster.GetEnabled()),
+		})
+	}
+	table.Render()
+}
+
+// AdminAddCluster adds a remote cluster
+func AdminAddCluster(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	clusterName := getRequiredOption(c, FlagCluster)
+	if !c.IsSet(FlagInitialFailoverVersion) {
+		ErrorAndExit(fmt.Sprintf("Option %s is required", FlagInitialFailoverVersion), nil)
+	}
+	req := &admin.AddClusterRequest{
+		ClusterName:            common.StringPtr(clusterName),
+		InitialFailoverVersion: common.Int64Ptr(c.Int64(FlagInitialFailoverVersion)),
+		RpcName:                common.StringPtr(getRequiredOption(c, FlagRPCName)),
+		RpcAddress:             common.StringPtr(getRequiredOption(c, FlagRPCAddress)),
+	}
+
+	ctx, cancel := newContext()
+	defer cancel()
+
+	if err := adminClient.AddCluster(ctx, req); err != nil {
+		ErrorAndExit("Add cluster failed", err)
+	}
+	fmt.Printf("Cluster %s is added.\n", clusterName)
+}
+
+// AdminUpdateCluster updates the frontend address of a cluster
+func AdminUpdateCluster(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	clusterName := getRequiredOption(c, FlagCluster)
+	req := &admin.UpdateClusterRequest{
+		ClusterName: common.StringPtr(clusterName),
+	}
+	if c.IsSet(FlagRPCName) {
+		req.RpcName = common.StringPtr(c.String(FlagRPCName))
+	}
+	if c.IsSet(FlagRPCAddress) {
+		req.RpcAddress = common.StringPtr(c.String(FlagRPCAddress))
+	}
+	if req.RpcName == nil && req.RpcAddress == nil {
+		ErrorAndExit(fmt.Sprintf("Option %s or %s is required", FlagRPCName, FlagRPCAddress), nil)
+	}
+
+	ctx, cancel := newContext()
+	defer cancel()
+
+	if err := adminClient.UpdateCluster(ctx, req); err != nil {
+		ErrorAndExit("Update cluster failed", err)
+	}
+	fmt.Printf("Cluster %s is updated.\n", clusterName)
+}
+
+// AdminSetClusterEnabled enables or disables a remote cluster
+func AdminSetClusterEnabled(c *cli.Context, enabled bool) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	clusterName := getRequiredOption(c, FlagCluster)
+	req := &admin.UpdateClusterRequest{
+		ClusterName: common.StringPtr(clusterName),
+		Enabled:     common.BoolPtr(enabled),
+	}
+
+	ctx, cancel := newContext()
+	defer cancel()
+
+	if err := adminClient.UpdateCluster(ctx, req); err != nil {
+		ErrorAndExit("Update cluster failed", err)
+	}
+	if enabled {
+		fmt.Printf("Cluster %s is enabled.\n", clusterName)
+	} else {
+		fmt.Printf("Cluster %s is disabled.\n", clusterName)
+	}
+}
+
+func clusterLevelsToString(levels map[string]int64) string {
+	var clusters []string
+	for cluster := range levels {
+		clusters = append(clusters, cluster)
+	}
+	sort.Strings(clusters)
+
+	var result []string
+	for _, cluster := range clusters {
+		result = append(result, fmt.Sprintf("%v:%v", cluster, levels[cluster]))
+	}
+	return strings.Join(result, ", ")
+}
@@ -0,0 +1,329 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/olekukonko/tablewriter"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+const (
+	dlqReadTimeout = 10 * time.Second
+
+	dlqTaskResultApplied = "applied"
+	dlqTaskResultSkipped = "skipped"
+	dlqTaskResultFailed  = "failed"
+)
+
+type (
+	// dlqMessageFn is called for every message read from the DLQ, with the error of deserializing the message
+	dlqMessageFn func(partition int32, offset int64, task *replicator.ReplicationTask, err error)
+
+	dlqWorkflowKey struct {
+		domainID   string
+		workflowID string
+	}
+
+	dlqWorkflowSummary struct {
+		taskCount       int
+		taskTypes       map[replicator.ReplicationTaskType]struct{}
+		firstPartition  int32
+		firstOffset     int64
+		minFirstEventID int64
+		maxNextEventID  int64
+	}
+)
+
+// AdminListDLQ lists the replication tasks in the DLQ summarised per domain and workflow
+func AdminListDLQ(c *cli.Context) {
+	summaries := make(map[dlqWorkflowKey]*dlqWorkflowSummary)
+	malformed := 0
+	scanDLQ(c, func(partition int32, offset int64, task *replicator.ReplicationTask, err error) {
+		if err != nil {
+			malformed++
+			return
+		}
+		key := getDLQWorkflowKey(task)
+		summary, ok := summaries[key]
+		if !ok {
+			summary = &dlqWorkflowSummary{
+				taskTypes:       make(map[replicator.ReplicationTaskType]struct{}),
+				firstPartition:  partition,
+				firstOffset:     offset,
+				minFirstEventID: common.EndEventID,
+				maxNextEventID:  common.EmptyEventID,
+			}
+			summaries[key] = summary
+		}
+		summary.taskCount++
+		summary.taskTypes[task.GetTaskType()] = struct{}{}
+		if attr := task.HistoryTaskAttributes; attr != nil {
+			if attr.GetFirstEventId() < summary.minFirstEventID {
+				summary.minFirstEventID = attr.GetFirstEventId()
+			}
+			if attr.GetNextEventId() > summary.maxNextEventID {
+				summary.maxNextEventID = attr.GetNextEventId()
+			}
+		}
+	})
+
+	keys := make([]dlqWorkflowKey, 0, len(summaries))
+	for key := range summaries {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].domainID != keys[j].domainID {
+			return keys[i].domainID < keys[j].domainID
+		}
+		return keys[i].workflowID < keys[j].workflowID
+	})
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Domain ID", "Workflow ID", "Tasks", "Task Types", "Event ID Range", "First Partition:Offset"})
+	for _, key := range keys {
+		summary := summaries[key]
+		var taskTypes []string
+		for taskType := range summary.taskTypes {
+			taskTypes = append(taskTypes, taskType.String())
+		}
+		sort.Strings(taskTypes)
+		eventIDRange := ""
+		if summary.maxNextEventID != common.EmptyEventID {
+			eventIDRange = fmt.Sprintf("[%v, %v)", summary.minFirstEventID, summary.maxNextEventID)
+		}
+		table.Append([]string{
+			key.domainID,
+			key.workflowID,
+			strconv.Itoa(summary.taskCount),
+			strings.Join(taskTypes, ","),
+			eventIDRange,
+			fmt.Sprintf("%v:%v", summary.firstPartition, summary.firstOffset),
+		})
+	}
+	table.Render()
+	if malformed > 0 {
+		fmt.Printf("%v messages could not be deserialized\n", malformed)
+	}
+}
+
+// AdminShowDLQTask shows a single replication task in the DLQ decoded as JSON
+func AdminShowDLQTask(c *cli.Context) {
+	if !c.IsSet(FlagPartition) || !c.IsSet(FlagOffset) {
+		ErrorAndExit(fmt.Sprintf("Options %s and %s are required.", FlagPartition, FlagOffset), nil)
+	}
+	offset := c.Int64(FlagOffset)
+
+	found := false
+	scanDLQWithRange(c, int32(c.Int(FlagPartition)), offset, offset, func(partition int32, offset int64, task *replicator.ReplicationTask, err error) {
+		found = true
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to deserialize message %v:%v.", partition, offset), err)
+		}
+		prettyPrintJSONObject(task)
+	})
+	if !found {
+		ErrorAndExit(fmt.Sprintf("Message %v:%v is not in the DLQ.", c.Int(FlagPartition), offset), nil)
+	}
+}
+
+// AdminReplayDLQ re-applies the selected history replication tasks in the DLQ through the history
+// ReplicateEvents API, and reports the result of every task
+func AdminReplayDLQ(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	domainID := c.String(FlagDomainID)
+	filter := buildFilterFn(c.String(FlagWorkflowID), c.String(FlagRunID))
+	skipWorkflowIDs := make(map[string]struct{})
+	for _, workflowID := range strings.Split(c.String(FlagSkipWorkflowIDs), ",") {
+		if workflowID = strings.TrimSpace(workflowID); len(workflowID) > 0 {
+			skipWorkflowIDs[workflowID] = struct{}{}
+		}
+	}
+
+	results := make(map[string]int)
+	report := func(partition int32, offset int64, task *replicator.ReplicationTask, result string, detail interface{}) {
+		results[result]++
+		key := getDLQWorkflowKey(task)
+		fmt.Printf("Message [%v],[%v] domain: %v, workflow: %v, result: %v %v\n",
+			partition, offset, key.domainID, key.workflowID, result, detail)
+	}
+
+	scanDLQ(c, func(partition int32, offset int64, task *replicator.ReplicationTask, err error) {
+		if err != nil {
+			report(partition, offset, &replicator.ReplicationTask{}, dlqTaskResultFailed, err)
+			return
+		}
+		if len(domainID) != 0 && getDLQWorkflowKey(task).domainID != domainID || !filter(task) {
+			// not selected, not reported
+			return
+		}
+		if task.GetTaskType() != replicator.ReplicationTaskTypeHistory {
+			report(partition, offset, task, dlqTaskResultSkipped, "unsupported task type "+task.GetTaskType().String())
+			return
+		}
+		if _, ok := skipWorkflowIDs[task.HistoryTaskAttributes.GetWorkflowId()]; ok {
+			report(partition, offset, task, dlqTaskResultSkipped, "")
+			return
+		}
+
+		ctx, cancel := newContext()
+		defer cancel()
+		err = adminClient.ApplyReplicationTask(ctx, &admin.ApplyReplicationTaskRequest{
+			SourceCluster:   common.StringPtr(sourceCluster),
+			ReplicationTask: task,
+		})
+		if err != nil {
+			report(partition, offset, task, dlqTaskResultFailed, err)
+			return
+		}
+		report(partition, offset, task, dlqTaskResultApplied, fmt.Sprintf("events [%v, %v)",
+			task.HistoryTaskAttributes.GetFirstEventId(), task.HistoryTaskAttributes.GetNextEventId()))
+	})
+
+	fmt.Printf("Applied: %v, skipped: %v, failed: %v\n",
+		results[dlqTaskResultApplied], results[dlqTaskResultSkipped], results[dlqTaskResultFailed])
+}
+
+func getDLQWorkflowKey(task *replicator.ReplicationTask) dlqWorkflowKey {
+	switch task.GetTaskType() {
+	case replicator.ReplicationTaskTypeHistory:
+		return dlqWorkflowKey{
+			domainID:   task.HistoryTaskAttributes.GetDomainId(),
+			workflowID: task.HistoryTaskAttributes.GetWorkflowId(),
+		}
+	case replicator.ReplicationTaskTypeSyncActivity:
+		return dlqWorkflowKey{
+			domainID:   task.SyncActicvityTaskAttributes.GetDomainId(),
+			workflowID: task.SyncActicvityTaskAttributes.GetWorkflowId(),
+		}
+	case replicator.ReplicationTaskTypeDomain:
+		return dlqWorkflowKey{domainID: task.DomainTaskAttributes.GetID()}
+	default:
+		return dlqWorkflowKey{}
+	}
+}
+
+// scanDLQ reads the DLQ topic of all partitions, or of the requested partition, from the start offset up to
+// the end offset or the high watermark at the time of the call. No offset of any consumer group is committed.
+func scanDLQ(c *cli.Context, fn dlqMessageFn) {
+	partition := int32(-1)
+	if c.IsSet(FlagPartition) {
+		partition = int32(c.Int(FlagPartition))
+	}
+	startOffset := sarama.OffsetOldest
+	if c.IsSet(FlagStartOffset) {
+		startOffset = c.Int64(FlagStartOffset)
+	}
+	endOffset := sarama.OffsetNewest
+	if c.IsSet(FlagEndOffset) {
+		endOffset = c.Int64(FlagEndOffset)
+	}
+	scanDLQWithRange(c, partition, startOffset, endOffset, fn)
+}
+
+// scanDLQWithRange reads the messages of a partition, or of all partitions if partition is negative, with offsets
+// within [startOffset, endOffset]. sarama.OffsetOldest / sarama.OffsetNewest can be used to not bound the range.
+func scanDLQWithRange(c *cli.Context, partition int32, startOffset int64, endOffset int64, fn dlqMessageFn) {
+	hostFile := getRequiredOption(c, FlagHostFile)
+	kafkaCluster := getRequiredOption(c, FlagCluster)
+	topic := getRequiredOption(c, FlagTopic)
+
+	clusterConfig, err := loadKafkaCluster(hostFile, kafkaCluster)
+	if err != nil {
+		ErrorAndExit("Failed to load Kafka brokers.", err)
+	}
+	config, err := clusterConfig.NewSaramaConfig()
+	if err != nil {
+		ErrorAndExit("Failed to load Kafka TLS or SASL config.", err)
+	}
+	config.Consumer.Return.Errors = true
+	client, err := sarama.NewClient(clusterConfig.Brokers, config)
+	if err != nil {
+		ErrorAndExit("Failed to connect to Kafka.", err)
+	}
+	defer client.Close()
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		ErrorAndExit("Failed to create Kafka consumer.", err)
+	}
+	defer consumer.Close()
+
+	partitions := []int32{partition}
+	if partition < 0 {
+		if partitions, err = client.Partitions(topic); err != nil {
+			ErrorAndExit("Failed to get partitions of DLQ topic.", err)
+		}
+	}
+
+	for _, partition := range partitions {
+		oldest, err := client.GetOffset(topic, partition, sarama.OffsetOldest)
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to get oldest offset of partition %v.", partition), err)
+		}
+		// the newest offset is the offset of the next message to be produced
+		newest, err := client.GetOffset(topic, partition, sarama.OffsetNewest)
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to get newest offset of partition %v.", partition), err)
+		}
+		start := oldest
+		if startOffset != sarama.OffsetOldest && startOffset > start {
+			start = startOffset
+		}
+		end := newest
+		if endOffset != sarama.OffsetNewest && endOffset+1 < end {
+			end = endOffset + 1
+		}
+		if start >= end {
+			continue
+		}
+
+		partitionConsumer, err := consumer.ConsumePartition(topic, partition, start)
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to consume partition %v.", partition), err)
+		}
+		for offset := start; offset < end; {
+			select {
+			case msg := <-partitionConsumer.Messages():
+				var task replicator.ReplicationTask
+				fn(partition, msg.Offset, &task, decode(msg.Value, &task))
+				offset = msg.Offset + 1
+			case err := <-partitionConsumer.Errors():
+				ErrorAndExit(fmt.Sprintf("Failed to read partition %v.", partition), err)
+			case <-time.After(dlqReadTimeout):
+				ErrorAndExit(fmt.Sprintf("Timed out reading partition %v at offset %v.", partition, offset), nil)
+			}
+		}
+		partitionConsumer.Close()
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/Shopify/sarama"
+	"github.com/golang/mock/gomock"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/replicator"
+	serverShared "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+)
+
+const (
+	dlqTestCluster = "test-kafka"
+	dlqTestTopic   = "test-dlq"
+)
+
+func (s *cliAppSuite) TestAdminListDLQ() {
+	hostFile, closeBroker := s.newDLQBroker([][]byte{
+		s.encodeDLQTask(dlqHistoryTask("workflow-1", 1, 5)),
+		s.encodeDLQTask(dlqHistoryTask("workflow-1", 5, 8)),
+		// a message that is not a replication task is counted, it does not stop the scan
+		{0x01, 0xff},
+	})
+	defer closeBroker()
+
+	errorCode := s.RunErrorExitCode([]string{"", "admin", "kafka", "dlq", "list",
+		"--host_file", hostFile, "--cluster", dlqTestCluster, "--topic", dlqTestTopic})
+	s.Equal(0, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminReplayDLQ() {
+	hostFile, closeBroker := s.newDLQBroker([][]byte{
+		s.encodeDLQTask(dlqHistoryTask("workflow-1", 1, 5)),
+		s.encodeDLQTask(dlqHistoryTask("workflow-skipped", 1, 5)),
+		s.encodeDLQTask(&replicator.ReplicationTask{
+			TaskType: replicator.ReplicationTaskTypeSyncActivity.Ptr(),
+			SyncActicvityTaskAttributes: &replicator.SyncActicvityTaskAttributes{
+				DomainId:   common.StringPtr("domain-id"),
+				WorkflowId: common.StringPtr("workflow-1"),
+			},
+		}),
+		s.encodeDLQTask(dlqHistoryTask("workflow-2", 1, 5)),
+	})
+	defer closeBroker()
+
+	// only the history tasks not skipped are applied, a failed task does not stop the replay
+	s.serverAdminClient.EXPECT().ApplyReplicationTask(gomock.Any(), gomock.Any()).Do(
+		func(_ interface{}, request *admin.ApplyReplicationTaskRequest) {
+			s.Equal("standby", request.GetSourceCluster())
+			s.Equal("workflow-1", request.ReplicationTask.HistoryTaskAttributes.GetWorkflowId())
+		}).Return(&serverShared.InternalServiceError{Message: "faked error"})
+	s.serverAdminClient.EXPECT().ApplyReplicationTask(gomock.Any(), gomock.Any()).Do(
+		func(_ interface{}, request *admin.ApplyReplicationTaskRequest) {
+			s.Equal("workflow-2", request.ReplicationTask.HistoryTaskAttributes.GetWorkflowId())
+		}).Return(nil)
+
+	errorCode := s.RunErrorExitCode([]string{"", "admin", "kafka", "dlq", "replay",
+		"--host_file", hostFile, "--cluster", dlqTestCluster, "--topic", dlqTestTopic,
+		"--source_cluster", "standby", "--skip_workflow_ids", "workflow-skipped"})
+	s.Equal(0, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminReplayDLQ_Filtered() {
+	hostFile, closeBroker := s.newDLQBroker([][]byte{
+		s.encodeDLQTask(dlqHistoryTask("workflow-1", 1, 5)),
+		s.encodeDLQTask(dlqHistoryTask("workflow-2", 1, 5)),
+	})
+	defer closeBroker()
+
+	s.serverAdminClient.EXPECT().ApplyReplicationTask(gomock.Any(), gomock.Any()).Do(
+		func(_ interface{}, request *admin.ApplyReplicationTaskRequest) {
+			s.Equal("workflow-2", request.ReplicationTask.HistoryTaskAttributes.GetWorkflowId())
+		}).Return(nil)
+
+	errorCode := s.RunErrorExitCode([]string{"", "admin", "kafka", "dlq", "replay",
+		"--host_file", hostFile, "--cluster", dlqTestCluster, "--topic", dlqTestTopic,
+		"--source_cluster", "standby", "--workflow_id", "workflow-2"})
+	s.Equal(0, errorCode)
+}
+
+// newDLQBroker starts a Kafka broker serving the messages from offset 0 of the single partition of the DLQ topic,
+// and returns a host file pointing at it
+func (s *cliAppSuite) newDLQBroker(messages [][]byte) (string, func()) {
+	broker := sarama.NewMockBroker(s.T(), 1)
+	fetchResponse := sarama.NewMockFetchResponse(s.T(), len(messages))
+	for offset, message := range messages {
+		fetchResponse.SetMessage(dlqTestTopic, 0, int64(offset), sarama.ByteEncoder(message))
+	}
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(s.T()).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader(dlqTestTopic, 0, broker.BrokerID()),
+		"OffsetRequest": sarama.NewMockOffsetResponse(s.T()).
+			SetOffset(dlqTestTopic, 0, sarama.OffsetOldest, 0).
+			SetOffset(dlqTestTopic, 0, sarama.OffsetNewest, int64(len(messages))),
+		"FetchRequest": fetchResponse,
+	})
+
+	hostFile, err := ioutil.TempFile("", "dlq-hosts")
+	s.NoError(err)
+	_, err = fmt.Fprintf(hostFile, "clusters:\n  %v:\n    brokers:\n    - %v\n", dlqTestCluster, broker.Addr())
+	s.NoError(err)
+	s.NoError(hostFile.Close())
+
+	return hostFile.Name(), func() {
+		broker.Close()
+		os.Remove(hostFile.Name())
+	}
+}
+
+func (s *cliAppSuite) encodeDLQTask(task *replicator.ReplicationTask) []byte {
+	message, err := codec.NewThriftRWEncoder().Encode(task)
+	s.NoError(err)
+	return message
+}
+
+func dlqHistoryTask(workflowID string, firstEventID, nextEventID int64) *replicator.ReplicationTask {
+	return &replicator.ReplicationTask{
+		TaskType: replicator.ReplicationTaskTypeHistory.Ptr(),
+		HistoryTaskAttributes: &replicator.HistoryTaskAttributes{
+			TargetClusters: []string{"active"},
+			DomainId:       common.StringPtr("domain-id"),
+			WorkflowId:     common.StringPtr(workflowID),
+			RunId:          common.StringPtr("run-id"),
+			FirstEventId:   common.Int64Ptr(firstEventID),
+			NextEventId:    common.Int64Ptr(nextEventID),
+			Version:        common.Int64Ptr(10),
+		},
+	}
+}
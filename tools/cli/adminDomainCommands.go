@@ -156,6 +156,10 @@ func AdminUpdateDomain(c *cli.Context) {
 			Name:                     common.StringPtr(domain),
 			ReplicationConfiguration: replicationConfig,
 		}
+		if c.IsSet(FlagFailoverTimeout) {
+			fmt.Printf("Domain will be failed over gracefully, or forcefully after %v seconds.\n", c.Int(FlagFailoverTimeout))
+			updateRequest.FailoverTimeoutInSeconds = common.Int32Ptr(int32(c.Int(FlagFailoverTimeout)))
+		}
 	} else {
 		req := &shared.DescribeDomainRequest{
 			Name: common.StringPtr(domain),
@@ -306,6 +310,14 @@ func AdminDescribeDomain(c *cli.Context) {
 			fmt.Sprintf("%v", resp.Configuration.GetArchivalRetentionPeriodInDays()),
 			resp.Configuration.GetArchivalBucketOwner())
 	}
+	if resp.FailoverInfo != nil {
+		formatStr = formatStr + "PendingActiveClusterName: %v\nFailoverStartTime: %v\nFailoverExpireTime: %v\nPendingReplicationTasks: %v\n"
+		descValues = append(descValues,
+			resp.FailoverInfo.GetPendingActiveClusterName(),
+			convertTime(resp.FailoverInfo.GetStartTimestamp(), false),
+			convertTime(resp.FailoverInfo.GetExpireTimestamp(), false),
+			resp.FailoverInfo.GetPendingReplicationTasks())
+	}
 	fmt.Printf(formatStr, descValues...)
 }
 
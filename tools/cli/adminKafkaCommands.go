@@ -498,7 +498,7 @@ func newKafkaProducer(c *cli.Context) messaging.Producer {
 	destTopic := getRequiredOption(c, FlagTopic)
 
 	// initialize kafka producer
-	destBrokers, err := loadBrokers(hostFile, destCluster)
+	destClusterConfig, err := loadKafkaCluster(hostFile, destCluster)
 	if err != nil {
 		ErrorAndExit("", err)
 	}
@@ -506,7 +506,10 @@ func newKafkaProducer(c *cli.Context) messaging.Producer {
 	config := sarama.NewConfig()
 	config.Producer.RequiredAcks = sarama.WaitForAll
 	config.Producer.Return.Successes = true
-	sproducer, err := sarama.NewSyncProducer(destBrokers, config)
+	if err := destClusterConfig.ApplyToSaramaConfig(config); err != nil {
+		ErrorAndExit("", err)
+	}
+	sproducer, err := sarama.NewSyncProducer(destClusterConfig.Brokers, config)
 	if err != nil {
 		ErrorAndExit("", err)
 	}
@@ -522,9 +525,12 @@ func AdminPurgeTopic(c *cli.Context) {
 	topic := getRequiredOption(c, FlagTopic)
 	cluster := getRequiredOption(c, FlagCluster)
 	group := getRequiredOption(c, FlagGroup)
-	brokers, err := loadBrokers(hostFile, cluster)
+	clusterConfig, err := loadKafkaCluster(hostFile, cluster)
+	if err != nil {
+		ErrorAndExit("", err)
+	}
 
-	consumer := createConsumerAndWaitForReady(brokers, group, topic)
+	consumer := createConsumerAndWaitForReady(clusterConfig, group, topic)
 
 	highWaterMarks, ok := consumer.HighWaterMarks()[topic]
 	if !ok {
@@ -540,7 +546,7 @@ func AdminPurgeTopic(c *cli.Context) {
 		ErrorAndExit("fail to commit offset", err)
 	}
 
-	consumer = createConsumerAndWaitForReady(brokers, group, topic)
+	consumer = createConsumerAndWaitForReady(clusterConfig, group, topic)
 	msg, ok := <-consumer.Messages()
 	fmt.Printf("current offset sample: %v: %v \n", msg.Partition, msg.Offset)
 }
@@ -580,12 +586,12 @@ func AdminMergeDLQ(c *cli.Context) {
 		startOffset := c.Int64(FlagStartOffset)
 		group := getRequiredOption(c, FlagGroup)
 
-		fromBrokers, err := loadBrokers(hostFile, fromCluster)
+		fromClusterConfig, err := loadKafkaCluster(hostFile, fromCluster)
 		if err != nil {
 			ErrorAndExit("", err)
 		}
 
-		consumer := createConsumerAndWaitForReady(fromBrokers, group, fromTopic)
+		consumer := createConsumerAndWaitForReady(fromClusterConfig, group, fromTopic)
 
 		highWaterMarks, ok := consumer.HighWaterMarks()[fromTopic]
 		if !ok {
@@ -601,7 +607,7 @@ func AdminMergeDLQ(c *cli.Context) {
 			ErrorAndExit("fail to commit offset", err)
 		}
 		// create consumer again to make sure MarkPartitionOffset works
-		consumer = createConsumerAndWaitForReady(fromBrokers, group, fromTopic)
+		consumer = createConsumerAndWaitForReady(fromClusterConfig, group, fromTopic)
 
 		for {
 			select {
@@ -636,13 +642,16 @@ func AdminMergeDLQ(c *cli.Context) {
 	}
 }
 
-func createConsumerAndWaitForReady(brokers []string, group, fromTopic string) *cluster.Consumer {
+func createConsumerAndWaitForReady(clusterConfig *messaging.ClusterConfig, group, fromTopic string) *cluster.Consumer {
 	config := cluster.NewConfig()
 	config.Consumer.Return.Errors = true
 	config.Consumer.Offsets.Initial = sarama.OffsetOldest
 	config.Group.Return.Notifications = true
+	if err := clusterConfig.ApplyToSaramaConfig(&config.Config); err != nil {
+		ErrorAndExit("", err)
+	}
 
-	client, err := cluster.NewClient(brokers, config)
+	client, err := cluster.NewClient(clusterConfig.Brokers, config)
 	if err != nil {
 		ErrorAndExit("", err)
 	}
@@ -694,7 +703,8 @@ func parseReplicationTask(in string) (tasks []*replicator.ReplicationTask, err e
 	return tasks, nil
 }
 
-func loadBrokers(hostFile string, cluster string) (brokers []string, err error) {
+// loadKafkaCluster loads the brokers, TLS and SASL config of a kafka cluster from the host file
+func loadKafkaCluster(hostFile string, cluster string) (*messaging.ClusterConfig, error) {
 	contents, err := ioutil.ReadFile(hostFile)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load kafka cluster info from %v., error: %v", hostFile, err)
@@ -713,7 +723,7 @@ func loadBrokers(hostFile string, cluster string) (brokers []string, err error)
 					brs[i] = b
 				}
 			}
-			return brs, nil
+			return &config, nil
 		}
 	}
 	return nil, fmt.Errorf("failed to load broker for cluster %v", cluster)
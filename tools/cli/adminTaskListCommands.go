@@ -0,0 +1,141 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+// AdminDescribeTaskListDispatchRate describes the dispatch rate set on a tasklist
+func AdminDescribeTaskListDispatchRate(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+	taskListType := getServerTaskListType(c)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	resp, err := adminClient.DescribeTaskListDispatchRate(ctx, &shared.DescribeTaskListDispatchRateRequest{
+		Domain:       common.StringPtr(domain),
+		TaskList:     &shared.TaskList{Name: common.StringPtr(taskList)},
+		TaskListType: taskListType.Ptr(),
+	})
+	if err != nil {
+		ErrorAndExit("Operation DescribeTaskListDispatchRate failed.", err)
+	}
+
+	if resp.DispatchRatePerSecond == nil {
+		fmt.Println("No dispatch rate is set on tasklist " + taskList + ", the rate workers ask for applies.")
+		return
+	}
+	fmt.Printf("Dispatch rate of tasklist %v: %v tasks per second\n", taskList, resp.GetDispatchRatePerSecond())
+}
+
+// AdminSetTaskListDispatchRate sets the dispatch rate of a tasklist
+func AdminSetTaskListDispatchRate(c *cli.Context) {
+	if !c.IsSet(FlagDispatchRate) {
+		ErrorAndExit("Option "+FlagDispatchRate+" is required", nil)
+	}
+	dispatchRate := c.Float64(FlagDispatchRate)
+	if dispatchRate <= 0 {
+		ErrorAndExit("Option "+FlagDispatchRate+" must be positive, use clear-dispatch-rate to clear it", nil)
+	}
+	updateTaskListDispatchRate(c, common.Float64Ptr(dispatchRate))
+	fmt.Println("Dispatch rate is set.")
+}
+
+// AdminClearTaskListDispatchRate clears the dispatch rate of a tasklist
+func AdminClearTaskListDispatchRate(c *cli.Context) {
+	updateTaskListDispatchRate(c, nil)
+	fmt.Println("Dispatch rate is cleared.")
+}
+
+func updateTaskListDispatchRate(c *cli.Context, dispatchRate *float64) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+	taskListType := getServerTaskListType(c)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	err := adminClient.UpdateTaskListDispatchRate(ctx, &shared.UpdateTaskListDispatchRateRequest{
+		Domain:                common.StringPtr(domain),
+		TaskList:              &shared.TaskList{Name: common.StringPtr(taskList)},
+		TaskListType:          taskListType.Ptr(),
+		DispatchRatePerSecond: dispatchRate,
+	})
+	if err != nil {
+		ErrorAndExit("Operation UpdateTaskListDispatchRate failed.", err)
+	}
+}
+
+// AdminPauseTaskList pauses a tasklist
+func AdminPauseTaskList(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+	taskListType := getServerTaskListType(c)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	err := adminClient.PauseTaskList(ctx, &shared.PauseTaskListRequest{
+		Domain:       common.StringPtr(domain),
+		TaskList:     &shared.TaskList{Name: common.StringPtr(taskList)},
+		TaskListType: taskListType.Ptr(),
+	})
+	if err != nil {
+		ErrorAndExit("Operation PauseTaskList failed.", err)
+	}
+	fmt.Println("Tasklist " + taskList + " is paused.")
+}
+
+// AdminResumeTaskList resumes a paused tasklist
+func AdminResumeTaskList(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+	taskListType := getServerTaskListType(c)
+
+	ctx, cancel := newContext()
+	defer cancel()
+	err := adminClient.ResumeTaskList(ctx, &shared.ResumeTaskListRequest{
+		Domain:       common.StringPtr(domain),
+		TaskList:     &shared.TaskList{Name: common.StringPtr(taskList)},
+		TaskListType: taskListType.Ptr(),
+	})
+	if err != nil {
+		ErrorAndExit("Operation ResumeTaskList failed.", err)
+	}
+	fmt.Println("Tasklist " + taskList + " is resumed.")
+}
+
+func getServerTaskListType(c *cli.Context) shared.TaskListType {
+	// strToTaskListType returns the client type, which shares the values of the server type
+	return shared.TaskListType(strToTaskListType(c.String(FlagTaskListType)))
+}
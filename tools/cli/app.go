@@ -95,6 +95,18 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on domain",
 					Subcommands: newAdminDomainCommands(),
 				},
+				{
+					Name:        "cluster",
+					Aliases:     []string{"cl"},
+					Usage:       "Run admin operation on cluster",
+					Subcommands: newAdminClusterCommands(),
+				},
+				{
+					Name:        "tasklist",
+					Aliases:     []string{"tl"},
+					Usage:       "Run admin operation on tasklist",
+					Subcommands: newAdminTaskListCommands(),
+				},
 			},
 		},
 	}
@@ -47,6 +47,7 @@ type cliAppSuite struct {
 	app                  *cli.App
 	mockCtrl             *gomock.Controller
 	clientFrontendClient *clientFrontendTest.MockClient
+	serverFrontendClient *serverFrontendTest.MockClient
 	serverAdminClient    *serverAdminTest.MockClient
 }
 
@@ -92,10 +93,11 @@ func (s *cliAppSuite) SetupTest() {
 	s.mockCtrl = gomock.NewController(s.T())
 
 	s.clientFrontendClient = clientFrontendTest.NewMockClient(s.mockCtrl)
+	s.serverFrontendClient = serverFrontendTest.NewMockClient(s.mockCtrl)
 	s.serverAdminClient = serverAdminTest.NewMockClient(s.mockCtrl)
 	SetFactory(&clientFactoryMock{
 		clientFrontendClient: s.clientFrontendClient,
-		serverFrontendClient: serverFrontendTest.NewMockClient(s.mockCtrl),
+		serverFrontendClient: s.serverFrontendClient,
 		serverAdminClient:    s.serverAdminClient,
 	})
 }
@@ -466,11 +468,14 @@ func (s *cliAppSuite) TestListWorkflow_Open_WithWorkflowType() {
 	s.Nil(err)
 }
 
-var describeTaskListResponse = &shared.DescribeTaskListResponse{
-	Pollers: []*shared.PollerInfo{
+var describeTaskListResponse = &serverShared.DescribeTaskListResponse{
+	Pollers: []*serverShared.PollerInfo{
 		{
 			LastAccessTime: common.Int64Ptr(time.Now().UnixNano()),
 			Identity:       common.StringPtr("tester"),
+			BinaryChecksum: common.StringPtr("checksum"),
+			SdkVersion:     common.StringPtr("1.0.0"),
+			Hostname:       common.StringPtr("worker-host"),
 		},
 	},
 }
@@ -495,18 +500,29 @@ func (s *cliAppSuite) TestAdminDescribeWorkflow_Failed() {
 
 func (s *cliAppSuite) TestDescribeTaskList() {
 	resp := describeTaskListResponse
-	s.clientFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
+	s.serverFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(resp, nil)
 	err := s.app.Run([]string{"", "--do", domainName, "tasklist", "describe", "-tl", "test-taskList"})
 	s.Nil(err)
 }
 
 func (s *cliAppSuite) TestDescribeTaskList_Activity() {
 	resp := describeTaskListResponse
-	s.clientFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
+	s.serverFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Do(
+		func(_ interface{}, request *serverShared.DescribeTaskListRequest) {
+			s.Equal(serverShared.TaskListTypeActivity, request.GetTaskListType())
+		}).Return(resp, nil)
 	err := s.app.Run([]string{"", "--do", domainName, "tasklist", "describe", "-tl", "test-taskList", "-tlt", "activity"})
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestDescribeTaskList_Paused() {
+	resp := *describeTaskListResponse
+	resp.Paused = common.BoolPtr(true)
+	s.serverFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(&resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "tasklist", "describe", "-tl", "test-taskList"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestObserveWorkflow() {
 	history := getWorkflowExecutionHistoryResponse
 	s.clientFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any(), callOptions...).Return(history, nil).Times(2)
@@ -159,6 +159,21 @@ const (
 	FlagSkipErrorModeWithAlias     = FlagSkipErrorMode + ", serr"
 	FlagHeadersMode                = "headers"
 	FlagHeadersModeWithAlias       = FlagHeadersMode + ", he"
+	FlagInitialFailoverVersion     = "initial_failover_version"
+	FlagRPCName                    = "rpc_name"
+	FlagRPCAddress                 = "rpc_address"
+	FlagDuration                   = "duration"
+	FlagFailoverTimeout            = "failover_timeout_seconds"
+	FlagFailoverTimeoutWithAlias   = FlagFailoverTimeout + ", fts"
+	FlagSourceCluster              = "source_cluster"
+	FlagSourceClusterWithAlias     = FlagSourceCluster + ", sc"
+	FlagRPS                        = "rps"
+	FlagResume                     = "resume"
+	FlagPartition                  = "partition"
+	FlagOffset                     = "offset"
+	FlagEndOffset                  = "end_offset"
+	FlagSkipWorkflowIDs            = "skip_workflow_ids"
+	FlagDispatchRate               = "dispatch_rate"
 )
 
 const (
@@ -1117,17 +1132,26 @@ func listClosedWorkflow(client client.Client, pageSize int, earliestTime, latest
 
 // DescribeTaskList show pollers info of a given tasklist
 func DescribeTaskList(c *cli.Context) {
-	wfClient := getWorkflowClient(c)
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
 	taskList := getRequiredOption(c, FlagTaskList)
-	taskListType := strToTaskListType(c.String(FlagTaskListType)) // default type is decision
+	taskListType := getServerTaskListType(c) // default type is decision
 
 	ctx, cancel := newContext()
 	defer cancel()
-	response, err := wfClient.DescribeTaskList(ctx, taskList, taskListType)
+	response, err := frontendClient.DescribeTaskList(ctx, &shared.DescribeTaskListRequest{
+		Domain:       common.StringPtr(domain),
+		TaskList:     &shared.TaskList{Name: common.StringPtr(taskList)},
+		TaskListType: taskListType.Ptr(),
+	})
 	if err != nil {
 		ErrorAndExit("Operation DescribeTaskList failed.", err)
 	}
 
+	if response.GetPaused() {
+		fmt.Println(colorRed("Tasklist is paused, its tasks are not dispatched until it is resumed"))
+	}
+
 	pollers := response.Pollers
 	if len(pollers) == 0 {
 		ErrorAndExit(colorMagenta("No poller for tasklist: "+taskList), nil)
@@ -1136,19 +1160,79 @@ func DescribeTaskList(c *cli.Context) {
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetBorder(false)
 	table.SetColumnSeparator("|")
-	if taskListType == s.TaskListTypeActivity {
-		table.SetHeader([]string{"Activity Poller Identity", "Last Access Time"})
+	if taskListType == shared.TaskListTypeActivity {
+		table.SetHeader([]string{"Activity Poller Identity", "Last Access Time", "Binary Checksum", "SDK Version", "Hostname"})
 	} else {
-		table.SetHeader([]string{"Decision Poller Identity", "Last Access Time"})
+		table.SetHeader([]string{"Decision Poller Identity", "Last Access Time", "Binary Checksum", "SDK Version", "Hostname"})
 	}
 	table.SetHeaderLine(false)
-	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
 	for _, poller := range pollers {
-		table.Append([]string{poller.GetIdentity(), convertTime(poller.GetLastAccessTime(), false)})
+		table.Append([]string{
+			poller.GetIdentity(),
+			convertTime(poller.GetLastAccessTime(), false),
+			poller.GetBinaryChecksum(),
+			poller.GetSdkVersion(),
+			poller.GetHostname(),
+		})
 	}
 	table.Render()
 }
 
+// ListTaskLists list the tasklists of a domain
+func ListTaskLists(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	more := c.Bool(FlagMore)
+	pageSize := c.Int(FlagPageSize)
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Task List", "Type", "Kind", "Ack Level", "Last Updated"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+
+	var nextPageToken []byte
+	for {
+		ctx, cancel := newContext()
+		response, err := frontendClient.ListTaskLists(ctx, &shared.ListTaskListsRequest{
+			Domain:        common.StringPtr(domain),
+			PageSize:      common.Int32Ptr(int32(pageSize)),
+			NextPageToken: nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Operation ListTaskLists failed.", err)
+		}
+
+		for _, info := range response.TaskLists {
+			table.Append([]string{
+				info.TaskList.GetName(),
+				info.GetTaskListType().String(),
+				info.GetKind().String(),
+				strconv.FormatInt(info.GetAckLevel(), 10),
+				convertTime(info.GetLastUpdatedTime(), false),
+			})
+		}
+		table.Render()
+		table.ClearRows()
+
+		nextPageToken = response.NextPageToken
+		if !more || len(nextPageToken) == 0 {
+			break
+		}
+
+		fmt.Printf("Press %s to show next page, press %s to quit: ",
+			color.GreenString("Enter"), color.RedString("any other key then Enter"))
+		var input string
+		fmt.Scanln(&input)
+		if strings.Trim(input, " ") != "" {
+			break
+		}
+	}
+}
+
 // ObserveHistory show the process of running workflow
 func ObserveHistory(c *cli.Context) {
 	wid := getRequiredOption(c, FlagWorkflowID)
@@ -43,5 +43,24 @@ func newTaskListCommands() []cli.Command {
 				DescribeTaskList(c)
 			},
 		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List tasklists of a domain. On Cassandra, the tasklists not loaded since the upgrade to schema v0.24 are not listed",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  FlagMoreWithAlias,
+					Usage: "List more pages, default is to list one page of default page size 10",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 10,
+					Usage: "Result page size",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ListTaskLists(c)
+			},
+		},
 	}
 }